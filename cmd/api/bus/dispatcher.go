@@ -9,7 +9,9 @@ import (
 	"sync"
 
 	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/pkg/types"
 	"github.com/dipdup-io/workerpool"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/lib/pq"
 	"github.com/pkg/errors"
@@ -19,7 +21,10 @@ import (
 type Dispatcher struct {
 	listener   storage.Listener
 	blocks     storage.IBlock
+	txs        storage.ITx
+	blobLogs   storage.IBlobLog
 	validators storage.IValidator
+	upgrades   storage.IUpgrade
 
 	mx        *sync.RWMutex
 	observers []*Observer
@@ -30,7 +35,10 @@ type Dispatcher struct {
 func NewDispatcher(
 	factory storage.ListenerFactory,
 	blocks storage.IBlock,
+	txs storage.ITx,
+	blobLogs storage.IBlobLog,
 	validators storage.IValidator,
+	upgrades storage.IUpgrade,
 ) (*Dispatcher, error) {
 	if factory == nil {
 		return nil, errors.New("nil listener factory")
@@ -39,7 +47,10 @@ func NewDispatcher(
 	return &Dispatcher{
 		listener:   listener,
 		blocks:     blocks,
+		txs:        txs,
+		blobLogs:   blobLogs,
 		validators: validators,
+		upgrades:   upgrades,
 		observers:  make([]*Observer, 0),
 		mx:         new(sync.RWMutex),
 		g:          workerpool.NewGroup(),
@@ -58,7 +69,7 @@ func (d *Dispatcher) Observe(channels ...string) *Observer {
 }
 
 func (d *Dispatcher) Start(ctx context.Context) {
-	if err := d.listener.Subscribe(ctx, storage.ChannelHead, storage.ChannelBlock); err != nil {
+	if err := d.listener.Subscribe(ctx, storage.ChannelHead, storage.ChannelBlock, storage.ChannelBlob); err != nil {
 		log.Err(err).Msg("subscribe on postgres notifications")
 		return
 	}
@@ -105,6 +116,8 @@ func (d *Dispatcher) handleNotification(ctx context.Context, notification *pq.No
 		return d.handleState(ctx, notification.Extra)
 	case storage.ChannelBlock:
 		return d.handleBlock(ctx, notification.Extra)
+	case storage.ChannelBlob:
+		return d.handleBlobs(ctx, notification.Extra)
 	default:
 		return errors.Errorf("unknown channel name: %s", notification.Channel)
 	}
@@ -125,9 +138,95 @@ func (d *Dispatcher) handleBlock(ctx context.Context, payload string) error {
 		d.observers[i].notifyBlocks(&block)
 	}
 	d.mx.RUnlock()
+
+	return d.handleTxs(ctx, block)
+}
+
+func (d *Dispatcher) handleTxs(ctx context.Context, block storage.Block) error {
+	if !d.hasTxsObservers() {
+		return nil
+	}
+	if block.Stats.TxCount == 0 {
+		return nil
+	}
+
+	txs, err := d.txs.ByHeightWithRelations(ctx, block.Height)
+	if err != nil {
+		return errors.Wrapf(err, "receiving transactions of block %d", block.Height)
+	}
+
+	d.mx.RLock()
+	for i := range txs {
+		for j := range d.observers {
+			d.observers[j].notifyTxs(&txs[i])
+		}
+	}
+	d.mx.RUnlock()
 	return nil
 }
 
+func (d *Dispatcher) hasTxsObservers() bool {
+	d.mx.RLock()
+	defer d.mx.RUnlock()
+
+	for i := range d.observers {
+		if d.observers[i].listenTxs {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *Dispatcher) hasBlobsObservers() bool {
+	d.mx.RLock()
+	defer d.mx.RUnlock()
+
+	for i := range d.observers {
+		if d.observers[i].listenBlobs {
+			return true
+		}
+	}
+	return false
+}
+
+const blobsPageSize = 100
+
+func (d *Dispatcher) handleBlobs(ctx context.Context, payload string) error {
+	height, err := strconv.ParseUint(payload, 10, 64)
+	if err != nil {
+		return errors.Wrapf(err, "parse block height: %s", payload)
+	}
+
+	if !d.hasBlobsObservers() {
+		return nil
+	}
+
+	var offset int
+	for {
+		blobs, err := d.blobLogs.ByHeight(ctx, types.Level(height), storage.BlobLogFilters{
+			Limit:  blobsPageSize,
+			Offset: offset,
+			Sort:   sdk.SortOrderAsc,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "receiving blobs of block %d", height)
+		}
+
+		d.mx.RLock()
+		for i := range blobs {
+			for j := range d.observers {
+				d.observers[j].notifyBlobs(&blobs[i])
+			}
+		}
+		d.mx.RUnlock()
+
+		if len(blobs) < blobsPageSize {
+			return nil
+		}
+		offset += len(blobs)
+	}
+}
+
 func (d *Dispatcher) handleState(ctx context.Context, payload string) error {
 	var state storage.State
 	if err := jsoniter.UnmarshalFromString(payload, &state); err != nil {
@@ -140,6 +239,14 @@ func (d *Dispatcher) handleState(ctx context.Context, payload string) error {
 	}
 	state.TotalVotingPower = power
 
+	if _, err := d.upgrades.Pending(ctx); err != nil {
+		if !d.upgrades.IsNoRows(err) {
+			return err
+		}
+	} else {
+		state.UpgradePending = true
+	}
+
 	d.mx.RLock()
 	for i := range d.observers {
 		d.observers[i].notifyState(&state)
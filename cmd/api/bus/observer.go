@@ -11,9 +11,13 @@ import (
 type Observer struct {
 	blocks chan *storage.Block
 	state  chan *storage.State
+	txs    chan *storage.Tx
+	blobs  chan *storage.BlobLog
 
 	listenBlocks bool
 	listenHead   bool
+	listenTxs    bool
+	listenBlobs  bool
 
 	g workerpool.Group
 }
@@ -26,6 +30,8 @@ func NewObserver(channels ...string) *Observer {
 	observer := &Observer{
 		blocks: make(chan *storage.Block, 1024),
 		state:  make(chan *storage.State, 1024),
+		txs:    make(chan *storage.Tx, 1024),
+		blobs:  make(chan *storage.BlobLog, 1024),
 		g:      workerpool.NewGroup(),
 	}
 
@@ -35,6 +41,10 @@ func NewObserver(channels ...string) *Observer {
 			observer.listenBlocks = true
 		case storage.ChannelHead:
 			observer.listenHead = true
+		case storage.ChannelTx:
+			observer.listenTxs = true
+		case storage.ChannelBlob:
+			observer.listenBlobs = true
 		}
 	}
 
@@ -45,6 +55,8 @@ func (observer Observer) Close() error {
 	observer.g.Wait()
 	close(observer.blocks)
 	close(observer.state)
+	close(observer.txs)
+	close(observer.blobs)
 	return nil
 }
 
@@ -60,6 +72,18 @@ func (observer Observer) notifyState(state *storage.State) {
 	}
 }
 
+func (observer Observer) notifyTxs(tx *storage.Tx) {
+	if observer.listenTxs {
+		observer.txs <- tx
+	}
+}
+
+func (observer Observer) notifyBlobs(blob *storage.BlobLog) {
+	if observer.listenBlobs {
+		observer.blobs <- blob
+	}
+}
+
 func (observer Observer) Blocks() <-chan *storage.Block {
 	return observer.blocks
 }
@@ -67,3 +91,11 @@ func (observer Observer) Blocks() <-chan *storage.Block {
 func (observer Observer) Head() <-chan *storage.State {
 	return observer.state
 }
+
+func (observer Observer) Txs() <-chan *storage.Tx {
+	return observer.txs
+}
+
+func (observer Observer) Blobs() <-chan *storage.BlobLog {
+	return observer.blobs
+}
@@ -11,21 +11,28 @@ import (
 	"github.com/celenium-io/celestia-indexer/internal/storage"
 	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
 	"github.com/celenium-io/celestia-indexer/pkg/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	"github.com/labstack/echo/v4"
 )
 
 type AddressHandler struct {
-	address       storage.IAddress
-	txs           storage.ITx
-	blobLogs      storage.IBlobLog
-	messages      storage.IMessage
-	delegations   storage.IDelegation
-	undelegations storage.IUndelegation
-	redelegations storage.IRedelegation
-	vestings      storage.IVestingAccount
-	grants        storage.IGrant
-	state         storage.IState
-	indexerName   string
+	address        storage.IAddress
+	txs            storage.ITx
+	blobLogs       storage.IBlobLog
+	messages       storage.IMessage
+	delegations    storage.IDelegation
+	undelegations  storage.IUndelegation
+	redelegations  storage.IRedelegation
+	vestings       storage.IVestingAccount
+	grants         storage.IGrant
+	votes          storage.IVote
+	deposits       storage.IDeposit
+	ibcTransfers   storage.IIbcTransfer
+	icas           storage.IInterchainAccount
+	balanceHistory storage.IBalanceHistory
+	transfers      storage.ITransfer
+	state          storage.IState
+	indexerName    string
 }
 
 func NewAddressHandler(
@@ -38,21 +45,33 @@ func NewAddressHandler(
 	redelegations storage.IRedelegation,
 	vestings storage.IVestingAccount,
 	grants storage.IGrant,
+	votes storage.IVote,
+	deposits storage.IDeposit,
+	ibcTransfers storage.IIbcTransfer,
+	icas storage.IInterchainAccount,
+	balanceHistory storage.IBalanceHistory,
+	transfers storage.ITransfer,
 	state storage.IState,
 	indexerName string,
 ) *AddressHandler {
 	return &AddressHandler{
-		address:       address,
-		txs:           txs,
-		blobLogs:      blobLogs,
-		messages:      messages,
-		delegations:   delegations,
-		undelegations: undelegations,
-		redelegations: redelegations,
-		vestings:      vestings,
-		grants:        grants,
-		state:         state,
-		indexerName:   indexerName,
+		address:        address,
+		txs:            txs,
+		blobLogs:       blobLogs,
+		messages:       messages,
+		delegations:    delegations,
+		undelegations:  undelegations,
+		redelegations:  redelegations,
+		vestings:       vestings,
+		grants:         grants,
+		votes:          votes,
+		deposits:       deposits,
+		ibcTransfers:   ibcTransfers,
+		icas:           icas,
+		balanceHistory: balanceHistory,
+		transfers:      transfers,
+		state:          state,
+		indexerName:    indexerName,
 	}
 }
 
@@ -63,7 +82,7 @@ type getAddressRequest struct {
 // Get godoc
 //
 //	@Summary		Get address info
-//	@Description	Get address info
+//	@Description	Get address info. If address is an interchain account hosted on Celestia, its owner and controller chain are returned too.
 //	@Tags			address
 //	@ID				get-address
 //	@Param			hash	path	string	true	"Hash"	minlength(47)	maxlength(47)
@@ -89,7 +108,17 @@ func (handler *AddressHandler) Get(c echo.Context) error {
 		return handleError(c, err, handler.address)
 	}
 
-	return c.JSON(http.StatusOK, responses.NewAddress(address))
+	response := responses.NewAddress(address)
+
+	ica, err := handler.icas.ByAddressId(c.Request().Context(), address.Id)
+	switch {
+	case err == nil:
+		response.InterchainAccount = responses.NewInterchainAccount(ica)
+	case !handler.icas.IsNoRows(err):
+		return handleError(c, err, handler.address)
+	}
+
+	return c.JSON(http.StatusOK, response)
 }
 
 type addressListRequest struct {
@@ -165,6 +194,7 @@ func (handler *AddressHandler) List(c echo.Context) error {
 //	@Param			from		query	integer					false	"Time from in unix timestamp"	minimum(1)
 //	@Param			to			query	integer					false	"Time to in unix timestamp"		minimum(1)
 //	@Param			height		query	integer					false	"Block number"					minimum(1)
+//	@Param			type		query	string					false	"Relation of address to transactions: signed by address or fee paid by address"	Enums(signed, paid)
 //	@Produce		json
 //	@Success		200	{array}		responses.Tx
 //	@Failure		400	{object}	Error
@@ -194,6 +224,7 @@ func (handler *AddressHandler) Transactions(c echo.Context) error {
 		Status:       req.Status,
 		Height:       req.Height,
 		MessageTypes: storageTypes.NewMsgTypeBitMask(),
+		FeePaid:      req.Type == "paid",
 	}
 	if req.From > 0 {
 		fltrs.TimeFrom = time.Unix(req.From, 0).UTC()
@@ -717,10 +748,414 @@ func (handler *AddressHandler) Grantee(c echo.Context) error {
 	return returnArray(c, response)
 }
 
+type addressVotesRequest struct {
+	Hash   string      `param:"hash"   validate:"required,address"`
+	Limit  int         `query:"limit"  validate:"omitempty,min=1,max=100"`
+	Offset int         `query:"offset" validate:"omitempty,min=0"`
+	Option StringArray `query:"option" validate:"omitempty,dive,vote_option"`
+}
+
+func (p *addressVotesRequest) SetDefault() {
+	if p.Limit == 0 {
+		p.Limit = 10
+	}
+}
+
+// Votes godoc
+//
+//	@Summary		Get address votes
+//	@Description	Get address votes in governance proposals
+//	@Tags			address
+//	@ID				address-votes
+//	@Param			hash	path	string	true	"Hash"							minlength(47)	maxlength(47)
+//	@Param			limit	query	integer	false	"Count of requested entities"	minimum(1)		maximum(100)
+//	@Param			offset	query	integer	false	"Offset"						minimum(1)
+//	@Param			option	query	string	false	"Comma-separated vote option list"
+//	@Produce		json
+//	@Success		200	{array}		responses.Vote
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/address/{hash}/votes [get]
+func (handler *AddressHandler) Votes(c echo.Context) error {
+	req, err := bindAndValidate[addressVotesRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	_, hash, err := types.Address(req.Hash).Decode()
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	addressId, err := handler.address.IdByHash(c.Request().Context(), hash)
+	if err != nil {
+		return handleError(c, err, handler.address)
+	}
+
+	fltrs := storage.VoteFilters{
+		Limit:  req.Limit,
+		Offset: req.Offset,
+		Option: make([]storageTypes.VoteOption, len(req.Option)),
+	}
+	for i := range req.Option {
+		fltrs.Option[i] = storageTypes.VoteOption(req.Option[i])
+	}
+
+	votes, err := handler.votes.ByVoterId(c.Request().Context(), addressId, fltrs)
+	if err != nil {
+		return handleError(c, err, handler.address)
+	}
+
+	response := make([]responses.Vote, len(votes))
+	for i := range response {
+		response[i] = responses.NewVote(votes[i])
+	}
+	return returnArray(c, response)
+}
+
+// Deposits godoc
+//
+//	@Summary		Get address deposits
+//	@Description	Get address deposits to governance proposals
+//	@Tags			address
+//	@ID				address-deposits
+//	@Param			hash	path	string	true	"Hash"							minlength(47)	maxlength(47)
+//	@Param			limit	query	integer	false	"Count of requested entities"	minimum(1)		maximum(100)
+//	@Param			offset	query	integer	false	"Offset"						minimum(1)
+//	@Produce		json
+//	@Success		200	{array}		responses.Deposit
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/address/{hash}/deposits [get]
+func (handler *AddressHandler) Deposits(c echo.Context) error {
+	req, err := bindAndValidate[getAddressPageable](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	_, hash, err := types.Address(req.Hash).Decode()
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	addressId, err := handler.address.IdByHash(c.Request().Context(), hash)
+	if err != nil {
+		return handleError(c, err, handler.address)
+	}
+
+	deposits, err := handler.deposits.ByDepositorId(
+		c.Request().Context(),
+		addressId,
+		req.Limit,
+		req.Offset,
+		sdk.SortOrderDesc,
+	)
+	if err != nil {
+		return handleError(c, err, handler.address)
+	}
+
+	response := make([]responses.Deposit, len(deposits))
+	for i := range response {
+		response[i] = responses.NewDeposit(deposits[i])
+	}
+	return returnArray(c, response)
+}
+
+type addressIbcRequest struct {
+	Hash   string      `param:"hash"   validate:"required,address"`
+	Limit  int         `query:"limit"  validate:"omitempty,min=1,max=100"`
+	Offset int         `query:"offset" validate:"omitempty,min=0"`
+	Sort   string      `query:"sort"   validate:"omitempty,oneof=asc desc"`
+	Status StringArray `query:"status" validate:"omitempty,dive,ibc_transfer_status"`
+}
+
+func (p *addressIbcRequest) SetDefault() {
+	if p.Limit == 0 {
+		p.Limit = 10
+	}
+	if p.Sort == "" {
+		p.Sort = desc
+	}
+}
+
+// Ibc godoc
+//
+//	@Summary		Get address IBC transfers
+//	@Description	Get IBC fungible token transfers where address is a sender or a receiver
+//	@Tags			address
+//	@ID				address-ibc
+//	@Param			hash	path	string	true	"Hash"							minlength(47)	maxlength(47)
+//	@Param			limit	query	integer	false	"Count of requested entities"	minimum(1)		maximum(100)
+//	@Param			offset	query	integer	false	"Offset"						minimum(1)
+//	@Param			sort	query	string	false	"Sort order"					Enums(asc, desc)
+//	@Param			status	query	string	false	"Comma-separated transfer status list"
+//	@Produce		json
+//	@Success		200	{array}		responses.IbcTransfer
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/address/{hash}/ibc [get]
+func (handler *AddressHandler) Ibc(c echo.Context) error {
+	req, err := bindAndValidate[addressIbcRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	_, hash, err := types.Address(req.Hash).Decode()
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	addressId, err := handler.address.IdByHash(c.Request().Context(), hash)
+	if err != nil {
+		return handleError(c, err, handler.address)
+	}
+
+	fltrs := storage.ListIbcTransferFilters{
+		Limit:     req.Limit,
+		Offset:    req.Offset,
+		Sort:      pgSort(req.Sort),
+		AddressId: &addressId,
+		Status:    make([]storageTypes.IbcTransferStatus, len(req.Status)),
+	}
+	for i := range req.Status {
+		fltrs.Status[i] = storageTypes.IbcTransferStatus(req.Status[i])
+	}
+
+	transfers, err := handler.ibcTransfers.ListWithFilters(c.Request().Context(), fltrs)
+	if err != nil {
+		return handleError(c, err, handler.address)
+	}
+
+	response := make([]responses.IbcTransfer, len(transfers))
+	for i := range response {
+		response[i] = responses.NewIbcTransfer(transfers[i])
+	}
+	return returnArray(c, response)
+}
+
+type addressIcaPacketsRequest struct {
+	Hash   string `param:"hash"   validate:"required,address"`
+	Limit  int    `query:"limit"  validate:"omitempty,min=1,max=100"`
+	Offset int    `query:"offset" validate:"omitempty,min=0"`
+	Sort   string `query:"sort"   validate:"omitempty,oneof=asc desc"`
+}
+
+func (p *addressIcaPacketsRequest) SetDefault() {
+	if p.Limit == 0 {
+		p.Limit = 10
+	}
+	if p.Sort == "" {
+		p.Sort = desc
+	}
+}
+
+// InterchainAccountPackets godoc
+//
+//	@Summary		Get interchain account packets
+//	@Description	Get packets received from controller chain and executed by interchain account
+//	@Tags			address
+//	@ID				address-ica-packets
+//	@Param			hash	path	string	true	"Hash"							minlength(47)	maxlength(47)
+//	@Param			limit	query	integer	false	"Count of requested entities"	minimum(1)		maximum(100)
+//	@Param			offset	query	integer	false	"Offset"						minimum(1)
+//	@Param			sort	query	string	false	"Sort order"					Enums(asc, desc)
+//	@Produce		json
+//	@Success		200	{array}		responses.InterchainAccountPacket
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/address/{hash}/ica_packets [get]
+func (handler *AddressHandler) InterchainAccountPackets(c echo.Context) error {
+	req, err := bindAndValidate[addressIcaPacketsRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	_, hash, err := types.Address(req.Hash).Decode()
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	addressId, err := handler.address.IdByHash(c.Request().Context(), hash)
+	if err != nil {
+		return handleError(c, err, handler.address)
+	}
+
+	ica, err := handler.icas.ByAddressId(c.Request().Context(), addressId)
+	if err != nil {
+		if handler.icas.IsNoRows(err) {
+			return returnArray(c, []responses.InterchainAccountPacket{})
+		}
+		return handleError(c, err, handler.address)
+	}
+
+	packets, err := handler.icas.Packets(c.Request().Context(), storage.ListInterchainAccountPacketsFilters{
+		Limit:        req.Limit,
+		Offset:       req.Offset,
+		Sort:         pgSort(req.Sort),
+		ConnectionId: ica.ConnectionId,
+		Owner:        ica.Owner,
+	})
+	if err != nil {
+		return handleError(c, err, handler.address)
+	}
+
+	response := make([]responses.InterchainAccountPacket, len(packets))
+	for i := range response {
+		response[i] = responses.NewInterchainAccountPacket(packets[i])
+	}
+	return returnArray(c, response)
+}
+
+type addressBalanceHistoryRequest struct {
+	Hash   string      `param:"hash"   validate:"required,address"`
+	Limit  int         `query:"limit"  validate:"omitempty,min=1,max=100"`
+	Offset int         `query:"offset" validate:"omitempty,min=0"`
+	Sort   string      `query:"sort"   validate:"omitempty,oneof=asc desc"`
+	Height uint64      `query:"height" validate:"omitempty,min=1"`
+	Type   StringArray `query:"type"   validate:"omitempty,dive,balance_history_type"`
+
+	From int64 `example:"1692892095" query:"from" swaggertype:"integer" validate:"omitempty,min=1"`
+	To   int64 `example:"1692892095" query:"to"   swaggertype:"integer" validate:"omitempty,min=1"`
+}
+
+func (p *addressBalanceHistoryRequest) SetDefault() {
+	if p.Limit == 0 {
+		p.Limit = 10
+	}
+	if p.Sort == "" {
+		p.Sort = desc
+	}
+}
+
+func (p *addressBalanceHistoryRequest) ToFilters() storage.BalanceHistoryFilters {
+	fltrs := storage.BalanceHistoryFilters{
+		Limit:  p.Limit,
+		Offset: p.Offset,
+		Sort:   pgSort(p.Sort),
+		Height: p.Height,
+		Type:   make([]storageTypes.BalanceHistoryType, len(p.Type)),
+	}
+	for i := range p.Type {
+		fltrs.Type[i] = storageTypes.BalanceHistoryType(p.Type[i])
+	}
+	if p.From > 0 {
+		fltrs.TimeFrom = time.Unix(p.From, 0).UTC()
+	}
+	if p.To > 0 {
+		fltrs.TimeTo = time.Unix(p.To, 0).UTC()
+	}
+	return fltrs
+}
+
+// BalanceHistory godoc
+//
+//	@Summary		Get address balance history
+//	@Description	Get changes of address balance. Each item contains change and resulting balance grouped by block and cause of change.
+//	@Tags			address
+//	@ID				address-balance-history
+//	@Param			hash	path	string	true	"Hash"									minlength(47)	maxlength(47)
+//	@Param			limit	query	integer	false	"Count of requested entities"			minimum(1)		maximum(100)
+//	@Param			offset	query	integer	false	"Offset"								minimum(1)
+//	@Param			sort	query	string	false	"Sort order"							Enums(asc, desc)
+//	@Param			height	query	integer	false	"Block number"							minimum(1)
+//	@Param			type	query	string	false	"Comma-separated list of change causes"	Enums(transfer, fee, reward, delegation, slashing)
+//	@Param			from	query	integer	false	"Time from in unix timestamp"			minimum(1)
+//	@Param			to		query	integer	false	"Time to in unix timestamp"				minimum(1)
+//	@Produce		json
+//	@Success		200	{array}		responses.BalanceHistory
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/address/{hash}/balance_history [get]
+func (handler *AddressHandler) BalanceHistory(c echo.Context) error {
+	req, err := bindAndValidate[addressBalanceHistoryRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	_, hash, err := types.Address(req.Hash).Decode()
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	addressId, err := handler.address.IdByHash(c.Request().Context(), hash)
+	if err != nil {
+		return handleError(c, err, handler.address)
+	}
+
+	history, err := handler.balanceHistory.ByAddress(c.Request().Context(), addressId, req.ToFilters())
+	if err != nil {
+		return handleError(c, err, handler.address)
+	}
+
+	response := make([]responses.BalanceHistory, len(history))
+	for i := range history {
+		response[i] = responses.NewBalanceHistory(history[i])
+	}
+	return returnArray(c, response)
+}
+
+// Transfers godoc
+//
+//	@Summary		Get address token transfers
+//	@Description	Get token transfers where address is a sender or a receiver
+//	@Tags			address
+//	@ID				address-transfers
+//	@Param			hash		path	string	true	"Hash"									minlength(47)	maxlength(47)
+//	@Param			limit		query	integer	false	"Count of requested entities"			minimum(1)		maximum(100)
+//	@Param			offset		query	integer	false	"Offset"								minimum(1)
+//	@Param			sort		query	string	false	"Sort order"							Enums(asc, desc)
+//	@Param			denom		query	string	false	"Denomination of transferred tokens"
+//	@Param			height		query	integer	false	"Block number"							minimum(1)
+//	@Param			amount_from	query	integer	false	"Minimum transferred amount"			minimum(1)
+//	@Param			amount_to	query	integer	false	"Maximum transferred amount (exclusive)"	minimum(1)
+//	@Param			from		query	integer	false	"Time from in unix timestamp"			minimum(1)
+//	@Param			to			query	integer	false	"Time to in unix timestamp"				minimum(1)
+//	@Produce		json
+//	@Success		200	{array}		responses.Transfer
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/address/{hash}/transfers [get]
+func (handler *AddressHandler) Transfers(c echo.Context) error {
+	req, err := bindAndValidate[listTransfersRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	_, hash, err := types.Address(req.Hash).Decode()
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	addressId, err := handler.address.IdByHash(c.Request().Context(), hash)
+	if err != nil {
+		return handleError(c, err, handler.address)
+	}
+
+	fltrs := req.ToFilters()
+	fltrs.AddressId = &addressId
+
+	transfers, err := handler.transfers.ListWithFilters(c.Request().Context(), fltrs)
+	if err != nil {
+		return handleError(c, err, handler.address)
+	}
+
+	response := make([]responses.Transfer, len(transfers))
+	for i := range transfers {
+		response[i] = responses.NewTransfer(transfers[i])
+	}
+	return returnArray(c, response)
+}
+
 type addressStatsRequest struct {
 	Hash       string `example:"celestia1glfkehhpvl55amdew2fnm6wxt7egy560mxdrj7" param:"hash"      swaggertype:"string"  validate:"required,address"`
 	Timeframe  string `example:"hour"                                            param:"timeframe" swaggertype:"string"  validate:"required,oneof=hour day month"`
-	SeriesName string `example:"tps"                                             param:"name"      swaggertype:"string"  validate:"required,oneof=gas_used gas_wanted fee count"`
+	SeriesName string `example:"tps"                                             param:"name"      swaggertype:"string"  validate:"required,oneof=gas_used gas_wanted fee count balance"`
 	From       int64  `example:"1692892095"                                      query:"from"      swaggertype:"integer" validate:"omitempty,min=1"`
 	To         int64  `example:"1692892095"                                      query:"to"        swaggertype:"integer" validate:"omitempty,min=1"`
 }
@@ -732,7 +1167,7 @@ type addressStatsRequest struct {
 //	@Tags			address
 //	@ID				address-stats
 //	@Param			hash		path	string	true	"Hash"							minlength(47)	maxlength(47)
-//	@Param			name		path	string	true	"Series name"					Enums(gas_used, gas_wanted, fee, count)
+//	@Param			name		path	string	true	"Series name"					Enums(gas_used, gas_wanted, fee, count, balance)
 //	@Param			timeframe	path	string	true	"Timeframe"						Enums(hour, day, month)
 //	@Param			from		query	integer	false	"Time from in unix timestamp"	mininum(1)
 //	@Param			to			query	integer	false	"Time to in unix timestamp"		mininum(1)
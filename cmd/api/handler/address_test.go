@@ -5,6 +5,7 @@ package handler
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -17,6 +18,8 @@ import (
 	"github.com/celenium-io/celestia-indexer/internal/storage"
 	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
 	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	testsuite "github.com/celenium-io/celestia-indexer/internal/test_suite"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	"github.com/labstack/echo/v4"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/suite"
@@ -41,19 +44,25 @@ var (
 // AddressTestSuite -
 type AddressTestSuite struct {
 	suite.Suite
-	address       *mock.MockIAddress
-	txs           *mock.MockITx
-	blobLogs      *mock.MockIBlobLog
-	messages      *mock.MockIMessage
-	delegations   *mock.MockIDelegation
-	undelegations *mock.MockIUndelegation
-	redelegations *mock.MockIRedelegation
-	vestings      *mock.MockIVestingAccount
-	grants        *mock.MockIGrant
-	state         *mock.MockIState
-	echo          *echo.Echo
-	handler       *AddressHandler
-	ctrl          *gomock.Controller
+	address        *mock.MockIAddress
+	txs            *mock.MockITx
+	blobLogs       *mock.MockIBlobLog
+	messages       *mock.MockIMessage
+	delegations    *mock.MockIDelegation
+	undelegations  *mock.MockIUndelegation
+	redelegations  *mock.MockIRedelegation
+	vestings       *mock.MockIVestingAccount
+	grants         *mock.MockIGrant
+	votes          *mock.MockIVote
+	deposits       *mock.MockIDeposit
+	ibcTransfers   *mock.MockIIbcTransfer
+	icas           *mock.MockIInterchainAccount
+	balanceHistory *mock.MockIBalanceHistory
+	transfers      *mock.MockITransfer
+	state          *mock.MockIState
+	echo           *echo.Echo
+	handler        *AddressHandler
+	ctrl           *gomock.Controller
 }
 
 // SetupSuite -
@@ -70,8 +79,14 @@ func (s *AddressTestSuite) SetupSuite() {
 	s.redelegations = mock.NewMockIRedelegation(s.ctrl)
 	s.vestings = mock.NewMockIVestingAccount(s.ctrl)
 	s.grants = mock.NewMockIGrant(s.ctrl)
+	s.votes = mock.NewMockIVote(s.ctrl)
+	s.deposits = mock.NewMockIDeposit(s.ctrl)
+	s.ibcTransfers = mock.NewMockIIbcTransfer(s.ctrl)
+	s.icas = mock.NewMockIInterchainAccount(s.ctrl)
+	s.balanceHistory = mock.NewMockIBalanceHistory(s.ctrl)
+	s.transfers = mock.NewMockITransfer(s.ctrl)
 	s.state = mock.NewMockIState(s.ctrl)
-	s.handler = NewAddressHandler(s.address, s.txs, s.blobLogs, s.messages, s.delegations, s.undelegations, s.redelegations, s.vestings, s.grants, s.state, testIndexerName)
+	s.handler = NewAddressHandler(s.address, s.txs, s.blobLogs, s.messages, s.delegations, s.undelegations, s.redelegations, s.vestings, s.grants, s.votes, s.deposits, s.ibcTransfers, s.icas, s.balanceHistory, s.transfers, s.state, testIndexerName)
 }
 
 // TearDownSuite -
@@ -102,6 +117,16 @@ func (s *AddressTestSuite) TestGet() {
 			LastHeight: 100,
 		}, nil)
 
+	s.icas.EXPECT().
+		ByAddressId(gomock.Any(), uint64(1)).
+		Return(storage.InterchainAccount{}, sql.ErrNoRows).
+		Times(1)
+
+	s.icas.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true).
+		Times(1)
+
 	s.Require().NoError(s.handler.Get(c))
 	s.Require().Equal(http.StatusOK, rec.Code)
 
@@ -112,6 +137,67 @@ func (s *AddressTestSuite) TestGet() {
 	s.Require().EqualValues(100, address.Height)
 	s.Require().EqualValues(100, address.LastHeight)
 	s.Require().Equal(testAddress, address.Hash)
+	s.Require().Nil(address.InterchainAccount)
+}
+
+func (s *AddressTestSuite) TestGetInterchainAccount() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address/:hash")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddress)
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), testHashAddress).
+		Return(storage.Address{
+			Id:         1,
+			Hash:       testHashAddress,
+			Address:    testAddress,
+			Height:     100,
+			LastHeight: 100,
+		}, nil)
+
+	s.icas.EXPECT().
+		ByAddressId(gomock.Any(), uint64(1)).
+		Return(storage.InterchainAccount{
+			Id:                     1,
+			Address:                testAddress,
+			AddressId:              1,
+			Owner:                  "osmo1owner",
+			ConnectionId:           "connection-0",
+			ControllerConnectionId: "connection-1",
+			ChannelId:              "channel-2",
+			CounterpartyChannelId:  "channel-3",
+			Height:                 100,
+			CreatedAt:              testTime,
+			Connection: &storage.IbcConnection{
+				Id: "connection-0",
+				Client: &storage.IbcClient{
+					Id:      "07-tendermint-0",
+					ChainId: "osmosis-1",
+				},
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Get(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var address responses.Address
+	err := json.NewDecoder(rec.Body).Decode(&address)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, address.Id)
+	s.Require().NotNil(address.InterchainAccount)
+
+	ica := address.InterchainAccount
+	s.Require().Equal("osmo1owner", ica.Owner)
+	s.Require().Equal("connection-0", ica.ConnectionId)
+	s.Require().Equal("connection-1", ica.ControllerConnectionId)
+	s.Require().Equal("osmosis-1", ica.ChainId)
+	s.Require().Equal("channel-2", ica.ChannelId)
+	s.Require().Equal("channel-3", ica.CounterpartyChannelId)
+	s.Require().EqualValues(100, ica.Height)
 }
 
 func (s *AddressTestSuite) TestGetInvalidAddress() {
@@ -245,6 +331,55 @@ func (s *AddressTestSuite) TestTransactions() {
 	s.Require().Equal(types.StatusSuccess, tx.Status)
 }
 
+func (s *AddressTestSuite) TestTransactionsPaid() {
+	q := make(url.Values)
+	q.Set("limit", "2")
+	q.Set("type", "paid")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address/:hash/txs")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddress)
+
+	s.address.EXPECT().
+		IdByHash(gomock.Any(), testHashAddress).
+		Return(uint64(1), nil).
+		Times(1)
+
+	s.txs.EXPECT().
+		ByAddress(gomock.Any(), uint64(1), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uint64, fltrs storage.TxFilter) ([]storage.Tx, error) {
+			s.Require().True(fltrs.FeePaid)
+			return []storage.Tx{testTx}, nil
+		}).
+		Times(1)
+
+	s.Require().NoError(s.handler.Transactions(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var txs []responses.Tx
+	err := json.NewDecoder(rec.Body).Decode(&txs)
+	s.Require().NoError(err)
+	s.Require().Len(txs, 1)
+}
+
+func (s *AddressTestSuite) TestTransactionsInvalidType() {
+	q := make(url.Values)
+	q.Set("type", "unknown")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address/:hash/txs")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddress)
+
+	s.Require().NoError(s.handler.Transactions(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
 func (s *AddressTestSuite) TestMessages() {
 	q := make(url.Values)
 	q.Set("limit", "10")
@@ -692,8 +827,352 @@ func (s *AddressTestSuite) TestGrantee() {
 	s.Require().False(g.Revoked)
 }
 
+func (s *AddressTestSuite) TestVotes() {
+	q := make(url.Values)
+	q.Set("limit", "10")
+	q.Set("offset", "0")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address/:hash/votes")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddress)
+
+	s.address.EXPECT().
+		IdByHash(gomock.Any(), testHashAddress).
+		Return(uint64(1), nil).
+		Times(1)
+
+	s.votes.EXPECT().
+		ByVoterId(gomock.Any(), uint64(1), storage.VoteFilters{
+			Limit:  10,
+			Offset: 0,
+			Option: []types.VoteOption{},
+		}).
+		Return([]storage.Vote{
+			{
+				Id:         1,
+				Height:     1000,
+				Time:       testTime,
+				Option:     types.VoteOptionNoWithVeto,
+				Weight:     decimal.NewFromInt(1),
+				ProposalId: 3,
+				VoterId:    1,
+				Voter: &storage.Address{
+					Address: testAddress,
+				},
+			},
+		}, nil)
+
+	s.Require().NoError(s.handler.Votes(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var votes []responses.Vote
+	err := json.NewDecoder(rec.Body).Decode(&votes)
+	s.Require().NoError(err)
+	s.Require().Len(votes, 1)
+
+	v := votes[0]
+	s.Require().EqualValues(3, v.ProposalId)
+	s.Require().EqualValues(1000, v.Height)
+	s.Require().Equal("no_with_veto", v.Option)
+	s.Require().Equal(testAddress, v.Voter)
+	s.Require().Nil(v.Validator)
+}
+
+func (s *AddressTestSuite) TestDeposits() {
+	q := make(url.Values)
+	q.Set("limit", "10")
+	q.Set("offset", "0")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address/:hash/deposits")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddress)
+
+	s.address.EXPECT().
+		IdByHash(gomock.Any(), testHashAddress).
+		Return(uint64(1), nil).
+		Times(1)
+
+	s.deposits.EXPECT().
+		ByDepositorId(gomock.Any(), uint64(1), 10, 0, sdk.SortOrderDesc).
+		Return([]storage.Deposit{
+			{
+				Id:          1,
+				Height:      1000,
+				Time:        testTime,
+				ProposalId:  3,
+				DepositorId: 1,
+				Amount:      decimal.RequireFromString("1000"),
+				Depositor: &storage.Address{
+					Address: testAddress,
+				},
+			},
+		}, nil)
+
+	s.Require().NoError(s.handler.Deposits(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var deposits []responses.Deposit
+	err := json.NewDecoder(rec.Body).Decode(&deposits)
+	s.Require().NoError(err)
+	s.Require().Len(deposits, 1)
+
+	d := deposits[0]
+	s.Require().EqualValues(3, d.ProposalId)
+	s.Require().Equal("1000", d.Amount)
+	s.Require().Equal(testAddress, d.Depositor)
+}
+
+func (s *AddressTestSuite) TestIbc() {
+	q := make(url.Values)
+	q.Set("limit", "10")
+	q.Set("offset", "0")
+	q.Set("status", "sent,acknowledged")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address/:hash/ibc")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddress)
+
+	s.address.EXPECT().
+		IdByHash(gomock.Any(), testHashAddress).
+		Return(uint64(1), nil).
+		Times(1)
+
+	s.ibcTransfers.EXPECT().
+		ListWithFilters(gomock.Any(), storage.ListIbcTransferFilters{
+			Limit:     10,
+			Offset:    0,
+			Sort:      sdk.SortOrderDesc,
+			AddressId: testsuite.Ptr[uint64](1),
+			Status:    []types.IbcTransferStatus{types.IbcTransferStatusSent, types.IbcTransferStatusAcknowledged},
+		}).
+		Return([]storage.IbcTransfer{testIbcTransfer}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Ibc(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var transfers []responses.IbcTransfer
+	err := json.NewDecoder(rec.Body).Decode(&transfers)
+	s.Require().NoError(err)
+	s.Require().Len(transfers, 1)
+
+	t := transfers[0]
+	s.Require().EqualValues(1, t.Id)
+	s.Require().EqualValues(7, t.Sequence)
+	s.Require().Equal("channel-2", t.ChannelId)
+	s.Require().Equal("osmosis-1", t.ChainId)
+	s.Require().Equal(testAddress, t.Sender)
+	s.Require().Equal("acknowledged", t.Status)
+}
+
+func (s *AddressTestSuite) TestInterchainAccountPackets() {
+	q := make(url.Values)
+	q.Set("limit", "10")
+	q.Set("offset", "0")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address/:hash/ica_packets")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddress)
+
+	s.address.EXPECT().
+		IdByHash(gomock.Any(), testHashAddress).
+		Return(uint64(1), nil).
+		Times(1)
+
+	s.icas.EXPECT().
+		ByAddressId(gomock.Any(), uint64(1)).
+		Return(storage.InterchainAccount{
+			Id:           1,
+			AddressId:    1,
+			Owner:        "osmo1owner",
+			ConnectionId: "connection-0",
+		}, nil).
+		Times(1)
+
+	s.icas.EXPECT().
+		Packets(gomock.Any(), storage.ListInterchainAccountPacketsFilters{
+			Limit:        10,
+			Offset:       0,
+			Sort:         sdk.SortOrderDesc,
+			ConnectionId: "connection-0",
+			Owner:        "osmo1owner",
+		}).
+		Return([]storage.InterchainAccountPacket{
+			{
+				Id:           1,
+				Height:       100,
+				Time:         testTime,
+				ConnectionId: "connection-0",
+				ChannelId:    "channel-2",
+				Owner:        "osmo1owner",
+				Sequence:     3,
+				Messages:     []string{"/cosmos.staking.v1beta1.MsgDelegate"},
+				Success:      true,
+				Tx:           &testTx,
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.InterchainAccountPackets(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var packets []responses.InterchainAccountPacket
+	err := json.NewDecoder(rec.Body).Decode(&packets)
+	s.Require().NoError(err)
+	s.Require().Len(packets, 1)
+
+	p := packets[0]
+	s.Require().EqualValues(1, p.Id)
+	s.Require().EqualValues(3, p.Sequence)
+	s.Require().Equal("channel-2", p.ChannelId)
+	s.Require().Equal([]string{"/cosmos.staking.v1beta1.MsgDelegate"}, p.Messages)
+	s.Require().True(p.Success)
+	s.Require().NotEmpty(p.TxHash)
+}
+
+func (s *AddressTestSuite) TestBalanceHistory() {
+	q := make(url.Values)
+	q.Set("limit", "10")
+	q.Set("offset", "0")
+	q.Set("type", "fee,reward")
+	q.Set("from", "1692892095")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address/:hash/balance_history")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddress)
+
+	s.address.EXPECT().
+		IdByHash(gomock.Any(), testHashAddress).
+		Return(uint64(1), nil).
+		Times(1)
+
+	s.balanceHistory.EXPECT().
+		ByAddress(gomock.Any(), uint64(1), storage.BalanceHistoryFilters{
+			Limit:    10,
+			Offset:   0,
+			Sort:     sdk.SortOrderDesc,
+			Type:     []types.BalanceHistoryType{types.BalanceHistoryTypeFee, types.BalanceHistoryTypeReward},
+			TimeFrom: time.Unix(1692892095, 0).UTC(),
+		}).
+		Return([]storage.BalanceHistory{
+			{
+				Id:              1,
+				Height:          100,
+				Time:            testTime,
+				AddressId:       1,
+				Currency:        "utia",
+				Type:            types.BalanceHistoryTypeFee,
+				SpendableChange: decimal.RequireFromString("-100"),
+				DelegatedChange: decimal.Zero,
+				UnbondingChange: decimal.Zero,
+				Spendable:       decimal.RequireFromString("900"),
+				Delegated:       decimal.RequireFromString("10"),
+				Unbonding:       decimal.Zero,
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.BalanceHistory(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var history []responses.BalanceHistory
+	err := json.NewDecoder(rec.Body).Decode(&history)
+	s.Require().NoError(err)
+	s.Require().Len(history, 1)
+
+	h := history[0]
+	s.Require().EqualValues(100, h.Height)
+	s.Require().Equal("fee", h.Type)
+	s.Require().Equal("utia", h.Currency)
+	s.Require().Equal("-100", h.SpendableChange)
+	s.Require().Equal("900", h.Spendable)
+	s.Require().Equal("10", h.Delegated)
+}
+
+func (s *AddressTestSuite) TestBalanceHistoryInvalidType() {
+	q := make(url.Values)
+	q.Set("type", "unknown")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address/:hash/balance_history")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddress)
+
+	s.Require().NoError(s.handler.BalanceHistory(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *AddressTestSuite) TestTransfers() {
+	q := make(url.Values)
+	q.Set("limit", "10")
+	q.Set("sort", "asc")
+	q.Set("height", "100")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address/:hash/transfers")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddress)
+
+	s.address.EXPECT().
+		IdByHash(gomock.Any(), testHashAddress).
+		Return(uint64(1), nil).
+		Times(1)
+
+	s.transfers.EXPECT().
+		ListWithFilters(gomock.Any(), storage.TransferFilters{
+			Limit:     10,
+			Sort:      sdk.SortOrderAsc,
+			AddressId: testsuite.Ptr[uint64](1),
+			Height:    100,
+		}).
+		Return([]storage.Transfer{
+			{
+				Id:         1,
+				Height:     100,
+				Time:       testTime,
+				SenderId:   1,
+				ReceiverId: 2,
+				Amount:     decimal.RequireFromString("1000"),
+				Denom:      "utia",
+				Sender:     &storage.Address{Address: testAddress},
+				Receiver:   &storage.Address{Address: "celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8"},
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Transfers(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var transfers []responses.Transfer
+	err := json.NewDecoder(rec.Body).Decode(&transfers)
+	s.Require().NoError(err)
+	s.Require().Len(transfers, 1)
+	s.Require().Equal("1000", transfers[0].Amount)
+	s.Require().Equal(testAddress, transfers[0].Sender)
+	s.Require().Empty(transfers[0].TxHash)
+}
+
 func (s *AddressTestSuite) TestStats() {
-	for _, name := range []string{"count", "fee", "gas_used", "gas_wanted"} {
+	for _, name := range []string{"count", "fee", "gas_used", "gas_wanted", "balance"} {
 		for _, tf := range []string{"hour", "day", "month"} {
 			req := httptest.NewRequest(http.MethodGet, "/", nil)
 			rec := httptest.NewRecorder()
@@ -0,0 +1,225 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/labstack/echo/v4"
+)
+
+type BlobstreamHandler struct {
+	attestations storage.IBlobstreamAttestation
+	evmAddresses storage.IBlobstreamEvmAddress
+}
+
+func NewBlobstreamHandler(
+	attestations storage.IBlobstreamAttestation,
+	evmAddresses storage.IBlobstreamEvmAddress,
+) *BlobstreamHandler {
+	return &BlobstreamHandler{
+		attestations: attestations,
+		evmAddresses: evmAddresses,
+	}
+}
+
+type listAttestationsRequest struct {
+	Limit  int         `query:"limit"  validate:"omitempty,min=1,max=100"`
+	Offset int         `query:"offset" validate:"omitempty,min=0"`
+	Sort   string      `query:"sort"   validate:"omitempty,oneof=asc desc"`
+	Type   StringArray `query:"type"   validate:"omitempty,dive,attestation_type"`
+
+	From int64 `example:"1692892095" query:"from" swaggertype:"integer" validate:"omitempty,min=1"`
+	To   int64 `example:"1692892095" query:"to"   swaggertype:"integer" validate:"omitempty,min=1"`
+}
+
+func (req *listAttestationsRequest) SetDefault() {
+	if req.Limit == 0 {
+		req.Limit = 10
+	}
+	if req.Sort == "" {
+		req.Sort = desc
+	}
+}
+
+func (req *listAttestationsRequest) ToFilters() storage.BlobstreamAttestationFilters {
+	fltrs := storage.BlobstreamAttestationFilters{
+		Limit:  req.Limit,
+		Offset: req.Offset,
+		Sort:   pgSort(req.Sort),
+	}
+	if len(req.Type) > 0 {
+		fltrs.Type = make([]storageTypes.AttestationType, len(req.Type))
+		for i := range req.Type {
+			fltrs.Type[i] = storageTypes.AttestationType(req.Type[i])
+		}
+	}
+	if req.From > 0 {
+		fltrs.TimeFrom = time.Unix(req.From, 0).UTC()
+	}
+	if req.To > 0 {
+		fltrs.TimeTo = time.Unix(req.To, 0).UTC()
+	}
+	return fltrs
+}
+
+// Attestations godoc
+//
+//	@Summary		List blobstream attestations
+//	@Description	List blobstream attestations: data commitments and validator set updates
+//	@Tags			blobstream
+//	@ID				list-blobstream-attestations
+//	@Param			limit	query	integer	false	"Count of requested entities"			mininum(1)	maximum(100)
+//	@Param			offset	query	integer	false	"Offset"								mininum(1)
+//	@Param			sort	query	string	false	"Sort order by nonce"					Enums(asc, desc)
+//	@Param			type	query	string	false	"Comma-separated attestation type list"
+//	@Param			from	query	integer	false	"Time from in unix timestamp"			minimum(1)
+//	@Param			to		query	integer	false	"Time to in unix timestamp"				minimum(1)
+//	@Produce		json
+//	@Success		200	{array}		responses.BlobstreamAttestation
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/blobstream/attestations [get]
+func (handler *BlobstreamHandler) Attestations(c echo.Context) error {
+	req, err := bindAndValidate[listAttestationsRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	attestations, err := handler.attestations.ListWithFilters(c.Request().Context(), req.ToFilters())
+	if err != nil {
+		return handleError(c, err, handler.attestations)
+	}
+
+	response := make([]responses.BlobstreamAttestation, len(attestations))
+	for i := range attestations {
+		response[i] = responses.NewBlobstreamAttestation(attestations[i])
+	}
+	return returnArray(c, response)
+}
+
+type attestationByNonceRequest struct {
+	Nonce uint64 `param:"nonce" validate:"required,min=1"`
+}
+
+// Attestation godoc
+//
+//	@Summary		Get blobstream attestation by nonce
+//	@Description	Get blobstream attestation by nonce
+//	@Tags			blobstream
+//	@ID				get-blobstream-attestation
+//	@Param			nonce	path	integer	true	"Attestation nonce"	minimum(1)
+//	@Produce		json
+//	@Success		200	{object}	responses.BlobstreamAttestation
+//	@Success		204
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/blobstream/attestations/{nonce} [get]
+func (handler *BlobstreamHandler) Attestation(c echo.Context) error {
+	req, err := bindAndValidate[attestationByNonceRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	attestation, err := handler.attestations.ByNonce(c.Request().Context(), req.Nonce)
+	if err != nil {
+		return handleError(c, err, handler.attestations)
+	}
+	return c.JSON(http.StatusOK, responses.NewBlobstreamAttestation(attestation))
+}
+
+type dataCommitmentByHeightRequest struct {
+	Height types.Level `param:"height" validate:"required,min=1"`
+}
+
+// DataCommitment godoc
+//
+//	@Summary		Get data commitment covering the block
+//	@Description	Get blobstream data commitment which range contains the block
+//	@Tags			blobstream
+//	@ID				get-blobstream-data-commitment
+//	@Param			height	path	integer	true	"Block height"	minimum(1)
+//	@Produce		json
+//	@Success		200	{object}	responses.BlobstreamAttestation
+//	@Success		204
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/blobstream/data_commitment/{height} [get]
+func (handler *BlobstreamHandler) DataCommitment(c echo.Context) error {
+	req, err := bindAndValidate[dataCommitmentByHeightRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	attestation, err := handler.attestations.ByDataHeight(c.Request().Context(), req.Height)
+	if err != nil {
+		return handleError(c, err, handler.attestations)
+	}
+	return c.JSON(http.StatusOK, responses.NewBlobstreamAttestation(attestation))
+}
+
+type listEvmAddressesRequest struct {
+	Limit       int    `query:"limit"        validate:"omitempty,min=1,max=100"`
+	Offset      int    `query:"offset"       validate:"omitempty,min=0"`
+	Sort        string `query:"sort"         validate:"omitempty,oneof=asc desc"`
+	ValidatorId uint64 `query:"validator_id" validate:"omitempty,min=1"`
+}
+
+func (req *listEvmAddressesRequest) SetDefault() {
+	if req.Limit == 0 {
+		req.Limit = 10
+	}
+	if req.Sort == "" {
+		req.Sort = desc
+	}
+}
+
+// EvmAddresses godoc
+//
+//	@Summary		List EVM addresses registered by validators
+//	@Description	List EVM addresses registered by validators to sign blobstream attestations
+//	@Tags			blobstream
+//	@ID				list-blobstream-evm-addresses
+//	@Param			limit			query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset			query	integer	false	"Offset"						mininum(1)
+//	@Param			sort			query	string	false	"Sort order"					Enums(asc, desc)
+//	@Param			validator_id	query	integer	false	"Internal validator identity"	minimum(1)
+//	@Produce		json
+//	@Success		200	{array}		responses.BlobstreamEvmAddress
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/blobstream/evm_addresses [get]
+func (handler *BlobstreamHandler) EvmAddresses(c echo.Context) error {
+	req, err := bindAndValidate[listEvmAddressesRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	fltrs := storage.BlobstreamEvmAddressFilters{
+		Limit:  req.Limit,
+		Offset: req.Offset,
+		Sort:   pgSort(req.Sort),
+	}
+	if req.ValidatorId > 0 {
+		fltrs.ValidatorId = &req.ValidatorId
+	}
+
+	addresses, err := handler.evmAddresses.ListWithFilters(c.Request().Context(), fltrs)
+	if err != nil {
+		return handleError(c, err, handler.evmAddresses)
+	}
+
+	response := make([]responses.BlobstreamEvmAddress, len(addresses))
+	for i := range addresses {
+		response[i] = responses.NewBlobstreamEvmAddress(addresses[i])
+	}
+	return returnArray(c, response)
+}
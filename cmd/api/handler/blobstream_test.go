@@ -0,0 +1,230 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	testsuite "github.com/celenium-io/celestia-indexer/internal/test_suite"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	testDataCommitment = storage.BlobstreamAttestation{
+		Id:         1,
+		Height:     800,
+		Time:       testTime,
+		Nonce:      3,
+		Type:       types.AttestationTypeDataCommitment,
+		BeginBlock: 401,
+		EndBlock:   801,
+	}
+	testEvmAddress = storage.BlobstreamEvmAddress{
+		Id:          1,
+		Height:      100,
+		Time:        testTime,
+		ValidatorId: 1,
+		TxId:        1,
+		EvmAddress:  "0xfDC46fBDd8AF50d9Bf7536Bf44ce8560E423352c",
+		Validator:   &testValidator,
+		Tx: &storage.Tx{
+			Hash: testTxHashBytes,
+		},
+	}
+)
+
+// BlobstreamTestSuite -
+type BlobstreamTestSuite struct {
+	suite.Suite
+	attestations *mock.MockIBlobstreamAttestation
+	evmAddresses *mock.MockIBlobstreamEvmAddress
+	echo         *echo.Echo
+	handler      *BlobstreamHandler
+	ctrl         *gomock.Controller
+}
+
+// SetupSuite -
+func (s *BlobstreamTestSuite) SetupSuite() {
+	s.echo = echo.New()
+	s.echo.Validator = NewCelestiaApiValidator()
+	s.ctrl = gomock.NewController(s.T())
+	s.attestations = mock.NewMockIBlobstreamAttestation(s.ctrl)
+	s.evmAddresses = mock.NewMockIBlobstreamEvmAddress(s.ctrl)
+	s.handler = NewBlobstreamHandler(s.attestations, s.evmAddresses)
+}
+
+// TearDownSuite -
+func (s *BlobstreamTestSuite) TearDownSuite() {
+	s.ctrl.Finish()
+	s.Require().NoError(s.echo.Shutdown(context.Background()))
+}
+
+func TestSuiteBlobstream_Run(t *testing.T) {
+	suite.Run(t, new(BlobstreamTestSuite))
+}
+
+func (s *BlobstreamTestSuite) TestAttestations() {
+	q := make(url.Values)
+	q.Set("limit", "10")
+	q.Set("type", "data_commitment")
+	q.Set("from", "1692892095")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/blobstream/attestations")
+
+	s.attestations.EXPECT().
+		ListWithFilters(gomock.Any(), storage.BlobstreamAttestationFilters{
+			Limit:    10,
+			Sort:     sdk.SortOrderDesc,
+			Type:     []types.AttestationType{types.AttestationTypeDataCommitment},
+			TimeFrom: time.Unix(1692892095, 0).UTC(),
+		}).
+		Return([]storage.BlobstreamAttestation{testDataCommitment}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Attestations(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var attestations []responses.BlobstreamAttestation
+	err := json.NewDecoder(rec.Body).Decode(&attestations)
+	s.Require().NoError(err)
+	s.Require().Len(attestations, 1)
+
+	a := attestations[0]
+	s.Require().EqualValues(1, a.Id)
+	s.Require().EqualValues(3, a.Nonce)
+	s.Require().Equal("data_commitment", a.Type)
+	s.Require().EqualValues(401, a.BeginBlock)
+	s.Require().EqualValues(801, a.EndBlock)
+}
+
+func (s *BlobstreamTestSuite) TestAttestationsInvalidType() {
+	q := make(url.Values)
+	q.Set("type", "unknown")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/blobstream/attestations")
+
+	s.Require().NoError(s.handler.Attestations(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *BlobstreamTestSuite) TestAttestation() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/blobstream/attestations/:nonce")
+	c.SetParamNames("nonce")
+	c.SetParamValues("3")
+
+	s.attestations.EXPECT().
+		ByNonce(gomock.Any(), uint64(3)).
+		Return(testDataCommitment, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Attestation(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var attestation responses.BlobstreamAttestation
+	err := json.NewDecoder(rec.Body).Decode(&attestation)
+	s.Require().NoError(err)
+	s.Require().EqualValues(3, attestation.Nonce)
+}
+
+func (s *BlobstreamTestSuite) TestDataCommitment() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/blobstream/data_commitment/:height")
+	c.SetParamNames("height")
+	c.SetParamValues("500")
+
+	s.attestations.EXPECT().
+		ByDataHeight(gomock.Any(), pkgTypes.Level(500)).
+		Return(testDataCommitment, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.DataCommitment(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var attestation responses.BlobstreamAttestation
+	err := json.NewDecoder(rec.Body).Decode(&attestation)
+	s.Require().NoError(err)
+	s.Require().EqualValues(401, attestation.BeginBlock)
+	s.Require().EqualValues(801, attestation.EndBlock)
+}
+
+func (s *BlobstreamTestSuite) TestDataCommitmentNoRows() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/blobstream/data_commitment/:height")
+	c.SetParamNames("height")
+	c.SetParamValues("10000")
+
+	s.attestations.EXPECT().
+		ByDataHeight(gomock.Any(), pkgTypes.Level(10000)).
+		Return(storage.BlobstreamAttestation{}, sql.ErrNoRows).
+		Times(1)
+
+	s.attestations.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true).
+		Times(1)
+
+	s.Require().NoError(s.handler.DataCommitment(c))
+	s.Require().Equal(http.StatusNoContent, rec.Code)
+}
+
+func (s *BlobstreamTestSuite) TestEvmAddresses() {
+	q := make(url.Values)
+	q.Set("validator_id", "1")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/blobstream/evm_addresses")
+
+	s.evmAddresses.EXPECT().
+		ListWithFilters(gomock.Any(), storage.BlobstreamEvmAddressFilters{
+			Limit:       10,
+			Sort:        sdk.SortOrderDesc,
+			ValidatorId: testsuite.Ptr[uint64](1),
+		}).
+		Return([]storage.BlobstreamEvmAddress{testEvmAddress}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.EvmAddresses(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var addresses []responses.BlobstreamEvmAddress
+	err := json.NewDecoder(rec.Body).Decode(&addresses)
+	s.Require().NoError(err)
+	s.Require().Len(addresses, 1)
+
+	a := addresses[0]
+	s.Require().Equal("0xfDC46fBDd8AF50d9Bf7536Bf44ce8560E423352c", a.EvmAddress)
+	s.Require().NotNil(a.Validator)
+	s.Require().Equal(testValidator.Moniker, a.Validator.Moniker)
+	s.Require().NotEmpty(a.TxHash)
+}
@@ -4,10 +4,12 @@
 package handler
 
 import (
+	"encoding/base64"
 	"net/http"
 	"time"
 
 	"github.com/celenium-io/celestia-indexer/pkg/node"
+	"github.com/celenium-io/celestia-indexer/pkg/proof"
 	"github.com/celenium-io/celestia-indexer/pkg/types"
 	"github.com/celestiaorg/celestia-app/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/pkg/da"
@@ -17,11 +19,13 @@ import (
 	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
 	"github.com/celenium-io/celestia-indexer/internal/storage"
 	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
 )
 
 type BlockHandler struct {
 	block       storage.IBlock
 	blockStats  storage.IBlockStats
+	blockDah    storage.IBlockDah
 	events      storage.IEvent
 	namespace   storage.INamespace
 	blobLogs    storage.IBlobLog
@@ -34,6 +38,7 @@ type BlockHandler struct {
 func NewBlockHandler(
 	block storage.IBlock,
 	blockStats storage.IBlockStats,
+	blockDah storage.IBlockDah,
 	events storage.IEvent,
 	namespace storage.INamespace,
 	message storage.IMessage,
@@ -45,6 +50,7 @@ func NewBlockHandler(
 	return &BlockHandler{
 		block:       block,
 		blockStats:  blockStats,
+		blockDah:    blockDah,
 		events:      events,
 		namespace:   namespace,
 		blobLogs:    blobLogs,
@@ -241,6 +247,32 @@ func (handler *BlockHandler) GetStats(c echo.Context) error {
 	return c.JSON(http.StatusOK, responses.NewBlockStats(stats))
 }
 
+// GetDah godoc
+//
+//	@Summary		Get data availability header of the block
+//	@Description	Get row and column roots of the extended data square computed by indexer and result of its verification against data hash of the block
+//	@Tags			block
+//	@ID				get-block-dah
+//	@Param			height	path	integer	true	"Block height"	minimum(1)
+//	@Produce		json
+//	@Success		200	{object}	responses.BlockDah
+//	@Success		204
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/block/{height}/dah [get]
+func (handler *BlockHandler) GetDah(c echo.Context) error {
+	req, err := bindAndValidate[getBlockByHeightRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	dah, err := handler.blockDah.ByHeight(c.Request().Context(), req.Height)
+	if err != nil {
+		return handleError(c, err, handler.block)
+	}
+	return c.JSON(http.StatusOK, responses.NewBlockDah(dah))
+}
+
 // Count godoc
 //
 //	@Summary		Get count of blocks in network
@@ -458,10 +490,67 @@ func (handler *BlockHandler) BlockODS(c echo.Context) error {
 		return internalServerError(c, err)
 	}
 
-	ods, err := responses.NewODS(eds)
+	blobs, err := handler.blobLogs.SharesByHeight(c.Request().Context(), req.Height)
+	if err != nil {
+		return handleError(c, err, handler.blobLogs)
+	}
+
+	ods, err := responses.NewODS(eds, blobs)
 	if err != nil {
 		return internalServerError(c, err)
 	}
 
 	return c.JSON(http.StatusOK, ods)
 }
+
+type getBlobProofRequest struct {
+	Namespace  string      `query:"namespace"  validate:"required,namespace"`
+	Height     types.Level `query:"height"     validate:"required,min=1"`
+	Commitment string      `query:"commitment" validate:"required,base64"`
+}
+
+// BlobProof godoc
+//
+//	@Summary		Get blob inclusion proof
+//	@Description	Returns share range of the blob, NMT proofs of blob shares to row roots and Merkle proofs of row roots to the data hash of the block
+//	@Tags			namespace
+//	@ID				get-blob-proof
+//	@Param			namespace	query	string	true	"Base64-encoded namespace id and version"
+//	@Param			height		query	integer	true	"Block heigth"	minimum(1)
+//	@Param			commitment	query	string	true	"Blob commitment"
+//	@Produce		json
+//	@Success		200	{object}	responses.BlobProof
+//	@Success		204
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/blob/proof [get]
+func (handler *BlockHandler) BlobProof(c echo.Context) error {
+	req, err := bindAndValidate[getBlobProofRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	namespace, err := base64.StdEncoding.DecodeString(req.Namespace)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	commitment, err := base64.StdEncoding.DecodeString(req.Commitment)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	block, err := handler.node.Block(c.Request().Context(), req.Height)
+	if err != nil {
+		return handleError(c, err, handler.block)
+	}
+
+	blobProof, err := proof.NewBlobProof(block.Block.Data.Txs.ToSliceOfBytes(), namespace, commitment)
+	if err != nil {
+		if errors.Is(err, proof.ErrBlobNotFound) {
+			return c.NoContent(http.StatusNoContent)
+		}
+		return internalServerError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, responses.NewBlobProof(blobProof, block.Block.DataHash))
+}
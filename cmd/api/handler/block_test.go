@@ -4,6 +4,7 @@
 package handler
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"encoding/base64"
@@ -19,6 +20,12 @@ import (
 	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
 	tmTypes "github.com/tendermint/tendermint/types"
 
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	sqBlob "github.com/celestiaorg/go-square/blob"
+	"github.com/celestiaorg/go-square/inclusion"
+	"github.com/celestiaorg/go-square/merkle"
+	sqNamespace "github.com/celestiaorg/go-square/namespace"
+
 	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
 	"github.com/celenium-io/celestia-indexer/internal/storage"
 	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
@@ -72,6 +79,7 @@ type BlockTestSuite struct {
 	suite.Suite
 	blocks     *mock.MockIBlock
 	blockStats *mock.MockIBlockStats
+	blockDah   *mock.MockIBlockDah
 	events     *mock.MockIEvent
 	message    *mock.MockIMessage
 	namespace  *mock.MockINamespace
@@ -90,13 +98,14 @@ func (s *BlockTestSuite) SetupSuite() {
 	s.ctrl = gomock.NewController(s.T())
 	s.blocks = mock.NewMockIBlock(s.ctrl)
 	s.blockStats = mock.NewMockIBlockStats(s.ctrl)
+	s.blockDah = mock.NewMockIBlockDah(s.ctrl)
 	s.events = mock.NewMockIEvent(s.ctrl)
 	s.namespace = mock.NewMockINamespace(s.ctrl)
 	s.blobLogs = mock.NewMockIBlobLog(s.ctrl)
 	s.message = mock.NewMockIMessage(s.ctrl)
 	s.state = mock.NewMockIState(s.ctrl)
 	s.node = nodeMock.NewMockApi(s.ctrl)
-	s.handler = NewBlockHandler(s.blocks, s.blockStats, s.events, s.namespace, s.message, s.blobLogs, s.state, s.node, testIndexerName)
+	s.handler = NewBlockHandler(s.blocks, s.blockStats, s.blockDah, s.events, s.namespace, s.message, s.blobLogs, s.state, s.node, testIndexerName)
 }
 
 // TearDownSuite -
@@ -370,6 +379,61 @@ func (s *BlockTestSuite) TestGetStats() {
 	s.Require().EqualValues(11043, stats.BlockTime)
 }
 
+func (s *BlockTestSuite) TestGetDah() {
+	req := httptest.NewRequest(http.MethodGet, "/?", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/block/:height/dah")
+	c.SetParamNames("height")
+	c.SetParamValues("100")
+
+	s.blockDah.EXPECT().
+		ByHeight(gomock.Any(), pkgTypes.Level(100)).
+		Return(storage.BlockDah{
+			Height:      100,
+			Time:        testTime,
+			Hash:        pkgTypes.Hex{0x01, 0x02},
+			DataHash:    pkgTypes.Hex{0x01, 0x02},
+			Valid:       true,
+			SquareSize:  1,
+			RowRoots:    []string{"AA", "BB"},
+			ColumnRoots: []string{"CC", "DD"},
+		}, nil)
+
+	s.Require().NoError(s.handler.GetDah(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var dah responses.BlockDah
+	err := json.NewDecoder(rec.Body).Decode(&dah)
+	s.Require().NoError(err)
+	s.Require().EqualValues(100, dah.Height)
+	s.Require().True(dah.Valid)
+	s.Require().EqualValues(1, dah.SquareSize)
+	s.Require().Equal("0102", dah.Hash.String())
+	s.Require().Equal([]string{"AA", "BB"}, dah.RowRoots)
+	s.Require().Equal([]string{"CC", "DD"}, dah.ColumnRoots)
+}
+
+func (s *BlockTestSuite) TestGetDahNoContent() {
+	req := httptest.NewRequest(http.MethodGet, "/?", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/block/:height/dah")
+	c.SetParamNames("height")
+	c.SetParamValues("100")
+
+	s.blockDah.EXPECT().
+		ByHeight(gomock.Any(), pkgTypes.Level(100)).
+		Return(storage.BlockDah{}, sql.ErrNoRows)
+
+	s.blocks.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true)
+
+	s.Require().NoError(s.handler.GetDah(c))
+	s.Require().Equal(http.StatusNoContent, rec.Code)
+}
+
 func (s *BlockTestSuite) TestBlobs() {
 	req := httptest.NewRequest(http.MethodGet, "/?", nil)
 	rec := httptest.NewRecorder()
@@ -558,6 +622,21 @@ func (s *BlockTestSuite) TestBlockODS() {
 		}, nil).
 		Times(1)
 
+	s.blobLogs.EXPECT().
+		SharesByHeight(gomock.Any(), pkgTypes.Level(100)).
+		Return([]storage.BlobLog{
+			{
+				Commitment: "AWIQZCyOADalK59tl0nqHz5t//5yQSHuEUjpP97Zt0U=",
+				ShareStart: 3,
+				ShareCount: 2,
+				StartRow:   0,
+				StartCol:   3,
+				EndRow:     1,
+				EndCol:     0,
+			},
+		}, nil).
+		Times(1)
+
 	s.Require().NoError(s.handler.BlockODS(c))
 	s.Require().Equal(http.StatusOK, rec.Code)
 
@@ -567,6 +646,13 @@ func (s *BlockTestSuite) TestBlockODS() {
 
 	s.Require().EqualValues(4, ods.Width)
 	s.Require().Len(ods.Items, 4)
+
+	blob := ods.Items[2]
+	s.Require().Equal(responses.DefaultNamespace, blob.Type)
+	s.Require().Equal("AWIQZCyOADalK59tl0nqHz5t//5yQSHuEUjpP97Zt0U=", blob.Commitment)
+	s.Require().Equal([]uint{0, 3}, blob.From)
+	s.Require().Equal([]uint{1, 0}, blob.To)
+	s.Require().Empty(ods.Items[3].Commitment)
 }
 
 func (s *BlockTestSuite) TestEmptyBlockODS() {
@@ -592,3 +678,79 @@ func (s *BlockTestSuite) TestEmptyBlockODS() {
 	s.Require().EqualValues(1, ods.Width)
 	s.Require().Len(ods.Items, 1)
 }
+
+func (s *BlockTestSuite) testBlobProofBlock() (pkgTypes.ResultBlock, *sqBlob.Blob, []byte) {
+	ns := sqNamespace.MustNewV0(bytes.Repeat([]byte{1}, sqNamespace.NamespaceVersionZeroIDSize))
+	blob := sqBlob.New(ns, bytes.Repeat([]byte{1}, 1000), 0)
+	commitment, err := inclusion.CreateCommitment(blob, merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold(0))
+	s.Require().NoError(err)
+
+	blobTx, err := sqBlob.MarshalBlobTx([]byte("pfb"), blob)
+	s.Require().NoError(err)
+
+	return pkgTypes.ResultBlock{
+		Block: &pkgTypes.Block{
+			Header: pkgTypes.Header{
+				DataHash: bytes.Repeat([]byte{2}, 32),
+			},
+			Data: pkgTypes.Data{
+				Txs: tmTypes.Txs{blobTx},
+			},
+		},
+	}, blob, commitment
+}
+
+func (s *BlockTestSuite) TestBlobProof() {
+	block, blob, commitment := s.testBlobProofBlock()
+	ns := append([]byte{byte(blob.NamespaceVersion)}, blob.NamespaceId...)
+
+	q := make(url.Values)
+	q.Add("namespace", base64.StdEncoding.EncodeToString(ns))
+	q.Add("height", "100")
+	q.Add("commitment", base64.StdEncoding.EncodeToString(commitment))
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/blob/proof")
+
+	s.node.EXPECT().
+		Block(gomock.Any(), pkgTypes.Level(100)).
+		Return(block, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.BlobProof(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var response responses.BlobProof
+	err := json.NewDecoder(rec.Body).Decode(&response)
+	s.Require().NoError(err)
+
+	s.Require().EqualValues(1, response.ShareStart)
+	s.Require().Greater(response.ShareEnd, response.ShareStart)
+	s.Require().EqualValues(block.Block.DataHash, response.DataHash)
+	s.Require().Len(response.Proof.Data, response.ShareEnd-response.ShareStart)
+}
+
+func (s *BlockTestSuite) TestBlobProofNotFound() {
+	block, blob, _ := s.testBlobProofBlock()
+	ns := append([]byte{byte(blob.NamespaceVersion)}, blob.NamespaceId...)
+
+	q := make(url.Values)
+	q.Add("namespace", base64.StdEncoding.EncodeToString(ns))
+	q.Add("height", "100")
+	q.Add("commitment", base64.StdEncoding.EncodeToString([]byte("unknown")))
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/blob/proof")
+
+	s.node.EXPECT().
+		Block(gomock.Any(), pkgTypes.Level(100)).
+		Return(block, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.BlobProof(c))
+	s.Require().Equal(http.StatusNoContent, rec.Code, rec.Body.String())
+}
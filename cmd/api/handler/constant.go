@@ -8,40 +8,60 @@ import (
 
 	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
 	"github.com/celenium-io/celestia-indexer/internal/storage"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
 	"github.com/labstack/echo/v4"
 )
 
 type ConstantHandler struct {
-	constants     storage.IConstant
-	denomMetadata storage.IDenomMetadata
-	address       storage.IAddress
+	constants       storage.IConstant
+	constantHistory storage.IConstantHistory
+	denomMetadata   storage.IDenomMetadata
+	address         storage.IAddress
 }
 
 func NewConstantHandler(
 	constants storage.IConstant,
+	constantHistory storage.IConstantHistory,
 	denomMetadata storage.IDenomMetadata,
 	address storage.IAddress,
 ) *ConstantHandler {
 	return &ConstantHandler{
-		constants:     constants,
-		denomMetadata: denomMetadata,
-		address:       address,
+		constants:       constants,
+		constantHistory: constantHistory,
+		denomMetadata:   denomMetadata,
+		address:         address,
 	}
 }
 
+type getConstantsRequest struct {
+	Height uint64 `query:"height" validate:"omitempty,min=1"`
+}
+
 // Get godoc
 //
 //	@Summary		Get network constants
-//	@Description	Get network constants
+//	@Description	Get network constants. If height is passed constant values which were effective at the height are returned.
 //	@Tags			general
 //	@ID				get-constants
+//	@Param			height	query	integer	false	"Block height"	minimum(1)
 //	@Produce		json
 //	@Success		200	{object}	responses.Constants
 //	@Success		204
+//	@Failure		400	{object}	Error
 //	@Failure		500	{object}	Error
 //	@Router			/constants [get]
 func (handler *ConstantHandler) Get(c echo.Context) error {
-	consts, err := handler.constants.All(c.Request().Context())
+	req, err := bindAndValidate[getConstantsRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	var consts []storage.Constant
+	if req.Height > 0 {
+		consts, err = handler.constantHistory.ByHeight(c.Request().Context(), pkgTypes.Level(req.Height))
+	} else {
+		consts, err = handler.constants.All(c.Request().Context())
+	}
 	if err != nil {
 		return handleError(c, err, handler.address)
 	}
@@ -8,10 +8,14 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
 	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
@@ -20,12 +24,13 @@ import (
 // ConstantTestSuite -
 type ConstantTestSuite struct {
 	suite.Suite
-	constants     *mock.MockIConstant
-	denomMetadata *mock.MockIDenomMetadata
-	address       *mock.MockIAddress
-	echo          *echo.Echo
-	handler       *ConstantHandler
-	ctrl          *gomock.Controller
+	constants       *mock.MockIConstant
+	constantHistory *mock.MockIConstantHistory
+	denomMetadata   *mock.MockIDenomMetadata
+	address         *mock.MockIAddress
+	echo            *echo.Echo
+	handler         *ConstantHandler
+	ctrl            *gomock.Controller
 }
 
 // SetupSuite -
@@ -35,7 +40,9 @@ func (s *ConstantTestSuite) SetupSuite() {
 	s.ctrl = gomock.NewController(s.T())
 	s.constants = mock.NewMockIConstant(s.ctrl)
 	s.denomMetadata = mock.NewMockIDenomMetadata(s.ctrl)
+	s.constantHistory = mock.NewMockIConstantHistory(s.ctrl)
 	s.address = mock.NewMockIAddress(s.ctrl)
+	s.handler = NewConstantHandler(s.constants, s.constantHistory, s.denomMetadata, s.address)
 }
 
 // TearDownSuite -
@@ -48,6 +55,85 @@ func TestSuiteConstant_Run(t *testing.T) {
 	suite.Run(t, new(ConstantTestSuite))
 }
 
+func (s *ConstantTestSuite) TestGet() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/constants")
+
+	s.constants.EXPECT().
+		All(gomock.Any()).
+		Return([]storage.Constant{
+			{
+				Module: types.ModuleNameSlashing,
+				Name:   "slash_fraction_downtime",
+				Value:  "0.01",
+			},
+		}, nil).
+		Times(1)
+
+	s.denomMetadata.EXPECT().
+		All(gomock.Any()).
+		Return([]storage.DenomMetadata{}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Get(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var constants responses.Constants
+	err := json.NewDecoder(rec.Body).Decode(&constants)
+	s.Require().NoError(err)
+	s.Require().Contains(constants.Module, "slashing")
+	s.Require().Equal("0.01", constants.Module["slashing"]["slash_fraction_downtime"])
+}
+
+func (s *ConstantTestSuite) TestGetByHeight() {
+	q := make(url.Values)
+	q.Set("height", "100")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/constants")
+
+	s.constantHistory.EXPECT().
+		ByHeight(gomock.Any(), pkgTypes.Level(100)).
+		Return([]storage.Constant{
+			{
+				Module: types.ModuleNameSlashing,
+				Name:   "slash_fraction_downtime",
+				Value:  "0.0001",
+			},
+		}, nil).
+		Times(1)
+
+	s.denomMetadata.EXPECT().
+		All(gomock.Any()).
+		Return([]storage.DenomMetadata{}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Get(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var constants responses.Constants
+	err := json.NewDecoder(rec.Body).Decode(&constants)
+	s.Require().NoError(err)
+	s.Require().Equal("0.0001", constants.Module["slashing"]["slash_fraction_downtime"])
+}
+
+func (s *ConstantTestSuite) TestGetInvalidHeight() {
+	q := make(url.Values)
+	q.Set("height", "-1")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/constants")
+
+	s.Require().NoError(s.handler.Get(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
 func (s *ConstantTestSuite) TestEnums() {
 	req := httptest.NewRequest(http.MethodGet, "/", nil)
 	rec := httptest.NewRecorder()
@@ -60,7 +146,13 @@ func (s *ConstantTestSuite) TestEnums() {
 	var enums responses.Enums
 	err := json.NewDecoder(rec.Body).Decode(&enums)
 	s.Require().NoError(err)
-	s.Require().Len(enums.EventType, 55)
+	s.Require().Len(enums.EventType, 67)
 	s.Require().Len(enums.MessageType, 74)
 	s.Require().Len(enums.Status, 2)
+	s.Require().Len(enums.ProposalStatus, 6)
+	s.Require().Len(enums.ProposalType, 7)
+	s.Require().Len(enums.VoteOption, 4)
+	s.Require().Len(enums.IbcTransferStatus, 4)
+	s.Require().Len(enums.IbcChannelStatus, 2)
+	s.Require().Len(enums.AttestationType, 2)
 }
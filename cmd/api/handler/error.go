@@ -15,6 +15,7 @@ import (
 var (
 	errInvalidHashLength = errors.New("invalid hash: should be 32 bytes length")
 	errInvalidAddress    = errors.New("invalid address")
+	errInvalidNamespace  = errors.New("invalid namespace: should be 29 bytes length")
 	errCancelRequest     = "pq: canceling statement due to user request"
 )
 
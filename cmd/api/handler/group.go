@@ -0,0 +1,393 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"net/http"
+
+	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/labstack/echo/v4"
+)
+
+type GroupHandler struct {
+	groups    storage.IGroup
+	members   storage.IGroupMember
+	policies  storage.IGroupPolicy
+	proposals storage.IGroupProposal
+	votes     storage.IGroupVote
+	address   storage.IAddress
+}
+
+func NewGroupHandler(
+	groups storage.IGroup,
+	members storage.IGroupMember,
+	policies storage.IGroupPolicy,
+	proposals storage.IGroupProposal,
+	votes storage.IGroupVote,
+	address storage.IAddress,
+) *GroupHandler {
+	return &GroupHandler{
+		groups:    groups,
+		members:   members,
+		policies:  policies,
+		proposals: proposals,
+		votes:     votes,
+		address:   address,
+	}
+}
+
+type listGroupsRequest struct {
+	Limit  int    `query:"limit"  validate:"omitempty,min=1,max=100"`
+	Offset int    `query:"offset" validate:"omitempty,min=0"`
+	Sort   string `query:"sort"   validate:"omitempty,oneof=asc desc"`
+	Admin  string `query:"admin"  validate:"omitempty,address"`
+}
+
+func (req *listGroupsRequest) SetDefault() {
+	if req.Limit == 0 {
+		req.Limit = 10
+	}
+	if req.Sort == "" {
+		req.Sort = desc
+	}
+}
+
+// List godoc
+//
+//	@Summary		List groups
+//	@Description	List groups of group module
+//	@Tags			group
+//	@ID				list-group
+//	@Param			limit	query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset	query	integer	false	"Offset"						mininum(1)
+//	@Param			sort	query	string	false	"Sort order"					Enums(asc, desc)
+//	@Param			admin	query	string	false	"Admin celestia address"		minlength(47)	maxlength(47)
+//	@Produce		json
+//	@Success		200	{array}		responses.Group
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/group [get]
+func (handler *GroupHandler) List(c echo.Context) error {
+	req, err := bindAndValidate[listGroupsRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	fltrs := storage.GroupFilters{
+		Limit:  req.Limit,
+		Offset: req.Offset,
+		Sort:   pgSort(req.Sort),
+	}
+	if req.Admin != "" {
+		_, hash, err := types.Address(req.Admin).Decode()
+		if err != nil {
+			return badRequestError(c, err)
+		}
+		addressId, err := handler.address.IdByHash(c.Request().Context(), hash)
+		if err != nil {
+			return handleError(c, err, handler.address)
+		}
+		fltrs.AdminId = addressId
+	}
+
+	groups, err := handler.groups.ListWithFilters(c.Request().Context(), fltrs)
+	if err != nil {
+		return handleError(c, err, handler.groups)
+	}
+
+	response := make([]responses.Group, len(groups))
+	for i := range groups {
+		response[i] = responses.NewGroup(groups[i])
+	}
+	return returnArray(c, response)
+}
+
+type getGroupRequest struct {
+	Id uint64 `param:"id" validate:"required,min=1"`
+}
+
+// Get godoc
+//
+//	@Summary		Get group info
+//	@Description	Get group info
+//	@Tags			group
+//	@ID				get-group
+//	@Param			id	path	integer	true	"Group id"	minimum(1)
+//	@Produce		json
+//	@Success		200	{object}	responses.Group
+//	@Success		204
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/group/{id} [get]
+func (handler *GroupHandler) Get(c echo.Context) error {
+	req, err := bindAndValidate[getGroupRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	group, err := handler.groups.ById(c.Request().Context(), req.Id)
+	if err != nil {
+		return handleError(c, err, handler.groups)
+	}
+
+	return c.JSON(http.StatusOK, responses.NewGroup(group))
+}
+
+type groupListRequest struct {
+	Id     uint64 `param:"id"     validate:"required,min=1"`
+	Limit  int    `query:"limit"  validate:"omitempty,min=1,max=100"`
+	Offset int    `query:"offset" validate:"omitempty,min=0"`
+}
+
+func (req *groupListRequest) SetDefault() {
+	if req.Limit == 0 {
+		req.Limit = 10
+	}
+}
+
+// Members godoc
+//
+//	@Summary		Get group members
+//	@Description	Get current members of group with their weights
+//	@Tags			group
+//	@ID				get-group-members
+//	@Param			id		path	integer	true	"Group id"						minimum(1)
+//	@Param			limit	query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset	query	integer	false	"Offset"						mininum(1)
+//	@Produce		json
+//	@Success		200	{array}		responses.GroupMember
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/group/{id}/members [get]
+func (handler *GroupHandler) Members(c echo.Context) error {
+	req, err := bindAndValidate[groupListRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	members, err := handler.members.ByGroupId(c.Request().Context(), req.Id, req.Limit, req.Offset)
+	if err != nil {
+		return handleError(c, err, handler.members)
+	}
+
+	response := make([]responses.GroupMember, len(members))
+	for i := range members {
+		response[i] = responses.NewGroupMember(members[i])
+	}
+	return returnArray(c, response)
+}
+
+// Policies godoc
+//
+//	@Summary		Get group policies
+//	@Description	Get group policies with decision policies
+//	@Tags			group
+//	@ID				get-group-policies
+//	@Param			id		path	integer	true	"Group id"						minimum(1)
+//	@Param			limit	query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset	query	integer	false	"Offset"						mininum(1)
+//	@Produce		json
+//	@Success		200	{array}		responses.GroupPolicy
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/group/{id}/policies [get]
+func (handler *GroupHandler) Policies(c echo.Context) error {
+	req, err := bindAndValidate[groupListRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	policies, err := handler.policies.ByGroupId(c.Request().Context(), req.Id, req.Limit, req.Offset)
+	if err != nil {
+		return handleError(c, err, handler.policies)
+	}
+
+	response := make([]responses.GroupPolicy, len(policies))
+	for i := range policies {
+		response[i] = responses.NewGroupPolicy(policies[i])
+	}
+	return returnArray(c, response)
+}
+
+type getGroupPolicyRequest struct {
+	Address string `param:"address" validate:"required,address"`
+}
+
+// Policy godoc
+//
+//	@Summary		Get group policy info
+//	@Description	Get group policy info by its address
+//	@Tags			group
+//	@ID				get-group-policy
+//	@Param			address	path	string	true	"Group policy celestia address"	minlength(47)	maxlength(47)
+//	@Produce		json
+//	@Success		200	{object}	responses.GroupPolicy
+//	@Success		204
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/group/policy/{address} [get]
+func (handler *GroupHandler) Policy(c echo.Context) error {
+	req, err := bindAndValidate[getGroupPolicyRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	_, hash, err := types.Address(req.Address).Decode()
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	addressId, err := handler.address.IdByHash(c.Request().Context(), hash)
+	if err != nil {
+		return handleError(c, err, handler.address)
+	}
+
+	policy, err := handler.policies.ByAddressId(c.Request().Context(), addressId)
+	if err != nil {
+		return handleError(c, err, handler.policies)
+	}
+
+	return c.JSON(http.StatusOK, responses.NewGroupPolicy(policy))
+}
+
+type listGroupProposalsRequest struct {
+	Limit   int         `query:"limit"    validate:"omitempty,min=1,max=100"`
+	Offset  int         `query:"offset"   validate:"omitempty,min=0"`
+	Sort    string      `query:"sort"     validate:"omitempty,oneof=asc desc"`
+	GroupId uint64      `query:"group_id" validate:"omitempty,min=1"`
+	Policy  string      `query:"policy"   validate:"omitempty,address"`
+	Status  StringArray `query:"status"   validate:"omitempty,dive,group_proposal_status"`
+}
+
+func (req *listGroupProposalsRequest) SetDefault() {
+	if req.Limit == 0 {
+		req.Limit = 10
+	}
+	if req.Sort == "" {
+		req.Sort = desc
+	}
+}
+
+// Proposals godoc
+//
+//	@Summary		List group proposals
+//	@Description	List proposals of group policies
+//	@Tags			group
+//	@ID				list-group-proposal
+//	@Param			limit		query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset		query	integer	false	"Offset"						mininum(1)
+//	@Param			sort		query	string	false	"Sort order"					Enums(asc, desc)
+//	@Param			group_id	query	integer	false	"Group id"						minimum(1)
+//	@Param			policy		query	string	false	"Group policy celestia address"	minlength(47)	maxlength(47)
+//	@Param			status		query	string	false	"Comma-separated group proposal status list"
+//	@Produce		json
+//	@Success		200	{array}		responses.GroupProposal
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/group/proposal [get]
+func (handler *GroupHandler) Proposals(c echo.Context) error {
+	req, err := bindAndValidate[listGroupProposalsRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	fltrs := storage.GroupProposalFilters{
+		Limit:   req.Limit,
+		Offset:  req.Offset,
+		Sort:    pgSort(req.Sort),
+		GroupId: req.GroupId,
+		Status:  make([]storageTypes.GroupProposalStatus, len(req.Status)),
+	}
+	for i := range req.Status {
+		fltrs.Status[i] = storageTypes.GroupProposalStatus(req.Status[i])
+	}
+	if req.Policy != "" {
+		_, hash, err := types.Address(req.Policy).Decode()
+		if err != nil {
+			return badRequestError(c, err)
+		}
+		addressId, err := handler.address.IdByHash(c.Request().Context(), hash)
+		if err != nil {
+			return handleError(c, err, handler.address)
+		}
+		fltrs.PolicyAddressId = addressId
+	}
+
+	proposals, err := handler.proposals.ListWithFilters(c.Request().Context(), fltrs)
+	if err != nil {
+		return handleError(c, err, handler.proposals)
+	}
+
+	response := make([]responses.GroupProposal, len(proposals))
+	for i := range proposals {
+		response[i] = responses.NewGroupProposal(proposals[i])
+	}
+	return returnArray(c, response)
+}
+
+// Proposal godoc
+//
+//	@Summary		Get group proposal info
+//	@Description	Get group proposal info
+//	@Tags			group
+//	@ID				get-group-proposal
+//	@Param			id	path	integer	true	"Group proposal id"	minimum(1)
+//	@Produce		json
+//	@Success		200	{object}	responses.GroupProposal
+//	@Success		204
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/group/proposal/{id} [get]
+func (handler *GroupHandler) Proposal(c echo.Context) error {
+	req, err := bindAndValidate[getGroupRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	proposal, err := handler.proposals.ById(c.Request().Context(), req.Id)
+	if err != nil {
+		return handleError(c, err, handler.proposals)
+	}
+
+	return c.JSON(http.StatusOK, responses.NewGroupProposal(proposal))
+}
+
+// ProposalVotes godoc
+//
+//	@Summary		Get group proposal's votes
+//	@Description	Get votes of group members for group proposal
+//	@Tags			group
+//	@ID				get-group-proposal-votes
+//	@Param			id		path	integer	true	"Group proposal id"				minimum(1)
+//	@Param			limit	query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset	query	integer	false	"Offset"						mininum(1)
+//	@Produce		json
+//	@Success		200	{array}		responses.GroupVote
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/group/proposal/{id}/votes [get]
+func (handler *GroupHandler) ProposalVotes(c echo.Context) error {
+	req, err := bindAndValidate[groupListRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	votes, err := handler.votes.ByProposalId(c.Request().Context(), req.Id, req.Limit, req.Offset)
+	if err != nil {
+		return handleError(c, err, handler.votes)
+	}
+
+	response := make([]responses.GroupVote, len(votes))
+	for i := range votes {
+		response[i] = responses.NewGroupVote(votes[i])
+	}
+	return returnArray(c, response)
+}
@@ -0,0 +1,327 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+var testGroup = storage.Group{
+	Id:           1,
+	Height:       100,
+	Time:         testTime,
+	AdminId:      1,
+	Metadata:     "group",
+	TotalWeight:  decimal.NewFromInt(3),
+	MembersCount: 2,
+	Admin: &storage.Address{
+		Address: testAddress,
+	},
+}
+
+var testGroupProposal = storage.GroupProposal{
+	Id:              1,
+	Height:          100,
+	Time:            testTime,
+	PolicyAddressId: 1,
+	Proposers:       []string{testAddress},
+	Status:          types.GroupProposalStatusAccepted,
+	ExecutorResult:  types.GroupExecutorResultSuccess,
+	VotesCount:      2,
+	Yes:             2,
+	Messages:        json.RawMessage(`[{"type":"/cosmos.bank.v1beta1.MsgSend"}]`),
+	PolicyAddress: &storage.Address{
+		Address: testAddress,
+	},
+}
+
+// GroupTestSuite -
+type GroupTestSuite struct {
+	suite.Suite
+	groups    *mock.MockIGroup
+	members   *mock.MockIGroupMember
+	policies  *mock.MockIGroupPolicy
+	proposals *mock.MockIGroupProposal
+	votes     *mock.MockIGroupVote
+	address   *mock.MockIAddress
+	echo      *echo.Echo
+	handler   *GroupHandler
+	ctrl      *gomock.Controller
+}
+
+// SetupSuite -
+func (s *GroupTestSuite) SetupSuite() {
+	s.echo = echo.New()
+	s.echo.Validator = NewCelestiaApiValidator()
+	s.ctrl = gomock.NewController(s.T())
+	s.groups = mock.NewMockIGroup(s.ctrl)
+	s.members = mock.NewMockIGroupMember(s.ctrl)
+	s.policies = mock.NewMockIGroupPolicy(s.ctrl)
+	s.proposals = mock.NewMockIGroupProposal(s.ctrl)
+	s.votes = mock.NewMockIGroupVote(s.ctrl)
+	s.address = mock.NewMockIAddress(s.ctrl)
+	s.handler = NewGroupHandler(s.groups, s.members, s.policies, s.proposals, s.votes, s.address)
+}
+
+// TearDownSuite -
+func (s *GroupTestSuite) TearDownSuite() {
+	s.ctrl.Finish()
+	s.Require().NoError(s.echo.Shutdown(context.Background()))
+}
+
+func TestSuiteGroup_Run(t *testing.T) {
+	suite.Run(t, new(GroupTestSuite))
+}
+
+func (s *GroupTestSuite) TestList() {
+	q := make(url.Values)
+	q.Set("limit", "10")
+	q.Set("offset", "0")
+	q.Set("sort", "asc")
+	q.Set("admin", testAddress)
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/group")
+
+	s.address.EXPECT().
+		IdByHash(gomock.Any(), testHashAddress).
+		Return(uint64(1), nil).
+		Times(1)
+
+	s.groups.EXPECT().
+		ListWithFilters(gomock.Any(), storage.GroupFilters{
+			Limit:   10,
+			Offset:  0,
+			Sort:    sdk.SortOrderAsc,
+			AdminId: 1,
+		}).
+		Return([]storage.Group{testGroup}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var groups []responses.Group
+	err := json.NewDecoder(rec.Body).Decode(&groups)
+	s.Require().NoError(err)
+	s.Require().Len(groups, 1)
+
+	g := groups[0]
+	s.Require().EqualValues(1, g.Id)
+	s.Require().EqualValues(100, g.Height)
+	s.Require().Equal(testAddress, g.Admin)
+	s.Require().Equal("group", g.Metadata)
+	s.Require().Equal("3", g.TotalWeight)
+	s.Require().EqualValues(2, g.MembersCount)
+}
+
+func (s *GroupTestSuite) TestGetNoRows() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/group/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("100")
+
+	s.groups.EXPECT().
+		ById(gomock.Any(), uint64(100)).
+		Return(storage.Group{}, sql.ErrNoRows).
+		Times(1)
+
+	s.groups.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true).
+		Times(1)
+
+	s.Require().NoError(s.handler.Get(c))
+	s.Require().Equal(http.StatusNoContent, rec.Code)
+}
+
+func (s *GroupTestSuite) TestMembers() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/group/:id/members")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	s.members.EXPECT().
+		ByGroupId(gomock.Any(), uint64(1), 10, 0).
+		Return([]storage.GroupMember{
+			{
+				Id:        1,
+				Height:    100,
+				Time:      testTime,
+				GroupId:   1,
+				AddressId: 1,
+				Weight:    decimal.NewFromInt(2),
+				Address: &storage.Address{
+					Address: testAddress,
+				},
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Members(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var members []responses.GroupMember
+	err := json.NewDecoder(rec.Body).Decode(&members)
+	s.Require().NoError(err)
+	s.Require().Len(members, 1)
+	s.Require().Equal(testAddress, members[0].Address)
+	s.Require().Equal("2", members[0].Weight)
+}
+
+func (s *GroupTestSuite) TestPolicy() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/group/policy/:address")
+	c.SetParamNames("address")
+	c.SetParamValues(testAddress)
+
+	s.address.EXPECT().
+		IdByHash(gomock.Any(), testHashAddress).
+		Return(uint64(1), nil).
+		Times(1)
+
+	s.policies.EXPECT().
+		ByAddressId(gomock.Any(), uint64(1)).
+		Return(storage.GroupPolicy{
+			AddressId:          1,
+			GroupId:            1,
+			Height:             100,
+			Time:               testTime,
+			DecisionPolicyType: types.DecisionPolicyTypeThreshold,
+			Threshold:          decimal.NewFromInt(2),
+			VotingPeriod:       86400,
+			Address: &storage.Address{
+				Address: testAddress,
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Policy(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var policy responses.GroupPolicy
+	err := json.NewDecoder(rec.Body).Decode(&policy)
+	s.Require().NoError(err)
+	s.Require().Equal(testAddress, policy.Address)
+	s.Require().EqualValues(1, policy.GroupId)
+	s.Require().Equal("threshold", policy.DecisionPolicyType)
+	s.Require().Equal("2", policy.Threshold)
+	s.Require().EqualValues(86400, policy.VotingPeriod)
+}
+
+func (s *GroupTestSuite) TestProposals() {
+	q := make(url.Values)
+	q.Set("group_id", "1")
+	q.Set("status", "accepted,submitted")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/group/proposal")
+
+	s.proposals.EXPECT().
+		ListWithFilters(gomock.Any(), storage.GroupProposalFilters{
+			Limit:   10,
+			Offset:  0,
+			Sort:    sdk.SortOrderDesc,
+			GroupId: 1,
+			Status:  []types.GroupProposalStatus{types.GroupProposalStatusAccepted, types.GroupProposalStatusSubmitted},
+		}).
+		Return([]storage.GroupProposal{testGroupProposal}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Proposals(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var proposals []responses.GroupProposal
+	err := json.NewDecoder(rec.Body).Decode(&proposals)
+	s.Require().NoError(err)
+	s.Require().Len(proposals, 1)
+
+	p := proposals[0]
+	s.Require().EqualValues(1, p.Id)
+	s.Require().Equal(testAddress, p.PolicyAddress)
+	s.Require().Equal([]string{testAddress}, p.Proposers)
+	s.Require().Equal("accepted", p.Status)
+	s.Require().Equal("success", p.ExecutorResult)
+	s.Require().EqualValues(2, p.Yes)
+	s.Require().NotEmpty(p.Messages)
+}
+
+func (s *GroupTestSuite) TestProposalsInvalidStatus() {
+	q := make(url.Values)
+	q.Set("status", "active")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/group/proposal")
+
+	s.Require().NoError(s.handler.Proposals(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *GroupTestSuite) TestProposalVotes() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/group/proposal/:id/votes")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	s.votes.EXPECT().
+		ByProposalId(gomock.Any(), uint64(1), 10, 0).
+		Return([]storage.GroupVote{
+			{
+				Id:         1,
+				Height:     100,
+				Time:       testTime,
+				ProposalId: 1,
+				VoterId:    1,
+				Option:     types.VoteOptionYes,
+				Voter: &storage.Address{
+					Address: testAddress,
+				},
+				Tx: &storage.Tx{
+					Hash: []byte{0x01, 0x02},
+				},
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.ProposalVotes(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var votes []responses.GroupVote
+	err := json.NewDecoder(rec.Body).Decode(&votes)
+	s.Require().NoError(err)
+	s.Require().Len(votes, 1)
+	s.Require().Equal("yes", votes[0].Option)
+	s.Require().Equal(testAddress, votes[0].Voter)
+	s.Require().Equal("0102", votes[0].TxHash)
+}
@@ -0,0 +1,434 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"net/http"
+
+	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/labstack/echo/v4"
+)
+
+type IbcHandler struct {
+	clients     storage.IIbcClient
+	connections storage.IIbcConnection
+	channels    storage.IIbcChannel
+	transfers   storage.IIbcTransfer
+	address     storage.IAddress
+}
+
+func NewIbcHandler(
+	clients storage.IIbcClient,
+	connections storage.IIbcConnection,
+	channels storage.IIbcChannel,
+	transfers storage.IIbcTransfer,
+	address storage.IAddress,
+) *IbcHandler {
+	return &IbcHandler{
+		clients:     clients,
+		connections: connections,
+		channels:    channels,
+		transfers:   transfers,
+		address:     address,
+	}
+}
+
+type ibcIdRequest struct {
+	Id string `param:"id" validate:"required"`
+}
+
+type listIbcClientsRequest struct {
+	Limit   int    `query:"limit"    validate:"omitempty,min=1,max=100"`
+	Offset  int    `query:"offset"   validate:"omitempty,min=0"`
+	Sort    string `query:"sort"     validate:"omitempty,oneof=asc desc"`
+	ChainId string `query:"chain_id" validate:"omitempty"`
+}
+
+func (req *listIbcClientsRequest) SetDefault() {
+	if req.Limit == 0 {
+		req.Limit = 10
+	}
+	if req.Sort == "" {
+		req.Sort = desc
+	}
+}
+
+// Clients godoc
+//
+//	@Summary		List IBC clients
+//	@Description	List IBC clients
+//	@Tags			ibc
+//	@ID				list-ibc-clients
+//	@Param			limit		query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset		query	integer	false	"Offset"						mininum(1)
+//	@Param			sort		query	string	false	"Sort order"					Enums(asc, desc)
+//	@Param			chain_id	query	string	false	"Counterparty chain identity"
+//	@Produce		json
+//	@Success		200	{array}		responses.IbcClient
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/ibc/client [get]
+func (handler *IbcHandler) Clients(c echo.Context) error {
+	req, err := bindAndValidate[listIbcClientsRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	clients, err := handler.clients.ListWithFilters(c.Request().Context(), storage.ListIbcClientsFilters{
+		Limit:   req.Limit,
+		Offset:  req.Offset,
+		Sort:    pgSort(req.Sort),
+		ChainId: req.ChainId,
+	})
+	if err != nil {
+		return handleError(c, err, handler.clients)
+	}
+
+	response := make([]responses.IbcClient, len(clients))
+	for i := range clients {
+		response[i] = responses.NewIbcClient(clients[i])
+	}
+	return returnArray(c, response)
+}
+
+// Client godoc
+//
+//	@Summary		Get IBC client info
+//	@Description	Get IBC client info
+//	@Tags			ibc
+//	@ID				get-ibc-client
+//	@Param			id	path	string	true	"Client identity"
+//	@Produce		json
+//	@Success		200	{object}	responses.IbcClient
+//	@Success		204
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/ibc/client/{id} [get]
+func (handler *IbcHandler) Client(c echo.Context) error {
+	req, err := bindAndValidate[ibcIdRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	client, err := handler.clients.ById(c.Request().Context(), req.Id)
+	if err != nil {
+		return handleError(c, err, handler.clients)
+	}
+
+	return c.JSON(http.StatusOK, responses.NewIbcClient(client))
+}
+
+type listIbcConnectionsRequest struct {
+	Limit    int    `query:"limit"     validate:"omitempty,min=1,max=100"`
+	Offset   int    `query:"offset"    validate:"omitempty,min=0"`
+	Sort     string `query:"sort"      validate:"omitempty,oneof=asc desc"`
+	ClientId string `query:"client_id" validate:"omitempty"`
+}
+
+func (req *listIbcConnectionsRequest) SetDefault() {
+	if req.Limit == 0 {
+		req.Limit = 10
+	}
+	if req.Sort == "" {
+		req.Sort = desc
+	}
+}
+
+// Connections godoc
+//
+//	@Summary		List IBC connections
+//	@Description	List IBC connections
+//	@Tags			ibc
+//	@ID				list-ibc-connections
+//	@Param			limit		query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset		query	integer	false	"Offset"						mininum(1)
+//	@Param			sort		query	string	false	"Sort order"					Enums(asc, desc)
+//	@Param			client_id	query	string	false	"Client identity"
+//	@Produce		json
+//	@Success		200	{array}		responses.IbcConnection
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/ibc/connection [get]
+func (handler *IbcHandler) Connections(c echo.Context) error {
+	req, err := bindAndValidate[listIbcConnectionsRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	conns, err := handler.connections.ListWithFilters(c.Request().Context(), storage.ListIbcConnectionsFilters{
+		Limit:    req.Limit,
+		Offset:   req.Offset,
+		Sort:     pgSort(req.Sort),
+		ClientId: req.ClientId,
+	})
+	if err != nil {
+		return handleError(c, err, handler.connections)
+	}
+
+	response := make([]responses.IbcConnection, len(conns))
+	for i := range conns {
+		response[i] = responses.NewIbcConnection(conns[i])
+	}
+	return returnArray(c, response)
+}
+
+// Connection godoc
+//
+//	@Summary		Get IBC connection info
+//	@Description	Get IBC connection info
+//	@Tags			ibc
+//	@ID				get-ibc-connection
+//	@Param			id	path	string	true	"Connection identity"
+//	@Produce		json
+//	@Success		200	{object}	responses.IbcConnection
+//	@Success		204
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/ibc/connection/{id} [get]
+func (handler *IbcHandler) Connection(c echo.Context) error {
+	req, err := bindAndValidate[ibcIdRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	conn, err := handler.connections.ById(c.Request().Context(), req.Id)
+	if err != nil {
+		return handleError(c, err, handler.connections)
+	}
+
+	return c.JSON(http.StatusOK, responses.NewIbcConnection(conn))
+}
+
+type listIbcChannelsRequest struct {
+	Limit        int    `query:"limit"         validate:"omitempty,min=1,max=100"`
+	Offset       int    `query:"offset"        validate:"omitempty,min=0"`
+	Sort         string `query:"sort"          validate:"omitempty,oneof=asc desc"`
+	ConnectionId string `query:"connection_id" validate:"omitempty"`
+	Status       string `query:"status"        validate:"omitempty,ibc_channel_status"`
+}
+
+func (req *listIbcChannelsRequest) SetDefault() {
+	if req.Limit == 0 {
+		req.Limit = 10
+	}
+	if req.Sort == "" {
+		req.Sort = desc
+	}
+}
+
+// Channels godoc
+//
+//	@Summary		List IBC channels
+//	@Description	List IBC channels
+//	@Tags			ibc
+//	@ID				list-ibc-channels
+//	@Param			limit			query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset			query	integer	false	"Offset"						mininum(1)
+//	@Param			sort			query	string	false	"Sort order"					Enums(asc, desc)
+//	@Param			connection_id	query	string	false	"Connection identity"
+//	@Param			status			query	string	false	"Channel status"				Enums(initialization, opened)
+//	@Produce		json
+//	@Success		200	{array}		responses.IbcChannel
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/ibc/channel [get]
+func (handler *IbcHandler) Channels(c echo.Context) error {
+	req, err := bindAndValidate[listIbcChannelsRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	channels, err := handler.channels.ListWithFilters(c.Request().Context(), storage.ListIbcChannelsFilters{
+		Limit:        req.Limit,
+		Offset:       req.Offset,
+		Sort:         pgSort(req.Sort),
+		ConnectionId: req.ConnectionId,
+		Status:       storageTypes.IbcChannelStatus(req.Status),
+	})
+	if err != nil {
+		return handleError(c, err, handler.channels)
+	}
+
+	response := make([]responses.IbcChannel, len(channels))
+	for i := range channels {
+		response[i] = responses.NewIbcChannel(channels[i])
+	}
+	return returnArray(c, response)
+}
+
+// Channel godoc
+//
+//	@Summary		Get IBC channel info
+//	@Description	Get IBC channel info
+//	@Tags			ibc
+//	@ID				get-ibc-channel
+//	@Param			id	path	string	true	"Channel identity"
+//	@Produce		json
+//	@Success		200	{object}	responses.IbcChannel
+//	@Success		204
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/ibc/channel/{id} [get]
+func (handler *IbcHandler) Channel(c echo.Context) error {
+	req, err := bindAndValidate[ibcIdRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	channel, err := handler.channels.ById(c.Request().Context(), req.Id)
+	if err != nil {
+		return handleError(c, err, handler.channels)
+	}
+
+	return c.JSON(http.StatusOK, responses.NewIbcChannel(channel))
+}
+
+type listIbcTransfersRequest struct {
+	Limit     int         `query:"limit"      validate:"omitempty,min=1,max=100"`
+	Offset    int         `query:"offset"     validate:"omitempty,min=0"`
+	Sort      string      `query:"sort"       validate:"omitempty,oneof=asc desc"`
+	ChannelId string      `query:"channel_id" validate:"omitempty"`
+	ChainId   string      `query:"chain_id"   validate:"omitempty"`
+	Address   string      `query:"address"    validate:"omitempty,address"`
+	Status    StringArray `query:"status"     validate:"omitempty,dive,ibc_transfer_status"`
+}
+
+func (req *listIbcTransfersRequest) SetDefault() {
+	if req.Limit == 0 {
+		req.Limit = 10
+	}
+	if req.Sort == "" {
+		req.Sort = desc
+	}
+}
+
+// Transfers godoc
+//
+//	@Summary		List IBC transfers
+//	@Description	List IBC fungible token transfers
+//	@Tags			ibc
+//	@ID				list-ibc-transfers
+//	@Param			limit		query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset		query	integer	false	"Offset"						mininum(1)
+//	@Param			sort		query	string	false	"Sort order"					Enums(asc, desc)
+//	@Param			channel_id	query	string	false	"Channel identity"
+//	@Param			chain_id	query	string	false	"Counterparty chain identity"
+//	@Param			address		query	string	false	"Sender or receiver celestia address"	minlength(47)	maxlength(47)
+//	@Param			status		query	string	false	"Comma-separated transfer status list"
+//	@Produce		json
+//	@Success		200	{array}		responses.IbcTransfer
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/ibc/transfer [get]
+func (handler *IbcHandler) Transfers(c echo.Context) error {
+	req, err := bindAndValidate[listIbcTransfersRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	fltrs := storage.ListIbcTransferFilters{
+		Limit:     req.Limit,
+		Offset:    req.Offset,
+		Sort:      pgSort(req.Sort),
+		ChannelId: req.ChannelId,
+		ChainId:   req.ChainId,
+		Status:    make([]storageTypes.IbcTransferStatus, len(req.Status)),
+	}
+	for i := range req.Status {
+		fltrs.Status[i] = storageTypes.IbcTransferStatus(req.Status[i])
+	}
+
+	if req.Address != "" {
+		_, hash, err := types.Address(req.Address).Decode()
+		if err != nil {
+			return badRequestError(c, err)
+		}
+		addressId, err := handler.address.IdByHash(c.Request().Context(), hash)
+		if err != nil {
+			return handleError(c, err, handler.address)
+		}
+		fltrs.AddressId = &addressId
+	}
+
+	transfers, err := handler.transfers.ListWithFilters(c.Request().Context(), fltrs)
+	if err != nil {
+		return handleError(c, err, handler.transfers)
+	}
+
+	response := make([]responses.IbcTransfer, len(transfers))
+	for i := range transfers {
+		response[i] = responses.NewIbcTransfer(transfers[i])
+	}
+	return returnArray(c, response)
+}
+
+type getIbcTransferRequest struct {
+	Id uint64 `param:"id" validate:"required,min=1"`
+}
+
+// Transfer godoc
+//
+//	@Summary		Get IBC transfer info
+//	@Description	Get IBC transfer info
+//	@Tags			ibc
+//	@ID				get-ibc-transfer
+//	@Param			id	path	integer	true	"Internal transfer identity"	minimum(1)
+//	@Produce		json
+//	@Success		200	{object}	responses.IbcTransfer
+//	@Success		204
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/ibc/transfer/{id} [get]
+func (handler *IbcHandler) Transfer(c echo.Context) error {
+	req, err := bindAndValidate[getIbcTransferRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	transfer, err := handler.transfers.ById(c.Request().Context(), req.Id)
+	if err != nil {
+		return handleError(c, err, handler.transfers)
+	}
+
+	return c.JSON(http.StatusOK, responses.NewIbcTransfer(transfer))
+}
+
+// Chains godoc
+//
+//	@Summary		Get IBC transfers statistics by chains
+//	@Description	Get count of IBC transfers and bridged utia amount grouped by counterparty chain
+//	@Tags			ibc
+//	@ID				get-ibc-chains
+//	@Param			limit	query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset	query	integer	false	"Offset"						mininum(1)
+//	@Produce		json
+//	@Success		200	{array}		responses.IbcChainStats
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/ibc/chains [get]
+func (handler *IbcHandler) Chains(c echo.Context) error {
+	req, err := bindAndValidate[limitOffsetPagination](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	stats, err := handler.transfers.ChainStats(c.Request().Context(), req.Limit, req.Offset)
+	if err != nil {
+		return handleError(c, err, handler.transfers)
+	}
+
+	response := make([]responses.IbcChainStats, len(stats))
+	for i := range stats {
+		response[i] = responses.NewIbcChainStats(stats[i])
+	}
+	return returnArray(c, response)
+}
@@ -0,0 +1,379 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	testsuite "github.com/celenium-io/celestia-indexer/internal/test_suite"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	testIbcClient = storage.IbcClient{
+		Id:        "07-tendermint-0",
+		Type:      "07-tendermint",
+		ChainId:   "osmosis-1",
+		Height:    100,
+		CreatedAt: testTime,
+		TxId:      1,
+		CreatorId: 1,
+		Creator: &storage.Address{
+			Address: testAddress,
+		},
+	}
+	testIbcConnection = storage.IbcConnection{
+		Id:                       "connection-0",
+		ClientId:                 "07-tendermint-0",
+		CounterpartyConnectionId: "connection-2",
+		CounterpartyClientId:     "07-tendermint-3",
+		Height:                   101,
+		CreatedAt:                testTime,
+		ConnectionHeight:         testsuite.Ptr[pkgTypes.Level](102),
+		ConnectedAt:              &testTime,
+		Client:                   &testIbcClient,
+	}
+	testIbcChannel = storage.IbcChannel{
+		Id:                    "channel-2",
+		PortId:                "transfer",
+		CounterpartyPortId:    "transfer",
+		CounterpartyChannelId: "channel-6994",
+		ConnectionId:          "connection-0",
+		Version:               "ics20-1",
+		Height:                103,
+		CreatedAt:             testTime,
+		ConfirmationHeight:    testsuite.Ptr[pkgTypes.Level](104),
+		ConfirmedAt:           &testTime,
+		Status:                types.IbcChannelStatusOpened,
+		Connection:            &testIbcConnection,
+	}
+	testIbcTransfer = storage.IbcTransfer{
+		Id:                    1,
+		Height:                105,
+		Time:                  testTime,
+		ChannelId:             "channel-2",
+		Port:                  "transfer",
+		CounterpartyChannelId: "channel-6994",
+		CounterpartyPort:      "transfer",
+		ConnectionId:          "connection-0",
+		Sequence:              7,
+		Amount:                decimal.RequireFromString("1000000"),
+		Denom:                 "utia",
+		Sender:                testAddress,
+		Receiver:              "osmo1jc92qdnty48pafummfr8ava2tjtuhfdw7hmxvk",
+		SenderId:              testsuite.Ptr[uint64](1),
+		TxId:                  1,
+		Status:                types.IbcTransferStatusAcknowledged,
+		CompletedHeight:       testsuite.Ptr[pkgTypes.Level](106),
+		CompletedAt:           &testTime,
+		Tx: &storage.Tx{
+			Hash: testTxHashBytes,
+		},
+		Connection: &testIbcConnection,
+	}
+)
+
+// IbcTestSuite -
+type IbcTestSuite struct {
+	suite.Suite
+	clients     *mock.MockIIbcClient
+	connections *mock.MockIIbcConnection
+	channels    *mock.MockIIbcChannel
+	transfers   *mock.MockIIbcTransfer
+	address     *mock.MockIAddress
+	echo        *echo.Echo
+	handler     *IbcHandler
+	ctrl        *gomock.Controller
+}
+
+// SetupSuite -
+func (s *IbcTestSuite) SetupSuite() {
+	s.echo = echo.New()
+	s.echo.Validator = NewCelestiaApiValidator()
+	s.ctrl = gomock.NewController(s.T())
+	s.clients = mock.NewMockIIbcClient(s.ctrl)
+	s.connections = mock.NewMockIIbcConnection(s.ctrl)
+	s.channels = mock.NewMockIIbcChannel(s.ctrl)
+	s.transfers = mock.NewMockIIbcTransfer(s.ctrl)
+	s.address = mock.NewMockIAddress(s.ctrl)
+	s.handler = NewIbcHandler(s.clients, s.connections, s.channels, s.transfers, s.address)
+}
+
+// TearDownSuite -
+func (s *IbcTestSuite) TearDownSuite() {
+	s.ctrl.Finish()
+	s.Require().NoError(s.echo.Shutdown(context.Background()))
+}
+
+func TestSuiteIbc_Run(t *testing.T) {
+	suite.Run(t, new(IbcTestSuite))
+}
+
+func (s *IbcTestSuite) TestClients() {
+	q := make(url.Values)
+	q.Set("limit", "10")
+	q.Set("chain_id", "osmosis-1")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/ibc/client")
+
+	s.clients.EXPECT().
+		ListWithFilters(gomock.Any(), storage.ListIbcClientsFilters{
+			Limit:   10,
+			Sort:    sdk.SortOrderDesc,
+			ChainId: "osmosis-1",
+		}).
+		Return([]storage.IbcClient{testIbcClient}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Clients(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var clients []responses.IbcClient
+	err := json.NewDecoder(rec.Body).Decode(&clients)
+	s.Require().NoError(err)
+	s.Require().Len(clients, 1)
+
+	client := clients[0]
+	s.Require().Equal("07-tendermint-0", client.Id)
+	s.Require().Equal("07-tendermint", client.Type)
+	s.Require().Equal("osmosis-1", client.ChainId)
+	s.Require().EqualValues(100, client.Height)
+	s.Require().Equal(testAddress, client.Creator)
+}
+
+func (s *IbcTestSuite) TestClientNoRows() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/ibc/client/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("07-tendermint-100")
+
+	s.clients.EXPECT().
+		ById(gomock.Any(), "07-tendermint-100").
+		Return(storage.IbcClient{}, sql.ErrNoRows).
+		Times(1)
+
+	s.clients.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true).
+		Times(1)
+
+	s.Require().NoError(s.handler.Client(c))
+	s.Require().Equal(http.StatusNoContent, rec.Code)
+}
+
+func (s *IbcTestSuite) TestConnection() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/ibc/connection/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("connection-0")
+
+	s.connections.EXPECT().
+		ById(gomock.Any(), "connection-0").
+		Return(testIbcConnection, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Connection(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var conn responses.IbcConnection
+	err := json.NewDecoder(rec.Body).Decode(&conn)
+	s.Require().NoError(err)
+	s.Require().Equal("connection-0", conn.Id)
+	s.Require().Equal("07-tendermint-0", conn.ClientId)
+	s.Require().Equal("osmosis-1", conn.ChainId)
+	s.Require().Equal("connection-2", conn.CounterpartyConnectionId)
+	s.Require().EqualValues(102, conn.ConnectionHeight)
+}
+
+func (s *IbcTestSuite) TestChannels() {
+	q := make(url.Values)
+	q.Set("connection_id", "connection-0")
+	q.Set("status", "opened")
+	q.Set("sort", "asc")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/ibc/channel")
+
+	s.channels.EXPECT().
+		ListWithFilters(gomock.Any(), storage.ListIbcChannelsFilters{
+			Limit:        10,
+			Sort:         sdk.SortOrderAsc,
+			ConnectionId: "connection-0",
+			Status:       types.IbcChannelStatusOpened,
+		}).
+		Return([]storage.IbcChannel{testIbcChannel}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Channels(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var channels []responses.IbcChannel
+	err := json.NewDecoder(rec.Body).Decode(&channels)
+	s.Require().NoError(err)
+	s.Require().Len(channels, 1)
+
+	channel := channels[0]
+	s.Require().Equal("channel-2", channel.Id)
+	s.Require().Equal("channel-6994", channel.CounterpartyChannelId)
+	s.Require().Equal("07-tendermint-0", channel.ClientId)
+	s.Require().Equal("osmosis-1", channel.ChainId)
+	s.Require().Equal("opened", channel.Status)
+	s.Require().EqualValues(104, channel.ConfirmationHeight)
+}
+
+func (s *IbcTestSuite) TestChannelsInvalidStatus() {
+	q := make(url.Values)
+	q.Set("status", "closed")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/ibc/channel")
+
+	s.Require().NoError(s.handler.Channels(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *IbcTestSuite) TestTransfers() {
+	q := make(url.Values)
+	q.Set("limit", "10")
+	q.Set("offset", "0")
+	q.Set("channel_id", "channel-2")
+	q.Set("chain_id", "osmosis-1")
+	q.Set("address", testAddress)
+	q.Set("status", "sent,received")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/ibc/transfer")
+
+	s.address.EXPECT().
+		IdByHash(gomock.Any(), testHashAddress).
+		Return(uint64(1), nil).
+		Times(1)
+
+	s.transfers.EXPECT().
+		ListWithFilters(gomock.Any(), storage.ListIbcTransferFilters{
+			Limit:     10,
+			Offset:    0,
+			Sort:      sdk.SortOrderDesc,
+			ChannelId: "channel-2",
+			ChainId:   "osmosis-1",
+			AddressId: testsuite.Ptr[uint64](1),
+			Status:    []types.IbcTransferStatus{types.IbcTransferStatusSent, types.IbcTransferStatusReceived},
+		}).
+		Return([]storage.IbcTransfer{testIbcTransfer}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Transfers(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var transfers []responses.IbcTransfer
+	err := json.NewDecoder(rec.Body).Decode(&transfers)
+	s.Require().NoError(err)
+	s.Require().Len(transfers, 1)
+
+	transfer := transfers[0]
+	s.Require().EqualValues(1, transfer.Id)
+	s.Require().EqualValues(7, transfer.Sequence)
+	s.Require().Equal("1000000", transfer.Amount)
+	s.Require().Equal("utia", transfer.Denom)
+	s.Require().Equal("osmosis-1", transfer.ChainId)
+	s.Require().Equal("acknowledged", transfer.Status)
+	s.Require().EqualValues(106, transfer.CompletedHeight)
+	s.Require().Equal(testTxHash, strings.ToUpper(transfer.TxHash))
+}
+
+func (s *IbcTestSuite) TestTransfersInvalidStatus() {
+	q := make(url.Values)
+	q.Set("status", "unknown")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/ibc/transfer")
+
+	s.Require().NoError(s.handler.Transfers(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *IbcTestSuite) TestTransfer() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/ibc/transfer/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	s.transfers.EXPECT().
+		ById(gomock.Any(), uint64(1)).
+		Return(testIbcTransfer, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Transfer(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var transfer responses.IbcTransfer
+	err := json.NewDecoder(rec.Body).Decode(&transfer)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, transfer.Id)
+	s.Require().Equal(testAddress, transfer.Sender)
+}
+
+func (s *IbcTestSuite) TestChains() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/ibc/chains")
+
+	s.transfers.EXPECT().
+		ChainStats(gomock.Any(), 10, 0).
+		Return([]storage.IbcChainStats{
+			{
+				ChainId:       "osmosis-1",
+				SentCount:     10,
+				ReceivedCount: 5,
+				Sent:          decimal.RequireFromString("1000"),
+				Received:      decimal.RequireFromString("500"),
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Chains(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var stats []responses.IbcChainStats
+	err := json.NewDecoder(rec.Body).Decode(&stats)
+	s.Require().NoError(err)
+	s.Require().Len(stats, 1)
+	s.Require().Equal("osmosis-1", stats[0].ChainId)
+	s.Require().EqualValues(10, stats[0].SentCount)
+	s.Require().Equal("500", stats[0].Received)
+}
@@ -4,18 +4,27 @@
 package handler
 
 import (
+	"bytes"
+	"context"
 	"encoding/base64"
 	"encoding/hex"
+	"io"
 	"net/http"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/celenium-io/celestia-indexer/pkg/types"
 	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 
 	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
+	blobStorage "github.com/celenium-io/celestia-indexer/internal/blob"
+	"github.com/celenium-io/celestia-indexer/internal/blob/decoder"
 	"github.com/celenium-io/celestia-indexer/internal/storage"
 	"github.com/celenium-io/celestia-indexer/pkg/node"
+	nodeTypes "github.com/celenium-io/celestia-indexer/pkg/node/types"
 	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
 )
 
 type NamespaceHandler struct {
@@ -25,6 +34,7 @@ type NamespaceHandler struct {
 	blob        node.DalApi
 	state       storage.IState
 	indexerName string
+	decoders    *decoder.Registry
 }
 
 func NewNamespaceHandler(
@@ -34,6 +44,7 @@ func NewNamespaceHandler(
 	state storage.IState,
 	indexerName string,
 	blob node.DalApi,
+	decoders *decoder.Registry,
 ) *NamespaceHandler {
 	return &NamespaceHandler{
 		namespace:   namespace,
@@ -42,6 +53,7 @@ func NewNamespaceHandler(
 		blob:        blob,
 		state:       state,
 		indexerName: indexerName,
+		decoders:    decoders,
 	}
 }
 
@@ -347,6 +359,7 @@ type postBlobRequest struct {
 	Hash       string      `json:"hash"       validate:"required,namespace"`
 	Height     types.Level `json:"height"     validate:"required,min=1"`
 	Commitment string      `json:"commitment" validate:"required,base64"`
+	Decode     bool        `json:"decode"     validate:"omitempty"`
 }
 
 // Blob godoc
@@ -358,6 +371,7 @@ type postBlobRequest struct {
 //	@Param			hash		body	string	true	"Base64-encoded namespace id and version"
 //	@Param			height		body	integer	true	"Block heigth"	minimum(1)
 //	@Param			commitment	body	string	true	"Blob commitment"
+//	@Param			decode		body	boolean	false	"Decode blob payload with rollup stack or namespace decoders"
 //	@Accept			json
 //	@Produce		json
 //	@Success		200	{object}	responses.Blob
@@ -379,9 +393,228 @@ func (handler *NamespaceHandler) Blob(c echo.Context) error {
 		return handleError(c, err, handler.blobLogs)
 	}
 
+	if req.Decode {
+		decoded, err := handler.decodeBlob(c.Request().Context(), req, blob.Data)
+		if err != nil {
+			return handleError(c, err, handler.blobLogs)
+		}
+		response.Decoded = decoded
+	}
+
 	return c.JSON(http.StatusOK, response)
 }
 
+// decodeBlob - decodes blob payload by decoders of rollup stack if blob was pushed by known rollup or by namespace and fallback decoders otherwise
+func (handler *NamespaceHandler) decodeBlob(ctx context.Context, req *postBlobRequest, data string) (*decoder.Result, error) {
+	payload, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var stack string
+	namespaceId, err := base64.StdEncoding.DecodeString(req.Hash)
+	if err != nil {
+		return nil, err
+	}
+	ns, err := handler.namespace.ByNamespaceIdAndVersion(ctx, namespaceId[1:], namespaceId[0])
+	switch {
+	case err == nil:
+		blobLog, err := handler.blobLogs.Blob(ctx, req.Height, ns.Id, req.Commitment)
+		if err != nil && !handler.blobLogs.IsNoRows(err) {
+			return nil, err
+		}
+		if blobLog.Rollup != nil {
+			stack = blobLog.Rollup.Stack
+		}
+	case !handler.namespace.IsNoRows(err):
+		return nil, err
+	}
+
+	result, err := handler.decoders.Decode(stack, req.Hash, payload)
+	if err != nil {
+		if errors.Is(err, decoder.ErrUnsupported) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return result, nil
+}
+
+type getRawBlobRequest struct {
+	Hash       string      `param:"hash"       validate:"required,base64url"`
+	Height     types.Level `param:"height"     validate:"required,min=1"`
+	Commitment string      `param:"commitment" validate:"required,base64url"`
+}
+
+// stdEncoding - converts URL-safe base64 path params to standard base64 which is used by node
+func (req getRawBlobRequest) stdEncoding() (namespace string, commitment string, err error) {
+	hash, err := base64.URLEncoding.DecodeString(req.Hash)
+	if err != nil {
+		return
+	}
+	namespace = base64.StdEncoding.EncodeToString(hash)
+	if !isNamespace(namespace) {
+		return "", "", errInvalidNamespace
+	}
+
+	data, err := base64.URLEncoding.DecodeString(req.Commitment)
+	if err != nil {
+		return "", "", err
+	}
+	commitment = base64.StdEncoding.EncodeToString(data)
+	return
+}
+
+// BlobRaw godoc
+//
+//	@Summary		Download raw blob data
+//	@Description	Returns raw bytes of blob with detected content type. Range requests are supported.
+//	@Description	Blob is streamed from blob storage. If blobs are received from node the whole blob is loaded to memory, so its size is limited by the node's max blob size.
+//	@Tags			namespace
+//	@ID				get-blob-raw
+//	@Param			hash		path	string	true	"URL-safe base64-encoded namespace id and version"
+//	@Param			height		path	integer	true	"Block heigth"	minimum(1)
+//	@Param			commitment	path	string	true	"URL-safe base64-encoded blob commitment"
+//	@Produce		octet-stream
+//	@Success		200	{file}		binary
+//	@Success		206	{file}		binary
+//	@Failure		400	{object}	Error
+//	@Failure		404	{object}	Error
+//	@Failure		416	{string}	string
+//	@Router			/blob/{hash}/{height}/{commitment}/raw [get]
+func (handler *NamespaceHandler) BlobRaw(c echo.Context) error {
+	req, err := bindAndValidate[getRawBlobRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	namespace, commitment, err := req.stdEncoding()
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	raw, err := handler.rawBlob(c.Request().Context(), req.Height, namespace, commitment)
+	if err != nil {
+		if errors.Is(err, nodeTypes.ErrBlobNotFound) {
+			return c.JSON(http.StatusNotFound, Error{
+				Message: err.Error(),
+			})
+		}
+		return internalServerError(c, err)
+	}
+	defer raw.Close()
+
+	header := c.Response().Header()
+	if raw.ContentType != "" {
+		header.Set(echo.HeaderContentType, raw.ContentType)
+	}
+	header.Set("ETag", strconv.Quote(commitment))
+	http.ServeContent(c.Response(), c.Request(), "", time.Time{}, raw)
+	return nil
+}
+
+// rawBlob - returns reader of blob data. Blob storages stream the data, blobs received from node are decoded in memory.
+func (handler *NamespaceHandler) rawBlob(ctx context.Context, height types.Level, namespace, commitment string) (blobStorage.RawBlob, error) {
+	if reader, ok := handler.blob.(blobStorage.RawReader); ok {
+		return reader.RawBlob(ctx, height, namespace, commitment)
+	}
+
+	blob, err := handler.blob.Blob(ctx, height, namespace, commitment)
+	if err != nil {
+		return blobStorage.RawBlob{}, err
+	}
+
+	data, err := base64.StdEncoding.DecodeString(blob.Data)
+	if err != nil {
+		return blobStorage.RawBlob{}, err
+	}
+
+	return blobStorage.RawBlob{
+		ReadSeekCloser: nopSeekCloser{bytes.NewReader(data)},
+		ContentType:    http.DetectContentType(data),
+	}, nil
+}
+
+// nopSeekCloser - in-memory blob data which doesn't need closing
+type nopSeekCloser struct {
+	io.ReadSeeker
+}
+
+func (nopSeekCloser) Close() error {
+	return nil
+}
+
+// blobBatchThreads - count of concurrent requests to blob receiver during batch request
+const blobBatchThreads = 10
+
+type postBlobBatchRequest struct {
+	Blobs []postBlobBatchItem `json:"blobs" validate:"required,min=1,max=100,dive"`
+}
+
+type postBlobBatchItem struct {
+	Hash       string      `json:"hash"       validate:"required,namespace"`
+	Height     types.Level `json:"height"     validate:"required,min=1"`
+	Commitment string      `json:"commitment" validate:"required,base64"`
+}
+
+// BlobBatch godoc
+//
+//	@Summary		Get batch of blobs
+//	@Description	Returns blobs by namespace, height and commitment. Blobs are received concurrently and errors are returned per item.
+//	@Tags			namespace
+//	@ID				get-blob-batch
+//	@Param			blobs	body	array	true	"Array of objects with base64-encoded namespace `hash`, `height` and `commitment`"	maxlength(100)
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{array}		responses.BlobBatchItem
+//	@Failure		400	{object}	Error
+//	@Router			/blob/batch [post]
+func (handler *NamespaceHandler) BlobBatch(c echo.Context) error {
+	req, err := bindAndValidate[postBlobBatchRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	var (
+		ctx     = c.Request().Context()
+		result  = make([]responses.BlobBatchItem, len(req.Blobs))
+		wg      = new(sync.WaitGroup)
+		threads = make(chan struct{}, blobBatchThreads)
+	)
+
+	for i := range req.Blobs {
+		wg.Add(1)
+		threads <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-threads
+				wg.Done()
+			}()
+
+			item := req.Blobs[i]
+			result[i] = responses.BlobBatchItem{
+				Hash:       item.Hash,
+				Height:     item.Height,
+				Commitment: item.Commitment,
+			}
+
+			blob, err := handler.blob.Blob(ctx, item.Height, item.Hash, item.Commitment)
+			if err != nil {
+				result[i].Error = err.Error()
+				return
+			}
+			response, err := responses.NewBlob(blob)
+			if err != nil {
+				result[i].Error = err.Error()
+				return
+			}
+			result[i].Blob = &response
+		}(i)
+	}
+	wg.Wait()
+
+	return returnArray(c, result)
+}
+
 // BlobMetadata godoc
 //
 //	@Summary		Get blob metadata by commitment on height
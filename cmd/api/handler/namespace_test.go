@@ -18,13 +18,17 @@ import (
 	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 
 	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/celestia-indexer/internal/blob"
+	"github.com/celenium-io/celestia-indexer/internal/blob/decoder"
 	"github.com/celenium-io/celestia-indexer/internal/storage"
 	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
 	"github.com/celenium-io/celestia-indexer/internal/storage/types"
 	nodeMock "github.com/celenium-io/celestia-indexer/pkg/node/mock"
 	nodeTypes "github.com/celenium-io/celestia-indexer/pkg/node/types"
 	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/suite"
+	tmTypes "github.com/tendermint/tendermint/proto/tendermint/types"
 	"go.uber.org/mock/gomock"
 )
 
@@ -65,7 +69,7 @@ func (s *NamespaceTestSuite) SetupSuite() {
 	s.rollups = mock.NewMockIRollup(s.ctrl)
 	s.state = mock.NewMockIState(s.ctrl)
 	s.blobReceiver = nodeMock.NewMockDalApi(s.ctrl)
-	s.handler = NewNamespaceHandler(s.namespaces, s.blobLogs, s.rollups, s.state, testIndexerName, s.blobReceiver)
+	s.handler = NewNamespaceHandler(s.namespaces, s.blobLogs, s.rollups, s.state, testIndexerName, s.blobReceiver, decoder.NewDefaultRegistry())
 }
 
 // TearDownSuite -
@@ -481,6 +485,301 @@ func (s *NamespaceTestSuite) TestBlob() {
 
 }
 
+func (s *NamespaceTestSuite) TestBlobDecoded() {
+	commitment := "vbGakK59+Non81TE3ULg5Ve5ufT9SFm/bCyY+WLR3gg="
+
+	for _, tt := range []struct {
+		name    string
+		data    []byte
+		decoder string
+	}{
+		{"rollup stack", []byte{0x08, 0x96, 0x01}, "protobuf"},
+		{"unsupported", []byte("plain text"), ""},
+	} {
+		s.Run(tt.name, func() {
+			blobReq := map[string]any{
+				"hash":       testNamespaceBase64,
+				"height":     1000,
+				"commitment": commitment,
+				"decode":     true,
+			}
+			stream := new(bytes.Buffer)
+			err := json.NewEncoder(stream).Encode(blobReq)
+			s.Require().NoError(err)
+
+			req := httptest.NewRequest(http.MethodPost, "/", stream)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.SetPath("/blob")
+
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			s.blobReceiver.EXPECT().
+				Blob(gomock.Any(), pkgTypes.Level(1000), testNamespaceBase64, commitment).
+				Return(nodeTypes.Blob{
+					Namespace:  testNamespaceBase64,
+					Data:       base64.StdEncoding.EncodeToString(tt.data),
+					Commitment: commitment,
+				}, nil).
+				Times(1)
+
+			s.namespaces.EXPECT().
+				ByNamespaceIdAndVersion(gomock.Any(), testNamespace.NamespaceID, byte(0)).
+				Return(testNamespace, nil).
+				Times(1)
+
+			rollup := testRollup
+			rollup.Stack = "rollkit"
+			s.blobLogs.EXPECT().
+				Blob(gomock.Any(), pkgTypes.Level(1000), testNamespace.Id, commitment).
+				Return(storage.BlobLog{
+					Height: 1000,
+					Rollup: &rollup,
+				}, nil).
+				Times(1)
+
+			s.Require().NoError(s.handler.Blob(c))
+			s.Require().Equal(http.StatusOK, rec.Code)
+
+			var blob responses.Blob
+			err = json.NewDecoder(rec.Body).Decode(&blob)
+			s.Require().NoError(err)
+
+			if tt.decoder == "" {
+				s.Require().Nil(blob.Decoded)
+				return
+			}
+			s.Require().NotNil(blob.Decoded)
+			s.Require().Equal(tt.decoder, blob.Decoded.Decoder)
+			s.Require().NotNil(blob.Decoded.Data)
+		})
+	}
+}
+
+func (s *NamespaceTestSuite) TestBlobRaw() {
+	commitment := "0CsLX630cjij9DR6nqoWfQcCH2pCQSoSuq63dTkd4Bw="
+	data := []byte("raw blob data")
+
+	for _, tt := range []struct {
+		name        string
+		rangeHeader string
+		code        int
+		body        string
+	}{
+		{"full", "", http.StatusOK, "raw blob data"},
+		{"range", "bytes=4-7", http.StatusPartialContent, "blob"},
+		{"invalid range", "bytes=100-200", http.StatusRequestedRangeNotSatisfiable, ""},
+	} {
+		s.Run(tt.name, func() {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.rangeHeader != "" {
+				req.Header.Set("Range", tt.rangeHeader)
+			}
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.SetPath("/blob/:hash/:height/:commitment/raw")
+			c.SetParamNames("hash", "height", "commitment")
+			c.SetParamValues(
+				"AAAAAAAAAAAAAAAAAAAAAAAAAAAA_HRDsVWSAVY=",
+				"1000",
+				"0CsLX630cjij9DR6nqoWfQcCH2pCQSoSuq63dTkd4Bw=",
+			)
+
+			s.blobReceiver.EXPECT().
+				Blob(gomock.Any(), pkgTypes.Level(1000), testNamespaceBase64, commitment).
+				Return(nodeTypes.Blob{
+					Namespace:  testNamespaceBase64,
+					Data:       base64.StdEncoding.EncodeToString(data),
+					Commitment: commitment,
+				}, nil).
+				Times(1)
+
+			s.Require().NoError(s.handler.BlobRaw(c))
+			s.Require().Equal(tt.code, rec.Code, rec.Body.String())
+			if tt.body != "" {
+				s.Require().Equal(tt.body, rec.Body.String())
+				s.Require().Equal("text/plain; charset=utf-8", rec.Header().Get(echo.HeaderContentType))
+			}
+		})
+	}
+}
+
+func (s *NamespaceTestSuite) TestBlobRawNotFound() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/blob/:hash/:height/:commitment/raw")
+	c.SetParamNames("hash", "height", "commitment")
+	c.SetParamValues(
+		"AAAAAAAAAAAAAAAAAAAAAAAAAAAA_HRDsVWSAVY=",
+		"1000",
+		"0CsLX630cjij9DR6nqoWfQcCH2pCQSoSuq63dTkd4Bw=",
+	)
+
+	s.blobReceiver.EXPECT().
+		Blob(gomock.Any(), pkgTypes.Level(1000), testNamespaceBase64, "0CsLX630cjij9DR6nqoWfQcCH2pCQSoSuq63dTkd4Bw=").
+		Return(nodeTypes.Blob{}, errors.Wrap(nodeTypes.ErrBlobNotFound, "request 1")).
+		Times(1)
+
+	s.Require().NoError(s.handler.BlobRaw(c))
+	s.Require().Equal(http.StatusNotFound, rec.Code)
+}
+
+func (s *NamespaceTestSuite) TestBlobRawFromStorage() {
+	commitment := "0CsLX630cjij9DR6nqoWfQcCH2pCQSoSuq63dTkd4Bw="
+	ns, err := base64.StdEncoding.DecodeString(testNamespaceBase64)
+	s.Require().NoError(err)
+	cm, err := base64.StdEncoding.DecodeString(commitment)
+	s.Require().NoError(err)
+
+	fs := blob.NewFS(blob.FSConfig{Dir: s.T().TempDir()})
+	s.Require().NoError(fs.Init(context.Background()))
+	s.Require().NoError(fs.Save(context.Background(), blob.Blob{
+		Blob: &tmTypes.Blob{
+			NamespaceId:  ns[1:],
+			Data:         []byte("raw blob data"),
+			ShareVersion: uint32(ns[0]),
+		},
+		Commitment: cm,
+		Height:     1000,
+	}))
+	handler := NewNamespaceHandler(s.namespaces, s.blobLogs, s.rollups, s.state, testIndexerName, fs, decoder.NewDefaultRegistry())
+
+	for _, tt := range []struct {
+		name        string
+		commitment  string
+		rangeHeader string
+		code        int
+		body        string
+	}{
+		{"full", "0CsLX630cjij9DR6nqoWfQcCH2pCQSoSuq63dTkd4Bw=", "", http.StatusOK, "raw blob data"},
+		{"range", "0CsLX630cjij9DR6nqoWfQcCH2pCQSoSuq63dTkd4Bw=", "bytes=4-7", http.StatusPartialContent, "blob"},
+		{"not found", "RWW7eaKKXasSGK_DS8PlpErARbl5iFs1vQIycYEAlk0=", "", http.StatusNotFound, ""},
+	} {
+		s.Run(tt.name, func() {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.rangeHeader != "" {
+				req.Header.Set("Range", tt.rangeHeader)
+			}
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.SetPath("/blob/:hash/:height/:commitment/raw")
+			c.SetParamNames("hash", "height", "commitment")
+			c.SetParamValues("AAAAAAAAAAAAAAAAAAAAAAAAAAAA_HRDsVWSAVY=", "1000", tt.commitment)
+
+			s.Require().NoError(handler.BlobRaw(c))
+			s.Require().Equal(tt.code, rec.Code, rec.Body.String())
+			if tt.body != "" {
+				s.Require().Equal(tt.body, rec.Body.String())
+				s.Require().Equal("text/plain; charset=utf-8", rec.Header().Get(echo.HeaderContentType))
+			}
+		})
+	}
+}
+
+func (s *NamespaceTestSuite) TestBlobRawInvalidNamespace() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/blob/:hash/:height/:commitment/raw")
+	c.SetParamNames("hash", "height", "commitment")
+	c.SetParamValues(
+		"c2hvcnQ=",
+		"1000",
+		"0CsLX630cjij9DR6nqoWfQcCH2pCQSoSuq63dTkd4Bw=",
+	)
+
+	s.Require().NoError(s.handler.BlobRaw(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *NamespaceTestSuite) TestBlobBatch() {
+	commitments := []string{
+		"RWW7eaKKXasSGK/DS8PlpErARbl5iFs1vQIycYEAlk0=",
+		"RWW7eaKKXasSGK/DS8PlpErARbl5iFs1vQIycYEAlk1=",
+	}
+
+	blobReq := map[string]any{
+		"blobs": []map[string]any{
+			{
+				"hash":       testNamespaceBase64,
+				"height":     1001,
+				"commitment": commitments[0],
+			}, {
+				"hash":       testNamespaceBase64,
+				"height":     1001,
+				"commitment": commitments[1],
+			},
+		},
+	}
+	stream := new(bytes.Buffer)
+	err := json.NewEncoder(stream).Encode(blobReq)
+	s.Require().NoError(err)
+
+	req := httptest.NewRequest(http.MethodPost, "/", stream)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/blob/batch")
+
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	s.blobReceiver.EXPECT().
+		Blob(gomock.Any(), pkgTypes.Level(1001), testNamespaceBase64, commitments[0]).
+		Return(nodeTypes.Blob{
+			Namespace:  testNamespaceBase64,
+			Data:       base64.StdEncoding.EncodeToString([]byte("data")),
+			Commitment: commitments[0],
+		}, nil).
+		Times(1)
+
+	s.blobReceiver.EXPECT().
+		Blob(gomock.Any(), pkgTypes.Level(1001), testNamespaceBase64, commitments[1]).
+		Return(nodeTypes.Blob{}, errors.New("blob: not found")).
+		Times(1)
+
+	s.Require().NoError(s.handler.BlobBatch(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var items []responses.BlobBatchItem
+	err = json.NewDecoder(rec.Body).Decode(&items)
+	s.Require().NoError(err)
+	s.Require().Len(items, 2)
+
+	s.Require().Equal(commitments[0], items[0].Commitment)
+	s.Require().EqualValues(1001, items[0].Height)
+	s.Require().Empty(items[0].Error)
+	s.Require().NotNil(items[0].Blob)
+	s.Require().Equal(base64.StdEncoding.EncodeToString([]byte("data")), items[0].Blob.Data)
+
+	s.Require().Equal(commitments[1], items[1].Commitment)
+	s.Require().Equal("blob: not found", items[1].Error)
+	s.Require().Nil(items[1].Blob)
+}
+
+func (s *NamespaceTestSuite) TestBlobBatchTooManyBlobs() {
+	blobs := make([]map[string]any, 101)
+	for i := range blobs {
+		blobs[i] = map[string]any{
+			"hash":       testNamespaceBase64,
+			"height":     1001,
+			"commitment": "RWW7eaKKXasSGK/DS8PlpErARbl5iFs1vQIycYEAlk0=",
+		}
+	}
+	stream := new(bytes.Buffer)
+	err := json.NewEncoder(stream).Encode(map[string]any{"blobs": blobs})
+	s.Require().NoError(err)
+
+	req := httptest.NewRequest(http.MethodPost, "/", stream)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/blob/batch")
+
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	s.Require().NoError(s.handler.BlobBatch(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
 func (s *NamespaceTestSuite) TestGetLogs() {
 	req := httptest.NewRequest(http.MethodGet, "/", nil)
 	rec := httptest.NewRecorder()
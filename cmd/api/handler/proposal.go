@@ -0,0 +1,258 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"net/http"
+
+	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/labstack/echo/v4"
+)
+
+type ProposalHandler struct {
+	proposals storage.IProposal
+	votes     storage.IVote
+	deposits  storage.IDeposit
+	address   storage.IAddress
+}
+
+func NewProposalHandler(
+	proposals storage.IProposal,
+	votes storage.IVote,
+	deposits storage.IDeposit,
+	address storage.IAddress,
+) *ProposalHandler {
+	return &ProposalHandler{
+		proposals: proposals,
+		votes:     votes,
+		deposits:  deposits,
+		address:   address,
+	}
+}
+
+type listProposalsRequest struct {
+	Limit    int         `query:"limit"    validate:"omitempty,min=1,max=100"`
+	Offset   int         `query:"offset"   validate:"omitempty,min=0"`
+	Sort     string      `query:"sort"     validate:"omitempty,oneof=asc desc"`
+	Proposer string      `query:"proposer" validate:"omitempty,address"`
+	Status   StringArray `query:"status"   validate:"omitempty,dive,proposal_status"`
+	Type     StringArray `query:"type"     validate:"omitempty,dive,proposal_type"`
+}
+
+func (req *listProposalsRequest) SetDefault() {
+	if req.Limit == 0 {
+		req.Limit = 10
+	}
+	if req.Sort == "" {
+		req.Sort = desc
+	}
+}
+
+// List godoc
+//
+//	@Summary		List governance proposals
+//	@Description	List governance proposals
+//	@Tags			proposal
+//	@ID				list-proposal
+//	@Param			limit		query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset		query	integer	false	"Offset"						mininum(1)
+//	@Param			sort		query	string	false	"Sort order"					Enums(asc, desc)
+//	@Param			proposer	query	string	false	"Proposer celestia address"		minlength(47)	maxlength(47)
+//	@Param			status		query	string	false	"Comma-separated proposal status list"
+//	@Param			type		query	string	false	"Comma-separated proposal type list"
+//	@Produce		json
+//	@Success		200	{array}		responses.Proposal
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/proposal [get]
+func (handler *ProposalHandler) List(c echo.Context) error {
+	req, err := bindAndValidate[listProposalsRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	fltrs := storage.ListProposalFilters{
+		Limit:  req.Limit,
+		Offset: req.Offset,
+		Sort:   pgSort(req.Sort),
+		Status: make([]storageTypes.ProposalStatus, len(req.Status)),
+		Type:   make([]storageTypes.ProposalType, len(req.Type)),
+	}
+	for i := range req.Status {
+		fltrs.Status[i] = storageTypes.ProposalStatus(req.Status[i])
+	}
+	for i := range req.Type {
+		fltrs.Type[i] = storageTypes.ProposalType(req.Type[i])
+	}
+
+	if req.Proposer != "" {
+		_, hash, err := types.Address(req.Proposer).Decode()
+		if err != nil {
+			return badRequestError(c, err)
+		}
+		addressId, err := handler.address.IdByHash(c.Request().Context(), hash)
+		if err != nil {
+			return handleError(c, err, handler.address)
+		}
+		fltrs.ProposerId = addressId
+	}
+
+	proposals, err := handler.proposals.ListWithFilters(c.Request().Context(), fltrs)
+	if err != nil {
+		return handleError(c, err, handler.proposals)
+	}
+
+	response := make([]responses.Proposal, len(proposals))
+	for i := range proposals {
+		response[i] = responses.NewProposal(proposals[i])
+	}
+	return returnArray(c, response)
+}
+
+type getProposalRequest struct {
+	Id uint64 `param:"id" validate:"required,min=1"`
+}
+
+// Get godoc
+//
+//	@Summary		Get proposal info
+//	@Description	Get proposal info
+//	@Tags			proposal
+//	@ID				get-proposal
+//	@Param			id	path	integer	true	"Proposal id"	minimum(1)
+//	@Produce		json
+//	@Success		200	{object}	responses.Proposal
+//	@Success		204
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/proposal/{id} [get]
+func (handler *ProposalHandler) Get(c echo.Context) error {
+	req, err := bindAndValidate[getProposalRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	proposal, err := handler.proposals.ById(c.Request().Context(), req.Id)
+	if err != nil {
+		return handleError(c, err, handler.proposals)
+	}
+
+	return c.JSON(http.StatusOK, responses.NewProposal(proposal))
+}
+
+type votesRequest struct {
+	Id        uint64      `param:"id"         validate:"required,min=1"`
+	Limit     int         `query:"limit"      validate:"omitempty,min=1,max=100"`
+	Offset    int         `query:"offset"     validate:"omitempty,min=0"`
+	Option    StringArray `query:"option"     validate:"omitempty,dive,vote_option"`
+	VoterType string      `query:"voter_type" validate:"omitempty,oneof=validator delegator"`
+}
+
+func (req *votesRequest) SetDefault() {
+	if req.Limit == 0 {
+		req.Limit = 10
+	}
+}
+
+func (req *votesRequest) Filters() storage.VoteFilters {
+	fltrs := storage.VoteFilters{
+		Limit:     req.Limit,
+		Offset:    req.Offset,
+		VoterType: storage.VoterType(req.VoterType),
+		Option:    make([]storageTypes.VoteOption, len(req.Option)),
+	}
+	for i := range req.Option {
+		fltrs.Option[i] = storageTypes.VoteOption(req.Option[i])
+	}
+	return fltrs
+}
+
+// Votes godoc
+//
+//	@Summary		Get proposal's votes
+//	@Description	Get proposal's votes
+//	@Tags			proposal
+//	@ID				get-proposal-votes
+//	@Param			id			path	integer	true	"Proposal id"					minimum(1)
+//	@Param			limit		query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset		query	integer	false	"Offset"						mininum(1)
+//	@Param			option		query	string	false	"Comma-separated vote option list"
+//	@Param			voter_type	query	string	false	"Voter type"					Enums(validator, delegator)
+//	@Produce		json
+//	@Success		200	{array}		responses.Vote
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/proposal/{id}/votes [get]
+func (handler *ProposalHandler) Votes(c echo.Context) error {
+	req, err := bindAndValidate[votesRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	votes, err := handler.votes.ByProposalId(c.Request().Context(), req.Id, req.Filters())
+	if err != nil {
+		return handleError(c, err, handler.votes)
+	}
+
+	response := make([]responses.Vote, len(votes))
+	for i := range votes {
+		response[i] = responses.NewVote(votes[i])
+	}
+	return returnArray(c, response)
+}
+
+type depositsRequest struct {
+	Id     uint64 `param:"id"     validate:"required,min=1"`
+	Limit  int    `query:"limit"  validate:"omitempty,min=1,max=100"`
+	Offset int    `query:"offset" validate:"omitempty,min=0"`
+	Sort   string `query:"sort"   validate:"omitempty,oneof=asc desc"`
+}
+
+func (req *depositsRequest) SetDefault() {
+	if req.Limit == 0 {
+		req.Limit = 10
+	}
+	if req.Sort == "" {
+		req.Sort = desc
+	}
+}
+
+// Deposits godoc
+//
+//	@Summary		Get proposal's deposits
+//	@Description	Get proposal's deposits
+//	@Tags			proposal
+//	@ID				get-proposal-deposits
+//	@Param			id		path	integer	true	"Proposal id"					minimum(1)
+//	@Param			limit	query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset	query	integer	false	"Offset"						mininum(1)
+//	@Param			sort	query	string	false	"Sort order"					Enums(asc, desc)
+//	@Produce		json
+//	@Success		200	{array}		responses.Deposit
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/proposal/{id}/deposits [get]
+func (handler *ProposalHandler) Deposits(c echo.Context) error {
+	req, err := bindAndValidate[depositsRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	deposits, err := handler.deposits.ByProposalId(c.Request().Context(), req.Id, req.Limit, req.Offset, pgSort(req.Sort))
+	if err != nil {
+		return handleError(c, err, handler.deposits)
+	}
+
+	response := make([]responses.Deposit, len(deposits))
+	for i := range deposits {
+		response[i] = responses.NewDeposit(deposits[i])
+	}
+	return returnArray(c, response)
+}
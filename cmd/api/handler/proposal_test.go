@@ -0,0 +1,291 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	testsuite "github.com/celenium-io/celestia-indexer/internal/test_suite"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+var testProposal = storage.Proposal{
+	Id:               1,
+	Height:           100,
+	ProposerId:       1,
+	CreatedAt:        testTime,
+	ActivationHeight: testsuite.Ptr[pkgTypes.Level](101),
+	ActivationTime:   &testTime,
+	Status:           types.ProposalStatusActive,
+	Type:             types.ProposalTypeText,
+	Title:            "title",
+	Description:      "description",
+	Deposit:          decimal.RequireFromString("10000000000"),
+	VotesCount:       3,
+	Yes:              2,
+	No:               1,
+	Proposer: &storage.Address{
+		Address: testAddress,
+	},
+}
+
+// ProposalTestSuite -
+type ProposalTestSuite struct {
+	suite.Suite
+	proposals *mock.MockIProposal
+	votes     *mock.MockIVote
+	deposits  *mock.MockIDeposit
+	address   *mock.MockIAddress
+	echo      *echo.Echo
+	handler   *ProposalHandler
+	ctrl      *gomock.Controller
+}
+
+// SetupSuite -
+func (s *ProposalTestSuite) SetupSuite() {
+	s.echo = echo.New()
+	s.echo.Validator = NewCelestiaApiValidator()
+	s.ctrl = gomock.NewController(s.T())
+	s.proposals = mock.NewMockIProposal(s.ctrl)
+	s.votes = mock.NewMockIVote(s.ctrl)
+	s.deposits = mock.NewMockIDeposit(s.ctrl)
+	s.address = mock.NewMockIAddress(s.ctrl)
+	s.handler = NewProposalHandler(s.proposals, s.votes, s.deposits, s.address)
+}
+
+// TearDownSuite -
+func (s *ProposalTestSuite) TearDownSuite() {
+	s.ctrl.Finish()
+	s.Require().NoError(s.echo.Shutdown(context.Background()))
+}
+
+func TestSuiteProposal_Run(t *testing.T) {
+	suite.Run(t, new(ProposalTestSuite))
+}
+
+func (s *ProposalTestSuite) TestList() {
+	q := make(url.Values)
+	q.Set("limit", "10")
+	q.Set("offset", "0")
+	q.Set("sort", "asc")
+	q.Set("status", "active,applied")
+	q.Set("type", "text")
+	q.Set("proposer", testAddress)
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/proposal")
+
+	s.address.EXPECT().
+		IdByHash(gomock.Any(), testHashAddress).
+		Return(uint64(1), nil).
+		Times(1)
+
+	s.proposals.EXPECT().
+		ListWithFilters(gomock.Any(), storage.ListProposalFilters{
+			Limit:      10,
+			Offset:     0,
+			Sort:       sdk.SortOrderAsc,
+			ProposerId: 1,
+			Status:     []types.ProposalStatus{types.ProposalStatusActive, types.ProposalStatusApplied},
+			Type:       []types.ProposalType{types.ProposalTypeText},
+		}).
+		Return([]storage.Proposal{testProposal}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var proposals []responses.Proposal
+	err := json.NewDecoder(rec.Body).Decode(&proposals)
+	s.Require().NoError(err)
+	s.Require().Len(proposals, 1)
+
+	p := proposals[0]
+	s.Require().EqualValues(1, p.Id)
+	s.Require().EqualValues(100, p.Height)
+	s.Require().EqualValues(101, p.ActivationHeight)
+	s.Require().Equal("active", p.Status)
+	s.Require().Equal("text", p.Type)
+	s.Require().Equal(testAddress, p.Proposer)
+	s.Require().Equal("10000000000", p.Deposit)
+	s.Require().EqualValues(3, p.VotesCount)
+}
+
+func (s *ProposalTestSuite) TestListInvalidStatus() {
+	q := make(url.Values)
+	q.Set("status", "unknown")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/proposal")
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *ProposalTestSuite) TestGet() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/proposal/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	s.proposals.EXPECT().
+		ById(gomock.Any(), uint64(1)).
+		Return(testProposal, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Get(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var p responses.Proposal
+	err := json.NewDecoder(rec.Body).Decode(&p)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, p.Id)
+	s.Require().Equal("title", p.Title)
+	s.Require().Equal("description", p.Description)
+}
+
+func (s *ProposalTestSuite) TestGetNoRows() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/proposal/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("100")
+
+	s.proposals.EXPECT().
+		ById(gomock.Any(), uint64(100)).
+		Return(storage.Proposal{}, sql.ErrNoRows).
+		Times(1)
+
+	s.proposals.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true).
+		Times(1)
+
+	s.Require().NoError(s.handler.Get(c))
+	s.Require().Equal(http.StatusNoContent, rec.Code)
+}
+
+func (s *ProposalTestSuite) TestVotes() {
+	q := make(url.Values)
+	q.Set("limit", "10")
+	q.Set("offset", "0")
+	q.Set("option", "yes")
+	q.Set("voter_type", "validator")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/proposal/:id/votes")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	s.votes.EXPECT().
+		ByProposalId(gomock.Any(), uint64(1), storage.VoteFilters{
+			Limit:     10,
+			Offset:    0,
+			Option:    []types.VoteOption{types.VoteOptionYes},
+			VoterType: storage.VoterTypeValidator,
+		}).
+		Return([]storage.Vote{
+			{
+				Id:          1,
+				Height:      100,
+				Time:        testTime,
+				Option:      types.VoteOptionYes,
+				Weight:      decimal.NewFromInt(1),
+				ProposalId:  1,
+				VoterId:     1,
+				ValidatorId: 1,
+				Voter: &storage.Address{
+					Address: testAddress,
+				},
+				Validator: &storage.Validator{
+					Id:          1,
+					ConsAddress: "E641C7A2C964833E556AEF934FBF166B712874B6",
+					Moniker:     "moniker",
+				},
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Votes(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var votes []responses.Vote
+	err := json.NewDecoder(rec.Body).Decode(&votes)
+	s.Require().NoError(err)
+	s.Require().Len(votes, 1)
+
+	v := votes[0]
+	s.Require().EqualValues(1, v.Id)
+	s.Require().Equal("yes", v.Option)
+	s.Require().Equal(testAddress, v.Voter)
+	s.Require().NotNil(v.Validator)
+	s.Require().Equal("moniker", v.Validator.Moniker)
+}
+
+func (s *ProposalTestSuite) TestDeposits() {
+	q := make(url.Values)
+	q.Set("limit", "10")
+	q.Set("offset", "0")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/proposal/:id/deposits")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	s.deposits.EXPECT().
+		ByProposalId(gomock.Any(), uint64(1), 10, 0, sdk.SortOrderDesc).
+		Return([]storage.Deposit{
+			{
+				Id:          1,
+				Height:      100,
+				Time:        testTime,
+				ProposalId:  1,
+				DepositorId: 1,
+				Amount:      decimal.RequireFromString("10000000000"),
+				Depositor: &storage.Address{
+					Address: testAddress,
+				},
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Deposits(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var deposits []responses.Deposit
+	err := json.NewDecoder(rec.Body).Decode(&deposits)
+	s.Require().NoError(err)
+	s.Require().Len(deposits, 1)
+
+	d := deposits[0]
+	s.Require().EqualValues(1, d.ProposalId)
+	s.Require().Equal("10000000000", d.Amount)
+	s.Require().Equal(testAddress, d.Depositor)
+}
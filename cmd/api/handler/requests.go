@@ -95,6 +95,7 @@ type addressTxRequest struct {
 	Height  uint64      `query:"height"   validate:"omitempty,min=1"`
 	Status  StringArray `query:"status"   validate:"omitempty,dive,status"`
 	MsgType StringArray `query:"msg_type" validate:"omitempty,dive,msg_type"`
+	Type    string      `query:"type"     validate:"omitempty,oneof=signed paid"`
 
 	From int64 `example:"1692892095" query:"from" swaggertype:"integer" validate:"omitempty,min=1"`
 	To   int64 `example:"1692892095" query:"to"   swaggertype:"integer" validate:"omitempty,min=1"`
@@ -17,6 +17,8 @@ type Address struct {
 	LastHeight pkgTypes.Level `example:"100"                                             json:"last_height"  swaggertype:"integer"`
 	Hash       string         `example:"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60" json:"hash"         swaggertype:"string"`
 	Balance    Balance        `json:"balance"`
+
+	InterchainAccount *InterchainAccount `json:"interchain_account,omitempty"`
 }
 
 func NewAddress(addr storage.Address) Address {
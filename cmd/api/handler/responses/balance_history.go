@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package responses
+
+import (
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+)
+
+// BalanceHistory model info
+//
+//	@Description	Change of address balance in the block
+type BalanceHistory struct {
+	Height          pkgTypes.Level `example:"100"                       json:"height"           swaggertype:"integer"`
+	Time            time.Time      `example:"2023-07-04T03:10:57+00:00" json:"time"             swaggertype:"string"`
+	Type            string         `example:"transfer"                  json:"type"             swaggertype:"string"`
+	Currency        string         `example:"utia"                      json:"currency"         swaggertype:"string"`
+	SpendableChange string         `example:"-10000"                    json:"spendable_change" swaggertype:"string"`
+	DelegatedChange string         `example:"0"                         json:"delegated_change" swaggertype:"string"`
+	UnbondingChange string         `example:"0"                         json:"unbonding_change" swaggertype:"string"`
+	Spendable       string         `example:"10000000000"               json:"spendable"        swaggertype:"string"`
+	Delegated       string         `example:"10000000000"               json:"delegated"        swaggertype:"string"`
+	Unbonding       string         `example:"10000000000"               json:"unbonding"        swaggertype:"string"`
+}
+
+func NewBalanceHistory(h storage.BalanceHistory) BalanceHistory {
+	return BalanceHistory{
+		Height:          h.Height,
+		Time:            h.Time,
+		Type:            h.Type.String(),
+		Currency:        h.Currency,
+		SpendableChange: h.SpendableChange.String(),
+		DelegatedChange: h.DelegatedChange.String(),
+		UnbondingChange: h.UnbondingChange.String(),
+		Spendable:       h.Spendable.String(),
+		Delegated:       h.Delegated.String(),
+		Unbonding:       h.Unbonding.String(),
+	}
+}
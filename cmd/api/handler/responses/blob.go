@@ -5,9 +5,11 @@ package responses
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"net/http"
 	"time"
 
+	"github.com/celenium-io/celestia-indexer/internal/blob/decoder"
 	"github.com/celenium-io/celestia-indexer/internal/storage"
 	"github.com/celenium-io/celestia-indexer/pkg/node/types"
 	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
@@ -19,6 +21,8 @@ type Blob struct {
 	ShareVersion int    `example:"0"                                            format:"integer" json:"share_version" swaggertype:"integer"`
 	Commitment   string `example:"vbGakK59+Non81TE3ULg5Ve5ufT9SFm/bCyY+WLR3gg=" format:"base64"  json:"commitment"    swaggertype:"string"`
 	ContentType  string `example:"image/png"                                    format:"string"  json:"content_type"  swaggertype:"string"`
+
+	Decoded *decoder.Result `json:"decoded,omitempty"`
 }
 
 func NewBlob(blob types.Blob) (Blob, error) {
@@ -37,16 +41,33 @@ func NewBlob(blob types.Blob) (Blob, error) {
 	return b, nil
 }
 
+type BlobBatchItem struct {
+	Hash       string         `example:"AAAAAAAAAAAAAAAAAAAAAAAAAAAAs2bWWU6FOB0="     format:"base64"  json:"hash"            swaggertype:"string"`
+	Height     pkgTypes.Level `example:"100"                                          format:"integer" json:"height"          swaggertype:"integer"`
+	Commitment string         `example:"vbGakK59+Non81TE3ULg5Ve5ufT9SFm/bCyY+WLR3gg=" format:"base64"  json:"commitment"      swaggertype:"string"`
+	Error      string         `example:"blob: not found"                              format:"string"  json:"error,omitempty" swaggertype:"string"`
+
+	Blob *Blob `json:"blob,omitempty"`
+}
+
 type BlobLog struct {
-	Commitment  string         `example:"vbGakK59+Non81TE3ULg5Ve5ufT9SFm/bCyY+WLR3gg="    format:"base64"    json:"commitment"       swaggertype:"string"`
-	Size        int64          `example:"10"                                              format:"integer"   json:"size"             swaggertype:"integer"`
-	Height      pkgTypes.Level `example:"100"                                             format:"integer"   json:"height"           swaggertype:"integer"`
-	Time        time.Time      `example:"2023-07-04T03:10:57+00:00"                       format:"date-time" json:"time"             swaggertype:"string"`
-	Signer      string         `example:"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60" format:"string"    json:"signer,omitempty" swaggertype:"string"`
-	ContentType string         `example:"image/png"                                       format:"string"    json:"content_type"     swaggertype:"string"`
-	Namespace   *Namespace     `json:"namespace,omitempty"`
-	Tx          *Tx            `json:"tx,omitempty"`
-	Rollup      *ShortRollup   `json:"rollup,omitempty"`
+	Commitment  string          `example:"vbGakK59+Non81TE3ULg5Ve5ufT9SFm/bCyY+WLR3gg="    format:"base64"    json:"commitment"        swaggertype:"string"`
+	Size        int64           `example:"10"                                              format:"integer"   json:"size"              swaggertype:"integer"`
+	Height      pkgTypes.Level  `example:"100"                                             format:"integer"   json:"height"            swaggertype:"integer"`
+	Time        time.Time       `example:"2023-07-04T03:10:57+00:00"                       format:"date-time" json:"time"              swaggertype:"string"`
+	Signer      string          `example:"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60" format:"string"    json:"signer,omitempty"  swaggertype:"string"`
+	ContentType string          `example:"image/png"                                       format:"string"    json:"content_type"      swaggertype:"string"`
+	ShareStart  int64           `example:"3"                                               format:"integer"   json:"share_start"       swaggertype:"integer"`
+	ShareCount  int64           `example:"2"                                               format:"integer"   json:"share_count"       swaggertype:"integer"`
+	StartRow    int64           `example:"0"                                               format:"integer"   json:"start_row"         swaggertype:"integer"`
+	StartCol    int64           `example:"3"                                               format:"integer"   json:"start_col"         swaggertype:"integer"`
+	EndRow      int64           `example:"1"                                               format:"integer"   json:"end_row"           swaggertype:"integer"`
+	EndCol      int64           `example:"0"                                               format:"integer"   json:"end_col"           swaggertype:"integer"`
+	Decoder     string          `example:"op_stack"                                        format:"string"    json:"decoder,omitempty" swaggertype:"string"`
+	Batch       json.RawMessage `json:"batch,omitempty" swaggertype:"object"`
+	Namespace   *Namespace      `json:"namespace,omitempty"`
+	Tx          *Tx             `json:"tx,omitempty"`
+	Rollup      *ShortRollup    `json:"rollup,omitempty"`
 }
 
 func NewBlobLog(blob storage.BlobLog) BlobLog {
@@ -56,6 +77,14 @@ func NewBlobLog(blob storage.BlobLog) BlobLog {
 		Height:      blob.Height,
 		Time:        blob.Time,
 		ContentType: blob.ContentType,
+		ShareStart:  blob.ShareStart,
+		ShareCount:  blob.ShareCount,
+		StartRow:    blob.StartRow,
+		StartCol:    blob.StartCol,
+		EndRow:      blob.EndRow,
+		EndCol:      blob.EndCol,
+		Decoder:     blob.Decoder,
+		Batch:       blob.Batch,
 		Rollup:      NewShortRollup(blob.Rollup),
 	}
 
@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package responses
+
+import (
+	"github.com/celenium-io/celestia-indexer/pkg/proof"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+// BlobProof - inclusion proof of blob. It can be decoded to proof.BlobProof and verified offline against data hash.
+type BlobProof struct {
+	ShareStart int          `example:"10"                                                               json:"share_start" swaggertype:"integer"`
+	ShareEnd   int          `example:"12"                                                               json:"share_end"   swaggertype:"integer"`
+	DataHash   pkgTypes.Hex `example:"BB5F7A5E0ED58CFA1A7E5BC4A1FB4E9C3EFD6C2F6A3D7A0C7E5B8E8A1D3C4B5A" json:"data_hash"   swaggertype:"string"`
+
+	Proof tmTypes.ShareProof `json:"proof"`
+}
+
+func NewBlobProof(p proof.BlobProof, dataHash pkgTypes.Hex) BlobProof {
+	return BlobProof{
+		ShareStart: p.ShareStart,
+		ShareEnd:   p.ShareEnd,
+		DataHash:   dataHash,
+		Proof:      p.Proof,
+	}
+}
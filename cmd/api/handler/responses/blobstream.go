@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package responses
+
+import (
+	"encoding/hex"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+)
+
+// BlobstreamAttestation model info
+//
+//	@Description	Blobstream attestation: data commitment over the range of blocks or validator set update
+type BlobstreamAttestation struct {
+	Id         uint64         `example:"321"                       json:"id"                    swaggertype:"integer"`
+	Height     pkgTypes.Level `example:"100"                       json:"height"                swaggertype:"integer"`
+	Time       time.Time      `example:"2023-07-04T03:10:57+00:00" json:"time"                  swaggertype:"string"`
+	Nonce      uint64         `example:"12"                        json:"nonce"                 swaggertype:"integer"`
+	Type       string         `example:"data_commitment"           json:"type"                  swaggertype:"string"`
+	BeginBlock pkgTypes.Level `example:"401"                       json:"begin_block,omitempty" swaggertype:"integer"`
+	EndBlock   pkgTypes.Level `example:"801"                       json:"end_block,omitempty"   swaggertype:"integer"`
+}
+
+func NewBlobstreamAttestation(a storage.BlobstreamAttestation) BlobstreamAttestation {
+	return BlobstreamAttestation{
+		Id:         a.Id,
+		Height:     a.Height,
+		Time:       a.Time,
+		Nonce:      a.Nonce,
+		Type:       a.Type.String(),
+		BeginBlock: a.BeginBlock,
+		EndBlock:   a.EndBlock,
+	}
+}
+
+// BlobstreamEvmAddress model info
+//
+//	@Description	EVM address registered by validator to sign blobstream attestations
+type BlobstreamEvmAddress struct {
+	Id         uint64          `example:"321"                                                              json:"id"                swaggertype:"integer"`
+	Height     pkgTypes.Level  `example:"100"                                                              json:"height"            swaggertype:"integer"`
+	Time       time.Time       `example:"2023-07-04T03:10:57+00:00"                                        json:"time"              swaggertype:"string"`
+	EvmAddress string          `example:"0xfDC46fBDd8AF50d9Bf7536Bf44ce8560E423352c"                       json:"evm_address"       swaggertype:"string"`
+	TxHash     string          `example:"652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF" json:"tx_hash,omitempty" swaggertype:"string"`
+	Validator  *ShortValidator `json:"validator,omitempty"`
+}
+
+func NewBlobstreamEvmAddress(a storage.BlobstreamEvmAddress) BlobstreamEvmAddress {
+	address := BlobstreamEvmAddress{
+		Id:         a.Id,
+		Height:     a.Height,
+		Time:       a.Time,
+		EvmAddress: a.EvmAddress,
+	}
+	if a.Validator != nil {
+		address.Validator = NewShortValidator(*a.Validator)
+	}
+	if a.Tx != nil {
+		address.TxHash = hex.EncodeToString(a.Tx.Hash)
+	}
+	return address
+}
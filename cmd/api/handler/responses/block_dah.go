@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package responses
+
+import (
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+)
+
+type BlockDah struct {
+	Height     uint64       `example:"100"                                                              json:"height"      swaggertype:"integer"`
+	Time       time.Time    `example:"2023-07-04T03:10:57+00:00"                                        json:"time"        swaggertype:"string"`
+	Hash       pkgTypes.Hex `example:"652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF" json:"hash"        swaggertype:"string"`
+	DataHash   pkgTypes.Hex `example:"652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF" json:"data_hash"   swaggertype:"string"`
+	Valid      bool         `example:"true"                                                             json:"valid"       swaggertype:"boolean"`
+	SquareSize int64        `example:"4"                                                                json:"square_size" swaggertype:"integer"`
+
+	RowRoots    []string `json:"row_roots"`
+	ColumnRoots []string `json:"column_roots"`
+}
+
+func NewBlockDah(dah storage.BlockDah) BlockDah {
+	return BlockDah{
+		Height:      uint64(dah.Height),
+		Time:        dah.Time,
+		Hash:        dah.Hash,
+		DataHash:    dah.DataHash,
+		Valid:       dah.Valid,
+		SquareSize:  dah.SquareSize,
+		RowRoots:    dah.RowRoots,
+		ColumnRoots: dah.ColumnRoots,
+	}
+}
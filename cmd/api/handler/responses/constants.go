@@ -66,15 +66,29 @@ func NewConstants(consts []storage.Constant, denomMetadata []storage.DenomMetada
 }
 
 type Enums struct {
-	Status      []string `json:"status"`
-	MessageType []string `json:"message_type"`
-	EventType   []string `json:"event_type"`
+	Status            []string `json:"status"`
+	MessageType       []string `json:"message_type"`
+	EventType         []string `json:"event_type"`
+	ProposalStatus    []string `json:"proposal_status"`
+	ProposalType      []string `json:"proposal_type"`
+	VoteOption        []string `json:"vote_option"`
+	IbcTransferStatus []string `json:"ibc_transfer_status"`
+	IbcChannelStatus  []string `json:"ibc_channel_status"`
+	AttestationType   []string `json:"attestation_type"`
+	UpgradeStatus     []string `json:"upgrade_status"`
 }
 
 func NewEnums() Enums {
 	return Enums{
-		Status:      types.StatusNames(),
-		MessageType: types.MsgTypeNames(),
-		EventType:   types.EventTypeNames(),
+		Status:            types.StatusNames(),
+		MessageType:       types.MsgTypeNames(),
+		EventType:         types.EventTypeNames(),
+		ProposalStatus:    types.ProposalStatusNames(),
+		ProposalType:      types.ProposalTypeNames(),
+		VoteOption:        types.VoteOptionNames(),
+		IbcTransferStatus: types.IbcTransferStatusNames(),
+		IbcChannelStatus:  types.IbcChannelStatusNames(),
+		AttestationType:   types.AttestationTypeNames(),
+		UpgradeStatus:     types.UpgradeStatusNames(),
 	}
 }
@@ -18,6 +18,8 @@ type Grant struct {
 	RevokeHeight  uint64     `example:"123123"                                          json:"revoke_height,omitempty" swaggertype:"integer"`
 	Height        uint64     `example:"123123"                                          json:"height"                  swaggertype:"integer"`
 	Time          time.Time  `example:"2023-07-04T03:10:57+00:00"                       json:"time"                    swaggertype:"string"`
+	SpendLimit    string     `example:"1000000"                                         json:"spend_limit,omitempty"   swaggertype:"string"`
+	Spent         string     `example:"1000"                                            json:"spent,omitempty"         swaggertype:"string"`
 
 	Params map[string]any `json:"params"`
 }
@@ -41,6 +43,12 @@ func NewGrant(g storage.Grant) Grant {
 	if g.RevokeHeight != nil {
 		grant.RevokeHeight = uint64(*g.RevokeHeight)
 	}
+	if g.SpendLimit != nil {
+		grant.SpendLimit = g.SpendLimit.String()
+	}
+	if !g.Spent.IsZero() {
+		grant.Spent = g.Spent.String()
+	}
 
 	return grant
 }
@@ -0,0 +1,197 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package responses
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+)
+
+// Group model info
+//
+//	@Description	Group of accounts of group module
+type Group struct {
+	Id           uint64         `example:"1"                                                                json:"id"                 swaggertype:"integer"`
+	Height       pkgTypes.Level `example:"100"                                                              json:"height"             swaggertype:"integer"`
+	Time         time.Time      `example:"2023-07-04T03:10:57+00:00"                                        json:"time"               swaggertype:"string"`
+	Admin        string         `example:"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"                  json:"admin,omitempty"    swaggertype:"string"`
+	Metadata     string         `example:"ipfs://CID"                                                       json:"metadata,omitempty" swaggertype:"string"`
+	TotalWeight  string         `example:"10"                                                               json:"total_weight"       swaggertype:"string"`
+	MembersCount int64          `example:"3"                                                                json:"members_count"      swaggertype:"integer"`
+	TxHash       string         `example:"652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF" json:"tx_hash,omitempty"  swaggertype:"string"`
+}
+
+func NewGroup(g storage.Group) Group {
+	group := Group{
+		Id:           g.Id,
+		Height:       g.Height,
+		Time:         g.Time,
+		Metadata:     g.Metadata,
+		TotalWeight:  g.TotalWeight.String(),
+		MembersCount: g.MembersCount,
+	}
+	if g.Admin != nil {
+		group.Admin = g.Admin.Address
+	}
+	if g.Tx != nil {
+		group.TxHash = hex.EncodeToString(g.Tx.Hash)
+	}
+	return group
+}
+
+// GroupMember model info
+//
+//	@Description	Current member of group
+type GroupMember struct {
+	Height   pkgTypes.Level `example:"100"                                                              json:"height"             swaggertype:"integer"`
+	Time     time.Time      `example:"2023-07-04T03:10:57+00:00"                                        json:"time"               swaggertype:"string"`
+	Address  string         `example:"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"                  json:"address"            swaggertype:"string"`
+	Weight   string         `example:"1"                                                                json:"weight"             swaggertype:"string"`
+	Metadata string         `example:"member"                                                           json:"metadata,omitempty" swaggertype:"string"`
+	TxHash   string         `example:"652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF" json:"tx_hash,omitempty"  swaggertype:"string"`
+}
+
+func NewGroupMember(m storage.GroupMember) GroupMember {
+	member := GroupMember{
+		Height:   m.Height,
+		Time:     m.Time,
+		Weight:   m.Weight.String(),
+		Metadata: m.Metadata,
+	}
+	if m.Address != nil {
+		member.Address = m.Address.Address
+	}
+	if m.Tx != nil {
+		member.TxHash = hex.EncodeToString(m.Tx.Hash)
+	}
+	return member
+}
+
+// GroupPolicy model info
+//
+//	@Description	Group policy account with decision policy
+type GroupPolicy struct {
+	Address            string         `example:"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"                  json:"address"                        swaggertype:"string"`
+	GroupId            uint64         `example:"1"                                                                json:"group_id"                       swaggertype:"integer"`
+	Height             pkgTypes.Level `example:"100"                                                              json:"height"                         swaggertype:"integer"`
+	Time               time.Time      `example:"2023-07-04T03:10:57+00:00"                                        json:"time"                           swaggertype:"string"`
+	Admin              string         `example:"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"                  json:"admin,omitempty"                swaggertype:"string"`
+	Metadata           string         `example:"ipfs://CID"                                                       json:"metadata,omitempty"             swaggertype:"string"`
+	DecisionPolicyType string         `example:"threshold"                                                        json:"decision_policy_type,omitempty" swaggertype:"string"`
+	Threshold          string         `example:"2"                                                                json:"threshold"                      swaggertype:"string"`
+	VotingPeriod       int64          `example:"86400"                                                            json:"voting_period"                  swaggertype:"integer"`
+	MinExecutionPeriod int64          `example:"0"                                                                json:"min_execution_period"           swaggertype:"integer"`
+	TxHash             string         `example:"652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF" json:"tx_hash,omitempty"              swaggertype:"string"`
+}
+
+func NewGroupPolicy(p storage.GroupPolicy) GroupPolicy {
+	policy := GroupPolicy{
+		GroupId:            p.GroupId,
+		Height:             p.Height,
+		Time:               p.Time,
+		Metadata:           p.Metadata,
+		DecisionPolicyType: p.DecisionPolicyType.String(),
+		Threshold:          p.Threshold.String(),
+		VotingPeriod:       p.VotingPeriod,
+		MinExecutionPeriod: p.MinExecutionPeriod,
+	}
+	if p.Address != nil {
+		policy.Address = p.Address.Address
+	}
+	if p.Admin != nil {
+		policy.Admin = p.Admin.Address
+	}
+	if p.Tx != nil {
+		policy.TxHash = hex.EncodeToString(p.Tx.Hash)
+	}
+	return policy
+}
+
+// GroupProposal model info
+//
+//	@Description	Proposal of group policy
+type GroupProposal struct {
+	Id             uint64          `example:"1"                                                                json:"id"                        swaggertype:"integer"`
+	Height         pkgTypes.Level  `example:"100"                                                              json:"height"                    swaggertype:"integer"`
+	Time           time.Time       `example:"2023-07-04T03:10:57+00:00"                                        json:"time"                      swaggertype:"string"`
+	PolicyAddress  string          `example:"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"                  json:"policy_address"            swaggertype:"string"`
+	Proposers      []string        `json:"proposers"                                                           swaggertype:"array,string"`
+	Metadata       string          `example:"ipfs://CID"                                                       json:"metadata,omitempty"        swaggertype:"string"`
+	Status         string          `example:"submitted"                                                        json:"status"                    swaggertype:"string"`
+	ExecutorResult string          `example:"not_run"                                                          json:"executor_result,omitempty" swaggertype:"string"`
+	EndHeight      pkgTypes.Level  `example:"100"                                                              json:"end_height,omitempty"      swaggertype:"integer"`
+	EndTime        *time.Time      `example:"2023-07-04T03:10:57+00:00"                                        json:"end_time,omitempty"        swaggertype:"string"`
+	VotesCount     int64           `example:"100"                                                              json:"votes_count"               swaggertype:"integer"`
+	Yes            int64           `example:"100"                                                              json:"yes"                       swaggertype:"integer"`
+	No             int64           `example:"100"                                                              json:"no"                        swaggertype:"integer"`
+	NoWithVeto     int64           `example:"100"                                                              json:"no_with_veto"              swaggertype:"integer"`
+	Abstain        int64           `example:"100"                                                              json:"abstain"                   swaggertype:"integer"`
+	TxHash         string          `example:"652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF" json:"tx_hash,omitempty"         swaggertype:"string"`
+	Messages       json.RawMessage `json:"messages,omitempty"                                                  swaggertype:"string"`
+}
+
+func NewGroupProposal(p storage.GroupProposal) GroupProposal {
+	proposal := GroupProposal{
+		Id:             p.Id,
+		Height:         p.Height,
+		Time:           p.Time,
+		Proposers:      p.Proposers,
+		Metadata:       p.Metadata,
+		Status:         p.Status.String(),
+		ExecutorResult: p.ExecutorResult.String(),
+		EndTime:        p.EndTime,
+		VotesCount:     p.VotesCount,
+		Yes:            p.Yes,
+		No:             p.No,
+		NoWithVeto:     p.NoWithVeto,
+		Abstain:        p.Abstain,
+		Messages:       p.Messages,
+	}
+	if p.PolicyAddress != nil {
+		proposal.PolicyAddress = p.PolicyAddress.Address
+	}
+	if p.EndHeight != nil {
+		proposal.EndHeight = *p.EndHeight
+	}
+	if p.Tx != nil {
+		proposal.TxHash = hex.EncodeToString(p.Tx.Hash)
+	}
+	return proposal
+}
+
+// GroupVote model info
+//
+//	@Description	Vote of group member for group proposal
+type GroupVote struct {
+	Id         uint64         `example:"1"                                                                json:"id"                 swaggertype:"integer"`
+	Height     pkgTypes.Level `example:"100"                                                              json:"height"             swaggertype:"integer"`
+	Time       time.Time      `example:"2023-07-04T03:10:57+00:00"                                        json:"time"               swaggertype:"string"`
+	ProposalId uint64         `example:"1"                                                                json:"proposal_id"        swaggertype:"integer"`
+	Option     string         `example:"yes"                                                              json:"option"             swaggertype:"string"`
+	Metadata   string         `example:"ipfs://CID"                                                       json:"metadata,omitempty" swaggertype:"string"`
+	Voter      string         `example:"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"                  json:"voter"              swaggertype:"string"`
+	TxHash     string         `example:"652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF" json:"tx_hash,omitempty"  swaggertype:"string"`
+}
+
+func NewGroupVote(v storage.GroupVote) GroupVote {
+	vote := GroupVote{
+		Id:         v.Id,
+		Height:     v.Height,
+		Time:       v.Time,
+		ProposalId: v.ProposalId,
+		Option:     v.Option.String(),
+		Metadata:   v.Metadata,
+	}
+	if v.Voter != nil {
+		vote.Voter = v.Voter.Address
+	}
+	if v.Tx != nil {
+		vote.TxHash = hex.EncodeToString(v.Tx.Hash)
+	}
+	return vote
+}
@@ -0,0 +1,179 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package responses
+
+import (
+	"encoding/hex"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+)
+
+type IbcClient struct {
+	Id        string    `example:"07-tendermint-0"                                 json:"id"                swaggertype:"string"`
+	Type      string    `example:"07-tendermint"                                   json:"type"              swaggertype:"string"`
+	ChainId   string    `example:"osmosis-1"                                       json:"chain_id"          swaggertype:"string"`
+	Height    uint64    `example:"100"                                             json:"height"            swaggertype:"integer"`
+	CreatedAt time.Time `example:"2023-07-04T03:10:57+00:00"                       json:"created_at"        swaggertype:"string"`
+	Creator   string    `example:"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60" json:"creator,omitempty" swaggertype:"string"`
+}
+
+func NewIbcClient(c storage.IbcClient) IbcClient {
+	client := IbcClient{
+		Id:        c.Id,
+		Type:      c.Type,
+		ChainId:   c.ChainId,
+		Height:    uint64(c.Height),
+		CreatedAt: c.CreatedAt,
+	}
+	if c.Creator != nil {
+		client.Creator = c.Creator.Address
+	}
+	return client
+}
+
+type IbcConnection struct {
+	Id                       string     `example:"connection-0"              json:"id"                          swaggertype:"string"`
+	ClientId                 string     `example:"07-tendermint-0"           json:"client_id"                   swaggertype:"string"`
+	ChainId                  string     `example:"osmosis-1"                 json:"chain_id,omitempty"          swaggertype:"string"`
+	CounterpartyConnectionId string     `example:"connection-1"              json:"counterparty_connection_id"  swaggertype:"string"`
+	CounterpartyClientId     string     `example:"07-tendermint-1"           json:"counterparty_client_id"      swaggertype:"string"`
+	Height                   uint64     `example:"100"                       json:"height"                      swaggertype:"integer"`
+	CreatedAt                time.Time  `example:"2023-07-04T03:10:57+00:00" json:"created_at"                  swaggertype:"string"`
+	ConnectionHeight         uint64     `example:"101"                       json:"connection_height,omitempty" swaggertype:"integer"`
+	ConnectedAt              *time.Time `example:"2023-07-04T03:10:57+00:00" json:"connected_at,omitempty"      swaggertype:"string"`
+}
+
+func NewIbcConnection(c storage.IbcConnection) IbcConnection {
+	conn := IbcConnection{
+		Id:                       c.Id,
+		ClientId:                 c.ClientId,
+		CounterpartyConnectionId: c.CounterpartyConnectionId,
+		CounterpartyClientId:     c.CounterpartyClientId,
+		Height:                   uint64(c.Height),
+		CreatedAt:                c.CreatedAt,
+		ConnectedAt:              c.ConnectedAt,
+	}
+	if c.ConnectionHeight != nil {
+		conn.ConnectionHeight = uint64(*c.ConnectionHeight)
+	}
+	if c.Client != nil {
+		conn.ChainId = c.Client.ChainId
+	}
+	return conn
+}
+
+type IbcChannel struct {
+	Id                    string     `example:"channel-0"                 json:"id"                            swaggertype:"string"`
+	PortId                string     `example:"transfer"                  json:"port_id"                       swaggertype:"string"`
+	CounterpartyPortId    string     `example:"transfer"                  json:"counterparty_port_id"          swaggertype:"string"`
+	CounterpartyChannelId string     `example:"channel-1"                 json:"counterparty_channel_id"       swaggertype:"string"`
+	ConnectionId          string     `example:"connection-0"              json:"connection_id"                 swaggertype:"string"`
+	ClientId              string     `example:"07-tendermint-0"           json:"client_id,omitempty"           swaggertype:"string"`
+	ChainId               string     `example:"osmosis-1"                 json:"chain_id,omitempty"            swaggertype:"string"`
+	Version               string     `example:"ics20-1"                   json:"version"                       swaggertype:"string"`
+	Height                uint64     `example:"100"                       json:"height"                        swaggertype:"integer"`
+	CreatedAt             time.Time  `example:"2023-07-04T03:10:57+00:00" json:"created_at"                    swaggertype:"string"`
+	ConfirmationHeight    uint64     `example:"101"                       json:"confirmation_height,omitempty" swaggertype:"integer"`
+	ConfirmedAt           *time.Time `example:"2023-07-04T03:10:57+00:00" json:"confirmed_at,omitempty"        swaggertype:"string"`
+	Status                string     `example:"opened"                    json:"status"                        swaggertype:"string"`
+}
+
+func NewIbcChannel(c storage.IbcChannel) IbcChannel {
+	channel := IbcChannel{
+		Id:                    c.Id,
+		PortId:                c.PortId,
+		CounterpartyPortId:    c.CounterpartyPortId,
+		CounterpartyChannelId: c.CounterpartyChannelId,
+		ConnectionId:          c.ConnectionId,
+		Version:               c.Version,
+		Height:                uint64(c.Height),
+		CreatedAt:             c.CreatedAt,
+		ConfirmedAt:           c.ConfirmedAt,
+		Status:                c.Status.String(),
+	}
+	if c.ConfirmationHeight != nil {
+		channel.ConfirmationHeight = uint64(*c.ConfirmationHeight)
+	}
+	if c.Connection != nil {
+		channel.ClientId = c.Connection.ClientId
+		if c.Connection.Client != nil {
+			channel.ChainId = c.Connection.Client.ChainId
+		}
+	}
+	return channel
+}
+
+type IbcTransfer struct {
+	Id                    uint64     `example:"1"                                                                json:"id"                         swaggertype:"integer"`
+	Height                uint64     `example:"100"                                                              json:"height"                     swaggertype:"integer"`
+	Time                  time.Time  `example:"2023-07-04T03:10:57+00:00"                                        json:"time"                       swaggertype:"string"`
+	Timeout               *time.Time `example:"2023-07-04T03:10:57+00:00"                                        json:"timeout,omitempty"          swaggertype:"string"`
+	ChannelId             string     `example:"channel-0"                                                        json:"channel_id"                 swaggertype:"string"`
+	Port                  string     `example:"transfer"                                                         json:"port"                       swaggertype:"string"`
+	CounterpartyChannelId string     `example:"channel-1"                                                        json:"counterparty_channel_id"    swaggertype:"string"`
+	CounterpartyPort      string     `example:"transfer"                                                         json:"counterparty_port"          swaggertype:"string"`
+	ConnectionId          string     `example:"connection-0"                                                     json:"connection_id"              swaggertype:"string"`
+	ChainId               string     `example:"osmosis-1"                                                        json:"chain_id,omitempty"         swaggertype:"string"`
+	Sequence              uint64     `example:"1"                                                                json:"sequence"                   swaggertype:"integer"`
+	Amount                string     `example:"1000000"                                                          json:"amount"                     swaggertype:"string"`
+	Denom                 string     `example:"utia"                                                             json:"denom"                      swaggertype:"string"`
+	Memo                  string     `example:"memo"                                                             json:"memo,omitempty"             swaggertype:"string"`
+	Sender                string     `example:"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"                  json:"sender"                     swaggertype:"string"`
+	Receiver              string     `example:"osmo1jc92qdnty48pafummfr8ava2tjtuhfdw7hmxvk"                      json:"receiver"                   swaggertype:"string"`
+	Status                string     `example:"sent"                                                             json:"status"                     swaggertype:"string"`
+	CompletedHeight       uint64     `example:"101"                                                              json:"completed_height,omitempty" swaggertype:"integer"`
+	CompletedAt           *time.Time `example:"2023-07-04T03:10:57+00:00"                                        json:"completed_at,omitempty"     swaggertype:"string"`
+	TxHash                string     `example:"652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF" json:"tx_hash,omitempty"          swaggertype:"string"`
+}
+
+func NewIbcTransfer(t storage.IbcTransfer) IbcTransfer {
+	transfer := IbcTransfer{
+		Id:                    t.Id,
+		Height:                uint64(t.Height),
+		Time:                  t.Time,
+		Timeout:               t.Timeout,
+		ChannelId:             t.ChannelId,
+		Port:                  t.Port,
+		CounterpartyChannelId: t.CounterpartyChannelId,
+		CounterpartyPort:      t.CounterpartyPort,
+		ConnectionId:          t.ConnectionId,
+		Sequence:              t.Sequence,
+		Amount:                t.Amount.String(),
+		Denom:                 t.Denom,
+		Memo:                  t.Memo,
+		Sender:                t.Sender,
+		Receiver:              t.Receiver,
+		Status:                t.Status.String(),
+		CompletedAt:           t.CompletedAt,
+	}
+	if t.CompletedHeight != nil {
+		transfer.CompletedHeight = uint64(*t.CompletedHeight)
+	}
+	if t.Tx != nil {
+		transfer.TxHash = hex.EncodeToString(t.Tx.Hash)
+	}
+	if t.Connection != nil && t.Connection.Client != nil {
+		transfer.ChainId = t.Connection.Client.ChainId
+	}
+	return transfer
+}
+
+type IbcChainStats struct {
+	ChainId       string `example:"osmosis-1" json:"chain_id"       swaggertype:"string"`
+	SentCount     int64  `example:"100"       json:"sent_count"     swaggertype:"integer"`
+	ReceivedCount int64  `example:"100"       json:"received_count" swaggertype:"integer"`
+	Sent          string `example:"1000000"   json:"sent"           swaggertype:"string"`
+	Received      string `example:"1000000"   json:"received"       swaggertype:"string"`
+}
+
+func NewIbcChainStats(s storage.IbcChainStats) IbcChainStats {
+	return IbcChainStats{
+		ChainId:       s.ChainId,
+		SentCount:     s.SentCount,
+		ReceivedCount: s.ReceivedCount,
+		Sent:          s.Sent.String(),
+		Received:      s.Received.String(),
+	}
+}
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package responses
+
+import (
+	"encoding/hex"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+)
+
+type InterchainAccount struct {
+	Owner                  string    `example:"osmo1jc92qdnty48pafummfr8ava2tjtuhfdw7hmxvk" json:"owner"                    swaggertype:"string"`
+	ConnectionId           string    `example:"connection-0"                                json:"connection_id"            swaggertype:"string"`
+	ControllerConnectionId string    `example:"connection-1"                                json:"controller_connection_id" swaggertype:"string"`
+	ChainId                string    `example:"osmosis-1"                                   json:"chain_id,omitempty"       swaggertype:"string"`
+	ChannelId              string    `example:"channel-0"                                   json:"channel_id"               swaggertype:"string"`
+	CounterpartyChannelId  string    `example:"channel-1"                                   json:"counterparty_channel_id"  swaggertype:"string"`
+	Height                 uint64    `example:"100"                                         json:"height"                   swaggertype:"integer"`
+	CreatedAt              time.Time `example:"2023-07-04T03:10:57+00:00"                   json:"created_at"               swaggertype:"string"`
+}
+
+func NewInterchainAccount(a storage.InterchainAccount) *InterchainAccount {
+	account := &InterchainAccount{
+		Owner:                  a.Owner,
+		ConnectionId:           a.ConnectionId,
+		ControllerConnectionId: a.ControllerConnectionId,
+		ChannelId:              a.ChannelId,
+		CounterpartyChannelId:  a.CounterpartyChannelId,
+		Height:                 uint64(a.Height),
+		CreatedAt:              a.CreatedAt,
+	}
+	if a.Connection != nil && a.Connection.Client != nil {
+		account.ChainId = a.Connection.Client.ChainId
+	}
+	return account
+}
+
+type InterchainAccountPacket struct {
+	Id        uint64    `example:"1"                                                                json:"id"                swaggertype:"integer"`
+	Height    uint64    `example:"100"                                                              json:"height"            swaggertype:"integer"`
+	Time      time.Time `example:"2023-07-04T03:10:57+00:00"                                        json:"time"              swaggertype:"string"`
+	ChannelId string    `example:"channel-0"                                                        json:"channel_id"        swaggertype:"string"`
+	Sequence  uint64    `example:"1"                                                                json:"sequence"          swaggertype:"integer"`
+	Memo      string    `example:"memo"                                                             json:"memo,omitempty"    swaggertype:"string"`
+	Messages  []string  `example:"/cosmos.staking.v1beta1.MsgDelegate"                              json:"messages"          swaggertype:"array,string"`
+	Success   bool      `example:"true"                                                             json:"success"           swaggertype:"boolean"`
+	Error     string    `example:"out of gas"                                                       json:"error,omitempty"   swaggertype:"string"`
+	TxHash    string    `example:"652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF" json:"tx_hash,omitempty" swaggertype:"string"`
+}
+
+func NewInterchainAccountPacket(p storage.InterchainAccountPacket) InterchainAccountPacket {
+	packet := InterchainAccountPacket{
+		Id:        p.Id,
+		Height:    uint64(p.Height),
+		Time:      p.Time,
+		ChannelId: p.ChannelId,
+		Sequence:  p.Sequence,
+		Memo:      p.Memo,
+		Messages:  p.Messages,
+		Success:   p.Success,
+		Error:     p.Error,
+	}
+	if p.Tx != nil {
+		packet.TxHash = hex.EncodeToString(p.Tx.Hash)
+	}
+	return packet
+}
@@ -18,25 +18,46 @@ type Message struct {
 	Position int64          `example:"2"                         format:"int64"     json:"position"        swaggertype:"integer"`
 	Size     int            `example:"2"                         format:"int"       json:"size"            swaggertype:"integer"`
 	TxId     uint64         `example:"11"                        format:"int64"     json:"tx_id,omitempty" swaggertype:"integer"`
+	ParentId *uint64        `example:"320"                       format:"int64"     json:"parent_id,omitempty" swaggertype:"integer"`
 
 	Type types.MsgType `example:"MsgCreatePeriodicVestingAccount" json:"type"`
 
 	Data map[string]any `json:"data"`
 
-	Tx *Tx `json:"tx,omitempty"`
+	Tx           *Tx       `json:"tx,omitempty"`
+	InternalMsgs []Message `json:"internal_msgs,omitempty"`
+
+	Addresses  []string `json:"-"`
+	Namespaces []string `json:"-"`
 }
 
 func NewMessage(msg storage.Message) Message {
-	return Message{
+	message := Message{
 		Id:       msg.Id,
 		Height:   msg.Height,
 		Time:     msg.Time,
 		Position: msg.Position,
 		Type:     msg.Type,
 		TxId:     msg.TxId,
+		ParentId: msg.ParentId,
 		Size:     msg.Size,
 		Data:     msg.Data,
 	}
+
+	if len(msg.Addresses) > 0 {
+		message.Addresses = make([]string, len(msg.Addresses))
+		for i := range msg.Addresses {
+			message.Addresses[i] = msg.Addresses[i].Address.Address
+		}
+	}
+	if len(msg.Namespace) > 0 {
+		message.Namespaces = make([]string, len(msg.Namespace))
+		for i := range msg.Namespace {
+			message.Namespaces[i] = msg.Namespace[i].Hash()
+		}
+	}
+
+	return message
 }
 
 func NewMessageWithTx(msg storage.MessageWithTx) Message {
@@ -47,6 +68,7 @@ func NewMessageWithTx(msg storage.MessageWithTx) Message {
 		Position: msg.Position,
 		Type:     msg.Type,
 		TxId:     msg.TxId,
+		ParentId: msg.ParentId,
 		Size:     msg.Size,
 		Data:     msg.Data,
 	}
@@ -66,6 +88,7 @@ type MessageForAddress struct {
 	Position int64          `example:"2"                         format:"int64"     json:"position"        swaggertype:"integer"`
 	Size     int            `example:"2"                         format:"int"       json:"size"            swaggertype:"integer"`
 	TxId     uint64         `example:"11"                        format:"int64"     json:"tx_id,omitempty" swaggertype:"integer"`
+	ParentId *uint64        `example:"320"                       format:"int64"     json:"parent_id,omitempty" swaggertype:"integer"`
 
 	Type           types.MsgType        `example:"MsgCreatePeriodicVestingAccount" json:"type"`
 	InvocationType types.MsgAddressType `example:"fromAddress"                     json:"invocation_type"`
@@ -81,6 +104,7 @@ func NewMessageForAddress(msg storage.AddressMessageWithTx) MessageForAddress {
 		Time:           msg.Msg.Time,
 		Position:       msg.Msg.Position,
 		TxId:           msg.Msg.TxId,
+		ParentId:       msg.Msg.ParentId,
 		Type:           msg.Msg.Type,
 		Size:           msg.Msg.Size,
 		Data:           msg.Msg.Data,
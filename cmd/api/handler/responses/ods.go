@@ -6,6 +6,7 @@ package responses
 import (
 	"encoding/base64"
 
+	"github.com/celenium-io/celestia-indexer/internal/storage"
 	"github.com/celestiaorg/go-square/namespace"
 	"github.com/celestiaorg/go-square/shares"
 	"github.com/celestiaorg/rsmt2d"
@@ -18,21 +19,36 @@ type ODS struct {
 }
 
 type ODSItem struct {
-	From      []uint        `json:"from"`
-	To        []uint        `json:"to"`
-	Namespace string        `json:"namespace"`
-	Type      NamespaceKind `json:"type"`
+	From       []uint        `json:"from"`
+	To         []uint        `json:"to"`
+	Namespace  string        `json:"namespace"`
+	Type       NamespaceKind `json:"type"`
+	Commitment string        `json:"commitment,omitempty"`
 }
 
-func NewODS(eds *rsmt2d.ExtendedDataSquare) (ODS, error) {
+// NewODS - splits original data square into items by namespaces. Shares of blobs are grouped by blob commitment.
+// Blobs should be sorted by share start index.
+func NewODS(eds *rsmt2d.ExtendedDataSquare, blobs []storage.BlobLog) (ODS, error) {
 	ods := ODS{
 		Width: eds.Width() / 2,
 		Items: make([]ODSItem, 0),
 	}
 
-	var current ODSItem
+	var (
+		current ODSItem
+		blobIdx int
+	)
 	for i := uint(0); i < ods.Width; i++ {
 		for j := uint(0); j < ods.Width; j++ {
+			shareIdx := int64(i*ods.Width + j)
+			for blobIdx < len(blobs) && blobs[blobIdx].ShareStart+blobs[blobIdx].ShareCount <= shareIdx {
+				blobIdx++
+			}
+			var commitment string
+			if blobIdx < len(blobs) && blobs[blobIdx].ShareStart <= shareIdx {
+				commitment = blobs[blobIdx].Commitment
+			}
+
 			cell := eds.GetCell(i, j)
 			share, err := shares.NewShare(cell)
 			if err != nil {
@@ -43,14 +59,15 @@ func NewODS(eds *rsmt2d.ExtendedDataSquare) (ODS, error) {
 				return ods, err
 			}
 			base64Namespace := base64.StdEncoding.EncodeToString(namespace.Bytes())
-			if base64Namespace != current.Namespace {
+			if base64Namespace != current.Namespace || commitment != current.Commitment {
 				if current.Namespace != "" {
 					ods.Items = append(ods.Items, current)
 				}
 				current = ODSItem{
-					From:      []uint{i, j},
-					Namespace: base64Namespace,
-					Type:      getNamespaceType(namespace),
+					From:       []uint{i, j},
+					Namespace:  base64Namespace,
+					Type:       getNamespaceType(namespace),
+					Commitment: commitment,
 				}
 			}
 			current.To = []uint{i, j}
@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package responses
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+)
+
+type Proposal struct {
+	Id               uint64     `example:"1"                                               json:"id"                          swaggertype:"integer"`
+	Height           uint64     `example:"100"                                             json:"height"                      swaggertype:"integer"`
+	Proposer         string     `example:"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60" json:"proposer,omitempty"          swaggertype:"string"`
+	CreatedAt        time.Time  `example:"2023-07-04T03:10:57+00:00"                       json:"created_at"                  swaggertype:"string"`
+	ActivationHeight uint64     `example:"100"                                             json:"activation_height,omitempty" swaggertype:"integer"`
+	ActivationTime   *time.Time `example:"2023-07-04T03:10:57+00:00"                       json:"activation_time,omitempty"   swaggertype:"string"`
+	EndHeight        uint64     `example:"100"                                             json:"end_height,omitempty"        swaggertype:"integer"`
+	EndTime          *time.Time `example:"2023-07-04T03:10:57+00:00"                       json:"end_time,omitempty"          swaggertype:"string"`
+	Status           string     `example:"active"                                          json:"status"                      swaggertype:"string"`
+	Type             string     `example:"text"                                            json:"type"                        swaggertype:"string"`
+	Title            string     `example:"Proposal title"                                  json:"title"                       swaggertype:"string"`
+	Description      string     `example:"Proposal description"                            json:"description,omitempty"       swaggertype:"string"`
+	Metadata         string     `example:"ipfs://CID"                                      json:"metadata,omitempty"          swaggertype:"string"`
+	Deposit          string     `example:"1000000"                                         json:"deposit"                     swaggertype:"string"`
+	VotesCount       int64      `example:"100"                                             json:"votes_count"                 swaggertype:"integer"`
+	Yes              int64      `example:"100"                                             json:"yes"                         swaggertype:"integer"`
+	No               int64      `example:"100"                                             json:"no"                          swaggertype:"integer"`
+	NoWithVeto       int64      `example:"100"                                             json:"no_with_veto"                swaggertype:"integer"`
+	Abstain          int64      `example:"100"                                             json:"abstain"                     swaggertype:"integer"`
+
+	Changes json.RawMessage `json:"changes,omitempty" swaggertype:"string"`
+}
+
+func NewProposal(p storage.Proposal) Proposal {
+	proposal := Proposal{
+		Id:             p.Id,
+		Height:         uint64(p.Height),
+		CreatedAt:      p.CreatedAt,
+		ActivationTime: p.ActivationTime,
+		EndTime:        p.EndTime,
+		Status:         p.Status.String(),
+		Type:           p.Type.String(),
+		Title:          p.Title,
+		Description:    p.Description,
+		Metadata:       p.Metadata,
+		Deposit:        p.Deposit.String(),
+		VotesCount:     p.VotesCount,
+		Yes:            p.Yes,
+		No:             p.No,
+		NoWithVeto:     p.NoWithVeto,
+		Abstain:        p.Abstain,
+		Changes:        p.Changes,
+	}
+
+	if p.Proposer != nil {
+		proposal.Proposer = p.Proposer.Address
+	}
+	if p.ActivationHeight != nil {
+		proposal.ActivationHeight = uint64(*p.ActivationHeight)
+	}
+	if p.EndHeight != nil {
+		proposal.EndHeight = uint64(*p.EndHeight)
+	}
+
+	return proposal
+}
+
+type Vote struct {
+	Id         uint64    `example:"1"                                               json:"id"          swaggertype:"integer"`
+	Height     uint64    `example:"100"                                             json:"height"      swaggertype:"integer"`
+	Time       time.Time `example:"2023-07-04T03:10:57+00:00"                       json:"time"        swaggertype:"string"`
+	ProposalId uint64    `example:"1"                                               json:"proposal_id" swaggertype:"integer"`
+	Option     string    `example:"yes"                                             json:"option"      swaggertype:"string"`
+	Weight     string    `example:"1"                                               json:"weight"      swaggertype:"string"`
+	Voter      string    `example:"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60" json:"voter"       swaggertype:"string"`
+
+	Validator *ShortValidator `json:"validator,omitempty"`
+}
+
+func NewVote(v storage.Vote) Vote {
+	vote := Vote{
+		Id:         v.Id,
+		Height:     uint64(v.Height),
+		Time:       v.Time,
+		ProposalId: v.ProposalId,
+		Option:     v.Option.String(),
+		Weight:     v.Weight.String(),
+	}
+
+	if v.Voter != nil {
+		vote.Voter = v.Voter.Address
+	}
+	if v.Validator != nil {
+		vote.Validator = NewShortValidator(*v.Validator)
+	}
+
+	return vote
+}
+
+type Deposit struct {
+	Id         uint64    `example:"1"                                               json:"id"          swaggertype:"integer"`
+	Height     uint64    `example:"100"                                             json:"height"      swaggertype:"integer"`
+	Time       time.Time `example:"2023-07-04T03:10:57+00:00"                       json:"time"        swaggertype:"string"`
+	ProposalId uint64    `example:"1"                                               json:"proposal_id" swaggertype:"integer"`
+	Amount     string    `example:"1000000"                                         json:"amount"      swaggertype:"string"`
+	Depositor  string    `example:"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60" json:"depositor"   swaggertype:"string"`
+}
+
+func NewDeposit(d storage.Deposit) Deposit {
+	deposit := Deposit{
+		Id:         d.Id,
+		Height:     uint64(d.Height),
+		Time:       d.Time,
+		ProposalId: d.ProposalId,
+		Amount:     d.Amount.String(),
+	}
+
+	if d.Depositor != nil {
+		deposit.Depositor = d.Depositor.Address
+	}
+
+	return deposit
+}
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package responses
+
+import (
+	"encoding/hex"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+)
+
+// Slash model info
+//
+//	@Description	Validator slashing for double sign or downtime
+type Slash struct {
+	Id               uint64         `example:"321"                                                              json:"id"                swaggertype:"integer"`
+	Height           pkgTypes.Level `example:"100"                                                              json:"height"            swaggertype:"integer"`
+	Time             time.Time      `example:"2023-07-04T03:10:57+00:00"                                        json:"time"              swaggertype:"string"`
+	InfractionHeight pkgTypes.Level `example:"98"                                                               json:"infraction_height" swaggertype:"integer"`
+	Reason           string         `example:"double_sign"                                                      json:"reason"            swaggertype:"string"`
+	Power            string         `example:"1000"                                                             json:"power"             swaggertype:"string"`
+	Fraction         string         `example:"0.02"                                                             json:"fraction"          swaggertype:"string"`
+	Amount           string         `example:"10000000000"                                                      json:"amount"            swaggertype:"string"`
+	Jailed           bool           `example:"true"                                                             json:"jailed"            swaggertype:"boolean"`
+	TxHash           string         `example:"652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF" json:"tx_hash,omitempty" swaggertype:"string"`
+}
+
+func NewSlash(s storage.Slash) Slash {
+	slash := Slash{
+		Id:               s.Id,
+		Height:           s.Height,
+		Time:             s.Time,
+		InfractionHeight: s.InfractionHeight,
+		Reason:           s.Reason,
+		Power:            s.Power.String(),
+		Fraction:         s.Fraction.String(),
+		Amount:           s.Amount.String(),
+		Jailed:           s.JailId != nil,
+	}
+	if s.Tx != nil {
+		slash.TxHash = hex.EncodeToString(s.Tx.Hash)
+	}
+	return slash
+}
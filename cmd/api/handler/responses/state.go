@@ -28,6 +28,7 @@ type State struct {
 	TotalStake       string         `example:"312"                                                              format:"string"    json:"total_stake"        swaggertype:"string"`
 	TotalVotingPower string         `example:"312"                                                              format:"string"    json:"total_voting_power" swaggertype:"string"`
 	Synced           bool           `example:"true"                                                             format:"boolean"   json:"synced"             swaggertype:"boolean"`
+	UpgradePending   bool           `example:"false"                                                            format:"boolean"   json:"upgrade_pending"    swaggertype:"boolean"`
 }
 
 func NewState(state storage.State) State {
@@ -47,5 +48,6 @@ func NewState(state storage.State) State {
 		TotalStake:       state.TotalStake.String(),
 		TotalVotingPower: state.TotalVotingPower.String(),
 		Synced:           !state.LastTime.UTC().Add(2 * time.Minute).Before(time.Now().UTC()),
+		UpgradePending:   state.UpgradePending,
 	}
 }
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package responses
+
+import (
+	"encoding/hex"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+)
+
+// Transfer model info
+//
+//	@Description	Token transfer between accounts
+type Transfer struct {
+	Id       uint64         `example:"321"                                                              json:"id"                 swaggertype:"integer"`
+	Height   pkgTypes.Level `example:"100"                                                              json:"height"             swaggertype:"integer"`
+	Time     time.Time      `example:"2023-07-04T03:10:57+00:00"                                        json:"time"               swaggertype:"string"`
+	Sender   string         `example:"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"                  json:"sender,omitempty"   swaggertype:"string"`
+	Receiver string         `example:"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"                  json:"receiver,omitempty" swaggertype:"string"`
+	Amount   string         `example:"1000"                                                             json:"amount"             swaggertype:"string"`
+	Denom    string         `example:"utia"                                                             json:"denom"              swaggertype:"string"`
+	TxHash   string         `example:"652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF" json:"tx_hash,omitempty"  swaggertype:"string"`
+}
+
+func NewTransfer(t storage.Transfer) Transfer {
+	transfer := Transfer{
+		Id:     t.Id,
+		Height: t.Height,
+		Time:   t.Time,
+		Amount: t.Amount.String(),
+		Denom:  t.Denom,
+	}
+	if t.Sender != nil {
+		transfer.Sender = t.Sender.Address
+	}
+	if t.Receiver != nil {
+		transfer.Receiver = t.Receiver.Address
+	}
+	if t.Tx != nil {
+		transfer.TxHash = hex.EncodeToString(t.Tx.Hash)
+	}
+	return transfer
+}
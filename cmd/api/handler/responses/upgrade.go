@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package responses
+
+import (
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+)
+
+// Upgrade model info
+//
+//	@Description	Software upgrade plan and app version transition
+type Upgrade struct {
+	Id               uint64          `example:"1"                                               json:"id"                          swaggertype:"integer"`
+	Height           pkgTypes.Level  `example:"100"                                             json:"height"                      swaggertype:"integer"`
+	Time             time.Time       `example:"2023-07-04T03:10:57+00:00"                       json:"time"                        swaggertype:"string"`
+	Name             string          `example:"v2"                                              json:"name"                        swaggertype:"string"`
+	PlanHeight       int64           `example:"1000"                                            json:"plan_height,omitempty"       swaggertype:"integer"`
+	Info             string          `example:"upgrade info"                                    json:"info,omitempty"              swaggertype:"string"`
+	ProposalId       *uint64         `example:"1"                                               json:"proposal_id,omitempty"       swaggertype:"integer"`
+	Authority        string          `example:"celestia10d07y265gmmuvt4z0w9aw880jnsr700jtgz4v7" json:"authority,omitempty"         swaggertype:"string"`
+	Status           string          `example:"scheduled"                                       json:"status"                      swaggertype:"string"`
+	CancelHeight     *pkgTypes.Level `example:"100"                                             json:"cancel_height,omitempty"     swaggertype:"integer"`
+	CancelTime       *time.Time      `example:"2023-07-04T03:10:57+00:00"                       json:"cancel_time,omitempty"       swaggertype:"string"`
+	AppVersion       uint64          `example:"2"                                               json:"app_version,omitempty"       swaggertype:"integer"`
+	ActivationHeight *pkgTypes.Level `example:"1000"                                            json:"activation_height,omitempty" swaggertype:"integer"`
+	ActivationTime   *time.Time      `example:"2023-07-04T03:10:57+00:00"                       json:"activation_time,omitempty"   swaggertype:"string"`
+}
+
+func NewUpgrade(u storage.Upgrade) Upgrade {
+	return Upgrade{
+		Id:               u.Id,
+		Height:           u.Height,
+		Time:             u.Time,
+		Name:             u.Name,
+		PlanHeight:       u.PlanHeight,
+		Info:             u.Info,
+		ProposalId:       u.ProposalId,
+		Authority:        u.Authority,
+		Status:           u.Status.String(),
+		CancelHeight:     u.CancelHeight,
+		CancelTime:       u.CancelTime,
+		AppVersion:       u.AppVersion,
+		ActivationHeight: u.ActivationHeight,
+		ActivationTime:   u.ActivationTime,
+	}
+}
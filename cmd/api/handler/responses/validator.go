@@ -119,6 +119,32 @@ func NewValidatorUptime(levels []types.Level, currentLevel types.Level, count ty
 	return uptime
 }
 
+type ValidatorSigning struct {
+	SignedBlocksWindow int64       `example:"5000" json:"signed_blocks_window"  swaggertype:"integer"`
+	MinSignedPerWindow string      `example:"0.75" json:"min_signed_per_window" swaggertype:"string"`
+	MaxMissedBlocks    int64       `example:"1250" json:"max_missed_blocks"     swaggertype:"integer"`
+	MissedBlocks       int64       `example:"10"   json:"missed_blocks"         swaggertype:"integer"`
+	WindowStart        types.Level `example:"100"  json:"window_start"          swaggertype:"integer"`
+	JailWarning        bool        `example:"true" json:"jail_warning"          swaggertype:"boolean"`
+}
+
+// jailWarningThreshold - share of allowed missed blocks after which validator is close to jail
+var jailWarningThreshold = decimal.NewFromFloat(0.8)
+
+func NewValidatorSigning(window int64, minSignedPerWindow decimal.Decimal, missed int64, windowStart types.Level) ValidatorSigning {
+	minSigned := minSignedPerWindow.Mul(decimal.NewFromInt(window)).Round(0).IntPart()
+	maxMissed := window - minSigned
+
+	return ValidatorSigning{
+		SignedBlocksWindow: window,
+		MinSignedPerWindow: minSignedPerWindow.String(),
+		MaxMissedBlocks:    maxMissed,
+		MissedBlocks:       missed,
+		WindowStart:        windowStart,
+		JailWarning:        missed > 0 && decimal.NewFromInt(missed).GreaterThanOrEqual(jailWarningThreshold.Mul(decimal.NewFromInt(maxMissed))),
+	}
+}
+
 type Jail struct {
 	Height types.Level `example:"100"                       json:"height" swaggertype:"integer"`
 	Time   time.Time   `example:"2023-07-04T03:10:57+00:00" json:"time"   swaggertype:"string"`
@@ -126,6 +152,7 @@ type Jail struct {
 	Burned string      `example:"10000000000"               json:"burned" swaggertype:"string"`
 
 	Validator *ShortValidator `json:"validator,omitempty"`
+	Slash     *Slash          `json:"slash,omitempty"`
 }
 
 func NewJail(jail storage.Jail) Jail {
@@ -139,6 +166,10 @@ func NewJail(jail storage.Jail) Jail {
 	if jail.Validator != nil {
 		j.Validator = NewShortValidator(*jail.Validator)
 	}
+	if jail.Slash != nil && jail.Slash.Id > 0 {
+		slash := NewSlash(*jail.Slash)
+		j.Slash = &slash
+	}
 
 	return j
 }
@@ -149,3 +180,37 @@ type ValidatorCount struct {
 	Active   int `example:"100" json:"active"   swaggertype:"integer"`
 	Inactive int `example:"100" json:"inactive" swaggertype:"integer"`
 }
+
+type ValidatorHistory struct {
+	Id     uint64      `example:"321"                       json:"id"     swaggertype:"integer"`
+	Height types.Level `example:"100"                       json:"height" swaggertype:"integer"`
+	Time   time.Time   `example:"2023-07-04T03:10:57+00:00" json:"time"   swaggertype:"string"`
+
+	Moniker  string `example:"Easy 2 Stake"                   json:"moniker"  swaggertype:"string"`
+	Website  string `example:"https://www.easy2stake.com/"    json:"website"  swaggertype:"string"`
+	Identity string `example:"2C877AC873132C91"               json:"identity" swaggertype:"string"`
+	Contacts string `example:"security@0xfury.com"            json:"contacts" swaggertype:"string"`
+	Details  string `example:"Some long text about validator" json:"details"  swaggertype:"string"`
+
+	Rate              string `example:"0.03" json:"rate"                swaggertype:"string"`
+	MaxRate           string `example:"0.1"  json:"max_rate"            swaggertype:"string"`
+	MaxChangeRate     string `example:"0.01" json:"max_change_rate"     swaggertype:"string"`
+	MinSelfDelegation string `example:"1"    json:"min_self_delegation" swaggertype:"string"`
+}
+
+func NewValidatorHistory(history storage.ValidatorHistory) ValidatorHistory {
+	return ValidatorHistory{
+		Id:                history.Id,
+		Height:            history.Height,
+		Time:              history.Time,
+		Moniker:           history.Moniker,
+		Website:           history.Website,
+		Identity:          history.Identity,
+		Contacts:          history.Contacts,
+		Details:           history.Details,
+		Rate:              history.Rate.String(),
+		MaxRate:           history.MaxRate.String(),
+		MaxChangeRate:     history.MaxChangeRate.String(),
+		MinSelfDelegation: history.MinSelfDelegation.String(),
+	}
+}
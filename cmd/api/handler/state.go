@@ -14,13 +14,15 @@ import (
 type StateHandler struct {
 	state       storage.IState
 	validator   storage.IValidator
+	upgrades    storage.IUpgrade
 	indexerName string
 }
 
-func NewStateHandler(state storage.IState, validator storage.IValidator, indexerName string) *StateHandler {
+func NewStateHandler(state storage.IState, validator storage.IValidator, upgrades storage.IUpgrade, indexerName string) *StateHandler {
 	return &StateHandler{
 		state:       state,
 		validator:   validator,
+		upgrades:    upgrades,
 		indexerName: indexerName,
 	}
 }
@@ -49,5 +51,13 @@ func (sh *StateHandler) Head(c echo.Context) error {
 	}
 	state.TotalVotingPower = votingPower
 
+	if _, err := sh.upgrades.Pending(c.Request().Context()); err != nil {
+		if !sh.upgrades.IsNoRows(err) {
+			return handleError(c, err, sh.upgrades)
+		}
+	} else {
+		state.UpgradePending = true
+	}
+
 	return c.JSON(http.StatusOK, responses.NewState(state))
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
 	"github.com/celenium-io/celestia-indexer/internal/storage"
 	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
 	"github.com/labstack/echo/v4"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/suite"
@@ -25,6 +26,7 @@ type StateTestSuite struct {
 	suite.Suite
 	state      *mock.MockIState
 	validators *mock.MockIValidator
+	upgrades   *mock.MockIUpgrade
 	echo       *echo.Echo
 	handler    *StateHandler
 	ctrl       *gomock.Controller
@@ -37,7 +39,8 @@ func (s *StateTestSuite) SetupSuite() {
 	s.ctrl = gomock.NewController(s.T())
 	s.state = mock.NewMockIState(s.ctrl)
 	s.validators = mock.NewMockIValidator(s.ctrl)
-	s.handler = NewStateHandler(s.state, s.validators, testIndexerName)
+	s.upgrades = mock.NewMockIUpgrade(s.ctrl)
+	s.handler = NewStateHandler(s.state, s.validators, s.upgrades, testIndexerName)
 }
 
 // TearDownSuite -
@@ -77,6 +80,16 @@ func (s *StateTestSuite) TestHead() {
 		}, nil).
 		Times(1)
 
+	s.upgrades.EXPECT().
+		Pending(gomock.Any()).
+		Return(storage.Upgrade{}, sql.ErrNoRows).
+		Times(1)
+
+	s.upgrades.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true).
+		Times(1)
+
 	s.Require().NoError(s.handler.Head(c))
 	s.Require().Equal(http.StatusOK, rec.Code)
 
@@ -94,6 +107,47 @@ func (s *StateTestSuite) TestHead() {
 	s.Require().Equal(testTime, state.LastTime)
 	s.Require().Equal("100", state.TotalVotingPower)
 	s.Require().Equal("100", state.TotalStake)
+	s.Require().False(state.UpgradePending)
+}
+
+func (s *StateTestSuite) TestHeadUpgradePending() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/head")
+
+	s.validators.EXPECT().
+		TotalVotingPower(gomock.Any()).
+		Return(decimal.RequireFromString("100"), nil).
+		Times(1)
+
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(storage.State{
+			Id:         1,
+			Name:       testIndexerName,
+			LastHeight: 100,
+			LastTime:   testTime,
+		}, nil).
+		Times(1)
+
+	s.upgrades.EXPECT().
+		Pending(gomock.Any()).
+		Return(storage.Upgrade{
+			Id:         1,
+			Name:       "v2",
+			PlanHeight: 1000,
+			Status:     types.UpgradeStatusScheduled,
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Head(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var state responses.State
+	err := json.NewDecoder(rec.Body).Decode(&state)
+	s.Require().NoError(err)
+	s.Require().True(state.UpgradePending)
 }
 
 func (s *StateTestSuite) TestNoHead() {
@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+)
+
+type TransferHandler struct {
+	transfers storage.ITransfer
+	address   storage.IAddress
+}
+
+func NewTransferHandler(
+	transfers storage.ITransfer,
+	address storage.IAddress,
+) *TransferHandler {
+	return &TransferHandler{
+		transfers: transfers,
+		address:   address,
+	}
+}
+
+type listTransfersRequest struct {
+	Hash       string `param:"hash"        validate:"omitempty,address"`
+	Address    string `query:"address"     validate:"omitempty,address"`
+	Limit      int    `query:"limit"       validate:"omitempty,min=1,max=100"`
+	Offset     int    `query:"offset"      validate:"omitempty,min=0"`
+	Sort       string `query:"sort"        validate:"omitempty,oneof=asc desc"`
+	Denom      string `query:"denom"       validate:"omitempty"`
+	Height     uint64 `query:"height"      validate:"omitempty,min=1"`
+	AmountFrom uint64 `query:"amount_from" validate:"omitempty,min=1"`
+	AmountTo   uint64 `query:"amount_to"   validate:"omitempty,min=1"`
+
+	From int64 `example:"1692892095" query:"from" swaggertype:"integer" validate:"omitempty,min=1"`
+	To   int64 `example:"1692892095" query:"to"   swaggertype:"integer" validate:"omitempty,min=1"`
+}
+
+func (req *listTransfersRequest) SetDefault() {
+	if req.Limit == 0 {
+		req.Limit = 10
+	}
+	if req.Sort == "" {
+		req.Sort = desc
+	}
+}
+
+func (req *listTransfersRequest) ToFilters() storage.TransferFilters {
+	fltrs := storage.TransferFilters{
+		Limit:  req.Limit,
+		Offset: req.Offset,
+		Sort:   pgSort(req.Sort),
+		Denom:  req.Denom,
+		Height: req.Height,
+	}
+	if req.AmountFrom > 0 {
+		fltrs.AmountFrom = decimal.NewFromBigInt(new(big.Int).SetUint64(req.AmountFrom), 0)
+	}
+	if req.AmountTo > 0 {
+		fltrs.AmountTo = decimal.NewFromBigInt(new(big.Int).SetUint64(req.AmountTo), 0)
+	}
+	if req.From > 0 {
+		fltrs.TimeFrom = time.Unix(req.From, 0).UTC()
+	}
+	if req.To > 0 {
+		fltrs.TimeTo = time.Unix(req.To, 0).UTC()
+	}
+	return fltrs
+}
+
+// List godoc
+//
+//	@Summary		List token transfers
+//	@Description	List token transfers between accounts
+//	@Tags			transfer
+//	@ID				list-transfers
+//	@Param			limit		query	integer	false	"Count of requested entities"			mininum(1)		maximum(100)
+//	@Param			offset		query	integer	false	"Offset"								mininum(1)
+//	@Param			sort		query	string	false	"Sort order"							Enums(asc, desc)
+//	@Param			address		query	string	false	"Sender or receiver celestia address"	minlength(47)	maxlength(47)
+//	@Param			denom		query	string	false	"Denomination of transferred tokens"
+//	@Param			height		query	integer	false	"Block number"							minimum(1)
+//	@Param			amount_from	query	integer	false	"Minimum transferred amount"			minimum(1)
+//	@Param			amount_to	query	integer	false	"Maximum transferred amount (exclusive)"	minimum(1)
+//	@Param			from		query	integer	false	"Time from in unix timestamp"			minimum(1)
+//	@Param			to			query	integer	false	"Time to in unix timestamp"				minimum(1)
+//	@Produce		json
+//	@Success		200	{array}		responses.Transfer
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/transfers [get]
+func (handler *TransferHandler) List(c echo.Context) error {
+	req, err := bindAndValidate[listTransfersRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	fltrs := req.ToFilters()
+	if req.Address != "" {
+		_, hash, err := types.Address(req.Address).Decode()
+		if err != nil {
+			return badRequestError(c, err)
+		}
+		addressId, err := handler.address.IdByHash(c.Request().Context(), hash)
+		if err != nil {
+			return handleError(c, err, handler.address)
+		}
+		fltrs.AddressId = &addressId
+	}
+
+	transfers, err := handler.transfers.ListWithFilters(c.Request().Context(), fltrs)
+	if err != nil {
+		return handleError(c, err, handler.transfers)
+	}
+
+	response := make([]responses.Transfer, len(transfers))
+	for i := range transfers {
+		response[i] = responses.NewTransfer(transfers[i])
+	}
+	return returnArray(c, response)
+}
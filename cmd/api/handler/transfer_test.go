@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+	testsuite "github.com/celenium-io/celestia-indexer/internal/test_suite"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+var testTransfer = storage.Transfer{
+	Id:         1,
+	Height:     100,
+	Time:       testTime,
+	TxId:       testsuite.Ptr[uint64](1),
+	SenderId:   1,
+	ReceiverId: 2,
+	Amount:     decimal.RequireFromString("1000"),
+	Denom:      "utia",
+	Tx: &storage.Tx{
+		Hash: testTxHashBytes,
+	},
+	Sender: &storage.Address{
+		Address: testAddress,
+	},
+	Receiver: &storage.Address{
+		Address: "celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8",
+	},
+}
+
+// TransferTestSuite -
+type TransferTestSuite struct {
+	suite.Suite
+	transfers *mock.MockITransfer
+	address   *mock.MockIAddress
+	echo      *echo.Echo
+	handler   *TransferHandler
+	ctrl      *gomock.Controller
+}
+
+// SetupSuite -
+func (s *TransferTestSuite) SetupSuite() {
+	s.echo = echo.New()
+	s.echo.Validator = NewCelestiaApiValidator()
+	s.ctrl = gomock.NewController(s.T())
+	s.transfers = mock.NewMockITransfer(s.ctrl)
+	s.address = mock.NewMockIAddress(s.ctrl)
+	s.handler = NewTransferHandler(s.transfers, s.address)
+}
+
+// TearDownSuite -
+func (s *TransferTestSuite) TearDownSuite() {
+	s.ctrl.Finish()
+	s.Require().NoError(s.echo.Shutdown(context.Background()))
+}
+
+func TestSuiteTransfer_Run(t *testing.T) {
+	suite.Run(t, new(TransferTestSuite))
+}
+
+func (s *TransferTestSuite) TestList() {
+	q := make(url.Values)
+	q.Set("limit", "10")
+	q.Set("denom", "utia")
+	q.Set("amount_from", "100")
+	q.Set("from", "1692892095")
+	q.Set("address", testAddress)
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/transfers")
+
+	s.address.EXPECT().
+		IdByHash(gomock.Any(), testHashAddress).
+		Return(uint64(1), nil).
+		Times(1)
+
+	s.transfers.EXPECT().
+		ListWithFilters(gomock.Any(), storage.TransferFilters{
+			Limit:      10,
+			Sort:       sdk.SortOrderDesc,
+			AddressId:  testsuite.Ptr[uint64](1),
+			Denom:      "utia",
+			AmountFrom: decimal.RequireFromString("100"),
+			TimeFrom:   time.Unix(1692892095, 0).UTC(),
+		}).
+		Return([]storage.Transfer{testTransfer}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var transfers []responses.Transfer
+	err := json.NewDecoder(rec.Body).Decode(&transfers)
+	s.Require().NoError(err)
+	s.Require().Len(transfers, 1)
+
+	t := transfers[0]
+	s.Require().EqualValues(1, t.Id)
+	s.Require().EqualValues(100, t.Height)
+	s.Require().Equal("1000", t.Amount)
+	s.Require().Equal("utia", t.Denom)
+	s.Require().Equal(testAddress, t.Sender)
+	s.Require().Equal("celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8", t.Receiver)
+	s.Require().Equal(testTxHash, strings.ToUpper(t.TxHash))
+}
+
+func (s *TransferTestSuite) TestListInvalidAddress() {
+	q := make(url.Values)
+	q.Set("address", "invalid")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/transfers")
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
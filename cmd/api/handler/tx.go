@@ -206,7 +206,7 @@ func (handler *TxHandler) GetEvents(c echo.Context) error {
 // GetMessages godoc
 //
 //	@Summary		Get transaction messages
-//	@Description	Get transaction messages
+//	@Description	Get transaction messages. Messages executed inside MsgExec are returned in `internal_msgs` of the parent message
 //	@Tags			transactions
 //	@ID				get-transaction-messages
 //	@Param			hash	path	string	true	"Transaction hash in hexadecimal"	minlength(64)	maxlength(64)
@@ -242,9 +242,49 @@ func (handler *TxHandler) GetMessages(c echo.Context) error {
 	for i := range messages {
 		response[i] = responses.NewMessage(messages[i])
 	}
+
+	if !hasMsgExec(messages) {
+		return returnArray(c, response)
+	}
+
+	internal, err := handler.messages.InternalByTxId(c.Request().Context(), txId)
+	if err != nil {
+		return handleError(c, err, handler.tx)
+	}
+	children := make(map[uint64][]storage.Message)
+	for i := range internal {
+		if internal[i].ParentId == nil {
+			continue
+		}
+		children[*internal[i].ParentId] = append(children[*internal[i].ParentId], internal[i])
+	}
+	for i := range response {
+		setInternalMessages(&response[i], children)
+	}
 	return returnArray(c, response)
 }
 
+func hasMsgExec(messages []storage.Message) bool {
+	for i := range messages {
+		if messages[i].Type == types.MsgExec {
+			return true
+		}
+	}
+	return false
+}
+
+func setInternalMessages(msg *responses.Message, children map[uint64][]storage.Message) {
+	internal, ok := children[msg.Id]
+	if !ok {
+		return
+	}
+	msg.InternalMsgs = make([]responses.Message, len(internal))
+	for i := range internal {
+		msg.InternalMsgs[i] = responses.NewMessage(internal[i])
+		setInternalMessages(&msg.InternalMsgs[i], children)
+	}
+}
+
 // Count godoc
 //
 //	@Summary		Get count of transactions in network
@@ -498,6 +498,79 @@ func (s *TxTestSuite) TestGetMessage() {
 	s.Require().EqualValues(string(types.MsgBeginRedelegate), msgs[0].Type)
 }
 
+func (s *TxTestSuite) TestGetMessagesWithInternal() {
+	q := make(url.Values)
+	q.Set("limit", "10")
+	q.Set("offset", "0")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/tx/:hash/messages")
+	c.SetParamNames("hash")
+	c.SetParamValues(testTxHash)
+
+	s.tx.EXPECT().
+		IdByHash(gomock.Any(), testTxHashBytes).
+		Return(testTx.Id, nil)
+
+	s.messages.EXPECT().
+		ByTxId(gomock.Any(), uint64(1), 10, 0).
+		Return([]storage.Message{
+			{
+				Id:       1,
+				Height:   100,
+				Time:     testTime,
+				Position: 0,
+				Type:     types.MsgExec,
+				TxId:     1,
+			},
+		}, nil)
+
+	s.messages.EXPECT().
+		InternalByTxId(gomock.Any(), uint64(1)).
+		Return([]storage.Message{
+			{
+				Id:       2,
+				Height:   100,
+				Time:     testTime,
+				Position: 0,
+				Type:     types.MsgDelegate,
+				TxId:     1,
+				ParentId: testsuite.Ptr[uint64](1),
+			}, {
+				Id:       3,
+				Height:   100,
+				Time:     testTime,
+				Position: 1,
+				Type:     types.MsgSend,
+				TxId:     1,
+				ParentId: testsuite.Ptr[uint64](1),
+			},
+		}, nil)
+
+	s.Require().NoError(s.handler.GetMessages(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var msgs []responses.Message
+	err := json.NewDecoder(rec.Body).Decode(&msgs)
+	s.Require().NoError(err)
+	s.Require().Len(msgs, 1)
+	s.Require().EqualValues(string(types.MsgExec), msgs[0].Type)
+	s.Require().Nil(msgs[0].ParentId)
+	s.Require().Len(msgs[0].InternalMsgs, 2)
+
+	delegate := msgs[0].InternalMsgs[0]
+	s.Require().EqualValues(2, delegate.Id)
+	s.Require().EqualValues(string(types.MsgDelegate), delegate.Type)
+	s.Require().NotNil(delegate.ParentId)
+	s.Require().EqualValues(1, *delegate.ParentId)
+
+	send := msgs[0].InternalMsgs[1]
+	s.Require().EqualValues(3, send.Id)
+	s.Require().EqualValues(string(types.MsgSend), send.Type)
+}
+
 func (s *TxTestSuite) TestCount() {
 	req := httptest.NewRequest(http.MethodGet, "/", nil)
 	rec := httptest.NewRecorder()
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/labstack/echo/v4"
+)
+
+type UpgradeHandler struct {
+	upgrades storage.IUpgrade
+}
+
+func NewUpgradeHandler(upgrades storage.IUpgrade) *UpgradeHandler {
+	return &UpgradeHandler{
+		upgrades: upgrades,
+	}
+}
+
+type listUpgradesRequest struct {
+	Limit  int         `query:"limit"  validate:"omitempty,min=1,max=100"`
+	Offset int         `query:"offset" validate:"omitempty,min=0"`
+	Sort   string      `query:"sort"   validate:"omitempty,oneof=asc desc"`
+	Status StringArray `query:"status" validate:"omitempty,dive,upgrade_status"`
+}
+
+func (req *listUpgradesRequest) SetDefault() {
+	if req.Limit == 0 {
+		req.Limit = 10
+	}
+	if req.Sort == "" {
+		req.Sort = desc
+	}
+}
+
+// List godoc
+//
+//	@Summary		List software upgrades
+//	@Description	List software upgrade plans and app version transitions
+//	@Tags			upgrade
+//	@ID				list-upgrade
+//	@Param			limit	query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset	query	integer	false	"Offset"						mininum(1)
+//	@Param			sort	query	string	false	"Sort order"					Enums(asc, desc)
+//	@Param			status	query	string	false	"Comma-separated upgrade status list"
+//	@Produce		json
+//	@Success		200	{array}		responses.Upgrade
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/upgrades [get]
+func (handler *UpgradeHandler) List(c echo.Context) error {
+	req, err := bindAndValidate[listUpgradesRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	fltrs := storage.ListUpgradesFilters{
+		Limit:  req.Limit,
+		Offset: req.Offset,
+		Sort:   pgSort(req.Sort),
+		Status: make([]storageTypes.UpgradeStatus, len(req.Status)),
+	}
+	for i := range req.Status {
+		fltrs.Status[i] = storageTypes.UpgradeStatus(req.Status[i])
+	}
+
+	upgrades, err := handler.upgrades.ListWithFilters(c.Request().Context(), fltrs)
+	if err != nil {
+		return handleError(c, err, handler.upgrades)
+	}
+
+	response := make([]responses.Upgrade, len(upgrades))
+	for i := range upgrades {
+		response[i] = responses.NewUpgrade(upgrades[i])
+	}
+	return returnArray(c, response)
+}
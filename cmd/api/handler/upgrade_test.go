@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	testsuite "github.com/celenium-io/celestia-indexer/internal/test_suite"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+var testUpgrade = storage.Upgrade{
+	Id:               1,
+	Height:           100,
+	Time:             testTime,
+	Name:             "v2",
+	PlanHeight:       1000,
+	Info:             "info",
+	ProposalId:       testsuite.Ptr[uint64](1),
+	Authority:        "celestia10d07y265gmmuvt4z0w9aw880jnsr700jtgz4v7",
+	Status:           types.UpgradeStatusApplied,
+	AppVersion:       2,
+	ActivationHeight: testsuite.Ptr[pkgTypes.Level](1000),
+	ActivationTime:   &testTime,
+}
+
+// UpgradeTestSuite -
+type UpgradeTestSuite struct {
+	suite.Suite
+	upgrades *mock.MockIUpgrade
+	echo     *echo.Echo
+	handler  *UpgradeHandler
+	ctrl     *gomock.Controller
+}
+
+// SetupSuite -
+func (s *UpgradeTestSuite) SetupSuite() {
+	s.echo = echo.New()
+	s.echo.Validator = NewCelestiaApiValidator()
+	s.ctrl = gomock.NewController(s.T())
+	s.upgrades = mock.NewMockIUpgrade(s.ctrl)
+	s.handler = NewUpgradeHandler(s.upgrades)
+}
+
+// TearDownSuite -
+func (s *UpgradeTestSuite) TearDownSuite() {
+	s.ctrl.Finish()
+	s.Require().NoError(s.echo.Shutdown(context.Background()))
+}
+
+func TestSuiteUpgrade_Run(t *testing.T) {
+	suite.Run(t, new(UpgradeTestSuite))
+}
+
+func (s *UpgradeTestSuite) TestList() {
+	q := make(url.Values)
+	q.Set("limit", "10")
+	q.Set("offset", "0")
+	q.Set("sort", "asc")
+	q.Set("status", "applied,scheduled")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/upgrades")
+
+	s.upgrades.EXPECT().
+		ListWithFilters(gomock.Any(), storage.ListUpgradesFilters{
+			Limit:  10,
+			Sort:   sdk.SortOrderAsc,
+			Status: []types.UpgradeStatus{types.UpgradeStatusApplied, types.UpgradeStatusScheduled},
+		}).
+		Return([]storage.Upgrade{testUpgrade}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var upgrades []responses.Upgrade
+	err := json.NewDecoder(rec.Body).Decode(&upgrades)
+	s.Require().NoError(err)
+	s.Require().Len(upgrades, 1)
+
+	upgrade := upgrades[0]
+	s.Require().EqualValues(1, upgrade.Id)
+	s.Require().EqualValues(100, upgrade.Height)
+	s.Require().Equal("v2", upgrade.Name)
+	s.Require().EqualValues(1000, upgrade.PlanHeight)
+	s.Require().Equal("info", upgrade.Info)
+	s.Require().NotNil(upgrade.ProposalId)
+	s.Require().EqualValues(1, *upgrade.ProposalId)
+	s.Require().Equal("applied", upgrade.Status)
+	s.Require().EqualValues(2, upgrade.AppVersion)
+	s.Require().NotNil(upgrade.ActivationHeight)
+	s.Require().EqualValues(1000, *upgrade.ActivationHeight)
+}
+
+func (s *UpgradeTestSuite) TestListInvalidStatus() {
+	q := make(url.Values)
+	q.Set("status", "invalid")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/upgrades")
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
@@ -13,6 +13,7 @@ import (
 	st "github.com/celenium-io/celestia-indexer/internal/storage/types"
 	"github.com/celenium-io/celestia-indexer/pkg/types"
 	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
 )
 
 type ValidatorHandler struct {
@@ -22,6 +23,10 @@ type ValidatorHandler struct {
 	delegations     storage.IDelegation
 	constants       storage.IConstant
 	jails           storage.IJail
+	slashes         storage.ISlash
+	history         storage.IValidatorHistory
+	missedBlocks    storage.IMissedBlock
+	votes           storage.IVote
 	state           storage.IState
 	indexerName     string
 }
@@ -33,6 +38,10 @@ func NewValidatorHandler(
 	delegations storage.IDelegation,
 	constants storage.IConstant,
 	jails storage.IJail,
+	slashes storage.ISlash,
+	history storage.IValidatorHistory,
+	missedBlocks storage.IMissedBlock,
+	votes storage.IVote,
 	state storage.IState,
 	indexerName string,
 ) *ValidatorHandler {
@@ -43,6 +52,10 @@ func NewValidatorHandler(
 		delegations:     delegations,
 		constants:       constants,
 		jails:           jails,
+		slashes:         slashes,
+		history:         history,
+		missedBlocks:    missedBlocks,
+		votes:           votes,
 		state:           state,
 		indexerName:     indexerName,
 	}
@@ -304,7 +317,7 @@ func (handler *ValidatorHandler) Jails(c echo.Context) error {
 		req.Offset,
 	)
 	if err != nil {
-		return handleError(c, err, handler.delegations)
+		return handleError(c, err, handler.jails)
 	}
 
 	response := make([]responses.Jail, len(jails))
@@ -314,6 +327,248 @@ func (handler *ValidatorHandler) Jails(c echo.Context) error {
 	return returnArray(c, response)
 }
 
+// Slashes godoc
+//
+//	@Summary		Get validator's slashes
+//	@Description	Get validator's slashes for double sign and downtime
+//	@Tags			validator
+//	@ID				validator-slashes
+//	@Param			id		path	integer	true	"Internal validator id"
+//	@Param			limit	query	integer	false	"Count of requested entities"	minimum(1)		maximum(100)
+//	@Param			offset	query	integer	false	"Offset"						minimum(1)
+//	@Produce		json
+//	@Success		200	{array}		responses.Slash
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/validators/{id}/slashes [get]
+func (handler *ValidatorHandler) Slashes(c echo.Context) error {
+	req, err := bindAndValidate[validatorPageableRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	slashes, err := handler.slashes.ByValidator(
+		c.Request().Context(),
+		req.Id,
+		req.Limit,
+		req.Offset,
+	)
+	if err != nil {
+		return handleError(c, err, handler.slashes)
+	}
+
+	response := make([]responses.Slash, len(slashes))
+	for i := range response {
+		response[i] = responses.NewSlash(slashes[i])
+	}
+	return returnArray(c, response)
+}
+
+// History godoc
+//
+//	@Summary		Get validator's history
+//	@Description	Get history of validator's description and commission changes
+//	@Tags			validator
+//	@ID				validator-history
+//	@Param			id		path	integer	true	"Internal validator id"
+//	@Param			limit	query	integer	false	"Count of requested entities"	minimum(1)		maximum(100)
+//	@Param			offset	query	integer	false	"Offset"						minimum(1)
+//	@Produce		json
+//	@Success		200	{array}		responses.ValidatorHistory
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/validators/{id}/history [get]
+func (handler *ValidatorHandler) History(c echo.Context) error {
+	req, err := bindAndValidate[validatorPageableRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	history, err := handler.history.ByValidator(
+		c.Request().Context(),
+		req.Id,
+		req.Limit,
+		req.Offset,
+	)
+	if err != nil {
+		return handleError(c, err, handler.history)
+	}
+
+	response := make([]responses.ValidatorHistory, len(history))
+	for i := range response {
+		response[i] = responses.NewValidatorHistory(history[i])
+	}
+	return returnArray(c, response)
+}
+
+// Signing godoc
+//
+//	@Summary		Get validator's signing info
+//	@Description	Get count of blocks missed by validator in the current slashing window and jail warning flag
+//	@Tags			validator
+//	@ID				validator-signing
+//	@Param			id	path	integer	true	"Internal validator id"
+//	@Produce		json
+//	@Success		200	{object}	responses.ValidatorSigning
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/validators/{id}/signing [get]
+func (handler *ValidatorHandler) Signing(c echo.Context) error {
+	req, err := bindAndValidate[validatorRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	ctx := c.Request().Context()
+
+	state, err := handler.state.ByName(ctx, handler.indexerName)
+	if err != nil {
+		return handleError(c, err, handler.state)
+	}
+
+	windowConst, err := handler.constants.Get(ctx, st.ModuleNameSlashing, "signed_blocks_window")
+	if err != nil {
+		return handleError(c, err, handler.validators)
+	}
+	window, err := strconv.ParseInt(windowConst.Value, 10, 64)
+	if err != nil {
+		return handleError(c, err, handler.validators)
+	}
+
+	minSignedConst, err := handler.constants.Get(ctx, st.ModuleNameSlashing, "min_signed_per_window")
+	if err != nil {
+		return handleError(c, err, handler.validators)
+	}
+	minSignedPerWindow, err := decimal.NewFromString(minSignedConst.Value)
+	if err != nil {
+		return handleError(c, err, handler.validators)
+	}
+
+	windowStart := state.LastHeight - types.Level(window)
+	if windowStart < 0 {
+		windowStart = 0
+	}
+
+	// missed blocks counter is reset when validator is jailed
+	jails, err := handler.jails.ByValidator(ctx, req.Id, 1, 0)
+	if err != nil {
+		return handleError(c, err, handler.jails)
+	}
+	if len(jails) > 0 && jails[0].Height > windowStart {
+		windowStart = jails[0].Height
+	}
+
+	missed, err := handler.missedBlocks.CountByValidator(ctx, req.Id, windowStart)
+	if err != nil {
+		return handleError(c, err, handler.missedBlocks)
+	}
+
+	response := responses.NewValidatorSigning(window, minSignedPerWindow, missed, windowStart)
+	return c.JSON(http.StatusOK, response)
+}
+
+type validatorMissedBlocksRequest struct {
+	Id        uint64 `example:"1"          param:"id"        swaggertype:"integer" validate:"required,min=1"`
+	Timeframe string `example:"hour"       param:"timeframe" swaggertype:"string"  validate:"required,oneof=hour day"`
+	From      int64  `example:"1692892095" query:"from"      swaggertype:"integer" validate:"omitempty,min=1"`
+	To        int64  `example:"1692892095" query:"to"        swaggertype:"integer" validate:"omitempty,min=1"`
+}
+
+// MissedBlocks godoc
+//
+//	@Summary		Get validator's missed blocks series
+//	@Description	Get histogram of blocks missed by validator
+//	@Tags			validator
+//	@ID				validator-missed-blocks
+//	@Param			id			path	integer	true	"Internal validator id"
+//	@Param			timeframe	path	string	true	"Timeframe"						Enums(hour, day)
+//	@Param			from		query	integer	false	"Time from in unix timestamp"	mininum(1)
+//	@Param			to			query	integer	false	"Time to in unix timestamp"		mininum(1)
+//	@Produce		json
+//	@Success		200	{array}		responses.HistogramItem
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/validators/{id}/missed_blocks/{timeframe} [get]
+func (handler *ValidatorHandler) MissedBlocks(c echo.Context) error {
+	req, err := bindAndValidate[validatorMissedBlocksRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	series, err := handler.missedBlocks.Series(
+		c.Request().Context(),
+		req.Id,
+		storage.Timeframe(req.Timeframe),
+		storage.NewSeriesRequest(req.From, req.To),
+	)
+	if err != nil {
+		return handleError(c, err, handler.missedBlocks)
+	}
+
+	response := make([]responses.HistogramItem, len(series))
+	for i := range series {
+		response[i] = responses.NewHistogramItem(series[i])
+	}
+	return returnArray(c, response)
+}
+
+type validatorVotesRequest struct {
+	Id     uint64      `param:"id"     validate:"required,min=1"`
+	Limit  int         `query:"limit"  validate:"omitempty,min=1,max=100"`
+	Offset int         `query:"offset" validate:"omitempty,min=0"`
+	Option StringArray `query:"option" validate:"omitempty,dive,vote_option"`
+}
+
+func (p *validatorVotesRequest) SetDefault() {
+	if p.Limit == 0 {
+		p.Limit = 10
+	}
+}
+
+// Votes godoc
+//
+//	@Summary		Get validator's votes
+//	@Description	Get validator's votes in governance proposals
+//	@Tags			validator
+//	@ID				validator-votes
+//	@Param			id		path	integer	true	"Internal validator id"
+//	@Param			limit	query	integer	false	"Count of requested entities"	minimum(1)		maximum(100)
+//	@Param			offset	query	integer	false	"Offset"						minimum(1)
+//	@Param			option	query	string	false	"Comma-separated vote option list"
+//	@Produce		json
+//	@Success		200	{array}		responses.Vote
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/validators/{id}/votes [get]
+func (handler *ValidatorHandler) Votes(c echo.Context) error {
+	req, err := bindAndValidate[validatorVotesRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	fltrs := storage.VoteFilters{
+		Limit:  req.Limit,
+		Offset: req.Offset,
+		Option: make([]st.VoteOption, len(req.Option)),
+	}
+	for i := range req.Option {
+		fltrs.Option[i] = st.VoteOption(req.Option[i])
+	}
+
+	votes, err := handler.votes.ByValidatorId(c.Request().Context(), req.Id, fltrs)
+	if err != nil {
+		return handleError(c, err, handler.votes)
+	}
+
+	response := make([]responses.Vote, len(votes))
+	for i := range response {
+		response[i] = responses.NewVote(votes[i])
+	}
+	return returnArray(c, response)
+}
+
 // Count godoc
 //
 //	@Summary		Get validator's count by status
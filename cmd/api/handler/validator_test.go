@@ -15,6 +15,7 @@ import (
 	"github.com/celenium-io/celestia-indexer/internal/storage"
 	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
 	st "github.com/celenium-io/celestia-indexer/internal/storage/types"
+	testsuite "github.com/celenium-io/celestia-indexer/internal/test_suite"
 	"github.com/celenium-io/celestia-indexer/pkg/types"
 	"github.com/labstack/echo/v4"
 	"github.com/shopspring/decimal"
@@ -30,7 +31,11 @@ type ValidatorTestSuite struct {
 	blockSignatures *mock.MockIBlockSignature
 	delegations     *mock.MockIDelegation
 	jails           *mock.MockIJail
+	slashes         *mock.MockISlash
+	history         *mock.MockIValidatorHistory
+	missedBlocks    *mock.MockIMissedBlock
 	constants       *mock.MockIConstant
+	votes           *mock.MockIVote
 	state           *mock.MockIState
 	echo            *echo.Echo
 	handler         *ValidatorHandler
@@ -48,8 +53,12 @@ func (s *ValidatorTestSuite) SetupSuite() {
 	s.delegations = mock.NewMockIDelegation(s.ctrl)
 	s.constants = mock.NewMockIConstant(s.ctrl)
 	s.jails = mock.NewMockIJail(s.ctrl)
+	s.slashes = mock.NewMockISlash(s.ctrl)
+	s.history = mock.NewMockIValidatorHistory(s.ctrl)
+	s.missedBlocks = mock.NewMockIMissedBlock(s.ctrl)
+	s.votes = mock.NewMockIVote(s.ctrl)
 	s.state = mock.NewMockIState(s.ctrl)
-	s.handler = NewValidatorHandler(s.validators, s.blocks, s.blockSignatures, s.delegations, s.constants, s.jails, s.state, testIndexerName)
+	s.handler = NewValidatorHandler(s.validators, s.blocks, s.blockSignatures, s.delegations, s.constants, s.jails, s.slashes, s.history, s.missedBlocks, s.votes, s.state, testIndexerName)
 }
 
 // TearDownSuite -
@@ -276,6 +285,15 @@ func (s *ValidatorTestSuite) TestJails() {
 				Time:        testTime,
 				Id:          1,
 				ValidatorId: 1,
+				Slash: &storage.Slash{
+					Id:               1,
+					Height:           100,
+					InfractionHeight: 98,
+					Reason:           "double_sign",
+					Power:            decimal.RequireFromString("1000"),
+					Amount:           decimal.RequireFromString("100"),
+					JailId:           testsuite.Ptr[uint64](1),
+				},
 			},
 		}, nil)
 
@@ -290,6 +308,110 @@ func (s *ValidatorTestSuite) TestJails() {
 	j := jail[0]
 	s.Require().Equal("100", j.Burned)
 	s.Require().Equal("double_sign", j.Reason)
+	s.Require().NotNil(j.Slash)
+	s.Require().EqualValues(98, j.Slash.InfractionHeight)
+	s.Require().True(j.Slash.Jailed)
+}
+
+func (s *ValidatorTestSuite) TestSlashes() {
+	q := make(url.Values)
+	q.Set("limit", "10")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/validators/:id/slashes")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	s.slashes.EXPECT().
+		ByValidator(gomock.Any(), uint64(1), 10, 0).
+		Return([]storage.Slash{
+			{
+				Id:               1,
+				Height:           100,
+				Time:             testTime,
+				ValidatorId:      1,
+				InfractionHeight: 98,
+				Reason:           "missing_signature",
+				Power:            decimal.RequireFromString("1000"),
+				Fraction:         decimal.RequireFromString("0.0001"),
+				Amount:           decimal.RequireFromString("10"),
+				TxId:             testsuite.Ptr[uint64](1),
+				Tx: &storage.Tx{
+					Hash: testTxHashBytes,
+				},
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Slashes(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var slashes []responses.Slash
+	err := json.NewDecoder(rec.Body).Decode(&slashes)
+	s.Require().NoError(err)
+	s.Require().Len(slashes, 1)
+
+	slash := slashes[0]
+	s.Require().EqualValues(1, slash.Id)
+	s.Require().EqualValues(98, slash.InfractionHeight)
+	s.Require().Equal("missing_signature", slash.Reason)
+	s.Require().Equal("1000", slash.Power)
+	s.Require().Equal("0.0001", slash.Fraction)
+	s.Require().Equal("10", slash.Amount)
+	s.Require().False(slash.Jailed)
+	s.Require().NotEmpty(slash.TxHash)
+}
+
+func (s *ValidatorTestSuite) TestVotes() {
+	q := make(url.Values)
+	q.Set("limit", "10")
+	q.Set("offset", "0")
+	q.Set("option", "yes,no")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/validators/:id/votes")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	s.votes.EXPECT().
+		ByValidatorId(gomock.Any(), uint64(1), storage.VoteFilters{
+			Limit:  10,
+			Offset: 0,
+			Option: []st.VoteOption{st.VoteOptionYes, st.VoteOptionNo},
+		}).
+		Return([]storage.Vote{
+			{
+				Id:          1,
+				Height:      100,
+				Time:        testTime,
+				Option:      st.VoteOptionYes,
+				Weight:      decimal.NewFromInt(1),
+				ProposalId:  2,
+				VoterId:     1,
+				ValidatorId: 1,
+				Voter: &storage.Address{
+					Address: testAddress,
+				},
+			},
+		}, nil)
+
+	s.Require().NoError(s.handler.Votes(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var votes []responses.Vote
+	err := json.NewDecoder(rec.Body).Decode(&votes)
+	s.Require().NoError(err)
+	s.Require().Len(votes, 1)
+
+	v := votes[0]
+	s.Require().EqualValues(2, v.ProposalId)
+	s.Require().Equal("yes", v.Option)
+	s.Require().Equal("1", v.Weight)
+	s.Require().Equal(testAddress, v.Voter)
 }
 
 func (s *ValidatorTestSuite) TestCount() {
@@ -330,3 +452,154 @@ func (s *ValidatorTestSuite) TestCount() {
 	s.Require().EqualValues(6, count.Active)
 	s.Require().EqualValues(2, count.Inactive)
 }
+
+func (s *ValidatorTestSuite) TestHistory() {
+	q := make(url.Values)
+	q.Set("limit", "10")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/validators/:id/history")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	s.history.EXPECT().
+		ByValidator(gomock.Any(), uint64(1), 10, 0).
+		Return([]storage.ValidatorHistory{
+			{
+				Id:                2,
+				Height:            200,
+				Time:              testTime,
+				ValidatorId:       1,
+				Moniker:           "moniker",
+				Website:           "https://example.com",
+				Rate:              decimal.RequireFromString("0.1"),
+				MaxRate:           decimal.RequireFromString("0.2"),
+				MaxChangeRate:     decimal.RequireFromString("0.01"),
+				MinSelfDelegation: decimal.RequireFromString("1"),
+			}, {
+				Id:                1,
+				Height:            100,
+				Time:              testTime,
+				ValidatorId:       1,
+				Moniker:           "moniker",
+				Rate:              decimal.RequireFromString("0.05"),
+				MaxRate:           decimal.RequireFromString("0.2"),
+				MaxChangeRate:     decimal.RequireFromString("0.01"),
+				MinSelfDelegation: decimal.RequireFromString("1"),
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.History(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var history []responses.ValidatorHistory
+	err := json.NewDecoder(rec.Body).Decode(&history)
+	s.Require().NoError(err)
+	s.Require().Len(history, 2)
+
+	item := history[0]
+	s.Require().EqualValues(2, item.Id)
+	s.Require().EqualValues(200, item.Height)
+	s.Require().Equal("moniker", item.Moniker)
+	s.Require().Equal("https://example.com", item.Website)
+	s.Require().Equal("0.1", item.Rate)
+	s.Require().Equal("0.2", item.MaxRate)
+	s.Require().Equal("0.01", item.MaxChangeRate)
+	s.Require().Equal("1", item.MinSelfDelegation)
+	s.Require().Equal("0.05", history[1].Rate)
+}
+
+func (s *ValidatorTestSuite) TestSigning() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/validators/:id/signing")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(storage.State{
+			LastHeight: 10000,
+		}, nil).
+		Times(1)
+
+	s.constants.EXPECT().
+		Get(gomock.Any(), st.ModuleNameSlashing, "signed_blocks_window").
+		Return(storage.Constant{
+			Value: "5000",
+		}, nil).
+		Times(1)
+
+	s.constants.EXPECT().
+		Get(gomock.Any(), st.ModuleNameSlashing, "min_signed_per_window").
+		Return(storage.Constant{
+			Value: "0.750000000000000000",
+		}, nil).
+		Times(1)
+
+	s.jails.EXPECT().
+		ByValidator(gomock.Any(), uint64(1), 1, 0).
+		Return([]storage.Jail{
+			{
+				Height:      6000,
+				ValidatorId: 1,
+			},
+		}, nil).
+		Times(1)
+
+	s.missedBlocks.EXPECT().
+		CountByValidator(gomock.Any(), uint64(1), types.Level(6000)).
+		Return(1100, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Signing(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var signing responses.ValidatorSigning
+	err := json.NewDecoder(rec.Body).Decode(&signing)
+	s.Require().NoError(err)
+
+	s.Require().EqualValues(5000, signing.SignedBlocksWindow)
+	s.Require().Equal("0.75", signing.MinSignedPerWindow)
+	s.Require().EqualValues(1250, signing.MaxMissedBlocks)
+	s.Require().EqualValues(1100, signing.MissedBlocks)
+	s.Require().EqualValues(6000, signing.WindowStart)
+	s.Require().True(signing.JailWarning)
+}
+
+func (s *ValidatorTestSuite) TestMissedBlocks() {
+	for _, tf := range []storage.Timeframe{
+		storage.TimeframeHour,
+		storage.TimeframeDay,
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := s.echo.NewContext(req, rec)
+		c.SetPath("/validators/:id/missed_blocks/:timeframe")
+		c.SetParamNames("id", "timeframe")
+		c.SetParamValues("1", string(tf))
+
+		s.missedBlocks.EXPECT().
+			Series(gomock.Any(), uint64(1), tf, gomock.Any()).
+			Return([]storage.HistogramItem{
+				{
+					Time:  testTime,
+					Value: "10",
+				},
+			}, nil).
+			Times(1)
+
+		s.Require().NoError(s.handler.MissedBlocks(c))
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		var series []responses.HistogramItem
+		err := json.NewDecoder(rec.Body).Decode(&series)
+		s.Require().NoError(err)
+		s.Require().Len(series, 1)
+		s.Require().Equal("10", series[0].Value)
+	}
+}
@@ -32,6 +32,33 @@ func NewCelestiaApiValidator() *CelestiaApiValidator {
 	if err := v.RegisterValidation("namespace", namespaceValidator()); err != nil {
 		panic(err)
 	}
+	if err := v.RegisterValidation("proposal_status", proposalStatusValidator()); err != nil {
+		panic(err)
+	}
+	if err := v.RegisterValidation("proposal_type", proposalTypeValidator()); err != nil {
+		panic(err)
+	}
+	if err := v.RegisterValidation("vote_option", voteOptionValidator()); err != nil {
+		panic(err)
+	}
+	if err := v.RegisterValidation("group_proposal_status", groupProposalStatusValidator()); err != nil {
+		panic(err)
+	}
+	if err := v.RegisterValidation("ibc_transfer_status", ibcTransferStatusValidator()); err != nil {
+		panic(err)
+	}
+	if err := v.RegisterValidation("ibc_channel_status", ibcChannelStatusValidator()); err != nil {
+		panic(err)
+	}
+	if err := v.RegisterValidation("balance_history_type", balanceHistoryTypeValidator()); err != nil {
+		panic(err)
+	}
+	if err := v.RegisterValidation("attestation_type", attestationTypeValidator()); err != nil {
+		panic(err)
+	}
+	if err := v.RegisterValidation("upgrade_status", upgradeStatusValidator()); err != nil {
+		panic(err)
+	}
 	return &CelestiaApiValidator{validator: v}
 }
 
@@ -83,6 +110,62 @@ func msgTypeValidator() validator.Func {
 	}
 }
 
+func proposalStatusValidator() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		_, err := types.ParseProposalStatus(fl.Field().String())
+		return err == nil
+	}
+}
+
+func proposalTypeValidator() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		_, err := types.ParseProposalType(fl.Field().String())
+		return err == nil
+	}
+}
+
+func voteOptionValidator() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		_, err := types.ParseVoteOption(fl.Field().String())
+		return err == nil
+	}
+}
+
+func ibcTransferStatusValidator() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		_, err := types.ParseIbcTransferStatus(fl.Field().String())
+		return err == nil
+	}
+}
+
+func ibcChannelStatusValidator() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		_, err := types.ParseIbcChannelStatus(fl.Field().String())
+		return err == nil
+	}
+}
+
+func balanceHistoryTypeValidator() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		_, err := types.ParseBalanceHistoryType(fl.Field().String())
+		return err == nil
+	}
+}
+
+func attestationTypeValidator() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		_, err := types.ParseAttestationType(fl.Field().String())
+		return err == nil
+	}
+}
+
+func upgradeStatusValidator() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		_, err := types.ParseUpgradeStatus(fl.Field().String())
+		return err == nil
+	}
+}
+
 func isNamespace(s string) bool {
 	hash, err := base64.StdEncoding.DecodeString(s)
 	if err != nil {
@@ -99,3 +182,10 @@ func namespaceValidator() validator.Func {
 		return isNamespace(fl.Field().String())
 	}
 }
+
+func groupProposalStatusValidator() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		_, err := types.ParseGroupProposalStatus(fl.Field().String())
+		return err == nil
+	}
+}
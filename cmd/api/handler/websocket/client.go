@@ -77,6 +77,36 @@ func (c *Client) ApplyFilters(msg Subscribe) error {
 		c.filters.head = true
 	case ChannelBlocks:
 		c.filters.blocks = true
+	case ChannelTxs:
+		var fltrs TransactionFilters
+		if err := unmarshalFilters(msg.Filters, &fltrs); err != nil {
+			return err
+		}
+		txs, err := newTxFilters(fltrs)
+		if err != nil {
+			return err
+		}
+		c.filters.txs = txs
+	case ChannelMessages:
+		var fltrs MessageFilters
+		if err := unmarshalFilters(msg.Filters, &fltrs); err != nil {
+			return err
+		}
+		messages, err := newMessageFilters(fltrs)
+		if err != nil {
+			return err
+		}
+		c.filters.messages = messages
+	case ChannelBlobs:
+		var fltrs BlobFilters
+		if err := unmarshalFilters(msg.Filters, &fltrs); err != nil {
+			return err
+		}
+		blobs, err := newBlobFilters(fltrs)
+		if err != nil {
+			return err
+		}
+		c.filters.blobs = blobs
 	default:
 		return errors.Wrap(ErrUnknownChannel, msg.Channel)
 	}
@@ -92,12 +122,25 @@ func (c *Client) DetachFilters(msg Unsubscribe) error {
 		c.filters.head = false
 	case ChannelBlocks:
 		c.filters.blocks = false
+	case ChannelTxs:
+		c.filters.txs = nil
+	case ChannelMessages:
+		c.filters.messages = nil
+	case ChannelBlobs:
+		c.filters.blobs = nil
 	default:
 		return errors.Wrap(ErrUnknownChannel, msg.Channel)
 	}
 	return nil
 }
 
+func unmarshalFilters(data json.RawMessage, output any) error {
+	if len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, output)
+}
+
 func (c *Client) Notify(msg any) {
 	if c.closed.Load() {
 		return
@@ -179,6 +222,9 @@ func (c *Client) ReadMessages(ctx context.Context, ws *websocket.Conn, sub *Clie
 					websocket.CloseGoingAway):
 					c.manager.RemoveClientFromChannel(ChannelHead, c)
 					c.manager.RemoveClientFromChannel(ChannelBlocks, c)
+					c.manager.RemoveClientFromChannel(ChannelTxs, c)
+					c.manager.RemoveClientFromChannel(ChannelMessages, c)
+					c.manager.RemoveClientFromChannel(ChannelBlobs, c)
 					return
 				}
 				log.Errorf("read websocket message: %s", err.Error())
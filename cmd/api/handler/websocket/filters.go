@@ -4,7 +4,13 @@
 package websocket
 
 import (
+	"encoding/base64"
+	"encoding/hex"
+
 	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
 )
 
 type Filterable[M INotification] interface {
@@ -37,7 +43,255 @@ func (f HeadFilter) Filter(c client, msg Notification[*responses.State]) bool {
 	return fltrs.head
 }
 
+type TxFilter struct{}
+
+func (f TxFilter) Filter(c client, msg Notification[*responses.Tx]) bool {
+	if msg.Body == nil {
+		return false
+	}
+	fltrs := c.Filters()
+	if fltrs == nil || fltrs.txs == nil {
+		return false
+	}
+	return fltrs.txs.Filter(*msg.Body)
+}
+
+type MessageFilter struct{}
+
+func (f MessageFilter) Filter(c client, msg Notification[*responses.Message]) bool {
+	if msg.Body == nil {
+		return false
+	}
+	fltrs := c.Filters()
+	if fltrs == nil || fltrs.messages == nil {
+		return false
+	}
+	return fltrs.messages.Filter(*msg.Body)
+}
+
+type BlobFilter struct{}
+
+func (f BlobFilter) Filter(c client, msg Notification[*responses.BlobLog]) bool {
+	if msg.Body == nil {
+		return false
+	}
+	fltrs := c.Filters()
+	if fltrs == nil || fltrs.blobs == nil {
+		return false
+	}
+	return fltrs.blobs.Filter(*msg.Body)
+}
+
 type Filters struct {
-	head   bool
-	blocks bool
+	head     bool
+	blocks   bool
+	txs      *txFilters
+	messages *messageFilters
+	blobs    *blobFilters
+}
+
+type txFilters struct {
+	status     map[types.Status]struct{}
+	msgTypes   types.MsgTypeBits
+	addresses  map[string]struct{}
+	namespaces map[string]struct{}
+}
+
+func newTxFilters(req TransactionFilters) (*txFilters, error) {
+	fltrs := &txFilters{
+		status:   make(map[types.Status]struct{}),
+		msgTypes: types.NewMsgTypeBits(),
+	}
+
+	for i := range req.Status {
+		status, err := types.ParseStatus(req.Status[i])
+		if err != nil {
+			return nil, errors.Wrap(ErrUnavailableFilter, req.Status[i])
+		}
+		fltrs.status[status] = struct{}{}
+	}
+
+	msgTypes, err := parseMsgTypes(req.Messages)
+	if err != nil {
+		return nil, err
+	}
+	fltrs.msgTypes = msgTypes
+
+	if fltrs.addresses, err = parseAddresses(req.Addresses); err != nil {
+		return nil, err
+	}
+	if fltrs.namespaces, err = parseNamespaces(req.Namespaces); err != nil {
+		return nil, err
+	}
+	return fltrs, nil
+}
+
+func (f txFilters) Filter(tx responses.Tx) bool {
+	if len(f.status) > 0 {
+		if _, ok := f.status[tx.Status]; !ok {
+			return false
+		}
+	}
+	if !f.msgTypes.Empty() && !f.msgTypes.HasOne(tx.MsgTypeMask) {
+		return false
+	}
+
+	if len(f.addresses) > 0 {
+		var found bool
+		for i := range tx.Signers {
+			if _, found = f.addresses[tx.Signers[i]]; found {
+				break
+			}
+		}
+		for i := 0; i < len(tx.Messages) && !found; i++ {
+			found = containsAny(f.addresses, tx.Messages[i].Addresses)
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if len(f.namespaces) > 0 {
+		var found bool
+		for i := 0; i < len(tx.Messages) && !found; i++ {
+			found = containsAny(f.namespaces, tx.Messages[i].Namespaces)
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+type messageFilters struct {
+	msgTypes   types.MsgTypeBits
+	addresses  map[string]struct{}
+	namespaces map[string]struct{}
+}
+
+func newMessageFilters(req MessageFilters) (*messageFilters, error) {
+	msgTypes, err := parseMsgTypes(req.Messages)
+	if err != nil {
+		return nil, err
+	}
+	fltrs := &messageFilters{
+		msgTypes: msgTypes,
+	}
+
+	if fltrs.addresses, err = parseAddresses(req.Addresses); err != nil {
+		return nil, err
+	}
+	if fltrs.namespaces, err = parseNamespaces(req.Namespaces); err != nil {
+		return nil, err
+	}
+	return fltrs, nil
+}
+
+func (f messageFilters) Filter(msg responses.Message) bool {
+	if !f.msgTypes.Empty() && !f.msgTypes.HasOne(types.NewMsgTypeBitMask(msg.Type)) {
+		return false
+	}
+	if len(f.addresses) > 0 && !containsAny(f.addresses, msg.Addresses) {
+		return false
+	}
+	if len(f.namespaces) > 0 && !containsAny(f.namespaces, msg.Namespaces) {
+		return false
+	}
+	return true
+}
+
+type blobFilters struct {
+	namespaces map[string]struct{}
+	signers    map[string]struct{}
 }
+
+func newBlobFilters(req BlobFilters) (*blobFilters, error) {
+	if len(req.Namespaces) == 0 {
+		return nil, errors.Wrap(ErrUnavailableFilter, "empty namespace list")
+	}
+
+	namespaces, err := parseNamespaces(req.Namespaces)
+	if err != nil {
+		return nil, err
+	}
+	signers, err := parseAddresses(req.Signers)
+	if err != nil {
+		return nil, err
+	}
+	return &blobFilters{
+		namespaces: namespaces,
+		signers:    signers,
+	}, nil
+}
+
+func (f blobFilters) Filter(blob responses.BlobLog) bool {
+	if blob.Namespace == nil {
+		return false
+	}
+	if _, ok := f.namespaces[blob.Namespace.Hash]; !ok {
+		return false
+	}
+	if len(f.signers) > 0 {
+		if _, ok := f.signers[blob.Signer]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func parseMsgTypes(values []string) (types.MsgTypeBits, error) {
+	mask := types.NewMsgTypeBits()
+	for i := range values {
+		msgType, err := types.ParseMsgType(values[i])
+		if err != nil {
+			return mask, errors.Wrap(ErrUnavailableFilter, values[i])
+		}
+		mask.SetByMsgType(msgType)
+	}
+	return mask, nil
+}
+
+func parseAddresses(values []string) (map[string]struct{}, error) {
+	addresses := make(map[string]struct{}, len(values))
+	for i := range values {
+		prefix, _, err := pkgTypes.Address(values[i]).Decode()
+		if err != nil || prefix != pkgTypes.AddressPrefixCelestia {
+			return nil, errors.Wrap(ErrUnavailableFilter, values[i])
+		}
+		addresses[values[i]] = struct{}{}
+	}
+	return addresses, nil
+}
+
+// parseNamespaces - receives namespaces in hex or base64 encoding and returns them as set of base64 strings
+func parseNamespaces(values []string) (map[string]struct{}, error) {
+	namespaces := make(map[string]struct{}, len(values))
+	for i := range values {
+		var (
+			data []byte
+			err  error
+		)
+		if len(values[i]) == namespaceSize*2 {
+			data, err = hex.DecodeString(values[i])
+		} else {
+			data, err = base64.StdEncoding.DecodeString(values[i])
+		}
+		if err != nil || len(data) != namespaceSize {
+			return nil, errors.Wrap(ErrUnavailableFilter, values[i])
+		}
+		namespaces[base64.StdEncoding.EncodeToString(data)] = struct{}{}
+	}
+	return namespaces, nil
+}
+
+func containsAny(set map[string]struct{}, values []string) bool {
+	for i := range values {
+		if _, ok := set[values[i]]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// namespaceSize - size of version byte and namespace id
+const namespaceSize = 29
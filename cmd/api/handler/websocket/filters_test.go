@@ -0,0 +1,266 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package websocket
+
+import (
+	"testing"
+
+	"github.com/celenium-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testAddress   = "celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8"
+	testNamespace = "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA="
+)
+
+func testTxResponse() responses.Tx {
+	return responses.Tx{
+		Id:          1,
+		Status:      types.StatusSuccess,
+		MsgTypeMask: types.NewMsgTypeBitMask(types.MsgPayForBlobs),
+		Signers:     []string{testAddress},
+		Messages: []responses.Message{
+			{
+				Id:         1,
+				Type:       types.MsgPayForBlobs,
+				Addresses:  []string{testAddress},
+				Namespaces: []string{testNamespace},
+			},
+		},
+	}
+}
+
+func TestTxFilters(t *testing.T) {
+	tests := []struct {
+		name    string
+		filters TransactionFilters
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:    "empty filters",
+			filters: TransactionFilters{},
+			want:    true,
+		}, {
+			name: "status",
+			filters: TransactionFilters{
+				Status: []string{"success"},
+			},
+			want: true,
+		}, {
+			name: "another status",
+			filters: TransactionFilters{
+				Status: []string{"failed"},
+			},
+			want: false,
+		}, {
+			name: "message types",
+			filters: TransactionFilters{
+				Messages: []string{"MsgSend", "MsgPayForBlobs"},
+			},
+			want: true,
+		}, {
+			name: "another message types",
+			filters: TransactionFilters{
+				Messages: []string{"MsgSend"},
+			},
+			want: false,
+		}, {
+			name: "address",
+			filters: TransactionFilters{
+				Addresses: []string{testAddress},
+			},
+			want: true,
+		}, {
+			name: "another address",
+			filters: TransactionFilters{
+				Addresses: []string{"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"},
+			},
+			want: false,
+		}, {
+			name: "namespace",
+			filters: TransactionFilters{
+				Status:     []string{"success"},
+				Namespaces: []string{testNamespace},
+			},
+			want: true,
+		}, {
+			name: "invalid status",
+			filters: TransactionFilters{
+				Status: []string{"unknown"},
+			},
+			wantErr: true,
+		}, {
+			name: "invalid address",
+			filters: TransactionFilters{
+				Addresses: []string{"celestiavaloper1"},
+			},
+			wantErr: true,
+		}, {
+			name: "invalid namespace",
+			filters: TransactionFilters{
+				Namespaces: []string{"AAAA"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fltrs, err := newTxFilters(tt.filters)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			tx := testTxResponse()
+			require.Equal(t, tt.want, fltrs.Filter(tx))
+			require.Equal(t, tt.want, fltrs.Filter(tx), "filter must not change state")
+		})
+	}
+}
+
+func TestMessageFilters(t *testing.T) {
+	tests := []struct {
+		name    string
+		filters MessageFilters
+		want    bool
+	}{
+		{
+			name:    "empty filters",
+			filters: MessageFilters{},
+			want:    true,
+		}, {
+			name: "message type",
+			filters: MessageFilters{
+				Messages: []string{"MsgPayForBlobs"},
+			},
+			want: true,
+		}, {
+			name: "another message type",
+			filters: MessageFilters{
+				Messages: []string{"MsgDelegate"},
+			},
+			want: false,
+		}, {
+			name: "address and namespace",
+			filters: MessageFilters{
+				Addresses:  []string{testAddress},
+				Namespaces: []string{testNamespace},
+			},
+			want: true,
+		}, {
+			name: "another namespace",
+			filters: MessageFilters{
+				Namespaces: []string{"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAE="},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fltrs, err := newMessageFilters(tt.filters)
+			require.NoError(t, err)
+
+			tx := testTxResponse()
+			require.Equal(t, tt.want, fltrs.Filter(tx.Messages[0]))
+		})
+	}
+}
+
+func TestApplyTxFilters(t *testing.T) {
+	client := newClient(1, nil)
+
+	filters, err := json.Marshal(TransactionFilters{
+		Status:   []string{"success"},
+		Messages: []string{"MsgPayForBlobs"},
+	})
+	require.NoError(t, err)
+
+	err = client.ApplyFilters(Subscribe{
+		Channel: ChannelTxs,
+		Filters: filters,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, client.Filters().txs)
+
+	notification := NewTxNotification(testTxResponse())
+	require.True(t, TxFilter{}.Filter(client, notification))
+	require.False(t, MessageFilter{}.Filter(client, NewMessageNotification(notification.Body.Messages[0])))
+
+	err = client.DetachFilters(Unsubscribe{
+		Channel: ChannelTxs,
+	})
+	require.NoError(t, err)
+	require.Nil(t, client.Filters().txs)
+	require.False(t, TxFilter{}.Filter(client, notification))
+}
+
+func TestBlobFilters(t *testing.T) {
+	blob := responses.BlobLog{
+		Signer: testAddress,
+		Namespace: &responses.Namespace{
+			Hash: testNamespace,
+		},
+	}
+
+	tests := []struct {
+		name    string
+		filters BlobFilters
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "base64 namespace",
+			filters: BlobFilters{
+				Namespaces: []string{testNamespace},
+			},
+			want: true,
+		}, {
+			name: "hex namespace",
+			filters: BlobFilters{
+				Namespaces: []string{"0000000000000000000000000000000000000000000000000000000000"},
+			},
+			want: true,
+		}, {
+			name: "another namespace",
+			filters: BlobFilters{
+				Namespaces: []string{"0000000000000000000000000000000000000000000000000000000001"},
+			},
+			want: false,
+		}, {
+			name: "namespace and signer",
+			filters: BlobFilters{
+				Namespaces: []string{testNamespace},
+				Signers:    []string{testAddress},
+			},
+			want: true,
+		}, {
+			name: "another signer",
+			filters: BlobFilters{
+				Namespaces: []string{testNamespace},
+				Signers:    []string{"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"},
+			},
+			want: false,
+		}, {
+			name:    "empty namespaces",
+			filters: BlobFilters{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fltrs, err := newBlobFilters(tt.filters)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, fltrs.Filter(blob))
+		})
+	}
+}
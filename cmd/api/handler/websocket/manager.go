@@ -25,8 +25,11 @@ type Manager struct {
 	clients  *sdkSync.Map[uint64, *Client]
 	observer *bus.Observer
 
-	blocks *Channel[storage.Block, *responses.Block]
-	head   *Channel[storage.State, *responses.State]
+	blocks   *Channel[storage.Block, *responses.Block]
+	head     *Channel[storage.State, *responses.State]
+	txs      *Channel[storage.Tx, *responses.Tx]
+	messages *Channel[storage.Message, *responses.Message]
+	blobs    *Channel[storage.BlobLog, *responses.BlobLog]
 
 	g workerpool.Group
 }
@@ -56,6 +59,21 @@ func NewManager(observer *bus.Observer) *Manager {
 		HeadFilter{},
 	)
 
+	manager.txs = NewChannel[storage.Tx, *responses.Tx](
+		txProcessor,
+		TxFilter{},
+	)
+
+	manager.messages = NewChannel[storage.Message, *responses.Message](
+		messageProcessor,
+		MessageFilter{},
+	)
+
+	manager.blobs = NewChannel[storage.BlobLog, *responses.BlobLog](
+		blobProcessor,
+		BlobFilter{},
+	)
+
 	return manager
 }
 
@@ -72,6 +90,19 @@ func (manager *Manager) listen(ctx context.Context) {
 			if err := manager.head.processMessage(*state); err != nil {
 				log.Err(err).Msg("handle state")
 			}
+		case tx := <-manager.observer.Txs():
+			if err := manager.txs.processMessage(*tx); err != nil {
+				log.Err(err).Msg("handle tx")
+			}
+			for i := range tx.Messages {
+				if err := manager.messages.processMessage(tx.Messages[i]); err != nil {
+					log.Err(err).Msg("handle message")
+				}
+			}
+		case blob := <-manager.observer.Blobs():
+			if err := manager.blobs.processMessage(*blob); err != nil {
+				log.Err(err).Msg("handle blob")
+			}
 		}
 	}
 }
@@ -131,6 +162,12 @@ func (manager *Manager) AddClientToChannel(channel string, client *Client) {
 		manager.head.AddClient(client)
 	case ChannelBlocks:
 		manager.blocks.AddClient(client)
+	case ChannelTxs:
+		manager.txs.AddClient(client)
+	case ChannelMessages:
+		manager.messages.AddClient(client)
+	case ChannelBlobs:
+		manager.blobs.AddClient(client)
 	default:
 		log.Error().Str("channel", channel).Msg("unknown channel name")
 	}
@@ -142,6 +179,12 @@ func (manager *Manager) RemoveClientFromChannel(channel string, client *Client)
 		manager.head.RemoveClient(client.id)
 	case ChannelBlocks:
 		manager.blocks.RemoveClient(client.id)
+	case ChannelTxs:
+		manager.txs.RemoveClient(client.id)
+	case ChannelMessages:
+		manager.messages.RemoveClient(client.id)
+	case ChannelBlobs:
+		manager.blobs.RemoveClient(client.id)
 	default:
 		log.Error().Str("channel", channel).Msg("unknown channel name")
 	}
@@ -16,8 +16,11 @@ const (
 
 // channels
 const (
-	ChannelHead   = "head"
-	ChannelBlocks = "blocks"
+	ChannelHead     = "head"
+	ChannelBlocks   = "blocks"
+	ChannelTxs      = "txs"
+	ChannelMessages = "messages"
+	ChannelBlobs    = "blobs"
 )
 
 type Message struct {
@@ -26,21 +29,34 @@ type Message struct {
 }
 
 type Subscribe struct {
-	Channel string          `json:"channel" validate:"required,oneof=head tx"`
+	Channel string          `json:"channel" validate:"required,oneof=head blocks txs messages blobs"`
 	Filters json.RawMessage `json:"filters" validate:"required"`
 }
 
 type Unsubscribe struct {
-	Channel string `json:"channel" validate:"required,oneof=head tx"`
+	Channel string `json:"channel" validate:"required,oneof=head blocks txs messages blobs"`
 }
 
 type TransactionFilters struct {
-	Status   []string `json:"status,omitempty"`
-	Messages []string `json:"msg_type,omitempty"`
+	Status     []string `json:"status,omitempty"`
+	Messages   []string `json:"msg_type,omitempty"`
+	Addresses  []string `json:"address,omitempty"`
+	Namespaces []string `json:"namespace,omitempty"`
+}
+
+type MessageFilters struct {
+	Messages   []string `json:"msg_type,omitempty"`
+	Addresses  []string `json:"address,omitempty"`
+	Namespaces []string `json:"namespace,omitempty"`
+}
+
+type BlobFilters struct {
+	Namespaces []string `json:"namespace"`
+	Signers    []string `json:"signer,omitempty"`
 }
 
 type INotification interface {
-	*responses.Block | *responses.State
+	*responses.Block | *responses.State | *responses.Tx | *responses.Message | *responses.BlobLog
 }
 
 type Notification[T INotification] struct {
@@ -61,3 +77,24 @@ func NewStateNotification(state responses.State) Notification[*responses.State]
 		Body:    &state,
 	}
 }
+
+func NewTxNotification(tx responses.Tx) Notification[*responses.Tx] {
+	return Notification[*responses.Tx]{
+		Channel: ChannelTxs,
+		Body:    &tx,
+	}
+}
+
+func NewMessageNotification(msg responses.Message) Notification[*responses.Message] {
+	return Notification[*responses.Message]{
+		Channel: ChannelMessages,
+		Body:    &msg,
+	}
+}
+
+func NewBlobNotification(blob responses.BlobLog) Notification[*responses.BlobLog] {
+	return Notification[*responses.BlobLog]{
+		Channel: ChannelBlobs,
+		Body:    &blob,
+	}
+}
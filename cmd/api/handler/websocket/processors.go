@@ -17,3 +17,18 @@ func headProcessor(state storage.State) Notification[*responses.State] {
 	response := responses.NewState(state)
 	return NewStateNotification(response)
 }
+
+func txProcessor(tx storage.Tx) Notification[*responses.Tx] {
+	response := responses.NewTx(tx)
+	return NewTxNotification(response)
+}
+
+func messageProcessor(msg storage.Message) Notification[*responses.Message] {
+	response := responses.NewMessage(msg)
+	return NewMessageNotification(response)
+}
+
+func blobProcessor(blob storage.BlobLog) Notification[*responses.BlobLog] {
+	response := responses.NewBlobLog(blob)
+	return NewBlobNotification(response)
+}
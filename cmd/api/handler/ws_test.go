@@ -46,8 +46,11 @@ func TestWebsocket(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	blockMock := mock.NewMockIBlock(ctrl)
+	txMock := mock.NewMockITx(ctrl)
+	blobLogsMock := mock.NewMockIBlobLog(ctrl)
 	validatorsMock := mock.NewMockIValidator(ctrl)
-	dispatcher, err := bus.NewDispatcher(listenerFactory, blockMock, validatorsMock)
+	upgradesMock := mock.NewMockIUpgrade(ctrl)
+	dispatcher, err := bus.NewDispatcher(listenerFactory, blockMock, txMock, blobLogsMock, validatorsMock, upgradesMock)
 	require.NoError(t, err)
 	dispatcher.Start(ctx)
 	observer := dispatcher.Observe(storage.ChannelHead, storage.ChannelBlock)
@@ -18,6 +18,7 @@ import (
 	"github.com/celenium-io/celestia-indexer/cmd/api/handler"
 	"github.com/celenium-io/celestia-indexer/cmd/api/handler/websocket"
 	"github.com/celenium-io/celestia-indexer/internal/blob"
+	"github.com/celenium-io/celestia-indexer/internal/blob/decoder"
 	"github.com/celenium-io/celestia-indexer/internal/profiler"
 	"github.com/celenium-io/celestia-indexer/internal/storage"
 	"github.com/celenium-io/celestia-indexer/internal/storage/postgres"
@@ -118,6 +119,9 @@ func postSkipper(c echo.Context) bool {
 	if c.Path() == "/v1/blob/metadata" {
 		return true
 	}
+	if c.Path() == "/v1/blob/batch" {
+		return true
+	}
 	if c.Path() == "/v1/auth/rollup" {
 		return true
 	}
@@ -128,6 +132,9 @@ func gzipSkipper(c echo.Context) bool {
 	if c.Path() == "/v1/swagger/doc.json" {
 		return true
 	}
+	if c.Path() == "/v1/blob/:hash/:height/:commitment/raw" {
+		return true
+	}
 	if metricsSkipper(c) {
 		return true
 	}
@@ -258,7 +265,7 @@ func initEcho(cfg ApiConfig, db postgres.Storage, env string) *echo.Echo {
 var dispatcher *bus.Dispatcher
 
 func initDispatcher(ctx context.Context, db postgres.Storage) {
-	d, err := bus.NewDispatcher(db, db.Blocks, db.Validator)
+	d, err := bus.NewDispatcher(db, db.Blocks, db.Tx, db.BlobLogs, db.Validator, db.Upgrades)
 	if err != nil {
 		panic(err)
 	}
@@ -279,9 +286,9 @@ func initDatabase(cfg config.Database, viewsDir string) postgres.Storage {
 func initHandlers(ctx context.Context, e *echo.Echo, cfg Config, db postgres.Storage) {
 	v1 := e.Group("v1")
 
-	stateHandlers := handler.NewStateHandler(db.State, db.Validator, cfg.Indexer.Name)
+	stateHandlers := handler.NewStateHandler(db.State, db.Validator, db.Upgrades, cfg.Indexer.Name)
 	v1.GET("/head", stateHandlers.Head)
-	constantsHandler := handler.NewConstantHandler(db.Constants, db.DenomMetadata, db.Address)
+	constantsHandler := handler.NewConstantHandler(db.Constants, db.ConstantHistory, db.DenomMetadata, db.Address)
 	v1.GET("/constants", constantsHandler.Get)
 	v1.GET("/enums", constantsHandler.Enums)
 
@@ -291,7 +298,7 @@ func initHandlers(ctx context.Context, e *echo.Echo, cfg Config, db postgres.Sto
 	ttlCache := cache.NewTTLCache(cache.Config{MaxEntitiesCount: 1000}, time.Minute*15)
 	ttlCacheMiddleware := cache.Middleware(ttlCache, nil)
 
-	addressHandlers := handler.NewAddressHandler(db.Address, db.Tx, db.BlobLogs, db.Message, db.Delegation, db.Undelegation, db.Redelegation, db.VestingAccounts, db.Grants, db.State, cfg.Indexer.Name)
+	addressHandlers := handler.NewAddressHandler(db.Address, db.Tx, db.BlobLogs, db.Message, db.Delegation, db.Undelegation, db.Redelegation, db.VestingAccounts, db.Grants, db.Votes, db.Deposits, db.IbcTransfers, db.InterchainAccounts, db.BalanceHistory, db.Transfers, db.State, cfg.Indexer.Name)
 	addressesGroup := v1.Group("/address")
 	{
 		addressesGroup.GET("", addressHandlers.List)
@@ -308,6 +315,12 @@ func initHandlers(ctx context.Context, e *echo.Echo, cfg Config, db postgres.Sto
 			addressGroup.GET("/vestings", addressHandlers.Vestings)
 			addressGroup.GET("/grants", addressHandlers.Grants)
 			addressGroup.GET("/granters", addressHandlers.Grantee)
+			addressGroup.GET("/votes", addressHandlers.Votes)
+			addressGroup.GET("/deposits", addressHandlers.Deposits)
+			addressGroup.GET("/ibc", addressHandlers.Ibc)
+			addressGroup.GET("/ica_packets", addressHandlers.InterchainAccountPackets)
+			addressGroup.GET("/balance_history", addressHandlers.BalanceHistory)
+			addressGroup.GET("/transfers", addressHandlers.Transfers)
 			addressGroup.GET("/stats/:name/:timeframe", addressHandlers.Stats)
 		}
 	}
@@ -317,7 +330,7 @@ func initHandlers(ctx context.Context, e *echo.Echo, cfg Config, db postgres.Sto
 	}
 	node := rpc.NewAPI(ds)
 
-	blockHandlers := handler.NewBlockHandler(db.Blocks, db.BlockStats, db.Event, db.Namespace, db.Message, db.BlobLogs, db.State, &node, cfg.Indexer.Name)
+	blockHandlers := handler.NewBlockHandler(db.Blocks, db.BlockStats, db.BlockDah, db.Event, db.Namespace, db.Message, db.BlobLogs, db.State, &node, cfg.Indexer.Name)
 	blockGroup := v1.Group("/block")
 	{
 		blockGroup.GET("", blockHandlers.List)
@@ -331,6 +344,7 @@ func initHandlers(ctx context.Context, e *echo.Echo, cfg Config, db postgres.Sto
 			heightGroup.GET("/blobs", blockHandlers.Blobs, ttlCacheMiddleware)
 			heightGroup.GET("/blobs/count", blockHandlers.BlobsCount, ttlCacheMiddleware)
 			heightGroup.GET("/ods", blockHandlers.BlockODS, ttlCacheMiddleware)
+			heightGroup.GET("/dah", blockHandlers.GetDah, ttlCacheMiddleware)
 		}
 	}
 
@@ -355,12 +369,20 @@ func initHandlers(ctx context.Context, e *echo.Echo, cfg Config, db postgres.Sto
 		panic(err)
 	}
 
-	namespaceHandlers := handler.NewNamespaceHandler(db.Namespace, db.BlobLogs, db.Rollup, db.State, cfg.Indexer.Name, blobReceiver)
+	blobDecoders, err := decoder.NewRegistryFromConfig(cfg.Indexer.BlobDecoders)
+	if err != nil {
+		panic(err)
+	}
+
+	namespaceHandlers := handler.NewNamespaceHandler(db.Namespace, db.BlobLogs, db.Rollup, db.State, cfg.Indexer.Name, blobReceiver, blobDecoders)
 
 	blobGroup := v1.Group("/blob")
 	{
 		blobGroup.POST("", namespaceHandlers.Blob)
 		blobGroup.POST("/metadata", namespaceHandlers.BlobMetadata)
+		blobGroup.POST("/batch", namespaceHandlers.BlobBatch)
+		blobGroup.GET("/:hash/:height/:commitment/raw", namespaceHandlers.BlobRaw)
+		blobGroup.GET("/proof", blockHandlers.BlobProof)
 	}
 
 	namespaceGroup := v1.Group("/namespace")
@@ -381,7 +403,7 @@ func initHandlers(ctx context.Context, e *echo.Echo, cfg Config, db postgres.Sto
 		namespaceByHash.GET("/:hash/:height", namespaceHandlers.GetBlobs)
 	}
 
-	validatorsHandler := handler.NewValidatorHandler(db.Validator, db.Blocks, db.BlockSignatures, db.Delegation, db.Constants, db.Jails, db.State, cfg.Indexer.Name)
+	validatorsHandler := handler.NewValidatorHandler(db.Validator, db.Blocks, db.BlockSignatures, db.Delegation, db.Constants, db.Jails, db.Slashes, db.ValidatorHistory, db.MissedBlocks, db.Votes, db.State, cfg.Indexer.Name)
 	validators := v1.Group("/validators")
 	{
 		validators.GET("", validatorsHandler.List)
@@ -393,6 +415,11 @@ func initHandlers(ctx context.Context, e *echo.Echo, cfg Config, db postgres.Sto
 			validator.GET("/uptime", validatorsHandler.Uptime)
 			validator.GET("/delegators", validatorsHandler.Delegators)
 			validator.GET("/jails", validatorsHandler.Jails)
+			validator.GET("/slashes", validatorsHandler.Slashes)
+			validator.GET("/history", validatorsHandler.History)
+			validator.GET("/signing", validatorsHandler.Signing)
+			validator.GET("/missed_blocks/:timeframe", validatorsHandler.MissedBlocks)
+			validator.GET("/votes", validatorsHandler.Votes)
 		}
 	}
 
@@ -438,6 +465,66 @@ func initHandlers(ctx context.Context, e *echo.Echo, cfg Config, db postgres.Sto
 		vesting.GET("/:id/periods", vestingHandler.Periods)
 	}
 
+	proposalHandler := handler.NewProposalHandler(db.Proposals, db.Votes, db.Deposits, db.Address)
+	proposals := v1.Group("/proposal")
+	{
+		proposals.GET("", proposalHandler.List)
+		proposal := proposals.Group("/:id")
+		{
+			proposal.GET("", proposalHandler.Get)
+			proposal.GET("/votes", proposalHandler.Votes)
+			proposal.GET("/deposits", proposalHandler.Deposits)
+		}
+	}
+
+	upgradeHandler := handler.NewUpgradeHandler(db.Upgrades)
+	v1.GET("/upgrades", upgradeHandler.List)
+
+	groupHandler := handler.NewGroupHandler(db.Groups, db.GroupMembers, db.GroupPolicies, db.GroupProposals, db.GroupVotes, db.Address)
+	groups := v1.Group("/group")
+	{
+		groups.GET("", groupHandler.List)
+		groups.GET("/policy/:address", groupHandler.Policy)
+		groupProposals := groups.Group("/proposal")
+		{
+			groupProposals.GET("", groupHandler.Proposals)
+			groupProposals.GET("/:id", groupHandler.Proposal)
+			groupProposals.GET("/:id/votes", groupHandler.ProposalVotes)
+		}
+		group := groups.Group("/:id")
+		{
+			group.GET("", groupHandler.Get)
+			group.GET("/members", groupHandler.Members)
+			group.GET("/policies", groupHandler.Policies)
+		}
+	}
+
+	ibcHandler := handler.NewIbcHandler(db.IbcClients, db.IbcConnections, db.IbcChannels, db.IbcTransfers, db.Address)
+	ibc := v1.Group("/ibc")
+	{
+		ibc.GET("/chains", ibcHandler.Chains)
+		ibc.GET("/client", ibcHandler.Clients)
+		ibc.GET("/client/:id", ibcHandler.Client)
+		ibc.GET("/connection", ibcHandler.Connections)
+		ibc.GET("/connection/:id", ibcHandler.Connection)
+		ibc.GET("/channel", ibcHandler.Channels)
+		ibc.GET("/channel/:id", ibcHandler.Channel)
+		ibc.GET("/transfer", ibcHandler.Transfers)
+		ibc.GET("/transfer/:id", ibcHandler.Transfer)
+	}
+
+	transferHandler := handler.NewTransferHandler(db.Transfers, db.Address)
+	v1.GET("/transfers", transferHandler.List)
+
+	blobstreamHandler := handler.NewBlobstreamHandler(db.Attestations, db.EvmAddresses)
+	blobstream := v1.Group("/blobstream")
+	{
+		blobstream.GET("/attestations", blobstreamHandler.Attestations)
+		blobstream.GET("/attestations/:nonce", blobstreamHandler.Attestation)
+		blobstream.GET("/data_commitment/:height", blobstreamHandler.DataCommitment)
+		blobstream.GET("/evm_addresses", blobstreamHandler.EvmAddresses)
+	}
+
 	if cfg.ApiConfig.Prometheus {
 		v1.GET("/metrics", echoprometheus.NewHandler())
 	}
@@ -556,7 +643,7 @@ var (
 )
 
 func initWebsocket(ctx context.Context, group *echo.Group) {
-	observer := dispatcher.Observe(storage.ChannelHead, storage.ChannelBlock)
+	observer := dispatcher.Observe(storage.ChannelHead, storage.ChannelBlock, storage.ChannelTx, storage.ChannelBlob)
 	wsManager = websocket.NewManager(observer)
 	wsManager.Start(ctx)
 	group.GET("/ws", wsManager.Handle)
@@ -584,15 +671,8 @@ func initGasTracker(ctx context.Context, db postgres.Storage) {
 
 func initBlobReceiver(ctx context.Context, cfg Config) (node.DalApi, error) {
 	switch cfg.ApiConfig.BlobReceiver {
-	case "r2":
-		r2 := blob.NewR2(blob.R2Config{
-			BucketName:      os.Getenv("R2_BUCKET"),
-			AccountId:       os.Getenv("R2_ACCOUNT_ID"),
-			AccessKeyId:     os.Getenv("R2_ACCESS_KEY_ID"),
-			AccessKeySecret: os.Getenv("R2_ACCESS_KEY_SECRET"),
-		})
-		err := r2.Init(ctx)
-		return r2, err
+	case blob.KindR2, blob.KindS3, blob.KindFS:
+		return blob.New(ctx, cfg.ApiConfig.BlobReceiver, cfg.Indexer.BlobStorage)
 	default:
 		datasource, ok := cfg.DataSources[cfg.ApiConfig.BlobReceiver]
 		if !ok {
@@ -79,7 +79,14 @@ func TestRoutes(t *testing.T) {
 		"/v1/block/count GET":                                 {},
 		"/v1/tx/genesis GET":                                  {},
 		"/v1/blob/metadata POST":                              {},
+		"/v1/blob/proof GET":                                  {},
+		"/v1/blob/batch POST":                                 {},
+		"/v1/blob/:hash/:height/:commitment/raw GET":          {},
 		"/v1/validators/:id/jails GET":                        {},
+		"/v1/validators/:id/slashes GET":                      {},
+		"/v1/validators/:id/history GET":                      {},
+		"/v1/validators/:id/signing GET":                      {},
+		"/v1/validators/:id/missed_blocks/:timeframe GET":     {},
 		"/v1/head GET":                                        {},
 		"/v1/address/:hash/stats/:name/:timeframe GET":        {},
 		"/v1/block/:height GET":                               {},
@@ -88,6 +95,7 @@ func TestRoutes(t *testing.T) {
 		"/v1/stats/price/series/:timeframe GET":               {},
 		"/v1/gas/price GET":                                   {},
 		"/v1/block/:height/ods GET":                           {},
+		"/v1/block/:height/dah GET":                           {},
 		"/v1/tx/:hash/blobs GET":                              {},
 		"/v1/namespace/:id/:version/messages GET":             {},
 		"/v1/validators/:id/uptime GET":                       {},
@@ -97,6 +105,40 @@ func TestRoutes(t *testing.T) {
 		"/v1/block/:height/stats GET":                         {},
 		"/v1/rollup/:id/export GET":                           {},
 		"/v1/docs GET":                                        {},
+		"/v1/address/:hash/votes GET":                         {},
+		"/v1/address/:hash/deposits GET":                      {},
+		"/v1/validators/:id/votes GET":                        {},
+		"/v1/proposal GET":                                    {},
+		"/v1/proposal/:id GET":                                {},
+		"/v1/proposal/:id/votes GET":                          {},
+		"/v1/proposal/:id/deposits GET":                       {},
+		"/v1/upgrades GET":                                    {},
+		"/v1/group GET":                                       {},
+		"/v1/group/:id GET":                                   {},
+		"/v1/group/:id/members GET":                           {},
+		"/v1/group/:id/policies GET":                          {},
+		"/v1/group/policy/:address GET":                       {},
+		"/v1/group/proposal GET":                              {},
+		"/v1/group/proposal/:id GET":                          {},
+		"/v1/group/proposal/:id/votes GET":                    {},
+		"/v1/address/:hash/ibc GET":                           {},
+		"/v1/address/:hash/ica_packets GET":                   {},
+		"/v1/address/:hash/balance_history GET":               {},
+		"/v1/address/:hash/transfers GET":                     {},
+		"/v1/transfers GET":                                   {},
+		"/v1/blobstream/attestations GET":                     {},
+		"/v1/blobstream/attestations/:nonce GET":              {},
+		"/v1/blobstream/data_commitment/:height GET":          {},
+		"/v1/blobstream/evm_addresses GET":                    {},
+		"/v1/ibc/chains GET":                                  {},
+		"/v1/ibc/client GET":                                  {},
+		"/v1/ibc/client/:id GET":                              {},
+		"/v1/ibc/connection GET":                              {},
+		"/v1/ibc/connection/:id GET":                          {},
+		"/v1/ibc/channel GET":                                 {},
+		"/v1/ibc/channel/:id GET":                             {},
+		"/v1/ibc/transfer GET":                                {},
+		"/v1/ibc/transfer/:id GET":                            {},
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/celenium-io/celestia-indexer/internal/blob"
+	blobsaver "github.com/celenium-io/celestia-indexer/pkg/indexer/blob_saver"
+	"github.com/celenium-io/celestia-indexer/pkg/node/rpc"
+	"github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+func backfillBlobsCmd() *cobra.Command {
+	var (
+		from     int64
+		to       int64
+		progress string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "backfill-blobs",
+		Short: "Saves blobs of already indexed blocks to the blob storage",
+		Long: `Walks blocks in range [from, to], extracts blobs of successful transactions and saves them to the blob storage configured in 'indexer.blob_saver' and 'indexer.blob_storage'.
+Blocks are processed in parallel by 'indexer.threads_count' workers. Progress is written to the file, so the command can be restarted and continues from the last saved height.
+By default 'to' is the head of the blob storage, i.e. the height from which blob saver of running indexer saves blobs.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return backfillBlobs(types.Level(from), types.Level(to), progress)
+		},
+	}
+
+	cmd.Flags().Int64Var(&from, "from", 1, "first height of the range")
+	cmd.Flags().Int64Var(&to, "to", 0, "last height of the range (default: blob storage head)")
+	cmd.Flags().StringVar(&progress, "progress", "blob_backfill.json", "path to the file with backfill progress")
+	return cmd
+}
+
+func backfillBlobs(from, to types.Level, progress string) error {
+	cfg, err := initConfig(configPath)
+	if err != nil {
+		return err
+	}
+	if err := initLogger(cfg.LogLevel); err != nil {
+		return err
+	}
+	if cfg.Indexer.BlobSaver == "" {
+		return errors.New("blob saver is not configured: set 'indexer.blob_saver'")
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM, syscall.SIGINT)
+	defer cancel()
+
+	storage, err := blob.New(ctx, cfg.Indexer.BlobSaver, cfg.Indexer.BlobStorage)
+	if err != nil {
+		return err
+	}
+
+	if to == 0 {
+		head, err := storage.Head(ctx)
+		if err != nil {
+			return errors.Wrap(err, "can't receive blob storage head")
+		}
+		if head == 0 {
+			return errors.New("blob storage head is empty: set '--to' flag")
+		}
+		to = types.Level(head)
+	}
+	if from < 1 || from > to {
+		return errors.Errorf("invalid height range: [%d, %d]", from, to)
+	}
+
+	api := rpc.NewAPI(cfg.DataSources["node_rpc"])
+	backfill := blobsaver.NewBackfill(&api, storage, int(cfg.Indexer.ThreadsCount), progress)
+	if err := backfill.Run(ctx, from, to); err != nil {
+		return err
+	}
+
+	log.Info().Msg("stopped")
+	return nil
+}
@@ -17,13 +17,29 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var rootCmd = &cobra.Command{
-	Use:   "indexer",
-	Short: "DipDup Verticals | Celenium Indexer",
-}
+var (
+	configPath string
+
+	rootCmd = &cobra.Command{
+		Use:   "indexer",
+		Short: "DipDup Verticals | Celenium Indexer",
+		Run: func(cmd *cobra.Command, args []string) {
+			run()
+		},
+	}
+)
 
 func main() {
-	cfg, err := initConfig()
+	rootCmd.PersistentFlags().StringVarP(&configPath, "config", "c", "dipdup.yml", "path to YAML config file")
+	rootCmd.AddCommand(backfillBlobsCmd())
+
+	if err := rootCmd.Execute(); err != nil {
+		log.Panic().Err(err).Msg("command line execute")
+	}
+}
+
+func run() {
+	cfg, err := initConfig(configPath)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,11 @@
+CREATE MATERIALIZED VIEW IF NOT EXISTS missed_blocks_by_hour
+WITH (timescaledb.continuous, timescaledb.materialized_only=false) AS
+    select 
+        time_bucket('1 hour'::interval, time) AS time, 
+        missed.validator_id,
+        count(*) as count
+    from missed_block as missed
+    group by 1, 2
+	with no data;
+        
+CALL add_view_refresh_job('missed_blocks_by_hour', NULL, INTERVAL '1 minute');
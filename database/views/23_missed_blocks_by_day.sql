@@ -0,0 +1,11 @@
+CREATE MATERIALIZED VIEW IF NOT EXISTS missed_blocks_by_day
+WITH (timescaledb.continuous, timescaledb.materialized_only=false) AS
+    select 
+        time_bucket('1 day'::interval, time) AS time, 
+        missed.validator_id,
+        sum(count) as count
+    from missed_blocks_by_hour as missed
+    group by 1, 2
+	with no data;
+        
+CALL add_view_refresh_job('missed_blocks_by_day', NULL, INTERVAL '5 minute');
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package blob
+
+import (
+	"context"
+	"io"
+
+	nodeTypes "github.com/celenium-io/celestia-indexer/pkg/node/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// kinds of blob storage
+const (
+	KindR2 = "r2"
+	KindS3 = "s3"
+	KindFS = "fs"
+)
+
+type Config struct {
+	Dir             string `validate:"omitempty"     yaml:"dir"`
+	Endpoint        string `validate:"omitempty,url" yaml:"endpoint"`
+	Region          string `validate:"omitempty"     yaml:"region"`
+	Bucket          string `validate:"omitempty"     yaml:"bucket"`
+	AccessKeyId     string `validate:"omitempty"     yaml:"access_key_id"`
+	AccessKeySecret string `validate:"omitempty"     yaml:"access_key_secret"`
+	UsePathStyle    bool   `validate:"omitempty"     yaml:"use_path_style"`
+
+	R2 R2Config `validate:"omitempty" yaml:"r2"`
+}
+
+// Backend - blob storage which saves blobs and returns them back
+type Backend interface {
+	Storage
+
+	Blob(ctx context.Context, height pkgTypes.Level, namespace, commitment string) (nodeTypes.Blob, error)
+	Blobs(ctx context.Context, height pkgTypes.Level, hash ...string) ([]nodeTypes.Blob, error)
+}
+
+// RawReader - blob storage which streams raw blob data without loading the whole blob to memory
+type RawReader interface {
+	RawBlob(ctx context.Context, height pkgTypes.Level, namespace, commitment string) (RawBlob, error)
+}
+
+// RawBlob - raw data of the blob. Content type is empty if storage doesn't know it.
+type RawBlob struct {
+	io.ReadSeekCloser
+	ContentType string
+}
+
+// New - creates and initializes blob storage of the kind
+func New(ctx context.Context, kind string, cfg Config) (Backend, error) {
+	switch kind {
+	case KindR2:
+		r2 := NewR2(cfg.R2)
+		if err := r2.Init(ctx); err != nil {
+			return nil, errors.Wrap(err, "r2 initialization")
+		}
+		return r2, nil
+	case KindS3:
+		s3 := NewS3(S3Config{
+			Endpoint:        cfg.Endpoint,
+			Region:          cfg.Region,
+			BucketName:      cfg.Bucket,
+			AccessKeyId:     cfg.AccessKeyId,
+			AccessKeySecret: cfg.AccessKeySecret,
+			UsePathStyle:    cfg.UsePathStyle,
+		})
+		if err := s3.Init(ctx); err != nil {
+			return nil, errors.Wrap(err, "s3 initialization")
+		}
+		return s3, nil
+	case KindFS:
+		fs := NewFS(FSConfig{
+			Dir: cfg.Dir,
+		})
+		if err := fs.Init(ctx); err != nil {
+			return nil, errors.Wrap(err, "filesystem storage initialization")
+		}
+		return fs, nil
+	default:
+		return nil, errors.Errorf("unknown blob storage kind: %s", kind)
+	}
+}
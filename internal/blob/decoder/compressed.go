@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package decoder
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+
+	"github.com/andybalholm/brotli"
+	"github.com/pkg/errors"
+)
+
+const (
+	Gzip   = "gzip"
+	Zlib   = "zlib"
+	Brotli = "brotli"
+)
+
+// Compressed - decompresses payload and decodes it by inner decoders
+type Compressed struct {
+	algorithm string
+	inner     []Decoder
+}
+
+func NewCompressed(algorithm string, inner ...Decoder) Compressed {
+	return Compressed{
+		algorithm: algorithm,
+		inner:     inner,
+	}
+}
+
+func (c Compressed) Name() string {
+	return c.algorithm
+}
+
+func (c Compressed) Decode(data []byte) (*Result, error) {
+	decompressed, err := decompress(c.algorithm, data)
+	if err != nil {
+		return nil, ErrUnsupported
+	}
+
+	for i := range c.inner {
+		result, err := c.inner[i].Decode(decompressed)
+		if err != nil {
+			continue
+		}
+		result.Compression = c.algorithm
+		return result, nil
+	}
+	return nil, ErrUnsupported
+}
+
+func decompress(algorithm string, data []byte) ([]byte, error) {
+	var (
+		reader io.Reader
+		err    error
+	)
+
+	switch algorithm {
+	case Gzip:
+		if len(data) < 2 || data[0] != 0x1f || data[1] != 0x8b {
+			return nil, ErrUnsupported
+		}
+		reader, err = gzip.NewReader(bytes.NewReader(data))
+	case Zlib:
+		// CMF byte should set deflate method and header checksum should be valid
+		if len(data) < 2 || data[0]&0x0f != 8 || (uint16(data[0])<<8|uint16(data[1]))%31 != 0 {
+			return nil, ErrUnsupported
+		}
+		reader, err = zlib.NewReader(bytes.NewReader(data))
+	case Brotli:
+		reader = brotli.NewReader(bytes.NewReader(data))
+	default:
+		return nil, errors.Errorf("unknown compression algorithm: %s", algorithm)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	decompressed, err := io.ReadAll(io.LimitReader(reader, maxDecompressedSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(decompressed) > maxDecompressedSize {
+		return nil, errors.Errorf("decompressed payload exceeds %d bytes", maxDecompressedSize)
+	}
+	return decompressed, nil
+}
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package decoder
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Config - configuration of blob payload decoders. Decoders are set by names: `op_stack`, `json`, `protobuf`
+// or compressed payload in form `<algorithm>+<decoder>` where algorithm is one of `gzip`, `zlib` or `brotli`.
+// If no decoders are set the default registry is used.
+type Config struct {
+	Enabled    bool                `validate:"omitempty" yaml:"enabled"`
+	Stacks     map[string][]string `validate:"omitempty" yaml:"stacks"`
+	Namespaces map[string][]string `validate:"omitempty" yaml:"namespaces"`
+	Fallback   []string            `validate:"omitempty" yaml:"fallback"`
+}
+
+// NewRegistryFromConfig - creates registry with decoders from config
+func NewRegistryFromConfig(cfg Config) (*Registry, error) {
+	if len(cfg.Stacks) == 0 && len(cfg.Namespaces) == 0 && len(cfg.Fallback) == 0 {
+		return NewDefaultRegistry(), nil
+	}
+
+	fallback, err := decodersByNames(cfg.Fallback)
+	if err != nil {
+		return nil, errors.Wrap(err, "fallback")
+	}
+	r := NewRegistry(fallback...)
+
+	for stack, names := range cfg.Stacks {
+		decoders, err := decodersByNames(names)
+		if err != nil {
+			return nil, errors.Wrapf(err, "stack %s", stack)
+		}
+		r.RegisterStack(stack, decoders...)
+	}
+
+	for namespace, names := range cfg.Namespaces {
+		decoders, err := decodersByNames(names)
+		if err != nil {
+			return nil, errors.Wrapf(err, "namespace %s", namespace)
+		}
+		r.RegisterNamespace(namespace, decoders...)
+	}
+
+	return r, nil
+}
+
+func decodersByNames(names []string) ([]Decoder, error) {
+	decoders := make([]Decoder, len(names))
+	for i := range names {
+		decoder, err := decoderByName(names[i])
+		if err != nil {
+			return nil, err
+		}
+		decoders[i] = decoder
+	}
+	return decoders, nil
+}
+
+func decoderByName(name string) (Decoder, error) {
+	if algorithm, inner, ok := strings.Cut(name, "+"); ok {
+		switch algorithm {
+		case Gzip, Zlib, Brotli:
+		default:
+			return nil, errors.Errorf("unknown compression algorithm: %s", algorithm)
+		}
+
+		decoder, err := decoderByName(inner)
+		if err != nil {
+			return nil, err
+		}
+		return NewCompressed(algorithm, decoder), nil
+	}
+
+	switch name {
+	case OpStack{}.Name():
+		return OpStack{}, nil
+	case JSON{}.Name():
+		return JSON{}, nil
+	case Protobuf{}.Name():
+		return Protobuf{}, nil
+	default:
+		return nil, errors.Errorf("unknown decoder: %s", name)
+	}
+}
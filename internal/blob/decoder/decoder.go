@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package decoder
+
+import (
+	"github.com/pkg/errors"
+)
+
+var ErrUnsupported = errors.New("unsupported blob format")
+
+// maxDecompressedSize - limit of decompressed payload size. It protects from decompression bombs.
+const maxDecompressedSize = 10 * 1024 * 1024
+
+// Result - structured view of the blob payload
+type Result struct {
+	Decoder     string `json:"decoder"`
+	Compression string `json:"compression,omitempty"`
+	Data        any    `json:"data,omitempty"`
+	Batch       *Batch `json:"batch,omitempty"`
+}
+
+// Batch - metadata of the rollup batch posted in the blob
+type Batch struct {
+	Channels      []string `json:"channels,omitempty"`
+	FramesCount   int      `json:"frames_count,omitempty"`
+	BatchesCount  int      `json:"batches_count,omitempty"`
+	L2BlocksCount int64    `json:"l2_blocks_count,omitempty"`
+	L2TimeFrom    uint64   `json:"l2_time_from,omitempty"`
+	L2TimeTo      uint64   `json:"l2_time_to,omitempty"`
+	L1OriginFrom  uint64   `json:"l1_origin_from,omitempty"`
+	L1OriginTo    uint64   `json:"l1_origin_to,omitempty"`
+}
+
+// Decoder - decoder of blob payload. It returns ErrUnsupported if data has another format.
+type Decoder interface {
+	Name() string
+	Decode(data []byte) (*Result, error)
+}
+
+// Registry - set of decoders keyed by rollup stack and namespace.
+// Decoders registered for the namespace have priority over decoders of the stack.
+// Fallback decoders are applied when nothing of specific ones can decode the blob.
+type Registry struct {
+	stacks     map[string][]Decoder
+	namespaces map[string][]Decoder
+	fallback   []Decoder
+}
+
+func NewRegistry(fallback ...Decoder) *Registry {
+	return &Registry{
+		stacks:     make(map[string][]Decoder),
+		namespaces: make(map[string][]Decoder),
+		fallback:   fallback,
+	}
+}
+
+// NewDefaultRegistry - registry with built-in decoders
+func NewDefaultRegistry() *Registry {
+	r := NewRegistry(
+		OpStack{},
+		NewCompressed(Gzip, JSON{}, Protobuf{}),
+		NewCompressed(Zlib, JSON{}, Protobuf{}),
+		JSON{},
+		NewCompressed(Brotli, JSON{}),
+	)
+	r.RegisterStack("op_stack", OpStack{})
+	r.RegisterStack("rollkit", Protobuf{}, NewCompressed(Brotli, Protobuf{}))
+	return r
+}
+
+// RegisterStack - registers decoders for rollups built on the stack
+func (r *Registry) RegisterStack(stack string, decoders ...Decoder) {
+	r.stacks[stack] = append(r.stacks[stack], decoders...)
+}
+
+// RegisterNamespace - registers decoders for base64-encoded namespace (version byte followed by namespace id)
+func (r *Registry) RegisterNamespace(namespace string, decoders ...Decoder) {
+	r.namespaces[namespace] = append(r.namespaces[namespace], decoders...)
+}
+
+// Decode - tries decoders of the namespace, then decoders of the stack and then fallback ones.
+// The result of the first succeeded decoder is returned.
+func (r *Registry) Decode(stack, namespace string, data []byte) (*Result, error) {
+	if len(data) == 0 {
+		return nil, ErrUnsupported
+	}
+
+	for _, decoders := range [][]Decoder{
+		r.namespaces[namespace],
+		r.stacks[stack],
+		r.fallback,
+	} {
+		for i := range decoders {
+			result, err := decoders[i].Decode(data)
+			if err != nil {
+				continue
+			}
+			return result, nil
+		}
+	}
+
+	return nil, ErrUnsupported
+}
@@ -0,0 +1,340 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package decoder
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+type testDecoder string
+
+func (d testDecoder) Name() string {
+	return string(d)
+}
+
+func (d testDecoder) Decode(data []byte) (*Result, error) {
+	return &Result{Decoder: d.Name()}, nil
+}
+
+func rlpString(data []byte) []byte {
+	if len(data) == 1 && data[0] < 0x80 {
+		return data
+	}
+	return append(rlpHeader(0x80, len(data)), data...)
+}
+
+func rlpListOf(items ...[]byte) []byte {
+	content := bytes.Join(items, nil)
+	return append(rlpHeader(0xc0, len(content)), content...)
+}
+
+func rlpHeader(base byte, size int) []byte {
+	if size <= 55 {
+		return []byte{base + byte(size)}
+	}
+	sizeBytes := bytes.TrimLeft(binary.BigEndian.AppendUint64(nil, uint64(size)), "\x00")
+	return append([]byte{base + 55 + byte(len(sizeBytes))}, sizeBytes...)
+}
+
+func rlpUintBytes(value uint64) []byte {
+	return rlpString(bytes.TrimLeft(binary.BigEndian.AppendUint64(nil, value), "\x00"))
+}
+
+func singularBatch(epoch, timestamp uint64) []byte {
+	batch := rlpListOf(
+		rlpString(bytes.Repeat([]byte{1}, 32)),
+		rlpUintBytes(epoch),
+		rlpString(bytes.Repeat([]byte{2}, 32)),
+		rlpUintBytes(timestamp),
+		rlpListOf(rlpString([]byte("tx"))),
+	)
+	return rlpString(append([]byte{opStackSingularBatch}, batch...))
+}
+
+func spanBatch(l1Origin, blockCount uint64) []byte {
+	batch := []byte{opStackSpanBatch}
+	batch = binary.AppendUvarint(batch, 100)
+	batch = binary.AppendUvarint(batch, l1Origin)
+	batch = append(batch, bytes.Repeat([]byte{3}, 40)...)
+	batch = binary.AppendUvarint(batch, blockCount)
+	return rlpString(batch)
+}
+
+func opStackFrames(channelId byte, channel []byte, framesCount int) []byte {
+	data := []byte{opStackDerivationVersion}
+	chunk := len(channel)/framesCount + 1
+	for i := 0; i < framesCount; i++ {
+		end := min((i+1)*chunk, len(channel))
+		frameData := channel[min(i*chunk, len(channel)):end]
+
+		data = append(data, bytes.Repeat([]byte{channelId}, opStackChannelIdLength)...)
+		data = binary.BigEndian.AppendUint16(data, uint16(i))
+		data = binary.BigEndian.AppendUint32(data, uint32(len(frameData)))
+		data = append(data, frameData...)
+		if i == framesCount-1 {
+			data = append(data, 1)
+		} else {
+			data = append(data, 0)
+		}
+	}
+	return data
+}
+
+func compressZlib(t *testing.T, data []byte) []byte {
+	var buf bytes.Buffer
+	w := zlib.NewWriter(&buf)
+	_, err := w.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func compressGzip(t *testing.T, data []byte) []byte {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, err := w.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func compressBrotli(t *testing.T, data []byte) []byte {
+	var buf bytes.Buffer
+	w := brotli.NewWriter(&buf)
+	_, err := w.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestOpStackSingularBatches(t *testing.T) {
+	channel := compressZlib(t, bytes.Join([][]byte{
+		singularBatch(10, 1000),
+		singularBatch(10, 1002),
+		singularBatch(11, 1004),
+	}, nil))
+
+	result, err := OpStack{}.Decode(opStackFrames(0xaa, channel, 2))
+	require.NoError(t, err)
+	require.Equal(t, "op_stack", result.Decoder)
+	require.NotNil(t, result.Batch)
+
+	batch := result.Batch
+	require.Equal(t, 2, batch.FramesCount)
+	require.Len(t, batch.Channels, 1)
+	require.Equal(t, 3, batch.BatchesCount)
+	require.EqualValues(t, 3, batch.L2BlocksCount)
+	require.EqualValues(t, 1000, batch.L2TimeFrom)
+	require.EqualValues(t, 1004, batch.L2TimeTo)
+	require.EqualValues(t, 10, batch.L1OriginFrom)
+	require.EqualValues(t, 11, batch.L1OriginTo)
+
+	frames, ok := result.Data.([]OpStackFrame)
+	require.True(t, ok)
+	require.Len(t, frames, 2)
+	require.False(t, frames[0].IsLast)
+	require.True(t, frames[1].IsLast)
+}
+
+func TestOpStackSpanBatch(t *testing.T) {
+	channel := append([]byte{opStackChannelBrotli}, compressBrotli(t, spanBatch(20, 15))...)
+
+	result, err := OpStack{}.Decode(opStackFrames(0xbb, channel, 1))
+	require.NoError(t, err)
+	require.Equal(t, 1, result.Batch.BatchesCount)
+	require.EqualValues(t, 15, result.Batch.L2BlocksCount)
+	require.EqualValues(t, 20, result.Batch.L1OriginTo)
+}
+
+func TestOpStackIncompleteChannel(t *testing.T) {
+	channel := compressZlib(t, singularBatch(10, 1000))
+	data := opStackFrames(0xaa, channel, 2)
+	// cut the last frame
+	lastFrameSize := opStackFrameOverhead + len(channel) - (len(channel)/2 + 1)
+	data = data[:len(data)-lastFrameSize]
+
+	result, err := OpStack{}.Decode(data)
+	require.NoError(t, err)
+	require.Equal(t, 1, result.Batch.FramesCount)
+	require.Zero(t, result.Batch.BatchesCount)
+	require.Zero(t, result.Batch.L2BlocksCount)
+}
+
+func TestOpStackUnsupported(t *testing.T) {
+	for name, data := range map[string][]byte{
+		"empty":           nil,
+		"wrong version":   append([]byte{1}, opStackFrames(0xaa, []byte("data"), 1)[1:]...),
+		"truncated frame": opStackFrames(0xaa, []byte("data"), 1)[:20],
+		"invalid is_last": append(opStackFrames(0xaa, []byte("data"), 1)[:opStackFrameOverhead+4], 2),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := OpStack{}.Decode(data)
+			require.ErrorIs(t, err, ErrUnsupported)
+		})
+	}
+}
+
+func TestJSON(t *testing.T) {
+	result, err := JSON{}.Decode([]byte(` {"key": "value"} `))
+	require.NoError(t, err)
+	require.Equal(t, "json", result.Decoder)
+	require.JSONEq(t, `{"key": "value"}`, string(result.Data.(json.RawMessage)))
+
+	_, err = JSON{}.Decode([]byte(`"string"`))
+	require.ErrorIs(t, err, ErrUnsupported)
+
+	_, err = JSON{}.Decode([]byte(`{"key":`))
+	require.ErrorIs(t, err, ErrUnsupported)
+}
+
+func TestCompressed(t *testing.T) {
+	payload := []byte(`{"key":"value"}`)
+
+	for _, tt := range []struct {
+		algorithm string
+		data      []byte
+	}{
+		{Gzip, compressGzip(t, payload)},
+		{Zlib, compressZlib(t, payload)},
+		{Brotli, compressBrotli(t, payload)},
+	} {
+		t.Run(tt.algorithm, func(t *testing.T) {
+			result, err := NewCompressed(tt.algorithm, JSON{}).Decode(tt.data)
+			require.NoError(t, err)
+			require.Equal(t, "json", result.Decoder)
+			require.Equal(t, tt.algorithm, result.Compression)
+
+			_, err = NewCompressed(tt.algorithm, JSON{}).Decode(payload)
+			require.ErrorIs(t, err, ErrUnsupported)
+		})
+	}
+}
+
+func TestProtobuf(t *testing.T) {
+	inner := protowire.AppendTag(nil, 1, protowire.VarintType)
+	inner = protowire.AppendVarint(inner, 150)
+
+	data := protowire.AppendTag(nil, 1, protowire.BytesType)
+	data = protowire.AppendString(data, "rollup")
+	data = protowire.AppendTag(data, 2, protowire.BytesType)
+	data = protowire.AppendBytes(data, inner)
+	data = protowire.AppendTag(data, 3, protowire.Fixed64Type)
+	data = protowire.AppendFixed64(data, 42)
+
+	result, err := Protobuf{}.Decode(data)
+	require.NoError(t, err)
+	require.Equal(t, "protobuf", result.Decoder)
+
+	fields, ok := result.Data.([]ProtobufField)
+	require.True(t, ok)
+	require.Len(t, fields, 3)
+	require.Equal(t, "string", fields[0].Type)
+	require.Equal(t, "rollup", fields[0].Value)
+	require.Equal(t, "message", fields[1].Type)
+	require.Len(t, fields[1].Message, 1)
+	require.EqualValues(t, 150, fields[1].Message[0].Value)
+	require.Equal(t, "fixed64", fields[2].Type)
+	require.EqualValues(t, 42, fields[2].Value)
+
+	_, err = Protobuf{}.Decode([]byte{0x0a, 0x05, 0x01})
+	require.ErrorIs(t, err, ErrUnsupported)
+}
+
+func TestRegistry(t *testing.T) {
+	r := NewDefaultRegistry()
+
+	t.Run("fallback", func(t *testing.T) {
+		result, err := r.Decode("", "", compressGzip(t, []byte(`[1, 2, 3]`)))
+		require.NoError(t, err)
+		require.Equal(t, "json", result.Decoder)
+		require.Equal(t, Gzip, result.Compression)
+
+		_, err = r.Decode("", "", []byte("plain text"))
+		require.ErrorIs(t, err, ErrUnsupported)
+
+		_, err = r.Decode("", "", nil)
+		require.ErrorIs(t, err, ErrUnsupported)
+	})
+
+	t.Run("stack", func(t *testing.T) {
+		data := protowire.AppendTag(nil, 1, protowire.VarintType)
+		data = protowire.AppendVarint(data, 1)
+
+		_, err := r.Decode("", "", data)
+		require.ErrorIs(t, err, ErrUnsupported)
+
+		result, err := r.Decode("rollkit", "", data)
+		require.NoError(t, err)
+		require.Equal(t, "protobuf", result.Decoder)
+	})
+
+	t.Run("namespace has priority over stack", func(t *testing.T) {
+		r := NewRegistry(JSON{})
+		r.RegisterStack("stack", testDecoder("stack"))
+		r.RegisterNamespace("ns", NewCompressed(Zlib, JSON{}), testDecoder("namespace"))
+
+		data := []byte(`{"a":1}`)
+		result, err := r.Decode("stack", "ns", data)
+		require.NoError(t, err)
+		require.Equal(t, "namespace", result.Decoder)
+
+		result, err = r.Decode("stack", "", data)
+		require.NoError(t, err)
+		require.Equal(t, "stack", result.Decoder)
+
+		result, err = r.Decode("", "", data)
+		require.NoError(t, err)
+		require.Equal(t, "json", result.Decoder)
+	})
+}
+
+func TestNewRegistryFromConfig(t *testing.T) {
+	t.Run("default registry", func(t *testing.T) {
+		r, err := NewRegistryFromConfig(Config{})
+		require.NoError(t, err)
+		require.Len(t, r.fallback, len(NewDefaultRegistry().fallback))
+		require.Contains(t, r.stacks, "op_stack")
+	})
+
+	t.Run("custom decoders", func(t *testing.T) {
+		r, err := NewRegistryFromConfig(Config{
+			Stacks: map[string][]string{
+				"stack": {"gzip+json"},
+			},
+			Namespaces: map[string][]string{
+				"ns": {"protobuf"},
+			},
+			Fallback: []string{"op_stack"},
+		})
+		require.NoError(t, err)
+
+		data := compressGzip(t, []byte(`{"a":1}`))
+		_, err = r.Decode("", "", data)
+		require.ErrorIs(t, err, ErrUnsupported)
+
+		result, err := r.Decode("stack", "", data)
+		require.NoError(t, err)
+		require.Equal(t, "json", result.Decoder)
+		require.Equal(t, Gzip, result.Compression)
+
+		require.Len(t, r.namespaces["ns"], 1)
+	})
+
+	t.Run("unknown decoder", func(t *testing.T) {
+		_, err := NewRegistryFromConfig(Config{Fallback: []string{"xml"}})
+		require.Error(t, err)
+
+		_, err = NewRegistryFromConfig(Config{Fallback: []string{"lz4+json"}})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package decoder
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// JSON - decoder of plain JSON objects and arrays
+type JSON struct{}
+
+func (JSON) Name() string {
+	return "json"
+}
+
+func (j JSON) Decode(data []byte) (*Result, error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || (trimmed[0] != '{' && trimmed[0] != '[') || !json.Valid(trimmed) {
+		return nil, ErrUnsupported
+	}
+
+	return &Result{
+		Decoder: j.Name(),
+		Data:    json.RawMessage(trimmed),
+	}, nil
+}
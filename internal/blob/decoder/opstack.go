@@ -0,0 +1,245 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package decoder
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"sort"
+)
+
+const (
+	opStackDerivationVersion = 0x00
+	opStackChannelIdLength   = 16
+	opStackFrameOverhead     = opStackChannelIdLength + 2 + 4 + 1
+	opStackChannelBrotli     = 0x01
+
+	opStackSingularBatch = 0x00
+	opStackSpanBatch     = 0x01
+)
+
+// OpStackFrame - frame of OP Stack channel
+type OpStackFrame struct {
+	ChannelId string `json:"channel_id"`
+	Number    uint16 `json:"number"`
+	Size      int    `json:"size"`
+	IsLast    bool   `json:"is_last"`
+
+	data []byte
+}
+
+// OpStack - decoder of OP Stack batcher transaction data: derivation version byte followed by channel frames.
+// If the blob contains all frames of a channel the channel is decompressed and its batches are summarized.
+type OpStack struct{}
+
+func (OpStack) Name() string {
+	return "op_stack"
+}
+
+func (o OpStack) Decode(data []byte) (*Result, error) {
+	frames, err := parseOpStackFrames(data)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := &Batch{
+		FramesCount: len(frames),
+	}
+
+	channels := make(map[string][]OpStackFrame)
+	for i := range frames {
+		if _, ok := channels[frames[i].ChannelId]; !ok {
+			batch.Channels = append(batch.Channels, frames[i].ChannelId)
+		}
+		channels[frames[i].ChannelId] = append(channels[frames[i].ChannelId], frames[i])
+	}
+
+	for _, id := range batch.Channels {
+		channelData, ok := assembleOpStackChannel(channels[id])
+		if !ok {
+			continue
+		}
+		// channel content is optional part of the view, so broken channels are skipped
+		channelBatch := *batch
+		if err := summarizeOpStackChannel(channelData, &channelBatch); err == nil {
+			*batch = channelBatch
+		}
+	}
+
+	return &Result{
+		Decoder: o.Name(),
+		Data:    frames,
+		Batch:   batch,
+	}, nil
+}
+
+func parseOpStackFrames(data []byte) ([]OpStackFrame, error) {
+	if len(data) < opStackFrameOverhead+1 || data[0] != opStackDerivationVersion {
+		return nil, ErrUnsupported
+	}
+	data = data[1:]
+
+	frames := make([]OpStackFrame, 0)
+	for len(data) > 0 {
+		if len(data) < opStackFrameOverhead {
+			return nil, ErrUnsupported
+		}
+		frame := OpStackFrame{
+			ChannelId: hex.EncodeToString(data[:opStackChannelIdLength]),
+			Number:    binary.BigEndian.Uint16(data[opStackChannelIdLength:]),
+		}
+		size := binary.BigEndian.Uint32(data[opStackChannelIdLength+2:])
+		data = data[opStackChannelIdLength+6:]
+
+		if uint64(len(data)) < uint64(size)+1 {
+			return nil, ErrUnsupported
+		}
+		frame.Size = int(size)
+		frame.data = data[:size]
+
+		switch data[size] {
+		case 0:
+		case 1:
+			frame.IsLast = true
+		default:
+			return nil, ErrUnsupported
+		}
+		data = data[size+1:]
+		frames = append(frames, frame)
+	}
+	return frames, nil
+}
+
+// assembleOpStackChannel - concatenates frames data if all frames of the channel are present
+func assembleOpStackChannel(frames []OpStackFrame) ([]byte, bool) {
+	sort.SliceStable(frames, func(i, j int) bool {
+		return frames[i].Number < frames[j].Number
+	})
+
+	var buf bytes.Buffer
+	for i := range frames {
+		if int(frames[i].Number) != i {
+			return nil, false
+		}
+		buf.Write(frames[i].data)
+	}
+	if !frames[len(frames)-1].IsLast {
+		return nil, false
+	}
+	return buf.Bytes(), true
+}
+
+func summarizeOpStackChannel(data []byte, batch *Batch) error {
+	if len(data) == 0 {
+		return ErrUnsupported
+	}
+
+	var (
+		decompressed []byte
+		err          error
+	)
+	if data[0] == opStackChannelBrotli {
+		decompressed, err = decompress(Brotli, data[1:])
+	} else {
+		decompressed, err = decompress(Zlib, data)
+	}
+	if err != nil {
+		return err
+	}
+
+	for len(decompressed) > 0 {
+		isList, content, rest, err := rlpNext(decompressed)
+		if err != nil {
+			return err
+		}
+		if isList || len(content) == 0 {
+			return errInvalidRlp
+		}
+		decompressed = rest
+
+		switch content[0] {
+		case opStackSingularBatch:
+			err = summarizeSingularBatch(content[1:], batch)
+		case opStackSpanBatch:
+			err = summarizeSpanBatch(content[1:], batch)
+		default:
+			err = ErrUnsupported
+		}
+		if err != nil {
+			return err
+		}
+		batch.BatchesCount += 1
+	}
+	return nil
+}
+
+// summarizeSingularBatch - singular batch is RLP list [parent_hash, epoch_number, epoch_hash, timestamp, transactions]
+func summarizeSingularBatch(data []byte, batch *Batch) error {
+	isList, content, _, err := rlpNext(data)
+	if err != nil {
+		return err
+	}
+	if !isList {
+		return errInvalidRlp
+	}
+	items, err := rlpList(content)
+	if err != nil {
+		return err
+	}
+	if len(items) != 5 {
+		return errInvalidRlp
+	}
+	epoch, err := rlpUint(items[1])
+	if err != nil {
+		return err
+	}
+	timestamp, err := rlpUint(items[3])
+	if err != nil {
+		return err
+	}
+
+	batch.L2BlocksCount += 1
+	if batch.L2TimeFrom == 0 || timestamp < batch.L2TimeFrom {
+		batch.L2TimeFrom = timestamp
+	}
+	if timestamp > batch.L2TimeTo {
+		batch.L2TimeTo = timestamp
+	}
+	if batch.L1OriginFrom == 0 || epoch < batch.L1OriginFrom {
+		batch.L1OriginFrom = epoch
+	}
+	if epoch > batch.L1OriginTo {
+		batch.L1OriginTo = epoch
+	}
+	return nil
+}
+
+// summarizeSpanBatch - span batch starts with prefix: rel_timestamp, l1_origin_num, parent_check, l1_origin_check
+// followed by payload starting with block_count. Timestamp is relative to L2 genesis, so only block count
+// and the last L1 origin number are extracted.
+func summarizeSpanBatch(data []byte, batch *Batch) error {
+	r := bytes.NewReader(data)
+	if _, err := binary.ReadUvarint(r); err != nil {
+		return err
+	}
+	l1Origin, err := binary.ReadUvarint(r)
+	if err != nil {
+		return err
+	}
+	if _, err := r.Seek(40, io.SeekCurrent); err != nil || r.Len() == 0 {
+		return ErrUnsupported
+	}
+	blockCount, err := binary.ReadUvarint(r)
+	if err != nil {
+		return err
+	}
+
+	batch.L2BlocksCount += int64(blockCount)
+	if l1Origin > batch.L1OriginTo {
+		batch.L1OriginTo = l1Origin
+	}
+	return nil
+}
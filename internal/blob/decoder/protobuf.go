@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package decoder
+
+import (
+	"encoding/hex"
+	"unicode"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// maxProtobufDepth - limit of nesting of embedded messages which are decoded without schema
+const maxProtobufDepth = 16
+
+// ProtobufField - field of protobuf message decoded without schema
+type ProtobufField struct {
+	Number  int32           `json:"number"`
+	Type    string          `json:"type"`
+	Value   any             `json:"value,omitempty"`
+	Message []ProtobufField `json:"message,omitempty"`
+}
+
+// Protobuf - schema-less decoder of protobuf messages. Length-delimited fields are shown
+// as strings if they are printable UTF-8 text, as embedded messages if possible and as hex otherwise.
+type Protobuf struct{}
+
+func (Protobuf) Name() string {
+	return "protobuf"
+}
+
+func (p Protobuf) Decode(data []byte) (*Result, error) {
+	fields, err := decodeProtobuf(data, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &Result{
+		Decoder: p.Name(),
+		Data:    fields,
+	}, nil
+}
+
+func decodeProtobuf(data []byte, depth int) ([]ProtobufField, error) {
+	if len(data) == 0 || depth > maxProtobufDepth {
+		return nil, ErrUnsupported
+	}
+
+	fields := make([]ProtobufField, 0)
+	for len(data) > 0 {
+		number, typ, n := protowire.ConsumeTag(data)
+		if n < 0 || !number.IsValid() {
+			return nil, ErrUnsupported
+		}
+		data = data[n:]
+
+		field := ProtobufField{
+			Number: int32(number),
+		}
+
+		switch typ {
+		case protowire.VarintType:
+			v, m := protowire.ConsumeVarint(data)
+			if m < 0 {
+				return nil, ErrUnsupported
+			}
+			field.Type = "varint"
+			field.Value = v
+			n = m
+		case protowire.Fixed32Type:
+			v, m := protowire.ConsumeFixed32(data)
+			if m < 0 {
+				return nil, ErrUnsupported
+			}
+			field.Type = "fixed32"
+			field.Value = v
+			n = m
+		case protowire.Fixed64Type:
+			v, m := protowire.ConsumeFixed64(data)
+			if m < 0 {
+				return nil, ErrUnsupported
+			}
+			field.Type = "fixed64"
+			field.Value = v
+			n = m
+		case protowire.BytesType:
+			v, m := protowire.ConsumeBytes(data)
+			if m < 0 {
+				return nil, ErrUnsupported
+			}
+			if isPrintable(v) {
+				field.Type = "string"
+				field.Value = string(v)
+			} else if msg, err := decodeProtobuf(v, depth+1); err == nil {
+				field.Type = "message"
+				field.Message = msg
+			} else {
+				field.Type = "bytes"
+				field.Value = hex.EncodeToString(v)
+			}
+			n = m
+		default:
+			return nil, ErrUnsupported
+		}
+
+		data = data[n:]
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+func isPrintable(data []byte) bool {
+	if !utf8.Valid(data) {
+		return false
+	}
+	for _, r := range string(data) {
+		if !unicode.IsPrint(r) && !unicode.IsSpace(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package decoder
+
+import (
+	"github.com/pkg/errors"
+)
+
+var errInvalidRlp = errors.New("invalid RLP")
+
+// rlpNext - reads the next RLP item. It returns flag whether the item is list, its content and the rest of data.
+func rlpNext(data []byte) (isList bool, content []byte, rest []byte, err error) {
+	if len(data) == 0 {
+		return false, nil, nil, errInvalidRlp
+	}
+
+	prefix := data[0]
+	var offset, size uint64
+	switch {
+	case prefix < 0x80:
+		return false, data[:1], data[1:], nil
+	case prefix <= 0xb7:
+		offset, size = 1, uint64(prefix-0x80)
+	case prefix <= 0xbf:
+		offset, size, err = rlpLongSize(data, prefix-0xb7)
+	case prefix <= 0xf7:
+		isList = true
+		offset, size = 1, uint64(prefix-0xc0)
+	default:
+		isList = true
+		offset, size, err = rlpLongSize(data, prefix-0xf7)
+	}
+	if err != nil {
+		return false, nil, nil, err
+	}
+	if uint64(len(data))-offset < size {
+		return false, nil, nil, errInvalidRlp
+	}
+	return isList, data[offset : offset+size], data[offset+size:], nil
+}
+
+func rlpLongSize(data []byte, sizeLen byte) (uint64, uint64, error) {
+	if sizeLen > 8 || len(data) < int(sizeLen)+1 {
+		return 0, 0, errInvalidRlp
+	}
+	size, err := rlpUint(data[1 : 1+sizeLen])
+	if err != nil {
+		return 0, 0, err
+	}
+	return uint64(sizeLen) + 1, size, nil
+}
+
+// rlpUint - decodes big-endian unsigned integer from the content of RLP string
+func rlpUint(data []byte) (uint64, error) {
+	if len(data) > 8 {
+		return 0, errInvalidRlp
+	}
+	var value uint64
+	for i := range data {
+		value = value<<8 | uint64(data[i])
+	}
+	return value, nil
+}
+
+// rlpList - splits the content of RLP list to items
+func rlpList(data []byte) ([][]byte, error) {
+	items := make([][]byte, 0)
+	for len(data) > 0 {
+		_, content, rest, err := rlpNext(data)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, content)
+		data = rest
+	}
+	return items, nil
+}
@@ -0,0 +1,309 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package blob
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	nodeTypes "github.com/celenium-io/celestia-indexer/pkg/node/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+)
+
+type FSConfig struct {
+	Dir string
+}
+
+// FS - blob storage on local filesystem. Blobs are saved to files by path `{shard}/{namespace}/{height}/{commitment}`
+// where shard is the first byte of namespace's sha256 hash. It limits count of entries in the root directory.
+type FS struct {
+	cfg FSConfig
+}
+
+func NewFS(cfg FSConfig) *FS {
+	return &FS{
+		cfg: cfg,
+	}
+}
+
+func (fs *FS) Init(ctx context.Context) error {
+	if fs.cfg.Dir == "" {
+		return errors.New("empty blob storage directory")
+	}
+	return os.MkdirAll(fs.cfg.Dir, 0o755)
+}
+
+func (fs *FS) Save(ctx context.Context, blob Blob) error {
+	return fs.writeFile(fs.path(blob.String()), blob.Data)
+}
+
+func (fs *FS) SaveBulk(ctx context.Context, blobs []Blob) error {
+	for i := range blobs {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		if err := fs.Save(ctx, blobs[i]); err != nil {
+			return errors.Wrap(err, blobs[i].String())
+		}
+	}
+
+	// index is written after blobs, so it never points to blobs which were not saved
+	for height, keys := range keysByHeight(blobs) {
+		if err := fs.appendIndex(height, keys); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (fs *FS) Head(ctx context.Context) (uint64, error) {
+	data, err := os.ReadFile(filepath.Join(fs.cfg.Dir, headFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	var head uint64
+	err = json.Unmarshal(data, &head)
+	return head, err
+}
+
+func (fs *FS) UpdateHead(ctx context.Context, head uint64) error {
+	data, err := json.Marshal(head)
+	if err != nil {
+		return err
+	}
+	return fs.writeFile(filepath.Join(fs.cfg.Dir, headFile), data)
+}
+
+func (fs *FS) Rollback(ctx context.Context, height uint64) error {
+	head, err := fs.Head(ctx)
+	if err != nil {
+		return err
+	}
+
+	for h := head; h > height; h-- {
+		keys, err := fs.keys(h)
+		if err != nil {
+			return err
+		}
+		for i := range keys {
+			if err := removeFile(fs.path(keys[i])); err != nil {
+				return err
+			}
+		}
+		if err := removeFile(filepath.Join(fs.cfg.Dir, filepath.FromSlash(heightIndexKey(h)))); err != nil {
+			return err
+		}
+	}
+
+	return fs.UpdateHead(ctx, height)
+}
+
+// keys - returns keys of blobs saved at the height. If the height index is absent directories `{namespace}/{height}` of all namespaces are listed.
+func (fs *FS) keys(height uint64) ([]string, error) {
+	keys, ok, err := fs.index(height)
+	if err != nil || ok {
+		return keys, err
+	}
+
+	shards, err := os.ReadDir(fs.cfg.Dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, shard := range shards {
+		if !shard.IsDir() || shard.Name() == heightIndexDir || strings.HasPrefix(shard.Name(), ".") {
+			continue
+		}
+		namespaces, err := os.ReadDir(filepath.Join(fs.cfg.Dir, shard.Name()))
+		if err != nil {
+			return nil, err
+		}
+		for _, ns := range namespaces {
+			if !ns.IsDir() || strings.HasPrefix(ns.Name(), ".") {
+				continue
+			}
+			nsKeys, err := fs.list(path.Join(ns.Name(), strconv.FormatUint(height, 10)))
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, nsKeys...)
+		}
+	}
+	return keys, nil
+}
+
+// index - returns keys from the height index. The flag is false if the index doesn't exist.
+func (fs *FS) index(height uint64) ([]string, bool, error) {
+	data, err := os.ReadFile(filepath.Join(fs.cfg.Dir, filepath.FromSlash(heightIndexKey(height))))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	var keys []string
+	err = json.Unmarshal(data, &keys)
+	return keys, true, err
+}
+
+// list - returns keys of blobs in the directory with the prefix `{namespace}/{height}`
+func (fs *FS) list(prefix string) ([]string, error) {
+	entries, err := os.ReadDir(fs.path(prefix))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		keys = append(keys, path.Join(prefix, entry.Name()))
+	}
+	return keys, nil
+}
+
+func (fs *FS) appendIndex(height uint64, keys []string) error {
+	saved, _, err := fs.index(height)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(mergeKeys(saved, keys))
+	if err != nil {
+		return err
+	}
+	return fs.writeFile(filepath.Join(fs.cfg.Dir, filepath.FromSlash(heightIndexKey(height))), data)
+}
+
+func removeFile(name string) error {
+	if err := os.Remove(name); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (fs *FS) Blob(ctx context.Context, height pkgTypes.Level, namespace, commitment string) (blob nodeTypes.Blob, err error) {
+	key, err := blobKey(height, namespace, commitment)
+	if err != nil {
+		return
+	}
+
+	data, err := os.ReadFile(fs.path(key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			err = errors.Wrap(nodeTypes.ErrBlobNotFound, key)
+		}
+		return
+	}
+
+	blob.Data = base64.StdEncoding.EncodeToString(data)
+	blob.ShareVersion = 0
+	blob.Commitment = commitment
+	blob.Namespace = namespace
+	return
+}
+
+// RawBlob - opens file of the blob for streaming
+func (fs *FS) RawBlob(ctx context.Context, height pkgTypes.Level, namespace, commitment string) (RawBlob, error) {
+	key, err := blobKey(height, namespace, commitment)
+	if err != nil {
+		return RawBlob{}, err
+	}
+
+	f, err := os.Open(fs.path(key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return RawBlob{}, errors.Wrap(nodeTypes.ErrBlobNotFound, key)
+		}
+		return RawBlob{}, err
+	}
+	return RawBlob{ReadSeekCloser: f}, nil
+}
+
+// Blobs - returns all blobs saved at the height. If namespace hashes are passed only blobs of these namespaces are returned.
+func (fs *FS) Blobs(ctx context.Context, height pkgTypes.Level, hash ...string) ([]nodeTypes.Blob, error) {
+	var keys []string
+	if len(hash) == 0 {
+		all, err := fs.keys(uint64(height))
+		if err != nil {
+			return nil, err
+		}
+		keys = all
+	}
+
+	for i := range hash {
+		ns, err := Base64ToUrl(hash[i])
+		if err != nil {
+			return nil, err
+		}
+
+		nsKeys, err := fs.list(path.Join(ns, strconv.FormatUint(uint64(height), 10)))
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, nsKeys...)
+	}
+
+	blobs := make([]nodeTypes.Blob, 0, len(keys))
+	for i := range keys {
+		namespace, commitment, err := parseKey(keys[i])
+		if err != nil {
+			return nil, err
+		}
+		blob, err := fs.Blob(ctx, height, namespace, commitment)
+		if err != nil {
+			return nil, errors.Wrap(err, keys[i])
+		}
+		blobs = append(blobs, blob)
+	}
+	return blobs, nil
+}
+
+// path - returns path to the file by blob key. Files with the same namespace are placed in the same shard.
+func (fs *FS) path(key string) string {
+	ns, _, _ := strings.Cut(key, "/")
+	shard := sha256.Sum256([]byte(ns))
+	return filepath.Join(fs.cfg.Dir, hex.EncodeToString(shard[:1]), filepath.FromSlash(key))
+}
+
+// writeFile - writes data to temporary file and renames it. So readers never see partially written blob.
+func (fs *FS) writeFile(name string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(name), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), name)
+}
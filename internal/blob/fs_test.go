@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package blob
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	nodeTypes "github.com/celenium-io/celestia-indexer/pkg/node/types"
+	"github.com/stretchr/testify/require"
+	blobTypes "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestFS(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	fs := NewFS(FSConfig{Dir: dir})
+	require.NoError(t, fs.Init(ctx))
+
+	head, err := fs.Head(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, head)
+
+	blobs := []Blob{
+		{
+			Blob: &blobTypes.Blob{
+				NamespaceId: []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10},
+				Data:        []byte{0, 1, 2, 3},
+			},
+			Commitment: []byte{0x01, 0xfe},
+			Height:     100,
+		}, {
+			Blob: &blobTypes.Blob{
+				NamespaceId: []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10},
+				Data:        []byte("second blob"),
+			},
+			Commitment: []byte{0x02, 0xff},
+			Height:     100,
+		},
+	}
+	require.NoError(t, fs.SaveBulk(ctx, blobs))
+	require.NoError(t, fs.UpdateHead(ctx, 100))
+
+	head, err = fs.Head(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 100, head)
+
+	_, err = os.Stat(fs.path(blobs[0].String()))
+	require.NoError(t, err)
+	require.Equal(t, dir, filepath.Dir(filepath.Dir(filepath.Dir(filepath.Dir(fs.path(blobs[0].String()))))))
+
+	namespace := base64.StdEncoding.EncodeToString(append([]byte{0}, blobs[0].NamespaceId...))
+	commitment := base64.StdEncoding.EncodeToString(blobs[0].Commitment)
+
+	blob, err := fs.Blob(ctx, 100, namespace, commitment)
+	require.NoError(t, err)
+	require.Equal(t, namespace, blob.Namespace)
+	require.Equal(t, commitment, blob.Commitment)
+	require.Equal(t, base64.StdEncoding.EncodeToString([]byte{0, 1, 2, 3}), blob.Data)
+
+	_, err = fs.Blob(ctx, 101, namespace, commitment)
+	require.ErrorIs(t, err, nodeTypes.ErrBlobNotFound)
+
+	raw, err := fs.RawBlob(ctx, 100, namespace, commitment)
+	require.NoError(t, err)
+	data, err := io.ReadAll(raw)
+	require.NoError(t, err)
+	require.NoError(t, raw.Close())
+	require.Equal(t, []byte{0, 1, 2, 3}, data)
+
+	_, err = fs.RawBlob(ctx, 101, namespace, commitment)
+	require.ErrorIs(t, err, nodeTypes.ErrBlobNotFound)
+
+	received, err := fs.Blobs(ctx, 100, namespace)
+	require.NoError(t, err)
+	require.Len(t, received, 2)
+
+	received, err = fs.Blobs(ctx, 100)
+	require.NoError(t, err)
+	require.Len(t, received, 2)
+	require.Equal(t, blob, received[0])
+
+	received, err = fs.Blobs(ctx, 99, namespace)
+	require.NoError(t, err)
+	require.Len(t, received, 0)
+
+	require.NoError(t, fs.SaveBulk(ctx, []Blob{
+		{
+			Blob: &blobTypes.Blob{
+				NamespaceId: []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10},
+				Data:        []byte("orphaned blob"),
+			},
+			Commitment: []byte{0x03, 0xff},
+			Height:     101,
+		},
+	}))
+	require.NoError(t, fs.UpdateHead(ctx, 101))
+
+	require.NoError(t, fs.Rollback(ctx, 100))
+
+	head, err = fs.Head(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 100, head)
+
+	received, err = fs.Blobs(ctx, 101, namespace)
+	require.NoError(t, err)
+	require.Len(t, received, 0)
+
+	received, err = fs.Blobs(ctx, 100, namespace)
+	require.NoError(t, err)
+	require.Len(t, received, 2)
+
+	require.NoError(t, fs.Rollback(ctx, 99))
+	received, err = fs.Blobs(ctx, 100, namespace)
+	require.NoError(t, err)
+	require.Len(t, received, 0)
+}
+
+func TestFSWithoutHeightIndex(t *testing.T) {
+	ctx := context.Background()
+
+	fs := NewFS(FSConfig{Dir: t.TempDir()})
+	require.NoError(t, fs.Init(ctx))
+
+	// blobs saved without height index, for example, before it was introduced
+	legacy := []Blob{
+		{
+			Blob: &blobTypes.Blob{
+				NamespaceId: []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10},
+				Data:        []byte{0, 1, 2, 3},
+			},
+			Commitment: []byte{0x01, 0xfe},
+			Height:     100,
+		}, {
+			Blob: &blobTypes.Blob{
+				NamespaceId: []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 11},
+				Data:        []byte{4, 5, 6},
+			},
+			Commitment: []byte{0x02, 0xfe},
+			Height:     100,
+		},
+	}
+	for i := range legacy {
+		require.NoError(t, fs.Save(ctx, legacy[i]))
+	}
+	require.NoError(t, fs.UpdateHead(ctx, 100))
+
+	received, err := fs.Blobs(ctx, 100)
+	require.NoError(t, err)
+	require.Len(t, received, 2)
+
+	received, err = fs.Blobs(ctx, 99)
+	require.NoError(t, err)
+	require.Len(t, received, 0)
+
+	require.NoError(t, fs.Rollback(ctx, 99))
+	for i := range legacy {
+		_, err := os.Stat(fs.path(legacy[i].String()))
+		require.True(t, os.IsNotExist(err))
+	}
+}
@@ -80,6 +80,44 @@ func (c *StorageHeadCall) DoAndReturn(f func(context.Context) (uint64, error)) *
 	return c
 }
 
+// Rollback mocks base method.
+func (m *MockStorage) Rollback(ctx context.Context, height uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollback", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rollback indicates an expected call of Rollback.
+func (mr *MockStorageMockRecorder) Rollback(ctx, height any) *StorageRollbackCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockStorage)(nil).Rollback), ctx, height)
+	return &StorageRollbackCall{Call: call}
+}
+
+// StorageRollbackCall wrap *gomock.Call
+type StorageRollbackCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *StorageRollbackCall) Return(arg0 error) *StorageRollbackCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *StorageRollbackCall) Do(f func(context.Context, uint64) error) *StorageRollbackCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *StorageRollbackCall) DoAndReturn(f func(context.Context, uint64) error) *StorageRollbackCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Save mocks base method.
 func (m *MockStorage) Save(ctx context.Context, blob Blob) error {
 	m.ctrl.T.Helper()
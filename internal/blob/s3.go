@@ -0,0 +1,529 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package blob
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsConfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	serviceS3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	s3Types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	nodeTypes "github.com/celenium-io/celestia-indexer/pkg/node/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-io/workerpool"
+	"github.com/rs/zerolog/log"
+)
+
+type S3Config struct {
+	Endpoint        string
+	Region          string
+	BucketName      string
+	AccessKeyId     string
+	AccessKeySecret string
+	UsePathStyle    bool
+}
+
+const (
+	// saveAttempts - count of attempts to save blob before the error is returned
+	saveAttempts = 5
+	// saveRetryDelay - delay before the first retry of blob saving. It's doubled on every next attempt.
+	saveRetryDelay = time.Second
+)
+
+// S3 - blob storage on any S3-compatible object storage (AWS S3, Cloudflare R2, MinIO and etc.)
+type S3 struct {
+	cfg        S3Config
+	client     *serviceS3.Client
+	pool       *workerpool.Pool[saveTask]
+	retryDelay time.Duration
+}
+
+type saveTask struct {
+	blob    Blob
+	attempt int
+	wg      *sync.WaitGroup
+	errs    chan<- error
+}
+
+func NewS3(cfg S3Config) *S3 {
+	return &S3{
+		cfg:        cfg,
+		retryDelay: saveRetryDelay,
+	}
+}
+
+func (s3 *S3) Init(ctx context.Context) error {
+	region := s3.cfg.Region
+	if region == "" {
+		region = "auto"
+	}
+
+	cfg, err := awsConfig.LoadDefaultConfig(ctx,
+		awsConfig.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(s3.cfg.AccessKeyId, s3.cfg.AccessKeySecret, "")),
+		awsConfig.WithRegion(region),
+		awsConfig.WithRetryMode(aws.RetryModeAdaptive),
+	)
+	if err != nil {
+		return err
+	}
+
+	s3.client = serviceS3.NewFromConfig(cfg, func(o *serviceS3.Options) {
+		if s3.cfg.Endpoint != "" {
+			o.BaseEndpoint = aws.String(s3.cfg.Endpoint)
+		}
+		o.UsePathStyle = s3.cfg.UsePathStyle
+	})
+	s3.pool = workerpool.NewPool(s3.saveBlob, 16)
+	s3.pool.Start(ctx)
+	return nil
+}
+
+func (s3 *S3) Save(ctx context.Context, blob Blob) error {
+	_, err := s3.client.PutObject(ctx, &serviceS3.PutObjectInput{
+		Bucket:        aws.String(s3.cfg.BucketName),
+		Key:           aws.String(blob.String()),
+		Body:          bytes.NewReader(blob.Data),
+		ContentType:   aws.String(blob.ContentType()),
+		ContentLength: aws.Int64(int64(len(blob.Data))),
+	})
+	return err
+}
+
+func (s3 *S3) SaveBulk(ctx context.Context, blobs []Blob) error {
+	if len(blobs) == 0 {
+		return nil
+	}
+
+	var (
+		wg   = new(sync.WaitGroup)
+		errs = make(chan error, len(blobs))
+	)
+	for i := range blobs {
+		wg.Add(1)
+		s3.pool.AddTask(saveTask{
+			blob: blobs[i],
+			wg:   wg,
+			errs: errs,
+		})
+	}
+
+	// wait until all blobs are saved. So head is updated only after saving of its blobs.
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-done:
+	}
+
+	select {
+	case err := <-errs:
+		return err
+	default:
+	}
+
+	// index is written after blobs, so it never points to blobs which were not saved
+	for height, keys := range keysByHeight(blobs) {
+		if err := s3.appendIndex(ctx, height, keys); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s3 *S3) Head(ctx context.Context) (uint64, error) {
+	output, err := s3.client.GetObject(ctx, &serviceS3.GetObjectInput{
+		Bucket: aws.String(s3.cfg.BucketName),
+		Key:    aws.String(headFile),
+	})
+	if err != nil {
+		if isNotFound(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	defer output.Body.Close()
+
+	var head uint64
+	err = json.NewDecoder(output.Body).Decode(&head)
+	return head, err
+}
+
+func (s3 *S3) UpdateHead(ctx context.Context, head uint64) error {
+	data, err := json.Marshal(head)
+	if err != nil {
+		return err
+	}
+	_, err = s3.client.PutObject(ctx, &serviceS3.PutObjectInput{
+		Bucket: aws.String(s3.cfg.BucketName),
+		Key:    aws.String(headFile),
+		Body:   bytes.NewReader(data),
+	})
+	return err
+}
+
+func (s3 *S3) Rollback(ctx context.Context, height uint64) error {
+	head, err := s3.Head(ctx)
+	if err != nil {
+		return err
+	}
+
+	for h := head; h > height; h-- {
+		keys, err := s3.keys(ctx, h)
+		if err != nil {
+			return err
+		}
+		for i := range keys {
+			if err := s3.delete(ctx, keys[i]); err != nil {
+				return err
+			}
+		}
+		if err := s3.delete(ctx, heightIndexKey(h)); err != nil {
+			return err
+		}
+	}
+
+	return s3.UpdateHead(ctx, height)
+}
+
+// keys - returns keys of blobs saved at the height. If the height index is absent prefixes `{namespace}/{height}/` of all namespaces are listed.
+func (s3 *S3) keys(ctx context.Context, height uint64) ([]string, error) {
+	keys, ok, err := s3.index(ctx, height)
+	if err != nil || ok {
+		return keys, err
+	}
+
+	namespaces, err := s3.namespaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range namespaces {
+		nsKeys, err := s3.list(ctx, fmt.Sprintf("%s/%d/", namespaces[i], height))
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, nsKeys...)
+	}
+	return keys, nil
+}
+
+// index - returns keys from the height index. The flag is false if the index doesn't exist.
+func (s3 *S3) index(ctx context.Context, height uint64) ([]string, bool, error) {
+	output, err := s3.client.GetObject(ctx, &serviceS3.GetObjectInput{
+		Bucket: aws.String(s3.cfg.BucketName),
+		Key:    aws.String(heightIndexKey(height)),
+	})
+	if err != nil {
+		if isNotFound(err) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	defer output.Body.Close()
+
+	var keys []string
+	err = json.NewDecoder(output.Body).Decode(&keys)
+	return keys, true, err
+}
+
+// namespaces - returns url-encoded namespaces which have saved blobs
+func (s3 *S3) namespaces(ctx context.Context) ([]string, error) {
+	paginator := serviceS3.NewListObjectsV2Paginator(s3.client, &serviceS3.ListObjectsV2Input{
+		Bucket:    aws.String(s3.cfg.BucketName),
+		Delimiter: aws.String("/"),
+	})
+
+	namespaces := make([]string, 0)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := range page.CommonPrefixes {
+			ns := strings.TrimSuffix(aws.ToString(page.CommonPrefixes[i].Prefix), "/")
+			if ns == heightIndexDir {
+				continue
+			}
+			namespaces = append(namespaces, ns)
+		}
+	}
+	return namespaces, nil
+}
+
+// list - returns keys of objects with the prefix
+func (s3 *S3) list(ctx context.Context, prefix string) ([]string, error) {
+	paginator := serviceS3.NewListObjectsV2Paginator(s3.client, &serviceS3.ListObjectsV2Input{
+		Bucket: aws.String(s3.cfg.BucketName),
+		Prefix: aws.String(prefix),
+	})
+
+	keys := make([]string, 0)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := range page.Contents {
+			keys = append(keys, aws.ToString(page.Contents[i].Key))
+		}
+	}
+	return keys, nil
+}
+
+func (s3 *S3) appendIndex(ctx context.Context, height uint64, keys []string) error {
+	saved, _, err := s3.index(ctx, height)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(mergeKeys(saved, keys))
+	if err != nil {
+		return err
+	}
+	_, err = s3.client.PutObject(ctx, &serviceS3.PutObjectInput{
+		Bucket: aws.String(s3.cfg.BucketName),
+		Key:    aws.String(heightIndexKey(height)),
+		Body:   bytes.NewReader(data),
+	})
+	return err
+}
+
+func (s3 *S3) delete(ctx context.Context, key string) error {
+	_, err := s3.client.DeleteObject(ctx, &serviceS3.DeleteObjectInput{
+		Bucket: aws.String(s3.cfg.BucketName),
+		Key:    aws.String(key),
+	})
+	if err != nil && !isNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func (s3 *S3) Blob(ctx context.Context, height pkgTypes.Level, namespace, commitment string) (blob nodeTypes.Blob, err error) {
+	fileName, err := blobKey(height, namespace, commitment)
+	if err != nil {
+		return
+	}
+
+	obj, err := s3.client.GetObject(ctx, &serviceS3.GetObjectInput{
+		Bucket: aws.String(s3.cfg.BucketName),
+		Key:    aws.String(fileName),
+	})
+	if err != nil {
+		if isNotFound(err) {
+			err = fmt.Errorf("%s: %w", fileName, nodeTypes.ErrBlobNotFound)
+		}
+		return
+	}
+	defer obj.Body.Close()
+
+	buf := new(bytes.Buffer)
+	encoder := base64.NewEncoder(base64.StdEncoding, buf)
+	if _, err := io.Copy(encoder, obj.Body); err != nil {
+		return blob, err
+	}
+	if err := encoder.Close(); err != nil {
+		return blob, err
+	}
+	blob.Data = buf.String()
+	blob.ShareVersion = 0
+	blob.Commitment = commitment
+	blob.Namespace = namespace
+
+	return
+}
+
+// RawBlob - returns reader of the blob object. Object is downloaded while it's read, so only the requested range is transferred.
+func (s3 *S3) RawBlob(ctx context.Context, height pkgTypes.Level, namespace, commitment string) (RawBlob, error) {
+	key, err := blobKey(height, namespace, commitment)
+	if err != nil {
+		return RawBlob{}, err
+	}
+
+	obj, err := s3.client.GetObject(ctx, &serviceS3.GetObjectInput{
+		Bucket: aws.String(s3.cfg.BucketName),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if isNotFound(err) {
+			return RawBlob{}, fmt.Errorf("%s: %w", key, nodeTypes.ErrBlobNotFound)
+		}
+		return RawBlob{}, err
+	}
+
+	return RawBlob{
+		ReadSeekCloser: &objectReader{
+			ctx:  ctx,
+			s3:   s3,
+			key:  key,
+			size: aws.ToInt64(obj.ContentLength),
+			body: obj.Body,
+		},
+		ContentType: aws.ToString(obj.ContentType),
+	}, nil
+}
+
+// Blobs - returns all blobs saved at the height. If namespace hashes are passed only blobs of these namespaces are returned.
+func (s3 *S3) Blobs(ctx context.Context, height pkgTypes.Level, hash ...string) ([]nodeTypes.Blob, error) {
+	var keys []string
+	if len(hash) == 0 {
+		all, err := s3.keys(ctx, uint64(height))
+		if err != nil {
+			return nil, err
+		}
+		keys = all
+	}
+
+	for i := range hash {
+		ns, err := Base64ToUrl(hash[i])
+		if err != nil {
+			return nil, err
+		}
+
+		nsKeys, err := s3.list(ctx, fmt.Sprintf("%s/%d/", ns, height))
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, nsKeys...)
+	}
+
+	blobs := make([]nodeTypes.Blob, 0, len(keys))
+	for i := range keys {
+		namespace, commitment, err := parseKey(keys[i])
+		if err != nil {
+			return nil, err
+		}
+		blob, err := s3.Blob(ctx, height, namespace, commitment)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", keys[i], err)
+		}
+		blobs = append(blobs, blob)
+	}
+	return blobs, nil
+}
+
+func (s3 *S3) saveBlob(ctx context.Context, task saveTask) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, time.Second*30)
+	defer cancel()
+
+	blob := task.blob
+	task.attempt += 1
+	log.Info().Str("blob", blob.String()).Int("size", blob.Size()).Msg("saving blob...")
+	if err := s3.Save(timeoutCtx, blob); err != nil {
+		log.Err(err).Str("blob", blob.String()).Int("size", blob.Size()).Int("attempt", task.attempt).Msg("blob saving")
+		if ctx.Err() != nil {
+			return
+		}
+		if task.attempt >= saveAttempts {
+			task.errs <- fmt.Errorf("%s: %w", blob.String(), err)
+			task.wg.Done()
+			return
+		}
+
+		go func() {
+			// if error occurred try again with exponential backoff
+			select {
+			case <-ctx.Done():
+			case <-time.After(s3.retryDelay << (task.attempt - 1)):
+				s3.pool.AddTask(task)
+			}
+		}()
+		return
+	}
+	task.wg.Done()
+}
+
+// objectReader - seekable reader of S3 object. After seek the object is requested again from the new offset on the next read.
+type objectReader struct {
+	ctx        context.Context
+	s3         *S3
+	key        string
+	size       int64
+	offset     int64
+	body       io.ReadCloser
+	bodyOffset int64
+}
+
+func (r *objectReader) Read(p []byte) (int, error) {
+	if r.offset >= r.size {
+		return 0, io.EOF
+	}
+
+	if r.body != nil && r.bodyOffset != r.offset {
+		if err := r.body.Close(); err != nil {
+			return 0, err
+		}
+		r.body = nil
+	}
+
+	if r.body == nil {
+		obj, err := r.s3.client.GetObject(r.ctx, &serviceS3.GetObjectInput{
+			Bucket: aws.String(r.s3.cfg.BucketName),
+			Key:    aws.String(r.key),
+			Range:  aws.String(fmt.Sprintf("bytes=%d-", r.offset)),
+		})
+		if err != nil {
+			return 0, err
+		}
+		r.body = obj.Body
+		r.bodyOffset = r.offset
+	}
+
+	n, err := r.body.Read(p)
+	r.offset += int64(n)
+	r.bodyOffset = r.offset
+	return n, err
+}
+
+func (r *objectReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.offset
+	case io.SeekEnd:
+		offset += r.size
+	default:
+		return 0, fmt.Errorf("invalid whence: %d", whence)
+	}
+	if offset < 0 {
+		return 0, errors.New("negative position")
+	}
+	r.offset = offset
+	return offset, nil
+}
+
+func (r *objectReader) Close() error {
+	if r.body == nil {
+		return nil
+	}
+	return r.body.Close()
+}
+
+func isNotFound(err error) bool {
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	switch apiErr.(type) {
+	case *s3Types.NoSuchKey, *s3Types.NotFound:
+		return true
+	default:
+		return apiErr.ErrorCode() == "NoSuchKey" || apiErr.ErrorCode() == "NotFound"
+	}
+}
@@ -0,0 +1,335 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package blob
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	nodeTypes "github.com/celenium-io/celestia-indexer/pkg/node/types"
+	"github.com/stretchr/testify/require"
+	blobTypes "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+// fakeS3 - minimal in-memory S3-compatible server supporting path-style put, get, list and delete of objects
+type fakeS3 struct {
+	mx      sync.Mutex
+	objects map[string][]byte
+	denied  map[string]int
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	switch r.Method {
+	case http.MethodPut:
+		if _, ok := f.denied[r.URL.Path]; ok {
+			f.denied[r.URL.Path] += 1
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>`))
+			return
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		f.objects[r.URL.Path] = data
+		w.WriteHeader(http.StatusOK)
+	case http.MethodGet:
+		if r.URL.Query().Get("list-type") == "2" {
+			f.list(w, r)
+			return
+		}
+		data, ok := f.objects[r.URL.Path]
+		if !ok {
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>`))
+			return
+		}
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(data))
+	case http.MethodDelete:
+		delete(f.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (f *fakeS3) list(w http.ResponseWriter, r *http.Request) {
+	prefix := r.URL.Path + "/" + r.URL.Query().Get("prefix")
+	delimiter := r.URL.Query().Get("delimiter")
+
+	keys := make([]string, 0)
+	commonPrefixes := make(map[string]struct{})
+	for key := range f.objects {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		if delimiter != "" {
+			if idx := strings.Index(strings.TrimPrefix(key, prefix), delimiter); idx >= 0 {
+				commonPrefixes[strings.TrimPrefix(key[:len(prefix)+idx+1], r.URL.Path+"/")] = struct{}{}
+				continue
+			}
+		}
+		keys = append(keys, strings.TrimPrefix(key, r.URL.Path+"/"))
+	}
+	sort.Strings(keys)
+
+	var buf strings.Builder
+	buf.WriteString(`<?xml version="1.0" encoding="UTF-8"?><ListBucketResult><IsTruncated>false</IsTruncated>`)
+	for i := range keys {
+		buf.WriteString("<Contents><Key>" + keys[i] + "</Key></Contents>")
+	}
+	for commonPrefix := range commonPrefixes {
+		buf.WriteString("<CommonPrefixes><Prefix>" + commonPrefix + "</Prefix></CommonPrefixes>")
+	}
+	buf.WriteString(fmt.Sprintf("<KeyCount>%d</KeyCount></ListBucketResult>", len(keys)))
+
+	w.Header().Set("Content-Type", "application/xml")
+	_, _ = w.Write([]byte(buf.String()))
+}
+
+func TestS3PathStyle(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := &fakeS3{objects: make(map[string][]byte)}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	s3 := NewS3(S3Config{
+		Endpoint:        ts.URL,
+		Region:          "us-east-1",
+		BucketName:      "blobs",
+		AccessKeyId:     "minioadmin",
+		AccessKeySecret: "minioadmin",
+		UsePathStyle:    true,
+	})
+	require.NoError(t, s3.Init(ctx))
+
+	head, err := s3.Head(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, head)
+
+	require.NoError(t, s3.UpdateHead(ctx, 100))
+	head, err = s3.Head(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 100, head)
+
+	blob := Blob{
+		Blob: &blobTypes.Blob{
+			NamespaceId: []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10},
+			Data:        []byte{0, 1, 2, 3},
+		},
+		Commitment: []byte{0x01, 0xfe},
+		Height:     100,
+	}
+	require.NoError(t, s3.Save(ctx, blob))
+	require.Contains(t, server.objects, "/blobs/"+blob.String())
+
+	namespace := base64.StdEncoding.EncodeToString(append([]byte{0}, blob.NamespaceId...))
+	commitment := base64.StdEncoding.EncodeToString(blob.Commitment)
+
+	received, err := s3.Blob(ctx, 100, namespace, commitment)
+	require.NoError(t, err)
+	require.Equal(t, base64.StdEncoding.EncodeToString([]byte{0, 1, 2, 3}), received.Data)
+	require.Equal(t, namespace, received.Namespace)
+	require.Equal(t, commitment, received.Commitment)
+
+	require.NoError(t, s3.SaveBulk(ctx, []Blob{blob}))
+	require.Contains(t, server.objects, "/blobs/heights/100.json")
+
+	blobs, err := s3.Blobs(ctx, 100, namespace)
+	require.NoError(t, err)
+	require.Len(t, blobs, 1)
+	require.Equal(t, received, blobs[0])
+
+	blobs, err = s3.Blobs(ctx, 100)
+	require.NoError(t, err)
+	require.Len(t, blobs, 1)
+	require.Equal(t, received, blobs[0])
+
+	blobs, err = s3.Blobs(ctx, 101, namespace)
+	require.NoError(t, err)
+	require.Len(t, blobs, 0)
+
+	require.NoError(t, s3.Rollback(ctx, 99))
+	require.NotContains(t, server.objects, "/blobs/"+blob.String())
+	require.NotContains(t, server.objects, "/blobs/heights/100.json")
+
+	head, err = s3.Head(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 99, head)
+}
+
+func TestS3WithoutHeightIndex(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := &fakeS3{objects: make(map[string][]byte)}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	s3 := NewS3(S3Config{
+		Endpoint:        ts.URL,
+		Region:          "us-east-1",
+		BucketName:      "blobs",
+		AccessKeyId:     "minioadmin",
+		AccessKeySecret: "minioadmin",
+		UsePathStyle:    true,
+	})
+	require.NoError(t, s3.Init(ctx))
+
+	// blobs saved without height index, for example, before it was introduced
+	legacy := []Blob{
+		{
+			Blob: &blobTypes.Blob{
+				NamespaceId: []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10},
+				Data:        []byte{0, 1, 2, 3},
+			},
+			Commitment: []byte{0x01, 0xfe},
+			Height:     100,
+		}, {
+			Blob: &blobTypes.Blob{
+				NamespaceId: []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 11},
+				Data:        []byte{4, 5, 6},
+			},
+			Commitment: []byte{0x02, 0xfe},
+			Height:     100,
+		},
+	}
+	for i := range legacy {
+		require.NoError(t, s3.Save(ctx, legacy[i]))
+	}
+	require.NoError(t, s3.UpdateHead(ctx, 100))
+
+	blobs, err := s3.Blobs(ctx, 100)
+	require.NoError(t, err)
+	require.Len(t, blobs, 2)
+
+	blobs, err = s3.Blobs(ctx, 99)
+	require.NoError(t, err)
+	require.Len(t, blobs, 0)
+
+	require.NoError(t, s3.Rollback(ctx, 99))
+	for i := range legacy {
+		require.NotContains(t, server.objects, "/blobs/"+legacy[i].String())
+	}
+}
+
+func TestS3RawBlob(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := &fakeS3{objects: make(map[string][]byte)}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	s3 := NewS3(S3Config{
+		Endpoint:        ts.URL,
+		Region:          "us-east-1",
+		BucketName:      "blobs",
+		AccessKeyId:     "minioadmin",
+		AccessKeySecret: "minioadmin",
+		UsePathStyle:    true,
+	})
+	require.NoError(t, s3.Init(ctx))
+
+	blob := Blob{
+		Blob: &blobTypes.Blob{
+			NamespaceId: []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10},
+			Data:        []byte("raw blob data"),
+		},
+		Commitment: []byte{0x01, 0xfe},
+		Height:     100,
+	}
+	require.NoError(t, s3.Save(ctx, blob))
+
+	namespace := base64.StdEncoding.EncodeToString(append([]byte{0}, blob.NamespaceId...))
+	commitment := base64.StdEncoding.EncodeToString(blob.Commitment)
+
+	raw, err := s3.RawBlob(ctx, 100, namespace, commitment)
+	require.NoError(t, err)
+	defer raw.Close()
+
+	size, err := raw.Seek(0, io.SeekEnd)
+	require.NoError(t, err)
+	require.EqualValues(t, len(blob.Data), size)
+
+	_, err = raw.Seek(4, io.SeekStart)
+	require.NoError(t, err)
+	part := make([]byte, 4)
+	_, err = io.ReadFull(raw, part)
+	require.NoError(t, err)
+	require.Equal(t, "blob", string(part))
+
+	_, err = raw.Seek(0, io.SeekStart)
+	require.NoError(t, err)
+	data, err := io.ReadAll(raw)
+	require.NoError(t, err)
+	require.Equal(t, blob.Data, data)
+
+	_, err = s3.RawBlob(ctx, 101, namespace, commitment)
+	require.ErrorIs(t, err, nodeTypes.ErrBlobNotFound)
+
+	_, err = s3.Blob(ctx, 101, namespace, commitment)
+	require.ErrorIs(t, err, nodeTypes.ErrBlobNotFound)
+}
+
+func TestS3SaveBulkFailed(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	blob := Blob{
+		Blob: &blobTypes.Blob{
+			NamespaceId: []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10},
+			Data:        []byte{0, 1, 2, 3},
+		},
+		Commitment: []byte{0x01, 0xfe},
+		Height:     100,
+	}
+
+	server := &fakeS3{
+		objects: make(map[string][]byte),
+		denied: map[string]int{
+			"/blobs/" + blob.String(): 0,
+		},
+	}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	s3 := NewS3(S3Config{
+		Endpoint:        ts.URL,
+		Region:          "us-east-1",
+		BucketName:      "blobs",
+		AccessKeyId:     "minioadmin",
+		AccessKeySecret: "minioadmin",
+		UsePathStyle:    true,
+	})
+	s3.retryDelay = time.Millisecond
+	require.NoError(t, s3.Init(ctx))
+
+	err := s3.SaveBulk(ctx, []Blob{blob})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), blob.String())
+
+	server.mx.Lock()
+	defer server.mx.Unlock()
+	require.Equal(t, saveAttempts, server.denied["/blobs/"+blob.String()])
+	require.NotContains(t, server.objects, "/blobs/heights/100.json")
+}
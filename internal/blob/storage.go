@@ -7,11 +7,19 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"strings"
 
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
 	"github.com/gabriel-vasile/mimetype"
+	"github.com/pkg/errors"
 	blobTypes "github.com/tendermint/tendermint/proto/tendermint/types"
 )
 
+const (
+	headFile       = "head.json"
+	heightIndexDir = "heights"
+)
+
 type Blob struct {
 	*blobTypes.Blob
 	Commitment []byte
@@ -36,6 +44,75 @@ type Storage interface {
 	SaveBulk(ctx context.Context, blobs []Blob) error
 	Head(ctx context.Context) (uint64, error)
 	UpdateHead(ctx context.Context, head uint64) error
+	// Rollback - removes blobs saved above the height and moves head to the height
+	Rollback(ctx context.Context, height uint64) error
+}
+
+// heightIndexKey - key of the file containing list of blob keys saved at the height. It's used to find blobs for rollback.
+// Blobs saved without the index (for example, before it was introduced) are found by listing of `{namespace}/{height}/` prefixes.
+func heightIndexKey(height uint64) string {
+	return fmt.Sprintf("%s/%d.json", heightIndexDir, height)
+}
+
+// keysByHeight - groups blob keys by heights
+func keysByHeight(blobs []Blob) map[uint64][]string {
+	keys := make(map[uint64][]string)
+	for i := range blobs {
+		keys[blobs[i].Height] = append(keys[blobs[i].Height], blobs[i].String())
+	}
+	return keys
+}
+
+// mergeKeys - appends keys to saved ones skipping duplicates
+func mergeKeys(saved, keys []string) []string {
+	exists := make(map[string]struct{}, len(saved))
+	for i := range saved {
+		exists[saved[i]] = struct{}{}
+	}
+	for i := range keys {
+		if _, ok := exists[keys[i]]; ok {
+			continue
+		}
+		exists[keys[i]] = struct{}{}
+		saved = append(saved, keys[i])
+	}
+	return saved
+}
+
+// blobKey - returns the same key as Blob.String for base64-encoded namespace and commitment
+func blobKey(height pkgTypes.Level, namespace, commitment string) (string, error) {
+	ns, err := Base64ToUrl(namespace)
+	if err != nil {
+		return "", err
+	}
+	cm, err := Base64ToUrl(commitment)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/%d/%s", ns, height, cm), nil
+}
+
+// parseKey - returns base64-encoded namespace and commitment from key made by Blob.String
+func parseKey(key string) (namespace string, commitment string, err error) {
+	parts := strings.Split(key, "/")
+	if len(parts) != 3 {
+		return "", "", errors.Errorf("invalid blob key: %s", key)
+	}
+	if namespace, err = urlToBase64(parts[0]); err != nil {
+		return "", "", errors.Wrap(err, key)
+	}
+	if commitment, err = urlToBase64(parts[2]); err != nil {
+		return "", "", errors.Wrap(err, key)
+	}
+	return
+}
+
+func urlToBase64(s string) (string, error) {
+	b, err := base64.URLEncoding.DecodeString(s)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(b), nil
 }
 
 func Base64ToUrl(s string) (string, error) {
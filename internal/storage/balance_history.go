@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+type BalanceHistoryFilters struct {
+	Limit    int
+	Offset   int
+	Sort     storage.SortOrder
+	Type     []types.BalanceHistoryType
+	Height   uint64
+	TimeFrom time.Time
+	TimeTo   time.Time
+}
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IBalanceHistory interface {
+	storage.Table[*BalanceHistory]
+
+	ByAddress(ctx context.Context, addressId uint64, fltrs BalanceHistoryFilters) ([]BalanceHistory, error)
+}
+
+// BalanceHistory - change of address balance in the block grouped by cause
+type BalanceHistory struct {
+	bun.BaseModel `bun:"balance_history" comment:"Table with history of account balances"`
+
+	Id              uint64                   `bun:"id,pk,notnull,autoincrement"     comment:"Unique internal id"`
+	Height          pkgTypes.Level           `bun:"height,notnull"                  comment:"The number (height) of this block"`
+	Time            time.Time                `bun:"time,pk,notnull"                 comment:"The time of block"`
+	AddressId       uint64                   `bun:"address_id,notnull"              comment:"Internal address id"`
+	Currency        string                   `bun:"currency,notnull"                comment:"Balance currency"`
+	Type            types.BalanceHistoryType `bun:"type,type:balance_history_type"  comment:"Cause of balance change"`
+	SpendableChange decimal.Decimal          `bun:"spendable_change,type:numeric"   comment:"Change of spendable balance"`
+	DelegatedChange decimal.Decimal          `bun:"delegated_change,type:numeric"   comment:"Change of delegated balance"`
+	UnbondingChange decimal.Decimal          `bun:"unbonding_change,type:numeric"   comment:"Change of unbonding balance"`
+	Spendable       decimal.Decimal          `bun:"spendable,type:numeric"          comment:"Spendable balance after change"`
+	Delegated       decimal.Decimal          `bun:"delegated,type:numeric"          comment:"Delegated balance after change"`
+	Unbonding       decimal.Decimal          `bun:"unbonding,type:numeric"          comment:"Unbonding balance after change"`
+
+	Address *Address `bun:"rel:belongs-to,join:address_id=id"`
+}
+
+// TableName -
+func (BalanceHistory) TableName() string {
+	return "balance_history"
+}
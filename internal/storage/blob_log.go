@@ -5,6 +5,7 @@ package storage
 
 import (
 	"context"
+	"encoding/json"
 	"io"
 	"time"
 
@@ -38,6 +39,7 @@ type IBlobLog interface {
 	CountByHeight(ctx context.Context, height types.Level) (int, error)
 	ExportByProviders(ctx context.Context, providers []RollupProvider, from, to time.Time, stream io.Writer) (err error)
 	Blob(ctx context.Context, height types.Level, nsId uint64, commitment string) (BlobLog, error)
+	SharesByHeight(ctx context.Context, height types.Level) ([]BlobLog, error)
 }
 
 type BlobLog struct {
@@ -51,6 +53,16 @@ type BlobLog struct {
 	ContentType string          `bun:"content_type"        comment:"Blob content type"`
 	Fee         decimal.Decimal `bun:"fee,type:numeric"    comment:"Fee per blob"`
 
+	ShareStart int64 `bun:"share_start" comment:"Index of the first blob share in the original data square"`
+	ShareCount int64 `bun:"share_count" comment:"Count of shares occupied by the blob"`
+	StartRow   int64 `bun:"start_row"   comment:"Row of the first blob share in the original data square"`
+	StartCol   int64 `bun:"start_col"   comment:"Column of the first blob share in the original data square"`
+	EndRow     int64 `bun:"end_row"     comment:"Row of the last blob share in the original data square"`
+	EndCol     int64 `bun:"end_col"     comment:"Column of the last blob share in the original data square"`
+
+	Decoder string          `bun:"decoder"                   comment:"Name of decoder which recognized blob payload"`
+	Batch   json.RawMessage `bun:"batch,type:jsonb,nullzero" comment:"Decoded metadata of rollup batch posted in the blob"`
+
 	SignerId    uint64 `bun:"signer_id"    comment:"Blob signer identity"`
 	NamespaceId uint64 `bun:"namespace_id" comment:"Namespace internal id"`
 	MsgId       uint64 `bun:"msg_id"       comment:"Message id"`
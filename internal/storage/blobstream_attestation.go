@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+type BlobstreamAttestationFilters struct {
+	Limit    int
+	Offset   int
+	Sort     storage.SortOrder
+	Type     []types.AttestationType
+	TimeFrom time.Time
+	TimeTo   time.Time
+}
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IBlobstreamAttestation interface {
+	storage.Table[*BlobstreamAttestation]
+
+	ListWithFilters(ctx context.Context, fltrs BlobstreamAttestationFilters) ([]BlobstreamAttestation, error)
+	ByNonce(ctx context.Context, nonce uint64) (BlobstreamAttestation, error)
+	ByDataHeight(ctx context.Context, height pkgTypes.Level) (BlobstreamAttestation, error)
+}
+
+// BlobstreamAttestation - attestation requested by blobstream module. It's a data commitment over the range of blocks or a validator set update.
+type BlobstreamAttestation struct {
+	bun.BaseModel `bun:"table:blobstream_attestation" comment:"Table with blobstream attestations"`
+
+	Id         uint64                `bun:"id,pk,notnull,autoincrement" comment:"Unique internal id"`
+	Height     pkgTypes.Level        `bun:"height,notnull"              comment:"The number (height) of block when attestation was requested"`
+	Time       time.Time             `bun:"time,notnull"                comment:"The time of block when attestation was requested"`
+	Nonce      uint64                `bun:"nonce,unique,notnull"        comment:"Universal attestation nonce"`
+	Type       types.AttestationType `bun:"type,type:attestation_type"  comment:"Attestation type"`
+	BeginBlock pkgTypes.Level        `bun:"begin_block"                 comment:"First block of data commitment range"`
+	EndBlock   pkgTypes.Level        `bun:"end_block"                   comment:"End exclusive block of data commitment range"`
+}
+
+// TableName -
+func (BlobstreamAttestation) TableName() string {
+	return "blobstream_attestation"
+}
+
+// IsDataCommitment -
+func (a BlobstreamAttestation) IsDataCommitment() bool {
+	return a.Type == types.AttestationTypeDataCommitment
+}
@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+type BlobstreamEvmAddressFilters struct {
+	Limit       int
+	Offset      int
+	Sort        storage.SortOrder
+	ValidatorId *uint64
+}
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IBlobstreamEvmAddress interface {
+	storage.Table[*BlobstreamEvmAddress]
+
+	ListWithFilters(ctx context.Context, fltrs BlobstreamEvmAddressFilters) ([]BlobstreamEvmAddress, error)
+}
+
+// BlobstreamEvmAddress - EVM address registered by validator to sign blobstream attestations
+type BlobstreamEvmAddress struct {
+	bun.BaseModel `bun:"table:blobstream_evm_address" comment:"Table with EVM addresses registered by validators for blobstream"`
+
+	Id          uint64         `bun:"id,pk,notnull,autoincrement" comment:"Unique internal id"`
+	Height      pkgTypes.Level `bun:"height,notnull"              comment:"The number (height) of block when address was registered"`
+	Time        time.Time      `bun:"time,notnull"                comment:"The time of block when address was registered"`
+	ValidatorId uint64         `bun:"validator_id,notnull"        comment:"Internal validator id"`
+	TxId        uint64         `bun:"tx_id"                       comment:"Registration transaction id"`
+	EvmAddress  string         `bun:"evm_address,type:text"       comment:"Registered EVM address"`
+
+	Validator *Validator `bun:"rel:belongs-to,join:validator_id=id"`
+	Tx        *Tx        `bun:"rel:belongs-to,join:tx_id=id"`
+}
+
+// TableName -
+func (BlobstreamEvmAddress) TableName() string {
+	return "blobstream_evm_address"
+}
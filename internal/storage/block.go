@@ -55,6 +55,8 @@ type Block struct {
 	ChainId         string           `bun:"-"` // internal field for filling state
 	ProposerAddress string           `bun:"-"` // internal field for proposer
 	BlockSignatures []BlockSignature `bun:"-"` // internal field for block signature
+	MissedBlocks    []MissedBlock    `bun:"-"` // internal field for blocks missed by validators
+	Dah             *BlockDah        `bun:"-"` // internal field for computed data availability header
 
 	Txs      []Tx       `bun:"rel:has-many"`
 	Events   []Event    `bun:"rel:has-many"`
@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IBlockDah interface {
+	storage.Table[*BlockDah]
+
+	ByHeight(ctx context.Context, height types.Level) (BlockDah, error)
+}
+
+// BlockDah - data availability header computed by indexer from the block transactions
+type BlockDah struct {
+	bun.BaseModel `bun:"block_dah" comment:"Table with data availability headers of blocks"`
+
+	Id          uint64      `bun:"id,pk,notnull,autoincrement" comment:"Unique internal id"`
+	Height      types.Level `bun:"height,notnull"              comment:"The number (height) of this block"`
+	Time        time.Time   `bun:"time,pk,notnull"             comment:"The time of block"`
+	Hash        types.Hex   `bun:"hash"                        comment:"Hash of the computed data availability header"`
+	DataHash    types.Hex   `bun:"data_hash"                   comment:"Data hash from the block header"`
+	Valid       bool        `bun:"valid"                       comment:"Flag is set when computed hash is equal to data hash from the block header"`
+	SquareSize  int64       `bun:"square_size"                 comment:"Width of the original data square"`
+	RowRoots    []string    `bun:"row_roots,array"             comment:"Hex-encoded row roots of the extended data square"`
+	ColumnRoots []string    `bun:"column_roots,array"          comment:"Hex-encoded column roots of the extended data square"`
+}
+
+func (BlockDah) TableName() string {
+	return "block_dah"
+}
@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IConstantHistory interface {
+	storage.Table[*ConstantHistory]
+
+	ByHeight(ctx context.Context, height pkgTypes.Level) ([]Constant, error)
+}
+
+// ConstantHistory - value of the constant which became effective at the height
+type ConstantHistory struct {
+	bun.BaseModel `bun:"table:constant_history" comment:"Table with history of celestia constants changes."`
+
+	Id         uint64           `bun:"id,pk,notnull,autoincrement" comment:"Unique internal id"`
+	Height     pkgTypes.Level   `bun:"height,notnull"              comment:"The number (height) of block when value became effective"`
+	Time       time.Time        `bun:"time,notnull"                comment:"The time of block when value became effective"`
+	Module     types.ModuleName `bun:"module,type:module_name"     comment:"Module name which declares constant"`
+	Name       string           `bun:"name,type:text"              comment:"Constant name"`
+	Value      string           `bun:"value,type:text"             comment:"Constant value"`
+	ProposalId *uint64          `bun:"proposal_id"                 comment:"Proposal identity which changed the value"`
+}
+
+// TableName -
+func (ConstantHistory) TableName() string {
+	return "constant_history"
+}
+
+// Constant - returns constant with value from history
+func (ch ConstantHistory) Constant() Constant {
+	return Constant{
+		Module: ch.Module,
+		Name:   ch.Name,
+		Value:  ch.Value,
+	}
+}
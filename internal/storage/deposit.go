@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IDeposit interface {
+	storage.Table[*Deposit]
+
+	ByProposalId(ctx context.Context, proposalId uint64, limit, offset int, sort storage.SortOrder) ([]Deposit, error)
+	ByDepositorId(ctx context.Context, depositorId uint64, limit, offset int, sort storage.SortOrder) ([]Deposit, error)
+}
+
+// Deposit -
+type Deposit struct {
+	bun.BaseModel `bun:"deposit" comment:"Table with governance deposits."`
+
+	Id          uint64          `bun:"id,pk,notnull,autoincrement" comment:"Unique internal identity"`
+	Height      pkgTypes.Level  `bun:"height,notnull"              comment:"The number (height) of this block"`
+	Time        time.Time       `bun:"time,notnull"                comment:"The time of block"`
+	ProposalId  uint64          `bun:"proposal_id,notnull"         comment:"Proposal identity"`
+	DepositorId uint64          `bun:"depositor_id,notnull"        comment:"Depositor internal identity"`
+	Amount      decimal.Decimal `bun:"amount,type:numeric"         comment:"Deposited amount"`
+
+	Depositor *Address  `bun:"rel:belongs-to,join:depositor_id=id"`
+	Proposal  *Proposal `bun:"rel:belongs-to,join:proposal_id=id"`
+}
+
+// TableName -
+func (Deposit) TableName() string {
+	return "deposit"
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"time"
 
+	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
 	"github.com/celenium-io/celestia-indexer/pkg/types"
 	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	"github.com/lib/pq"
@@ -17,6 +18,7 @@ import (
 var Models = []any{
 	&State{},
 	&Constant{},
+	&ConstantHistory{},
 	&DenomMetadata{},
 	&Balance{},
 	&Address{},
@@ -31,6 +33,7 @@ var Models = []any{
 	&Namespace{},
 	&NamespaceMessage{},
 	&Signer{},
+	&TxAddress{},
 	&MsgAddress{},
 	&Validator{},
 	&Delegation{},
@@ -43,6 +46,31 @@ var Models = []any{
 	&Rollup{},
 	&RollupProvider{},
 	&Grant{},
+	&GrantUpdate{},
+	&Proposal{},
+	&Vote{},
+	&Deposit{},
+	&IbcClient{},
+	&IbcConnection{},
+	&IbcChannel{},
+	&IbcTransfer{},
+	&BalanceHistory{},
+	&Transfer{},
+	&BlobstreamAttestation{},
+	&BlobstreamEvmAddress{},
+	&Slash{},
+	&Group{},
+	&GroupMember{},
+	&GroupPolicy{},
+	&GroupProposal{},
+	&GroupVote{},
+	&GroupUpdate{},
+	&InterchainAccount{},
+	&InterchainAccountPacket{},
+	&Upgrade{},
+	&ValidatorHistory{},
+	&MissedBlock{},
+	&BlockDah{},
 }
 
 //go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
@@ -68,21 +96,29 @@ type Transaction interface {
 	sdk.Transaction
 
 	SaveConstants(ctx context.Context, constants ...Constant) error
+	SaveConstantHistory(ctx context.Context, history ...ConstantHistory) error
 	SaveTransactions(ctx context.Context, txs ...Tx) error
 	SaveNamespaces(ctx context.Context, namespaces ...*Namespace) (int64, error)
 	SaveAddresses(ctx context.Context, addresses ...*Address) (int64, error)
 	SaveVestingAccounts(ctx context.Context, accounts ...*VestingAccount) error
 	SaveVestingPeriods(ctx context.Context, periods ...VestingPeriod) error
 	SaveBalances(ctx context.Context, balances ...Balance) error
+	SaveBalanceHistory(ctx context.Context, history ...BalanceHistory) error
+	SaveTransfers(ctx context.Context, transfers ...*Transfer) error
+	SaveBlobstreamAttestations(ctx context.Context, attestations ...*BlobstreamAttestation) error
+	SaveBlobstreamEvmAddresses(ctx context.Context, addresses ...*BlobstreamEvmAddress) error
 	SaveMessages(ctx context.Context, msgs ...*Message) error
 	SaveSigners(ctx context.Context, addresses ...Signer) error
+	SaveTxAddresses(ctx context.Context, addresses ...TxAddress) error
 	SaveMsgAddresses(ctx context.Context, addresses ...MsgAddress) error
 	SaveNamespaceMessage(ctx context.Context, nsMsgs ...NamespaceMessage) error
 	SaveBlobLogs(ctx context.Context, logs ...BlobLog) error
 	SaveValidators(ctx context.Context, validators ...*Validator) (int, error)
+	SaveValidatorHistory(ctx context.Context, height types.Level, t time.Time, validatorIds ...uint64) error
 	SaveEvents(ctx context.Context, events ...Event) error
 	SaveRollup(ctx context.Context, rollup *Rollup) error
 	SaveGrants(ctx context.Context, grants ...Grant) error
+	SpendFeeGrants(ctx context.Context, grants ...Grant) error
 	UpdateRollup(ctx context.Context, rollup *Rollup) error
 	SaveProviders(ctx context.Context, providers ...RollupProvider) error
 	SaveUndelegations(ctx context.Context, undelegations ...Undelegation) error
@@ -91,12 +127,35 @@ type Transaction interface {
 	UpdateSlashedDelegations(ctx context.Context, validatorId uint64, fraction decimal.Decimal) ([]Balance, error)
 	SaveStakingLogs(ctx context.Context, logs ...StakingLog) error
 	SaveJails(ctx context.Context, jails ...Jail) error
+	SaveSlashes(ctx context.Context, slashes ...*Slash) error
 	SaveBlockSignatures(ctx context.Context, signs ...BlockSignature) error
+	SaveMissedBlocks(ctx context.Context, blocks ...MissedBlock) error
+	SaveBlockDah(ctx context.Context, dah *BlockDah) error
 	RetentionBlockSignatures(ctx context.Context, height types.Level) error
 	CancelUnbondings(ctx context.Context, cancellations ...Undelegation) error
 	RetentionCompletedUnbondings(ctx context.Context, blockTime time.Time) error
 	RetentionCompletedRedelegations(ctx context.Context, blockTime time.Time) error
 	Jail(ctx context.Context, validators ...*Validator) error
+	SaveProposals(ctx context.Context, proposals ...*Proposal) error
+	SaveVotes(ctx context.Context, votes ...Vote) error
+	UpdateProposalsTally(ctx context.Context, proposalIds ...uint64) error
+	SaveDeposits(ctx context.Context, deposits ...Deposit) error
+	SaveIbcClients(ctx context.Context, clients ...*IbcClient) error
+	SaveIbcConnections(ctx context.Context, connections ...*IbcConnection) error
+	SaveIbcChannels(ctx context.Context, channels ...*IbcChannel) error
+	SaveIbcTransfers(ctx context.Context, transfers ...*IbcTransfer) error
+	UpdateIbcTransfers(ctx context.Context, transfers ...*IbcTransfer) error
+	SaveGroups(ctx context.Context, groups ...*Group) error
+	SaveGroupMembers(ctx context.Context, members ...*GroupMember) error
+	SaveGroupPolicies(ctx context.Context, policies ...*GroupPolicy) error
+	SaveGroupProposals(ctx context.Context, proposals ...*GroupProposal) error
+	SaveGroupVotes(ctx context.Context, votes ...*GroupVote) error
+	UpdateGroupWeights(ctx context.Context, groupIds ...uint64) error
+	SaveInterchainAccounts(ctx context.Context, accounts ...*InterchainAccount) error
+	SaveInterchainAccountPackets(ctx context.Context, packets ...*InterchainAccountPacket) error
+	SaveUpgrades(ctx context.Context, upgrades ...*Upgrade) error
+	CancelUpgrades(ctx context.Context, height types.Level, t time.Time) error
+	ApplyUpgrades(ctx context.Context, height types.Level, t time.Time, appVersion uint64) (int64, error)
 
 	RollbackBlock(ctx context.Context, height types.Level) error
 	RollbackBlockStats(ctx context.Context, height types.Level) (stats BlockStats, err error)
@@ -112,12 +171,38 @@ type Transaction interface {
 	RollbackBlobLog(ctx context.Context, height types.Level) error
 	RollbackGrants(ctx context.Context, height types.Level) error
 	RollbackBlockSignatures(ctx context.Context, height types.Level) (err error)
+	RollbackMissedBlocks(ctx context.Context, height types.Level) error
+	RollbackBlockDah(ctx context.Context, height types.Level) error
 	RollbackSigners(ctx context.Context, txIds []uint64) (err error)
+	RollbackTxAddresses(ctx context.Context, txIds []uint64) (addresses []TxAddress, err error)
 	RollbackMessageAddresses(ctx context.Context, msgIds []uint64) (err error)
 	RollbackUndelegations(ctx context.Context, height types.Level) (err error)
 	RollbackRedelegations(ctx context.Context, height types.Level) (err error)
 	RollbackStakingLogs(ctx context.Context, height types.Level) ([]StakingLog, error)
 	RollbackJails(ctx context.Context, height types.Level) ([]Jail, error)
+	RollbackProposals(ctx context.Context, height types.Level) error
+	RollbackVotes(ctx context.Context, height types.Level) ([]Vote, error)
+	RollbackDeposits(ctx context.Context, height types.Level) ([]Deposit, error)
+	RollbackIbcClients(ctx context.Context, height types.Level) error
+	RollbackIbcConnections(ctx context.Context, height types.Level) error
+	RollbackIbcChannels(ctx context.Context, height types.Level) error
+	RollbackIbcTransfers(ctx context.Context, height types.Level) error
+	RollbackBalanceHistory(ctx context.Context, height types.Level) error
+	RollbackTransfers(ctx context.Context, height types.Level) error
+	RollbackConstantHistory(ctx context.Context, height types.Level) ([]ConstantHistory, error)
+	RollbackBlobstreamAttestations(ctx context.Context, height types.Level) error
+	RollbackBlobstreamEvmAddresses(ctx context.Context, height types.Level) error
+	RollbackSlashes(ctx context.Context, height types.Level) error
+	RollbackValidatorHistory(ctx context.Context, height types.Level) error
+	RollbackGroups(ctx context.Context, height types.Level) error
+	RollbackGroupMembers(ctx context.Context, height types.Level) ([]GroupMember, error)
+	RollbackGroupPolicies(ctx context.Context, height types.Level) error
+	RollbackGroupProposals(ctx context.Context, height types.Level) error
+	RollbackGroupVotes(ctx context.Context, height types.Level) ([]GroupVote, error)
+	RollbackGroupUpdates(ctx context.Context, height types.Level) error
+	RollbackInterchainAccounts(ctx context.Context, height types.Level) error
+	RollbackInterchainAccountPackets(ctx context.Context, height types.Level) error
+	RollbackUpgrades(ctx context.Context, height types.Level) error
 	DeleteBalances(ctx context.Context, ids []uint64) error
 	DeleteProviders(ctx context.Context, rollupId uint64) error
 	DeleteRollup(ctx context.Context, rollupId uint64) error
@@ -126,6 +211,8 @@ type Transaction interface {
 
 	State(ctx context.Context, name string) (state State, err error)
 	LastBlock(ctx context.Context) (block Block, err error)
+	LastConstant(ctx context.Context, module storageTypes.ModuleName, name string) (ConstantHistory, error)
+	LastDataCommitment(ctx context.Context) (BlobstreamAttestation, error)
 	Namespace(ctx context.Context, id uint64) (ns Namespace, err error)
 	LastNamespaceMessage(ctx context.Context, nsId uint64) (msg NamespaceMessage, err error)
 	LastAddressAction(ctx context.Context, address []byte) (uint64, error)
@@ -137,6 +224,9 @@ type Transaction interface {
 const (
 	ChannelHead  = "head"
 	ChannelBlock = "block"
+	ChannelBlob  = "blob"
+	// ChannelTx is not a postgres notification channel: transactions are loaded by API from block notifications
+	ChannelTx = "tx"
 )
 
 type SearchResult struct {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/celenium-io/celestia-indexer/pkg/types"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/shopspring/decimal"
 	"github.com/uptrace/bun"
 )
 
@@ -24,16 +25,18 @@ type IGrant interface {
 type Grant struct {
 	bun.BaseModel `bun:"grant" comment:"Table with grants"`
 
-	Id            uint64         `bun:"id,pk,notnull,autoincrement"    comment:"Unique internal identity"`
-	Height        types.Level    `bun:"height"                         comment:"Block height"`
-	RevokeHeight  *types.Level   `bun:"revoke_height"                  comment:"Block height when grant was revoked"`
-	Time          time.Time      `bun:"time"                           comment:"The time of block"`
-	GranterId     uint64         `bun:"granter_id,unique:grant_key"    comment:"Granter internal identity"`
-	GranteeId     uint64         `bun:"grantee_id,unique:grant_key"    comment:"Grantee internal identity"`
-	Authorization string         `bun:"authorization,unique:grant_key" comment:"Authorization type"`
-	Expiration    *time.Time     `bun:"expiration"                     comment:"Expiration time"`
-	Revoked       bool           `bun:"revoked"                        comment:"Is grant revoked"`
-	Params        map[string]any `bun:"params,type:jsonb,nullzero"     comment:"Authorization parameters"`
+	Id            uint64           `bun:"id,pk,notnull,autoincrement"    comment:"Unique internal identity"`
+	Height        types.Level      `bun:"height"                         comment:"Block height"`
+	RevokeHeight  *types.Level     `bun:"revoke_height"                  comment:"Block height when grant was revoked"`
+	Time          time.Time        `bun:"time"                           comment:"The time of block"`
+	GranterId     uint64           `bun:"granter_id,unique:grant_key"    comment:"Granter internal identity"`
+	GranteeId     uint64           `bun:"grantee_id,unique:grant_key"    comment:"Grantee internal identity"`
+	Authorization string           `bun:"authorization,unique:grant_key" comment:"Authorization type"`
+	Expiration    *time.Time       `bun:"expiration"                     comment:"Expiration time"`
+	Revoked       bool             `bun:"revoked"                        comment:"Is grant revoked"`
+	Params        map[string]any   `bun:"params,type:jsonb,nullzero"     comment:"Authorization parameters"`
+	SpendLimit    *decimal.Decimal `bun:"spend_limit,type:numeric"       comment:"Remaining fee spend limit in utia. Null if limit is not set"`
+	Spent         decimal.Decimal  `bun:"spent,type:numeric,default:0"   comment:"Total fee spent by grantee from the allowance in utia"`
 
 	Granter *Address `bun:"rel:has-one"`
 	Grantee *Address `bun:"rel:has-one"`
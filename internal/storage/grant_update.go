@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+// GrantUpdate - values of the grant before it was granted again in the block. It's used to restore the grant on rollback.
+type GrantUpdate struct {
+	bun.BaseModel `bun:"grant_update" comment:"Table with previous values of regranted grants. It's used by rollback."`
+
+	Id      uint64      `bun:"id,pk,notnull,autoincrement" comment:"Unique internal id"`
+	Height  types.Level `bun:"height,notnull"              comment:"The number (height) of block when grant was granted again"`
+	GrantId uint64      `bun:"grant_id,notnull"            comment:"Internal identity of regranted grant"`
+
+	GrantHeight  types.Level      `bun:"grant_height"               comment:"Previous block height of the grant"`
+	Time         time.Time        `bun:"time"                       comment:"Previous time of the grant"`
+	Expiration   *time.Time       `bun:"expiration"                 comment:"Previous expiration time"`
+	Revoked      bool             `bun:"revoked"                    comment:"Was grant revoked"`
+	RevokeHeight *types.Level     `bun:"revoke_height"              comment:"Previous block height when grant was revoked"`
+	Params       map[string]any   `bun:"params,type:jsonb,nullzero" comment:"Previous authorization parameters"`
+	SpendLimit   *decimal.Decimal `bun:"spend_limit,type:numeric"   comment:"Previous remaining fee spend limit in utia"`
+	Spent        decimal.Decimal  `bun:"spent,type:numeric"         comment:"Previous total fee spent by grantee from the allowance in utia"`
+}
+
+// TableName -
+func (GrantUpdate) TableName() string {
+	return "grant_update"
+}
@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+type GroupFilters struct {
+	Limit   int
+	Offset  int
+	Sort    storage.SortOrder
+	AdminId uint64
+}
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IGroup interface {
+	storage.Table[*Group]
+
+	ListWithFilters(ctx context.Context, filters GroupFilters) ([]Group, error)
+	ById(ctx context.Context, id uint64) (Group, error)
+}
+
+// Group - group of accounts of group module. Table is named `group_info` because `group` is reserved SQL keyword.
+type Group struct {
+	bun.BaseModel `bun:"table:group_info,alias:group_info" comment:"Table with groups of group module."`
+
+	Id           uint64          `bun:"id,pk,notnull"             comment:"Group identity from the chain"`
+	Height       pkgTypes.Level  `bun:"height,notnull"            comment:"The number (height) of block when group was created"`
+	Time         time.Time       `bun:"time,notnull"              comment:"The time of block when group was created"`
+	TxId         uint64          `bun:"tx_id"                     comment:"Internal identity of transaction which created group"`
+	AdminId      uint64          `bun:"admin_id"                  comment:"Internal identity of group admin address"`
+	Metadata     string          `bun:"metadata"                  comment:"Group metadata"`
+	TotalWeight  decimal.Decimal `bun:"total_weight,type:numeric" comment:"Sum of weights of group members"`
+	MembersCount int64           `bun:"members_count"             comment:"Count of group members"`
+
+	Admin   *Address       `bun:"rel:belongs-to,join:admin_id=id"`
+	Tx      *Tx            `bun:"rel:belongs-to,join:tx_id=id"`
+	Members []*GroupMember `bun:"-"` // internal field
+}
+
+// TableName -
+func (Group) TableName() string {
+	return "group_info"
+}
@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IGroupMember interface {
+	storage.Table[*GroupMember]
+
+	ByGroupId(ctx context.Context, groupId uint64, limit, offset int) ([]GroupMember, error)
+}
+
+// GroupMember - change of group member. Current members are the last changes of each address with positive weight.
+type GroupMember struct {
+	bun.BaseModel `bun:"table:group_member" comment:"Table with history of group members changes."`
+
+	Id        uint64          `bun:"id,pk,notnull,autoincrement" comment:"Unique internal identity"`
+	Height    pkgTypes.Level  `bun:"height,notnull"              comment:"The number (height) of block when member was changed"`
+	Time      time.Time       `bun:"time,notnull"                comment:"The time of block when member was changed"`
+	GroupId   uint64          `bun:"group_id,notnull"            comment:"Group identity"`
+	AddressId uint64          `bun:"address_id,notnull"          comment:"Internal identity of member address"`
+	TxId      uint64          `bun:"tx_id"                       comment:"Internal identity of transaction which changed member"`
+	Weight    decimal.Decimal `bun:"weight,type:numeric"         comment:"Member weight. Zero weight means member was removed from group"`
+	Metadata  string          `bun:"metadata"                    comment:"Member metadata"`
+
+	Address *Address `bun:"rel:belongs-to,join:address_id=id"`
+	Tx      *Tx      `bun:"rel:belongs-to,join:tx_id=id"`
+}
+
+// TableName -
+func (GroupMember) TableName() string {
+	return "group_member"
+}
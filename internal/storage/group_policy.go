@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IGroupPolicy interface {
+	storage.Table[*GroupPolicy]
+
+	ByGroupId(ctx context.Context, groupId uint64, limit, offset int) ([]GroupPolicy, error)
+	ByAddressId(ctx context.Context, addressId uint64) (GroupPolicy, error)
+}
+
+// GroupPolicy - account of group module which executes proposals accepted by group members according to decision policy
+type GroupPolicy struct {
+	bun.BaseModel `bun:"table:group_policy" comment:"Table with group policies."`
+
+	AddressId          uint64                   `bun:"address_id,pk,notnull"                                   comment:"Internal identity of group policy address"`
+	GroupId            uint64                   `bun:"group_id,notnull"                                        comment:"Group identity"`
+	Height             pkgTypes.Level           `bun:"height,notnull"                                          comment:"The number (height) of block when group policy was created"`
+	Time               time.Time                `bun:"time,notnull"                                            comment:"The time of block when group policy was created"`
+	TxId               uint64                   `bun:"tx_id"                                                   comment:"Internal identity of transaction which created group policy"`
+	AdminId            uint64                   `bun:"admin_id"                                                comment:"Internal identity of group policy admin address"`
+	Metadata           string                   `bun:"metadata"                                                comment:"Group policy metadata"`
+	DecisionPolicyType types.DecisionPolicyType `bun:"decision_policy_type,type:decision_policy_type,nullzero" comment:"Type of decision policy"`
+	Threshold          decimal.Decimal          `bun:"threshold,type:numeric"                                  comment:"Minimal weight of yes votes for threshold decision policy or minimal percentage of yes votes for percentage decision policy"`
+	VotingPeriod       int64                    `bun:"voting_period"                                           comment:"Voting period in seconds"`
+	MinExecutionPeriod int64                    `bun:"min_execution_period"                                    comment:"Minimal period in seconds after submission when proposal can be executed"`
+
+	Address *Address `bun:"rel:belongs-to,join:address_id=id"`
+	Admin   *Address `bun:"rel:belongs-to,join:admin_id=id"`
+	Group   *Group   `bun:"rel:belongs-to,join:group_id=id"`
+	Tx      *Tx      `bun:"rel:belongs-to,join:tx_id=id"`
+}
+
+// TableName -
+func (GroupPolicy) TableName() string {
+	return "group_policy"
+}
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+type GroupProposalFilters struct {
+	Limit           int
+	Offset          int
+	Sort            storage.SortOrder
+	GroupId         uint64
+	PolicyAddressId uint64
+	Status          []types.GroupProposalStatus
+}
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IGroupProposal interface {
+	storage.Table[*GroupProposal]
+
+	ListWithFilters(ctx context.Context, filters GroupProposalFilters) ([]GroupProposal, error)
+	ById(ctx context.Context, id uint64) (GroupProposal, error)
+}
+
+// GroupProposal - proposal of group policy
+type GroupProposal struct {
+	bun.BaseModel `bun:"table:group_proposal" comment:"Table with group proposals."`
+
+	Id              uint64                    `bun:"id,pk,notnull"                                       comment:"Proposal identity from the chain"`
+	Height          pkgTypes.Level            `bun:"height,notnull"                                      comment:"The number (height) of block when proposal was submitted"`
+	Time            time.Time                 `bun:"time,notnull"                                        comment:"Submission time"`
+	TxId            uint64                    `bun:"tx_id"                                               comment:"Internal identity of transaction which submitted proposal"`
+	PolicyAddressId uint64                    `bun:"policy_address_id"                                   comment:"Internal identity of group policy address"`
+	Proposers       []string                  `bun:"proposers,array"                                     comment:"Proposer addresses"`
+	Metadata        string                    `bun:"metadata"                                            comment:"Metadata"`
+	Messages        json.RawMessage           `bun:"messages,type:jsonb,nullzero"                        comment:"Messages which will be executed if proposal is accepted"`
+	Status          types.GroupProposalStatus `bun:"status,type:group_proposal_status,nullzero"          comment:"Proposal status"`
+	ExecutorResult  types.GroupExecutorResult `bun:"executor_result,type:group_executor_result,nullzero" comment:"Result of proposal execution"`
+	EndHeight       *pkgTypes.Level           `bun:"end_height"                                          comment:"Height of block when proposal status or execution result was changed last time"`
+	EndTime         *time.Time                `bun:"end_time"                                            comment:"Time when proposal status or execution result was changed last time"`
+	VotesCount      int64                     `bun:"votes_count"                                         comment:"Count of votes"`
+	Yes             int64                     `bun:"yes"                                                 comment:"Count of yes votes"`
+	No              int64                     `bun:"no"                                                  comment:"Count of no votes"`
+	NoWithVeto      int64                     `bun:"no_with_veto"                                        comment:"Count of no with veto votes"`
+	Abstain         int64                     `bun:"abstain"                                             comment:"Count of abstain votes"`
+
+	PolicyAddress *Address `bun:"rel:belongs-to,join:policy_address_id=id"`
+	Tx            *Tx      `bun:"rel:belongs-to,join:tx_id=id"`
+}
+
+// TableName -
+func (GroupProposal) TableName() string {
+	return "group_proposal"
+}
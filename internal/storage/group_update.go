@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+// GroupUpdate - values of group or group policy before its change in the block. It's used to restore them on rollback.
+type GroupUpdate struct {
+	bun.BaseModel `bun:"group_update" comment:"Table with previous values of changed groups and group policies. It's used by rollback."`
+
+	Id              uint64         `bun:"id,pk,notnull,autoincrement" comment:"Unique internal id"`
+	Height          pkgTypes.Level `bun:"height,notnull"              comment:"The number (height) of block when group or group policy was changed"`
+	GroupId         uint64         `bun:"group_id,nullzero"           comment:"Identity of changed group. Empty if group policy was changed"`
+	PolicyAddressId uint64         `bun:"policy_address_id,nullzero"  comment:"Internal identity of changed group policy address. Empty if group was changed"`
+
+	AdminId            uint64                   `bun:"admin_id"                                                comment:"Internal identity of previous admin address"`
+	Metadata           string                   `bun:"metadata"                                                comment:"Previous metadata"`
+	DecisionPolicyType types.DecisionPolicyType `bun:"decision_policy_type,type:decision_policy_type,nullzero" comment:"Previous type of decision policy of group policy"`
+	Threshold          decimal.Decimal          `bun:"threshold,type:numeric"                                  comment:"Previous threshold of decision policy of group policy"`
+	VotingPeriod       int64                    `bun:"voting_period"                                           comment:"Previous voting period in seconds of group policy"`
+	MinExecutionPeriod int64                    `bun:"min_execution_period"                                    comment:"Previous minimal execution period in seconds of group policy"`
+}
+
+// TableName -
+func (GroupUpdate) TableName() string {
+	return "group_update"
+}
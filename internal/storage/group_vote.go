@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IGroupVote interface {
+	storage.Table[*GroupVote]
+
+	ByProposalId(ctx context.Context, proposalId uint64, limit, offset int) ([]GroupVote, error)
+}
+
+// GroupVote - vote of group member for group proposal
+type GroupVote struct {
+	bun.BaseModel `bun:"table:group_vote" comment:"Table with group proposal votes."`
+
+	Id         uint64           `bun:"id,pk,notnull,autoincrement" comment:"Unique internal identity"`
+	Height     pkgTypes.Level   `bun:"height,notnull"              comment:"The number (height) of block when vote was cast"`
+	Time       time.Time        `bun:"time,notnull"                comment:"The time of block when vote was cast"`
+	ProposalId uint64           `bun:"proposal_id,notnull"         comment:"Group proposal identity"`
+	VoterId    uint64           `bun:"voter_id,notnull"            comment:"Internal identity of voter address"`
+	TxId       uint64           `bun:"tx_id"                       comment:"Internal identity of transaction which contains vote"`
+	Option     types.VoteOption `bun:"option,type:vote_option"     comment:"Selected vote option"`
+	Metadata   string           `bun:"metadata"                    comment:"Vote metadata"`
+
+	Voter *Address `bun:"rel:belongs-to,join:voter_id=id"`
+	Tx    *Tx      `bun:"rel:belongs-to,join:tx_id=id"`
+}
+
+// TableName -
+func (GroupVote) TableName() string {
+	return "group_vote"
+}
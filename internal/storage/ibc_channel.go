@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+type ListIbcChannelsFilters struct {
+	Limit        int
+	Offset       int
+	Sort         storage.SortOrder
+	ConnectionId string
+	Status       types.IbcChannelStatus
+}
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IIbcChannel interface {
+	storage.Table[*IbcChannel]
+
+	ById(ctx context.Context, id string) (IbcChannel, error)
+	ListWithFilters(ctx context.Context, filters ListIbcChannelsFilters) ([]IbcChannel, error)
+}
+
+// IbcChannel -
+type IbcChannel struct {
+	bun.BaseModel `bun:"ibc_channel" comment:"Table with IBC channels."`
+
+	Id                    string                 `bun:"id,pk,notnull"                           comment:"Channel identity"`
+	PortId                string                 `bun:"port_id"                                 comment:"Port identity"`
+	CounterpartyPortId    string                 `bun:"counterparty_port_id"                    comment:"Port identity on counterparty chain"`
+	CounterpartyChannelId string                 `bun:"counterparty_channel_id"                 comment:"Channel identity on counterparty chain"`
+	ConnectionId          string                 `bun:"connection_id"                           comment:"Connection identity"`
+	Version               string                 `bun:"version"                                 comment:"Channel version"`
+	Height                pkgTypes.Level         `bun:"height,notnull"                          comment:"The number (height) of block when channel was created"`
+	CreatedAt             time.Time              `bun:"created_at,notnull"                      comment:"Time when channel was created"`
+	ConfirmationHeight    *pkgTypes.Level        `bun:"confirmation_height"                     comment:"The number (height) of block when channel was opened"`
+	ConfirmedAt           *time.Time             `bun:"confirmed_at"                            comment:"Time when channel was opened"`
+	CreateTxId            uint64                 `bun:"create_tx_id"                            comment:"Internal identity of transaction which created channel"`
+	ConfirmationTxId      *uint64                `bun:"confirmation_tx_id"                      comment:"Internal identity of transaction which opened channel"`
+	Status                types.IbcChannelStatus `bun:"status,type:ibc_channel_status,nullzero" comment:"Channel status"`
+
+	Connection *IbcConnection `bun:"rel:belongs-to,join:connection_id=id"`
+}
+
+// TableName -
+func (IbcChannel) TableName() string {
+	return "ibc_channel"
+}
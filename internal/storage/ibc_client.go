@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+type ListIbcClientsFilters struct {
+	Limit   int
+	Offset  int
+	Sort    storage.SortOrder
+	ChainId string
+}
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IIbcClient interface {
+	storage.Table[*IbcClient]
+
+	ById(ctx context.Context, id string) (IbcClient, error)
+	ListWithFilters(ctx context.Context, filters ListIbcClientsFilters) ([]IbcClient, error)
+}
+
+// IbcClient -
+type IbcClient struct {
+	bun.BaseModel `bun:"ibc_client" comment:"Table with IBC clients."`
+
+	Id        string         `bun:"id,pk,notnull"      comment:"Client identity"`
+	Type      string         `bun:"type"               comment:"Client type"`
+	ChainId   string         `bun:"chain_id"           comment:"Chain identity of counterparty"`
+	Height    pkgTypes.Level `bun:"height,notnull"     comment:"The number (height) of block when client was created"`
+	CreatedAt time.Time      `bun:"created_at,notnull" comment:"Time when client was created"`
+	TxId      uint64         `bun:"tx_id"              comment:"Internal identity of transaction which created client"`
+	CreatorId uint64         `bun:"creator_id"         comment:"Internal identity of creator address"`
+
+	Creator *Address `bun:"rel:belongs-to,join:creator_id=id"`
+}
+
+// TableName -
+func (IbcClient) TableName() string {
+	return "ibc_client"
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+type ListIbcConnectionsFilters struct {
+	Limit    int
+	Offset   int
+	Sort     storage.SortOrder
+	ClientId string
+}
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IIbcConnection interface {
+	storage.Table[*IbcConnection]
+
+	ById(ctx context.Context, id string) (IbcConnection, error)
+	ListWithFilters(ctx context.Context, filters ListIbcConnectionsFilters) ([]IbcConnection, error)
+}
+
+// IbcConnection -
+type IbcConnection struct {
+	bun.BaseModel `bun:"ibc_connection" comment:"Table with IBC connections."`
+
+	Id                       string          `bun:"id,pk,notnull"              comment:"Connection identity"`
+	ClientId                 string          `bun:"client_id"                  comment:"Client identity"`
+	CounterpartyConnectionId string          `bun:"counterparty_connection_id" comment:"Connection identity on counterparty chain"`
+	CounterpartyClientId     string          `bun:"counterparty_client_id"     comment:"Client identity on counterparty chain"`
+	Height                   pkgTypes.Level  `bun:"height,notnull"             comment:"The number (height) of block when connection was created"`
+	CreatedAt                time.Time       `bun:"created_at,notnull"         comment:"Time when connection was created"`
+	ConnectionHeight         *pkgTypes.Level `bun:"connection_height"          comment:"The number (height) of block when connection was opened"`
+	ConnectedAt              *time.Time      `bun:"connected_at"               comment:"Time when connection was opened"`
+	CreateTxId               uint64          `bun:"create_tx_id"               comment:"Internal identity of transaction which created connection"`
+	ConnectionTxId           *uint64         `bun:"connection_tx_id"           comment:"Internal identity of transaction which opened connection"`
+
+	Client *IbcClient `bun:"rel:belongs-to,join:client_id=id"`
+}
+
+// TableName -
+func (IbcConnection) TableName() string {
+	return "ibc_connection"
+}
+
+// Opened - returns true if connection handshake is completed
+func (c IbcConnection) Opened() bool {
+	return c.ConnectionHeight != nil
+}
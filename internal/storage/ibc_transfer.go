@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+type ListIbcTransferFilters struct {
+	Limit     int
+	Offset    int
+	Sort      storage.SortOrder
+	ChannelId string
+	ChainId   string
+	AddressId *uint64
+	Status    []types.IbcTransferStatus
+}
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IIbcTransfer interface {
+	storage.Table[*IbcTransfer]
+
+	ById(ctx context.Context, id uint64) (IbcTransfer, error)
+	ListWithFilters(ctx context.Context, filters ListIbcTransferFilters) ([]IbcTransfer, error)
+	ChainStats(ctx context.Context, limit, offset int) ([]IbcChainStats, error)
+}
+
+// IbcTransfer -
+type IbcTransfer struct {
+	bun.BaseModel `bun:"ibc_transfer" comment:"Table with IBC fungible token transfers."`
+
+	Id                    uint64                  `bun:"id,pk,notnull,autoincrement"     comment:"Unique internal identity"`
+	Height                pkgTypes.Level          `bun:"height,notnull"                  comment:"The number (height) of block when transfer was sent or received"`
+	Time                  time.Time               `bun:"time,notnull"                    comment:"The time of block"`
+	Timeout               *time.Time              `bun:"timeout"                         comment:"Packet timeout timestamp"`
+	ChannelId             string                  `bun:"channel_id"                      comment:"Channel identity on Celestia"`
+	Port                  string                  `bun:"port"                            comment:"Port on Celestia"`
+	CounterpartyChannelId string                  `bun:"counterparty_channel_id"         comment:"Channel identity on counterparty chain"`
+	CounterpartyPort      string                  `bun:"counterparty_port"               comment:"Port on counterparty chain"`
+	ConnectionId          string                  `bun:"connection_id"                   comment:"Connection identity on Celestia"`
+	Sequence              uint64                  `bun:"sequence"                        comment:"Packet sequence"`
+	Amount                decimal.Decimal         `bun:"amount,type:numeric"             comment:"Transferred amount"`
+	Denom                 string                  `bun:"denom"                           comment:"Denomination from packet data"`
+	Memo                  string                  `bun:"memo"                            comment:"Transfer memo"`
+	Sender                string                  `bun:"sender"                          comment:"Sender address"`
+	Receiver              string                  `bun:"receiver"                        comment:"Receiver address"`
+	SenderId              *uint64                 `bun:"sender_id"                       comment:"Internal identity of sender address if it is Celestia address"`
+	ReceiverId            *uint64                 `bun:"receiver_id"                     comment:"Internal identity of receiver address if it is Celestia address"`
+	TxId                  uint64                  `bun:"tx_id"                           comment:"Internal identity of transaction"`
+	Status                types.IbcTransferStatus `bun:"status,type:ibc_transfer_status" comment:"Transfer status"`
+	CompletedHeight       *pkgTypes.Level         `bun:"completed_height"                comment:"The number (height) of block when transfer was acknowledged or timed out"`
+	CompletedAt           *time.Time              `bun:"completed_at"                    comment:"Time when transfer was acknowledged or timed out"`
+
+	Tx         *Tx            `bun:"rel:belongs-to,join:tx_id=id"`
+	Connection *IbcConnection `bun:"rel:belongs-to,join:connection_id=id"`
+}
+
+// TableName -
+func (IbcTransfer) TableName() string {
+	return "ibc_transfer"
+}
+
+// String - returns packet key which is unique for outgoing transfers
+func (t IbcTransfer) String() string {
+	return fmt.Sprintf("%s/%s/%d", t.Port, t.ChannelId, t.Sequence)
+}
+
+// IsCompletion - returns true if entity is an acknowledgement or a timeout of the sent packet
+func (t IbcTransfer) IsCompletion() bool {
+	return t.Status == types.IbcTransferStatusAcknowledged || t.Status == types.IbcTransferStatusTimeout
+}
+
+type IbcChainStats struct {
+	ChainId       string          `bun:"chain_id"`
+	SentCount     int64           `bun:"sent_count"`
+	ReceivedCount int64           `bun:"received_count"`
+	Sent          decimal.Decimal `bun:"sent"`
+	Received      decimal.Decimal `bun:"received"`
+}
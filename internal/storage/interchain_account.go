@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+type ListInterchainAccountPacketsFilters struct {
+	Limit        int
+	Offset       int
+	Sort         storage.SortOrder
+	ConnectionId string
+	Owner        string
+}
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IInterchainAccount interface {
+	storage.Table[*InterchainAccount]
+
+	ByAddressId(ctx context.Context, addressId uint64) (InterchainAccount, error)
+	Packets(ctx context.Context, filters ListInterchainAccountPacketsFilters) ([]InterchainAccountPacket, error)
+}
+
+// InterchainAccount -
+type InterchainAccount struct {
+	bun.BaseModel `bun:"interchain_account" comment:"Table with interchain accounts hosted on Celestia."`
+
+	Id                     uint64         `bun:"id,pk,notnull,autoincrement"                       comment:"Unique internal identity"`
+	Address                string         `bun:"address"                                           comment:"Interchain account address"`
+	AddressId              uint64         `bun:"address_id"                                        comment:"Internal identity of interchain account address"`
+	Owner                  string         `bun:"owner,unique:interchain_account_owner_idx"         comment:"Owner address on controller chain"`
+	ConnectionId           string         `bun:"connection_id,unique:interchain_account_owner_idx" comment:"Connection identity on Celestia"`
+	ControllerConnectionId string         `bun:"controller_connection_id"                          comment:"Connection identity on controller chain"`
+	ChannelId              string         `bun:"channel_id"                                        comment:"Channel identity on Celestia"`
+	CounterpartyChannelId  string         `bun:"counterparty_channel_id"                           comment:"Channel identity on controller chain"`
+	Height                 pkgTypes.Level `bun:"height,notnull"                                    comment:"The number (height) of block when account was registered"`
+	CreatedAt              time.Time      `bun:"created_at,notnull"                                comment:"Time when account was registered"`
+	TxId                   uint64         `bun:"tx_id"                                             comment:"Internal identity of transaction which registered account"`
+
+	Connection *IbcConnection `bun:"rel:belongs-to,join:connection_id=id"`
+}
+
+// TableName -
+func (InterchainAccount) TableName() string {
+	return "interchain_account"
+}
+
+// InterchainAccountPacket -
+type InterchainAccountPacket struct {
+	bun.BaseModel `bun:"interchain_account_packet" comment:"Table with packets executed by interchain accounts."`
+
+	Id           uint64         `bun:"id,pk,notnull,autoincrement" comment:"Unique internal identity"`
+	Height       pkgTypes.Level `bun:"height,notnull"              comment:"The number (height) of block when packet was received"`
+	Time         time.Time      `bun:"time,notnull"                comment:"The time of block"`
+	ConnectionId string         `bun:"connection_id"               comment:"Connection identity on Celestia"`
+	ChannelId    string         `bun:"channel_id"                  comment:"Channel identity on Celestia"`
+	Owner        string         `bun:"owner"                       comment:"Owner address on controller chain"`
+	Sequence     uint64         `bun:"sequence"                    comment:"Packet sequence"`
+	Memo         string         `bun:"memo"                        comment:"Packet memo"`
+	Messages     []string       `bun:"messages,array"              comment:"Type URLs of executed messages"`
+	Success      bool           `bun:"success"                     comment:"Execution result"`
+	Error        string         `bun:"error"                       comment:"Error of failed execution"`
+	TxId         uint64         `bun:"tx_id"                       comment:"Internal identity of transaction"`
+
+	Tx *Tx `bun:"rel:belongs-to,join:tx_id=id"`
+}
+
+// TableName -
+func (InterchainAccountPacket) TableName() string {
+	return "interchain_account_packet"
+}
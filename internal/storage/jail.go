@@ -32,6 +32,7 @@ type Jail struct {
 	Burned      decimal.Decimal `bun:"burned,type:numeric"         comment:"Burned coins"`
 
 	Validator *Validator `bun:"rel:belongs-to,join:validator_id=id"`
+	Slash     *Slash     `bun:"rel:has-one,join:id=jail_id"`
 }
 
 // TableName -
@@ -49,6 +49,7 @@ type IMessage interface {
 	storage.Table[*Message]
 
 	ByTxId(ctx context.Context, txId uint64, limit, offset int) ([]Message, error)
+	InternalByTxId(ctx context.Context, txId uint64) ([]Message, error)
 	ListWithTx(ctx context.Context, filters MessageListWithTxFilters) ([]MessageWithTx, error)
 	ByAddress(ctx context.Context, id uint64, filters AddressMsgsFilter) ([]AddressMessageWithTx, error)
 }
@@ -63,15 +64,27 @@ type Message struct {
 	Position int64             `bun:"position"                    comment:"Position in transaction"`
 	Type     types.MsgType     `bun:",type:msg_type"              comment:"Message type"                      stats:"filterable"`
 	TxId     uint64            `bun:"tx_id"                       comment:"Parent transaction id"`
+	ParentId *uint64           `bun:"parent_id"                   comment:"Parent message id for messages executed inside MsgExec"`
 	Size     int               `bun:"size"                        comment:"Message size in bytes"`
 	Data     types.PackedBytes `bun:"data,type:bytea,nullzero"    comment:"Message data"`
 
-	Namespace      []Namespace       `bun:"m2m:namespace_message,join:Message=Namespace"`
-	Addresses      []AddressWithType `bun:"-"`
-	BlobLogs       []*BlobLog        `bun:"-"`
-	Grants         []Grant           `bun:"-"`
-	InternalMsgs   []string          `bun:"-"` // field for parsing MsgExec internal messages
-	VestingAccount *VestingAccount   `bun:"-"` // internal field
+	Namespace               []Namespace              `bun:"m2m:namespace_message,join:Message=Namespace"`
+	Addresses               []AddressWithType        `bun:"-"`
+	BlobLogs                []*BlobLog               `bun:"-"`
+	Grants                  []Grant                  `bun:"-"`
+	InternalMsgs            []*Message               `bun:"-"` // field for parsing MsgExec internal messages
+	VestingAccount          *VestingAccount          `bun:"-"` // internal field
+	Proposal                *Proposal                `bun:"-"` // internal field
+	IbcClient               *IbcClient               `bun:"-"` // internal field
+	IbcConnection           *IbcConnection           `bun:"-"` // internal field
+	IbcChannel              *IbcChannel              `bun:"-"` // internal field
+	IbcTransfer             *IbcTransfer             `bun:"-"` // internal field
+	InterchainAccount       *InterchainAccount       `bun:"-"` // internal field
+	InterchainAccountPacket *InterchainAccountPacket `bun:"-"` // internal field
+	EvmAddress              *BlobstreamEvmAddress    `bun:"-"` // internal field
+	Group                   *Group                   `bun:"-"` // internal field
+	GroupPolicy             *GroupPolicy             `bun:"-"` // internal field
+	GroupProposal           *GroupProposal           `bun:"-"` // internal field
 }
 
 // TableName -
@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IMissedBlock interface {
+	storage.Table[*MissedBlock]
+
+	CountByValidator(ctx context.Context, validatorId uint64, fromHeight types.Level) (int64, error)
+	Series(ctx context.Context, validatorId uint64, timeframe Timeframe, req SeriesRequest) ([]HistogramItem, error)
+}
+
+// MissedBlock - block which was not signed by active validator
+type MissedBlock struct {
+	bun.BaseModel `bun:"missed_block" comment:"Table with blocks missed by validators"`
+
+	Id          uint64      `bun:"id,pk,notnull,autoincrement" comment:"Unique internal id"`
+	Height      types.Level `bun:"height,notnull"              comment:"The number (height) of missed block"`
+	Time        time.Time   `bun:"time,pk,notnull"             comment:"The time of block which contains commit of missed block"`
+	ValidatorId uint64      `bun:"validator_id,notnull"        comment:"Validator's internal identity"`
+
+	Validator *Validator `bun:"rel:belongs-to"`
+}
+
+func (MissedBlock) TableName() string {
+	return "missed_block"
+}
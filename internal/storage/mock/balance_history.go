@@ -0,0 +1,353 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: balance_history.go
+//
+// Generated by this command:
+//
+//	mockgen -source=balance_history.go -destination=mock/balance_history.go -package=mock -typed
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/celestia-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIBalanceHistory is a mock of IBalanceHistory interface.
+type MockIBalanceHistory struct {
+	ctrl     *gomock.Controller
+	recorder *MockIBalanceHistoryMockRecorder
+}
+
+// MockIBalanceHistoryMockRecorder is the mock recorder for MockIBalanceHistory.
+type MockIBalanceHistoryMockRecorder struct {
+	mock *MockIBalanceHistory
+}
+
+// NewMockIBalanceHistory creates a new mock instance.
+func NewMockIBalanceHistory(ctrl *gomock.Controller) *MockIBalanceHistory {
+	mock := &MockIBalanceHistory{ctrl: ctrl}
+	mock.recorder = &MockIBalanceHistoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIBalanceHistory) EXPECT() *MockIBalanceHistoryMockRecorder {
+	return m.recorder
+}
+
+// ByAddress mocks base method.
+func (m *MockIBalanceHistory) ByAddress(ctx context.Context, addressId uint64, fltrs storage.BalanceHistoryFilters) ([]storage.BalanceHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByAddress", ctx, addressId, fltrs)
+	ret0, _ := ret[0].([]storage.BalanceHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByAddress indicates an expected call of ByAddress.
+func (mr *MockIBalanceHistoryMockRecorder) ByAddress(ctx, addressId, fltrs any) *IBalanceHistoryByAddressCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByAddress", reflect.TypeOf((*MockIBalanceHistory)(nil).ByAddress), ctx, addressId, fltrs)
+	return &IBalanceHistoryByAddressCall{Call: call}
+}
+
+// IBalanceHistoryByAddressCall wrap *gomock.Call
+type IBalanceHistoryByAddressCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBalanceHistoryByAddressCall) Return(arg0 []storage.BalanceHistory, arg1 error) *IBalanceHistoryByAddressCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBalanceHistoryByAddressCall) Do(f func(context.Context, uint64, storage.BalanceHistoryFilters) ([]storage.BalanceHistory, error)) *IBalanceHistoryByAddressCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBalanceHistoryByAddressCall) DoAndReturn(f func(context.Context, uint64, storage.BalanceHistoryFilters) ([]storage.BalanceHistory, error)) *IBalanceHistoryByAddressCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIBalanceHistory) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.BalanceHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.BalanceHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIBalanceHistoryMockRecorder) CursorList(ctx, id, limit, order, cmp any) *IBalanceHistoryCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIBalanceHistory)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &IBalanceHistoryCursorListCall{Call: call}
+}
+
+// IBalanceHistoryCursorListCall wrap *gomock.Call
+type IBalanceHistoryCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBalanceHistoryCursorListCall) Return(arg0 []*storage.BalanceHistory, arg1 error) *IBalanceHistoryCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBalanceHistoryCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.BalanceHistory, error)) *IBalanceHistoryCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBalanceHistoryCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.BalanceHistory, error)) *IBalanceHistoryCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIBalanceHistory) GetByID(ctx context.Context, id uint64) (*storage.BalanceHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.BalanceHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIBalanceHistoryMockRecorder) GetByID(ctx, id any) *IBalanceHistoryGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIBalanceHistory)(nil).GetByID), ctx, id)
+	return &IBalanceHistoryGetByIDCall{Call: call}
+}
+
+// IBalanceHistoryGetByIDCall wrap *gomock.Call
+type IBalanceHistoryGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBalanceHistoryGetByIDCall) Return(arg0 *storage.BalanceHistory, arg1 error) *IBalanceHistoryGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBalanceHistoryGetByIDCall) Do(f func(context.Context, uint64) (*storage.BalanceHistory, error)) *IBalanceHistoryGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBalanceHistoryGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.BalanceHistory, error)) *IBalanceHistoryGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIBalanceHistory) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIBalanceHistoryMockRecorder) IsNoRows(err any) *IBalanceHistoryIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIBalanceHistory)(nil).IsNoRows), err)
+	return &IBalanceHistoryIsNoRowsCall{Call: call}
+}
+
+// IBalanceHistoryIsNoRowsCall wrap *gomock.Call
+type IBalanceHistoryIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBalanceHistoryIsNoRowsCall) Return(arg0 bool) *IBalanceHistoryIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBalanceHistoryIsNoRowsCall) Do(f func(error) bool) *IBalanceHistoryIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBalanceHistoryIsNoRowsCall) DoAndReturn(f func(error) bool) *IBalanceHistoryIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIBalanceHistory) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIBalanceHistoryMockRecorder) LastID(ctx any) *IBalanceHistoryLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIBalanceHistory)(nil).LastID), ctx)
+	return &IBalanceHistoryLastIDCall{Call: call}
+}
+
+// IBalanceHistoryLastIDCall wrap *gomock.Call
+type IBalanceHistoryLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBalanceHistoryLastIDCall) Return(arg0 uint64, arg1 error) *IBalanceHistoryLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBalanceHistoryLastIDCall) Do(f func(context.Context) (uint64, error)) *IBalanceHistoryLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBalanceHistoryLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *IBalanceHistoryLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIBalanceHistory) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.BalanceHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.BalanceHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIBalanceHistoryMockRecorder) List(ctx, limit, offset, order any) *IBalanceHistoryListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIBalanceHistory)(nil).List), ctx, limit, offset, order)
+	return &IBalanceHistoryListCall{Call: call}
+}
+
+// IBalanceHistoryListCall wrap *gomock.Call
+type IBalanceHistoryListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBalanceHistoryListCall) Return(arg0 []*storage.BalanceHistory, arg1 error) *IBalanceHistoryListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBalanceHistoryListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.BalanceHistory, error)) *IBalanceHistoryListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBalanceHistoryListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.BalanceHistory, error)) *IBalanceHistoryListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIBalanceHistory) Save(ctx context.Context, m *storage.BalanceHistory) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIBalanceHistoryMockRecorder) Save(ctx, m any) *IBalanceHistorySaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIBalanceHistory)(nil).Save), ctx, m)
+	return &IBalanceHistorySaveCall{Call: call}
+}
+
+// IBalanceHistorySaveCall wrap *gomock.Call
+type IBalanceHistorySaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBalanceHistorySaveCall) Return(arg0 error) *IBalanceHistorySaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBalanceHistorySaveCall) Do(f func(context.Context, *storage.BalanceHistory) error) *IBalanceHistorySaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBalanceHistorySaveCall) DoAndReturn(f func(context.Context, *storage.BalanceHistory) error) *IBalanceHistorySaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIBalanceHistory) Update(ctx context.Context, m *storage.BalanceHistory) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIBalanceHistoryMockRecorder) Update(ctx, m any) *IBalanceHistoryUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIBalanceHistory)(nil).Update), ctx, m)
+	return &IBalanceHistoryUpdateCall{Call: call}
+}
+
+// IBalanceHistoryUpdateCall wrap *gomock.Call
+type IBalanceHistoryUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBalanceHistoryUpdateCall) Return(arg0 error) *IBalanceHistoryUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBalanceHistoryUpdateCall) Do(f func(context.Context, *storage.BalanceHistory) error) *IBalanceHistoryUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBalanceHistoryUpdateCall) DoAndReturn(f func(context.Context, *storage.BalanceHistory) error) *IBalanceHistoryUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
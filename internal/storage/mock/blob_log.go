@@ -628,6 +628,45 @@ func (c *IBlobLogSaveCall) DoAndReturn(f func(context.Context, *storage.BlobLog)
 	return c
 }
 
+// SharesByHeight mocks base method.
+func (m *MockIBlobLog) SharesByHeight(ctx context.Context, height types.Level) ([]storage.BlobLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SharesByHeight", ctx, height)
+	ret0, _ := ret[0].([]storage.BlobLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SharesByHeight indicates an expected call of SharesByHeight.
+func (mr *MockIBlobLogMockRecorder) SharesByHeight(ctx, height any) *IBlobLogSharesByHeightCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SharesByHeight", reflect.TypeOf((*MockIBlobLog)(nil).SharesByHeight), ctx, height)
+	return &IBlobLogSharesByHeightCall{Call: call}
+}
+
+// IBlobLogSharesByHeightCall wrap *gomock.Call
+type IBlobLogSharesByHeightCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBlobLogSharesByHeightCall) Return(arg0 []storage.BlobLog, arg1 error) *IBlobLogSharesByHeightCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBlobLogSharesByHeightCall) Do(f func(context.Context, types.Level) ([]storage.BlobLog, error)) *IBlobLogSharesByHeightCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBlobLogSharesByHeightCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.BlobLog, error)) *IBlobLogSharesByHeightCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Update mocks base method.
 func (m_2 *MockIBlobLog) Update(ctx context.Context, m *storage.BlobLog) error {
 	m_2.ctrl.T.Helper()
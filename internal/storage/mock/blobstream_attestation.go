@@ -0,0 +1,432 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: blobstream_attestation.go
+//
+// Generated by this command:
+//
+//	mockgen -source=blobstream_attestation.go -destination=mock/blobstream_attestation.go -package=mock -typed
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/celestia-indexer/internal/storage"
+	types "github.com/celenium-io/celestia-indexer/pkg/types"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIBlobstreamAttestation is a mock of IBlobstreamAttestation interface.
+type MockIBlobstreamAttestation struct {
+	ctrl     *gomock.Controller
+	recorder *MockIBlobstreamAttestationMockRecorder
+}
+
+// MockIBlobstreamAttestationMockRecorder is the mock recorder for MockIBlobstreamAttestation.
+type MockIBlobstreamAttestationMockRecorder struct {
+	mock *MockIBlobstreamAttestation
+}
+
+// NewMockIBlobstreamAttestation creates a new mock instance.
+func NewMockIBlobstreamAttestation(ctrl *gomock.Controller) *MockIBlobstreamAttestation {
+	mock := &MockIBlobstreamAttestation{ctrl: ctrl}
+	mock.recorder = &MockIBlobstreamAttestationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIBlobstreamAttestation) EXPECT() *MockIBlobstreamAttestationMockRecorder {
+	return m.recorder
+}
+
+// ByDataHeight mocks base method.
+func (m *MockIBlobstreamAttestation) ByDataHeight(ctx context.Context, height types.Level) (storage.BlobstreamAttestation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByDataHeight", ctx, height)
+	ret0, _ := ret[0].(storage.BlobstreamAttestation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByDataHeight indicates an expected call of ByDataHeight.
+func (mr *MockIBlobstreamAttestationMockRecorder) ByDataHeight(ctx, height any) *IBlobstreamAttestationByDataHeightCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByDataHeight", reflect.TypeOf((*MockIBlobstreamAttestation)(nil).ByDataHeight), ctx, height)
+	return &IBlobstreamAttestationByDataHeightCall{Call: call}
+}
+
+// IBlobstreamAttestationByDataHeightCall wrap *gomock.Call
+type IBlobstreamAttestationByDataHeightCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBlobstreamAttestationByDataHeightCall) Return(arg0 storage.BlobstreamAttestation, arg1 error) *IBlobstreamAttestationByDataHeightCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBlobstreamAttestationByDataHeightCall) Do(f func(context.Context, types.Level) (storage.BlobstreamAttestation, error)) *IBlobstreamAttestationByDataHeightCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBlobstreamAttestationByDataHeightCall) DoAndReturn(f func(context.Context, types.Level) (storage.BlobstreamAttestation, error)) *IBlobstreamAttestationByDataHeightCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ByNonce mocks base method.
+func (m *MockIBlobstreamAttestation) ByNonce(ctx context.Context, nonce uint64) (storage.BlobstreamAttestation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByNonce", ctx, nonce)
+	ret0, _ := ret[0].(storage.BlobstreamAttestation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByNonce indicates an expected call of ByNonce.
+func (mr *MockIBlobstreamAttestationMockRecorder) ByNonce(ctx, nonce any) *IBlobstreamAttestationByNonceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByNonce", reflect.TypeOf((*MockIBlobstreamAttestation)(nil).ByNonce), ctx, nonce)
+	return &IBlobstreamAttestationByNonceCall{Call: call}
+}
+
+// IBlobstreamAttestationByNonceCall wrap *gomock.Call
+type IBlobstreamAttestationByNonceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBlobstreamAttestationByNonceCall) Return(arg0 storage.BlobstreamAttestation, arg1 error) *IBlobstreamAttestationByNonceCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBlobstreamAttestationByNonceCall) Do(f func(context.Context, uint64) (storage.BlobstreamAttestation, error)) *IBlobstreamAttestationByNonceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBlobstreamAttestationByNonceCall) DoAndReturn(f func(context.Context, uint64) (storage.BlobstreamAttestation, error)) *IBlobstreamAttestationByNonceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIBlobstreamAttestation) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.BlobstreamAttestation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.BlobstreamAttestation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIBlobstreamAttestationMockRecorder) CursorList(ctx, id, limit, order, cmp any) *IBlobstreamAttestationCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIBlobstreamAttestation)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &IBlobstreamAttestationCursorListCall{Call: call}
+}
+
+// IBlobstreamAttestationCursorListCall wrap *gomock.Call
+type IBlobstreamAttestationCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBlobstreamAttestationCursorListCall) Return(arg0 []*storage.BlobstreamAttestation, arg1 error) *IBlobstreamAttestationCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBlobstreamAttestationCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.BlobstreamAttestation, error)) *IBlobstreamAttestationCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBlobstreamAttestationCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.BlobstreamAttestation, error)) *IBlobstreamAttestationCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIBlobstreamAttestation) GetByID(ctx context.Context, id uint64) (*storage.BlobstreamAttestation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.BlobstreamAttestation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIBlobstreamAttestationMockRecorder) GetByID(ctx, id any) *IBlobstreamAttestationGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIBlobstreamAttestation)(nil).GetByID), ctx, id)
+	return &IBlobstreamAttestationGetByIDCall{Call: call}
+}
+
+// IBlobstreamAttestationGetByIDCall wrap *gomock.Call
+type IBlobstreamAttestationGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBlobstreamAttestationGetByIDCall) Return(arg0 *storage.BlobstreamAttestation, arg1 error) *IBlobstreamAttestationGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBlobstreamAttestationGetByIDCall) Do(f func(context.Context, uint64) (*storage.BlobstreamAttestation, error)) *IBlobstreamAttestationGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBlobstreamAttestationGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.BlobstreamAttestation, error)) *IBlobstreamAttestationGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIBlobstreamAttestation) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIBlobstreamAttestationMockRecorder) IsNoRows(err any) *IBlobstreamAttestationIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIBlobstreamAttestation)(nil).IsNoRows), err)
+	return &IBlobstreamAttestationIsNoRowsCall{Call: call}
+}
+
+// IBlobstreamAttestationIsNoRowsCall wrap *gomock.Call
+type IBlobstreamAttestationIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBlobstreamAttestationIsNoRowsCall) Return(arg0 bool) *IBlobstreamAttestationIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBlobstreamAttestationIsNoRowsCall) Do(f func(error) bool) *IBlobstreamAttestationIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBlobstreamAttestationIsNoRowsCall) DoAndReturn(f func(error) bool) *IBlobstreamAttestationIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIBlobstreamAttestation) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIBlobstreamAttestationMockRecorder) LastID(ctx any) *IBlobstreamAttestationLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIBlobstreamAttestation)(nil).LastID), ctx)
+	return &IBlobstreamAttestationLastIDCall{Call: call}
+}
+
+// IBlobstreamAttestationLastIDCall wrap *gomock.Call
+type IBlobstreamAttestationLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBlobstreamAttestationLastIDCall) Return(arg0 uint64, arg1 error) *IBlobstreamAttestationLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBlobstreamAttestationLastIDCall) Do(f func(context.Context) (uint64, error)) *IBlobstreamAttestationLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBlobstreamAttestationLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *IBlobstreamAttestationLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIBlobstreamAttestation) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.BlobstreamAttestation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.BlobstreamAttestation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIBlobstreamAttestationMockRecorder) List(ctx, limit, offset, order any) *IBlobstreamAttestationListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIBlobstreamAttestation)(nil).List), ctx, limit, offset, order)
+	return &IBlobstreamAttestationListCall{Call: call}
+}
+
+// IBlobstreamAttestationListCall wrap *gomock.Call
+type IBlobstreamAttestationListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBlobstreamAttestationListCall) Return(arg0 []*storage.BlobstreamAttestation, arg1 error) *IBlobstreamAttestationListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBlobstreamAttestationListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.BlobstreamAttestation, error)) *IBlobstreamAttestationListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBlobstreamAttestationListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.BlobstreamAttestation, error)) *IBlobstreamAttestationListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListWithFilters mocks base method.
+func (m *MockIBlobstreamAttestation) ListWithFilters(ctx context.Context, fltrs storage.BlobstreamAttestationFilters) ([]storage.BlobstreamAttestation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWithFilters", ctx, fltrs)
+	ret0, _ := ret[0].([]storage.BlobstreamAttestation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWithFilters indicates an expected call of ListWithFilters.
+func (mr *MockIBlobstreamAttestationMockRecorder) ListWithFilters(ctx, fltrs any) *IBlobstreamAttestationListWithFiltersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithFilters", reflect.TypeOf((*MockIBlobstreamAttestation)(nil).ListWithFilters), ctx, fltrs)
+	return &IBlobstreamAttestationListWithFiltersCall{Call: call}
+}
+
+// IBlobstreamAttestationListWithFiltersCall wrap *gomock.Call
+type IBlobstreamAttestationListWithFiltersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBlobstreamAttestationListWithFiltersCall) Return(arg0 []storage.BlobstreamAttestation, arg1 error) *IBlobstreamAttestationListWithFiltersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBlobstreamAttestationListWithFiltersCall) Do(f func(context.Context, storage.BlobstreamAttestationFilters) ([]storage.BlobstreamAttestation, error)) *IBlobstreamAttestationListWithFiltersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBlobstreamAttestationListWithFiltersCall) DoAndReturn(f func(context.Context, storage.BlobstreamAttestationFilters) ([]storage.BlobstreamAttestation, error)) *IBlobstreamAttestationListWithFiltersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIBlobstreamAttestation) Save(ctx context.Context, m *storage.BlobstreamAttestation) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIBlobstreamAttestationMockRecorder) Save(ctx, m any) *IBlobstreamAttestationSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIBlobstreamAttestation)(nil).Save), ctx, m)
+	return &IBlobstreamAttestationSaveCall{Call: call}
+}
+
+// IBlobstreamAttestationSaveCall wrap *gomock.Call
+type IBlobstreamAttestationSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBlobstreamAttestationSaveCall) Return(arg0 error) *IBlobstreamAttestationSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBlobstreamAttestationSaveCall) Do(f func(context.Context, *storage.BlobstreamAttestation) error) *IBlobstreamAttestationSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBlobstreamAttestationSaveCall) DoAndReturn(f func(context.Context, *storage.BlobstreamAttestation) error) *IBlobstreamAttestationSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIBlobstreamAttestation) Update(ctx context.Context, m *storage.BlobstreamAttestation) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIBlobstreamAttestationMockRecorder) Update(ctx, m any) *IBlobstreamAttestationUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIBlobstreamAttestation)(nil).Update), ctx, m)
+	return &IBlobstreamAttestationUpdateCall{Call: call}
+}
+
+// IBlobstreamAttestationUpdateCall wrap *gomock.Call
+type IBlobstreamAttestationUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBlobstreamAttestationUpdateCall) Return(arg0 error) *IBlobstreamAttestationUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBlobstreamAttestationUpdateCall) Do(f func(context.Context, *storage.BlobstreamAttestation) error) *IBlobstreamAttestationUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBlobstreamAttestationUpdateCall) DoAndReturn(f func(context.Context, *storage.BlobstreamAttestation) error) *IBlobstreamAttestationUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
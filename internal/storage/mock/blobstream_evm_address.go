@@ -0,0 +1,353 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: blobstream_evm_address.go
+//
+// Generated by this command:
+//
+//	mockgen -source=blobstream_evm_address.go -destination=mock/blobstream_evm_address.go -package=mock -typed
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/celestia-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIBlobstreamEvmAddress is a mock of IBlobstreamEvmAddress interface.
+type MockIBlobstreamEvmAddress struct {
+	ctrl     *gomock.Controller
+	recorder *MockIBlobstreamEvmAddressMockRecorder
+}
+
+// MockIBlobstreamEvmAddressMockRecorder is the mock recorder for MockIBlobstreamEvmAddress.
+type MockIBlobstreamEvmAddressMockRecorder struct {
+	mock *MockIBlobstreamEvmAddress
+}
+
+// NewMockIBlobstreamEvmAddress creates a new mock instance.
+func NewMockIBlobstreamEvmAddress(ctrl *gomock.Controller) *MockIBlobstreamEvmAddress {
+	mock := &MockIBlobstreamEvmAddress{ctrl: ctrl}
+	mock.recorder = &MockIBlobstreamEvmAddressMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIBlobstreamEvmAddress) EXPECT() *MockIBlobstreamEvmAddressMockRecorder {
+	return m.recorder
+}
+
+// CursorList mocks base method.
+func (m *MockIBlobstreamEvmAddress) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.BlobstreamEvmAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.BlobstreamEvmAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIBlobstreamEvmAddressMockRecorder) CursorList(ctx, id, limit, order, cmp any) *IBlobstreamEvmAddressCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIBlobstreamEvmAddress)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &IBlobstreamEvmAddressCursorListCall{Call: call}
+}
+
+// IBlobstreamEvmAddressCursorListCall wrap *gomock.Call
+type IBlobstreamEvmAddressCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBlobstreamEvmAddressCursorListCall) Return(arg0 []*storage.BlobstreamEvmAddress, arg1 error) *IBlobstreamEvmAddressCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBlobstreamEvmAddressCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.BlobstreamEvmAddress, error)) *IBlobstreamEvmAddressCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBlobstreamEvmAddressCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.BlobstreamEvmAddress, error)) *IBlobstreamEvmAddressCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIBlobstreamEvmAddress) GetByID(ctx context.Context, id uint64) (*storage.BlobstreamEvmAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.BlobstreamEvmAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIBlobstreamEvmAddressMockRecorder) GetByID(ctx, id any) *IBlobstreamEvmAddressGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIBlobstreamEvmAddress)(nil).GetByID), ctx, id)
+	return &IBlobstreamEvmAddressGetByIDCall{Call: call}
+}
+
+// IBlobstreamEvmAddressGetByIDCall wrap *gomock.Call
+type IBlobstreamEvmAddressGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBlobstreamEvmAddressGetByIDCall) Return(arg0 *storage.BlobstreamEvmAddress, arg1 error) *IBlobstreamEvmAddressGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBlobstreamEvmAddressGetByIDCall) Do(f func(context.Context, uint64) (*storage.BlobstreamEvmAddress, error)) *IBlobstreamEvmAddressGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBlobstreamEvmAddressGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.BlobstreamEvmAddress, error)) *IBlobstreamEvmAddressGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIBlobstreamEvmAddress) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIBlobstreamEvmAddressMockRecorder) IsNoRows(err any) *IBlobstreamEvmAddressIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIBlobstreamEvmAddress)(nil).IsNoRows), err)
+	return &IBlobstreamEvmAddressIsNoRowsCall{Call: call}
+}
+
+// IBlobstreamEvmAddressIsNoRowsCall wrap *gomock.Call
+type IBlobstreamEvmAddressIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBlobstreamEvmAddressIsNoRowsCall) Return(arg0 bool) *IBlobstreamEvmAddressIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBlobstreamEvmAddressIsNoRowsCall) Do(f func(error) bool) *IBlobstreamEvmAddressIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBlobstreamEvmAddressIsNoRowsCall) DoAndReturn(f func(error) bool) *IBlobstreamEvmAddressIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIBlobstreamEvmAddress) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIBlobstreamEvmAddressMockRecorder) LastID(ctx any) *IBlobstreamEvmAddressLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIBlobstreamEvmAddress)(nil).LastID), ctx)
+	return &IBlobstreamEvmAddressLastIDCall{Call: call}
+}
+
+// IBlobstreamEvmAddressLastIDCall wrap *gomock.Call
+type IBlobstreamEvmAddressLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBlobstreamEvmAddressLastIDCall) Return(arg0 uint64, arg1 error) *IBlobstreamEvmAddressLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBlobstreamEvmAddressLastIDCall) Do(f func(context.Context) (uint64, error)) *IBlobstreamEvmAddressLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBlobstreamEvmAddressLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *IBlobstreamEvmAddressLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIBlobstreamEvmAddress) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.BlobstreamEvmAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.BlobstreamEvmAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIBlobstreamEvmAddressMockRecorder) List(ctx, limit, offset, order any) *IBlobstreamEvmAddressListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIBlobstreamEvmAddress)(nil).List), ctx, limit, offset, order)
+	return &IBlobstreamEvmAddressListCall{Call: call}
+}
+
+// IBlobstreamEvmAddressListCall wrap *gomock.Call
+type IBlobstreamEvmAddressListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBlobstreamEvmAddressListCall) Return(arg0 []*storage.BlobstreamEvmAddress, arg1 error) *IBlobstreamEvmAddressListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBlobstreamEvmAddressListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.BlobstreamEvmAddress, error)) *IBlobstreamEvmAddressListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBlobstreamEvmAddressListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.BlobstreamEvmAddress, error)) *IBlobstreamEvmAddressListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListWithFilters mocks base method.
+func (m *MockIBlobstreamEvmAddress) ListWithFilters(ctx context.Context, fltrs storage.BlobstreamEvmAddressFilters) ([]storage.BlobstreamEvmAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWithFilters", ctx, fltrs)
+	ret0, _ := ret[0].([]storage.BlobstreamEvmAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWithFilters indicates an expected call of ListWithFilters.
+func (mr *MockIBlobstreamEvmAddressMockRecorder) ListWithFilters(ctx, fltrs any) *IBlobstreamEvmAddressListWithFiltersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithFilters", reflect.TypeOf((*MockIBlobstreamEvmAddress)(nil).ListWithFilters), ctx, fltrs)
+	return &IBlobstreamEvmAddressListWithFiltersCall{Call: call}
+}
+
+// IBlobstreamEvmAddressListWithFiltersCall wrap *gomock.Call
+type IBlobstreamEvmAddressListWithFiltersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBlobstreamEvmAddressListWithFiltersCall) Return(arg0 []storage.BlobstreamEvmAddress, arg1 error) *IBlobstreamEvmAddressListWithFiltersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBlobstreamEvmAddressListWithFiltersCall) Do(f func(context.Context, storage.BlobstreamEvmAddressFilters) ([]storage.BlobstreamEvmAddress, error)) *IBlobstreamEvmAddressListWithFiltersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBlobstreamEvmAddressListWithFiltersCall) DoAndReturn(f func(context.Context, storage.BlobstreamEvmAddressFilters) ([]storage.BlobstreamEvmAddress, error)) *IBlobstreamEvmAddressListWithFiltersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIBlobstreamEvmAddress) Save(ctx context.Context, m *storage.BlobstreamEvmAddress) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIBlobstreamEvmAddressMockRecorder) Save(ctx, m any) *IBlobstreamEvmAddressSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIBlobstreamEvmAddress)(nil).Save), ctx, m)
+	return &IBlobstreamEvmAddressSaveCall{Call: call}
+}
+
+// IBlobstreamEvmAddressSaveCall wrap *gomock.Call
+type IBlobstreamEvmAddressSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBlobstreamEvmAddressSaveCall) Return(arg0 error) *IBlobstreamEvmAddressSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBlobstreamEvmAddressSaveCall) Do(f func(context.Context, *storage.BlobstreamEvmAddress) error) *IBlobstreamEvmAddressSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBlobstreamEvmAddressSaveCall) DoAndReturn(f func(context.Context, *storage.BlobstreamEvmAddress) error) *IBlobstreamEvmAddressSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIBlobstreamEvmAddress) Update(ctx context.Context, m *storage.BlobstreamEvmAddress) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIBlobstreamEvmAddressMockRecorder) Update(ctx, m any) *IBlobstreamEvmAddressUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIBlobstreamEvmAddress)(nil).Update), ctx, m)
+	return &IBlobstreamEvmAddressUpdateCall{Call: call}
+}
+
+// IBlobstreamEvmAddressUpdateCall wrap *gomock.Call
+type IBlobstreamEvmAddressUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBlobstreamEvmAddressUpdateCall) Return(arg0 error) *IBlobstreamEvmAddressUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBlobstreamEvmAddressUpdateCall) Do(f func(context.Context, *storage.BlobstreamEvmAddress) error) *IBlobstreamEvmAddressUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBlobstreamEvmAddressUpdateCall) DoAndReturn(f func(context.Context, *storage.BlobstreamEvmAddress) error) *IBlobstreamEvmAddressUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
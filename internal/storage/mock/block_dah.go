@@ -0,0 +1,354 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: block_dah.go
+//
+// Generated by this command:
+//
+//	mockgen -source=block_dah.go -destination=mock/block_dah.go -package=mock -typed
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/celestia-indexer/internal/storage"
+	types "github.com/celenium-io/celestia-indexer/pkg/types"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIBlockDah is a mock of IBlockDah interface.
+type MockIBlockDah struct {
+	ctrl     *gomock.Controller
+	recorder *MockIBlockDahMockRecorder
+}
+
+// MockIBlockDahMockRecorder is the mock recorder for MockIBlockDah.
+type MockIBlockDahMockRecorder struct {
+	mock *MockIBlockDah
+}
+
+// NewMockIBlockDah creates a new mock instance.
+func NewMockIBlockDah(ctrl *gomock.Controller) *MockIBlockDah {
+	mock := &MockIBlockDah{ctrl: ctrl}
+	mock.recorder = &MockIBlockDahMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIBlockDah) EXPECT() *MockIBlockDahMockRecorder {
+	return m.recorder
+}
+
+// ByHeight mocks base method.
+func (m *MockIBlockDah) ByHeight(ctx context.Context, height types.Level) (storage.BlockDah, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByHeight", ctx, height)
+	ret0, _ := ret[0].(storage.BlockDah)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByHeight indicates an expected call of ByHeight.
+func (mr *MockIBlockDahMockRecorder) ByHeight(ctx, height any) *IBlockDahByHeightCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByHeight", reflect.TypeOf((*MockIBlockDah)(nil).ByHeight), ctx, height)
+	return &IBlockDahByHeightCall{Call: call}
+}
+
+// IBlockDahByHeightCall wrap *gomock.Call
+type IBlockDahByHeightCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBlockDahByHeightCall) Return(arg0 storage.BlockDah, arg1 error) *IBlockDahByHeightCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBlockDahByHeightCall) Do(f func(context.Context, types.Level) (storage.BlockDah, error)) *IBlockDahByHeightCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBlockDahByHeightCall) DoAndReturn(f func(context.Context, types.Level) (storage.BlockDah, error)) *IBlockDahByHeightCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIBlockDah) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.BlockDah, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.BlockDah)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIBlockDahMockRecorder) CursorList(ctx, id, limit, order, cmp any) *IBlockDahCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIBlockDah)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &IBlockDahCursorListCall{Call: call}
+}
+
+// IBlockDahCursorListCall wrap *gomock.Call
+type IBlockDahCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBlockDahCursorListCall) Return(arg0 []*storage.BlockDah, arg1 error) *IBlockDahCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBlockDahCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.BlockDah, error)) *IBlockDahCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBlockDahCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.BlockDah, error)) *IBlockDahCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIBlockDah) GetByID(ctx context.Context, id uint64) (*storage.BlockDah, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.BlockDah)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIBlockDahMockRecorder) GetByID(ctx, id any) *IBlockDahGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIBlockDah)(nil).GetByID), ctx, id)
+	return &IBlockDahGetByIDCall{Call: call}
+}
+
+// IBlockDahGetByIDCall wrap *gomock.Call
+type IBlockDahGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBlockDahGetByIDCall) Return(arg0 *storage.BlockDah, arg1 error) *IBlockDahGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBlockDahGetByIDCall) Do(f func(context.Context, uint64) (*storage.BlockDah, error)) *IBlockDahGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBlockDahGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.BlockDah, error)) *IBlockDahGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIBlockDah) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIBlockDahMockRecorder) IsNoRows(err any) *IBlockDahIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIBlockDah)(nil).IsNoRows), err)
+	return &IBlockDahIsNoRowsCall{Call: call}
+}
+
+// IBlockDahIsNoRowsCall wrap *gomock.Call
+type IBlockDahIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBlockDahIsNoRowsCall) Return(arg0 bool) *IBlockDahIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBlockDahIsNoRowsCall) Do(f func(error) bool) *IBlockDahIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBlockDahIsNoRowsCall) DoAndReturn(f func(error) bool) *IBlockDahIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIBlockDah) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIBlockDahMockRecorder) LastID(ctx any) *IBlockDahLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIBlockDah)(nil).LastID), ctx)
+	return &IBlockDahLastIDCall{Call: call}
+}
+
+// IBlockDahLastIDCall wrap *gomock.Call
+type IBlockDahLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBlockDahLastIDCall) Return(arg0 uint64, arg1 error) *IBlockDahLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBlockDahLastIDCall) Do(f func(context.Context) (uint64, error)) *IBlockDahLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBlockDahLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *IBlockDahLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIBlockDah) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.BlockDah, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.BlockDah)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIBlockDahMockRecorder) List(ctx, limit, offset, order any) *IBlockDahListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIBlockDah)(nil).List), ctx, limit, offset, order)
+	return &IBlockDahListCall{Call: call}
+}
+
+// IBlockDahListCall wrap *gomock.Call
+type IBlockDahListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBlockDahListCall) Return(arg0 []*storage.BlockDah, arg1 error) *IBlockDahListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBlockDahListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.BlockDah, error)) *IBlockDahListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBlockDahListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.BlockDah, error)) *IBlockDahListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIBlockDah) Save(ctx context.Context, m *storage.BlockDah) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIBlockDahMockRecorder) Save(ctx, m any) *IBlockDahSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIBlockDah)(nil).Save), ctx, m)
+	return &IBlockDahSaveCall{Call: call}
+}
+
+// IBlockDahSaveCall wrap *gomock.Call
+type IBlockDahSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBlockDahSaveCall) Return(arg0 error) *IBlockDahSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBlockDahSaveCall) Do(f func(context.Context, *storage.BlockDah) error) *IBlockDahSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBlockDahSaveCall) DoAndReturn(f func(context.Context, *storage.BlockDah) error) *IBlockDahSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIBlockDah) Update(ctx context.Context, m *storage.BlockDah) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIBlockDahMockRecorder) Update(ctx, m any) *IBlockDahUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIBlockDah)(nil).Update), ctx, m)
+	return &IBlockDahUpdateCall{Call: call}
+}
+
+// IBlockDahUpdateCall wrap *gomock.Call
+type IBlockDahUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBlockDahUpdateCall) Return(arg0 error) *IBlockDahUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBlockDahUpdateCall) Do(f func(context.Context, *storage.BlockDah) error) *IBlockDahUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBlockDahUpdateCall) DoAndReturn(f func(context.Context, *storage.BlockDah) error) *IBlockDahUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,354 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: constant_history.go
+//
+// Generated by this command:
+//
+//	mockgen -source=constant_history.go -destination=mock/constant_history.go -package=mock -typed
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/celestia-indexer/internal/storage"
+	types "github.com/celenium-io/celestia-indexer/pkg/types"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIConstantHistory is a mock of IConstantHistory interface.
+type MockIConstantHistory struct {
+	ctrl     *gomock.Controller
+	recorder *MockIConstantHistoryMockRecorder
+}
+
+// MockIConstantHistoryMockRecorder is the mock recorder for MockIConstantHistory.
+type MockIConstantHistoryMockRecorder struct {
+	mock *MockIConstantHistory
+}
+
+// NewMockIConstantHistory creates a new mock instance.
+func NewMockIConstantHistory(ctrl *gomock.Controller) *MockIConstantHistory {
+	mock := &MockIConstantHistory{ctrl: ctrl}
+	mock.recorder = &MockIConstantHistoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIConstantHistory) EXPECT() *MockIConstantHistoryMockRecorder {
+	return m.recorder
+}
+
+// ByHeight mocks base method.
+func (m *MockIConstantHistory) ByHeight(ctx context.Context, height types.Level) ([]storage.Constant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByHeight", ctx, height)
+	ret0, _ := ret[0].([]storage.Constant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByHeight indicates an expected call of ByHeight.
+func (mr *MockIConstantHistoryMockRecorder) ByHeight(ctx, height any) *IConstantHistoryByHeightCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByHeight", reflect.TypeOf((*MockIConstantHistory)(nil).ByHeight), ctx, height)
+	return &IConstantHistoryByHeightCall{Call: call}
+}
+
+// IConstantHistoryByHeightCall wrap *gomock.Call
+type IConstantHistoryByHeightCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IConstantHistoryByHeightCall) Return(arg0 []storage.Constant, arg1 error) *IConstantHistoryByHeightCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IConstantHistoryByHeightCall) Do(f func(context.Context, types.Level) ([]storage.Constant, error)) *IConstantHistoryByHeightCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IConstantHistoryByHeightCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.Constant, error)) *IConstantHistoryByHeightCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIConstantHistory) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.ConstantHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.ConstantHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIConstantHistoryMockRecorder) CursorList(ctx, id, limit, order, cmp any) *IConstantHistoryCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIConstantHistory)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &IConstantHistoryCursorListCall{Call: call}
+}
+
+// IConstantHistoryCursorListCall wrap *gomock.Call
+type IConstantHistoryCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IConstantHistoryCursorListCall) Return(arg0 []*storage.ConstantHistory, arg1 error) *IConstantHistoryCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IConstantHistoryCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.ConstantHistory, error)) *IConstantHistoryCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IConstantHistoryCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.ConstantHistory, error)) *IConstantHistoryCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIConstantHistory) GetByID(ctx context.Context, id uint64) (*storage.ConstantHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.ConstantHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIConstantHistoryMockRecorder) GetByID(ctx, id any) *IConstantHistoryGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIConstantHistory)(nil).GetByID), ctx, id)
+	return &IConstantHistoryGetByIDCall{Call: call}
+}
+
+// IConstantHistoryGetByIDCall wrap *gomock.Call
+type IConstantHistoryGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IConstantHistoryGetByIDCall) Return(arg0 *storage.ConstantHistory, arg1 error) *IConstantHistoryGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IConstantHistoryGetByIDCall) Do(f func(context.Context, uint64) (*storage.ConstantHistory, error)) *IConstantHistoryGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IConstantHistoryGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.ConstantHistory, error)) *IConstantHistoryGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIConstantHistory) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIConstantHistoryMockRecorder) IsNoRows(err any) *IConstantHistoryIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIConstantHistory)(nil).IsNoRows), err)
+	return &IConstantHistoryIsNoRowsCall{Call: call}
+}
+
+// IConstantHistoryIsNoRowsCall wrap *gomock.Call
+type IConstantHistoryIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IConstantHistoryIsNoRowsCall) Return(arg0 bool) *IConstantHistoryIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IConstantHistoryIsNoRowsCall) Do(f func(error) bool) *IConstantHistoryIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IConstantHistoryIsNoRowsCall) DoAndReturn(f func(error) bool) *IConstantHistoryIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIConstantHistory) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIConstantHistoryMockRecorder) LastID(ctx any) *IConstantHistoryLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIConstantHistory)(nil).LastID), ctx)
+	return &IConstantHistoryLastIDCall{Call: call}
+}
+
+// IConstantHistoryLastIDCall wrap *gomock.Call
+type IConstantHistoryLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IConstantHistoryLastIDCall) Return(arg0 uint64, arg1 error) *IConstantHistoryLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IConstantHistoryLastIDCall) Do(f func(context.Context) (uint64, error)) *IConstantHistoryLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IConstantHistoryLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *IConstantHistoryLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIConstantHistory) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.ConstantHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.ConstantHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIConstantHistoryMockRecorder) List(ctx, limit, offset, order any) *IConstantHistoryListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIConstantHistory)(nil).List), ctx, limit, offset, order)
+	return &IConstantHistoryListCall{Call: call}
+}
+
+// IConstantHistoryListCall wrap *gomock.Call
+type IConstantHistoryListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IConstantHistoryListCall) Return(arg0 []*storage.ConstantHistory, arg1 error) *IConstantHistoryListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IConstantHistoryListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.ConstantHistory, error)) *IConstantHistoryListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IConstantHistoryListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.ConstantHistory, error)) *IConstantHistoryListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIConstantHistory) Save(ctx context.Context, m *storage.ConstantHistory) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIConstantHistoryMockRecorder) Save(ctx, m any) *IConstantHistorySaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIConstantHistory)(nil).Save), ctx, m)
+	return &IConstantHistorySaveCall{Call: call}
+}
+
+// IConstantHistorySaveCall wrap *gomock.Call
+type IConstantHistorySaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IConstantHistorySaveCall) Return(arg0 error) *IConstantHistorySaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IConstantHistorySaveCall) Do(f func(context.Context, *storage.ConstantHistory) error) *IConstantHistorySaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IConstantHistorySaveCall) DoAndReturn(f func(context.Context, *storage.ConstantHistory) error) *IConstantHistorySaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIConstantHistory) Update(ctx context.Context, m *storage.ConstantHistory) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIConstantHistoryMockRecorder) Update(ctx, m any) *IConstantHistoryUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIConstantHistory)(nil).Update), ctx, m)
+	return &IConstantHistoryUpdateCall{Call: call}
+}
+
+// IConstantHistoryUpdateCall wrap *gomock.Call
+type IConstantHistoryUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IConstantHistoryUpdateCall) Return(arg0 error) *IConstantHistoryUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IConstantHistoryUpdateCall) Do(f func(context.Context, *storage.ConstantHistory) error) *IConstantHistoryUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IConstantHistoryUpdateCall) DoAndReturn(f func(context.Context, *storage.ConstantHistory) error) *IConstantHistoryUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
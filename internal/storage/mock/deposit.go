@@ -0,0 +1,392 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deposit.go
+//
+// Generated by this command:
+//
+//	mockgen -source=deposit.go -destination=mock/deposit.go -package=mock -typed
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/celestia-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIDeposit is a mock of IDeposit interface.
+type MockIDeposit struct {
+	ctrl     *gomock.Controller
+	recorder *MockIDepositMockRecorder
+}
+
+// MockIDepositMockRecorder is the mock recorder for MockIDeposit.
+type MockIDepositMockRecorder struct {
+	mock *MockIDeposit
+}
+
+// NewMockIDeposit creates a new mock instance.
+func NewMockIDeposit(ctrl *gomock.Controller) *MockIDeposit {
+	mock := &MockIDeposit{ctrl: ctrl}
+	mock.recorder = &MockIDepositMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIDeposit) EXPECT() *MockIDepositMockRecorder {
+	return m.recorder
+}
+
+// ByDepositorId mocks base method.
+func (m *MockIDeposit) ByDepositorId(ctx context.Context, depositorId uint64, limit, offset int, sort storage0.SortOrder) ([]storage.Deposit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByDepositorId", ctx, depositorId, limit, offset, sort)
+	ret0, _ := ret[0].([]storage.Deposit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByDepositorId indicates an expected call of ByDepositorId.
+func (mr *MockIDepositMockRecorder) ByDepositorId(ctx, depositorId, limit, offset, sort any) *IDepositByDepositorIdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByDepositorId", reflect.TypeOf((*MockIDeposit)(nil).ByDepositorId), ctx, depositorId, limit, offset, sort)
+	return &IDepositByDepositorIdCall{Call: call}
+}
+
+// IDepositByDepositorIdCall wrap *gomock.Call
+type IDepositByDepositorIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IDepositByDepositorIdCall) Return(arg0 []storage.Deposit, arg1 error) *IDepositByDepositorIdCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IDepositByDepositorIdCall) Do(f func(context.Context, uint64, int, int, storage0.SortOrder) ([]storage.Deposit, error)) *IDepositByDepositorIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IDepositByDepositorIdCall) DoAndReturn(f func(context.Context, uint64, int, int, storage0.SortOrder) ([]storage.Deposit, error)) *IDepositByDepositorIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ByProposalId mocks base method.
+func (m *MockIDeposit) ByProposalId(ctx context.Context, proposalId uint64, limit, offset int, sort storage0.SortOrder) ([]storage.Deposit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByProposalId", ctx, proposalId, limit, offset, sort)
+	ret0, _ := ret[0].([]storage.Deposit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByProposalId indicates an expected call of ByProposalId.
+func (mr *MockIDepositMockRecorder) ByProposalId(ctx, proposalId, limit, offset, sort any) *IDepositByProposalIdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByProposalId", reflect.TypeOf((*MockIDeposit)(nil).ByProposalId), ctx, proposalId, limit, offset, sort)
+	return &IDepositByProposalIdCall{Call: call}
+}
+
+// IDepositByProposalIdCall wrap *gomock.Call
+type IDepositByProposalIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IDepositByProposalIdCall) Return(arg0 []storage.Deposit, arg1 error) *IDepositByProposalIdCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IDepositByProposalIdCall) Do(f func(context.Context, uint64, int, int, storage0.SortOrder) ([]storage.Deposit, error)) *IDepositByProposalIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IDepositByProposalIdCall) DoAndReturn(f func(context.Context, uint64, int, int, storage0.SortOrder) ([]storage.Deposit, error)) *IDepositByProposalIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIDeposit) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.Deposit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.Deposit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIDepositMockRecorder) CursorList(ctx, id, limit, order, cmp any) *IDepositCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIDeposit)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &IDepositCursorListCall{Call: call}
+}
+
+// IDepositCursorListCall wrap *gomock.Call
+type IDepositCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IDepositCursorListCall) Return(arg0 []*storage.Deposit, arg1 error) *IDepositCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IDepositCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Deposit, error)) *IDepositCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IDepositCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Deposit, error)) *IDepositCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIDeposit) GetByID(ctx context.Context, id uint64) (*storage.Deposit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.Deposit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIDepositMockRecorder) GetByID(ctx, id any) *IDepositGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIDeposit)(nil).GetByID), ctx, id)
+	return &IDepositGetByIDCall{Call: call}
+}
+
+// IDepositGetByIDCall wrap *gomock.Call
+type IDepositGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IDepositGetByIDCall) Return(arg0 *storage.Deposit, arg1 error) *IDepositGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IDepositGetByIDCall) Do(f func(context.Context, uint64) (*storage.Deposit, error)) *IDepositGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IDepositGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.Deposit, error)) *IDepositGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIDeposit) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIDepositMockRecorder) IsNoRows(err any) *IDepositIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIDeposit)(nil).IsNoRows), err)
+	return &IDepositIsNoRowsCall{Call: call}
+}
+
+// IDepositIsNoRowsCall wrap *gomock.Call
+type IDepositIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IDepositIsNoRowsCall) Return(arg0 bool) *IDepositIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IDepositIsNoRowsCall) Do(f func(error) bool) *IDepositIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IDepositIsNoRowsCall) DoAndReturn(f func(error) bool) *IDepositIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIDeposit) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIDepositMockRecorder) LastID(ctx any) *IDepositLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIDeposit)(nil).LastID), ctx)
+	return &IDepositLastIDCall{Call: call}
+}
+
+// IDepositLastIDCall wrap *gomock.Call
+type IDepositLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IDepositLastIDCall) Return(arg0 uint64, arg1 error) *IDepositLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IDepositLastIDCall) Do(f func(context.Context) (uint64, error)) *IDepositLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IDepositLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *IDepositLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIDeposit) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.Deposit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.Deposit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIDepositMockRecorder) List(ctx, limit, offset, order any) *IDepositListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIDeposit)(nil).List), ctx, limit, offset, order)
+	return &IDepositListCall{Call: call}
+}
+
+// IDepositListCall wrap *gomock.Call
+type IDepositListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IDepositListCall) Return(arg0 []*storage.Deposit, arg1 error) *IDepositListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IDepositListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Deposit, error)) *IDepositListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IDepositListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Deposit, error)) *IDepositListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIDeposit) Save(ctx context.Context, m *storage.Deposit) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIDepositMockRecorder) Save(ctx, m any) *IDepositSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIDeposit)(nil).Save), ctx, m)
+	return &IDepositSaveCall{Call: call}
+}
+
+// IDepositSaveCall wrap *gomock.Call
+type IDepositSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IDepositSaveCall) Return(arg0 error) *IDepositSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IDepositSaveCall) Do(f func(context.Context, *storage.Deposit) error) *IDepositSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IDepositSaveCall) DoAndReturn(f func(context.Context, *storage.Deposit) error) *IDepositSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIDeposit) Update(ctx context.Context, m *storage.Deposit) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIDepositMockRecorder) Update(ctx, m any) *IDepositUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIDeposit)(nil).Update), ctx, m)
+	return &IDepositUpdateCall{Call: call}
+}
+
+// IDepositUpdateCall wrap *gomock.Call
+type IDepositUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IDepositUpdateCall) Return(arg0 error) *IDepositUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IDepositUpdateCall) Do(f func(context.Context, *storage.Deposit) error) *IDepositUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IDepositUpdateCall) DoAndReturn(f func(context.Context, *storage.Deposit) error) *IDepositUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -18,7 +18,8 @@ import (
 	time "time"
 
 	storage "github.com/celenium-io/celestia-indexer/internal/storage"
-	types "github.com/celenium-io/celestia-indexer/pkg/types"
+	types "github.com/celenium-io/celestia-indexer/internal/storage/types"
+	types0 "github.com/celenium-io/celestia-indexer/pkg/types"
 	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	pq "github.com/lib/pq"
 	decimal "github.com/shopspring/decimal"
@@ -351,6 +352,45 @@ func (c *TransactionAddCall) DoAndReturn(f func(context.Context, any) error) *Tr
 	return c
 }
 
+// ApplyUpgrades mocks base method.
+func (m *MockTransaction) ApplyUpgrades(ctx context.Context, height types0.Level, t time.Time, appVersion uint64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyUpgrades", ctx, height, t, appVersion)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyUpgrades indicates an expected call of ApplyUpgrades.
+func (mr *MockTransactionMockRecorder) ApplyUpgrades(ctx, height, t, appVersion any) *TransactionApplyUpgradesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyUpgrades", reflect.TypeOf((*MockTransaction)(nil).ApplyUpgrades), ctx, height, t, appVersion)
+	return &TransactionApplyUpgradesCall{Call: call}
+}
+
+// TransactionApplyUpgradesCall wrap *gomock.Call
+type TransactionApplyUpgradesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionApplyUpgradesCall) Return(arg0 int64, arg1 error) *TransactionApplyUpgradesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionApplyUpgradesCall) Do(f func(context.Context, types0.Level, time.Time, uint64) (int64, error)) *TransactionApplyUpgradesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionApplyUpgradesCall) DoAndReturn(f func(context.Context, types0.Level, time.Time, uint64) (int64, error)) *TransactionApplyUpgradesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // BulkSave mocks base method.
 func (m *MockTransaction) BulkSave(ctx context.Context, models []any) error {
 	m.ctrl.T.Helper()
@@ -432,6 +472,44 @@ func (c *TransactionCancelUnbondingsCall) DoAndReturn(f func(context.Context, ..
 	return c
 }
 
+// CancelUpgrades mocks base method.
+func (m *MockTransaction) CancelUpgrades(ctx context.Context, height types0.Level, t time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelUpgrades", ctx, height, t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelUpgrades indicates an expected call of CancelUpgrades.
+func (mr *MockTransactionMockRecorder) CancelUpgrades(ctx, height, t any) *TransactionCancelUpgradesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelUpgrades", reflect.TypeOf((*MockTransaction)(nil).CancelUpgrades), ctx, height, t)
+	return &TransactionCancelUpgradesCall{Call: call}
+}
+
+// TransactionCancelUpgradesCall wrap *gomock.Call
+type TransactionCancelUpgradesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionCancelUpgradesCall) Return(arg0 error) *TransactionCancelUpgradesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionCancelUpgradesCall) Do(f func(context.Context, types0.Level, time.Time) error) *TransactionCancelUpgradesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionCancelUpgradesCall) DoAndReturn(f func(context.Context, types0.Level, time.Time) error) *TransactionCancelUpgradesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Close mocks base method.
 func (m *MockTransaction) Close(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -984,6 +1062,84 @@ func (c *TransactionLastBlockCall) DoAndReturn(f func(context.Context) (storage.
 	return c
 }
 
+// LastConstant mocks base method.
+func (m *MockTransaction) LastConstant(ctx context.Context, module types.ModuleName, name string) (storage.ConstantHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastConstant", ctx, module, name)
+	ret0, _ := ret[0].(storage.ConstantHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastConstant indicates an expected call of LastConstant.
+func (mr *MockTransactionMockRecorder) LastConstant(ctx, module, name any) *TransactionLastConstantCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastConstant", reflect.TypeOf((*MockTransaction)(nil).LastConstant), ctx, module, name)
+	return &TransactionLastConstantCall{Call: call}
+}
+
+// TransactionLastConstantCall wrap *gomock.Call
+type TransactionLastConstantCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionLastConstantCall) Return(arg0 storage.ConstantHistory, arg1 error) *TransactionLastConstantCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionLastConstantCall) Do(f func(context.Context, types.ModuleName, string) (storage.ConstantHistory, error)) *TransactionLastConstantCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionLastConstantCall) DoAndReturn(f func(context.Context, types.ModuleName, string) (storage.ConstantHistory, error)) *TransactionLastConstantCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastDataCommitment mocks base method.
+func (m *MockTransaction) LastDataCommitment(ctx context.Context) (storage.BlobstreamAttestation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastDataCommitment", ctx)
+	ret0, _ := ret[0].(storage.BlobstreamAttestation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastDataCommitment indicates an expected call of LastDataCommitment.
+func (mr *MockTransactionMockRecorder) LastDataCommitment(ctx any) *TransactionLastDataCommitmentCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastDataCommitment", reflect.TypeOf((*MockTransaction)(nil).LastDataCommitment), ctx)
+	return &TransactionLastDataCommitmentCall{Call: call}
+}
+
+// TransactionLastDataCommitmentCall wrap *gomock.Call
+type TransactionLastDataCommitmentCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionLastDataCommitmentCall) Return(arg0 storage.BlobstreamAttestation, arg1 error) *TransactionLastDataCommitmentCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionLastDataCommitmentCall) Do(f func(context.Context) (storage.BlobstreamAttestation, error)) *TransactionLastDataCommitmentCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionLastDataCommitmentCall) DoAndReturn(f func(context.Context) (storage.BlobstreamAttestation, error)) *TransactionLastDataCommitmentCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // LastNamespaceMessage mocks base method.
 func (m *MockTransaction) LastNamespaceMessage(ctx context.Context, nsId uint64) (storage.NamespaceMessage, error) {
 	m.ctrl.T.Helper()
@@ -1063,7 +1219,7 @@ func (c *TransactionNamespaceCall) DoAndReturn(f func(context.Context, uint64) (
 }
 
 // RetentionBlockSignatures mocks base method.
-func (m *MockTransaction) RetentionBlockSignatures(ctx context.Context, height types.Level) error {
+func (m *MockTransaction) RetentionBlockSignatures(ctx context.Context, height types0.Level) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RetentionBlockSignatures", ctx, height)
 	ret0, _ := ret[0].(error)
@@ -1089,13 +1245,13 @@ func (c *TransactionRetentionBlockSignaturesCall) Return(arg0 error) *Transactio
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionRetentionBlockSignaturesCall) Do(f func(context.Context, types.Level) error) *TransactionRetentionBlockSignaturesCall {
+func (c *TransactionRetentionBlockSignaturesCall) Do(f func(context.Context, types0.Level) error) *TransactionRetentionBlockSignaturesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionRetentionBlockSignaturesCall) DoAndReturn(f func(context.Context, types.Level) error) *TransactionRetentionBlockSignaturesCall {
+func (c *TransactionRetentionBlockSignaturesCall) DoAndReturn(f func(context.Context, types0.Level) error) *TransactionRetentionBlockSignaturesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -1215,7 +1371,7 @@ func (c *TransactionRollbackCall) DoAndReturn(f func(context.Context) error) *Tr
 }
 
 // RollbackAddresses mocks base method.
-func (m *MockTransaction) RollbackAddresses(ctx context.Context, height types.Level) ([]storage.Address, error) {
+func (m *MockTransaction) RollbackAddresses(ctx context.Context, height types0.Level) ([]storage.Address, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RollbackAddresses", ctx, height)
 	ret0, _ := ret[0].([]storage.Address)
@@ -1242,19 +1398,57 @@ func (c *TransactionRollbackAddressesCall) Return(address []storage.Address, err
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionRollbackAddressesCall) Do(f func(context.Context, types.Level) ([]storage.Address, error)) *TransactionRollbackAddressesCall {
+func (c *TransactionRollbackAddressesCall) Do(f func(context.Context, types0.Level) ([]storage.Address, error)) *TransactionRollbackAddressesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackAddressesCall) DoAndReturn(f func(context.Context, types0.Level) ([]storage.Address, error)) *TransactionRollbackAddressesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackBalanceHistory mocks base method.
+func (m *MockTransaction) RollbackBalanceHistory(ctx context.Context, height types0.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackBalanceHistory", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackBalanceHistory indicates an expected call of RollbackBalanceHistory.
+func (mr *MockTransactionMockRecorder) RollbackBalanceHistory(ctx, height any) *TransactionRollbackBalanceHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackBalanceHistory", reflect.TypeOf((*MockTransaction)(nil).RollbackBalanceHistory), ctx, height)
+	return &TransactionRollbackBalanceHistoryCall{Call: call}
+}
+
+// TransactionRollbackBalanceHistoryCall wrap *gomock.Call
+type TransactionRollbackBalanceHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackBalanceHistoryCall) Return(arg0 error) *TransactionRollbackBalanceHistoryCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackBalanceHistoryCall) Do(f func(context.Context, types0.Level) error) *TransactionRollbackBalanceHistoryCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionRollbackAddressesCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.Address, error)) *TransactionRollbackAddressesCall {
+func (c *TransactionRollbackBalanceHistoryCall) DoAndReturn(f func(context.Context, types0.Level) error) *TransactionRollbackBalanceHistoryCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // RollbackBlobLog mocks base method.
-func (m *MockTransaction) RollbackBlobLog(ctx context.Context, height types.Level) error {
+func (m *MockTransaction) RollbackBlobLog(ctx context.Context, height types0.Level) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RollbackBlobLog", ctx, height)
 	ret0, _ := ret[0].(error)
@@ -1280,1051 +1474,2733 @@ func (c *TransactionRollbackBlobLogCall) Return(arg0 error) *TransactionRollback
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionRollbackBlobLogCall) Do(f func(context.Context, types.Level) error) *TransactionRollbackBlobLogCall {
+func (c *TransactionRollbackBlobLogCall) Do(f func(context.Context, types0.Level) error) *TransactionRollbackBlobLogCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionRollbackBlobLogCall) DoAndReturn(f func(context.Context, types.Level) error) *TransactionRollbackBlobLogCall {
+func (c *TransactionRollbackBlobLogCall) DoAndReturn(f func(context.Context, types0.Level) error) *TransactionRollbackBlobLogCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackBlock mocks base method.
-func (m *MockTransaction) RollbackBlock(ctx context.Context, height types.Level) error {
+// RollbackBlobstreamAttestations mocks base method.
+func (m *MockTransaction) RollbackBlobstreamAttestations(ctx context.Context, height types0.Level) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackBlock", ctx, height)
+	ret := m.ctrl.Call(m, "RollbackBlobstreamAttestations", ctx, height)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// RollbackBlock indicates an expected call of RollbackBlock.
-func (mr *MockTransactionMockRecorder) RollbackBlock(ctx, height any) *TransactionRollbackBlockCall {
+// RollbackBlobstreamAttestations indicates an expected call of RollbackBlobstreamAttestations.
+func (mr *MockTransactionMockRecorder) RollbackBlobstreamAttestations(ctx, height any) *TransactionRollbackBlobstreamAttestationsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackBlock", reflect.TypeOf((*MockTransaction)(nil).RollbackBlock), ctx, height)
-	return &TransactionRollbackBlockCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackBlobstreamAttestations", reflect.TypeOf((*MockTransaction)(nil).RollbackBlobstreamAttestations), ctx, height)
+	return &TransactionRollbackBlobstreamAttestationsCall{Call: call}
 }
 
-// TransactionRollbackBlockCall wrap *gomock.Call
-type TransactionRollbackBlockCall struct {
+// TransactionRollbackBlobstreamAttestationsCall wrap *gomock.Call
+type TransactionRollbackBlobstreamAttestationsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionRollbackBlockCall) Return(arg0 error) *TransactionRollbackBlockCall {
+func (c *TransactionRollbackBlobstreamAttestationsCall) Return(arg0 error) *TransactionRollbackBlobstreamAttestationsCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionRollbackBlockCall) Do(f func(context.Context, types.Level) error) *TransactionRollbackBlockCall {
+func (c *TransactionRollbackBlobstreamAttestationsCall) Do(f func(context.Context, types0.Level) error) *TransactionRollbackBlobstreamAttestationsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionRollbackBlockCall) DoAndReturn(f func(context.Context, types.Level) error) *TransactionRollbackBlockCall {
+func (c *TransactionRollbackBlobstreamAttestationsCall) DoAndReturn(f func(context.Context, types0.Level) error) *TransactionRollbackBlobstreamAttestationsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackBlockSignatures mocks base method.
-func (m *MockTransaction) RollbackBlockSignatures(ctx context.Context, height types.Level) error {
+// RollbackBlobstreamEvmAddresses mocks base method.
+func (m *MockTransaction) RollbackBlobstreamEvmAddresses(ctx context.Context, height types0.Level) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackBlockSignatures", ctx, height)
+	ret := m.ctrl.Call(m, "RollbackBlobstreamEvmAddresses", ctx, height)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// RollbackBlockSignatures indicates an expected call of RollbackBlockSignatures.
-func (mr *MockTransactionMockRecorder) RollbackBlockSignatures(ctx, height any) *TransactionRollbackBlockSignaturesCall {
+// RollbackBlobstreamEvmAddresses indicates an expected call of RollbackBlobstreamEvmAddresses.
+func (mr *MockTransactionMockRecorder) RollbackBlobstreamEvmAddresses(ctx, height any) *TransactionRollbackBlobstreamEvmAddressesCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackBlockSignatures", reflect.TypeOf((*MockTransaction)(nil).RollbackBlockSignatures), ctx, height)
-	return &TransactionRollbackBlockSignaturesCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackBlobstreamEvmAddresses", reflect.TypeOf((*MockTransaction)(nil).RollbackBlobstreamEvmAddresses), ctx, height)
+	return &TransactionRollbackBlobstreamEvmAddressesCall{Call: call}
 }
 
-// TransactionRollbackBlockSignaturesCall wrap *gomock.Call
-type TransactionRollbackBlockSignaturesCall struct {
+// TransactionRollbackBlobstreamEvmAddressesCall wrap *gomock.Call
+type TransactionRollbackBlobstreamEvmAddressesCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionRollbackBlockSignaturesCall) Return(err error) *TransactionRollbackBlockSignaturesCall {
-	c.Call = c.Call.Return(err)
+func (c *TransactionRollbackBlobstreamEvmAddressesCall) Return(arg0 error) *TransactionRollbackBlobstreamEvmAddressesCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionRollbackBlockSignaturesCall) Do(f func(context.Context, types.Level) error) *TransactionRollbackBlockSignaturesCall {
+func (c *TransactionRollbackBlobstreamEvmAddressesCall) Do(f func(context.Context, types0.Level) error) *TransactionRollbackBlobstreamEvmAddressesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionRollbackBlockSignaturesCall) DoAndReturn(f func(context.Context, types.Level) error) *TransactionRollbackBlockSignaturesCall {
+func (c *TransactionRollbackBlobstreamEvmAddressesCall) DoAndReturn(f func(context.Context, types0.Level) error) *TransactionRollbackBlobstreamEvmAddressesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackBlockStats mocks base method.
-func (m *MockTransaction) RollbackBlockStats(ctx context.Context, height types.Level) (storage.BlockStats, error) {
+// RollbackBlock mocks base method.
+func (m *MockTransaction) RollbackBlock(ctx context.Context, height types0.Level) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackBlockStats", ctx, height)
-	ret0, _ := ret[0].(storage.BlockStats)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RollbackBlock", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// RollbackBlockStats indicates an expected call of RollbackBlockStats.
-func (mr *MockTransactionMockRecorder) RollbackBlockStats(ctx, height any) *TransactionRollbackBlockStatsCall {
+// RollbackBlock indicates an expected call of RollbackBlock.
+func (mr *MockTransactionMockRecorder) RollbackBlock(ctx, height any) *TransactionRollbackBlockCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackBlockStats", reflect.TypeOf((*MockTransaction)(nil).RollbackBlockStats), ctx, height)
-	return &TransactionRollbackBlockStatsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackBlock", reflect.TypeOf((*MockTransaction)(nil).RollbackBlock), ctx, height)
+	return &TransactionRollbackBlockCall{Call: call}
 }
 
-// TransactionRollbackBlockStatsCall wrap *gomock.Call
-type TransactionRollbackBlockStatsCall struct {
+// TransactionRollbackBlockCall wrap *gomock.Call
+type TransactionRollbackBlockCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionRollbackBlockStatsCall) Return(stats storage.BlockStats, err error) *TransactionRollbackBlockStatsCall {
-	c.Call = c.Call.Return(stats, err)
+func (c *TransactionRollbackBlockCall) Return(arg0 error) *TransactionRollbackBlockCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionRollbackBlockStatsCall) Do(f func(context.Context, types.Level) (storage.BlockStats, error)) *TransactionRollbackBlockStatsCall {
+func (c *TransactionRollbackBlockCall) Do(f func(context.Context, types0.Level) error) *TransactionRollbackBlockCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionRollbackBlockStatsCall) DoAndReturn(f func(context.Context, types.Level) (storage.BlockStats, error)) *TransactionRollbackBlockStatsCall {
+func (c *TransactionRollbackBlockCall) DoAndReturn(f func(context.Context, types0.Level) error) *TransactionRollbackBlockCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackEvents mocks base method.
-func (m *MockTransaction) RollbackEvents(ctx context.Context, height types.Level) ([]storage.Event, error) {
+// RollbackBlockDah mocks base method.
+func (m *MockTransaction) RollbackBlockDah(ctx context.Context, height types0.Level) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackEvents", ctx, height)
-	ret0, _ := ret[0].([]storage.Event)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RollbackBlockDah", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// RollbackEvents indicates an expected call of RollbackEvents.
-func (mr *MockTransactionMockRecorder) RollbackEvents(ctx, height any) *TransactionRollbackEventsCall {
+// RollbackBlockDah indicates an expected call of RollbackBlockDah.
+func (mr *MockTransactionMockRecorder) RollbackBlockDah(ctx, height any) *TransactionRollbackBlockDahCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackEvents", reflect.TypeOf((*MockTransaction)(nil).RollbackEvents), ctx, height)
-	return &TransactionRollbackEventsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackBlockDah", reflect.TypeOf((*MockTransaction)(nil).RollbackBlockDah), ctx, height)
+	return &TransactionRollbackBlockDahCall{Call: call}
 }
 
-// TransactionRollbackEventsCall wrap *gomock.Call
-type TransactionRollbackEventsCall struct {
+// TransactionRollbackBlockDahCall wrap *gomock.Call
+type TransactionRollbackBlockDahCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionRollbackEventsCall) Return(events []storage.Event, err error) *TransactionRollbackEventsCall {
-	c.Call = c.Call.Return(events, err)
+func (c *TransactionRollbackBlockDahCall) Return(arg0 error) *TransactionRollbackBlockDahCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionRollbackEventsCall) Do(f func(context.Context, types.Level) ([]storage.Event, error)) *TransactionRollbackEventsCall {
+func (c *TransactionRollbackBlockDahCall) Do(f func(context.Context, types0.Level) error) *TransactionRollbackBlockDahCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionRollbackEventsCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.Event, error)) *TransactionRollbackEventsCall {
+func (c *TransactionRollbackBlockDahCall) DoAndReturn(f func(context.Context, types0.Level) error) *TransactionRollbackBlockDahCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackGrants mocks base method.
-func (m *MockTransaction) RollbackGrants(ctx context.Context, height types.Level) error {
+// RollbackBlockSignatures mocks base method.
+func (m *MockTransaction) RollbackBlockSignatures(ctx context.Context, height types0.Level) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackGrants", ctx, height)
+	ret := m.ctrl.Call(m, "RollbackBlockSignatures", ctx, height)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// RollbackGrants indicates an expected call of RollbackGrants.
-func (mr *MockTransactionMockRecorder) RollbackGrants(ctx, height any) *TransactionRollbackGrantsCall {
+// RollbackBlockSignatures indicates an expected call of RollbackBlockSignatures.
+func (mr *MockTransactionMockRecorder) RollbackBlockSignatures(ctx, height any) *TransactionRollbackBlockSignaturesCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackGrants", reflect.TypeOf((*MockTransaction)(nil).RollbackGrants), ctx, height)
-	return &TransactionRollbackGrantsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackBlockSignatures", reflect.TypeOf((*MockTransaction)(nil).RollbackBlockSignatures), ctx, height)
+	return &TransactionRollbackBlockSignaturesCall{Call: call}
 }
 
-// TransactionRollbackGrantsCall wrap *gomock.Call
-type TransactionRollbackGrantsCall struct {
+// TransactionRollbackBlockSignaturesCall wrap *gomock.Call
+type TransactionRollbackBlockSignaturesCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionRollbackGrantsCall) Return(arg0 error) *TransactionRollbackGrantsCall {
-	c.Call = c.Call.Return(arg0)
+func (c *TransactionRollbackBlockSignaturesCall) Return(err error) *TransactionRollbackBlockSignaturesCall {
+	c.Call = c.Call.Return(err)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionRollbackGrantsCall) Do(f func(context.Context, types.Level) error) *TransactionRollbackGrantsCall {
+func (c *TransactionRollbackBlockSignaturesCall) Do(f func(context.Context, types0.Level) error) *TransactionRollbackBlockSignaturesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionRollbackGrantsCall) DoAndReturn(f func(context.Context, types.Level) error) *TransactionRollbackGrantsCall {
+func (c *TransactionRollbackBlockSignaturesCall) DoAndReturn(f func(context.Context, types0.Level) error) *TransactionRollbackBlockSignaturesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackJails mocks base method.
-func (m *MockTransaction) RollbackJails(ctx context.Context, height types.Level) ([]storage.Jail, error) {
+// RollbackBlockStats mocks base method.
+func (m *MockTransaction) RollbackBlockStats(ctx context.Context, height types0.Level) (storage.BlockStats, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackJails", ctx, height)
-	ret0, _ := ret[0].([]storage.Jail)
+	ret := m.ctrl.Call(m, "RollbackBlockStats", ctx, height)
+	ret0, _ := ret[0].(storage.BlockStats)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RollbackJails indicates an expected call of RollbackJails.
-func (mr *MockTransactionMockRecorder) RollbackJails(ctx, height any) *TransactionRollbackJailsCall {
+// RollbackBlockStats indicates an expected call of RollbackBlockStats.
+func (mr *MockTransactionMockRecorder) RollbackBlockStats(ctx, height any) *TransactionRollbackBlockStatsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackJails", reflect.TypeOf((*MockTransaction)(nil).RollbackJails), ctx, height)
-	return &TransactionRollbackJailsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackBlockStats", reflect.TypeOf((*MockTransaction)(nil).RollbackBlockStats), ctx, height)
+	return &TransactionRollbackBlockStatsCall{Call: call}
 }
 
-// TransactionRollbackJailsCall wrap *gomock.Call
-type TransactionRollbackJailsCall struct {
+// TransactionRollbackBlockStatsCall wrap *gomock.Call
+type TransactionRollbackBlockStatsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionRollbackJailsCall) Return(arg0 []storage.Jail, arg1 error) *TransactionRollbackJailsCall {
-	c.Call = c.Call.Return(arg0, arg1)
+func (c *TransactionRollbackBlockStatsCall) Return(stats storage.BlockStats, err error) *TransactionRollbackBlockStatsCall {
+	c.Call = c.Call.Return(stats, err)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionRollbackJailsCall) Do(f func(context.Context, types.Level) ([]storage.Jail, error)) *TransactionRollbackJailsCall {
+func (c *TransactionRollbackBlockStatsCall) Do(f func(context.Context, types0.Level) (storage.BlockStats, error)) *TransactionRollbackBlockStatsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionRollbackJailsCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.Jail, error)) *TransactionRollbackJailsCall {
+func (c *TransactionRollbackBlockStatsCall) DoAndReturn(f func(context.Context, types0.Level) (storage.BlockStats, error)) *TransactionRollbackBlockStatsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackMessageAddresses mocks base method.
-func (m *MockTransaction) RollbackMessageAddresses(ctx context.Context, msgIds []uint64) error {
+// RollbackConstantHistory mocks base method.
+func (m *MockTransaction) RollbackConstantHistory(ctx context.Context, height types0.Level) ([]storage.ConstantHistory, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackMessageAddresses", ctx, msgIds)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "RollbackConstantHistory", ctx, height)
+	ret0, _ := ret[0].([]storage.ConstantHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// RollbackMessageAddresses indicates an expected call of RollbackMessageAddresses.
-func (mr *MockTransactionMockRecorder) RollbackMessageAddresses(ctx, msgIds any) *TransactionRollbackMessageAddressesCall {
+// RollbackConstantHistory indicates an expected call of RollbackConstantHistory.
+func (mr *MockTransactionMockRecorder) RollbackConstantHistory(ctx, height any) *TransactionRollbackConstantHistoryCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackMessageAddresses", reflect.TypeOf((*MockTransaction)(nil).RollbackMessageAddresses), ctx, msgIds)
-	return &TransactionRollbackMessageAddressesCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackConstantHistory", reflect.TypeOf((*MockTransaction)(nil).RollbackConstantHistory), ctx, height)
+	return &TransactionRollbackConstantHistoryCall{Call: call}
 }
 
-// TransactionRollbackMessageAddressesCall wrap *gomock.Call
-type TransactionRollbackMessageAddressesCall struct {
+// TransactionRollbackConstantHistoryCall wrap *gomock.Call
+type TransactionRollbackConstantHistoryCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionRollbackMessageAddressesCall) Return(err error) *TransactionRollbackMessageAddressesCall {
-	c.Call = c.Call.Return(err)
+func (c *TransactionRollbackConstantHistoryCall) Return(arg0 []storage.ConstantHistory, arg1 error) *TransactionRollbackConstantHistoryCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionRollbackMessageAddressesCall) Do(f func(context.Context, []uint64) error) *TransactionRollbackMessageAddressesCall {
+func (c *TransactionRollbackConstantHistoryCall) Do(f func(context.Context, types0.Level) ([]storage.ConstantHistory, error)) *TransactionRollbackConstantHistoryCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionRollbackMessageAddressesCall) DoAndReturn(f func(context.Context, []uint64) error) *TransactionRollbackMessageAddressesCall {
+func (c *TransactionRollbackConstantHistoryCall) DoAndReturn(f func(context.Context, types0.Level) ([]storage.ConstantHistory, error)) *TransactionRollbackConstantHistoryCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackMessages mocks base method.
-func (m *MockTransaction) RollbackMessages(ctx context.Context, height types.Level) ([]storage.Message, error) {
+// RollbackDeposits mocks base method.
+func (m *MockTransaction) RollbackDeposits(ctx context.Context, height types0.Level) ([]storage.Deposit, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackMessages", ctx, height)
-	ret0, _ := ret[0].([]storage.Message)
+	ret := m.ctrl.Call(m, "RollbackDeposits", ctx, height)
+	ret0, _ := ret[0].([]storage.Deposit)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RollbackMessages indicates an expected call of RollbackMessages.
-func (mr *MockTransactionMockRecorder) RollbackMessages(ctx, height any) *TransactionRollbackMessagesCall {
+// RollbackDeposits indicates an expected call of RollbackDeposits.
+func (mr *MockTransactionMockRecorder) RollbackDeposits(ctx, height any) *TransactionRollbackDepositsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackMessages", reflect.TypeOf((*MockTransaction)(nil).RollbackMessages), ctx, height)
-	return &TransactionRollbackMessagesCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackDeposits", reflect.TypeOf((*MockTransaction)(nil).RollbackDeposits), ctx, height)
+	return &TransactionRollbackDepositsCall{Call: call}
 }
 
-// TransactionRollbackMessagesCall wrap *gomock.Call
-type TransactionRollbackMessagesCall struct {
+// TransactionRollbackDepositsCall wrap *gomock.Call
+type TransactionRollbackDepositsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionRollbackMessagesCall) Return(msgs []storage.Message, err error) *TransactionRollbackMessagesCall {
-	c.Call = c.Call.Return(msgs, err)
+func (c *TransactionRollbackDepositsCall) Return(arg0 []storage.Deposit, arg1 error) *TransactionRollbackDepositsCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionRollbackMessagesCall) Do(f func(context.Context, types.Level) ([]storage.Message, error)) *TransactionRollbackMessagesCall {
+func (c *TransactionRollbackDepositsCall) Do(f func(context.Context, types0.Level) ([]storage.Deposit, error)) *TransactionRollbackDepositsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionRollbackMessagesCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.Message, error)) *TransactionRollbackMessagesCall {
+func (c *TransactionRollbackDepositsCall) DoAndReturn(f func(context.Context, types0.Level) ([]storage.Deposit, error)) *TransactionRollbackDepositsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackNamespaceMessages mocks base method.
-func (m *MockTransaction) RollbackNamespaceMessages(ctx context.Context, height types.Level) ([]storage.NamespaceMessage, error) {
+// RollbackEvents mocks base method.
+func (m *MockTransaction) RollbackEvents(ctx context.Context, height types0.Level) ([]storage.Event, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackNamespaceMessages", ctx, height)
-	ret0, _ := ret[0].([]storage.NamespaceMessage)
+	ret := m.ctrl.Call(m, "RollbackEvents", ctx, height)
+	ret0, _ := ret[0].([]storage.Event)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RollbackNamespaceMessages indicates an expected call of RollbackNamespaceMessages.
-func (mr *MockTransactionMockRecorder) RollbackNamespaceMessages(ctx, height any) *TransactionRollbackNamespaceMessagesCall {
+// RollbackEvents indicates an expected call of RollbackEvents.
+func (mr *MockTransactionMockRecorder) RollbackEvents(ctx, height any) *TransactionRollbackEventsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackNamespaceMessages", reflect.TypeOf((*MockTransaction)(nil).RollbackNamespaceMessages), ctx, height)
-	return &TransactionRollbackNamespaceMessagesCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackEvents", reflect.TypeOf((*MockTransaction)(nil).RollbackEvents), ctx, height)
+	return &TransactionRollbackEventsCall{Call: call}
 }
 
-// TransactionRollbackNamespaceMessagesCall wrap *gomock.Call
-type TransactionRollbackNamespaceMessagesCall struct {
+// TransactionRollbackEventsCall wrap *gomock.Call
+type TransactionRollbackEventsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionRollbackNamespaceMessagesCall) Return(msgs []storage.NamespaceMessage, err error) *TransactionRollbackNamespaceMessagesCall {
-	c.Call = c.Call.Return(msgs, err)
+func (c *TransactionRollbackEventsCall) Return(events []storage.Event, err error) *TransactionRollbackEventsCall {
+	c.Call = c.Call.Return(events, err)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionRollbackNamespaceMessagesCall) Do(f func(context.Context, types.Level) ([]storage.NamespaceMessage, error)) *TransactionRollbackNamespaceMessagesCall {
+func (c *TransactionRollbackEventsCall) Do(f func(context.Context, types0.Level) ([]storage.Event, error)) *TransactionRollbackEventsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionRollbackNamespaceMessagesCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.NamespaceMessage, error)) *TransactionRollbackNamespaceMessagesCall {
+func (c *TransactionRollbackEventsCall) DoAndReturn(f func(context.Context, types0.Level) ([]storage.Event, error)) *TransactionRollbackEventsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackNamespaces mocks base method.
-func (m *MockTransaction) RollbackNamespaces(ctx context.Context, height types.Level) ([]storage.Namespace, error) {
+// RollbackGrants mocks base method.
+func (m *MockTransaction) RollbackGrants(ctx context.Context, height types0.Level) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackNamespaces", ctx, height)
-	ret0, _ := ret[0].([]storage.Namespace)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RollbackGrants", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// RollbackNamespaces indicates an expected call of RollbackNamespaces.
-func (mr *MockTransactionMockRecorder) RollbackNamespaces(ctx, height any) *TransactionRollbackNamespacesCall {
+// RollbackGrants indicates an expected call of RollbackGrants.
+func (mr *MockTransactionMockRecorder) RollbackGrants(ctx, height any) *TransactionRollbackGrantsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackNamespaces", reflect.TypeOf((*MockTransaction)(nil).RollbackNamespaces), ctx, height)
-	return &TransactionRollbackNamespacesCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackGrants", reflect.TypeOf((*MockTransaction)(nil).RollbackGrants), ctx, height)
+	return &TransactionRollbackGrantsCall{Call: call}
 }
 
-// TransactionRollbackNamespacesCall wrap *gomock.Call
-type TransactionRollbackNamespacesCall struct {
+// TransactionRollbackGrantsCall wrap *gomock.Call
+type TransactionRollbackGrantsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionRollbackNamespacesCall) Return(ns []storage.Namespace, err error) *TransactionRollbackNamespacesCall {
-	c.Call = c.Call.Return(ns, err)
+func (c *TransactionRollbackGrantsCall) Return(arg0 error) *TransactionRollbackGrantsCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionRollbackNamespacesCall) Do(f func(context.Context, types.Level) ([]storage.Namespace, error)) *TransactionRollbackNamespacesCall {
+func (c *TransactionRollbackGrantsCall) Do(f func(context.Context, types0.Level) error) *TransactionRollbackGrantsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionRollbackNamespacesCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.Namespace, error)) *TransactionRollbackNamespacesCall {
+func (c *TransactionRollbackGrantsCall) DoAndReturn(f func(context.Context, types0.Level) error) *TransactionRollbackGrantsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackRedelegations mocks base method.
-func (m *MockTransaction) RollbackRedelegations(ctx context.Context, height types.Level) error {
+// RollbackGroupMembers mocks base method.
+func (m *MockTransaction) RollbackGroupMembers(ctx context.Context, height types0.Level) ([]storage.GroupMember, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackRedelegations", ctx, height)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "RollbackGroupMembers", ctx, height)
+	ret0, _ := ret[0].([]storage.GroupMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// RollbackRedelegations indicates an expected call of RollbackRedelegations.
-func (mr *MockTransactionMockRecorder) RollbackRedelegations(ctx, height any) *TransactionRollbackRedelegationsCall {
+// RollbackGroupMembers indicates an expected call of RollbackGroupMembers.
+func (mr *MockTransactionMockRecorder) RollbackGroupMembers(ctx, height any) *TransactionRollbackGroupMembersCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackRedelegations", reflect.TypeOf((*MockTransaction)(nil).RollbackRedelegations), ctx, height)
-	return &TransactionRollbackRedelegationsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackGroupMembers", reflect.TypeOf((*MockTransaction)(nil).RollbackGroupMembers), ctx, height)
+	return &TransactionRollbackGroupMembersCall{Call: call}
 }
 
-// TransactionRollbackRedelegationsCall wrap *gomock.Call
-type TransactionRollbackRedelegationsCall struct {
+// TransactionRollbackGroupMembersCall wrap *gomock.Call
+type TransactionRollbackGroupMembersCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionRollbackRedelegationsCall) Return(err error) *TransactionRollbackRedelegationsCall {
-	c.Call = c.Call.Return(err)
+func (c *TransactionRollbackGroupMembersCall) Return(arg0 []storage.GroupMember, arg1 error) *TransactionRollbackGroupMembersCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionRollbackRedelegationsCall) Do(f func(context.Context, types.Level) error) *TransactionRollbackRedelegationsCall {
+func (c *TransactionRollbackGroupMembersCall) Do(f func(context.Context, types0.Level) ([]storage.GroupMember, error)) *TransactionRollbackGroupMembersCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionRollbackRedelegationsCall) DoAndReturn(f func(context.Context, types.Level) error) *TransactionRollbackRedelegationsCall {
+func (c *TransactionRollbackGroupMembersCall) DoAndReturn(f func(context.Context, types0.Level) ([]storage.GroupMember, error)) *TransactionRollbackGroupMembersCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackSigners mocks base method.
-func (m *MockTransaction) RollbackSigners(ctx context.Context, txIds []uint64) error {
+// RollbackGroupPolicies mocks base method.
+func (m *MockTransaction) RollbackGroupPolicies(ctx context.Context, height types0.Level) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackSigners", ctx, txIds)
+	ret := m.ctrl.Call(m, "RollbackGroupPolicies", ctx, height)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// RollbackSigners indicates an expected call of RollbackSigners.
-func (mr *MockTransactionMockRecorder) RollbackSigners(ctx, txIds any) *TransactionRollbackSignersCall {
+// RollbackGroupPolicies indicates an expected call of RollbackGroupPolicies.
+func (mr *MockTransactionMockRecorder) RollbackGroupPolicies(ctx, height any) *TransactionRollbackGroupPoliciesCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackSigners", reflect.TypeOf((*MockTransaction)(nil).RollbackSigners), ctx, txIds)
-	return &TransactionRollbackSignersCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackGroupPolicies", reflect.TypeOf((*MockTransaction)(nil).RollbackGroupPolicies), ctx, height)
+	return &TransactionRollbackGroupPoliciesCall{Call: call}
 }
 
-// TransactionRollbackSignersCall wrap *gomock.Call
-type TransactionRollbackSignersCall struct {
+// TransactionRollbackGroupPoliciesCall wrap *gomock.Call
+type TransactionRollbackGroupPoliciesCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionRollbackSignersCall) Return(err error) *TransactionRollbackSignersCall {
-	c.Call = c.Call.Return(err)
+func (c *TransactionRollbackGroupPoliciesCall) Return(arg0 error) *TransactionRollbackGroupPoliciesCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionRollbackSignersCall) Do(f func(context.Context, []uint64) error) *TransactionRollbackSignersCall {
+func (c *TransactionRollbackGroupPoliciesCall) Do(f func(context.Context, types0.Level) error) *TransactionRollbackGroupPoliciesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionRollbackSignersCall) DoAndReturn(f func(context.Context, []uint64) error) *TransactionRollbackSignersCall {
+func (c *TransactionRollbackGroupPoliciesCall) DoAndReturn(f func(context.Context, types0.Level) error) *TransactionRollbackGroupPoliciesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackStakingLogs mocks base method.
-func (m *MockTransaction) RollbackStakingLogs(ctx context.Context, height types.Level) ([]storage.StakingLog, error) {
+// RollbackGroupProposals mocks base method.
+func (m *MockTransaction) RollbackGroupProposals(ctx context.Context, height types0.Level) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackStakingLogs", ctx, height)
-	ret0, _ := ret[0].([]storage.StakingLog)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RollbackGroupProposals", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// RollbackStakingLogs indicates an expected call of RollbackStakingLogs.
-func (mr *MockTransactionMockRecorder) RollbackStakingLogs(ctx, height any) *TransactionRollbackStakingLogsCall {
+// RollbackGroupProposals indicates an expected call of RollbackGroupProposals.
+func (mr *MockTransactionMockRecorder) RollbackGroupProposals(ctx, height any) *TransactionRollbackGroupProposalsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackStakingLogs", reflect.TypeOf((*MockTransaction)(nil).RollbackStakingLogs), ctx, height)
-	return &TransactionRollbackStakingLogsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackGroupProposals", reflect.TypeOf((*MockTransaction)(nil).RollbackGroupProposals), ctx, height)
+	return &TransactionRollbackGroupProposalsCall{Call: call}
 }
 
-// TransactionRollbackStakingLogsCall wrap *gomock.Call
-type TransactionRollbackStakingLogsCall struct {
+// TransactionRollbackGroupProposalsCall wrap *gomock.Call
+type TransactionRollbackGroupProposalsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionRollbackStakingLogsCall) Return(arg0 []storage.StakingLog, arg1 error) *TransactionRollbackStakingLogsCall {
-	c.Call = c.Call.Return(arg0, arg1)
+func (c *TransactionRollbackGroupProposalsCall) Return(arg0 error) *TransactionRollbackGroupProposalsCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionRollbackStakingLogsCall) Do(f func(context.Context, types.Level) ([]storage.StakingLog, error)) *TransactionRollbackStakingLogsCall {
+func (c *TransactionRollbackGroupProposalsCall) Do(f func(context.Context, types0.Level) error) *TransactionRollbackGroupProposalsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionRollbackStakingLogsCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.StakingLog, error)) *TransactionRollbackStakingLogsCall {
+func (c *TransactionRollbackGroupProposalsCall) DoAndReturn(f func(context.Context, types0.Level) error) *TransactionRollbackGroupProposalsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackTxs mocks base method.
-func (m *MockTransaction) RollbackTxs(ctx context.Context, height types.Level) ([]storage.Tx, error) {
+// RollbackGroupUpdates mocks base method.
+func (m *MockTransaction) RollbackGroupUpdates(ctx context.Context, height types0.Level) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackTxs", ctx, height)
-	ret0, _ := ret[0].([]storage.Tx)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RollbackGroupUpdates", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// RollbackTxs indicates an expected call of RollbackTxs.
-func (mr *MockTransactionMockRecorder) RollbackTxs(ctx, height any) *TransactionRollbackTxsCall {
+// RollbackGroupUpdates indicates an expected call of RollbackGroupUpdates.
+func (mr *MockTransactionMockRecorder) RollbackGroupUpdates(ctx, height any) *TransactionRollbackGroupUpdatesCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackTxs", reflect.TypeOf((*MockTransaction)(nil).RollbackTxs), ctx, height)
-	return &TransactionRollbackTxsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackGroupUpdates", reflect.TypeOf((*MockTransaction)(nil).RollbackGroupUpdates), ctx, height)
+	return &TransactionRollbackGroupUpdatesCall{Call: call}
 }
 
-// TransactionRollbackTxsCall wrap *gomock.Call
-type TransactionRollbackTxsCall struct {
+// TransactionRollbackGroupUpdatesCall wrap *gomock.Call
+type TransactionRollbackGroupUpdatesCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionRollbackTxsCall) Return(txs []storage.Tx, err error) *TransactionRollbackTxsCall {
-	c.Call = c.Call.Return(txs, err)
+func (c *TransactionRollbackGroupUpdatesCall) Return(arg0 error) *TransactionRollbackGroupUpdatesCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionRollbackTxsCall) Do(f func(context.Context, types.Level) ([]storage.Tx, error)) *TransactionRollbackTxsCall {
+func (c *TransactionRollbackGroupUpdatesCall) Do(f func(context.Context, types0.Level) error) *TransactionRollbackGroupUpdatesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionRollbackTxsCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.Tx, error)) *TransactionRollbackTxsCall {
+func (c *TransactionRollbackGroupUpdatesCall) DoAndReturn(f func(context.Context, types0.Level) error) *TransactionRollbackGroupUpdatesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackUndelegations mocks base method.
-func (m *MockTransaction) RollbackUndelegations(ctx context.Context, height types.Level) error {
+// RollbackGroupVotes mocks base method.
+func (m *MockTransaction) RollbackGroupVotes(ctx context.Context, height types0.Level) ([]storage.GroupVote, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackUndelegations", ctx, height)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "RollbackGroupVotes", ctx, height)
+	ret0, _ := ret[0].([]storage.GroupVote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// RollbackUndelegations indicates an expected call of RollbackUndelegations.
-func (mr *MockTransactionMockRecorder) RollbackUndelegations(ctx, height any) *TransactionRollbackUndelegationsCall {
+// RollbackGroupVotes indicates an expected call of RollbackGroupVotes.
+func (mr *MockTransactionMockRecorder) RollbackGroupVotes(ctx, height any) *TransactionRollbackGroupVotesCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackUndelegations", reflect.TypeOf((*MockTransaction)(nil).RollbackUndelegations), ctx, height)
-	return &TransactionRollbackUndelegationsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackGroupVotes", reflect.TypeOf((*MockTransaction)(nil).RollbackGroupVotes), ctx, height)
+	return &TransactionRollbackGroupVotesCall{Call: call}
 }
 
-// TransactionRollbackUndelegationsCall wrap *gomock.Call
-type TransactionRollbackUndelegationsCall struct {
+// TransactionRollbackGroupVotesCall wrap *gomock.Call
+type TransactionRollbackGroupVotesCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionRollbackUndelegationsCall) Return(err error) *TransactionRollbackUndelegationsCall {
-	c.Call = c.Call.Return(err)
+func (c *TransactionRollbackGroupVotesCall) Return(arg0 []storage.GroupVote, arg1 error) *TransactionRollbackGroupVotesCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionRollbackUndelegationsCall) Do(f func(context.Context, types.Level) error) *TransactionRollbackUndelegationsCall {
+func (c *TransactionRollbackGroupVotesCall) Do(f func(context.Context, types0.Level) ([]storage.GroupVote, error)) *TransactionRollbackGroupVotesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionRollbackUndelegationsCall) DoAndReturn(f func(context.Context, types.Level) error) *TransactionRollbackUndelegationsCall {
+func (c *TransactionRollbackGroupVotesCall) DoAndReturn(f func(context.Context, types0.Level) ([]storage.GroupVote, error)) *TransactionRollbackGroupVotesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackValidators mocks base method.
-func (m *MockTransaction) RollbackValidators(ctx context.Context, height types.Level) ([]storage.Validator, error) {
+// RollbackGroups mocks base method.
+func (m *MockTransaction) RollbackGroups(ctx context.Context, height types0.Level) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackValidators", ctx, height)
-	ret0, _ := ret[0].([]storage.Validator)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RollbackGroups", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// RollbackValidators indicates an expected call of RollbackValidators.
-func (mr *MockTransactionMockRecorder) RollbackValidators(ctx, height any) *TransactionRollbackValidatorsCall {
+// RollbackGroups indicates an expected call of RollbackGroups.
+func (mr *MockTransactionMockRecorder) RollbackGroups(ctx, height any) *TransactionRollbackGroupsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackValidators", reflect.TypeOf((*MockTransaction)(nil).RollbackValidators), ctx, height)
-	return &TransactionRollbackValidatorsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackGroups", reflect.TypeOf((*MockTransaction)(nil).RollbackGroups), ctx, height)
+	return &TransactionRollbackGroupsCall{Call: call}
 }
 
-// TransactionRollbackValidatorsCall wrap *gomock.Call
-type TransactionRollbackValidatorsCall struct {
+// TransactionRollbackGroupsCall wrap *gomock.Call
+type TransactionRollbackGroupsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionRollbackValidatorsCall) Return(arg0 []storage.Validator, arg1 error) *TransactionRollbackValidatorsCall {
-	c.Call = c.Call.Return(arg0, arg1)
+func (c *TransactionRollbackGroupsCall) Return(arg0 error) *TransactionRollbackGroupsCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionRollbackValidatorsCall) Do(f func(context.Context, types.Level) ([]storage.Validator, error)) *TransactionRollbackValidatorsCall {
+func (c *TransactionRollbackGroupsCall) Do(f func(context.Context, types0.Level) error) *TransactionRollbackGroupsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionRollbackValidatorsCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.Validator, error)) *TransactionRollbackValidatorsCall {
+func (c *TransactionRollbackGroupsCall) DoAndReturn(f func(context.Context, types0.Level) error) *TransactionRollbackGroupsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackVestingAccounts mocks base method.
-func (m *MockTransaction) RollbackVestingAccounts(ctx context.Context, height types.Level) error {
+// RollbackIbcChannels mocks base method.
+func (m *MockTransaction) RollbackIbcChannels(ctx context.Context, height types0.Level) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackVestingAccounts", ctx, height)
+	ret := m.ctrl.Call(m, "RollbackIbcChannels", ctx, height)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// RollbackVestingAccounts indicates an expected call of RollbackVestingAccounts.
-func (mr *MockTransactionMockRecorder) RollbackVestingAccounts(ctx, height any) *TransactionRollbackVestingAccountsCall {
+// RollbackIbcChannels indicates an expected call of RollbackIbcChannels.
+func (mr *MockTransactionMockRecorder) RollbackIbcChannels(ctx, height any) *TransactionRollbackIbcChannelsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackVestingAccounts", reflect.TypeOf((*MockTransaction)(nil).RollbackVestingAccounts), ctx, height)
-	return &TransactionRollbackVestingAccountsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackIbcChannels", reflect.TypeOf((*MockTransaction)(nil).RollbackIbcChannels), ctx, height)
+	return &TransactionRollbackIbcChannelsCall{Call: call}
 }
 
-// TransactionRollbackVestingAccountsCall wrap *gomock.Call
-type TransactionRollbackVestingAccountsCall struct {
+// TransactionRollbackIbcChannelsCall wrap *gomock.Call
+type TransactionRollbackIbcChannelsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionRollbackVestingAccountsCall) Return(arg0 error) *TransactionRollbackVestingAccountsCall {
+func (c *TransactionRollbackIbcChannelsCall) Return(arg0 error) *TransactionRollbackIbcChannelsCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionRollbackVestingAccountsCall) Do(f func(context.Context, types.Level) error) *TransactionRollbackVestingAccountsCall {
+func (c *TransactionRollbackIbcChannelsCall) Do(f func(context.Context, types0.Level) error) *TransactionRollbackIbcChannelsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionRollbackVestingAccountsCall) DoAndReturn(f func(context.Context, types.Level) error) *TransactionRollbackVestingAccountsCall {
+func (c *TransactionRollbackIbcChannelsCall) DoAndReturn(f func(context.Context, types0.Level) error) *TransactionRollbackIbcChannelsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackVestingPeriods mocks base method.
-func (m *MockTransaction) RollbackVestingPeriods(ctx context.Context, height types.Level) error {
+// RollbackIbcClients mocks base method.
+func (m *MockTransaction) RollbackIbcClients(ctx context.Context, height types0.Level) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackVestingPeriods", ctx, height)
+	ret := m.ctrl.Call(m, "RollbackIbcClients", ctx, height)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// RollbackVestingPeriods indicates an expected call of RollbackVestingPeriods.
-func (mr *MockTransactionMockRecorder) RollbackVestingPeriods(ctx, height any) *TransactionRollbackVestingPeriodsCall {
+// RollbackIbcClients indicates an expected call of RollbackIbcClients.
+func (mr *MockTransactionMockRecorder) RollbackIbcClients(ctx, height any) *TransactionRollbackIbcClientsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackVestingPeriods", reflect.TypeOf((*MockTransaction)(nil).RollbackVestingPeriods), ctx, height)
-	return &TransactionRollbackVestingPeriodsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackIbcClients", reflect.TypeOf((*MockTransaction)(nil).RollbackIbcClients), ctx, height)
+	return &TransactionRollbackIbcClientsCall{Call: call}
 }
 
-// TransactionRollbackVestingPeriodsCall wrap *gomock.Call
-type TransactionRollbackVestingPeriodsCall struct {
+// TransactionRollbackIbcClientsCall wrap *gomock.Call
+type TransactionRollbackIbcClientsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionRollbackVestingPeriodsCall) Return(arg0 error) *TransactionRollbackVestingPeriodsCall {
+func (c *TransactionRollbackIbcClientsCall) Return(arg0 error) *TransactionRollbackIbcClientsCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionRollbackVestingPeriodsCall) Do(f func(context.Context, types.Level) error) *TransactionRollbackVestingPeriodsCall {
+func (c *TransactionRollbackIbcClientsCall) Do(f func(context.Context, types0.Level) error) *TransactionRollbackIbcClientsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionRollbackVestingPeriodsCall) DoAndReturn(f func(context.Context, types.Level) error) *TransactionRollbackVestingPeriodsCall {
+func (c *TransactionRollbackIbcClientsCall) DoAndReturn(f func(context.Context, types0.Level) error) *TransactionRollbackIbcClientsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// SaveAddresses mocks base method.
-func (m *MockTransaction) SaveAddresses(ctx context.Context, addresses ...*storage.Address) (int64, error) {
+// RollbackIbcConnections mocks base method.
+func (m *MockTransaction) RollbackIbcConnections(ctx context.Context, height types0.Level) error {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range addresses {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "SaveAddresses", varargs...)
-	ret0, _ := ret[0].(int64)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RollbackIbcConnections", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// SaveAddresses indicates an expected call of SaveAddresses.
-func (mr *MockTransactionMockRecorder) SaveAddresses(ctx any, addresses ...any) *TransactionSaveAddressesCall {
+// RollbackIbcConnections indicates an expected call of RollbackIbcConnections.
+func (mr *MockTransactionMockRecorder) RollbackIbcConnections(ctx, height any) *TransactionRollbackIbcConnectionsCall {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, addresses...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAddresses", reflect.TypeOf((*MockTransaction)(nil).SaveAddresses), varargs...)
-	return &TransactionSaveAddressesCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackIbcConnections", reflect.TypeOf((*MockTransaction)(nil).RollbackIbcConnections), ctx, height)
+	return &TransactionRollbackIbcConnectionsCall{Call: call}
 }
 
-// TransactionSaveAddressesCall wrap *gomock.Call
-type TransactionSaveAddressesCall struct {
+// TransactionRollbackIbcConnectionsCall wrap *gomock.Call
+type TransactionRollbackIbcConnectionsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionSaveAddressesCall) Return(arg0 int64, arg1 error) *TransactionSaveAddressesCall {
-	c.Call = c.Call.Return(arg0, arg1)
+func (c *TransactionRollbackIbcConnectionsCall) Return(arg0 error) *TransactionRollbackIbcConnectionsCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionSaveAddressesCall) Do(f func(context.Context, ...*storage.Address) (int64, error)) *TransactionSaveAddressesCall {
+func (c *TransactionRollbackIbcConnectionsCall) Do(f func(context.Context, types0.Level) error) *TransactionRollbackIbcConnectionsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionSaveAddressesCall) DoAndReturn(f func(context.Context, ...*storage.Address) (int64, error)) *TransactionSaveAddressesCall {
+func (c *TransactionRollbackIbcConnectionsCall) DoAndReturn(f func(context.Context, types0.Level) error) *TransactionRollbackIbcConnectionsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// SaveBalances mocks base method.
-func (m *MockTransaction) SaveBalances(ctx context.Context, balances ...storage.Balance) error {
+// RollbackIbcTransfers mocks base method.
+func (m *MockTransaction) RollbackIbcTransfers(ctx context.Context, height types0.Level) error {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range balances {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "SaveBalances", varargs...)
+	ret := m.ctrl.Call(m, "RollbackIbcTransfers", ctx, height)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SaveBalances indicates an expected call of SaveBalances.
-func (mr *MockTransactionMockRecorder) SaveBalances(ctx any, balances ...any) *TransactionSaveBalancesCall {
+// RollbackIbcTransfers indicates an expected call of RollbackIbcTransfers.
+func (mr *MockTransactionMockRecorder) RollbackIbcTransfers(ctx, height any) *TransactionRollbackIbcTransfersCall {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, balances...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBalances", reflect.TypeOf((*MockTransaction)(nil).SaveBalances), varargs...)
-	return &TransactionSaveBalancesCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackIbcTransfers", reflect.TypeOf((*MockTransaction)(nil).RollbackIbcTransfers), ctx, height)
+	return &TransactionRollbackIbcTransfersCall{Call: call}
 }
 
-// TransactionSaveBalancesCall wrap *gomock.Call
-type TransactionSaveBalancesCall struct {
+// TransactionRollbackIbcTransfersCall wrap *gomock.Call
+type TransactionRollbackIbcTransfersCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionSaveBalancesCall) Return(arg0 error) *TransactionSaveBalancesCall {
+func (c *TransactionRollbackIbcTransfersCall) Return(arg0 error) *TransactionRollbackIbcTransfersCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionSaveBalancesCall) Do(f func(context.Context, ...storage.Balance) error) *TransactionSaveBalancesCall {
+func (c *TransactionRollbackIbcTransfersCall) Do(f func(context.Context, types0.Level) error) *TransactionRollbackIbcTransfersCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionSaveBalancesCall) DoAndReturn(f func(context.Context, ...storage.Balance) error) *TransactionSaveBalancesCall {
+func (c *TransactionRollbackIbcTransfersCall) DoAndReturn(f func(context.Context, types0.Level) error) *TransactionRollbackIbcTransfersCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// SaveBlobLogs mocks base method.
-func (m *MockTransaction) SaveBlobLogs(ctx context.Context, logs ...storage.BlobLog) error {
+// RollbackInterchainAccountPackets mocks base method.
+func (m *MockTransaction) RollbackInterchainAccountPackets(ctx context.Context, height types0.Level) error {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range logs {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "SaveBlobLogs", varargs...)
+	ret := m.ctrl.Call(m, "RollbackInterchainAccountPackets", ctx, height)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SaveBlobLogs indicates an expected call of SaveBlobLogs.
-func (mr *MockTransactionMockRecorder) SaveBlobLogs(ctx any, logs ...any) *TransactionSaveBlobLogsCall {
+// RollbackInterchainAccountPackets indicates an expected call of RollbackInterchainAccountPackets.
+func (mr *MockTransactionMockRecorder) RollbackInterchainAccountPackets(ctx, height any) *TransactionRollbackInterchainAccountPacketsCall {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, logs...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBlobLogs", reflect.TypeOf((*MockTransaction)(nil).SaveBlobLogs), varargs...)
-	return &TransactionSaveBlobLogsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackInterchainAccountPackets", reflect.TypeOf((*MockTransaction)(nil).RollbackInterchainAccountPackets), ctx, height)
+	return &TransactionRollbackInterchainAccountPacketsCall{Call: call}
 }
 
-// TransactionSaveBlobLogsCall wrap *gomock.Call
-type TransactionSaveBlobLogsCall struct {
+// TransactionRollbackInterchainAccountPacketsCall wrap *gomock.Call
+type TransactionRollbackInterchainAccountPacketsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionSaveBlobLogsCall) Return(arg0 error) *TransactionSaveBlobLogsCall {
+func (c *TransactionRollbackInterchainAccountPacketsCall) Return(arg0 error) *TransactionRollbackInterchainAccountPacketsCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionSaveBlobLogsCall) Do(f func(context.Context, ...storage.BlobLog) error) *TransactionSaveBlobLogsCall {
+func (c *TransactionRollbackInterchainAccountPacketsCall) Do(f func(context.Context, types0.Level) error) *TransactionRollbackInterchainAccountPacketsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionSaveBlobLogsCall) DoAndReturn(f func(context.Context, ...storage.BlobLog) error) *TransactionSaveBlobLogsCall {
+func (c *TransactionRollbackInterchainAccountPacketsCall) DoAndReturn(f func(context.Context, types0.Level) error) *TransactionRollbackInterchainAccountPacketsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// SaveBlockSignatures mocks base method.
-func (m *MockTransaction) SaveBlockSignatures(ctx context.Context, signs ...storage.BlockSignature) error {
+// RollbackInterchainAccounts mocks base method.
+func (m *MockTransaction) RollbackInterchainAccounts(ctx context.Context, height types0.Level) error {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range signs {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "SaveBlockSignatures", varargs...)
+	ret := m.ctrl.Call(m, "RollbackInterchainAccounts", ctx, height)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SaveBlockSignatures indicates an expected call of SaveBlockSignatures.
-func (mr *MockTransactionMockRecorder) SaveBlockSignatures(ctx any, signs ...any) *TransactionSaveBlockSignaturesCall {
+// RollbackInterchainAccounts indicates an expected call of RollbackInterchainAccounts.
+func (mr *MockTransactionMockRecorder) RollbackInterchainAccounts(ctx, height any) *TransactionRollbackInterchainAccountsCall {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, signs...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBlockSignatures", reflect.TypeOf((*MockTransaction)(nil).SaveBlockSignatures), varargs...)
-	return &TransactionSaveBlockSignaturesCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackInterchainAccounts", reflect.TypeOf((*MockTransaction)(nil).RollbackInterchainAccounts), ctx, height)
+	return &TransactionRollbackInterchainAccountsCall{Call: call}
 }
 
-// TransactionSaveBlockSignaturesCall wrap *gomock.Call
-type TransactionSaveBlockSignaturesCall struct {
+// TransactionRollbackInterchainAccountsCall wrap *gomock.Call
+type TransactionRollbackInterchainAccountsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionSaveBlockSignaturesCall) Return(arg0 error) *TransactionSaveBlockSignaturesCall {
+func (c *TransactionRollbackInterchainAccountsCall) Return(arg0 error) *TransactionRollbackInterchainAccountsCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionSaveBlockSignaturesCall) Do(f func(context.Context, ...storage.BlockSignature) error) *TransactionSaveBlockSignaturesCall {
+func (c *TransactionRollbackInterchainAccountsCall) Do(f func(context.Context, types0.Level) error) *TransactionRollbackInterchainAccountsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionSaveBlockSignaturesCall) DoAndReturn(f func(context.Context, ...storage.BlockSignature) error) *TransactionSaveBlockSignaturesCall {
+func (c *TransactionRollbackInterchainAccountsCall) DoAndReturn(f func(context.Context, types0.Level) error) *TransactionRollbackInterchainAccountsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// SaveConstants mocks base method.
-func (m *MockTransaction) SaveConstants(ctx context.Context, constants ...storage.Constant) error {
+// RollbackJails mocks base method.
+func (m *MockTransaction) RollbackJails(ctx context.Context, height types0.Level) ([]storage.Jail, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range constants {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "SaveConstants", varargs...)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "RollbackJails", ctx, height)
+	ret0, _ := ret[0].([]storage.Jail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// SaveConstants indicates an expected call of SaveConstants.
-func (mr *MockTransactionMockRecorder) SaveConstants(ctx any, constants ...any) *TransactionSaveConstantsCall {
+// RollbackJails indicates an expected call of RollbackJails.
+func (mr *MockTransactionMockRecorder) RollbackJails(ctx, height any) *TransactionRollbackJailsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackJails", reflect.TypeOf((*MockTransaction)(nil).RollbackJails), ctx, height)
+	return &TransactionRollbackJailsCall{Call: call}
+}
+
+// TransactionRollbackJailsCall wrap *gomock.Call
+type TransactionRollbackJailsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackJailsCall) Return(arg0 []storage.Jail, arg1 error) *TransactionRollbackJailsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackJailsCall) Do(f func(context.Context, types0.Level) ([]storage.Jail, error)) *TransactionRollbackJailsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackJailsCall) DoAndReturn(f func(context.Context, types0.Level) ([]storage.Jail, error)) *TransactionRollbackJailsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackMessageAddresses mocks base method.
+func (m *MockTransaction) RollbackMessageAddresses(ctx context.Context, msgIds []uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackMessageAddresses", ctx, msgIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackMessageAddresses indicates an expected call of RollbackMessageAddresses.
+func (mr *MockTransactionMockRecorder) RollbackMessageAddresses(ctx, msgIds any) *TransactionRollbackMessageAddressesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackMessageAddresses", reflect.TypeOf((*MockTransaction)(nil).RollbackMessageAddresses), ctx, msgIds)
+	return &TransactionRollbackMessageAddressesCall{Call: call}
+}
+
+// TransactionRollbackMessageAddressesCall wrap *gomock.Call
+type TransactionRollbackMessageAddressesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackMessageAddressesCall) Return(err error) *TransactionRollbackMessageAddressesCall {
+	c.Call = c.Call.Return(err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackMessageAddressesCall) Do(f func(context.Context, []uint64) error) *TransactionRollbackMessageAddressesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackMessageAddressesCall) DoAndReturn(f func(context.Context, []uint64) error) *TransactionRollbackMessageAddressesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackMessages mocks base method.
+func (m *MockTransaction) RollbackMessages(ctx context.Context, height types0.Level) ([]storage.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackMessages", ctx, height)
+	ret0, _ := ret[0].([]storage.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackMessages indicates an expected call of RollbackMessages.
+func (mr *MockTransactionMockRecorder) RollbackMessages(ctx, height any) *TransactionRollbackMessagesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackMessages", reflect.TypeOf((*MockTransaction)(nil).RollbackMessages), ctx, height)
+	return &TransactionRollbackMessagesCall{Call: call}
+}
+
+// TransactionRollbackMessagesCall wrap *gomock.Call
+type TransactionRollbackMessagesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackMessagesCall) Return(msgs []storage.Message, err error) *TransactionRollbackMessagesCall {
+	c.Call = c.Call.Return(msgs, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackMessagesCall) Do(f func(context.Context, types0.Level) ([]storage.Message, error)) *TransactionRollbackMessagesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackMessagesCall) DoAndReturn(f func(context.Context, types0.Level) ([]storage.Message, error)) *TransactionRollbackMessagesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackMissedBlocks mocks base method.
+func (m *MockTransaction) RollbackMissedBlocks(ctx context.Context, height types0.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackMissedBlocks", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackMissedBlocks indicates an expected call of RollbackMissedBlocks.
+func (mr *MockTransactionMockRecorder) RollbackMissedBlocks(ctx, height any) *TransactionRollbackMissedBlocksCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackMissedBlocks", reflect.TypeOf((*MockTransaction)(nil).RollbackMissedBlocks), ctx, height)
+	return &TransactionRollbackMissedBlocksCall{Call: call}
+}
+
+// TransactionRollbackMissedBlocksCall wrap *gomock.Call
+type TransactionRollbackMissedBlocksCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackMissedBlocksCall) Return(arg0 error) *TransactionRollbackMissedBlocksCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackMissedBlocksCall) Do(f func(context.Context, types0.Level) error) *TransactionRollbackMissedBlocksCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackMissedBlocksCall) DoAndReturn(f func(context.Context, types0.Level) error) *TransactionRollbackMissedBlocksCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackNamespaceMessages mocks base method.
+func (m *MockTransaction) RollbackNamespaceMessages(ctx context.Context, height types0.Level) ([]storage.NamespaceMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackNamespaceMessages", ctx, height)
+	ret0, _ := ret[0].([]storage.NamespaceMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackNamespaceMessages indicates an expected call of RollbackNamespaceMessages.
+func (mr *MockTransactionMockRecorder) RollbackNamespaceMessages(ctx, height any) *TransactionRollbackNamespaceMessagesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackNamespaceMessages", reflect.TypeOf((*MockTransaction)(nil).RollbackNamespaceMessages), ctx, height)
+	return &TransactionRollbackNamespaceMessagesCall{Call: call}
+}
+
+// TransactionRollbackNamespaceMessagesCall wrap *gomock.Call
+type TransactionRollbackNamespaceMessagesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackNamespaceMessagesCall) Return(msgs []storage.NamespaceMessage, err error) *TransactionRollbackNamespaceMessagesCall {
+	c.Call = c.Call.Return(msgs, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackNamespaceMessagesCall) Do(f func(context.Context, types0.Level) ([]storage.NamespaceMessage, error)) *TransactionRollbackNamespaceMessagesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackNamespaceMessagesCall) DoAndReturn(f func(context.Context, types0.Level) ([]storage.NamespaceMessage, error)) *TransactionRollbackNamespaceMessagesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackNamespaces mocks base method.
+func (m *MockTransaction) RollbackNamespaces(ctx context.Context, height types0.Level) ([]storage.Namespace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackNamespaces", ctx, height)
+	ret0, _ := ret[0].([]storage.Namespace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackNamespaces indicates an expected call of RollbackNamespaces.
+func (mr *MockTransactionMockRecorder) RollbackNamespaces(ctx, height any) *TransactionRollbackNamespacesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackNamespaces", reflect.TypeOf((*MockTransaction)(nil).RollbackNamespaces), ctx, height)
+	return &TransactionRollbackNamespacesCall{Call: call}
+}
+
+// TransactionRollbackNamespacesCall wrap *gomock.Call
+type TransactionRollbackNamespacesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackNamespacesCall) Return(ns []storage.Namespace, err error) *TransactionRollbackNamespacesCall {
+	c.Call = c.Call.Return(ns, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackNamespacesCall) Do(f func(context.Context, types0.Level) ([]storage.Namespace, error)) *TransactionRollbackNamespacesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackNamespacesCall) DoAndReturn(f func(context.Context, types0.Level) ([]storage.Namespace, error)) *TransactionRollbackNamespacesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackProposals mocks base method.
+func (m *MockTransaction) RollbackProposals(ctx context.Context, height types0.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackProposals", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackProposals indicates an expected call of RollbackProposals.
+func (mr *MockTransactionMockRecorder) RollbackProposals(ctx, height any) *TransactionRollbackProposalsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackProposals", reflect.TypeOf((*MockTransaction)(nil).RollbackProposals), ctx, height)
+	return &TransactionRollbackProposalsCall{Call: call}
+}
+
+// TransactionRollbackProposalsCall wrap *gomock.Call
+type TransactionRollbackProposalsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackProposalsCall) Return(arg0 error) *TransactionRollbackProposalsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackProposalsCall) Do(f func(context.Context, types0.Level) error) *TransactionRollbackProposalsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackProposalsCall) DoAndReturn(f func(context.Context, types0.Level) error) *TransactionRollbackProposalsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackRedelegations mocks base method.
+func (m *MockTransaction) RollbackRedelegations(ctx context.Context, height types0.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackRedelegations", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackRedelegations indicates an expected call of RollbackRedelegations.
+func (mr *MockTransactionMockRecorder) RollbackRedelegations(ctx, height any) *TransactionRollbackRedelegationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackRedelegations", reflect.TypeOf((*MockTransaction)(nil).RollbackRedelegations), ctx, height)
+	return &TransactionRollbackRedelegationsCall{Call: call}
+}
+
+// TransactionRollbackRedelegationsCall wrap *gomock.Call
+type TransactionRollbackRedelegationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackRedelegationsCall) Return(err error) *TransactionRollbackRedelegationsCall {
+	c.Call = c.Call.Return(err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackRedelegationsCall) Do(f func(context.Context, types0.Level) error) *TransactionRollbackRedelegationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackRedelegationsCall) DoAndReturn(f func(context.Context, types0.Level) error) *TransactionRollbackRedelegationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackSigners mocks base method.
+func (m *MockTransaction) RollbackSigners(ctx context.Context, txIds []uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackSigners", ctx, txIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackSigners indicates an expected call of RollbackSigners.
+func (mr *MockTransactionMockRecorder) RollbackSigners(ctx, txIds any) *TransactionRollbackSignersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackSigners", reflect.TypeOf((*MockTransaction)(nil).RollbackSigners), ctx, txIds)
+	return &TransactionRollbackSignersCall{Call: call}
+}
+
+// TransactionRollbackSignersCall wrap *gomock.Call
+type TransactionRollbackSignersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackSignersCall) Return(err error) *TransactionRollbackSignersCall {
+	c.Call = c.Call.Return(err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackSignersCall) Do(f func(context.Context, []uint64) error) *TransactionRollbackSignersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackSignersCall) DoAndReturn(f func(context.Context, []uint64) error) *TransactionRollbackSignersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackSlashes mocks base method.
+func (m *MockTransaction) RollbackSlashes(ctx context.Context, height types0.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackSlashes", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackSlashes indicates an expected call of RollbackSlashes.
+func (mr *MockTransactionMockRecorder) RollbackSlashes(ctx, height any) *TransactionRollbackSlashesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackSlashes", reflect.TypeOf((*MockTransaction)(nil).RollbackSlashes), ctx, height)
+	return &TransactionRollbackSlashesCall{Call: call}
+}
+
+// TransactionRollbackSlashesCall wrap *gomock.Call
+type TransactionRollbackSlashesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackSlashesCall) Return(arg0 error) *TransactionRollbackSlashesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackSlashesCall) Do(f func(context.Context, types0.Level) error) *TransactionRollbackSlashesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackSlashesCall) DoAndReturn(f func(context.Context, types0.Level) error) *TransactionRollbackSlashesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackStakingLogs mocks base method.
+func (m *MockTransaction) RollbackStakingLogs(ctx context.Context, height types0.Level) ([]storage.StakingLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackStakingLogs", ctx, height)
+	ret0, _ := ret[0].([]storage.StakingLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackStakingLogs indicates an expected call of RollbackStakingLogs.
+func (mr *MockTransactionMockRecorder) RollbackStakingLogs(ctx, height any) *TransactionRollbackStakingLogsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackStakingLogs", reflect.TypeOf((*MockTransaction)(nil).RollbackStakingLogs), ctx, height)
+	return &TransactionRollbackStakingLogsCall{Call: call}
+}
+
+// TransactionRollbackStakingLogsCall wrap *gomock.Call
+type TransactionRollbackStakingLogsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackStakingLogsCall) Return(arg0 []storage.StakingLog, arg1 error) *TransactionRollbackStakingLogsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackStakingLogsCall) Do(f func(context.Context, types0.Level) ([]storage.StakingLog, error)) *TransactionRollbackStakingLogsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackStakingLogsCall) DoAndReturn(f func(context.Context, types0.Level) ([]storage.StakingLog, error)) *TransactionRollbackStakingLogsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackTransfers mocks base method.
+func (m *MockTransaction) RollbackTransfers(ctx context.Context, height types0.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackTransfers", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackTransfers indicates an expected call of RollbackTransfers.
+func (mr *MockTransactionMockRecorder) RollbackTransfers(ctx, height any) *TransactionRollbackTransfersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackTransfers", reflect.TypeOf((*MockTransaction)(nil).RollbackTransfers), ctx, height)
+	return &TransactionRollbackTransfersCall{Call: call}
+}
+
+// TransactionRollbackTransfersCall wrap *gomock.Call
+type TransactionRollbackTransfersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackTransfersCall) Return(arg0 error) *TransactionRollbackTransfersCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackTransfersCall) Do(f func(context.Context, types0.Level) error) *TransactionRollbackTransfersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackTransfersCall) DoAndReturn(f func(context.Context, types0.Level) error) *TransactionRollbackTransfersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackTxAddresses mocks base method.
+func (m *MockTransaction) RollbackTxAddresses(ctx context.Context, txIds []uint64) ([]storage.TxAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackTxAddresses", ctx, txIds)
+	ret0, _ := ret[0].([]storage.TxAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackTxAddresses indicates an expected call of RollbackTxAddresses.
+func (mr *MockTransactionMockRecorder) RollbackTxAddresses(ctx, txIds any) *TransactionRollbackTxAddressesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackTxAddresses", reflect.TypeOf((*MockTransaction)(nil).RollbackTxAddresses), ctx, txIds)
+	return &TransactionRollbackTxAddressesCall{Call: call}
+}
+
+// TransactionRollbackTxAddressesCall wrap *gomock.Call
+type TransactionRollbackTxAddressesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackTxAddressesCall) Return(addresses []storage.TxAddress, err error) *TransactionRollbackTxAddressesCall {
+	c.Call = c.Call.Return(addresses, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackTxAddressesCall) Do(f func(context.Context, []uint64) ([]storage.TxAddress, error)) *TransactionRollbackTxAddressesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackTxAddressesCall) DoAndReturn(f func(context.Context, []uint64) ([]storage.TxAddress, error)) *TransactionRollbackTxAddressesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackTxs mocks base method.
+func (m *MockTransaction) RollbackTxs(ctx context.Context, height types0.Level) ([]storage.Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackTxs", ctx, height)
+	ret0, _ := ret[0].([]storage.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackTxs indicates an expected call of RollbackTxs.
+func (mr *MockTransactionMockRecorder) RollbackTxs(ctx, height any) *TransactionRollbackTxsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackTxs", reflect.TypeOf((*MockTransaction)(nil).RollbackTxs), ctx, height)
+	return &TransactionRollbackTxsCall{Call: call}
+}
+
+// TransactionRollbackTxsCall wrap *gomock.Call
+type TransactionRollbackTxsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackTxsCall) Return(txs []storage.Tx, err error) *TransactionRollbackTxsCall {
+	c.Call = c.Call.Return(txs, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackTxsCall) Do(f func(context.Context, types0.Level) ([]storage.Tx, error)) *TransactionRollbackTxsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackTxsCall) DoAndReturn(f func(context.Context, types0.Level) ([]storage.Tx, error)) *TransactionRollbackTxsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackUndelegations mocks base method.
+func (m *MockTransaction) RollbackUndelegations(ctx context.Context, height types0.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackUndelegations", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackUndelegations indicates an expected call of RollbackUndelegations.
+func (mr *MockTransactionMockRecorder) RollbackUndelegations(ctx, height any) *TransactionRollbackUndelegationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackUndelegations", reflect.TypeOf((*MockTransaction)(nil).RollbackUndelegations), ctx, height)
+	return &TransactionRollbackUndelegationsCall{Call: call}
+}
+
+// TransactionRollbackUndelegationsCall wrap *gomock.Call
+type TransactionRollbackUndelegationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackUndelegationsCall) Return(err error) *TransactionRollbackUndelegationsCall {
+	c.Call = c.Call.Return(err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackUndelegationsCall) Do(f func(context.Context, types0.Level) error) *TransactionRollbackUndelegationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackUndelegationsCall) DoAndReturn(f func(context.Context, types0.Level) error) *TransactionRollbackUndelegationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackUpgrades mocks base method.
+func (m *MockTransaction) RollbackUpgrades(ctx context.Context, height types0.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackUpgrades", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackUpgrades indicates an expected call of RollbackUpgrades.
+func (mr *MockTransactionMockRecorder) RollbackUpgrades(ctx, height any) *TransactionRollbackUpgradesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackUpgrades", reflect.TypeOf((*MockTransaction)(nil).RollbackUpgrades), ctx, height)
+	return &TransactionRollbackUpgradesCall{Call: call}
+}
+
+// TransactionRollbackUpgradesCall wrap *gomock.Call
+type TransactionRollbackUpgradesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackUpgradesCall) Return(arg0 error) *TransactionRollbackUpgradesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackUpgradesCall) Do(f func(context.Context, types0.Level) error) *TransactionRollbackUpgradesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackUpgradesCall) DoAndReturn(f func(context.Context, types0.Level) error) *TransactionRollbackUpgradesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackValidatorHistory mocks base method.
+func (m *MockTransaction) RollbackValidatorHistory(ctx context.Context, height types0.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackValidatorHistory", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackValidatorHistory indicates an expected call of RollbackValidatorHistory.
+func (mr *MockTransactionMockRecorder) RollbackValidatorHistory(ctx, height any) *TransactionRollbackValidatorHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackValidatorHistory", reflect.TypeOf((*MockTransaction)(nil).RollbackValidatorHistory), ctx, height)
+	return &TransactionRollbackValidatorHistoryCall{Call: call}
+}
+
+// TransactionRollbackValidatorHistoryCall wrap *gomock.Call
+type TransactionRollbackValidatorHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackValidatorHistoryCall) Return(arg0 error) *TransactionRollbackValidatorHistoryCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackValidatorHistoryCall) Do(f func(context.Context, types0.Level) error) *TransactionRollbackValidatorHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackValidatorHistoryCall) DoAndReturn(f func(context.Context, types0.Level) error) *TransactionRollbackValidatorHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackValidators mocks base method.
+func (m *MockTransaction) RollbackValidators(ctx context.Context, height types0.Level) ([]storage.Validator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackValidators", ctx, height)
+	ret0, _ := ret[0].([]storage.Validator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackValidators indicates an expected call of RollbackValidators.
+func (mr *MockTransactionMockRecorder) RollbackValidators(ctx, height any) *TransactionRollbackValidatorsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackValidators", reflect.TypeOf((*MockTransaction)(nil).RollbackValidators), ctx, height)
+	return &TransactionRollbackValidatorsCall{Call: call}
+}
+
+// TransactionRollbackValidatorsCall wrap *gomock.Call
+type TransactionRollbackValidatorsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackValidatorsCall) Return(arg0 []storage.Validator, arg1 error) *TransactionRollbackValidatorsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackValidatorsCall) Do(f func(context.Context, types0.Level) ([]storage.Validator, error)) *TransactionRollbackValidatorsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackValidatorsCall) DoAndReturn(f func(context.Context, types0.Level) ([]storage.Validator, error)) *TransactionRollbackValidatorsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackVestingAccounts mocks base method.
+func (m *MockTransaction) RollbackVestingAccounts(ctx context.Context, height types0.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackVestingAccounts", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackVestingAccounts indicates an expected call of RollbackVestingAccounts.
+func (mr *MockTransactionMockRecorder) RollbackVestingAccounts(ctx, height any) *TransactionRollbackVestingAccountsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackVestingAccounts", reflect.TypeOf((*MockTransaction)(nil).RollbackVestingAccounts), ctx, height)
+	return &TransactionRollbackVestingAccountsCall{Call: call}
+}
+
+// TransactionRollbackVestingAccountsCall wrap *gomock.Call
+type TransactionRollbackVestingAccountsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackVestingAccountsCall) Return(arg0 error) *TransactionRollbackVestingAccountsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackVestingAccountsCall) Do(f func(context.Context, types0.Level) error) *TransactionRollbackVestingAccountsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackVestingAccountsCall) DoAndReturn(f func(context.Context, types0.Level) error) *TransactionRollbackVestingAccountsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackVestingPeriods mocks base method.
+func (m *MockTransaction) RollbackVestingPeriods(ctx context.Context, height types0.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackVestingPeriods", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackVestingPeriods indicates an expected call of RollbackVestingPeriods.
+func (mr *MockTransactionMockRecorder) RollbackVestingPeriods(ctx, height any) *TransactionRollbackVestingPeriodsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackVestingPeriods", reflect.TypeOf((*MockTransaction)(nil).RollbackVestingPeriods), ctx, height)
+	return &TransactionRollbackVestingPeriodsCall{Call: call}
+}
+
+// TransactionRollbackVestingPeriodsCall wrap *gomock.Call
+type TransactionRollbackVestingPeriodsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackVestingPeriodsCall) Return(arg0 error) *TransactionRollbackVestingPeriodsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackVestingPeriodsCall) Do(f func(context.Context, types0.Level) error) *TransactionRollbackVestingPeriodsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackVestingPeriodsCall) DoAndReturn(f func(context.Context, types0.Level) error) *TransactionRollbackVestingPeriodsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackVotes mocks base method.
+func (m *MockTransaction) RollbackVotes(ctx context.Context, height types0.Level) ([]storage.Vote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackVotes", ctx, height)
+	ret0, _ := ret[0].([]storage.Vote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackVotes indicates an expected call of RollbackVotes.
+func (mr *MockTransactionMockRecorder) RollbackVotes(ctx, height any) *TransactionRollbackVotesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackVotes", reflect.TypeOf((*MockTransaction)(nil).RollbackVotes), ctx, height)
+	return &TransactionRollbackVotesCall{Call: call}
+}
+
+// TransactionRollbackVotesCall wrap *gomock.Call
+type TransactionRollbackVotesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackVotesCall) Return(arg0 []storage.Vote, arg1 error) *TransactionRollbackVotesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackVotesCall) Do(f func(context.Context, types0.Level) ([]storage.Vote, error)) *TransactionRollbackVotesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackVotesCall) DoAndReturn(f func(context.Context, types0.Level) ([]storage.Vote, error)) *TransactionRollbackVotesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveAddresses mocks base method.
+func (m *MockTransaction) SaveAddresses(ctx context.Context, addresses ...*storage.Address) (int64, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range addresses {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveAddresses", varargs...)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveAddresses indicates an expected call of SaveAddresses.
+func (mr *MockTransactionMockRecorder) SaveAddresses(ctx any, addresses ...any) *TransactionSaveAddressesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, addresses...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAddresses", reflect.TypeOf((*MockTransaction)(nil).SaveAddresses), varargs...)
+	return &TransactionSaveAddressesCall{Call: call}
+}
+
+// TransactionSaveAddressesCall wrap *gomock.Call
+type TransactionSaveAddressesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveAddressesCall) Return(arg0 int64, arg1 error) *TransactionSaveAddressesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveAddressesCall) Do(f func(context.Context, ...*storage.Address) (int64, error)) *TransactionSaveAddressesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveAddressesCall) DoAndReturn(f func(context.Context, ...*storage.Address) (int64, error)) *TransactionSaveAddressesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveBalanceHistory mocks base method.
+func (m *MockTransaction) SaveBalanceHistory(ctx context.Context, history ...storage.BalanceHistory) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range history {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveBalanceHistory", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveBalanceHistory indicates an expected call of SaveBalanceHistory.
+func (mr *MockTransactionMockRecorder) SaveBalanceHistory(ctx any, history ...any) *TransactionSaveBalanceHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, history...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBalanceHistory", reflect.TypeOf((*MockTransaction)(nil).SaveBalanceHistory), varargs...)
+	return &TransactionSaveBalanceHistoryCall{Call: call}
+}
+
+// TransactionSaveBalanceHistoryCall wrap *gomock.Call
+type TransactionSaveBalanceHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveBalanceHistoryCall) Return(arg0 error) *TransactionSaveBalanceHistoryCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveBalanceHistoryCall) Do(f func(context.Context, ...storage.BalanceHistory) error) *TransactionSaveBalanceHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveBalanceHistoryCall) DoAndReturn(f func(context.Context, ...storage.BalanceHistory) error) *TransactionSaveBalanceHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveBalances mocks base method.
+func (m *MockTransaction) SaveBalances(ctx context.Context, balances ...storage.Balance) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range balances {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveBalances", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveBalances indicates an expected call of SaveBalances.
+func (mr *MockTransactionMockRecorder) SaveBalances(ctx any, balances ...any) *TransactionSaveBalancesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, balances...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBalances", reflect.TypeOf((*MockTransaction)(nil).SaveBalances), varargs...)
+	return &TransactionSaveBalancesCall{Call: call}
+}
+
+// TransactionSaveBalancesCall wrap *gomock.Call
+type TransactionSaveBalancesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveBalancesCall) Return(arg0 error) *TransactionSaveBalancesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveBalancesCall) Do(f func(context.Context, ...storage.Balance) error) *TransactionSaveBalancesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveBalancesCall) DoAndReturn(f func(context.Context, ...storage.Balance) error) *TransactionSaveBalancesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveBlobLogs mocks base method.
+func (m *MockTransaction) SaveBlobLogs(ctx context.Context, logs ...storage.BlobLog) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range logs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveBlobLogs", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveBlobLogs indicates an expected call of SaveBlobLogs.
+func (mr *MockTransactionMockRecorder) SaveBlobLogs(ctx any, logs ...any) *TransactionSaveBlobLogsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, logs...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBlobLogs", reflect.TypeOf((*MockTransaction)(nil).SaveBlobLogs), varargs...)
+	return &TransactionSaveBlobLogsCall{Call: call}
+}
+
+// TransactionSaveBlobLogsCall wrap *gomock.Call
+type TransactionSaveBlobLogsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveBlobLogsCall) Return(arg0 error) *TransactionSaveBlobLogsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveBlobLogsCall) Do(f func(context.Context, ...storage.BlobLog) error) *TransactionSaveBlobLogsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveBlobLogsCall) DoAndReturn(f func(context.Context, ...storage.BlobLog) error) *TransactionSaveBlobLogsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveBlobstreamAttestations mocks base method.
+func (m *MockTransaction) SaveBlobstreamAttestations(ctx context.Context, attestations ...*storage.BlobstreamAttestation) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range attestations {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveBlobstreamAttestations", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveBlobstreamAttestations indicates an expected call of SaveBlobstreamAttestations.
+func (mr *MockTransactionMockRecorder) SaveBlobstreamAttestations(ctx any, attestations ...any) *TransactionSaveBlobstreamAttestationsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, attestations...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBlobstreamAttestations", reflect.TypeOf((*MockTransaction)(nil).SaveBlobstreamAttestations), varargs...)
+	return &TransactionSaveBlobstreamAttestationsCall{Call: call}
+}
+
+// TransactionSaveBlobstreamAttestationsCall wrap *gomock.Call
+type TransactionSaveBlobstreamAttestationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveBlobstreamAttestationsCall) Return(arg0 error) *TransactionSaveBlobstreamAttestationsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveBlobstreamAttestationsCall) Do(f func(context.Context, ...*storage.BlobstreamAttestation) error) *TransactionSaveBlobstreamAttestationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveBlobstreamAttestationsCall) DoAndReturn(f func(context.Context, ...*storage.BlobstreamAttestation) error) *TransactionSaveBlobstreamAttestationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveBlobstreamEvmAddresses mocks base method.
+func (m *MockTransaction) SaveBlobstreamEvmAddresses(ctx context.Context, addresses ...*storage.BlobstreamEvmAddress) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range addresses {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveBlobstreamEvmAddresses", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveBlobstreamEvmAddresses indicates an expected call of SaveBlobstreamEvmAddresses.
+func (mr *MockTransactionMockRecorder) SaveBlobstreamEvmAddresses(ctx any, addresses ...any) *TransactionSaveBlobstreamEvmAddressesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, addresses...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBlobstreamEvmAddresses", reflect.TypeOf((*MockTransaction)(nil).SaveBlobstreamEvmAddresses), varargs...)
+	return &TransactionSaveBlobstreamEvmAddressesCall{Call: call}
+}
+
+// TransactionSaveBlobstreamEvmAddressesCall wrap *gomock.Call
+type TransactionSaveBlobstreamEvmAddressesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveBlobstreamEvmAddressesCall) Return(arg0 error) *TransactionSaveBlobstreamEvmAddressesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveBlobstreamEvmAddressesCall) Do(f func(context.Context, ...*storage.BlobstreamEvmAddress) error) *TransactionSaveBlobstreamEvmAddressesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveBlobstreamEvmAddressesCall) DoAndReturn(f func(context.Context, ...*storage.BlobstreamEvmAddress) error) *TransactionSaveBlobstreamEvmAddressesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveBlockDah mocks base method.
+func (m *MockTransaction) SaveBlockDah(ctx context.Context, dah *storage.BlockDah) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveBlockDah", ctx, dah)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveBlockDah indicates an expected call of SaveBlockDah.
+func (mr *MockTransactionMockRecorder) SaveBlockDah(ctx, dah any) *TransactionSaveBlockDahCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBlockDah", reflect.TypeOf((*MockTransaction)(nil).SaveBlockDah), ctx, dah)
+	return &TransactionSaveBlockDahCall{Call: call}
+}
+
+// TransactionSaveBlockDahCall wrap *gomock.Call
+type TransactionSaveBlockDahCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveBlockDahCall) Return(arg0 error) *TransactionSaveBlockDahCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveBlockDahCall) Do(f func(context.Context, *storage.BlockDah) error) *TransactionSaveBlockDahCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveBlockDahCall) DoAndReturn(f func(context.Context, *storage.BlockDah) error) *TransactionSaveBlockDahCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveBlockSignatures mocks base method.
+func (m *MockTransaction) SaveBlockSignatures(ctx context.Context, signs ...storage.BlockSignature) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range signs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveBlockSignatures", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveBlockSignatures indicates an expected call of SaveBlockSignatures.
+func (mr *MockTransactionMockRecorder) SaveBlockSignatures(ctx any, signs ...any) *TransactionSaveBlockSignaturesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, signs...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBlockSignatures", reflect.TypeOf((*MockTransaction)(nil).SaveBlockSignatures), varargs...)
+	return &TransactionSaveBlockSignaturesCall{Call: call}
+}
+
+// TransactionSaveBlockSignaturesCall wrap *gomock.Call
+type TransactionSaveBlockSignaturesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveBlockSignaturesCall) Return(arg0 error) *TransactionSaveBlockSignaturesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveBlockSignaturesCall) Do(f func(context.Context, ...storage.BlockSignature) error) *TransactionSaveBlockSignaturesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveBlockSignaturesCall) DoAndReturn(f func(context.Context, ...storage.BlockSignature) error) *TransactionSaveBlockSignaturesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveConstantHistory mocks base method.
+func (m *MockTransaction) SaveConstantHistory(ctx context.Context, history ...storage.ConstantHistory) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range history {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveConstantHistory", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveConstantHistory indicates an expected call of SaveConstantHistory.
+func (mr *MockTransactionMockRecorder) SaveConstantHistory(ctx any, history ...any) *TransactionSaveConstantHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, history...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveConstantHistory", reflect.TypeOf((*MockTransaction)(nil).SaveConstantHistory), varargs...)
+	return &TransactionSaveConstantHistoryCall{Call: call}
+}
+
+// TransactionSaveConstantHistoryCall wrap *gomock.Call
+type TransactionSaveConstantHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveConstantHistoryCall) Return(arg0 error) *TransactionSaveConstantHistoryCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveConstantHistoryCall) Do(f func(context.Context, ...storage.ConstantHistory) error) *TransactionSaveConstantHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveConstantHistoryCall) DoAndReturn(f func(context.Context, ...storage.ConstantHistory) error) *TransactionSaveConstantHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveConstants mocks base method.
+func (m *MockTransaction) SaveConstants(ctx context.Context, constants ...storage.Constant) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range constants {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveConstants", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveConstants indicates an expected call of SaveConstants.
+func (mr *MockTransactionMockRecorder) SaveConstants(ctx any, constants ...any) *TransactionSaveConstantsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, constants...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveConstants", reflect.TypeOf((*MockTransaction)(nil).SaveConstants), varargs...)
+	return &TransactionSaveConstantsCall{Call: call}
+}
+
+// TransactionSaveConstantsCall wrap *gomock.Call
+type TransactionSaveConstantsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveConstantsCall) Return(arg0 error) *TransactionSaveConstantsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveConstantsCall) Do(f func(context.Context, ...storage.Constant) error) *TransactionSaveConstantsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveConstantsCall) DoAndReturn(f func(context.Context, ...storage.Constant) error) *TransactionSaveConstantsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveDelegations mocks base method.
+func (m *MockTransaction) SaveDelegations(ctx context.Context, delegations ...storage.Delegation) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range delegations {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveDelegations", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveDelegations indicates an expected call of SaveDelegations.
+func (mr *MockTransactionMockRecorder) SaveDelegations(ctx any, delegations ...any) *TransactionSaveDelegationsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, delegations...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDelegations", reflect.TypeOf((*MockTransaction)(nil).SaveDelegations), varargs...)
+	return &TransactionSaveDelegationsCall{Call: call}
+}
+
+// TransactionSaveDelegationsCall wrap *gomock.Call
+type TransactionSaveDelegationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveDelegationsCall) Return(arg0 error) *TransactionSaveDelegationsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveDelegationsCall) Do(f func(context.Context, ...storage.Delegation) error) *TransactionSaveDelegationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveDelegationsCall) DoAndReturn(f func(context.Context, ...storage.Delegation) error) *TransactionSaveDelegationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveDeposits mocks base method.
+func (m *MockTransaction) SaveDeposits(ctx context.Context, deposits ...storage.Deposit) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range deposits {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveDeposits", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveDeposits indicates an expected call of SaveDeposits.
+func (mr *MockTransactionMockRecorder) SaveDeposits(ctx any, deposits ...any) *TransactionSaveDepositsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, deposits...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDeposits", reflect.TypeOf((*MockTransaction)(nil).SaveDeposits), varargs...)
+	return &TransactionSaveDepositsCall{Call: call}
+}
+
+// TransactionSaveDepositsCall wrap *gomock.Call
+type TransactionSaveDepositsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveDepositsCall) Return(arg0 error) *TransactionSaveDepositsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveDepositsCall) Do(f func(context.Context, ...storage.Deposit) error) *TransactionSaveDepositsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveDepositsCall) DoAndReturn(f func(context.Context, ...storage.Deposit) error) *TransactionSaveDepositsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveEvents mocks base method.
+func (m *MockTransaction) SaveEvents(ctx context.Context, events ...storage.Event) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range events {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveEvents", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveEvents indicates an expected call of SaveEvents.
+func (mr *MockTransactionMockRecorder) SaveEvents(ctx any, events ...any) *TransactionSaveEventsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, events...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveEvents", reflect.TypeOf((*MockTransaction)(nil).SaveEvents), varargs...)
+	return &TransactionSaveEventsCall{Call: call}
+}
+
+// TransactionSaveEventsCall wrap *gomock.Call
+type TransactionSaveEventsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveEventsCall) Return(arg0 error) *TransactionSaveEventsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveEventsCall) Do(f func(context.Context, ...storage.Event) error) *TransactionSaveEventsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveEventsCall) DoAndReturn(f func(context.Context, ...storage.Event) error) *TransactionSaveEventsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveGrants mocks base method.
+func (m *MockTransaction) SaveGrants(ctx context.Context, grants ...storage.Grant) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range grants {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveGrants", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveGrants indicates an expected call of SaveGrants.
+func (mr *MockTransactionMockRecorder) SaveGrants(ctx any, grants ...any) *TransactionSaveGrantsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, grants...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveGrants", reflect.TypeOf((*MockTransaction)(nil).SaveGrants), varargs...)
+	return &TransactionSaveGrantsCall{Call: call}
+}
+
+// TransactionSaveGrantsCall wrap *gomock.Call
+type TransactionSaveGrantsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveGrantsCall) Return(arg0 error) *TransactionSaveGrantsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveGrantsCall) Do(f func(context.Context, ...storage.Grant) error) *TransactionSaveGrantsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveGrantsCall) DoAndReturn(f func(context.Context, ...storage.Grant) error) *TransactionSaveGrantsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveGroupMembers mocks base method.
+func (m *MockTransaction) SaveGroupMembers(ctx context.Context, members ...*storage.GroupMember) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range members {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveGroupMembers", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveGroupMembers indicates an expected call of SaveGroupMembers.
+func (mr *MockTransactionMockRecorder) SaveGroupMembers(ctx any, members ...any) *TransactionSaveGroupMembersCall {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, constants...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveConstants", reflect.TypeOf((*MockTransaction)(nil).SaveConstants), varargs...)
-	return &TransactionSaveConstantsCall{Call: call}
+	varargs := append([]any{ctx}, members...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveGroupMembers", reflect.TypeOf((*MockTransaction)(nil).SaveGroupMembers), varargs...)
+	return &TransactionSaveGroupMembersCall{Call: call}
 }
 
-// TransactionSaveConstantsCall wrap *gomock.Call
-type TransactionSaveConstantsCall struct {
+// TransactionSaveGroupMembersCall wrap *gomock.Call
+type TransactionSaveGroupMembersCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionSaveConstantsCall) Return(arg0 error) *TransactionSaveConstantsCall {
+func (c *TransactionSaveGroupMembersCall) Return(arg0 error) *TransactionSaveGroupMembersCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionSaveConstantsCall) Do(f func(context.Context, ...storage.Constant) error) *TransactionSaveConstantsCall {
+func (c *TransactionSaveGroupMembersCall) Do(f func(context.Context, ...*storage.GroupMember) error) *TransactionSaveGroupMembersCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionSaveConstantsCall) DoAndReturn(f func(context.Context, ...storage.Constant) error) *TransactionSaveConstantsCall {
+func (c *TransactionSaveGroupMembersCall) DoAndReturn(f func(context.Context, ...*storage.GroupMember) error) *TransactionSaveGroupMembersCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// SaveDelegations mocks base method.
-func (m *MockTransaction) SaveDelegations(ctx context.Context, delegations ...storage.Delegation) error {
+// SaveGroupPolicies mocks base method.
+func (m *MockTransaction) SaveGroupPolicies(ctx context.Context, policies ...*storage.GroupPolicy) error {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
-	for _, a := range delegations {
+	for _, a := range policies {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SaveDelegations", varargs...)
+	ret := m.ctrl.Call(m, "SaveGroupPolicies", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SaveDelegations indicates an expected call of SaveDelegations.
-func (mr *MockTransactionMockRecorder) SaveDelegations(ctx any, delegations ...any) *TransactionSaveDelegationsCall {
+// SaveGroupPolicies indicates an expected call of SaveGroupPolicies.
+func (mr *MockTransactionMockRecorder) SaveGroupPolicies(ctx any, policies ...any) *TransactionSaveGroupPoliciesCall {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, delegations...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDelegations", reflect.TypeOf((*MockTransaction)(nil).SaveDelegations), varargs...)
-	return &TransactionSaveDelegationsCall{Call: call}
+	varargs := append([]any{ctx}, policies...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveGroupPolicies", reflect.TypeOf((*MockTransaction)(nil).SaveGroupPolicies), varargs...)
+	return &TransactionSaveGroupPoliciesCall{Call: call}
 }
 
-// TransactionSaveDelegationsCall wrap *gomock.Call
-type TransactionSaveDelegationsCall struct {
+// TransactionSaveGroupPoliciesCall wrap *gomock.Call
+type TransactionSaveGroupPoliciesCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionSaveDelegationsCall) Return(arg0 error) *TransactionSaveDelegationsCall {
+func (c *TransactionSaveGroupPoliciesCall) Return(arg0 error) *TransactionSaveGroupPoliciesCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionSaveDelegationsCall) Do(f func(context.Context, ...storage.Delegation) error) *TransactionSaveDelegationsCall {
+func (c *TransactionSaveGroupPoliciesCall) Do(f func(context.Context, ...*storage.GroupPolicy) error) *TransactionSaveGroupPoliciesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionSaveDelegationsCall) DoAndReturn(f func(context.Context, ...storage.Delegation) error) *TransactionSaveDelegationsCall {
+func (c *TransactionSaveGroupPoliciesCall) DoAndReturn(f func(context.Context, ...*storage.GroupPolicy) error) *TransactionSaveGroupPoliciesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// SaveEvents mocks base method.
-func (m *MockTransaction) SaveEvents(ctx context.Context, events ...storage.Event) error {
+// SaveGroupProposals mocks base method.
+func (m *MockTransaction) SaveGroupProposals(ctx context.Context, proposals ...*storage.GroupProposal) error {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
-	for _, a := range events {
+	for _, a := range proposals {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SaveEvents", varargs...)
+	ret := m.ctrl.Call(m, "SaveGroupProposals", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SaveEvents indicates an expected call of SaveEvents.
-func (mr *MockTransactionMockRecorder) SaveEvents(ctx any, events ...any) *TransactionSaveEventsCall {
+// SaveGroupProposals indicates an expected call of SaveGroupProposals.
+func (mr *MockTransactionMockRecorder) SaveGroupProposals(ctx any, proposals ...any) *TransactionSaveGroupProposalsCall {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, events...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveEvents", reflect.TypeOf((*MockTransaction)(nil).SaveEvents), varargs...)
-	return &TransactionSaveEventsCall{Call: call}
+	varargs := append([]any{ctx}, proposals...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveGroupProposals", reflect.TypeOf((*MockTransaction)(nil).SaveGroupProposals), varargs...)
+	return &TransactionSaveGroupProposalsCall{Call: call}
 }
 
-// TransactionSaveEventsCall wrap *gomock.Call
-type TransactionSaveEventsCall struct {
+// TransactionSaveGroupProposalsCall wrap *gomock.Call
+type TransactionSaveGroupProposalsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionSaveEventsCall) Return(arg0 error) *TransactionSaveEventsCall {
+func (c *TransactionSaveGroupProposalsCall) Return(arg0 error) *TransactionSaveGroupProposalsCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionSaveEventsCall) Do(f func(context.Context, ...storage.Event) error) *TransactionSaveEventsCall {
+func (c *TransactionSaveGroupProposalsCall) Do(f func(context.Context, ...*storage.GroupProposal) error) *TransactionSaveGroupProposalsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionSaveEventsCall) DoAndReturn(f func(context.Context, ...storage.Event) error) *TransactionSaveEventsCall {
+func (c *TransactionSaveGroupProposalsCall) DoAndReturn(f func(context.Context, ...*storage.GroupProposal) error) *TransactionSaveGroupProposalsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// SaveGrants mocks base method.
-func (m *MockTransaction) SaveGrants(ctx context.Context, grants ...storage.Grant) error {
+// SaveGroupVotes mocks base method.
+func (m *MockTransaction) SaveGroupVotes(ctx context.Context, votes ...*storage.GroupVote) error {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
-	for _, a := range grants {
+	for _, a := range votes {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SaveGrants", varargs...)
+	ret := m.ctrl.Call(m, "SaveGroupVotes", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SaveGrants indicates an expected call of SaveGrants.
-func (mr *MockTransactionMockRecorder) SaveGrants(ctx any, grants ...any) *TransactionSaveGrantsCall {
+// SaveGroupVotes indicates an expected call of SaveGroupVotes.
+func (mr *MockTransactionMockRecorder) SaveGroupVotes(ctx any, votes ...any) *TransactionSaveGroupVotesCall {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, grants...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveGrants", reflect.TypeOf((*MockTransaction)(nil).SaveGrants), varargs...)
-	return &TransactionSaveGrantsCall{Call: call}
+	varargs := append([]any{ctx}, votes...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveGroupVotes", reflect.TypeOf((*MockTransaction)(nil).SaveGroupVotes), varargs...)
+	return &TransactionSaveGroupVotesCall{Call: call}
 }
 
-// TransactionSaveGrantsCall wrap *gomock.Call
-type TransactionSaveGrantsCall struct {
+// TransactionSaveGroupVotesCall wrap *gomock.Call
+type TransactionSaveGroupVotesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveGroupVotesCall) Return(arg0 error) *TransactionSaveGroupVotesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveGroupVotesCall) Do(f func(context.Context, ...*storage.GroupVote) error) *TransactionSaveGroupVotesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveGroupVotesCall) DoAndReturn(f func(context.Context, ...*storage.GroupVote) error) *TransactionSaveGroupVotesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveGroups mocks base method.
+func (m *MockTransaction) SaveGroups(ctx context.Context, groups ...*storage.Group) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range groups {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveGroups", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveGroups indicates an expected call of SaveGroups.
+func (mr *MockTransactionMockRecorder) SaveGroups(ctx any, groups ...any) *TransactionSaveGroupsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, groups...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveGroups", reflect.TypeOf((*MockTransaction)(nil).SaveGroups), varargs...)
+	return &TransactionSaveGroupsCall{Call: call}
+}
+
+// TransactionSaveGroupsCall wrap *gomock.Call
+type TransactionSaveGroupsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveGroupsCall) Return(arg0 error) *TransactionSaveGroupsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveGroupsCall) Do(f func(context.Context, ...*storage.Group) error) *TransactionSaveGroupsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveGroupsCall) DoAndReturn(f func(context.Context, ...*storage.Group) error) *TransactionSaveGroupsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveIbcChannels mocks base method.
+func (m *MockTransaction) SaveIbcChannels(ctx context.Context, channels ...*storage.IbcChannel) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range channels {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveIbcChannels", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveIbcChannels indicates an expected call of SaveIbcChannels.
+func (mr *MockTransactionMockRecorder) SaveIbcChannels(ctx any, channels ...any) *TransactionSaveIbcChannelsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, channels...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveIbcChannels", reflect.TypeOf((*MockTransaction)(nil).SaveIbcChannels), varargs...)
+	return &TransactionSaveIbcChannelsCall{Call: call}
+}
+
+// TransactionSaveIbcChannelsCall wrap *gomock.Call
+type TransactionSaveIbcChannelsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveIbcChannelsCall) Return(arg0 error) *TransactionSaveIbcChannelsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveIbcChannelsCall) Do(f func(context.Context, ...*storage.IbcChannel) error) *TransactionSaveIbcChannelsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveIbcChannelsCall) DoAndReturn(f func(context.Context, ...*storage.IbcChannel) error) *TransactionSaveIbcChannelsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveIbcClients mocks base method.
+func (m *MockTransaction) SaveIbcClients(ctx context.Context, clients ...*storage.IbcClient) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range clients {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveIbcClients", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveIbcClients indicates an expected call of SaveIbcClients.
+func (mr *MockTransactionMockRecorder) SaveIbcClients(ctx any, clients ...any) *TransactionSaveIbcClientsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, clients...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveIbcClients", reflect.TypeOf((*MockTransaction)(nil).SaveIbcClients), varargs...)
+	return &TransactionSaveIbcClientsCall{Call: call}
+}
+
+// TransactionSaveIbcClientsCall wrap *gomock.Call
+type TransactionSaveIbcClientsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveIbcClientsCall) Return(arg0 error) *TransactionSaveIbcClientsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveIbcClientsCall) Do(f func(context.Context, ...*storage.IbcClient) error) *TransactionSaveIbcClientsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveIbcClientsCall) DoAndReturn(f func(context.Context, ...*storage.IbcClient) error) *TransactionSaveIbcClientsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveIbcConnections mocks base method.
+func (m *MockTransaction) SaveIbcConnections(ctx context.Context, connections ...*storage.IbcConnection) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range connections {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveIbcConnections", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveIbcConnections indicates an expected call of SaveIbcConnections.
+func (mr *MockTransactionMockRecorder) SaveIbcConnections(ctx any, connections ...any) *TransactionSaveIbcConnectionsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, connections...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveIbcConnections", reflect.TypeOf((*MockTransaction)(nil).SaveIbcConnections), varargs...)
+	return &TransactionSaveIbcConnectionsCall{Call: call}
+}
+
+// TransactionSaveIbcConnectionsCall wrap *gomock.Call
+type TransactionSaveIbcConnectionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveIbcConnectionsCall) Return(arg0 error) *TransactionSaveIbcConnectionsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveIbcConnectionsCall) Do(f func(context.Context, ...*storage.IbcConnection) error) *TransactionSaveIbcConnectionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveIbcConnectionsCall) DoAndReturn(f func(context.Context, ...*storage.IbcConnection) error) *TransactionSaveIbcConnectionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveIbcTransfers mocks base method.
+func (m *MockTransaction) SaveIbcTransfers(ctx context.Context, transfers ...*storage.IbcTransfer) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range transfers {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveIbcTransfers", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveIbcTransfers indicates an expected call of SaveIbcTransfers.
+func (mr *MockTransactionMockRecorder) SaveIbcTransfers(ctx any, transfers ...any) *TransactionSaveIbcTransfersCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, transfers...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveIbcTransfers", reflect.TypeOf((*MockTransaction)(nil).SaveIbcTransfers), varargs...)
+	return &TransactionSaveIbcTransfersCall{Call: call}
+}
+
+// TransactionSaveIbcTransfersCall wrap *gomock.Call
+type TransactionSaveIbcTransfersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveIbcTransfersCall) Return(arg0 error) *TransactionSaveIbcTransfersCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveIbcTransfersCall) Do(f func(context.Context, ...*storage.IbcTransfer) error) *TransactionSaveIbcTransfersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveIbcTransfersCall) DoAndReturn(f func(context.Context, ...*storage.IbcTransfer) error) *TransactionSaveIbcTransfersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveInterchainAccountPackets mocks base method.
+func (m *MockTransaction) SaveInterchainAccountPackets(ctx context.Context, packets ...*storage.InterchainAccountPacket) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range packets {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveInterchainAccountPackets", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveInterchainAccountPackets indicates an expected call of SaveInterchainAccountPackets.
+func (mr *MockTransactionMockRecorder) SaveInterchainAccountPackets(ctx any, packets ...any) *TransactionSaveInterchainAccountPacketsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, packets...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveInterchainAccountPackets", reflect.TypeOf((*MockTransaction)(nil).SaveInterchainAccountPackets), varargs...)
+	return &TransactionSaveInterchainAccountPacketsCall{Call: call}
+}
+
+// TransactionSaveInterchainAccountPacketsCall wrap *gomock.Call
+type TransactionSaveInterchainAccountPacketsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveInterchainAccountPacketsCall) Return(arg0 error) *TransactionSaveInterchainAccountPacketsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveInterchainAccountPacketsCall) Do(f func(context.Context, ...*storage.InterchainAccountPacket) error) *TransactionSaveInterchainAccountPacketsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveInterchainAccountPacketsCall) DoAndReturn(f func(context.Context, ...*storage.InterchainAccountPacket) error) *TransactionSaveInterchainAccountPacketsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveInterchainAccounts mocks base method.
+func (m *MockTransaction) SaveInterchainAccounts(ctx context.Context, accounts ...*storage.InterchainAccount) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range accounts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveInterchainAccounts", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveInterchainAccounts indicates an expected call of SaveInterchainAccounts.
+func (mr *MockTransactionMockRecorder) SaveInterchainAccounts(ctx any, accounts ...any) *TransactionSaveInterchainAccountsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, accounts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveInterchainAccounts", reflect.TypeOf((*MockTransaction)(nil).SaveInterchainAccounts), varargs...)
+	return &TransactionSaveInterchainAccountsCall{Call: call}
+}
+
+// TransactionSaveInterchainAccountsCall wrap *gomock.Call
+type TransactionSaveInterchainAccountsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionSaveGrantsCall) Return(arg0 error) *TransactionSaveGrantsCall {
+func (c *TransactionSaveInterchainAccountsCall) Return(arg0 error) *TransactionSaveInterchainAccountsCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionSaveGrantsCall) Do(f func(context.Context, ...storage.Grant) error) *TransactionSaveGrantsCall {
+func (c *TransactionSaveInterchainAccountsCall) Do(f func(context.Context, ...*storage.InterchainAccount) error) *TransactionSaveInterchainAccountsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionSaveGrantsCall) DoAndReturn(f func(context.Context, ...storage.Grant) error) *TransactionSaveGrantsCall {
+func (c *TransactionSaveInterchainAccountsCall) DoAndReturn(f func(context.Context, ...*storage.InterchainAccount) error) *TransactionSaveInterchainAccountsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -2415,6 +4291,49 @@ func (c *TransactionSaveMessagesCall) DoAndReturn(f func(context.Context, ...*st
 	return c
 }
 
+// SaveMissedBlocks mocks base method.
+func (m *MockTransaction) SaveMissedBlocks(ctx context.Context, blocks ...storage.MissedBlock) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range blocks {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveMissedBlocks", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveMissedBlocks indicates an expected call of SaveMissedBlocks.
+func (mr *MockTransactionMockRecorder) SaveMissedBlocks(ctx any, blocks ...any) *TransactionSaveMissedBlocksCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, blocks...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMissedBlocks", reflect.TypeOf((*MockTransaction)(nil).SaveMissedBlocks), varargs...)
+	return &TransactionSaveMissedBlocksCall{Call: call}
+}
+
+// TransactionSaveMissedBlocksCall wrap *gomock.Call
+type TransactionSaveMissedBlocksCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveMissedBlocksCall) Return(arg0 error) *TransactionSaveMissedBlocksCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveMissedBlocksCall) Do(f func(context.Context, ...storage.MissedBlock) error) *TransactionSaveMissedBlocksCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveMissedBlocksCall) DoAndReturn(f func(context.Context, ...storage.MissedBlock) error) *TransactionSaveMissedBlocksCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SaveMsgAddresses mocks base method.
 func (m *MockTransaction) SaveMsgAddresses(ctx context.Context, addresses ...storage.MsgAddress) error {
 	m.ctrl.T.Helper()
@@ -2545,6 +4464,49 @@ func (c *TransactionSaveNamespacesCall) DoAndReturn(f func(context.Context, ...*
 	return c
 }
 
+// SaveProposals mocks base method.
+func (m *MockTransaction) SaveProposals(ctx context.Context, proposals ...*storage.Proposal) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range proposals {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveProposals", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveProposals indicates an expected call of SaveProposals.
+func (mr *MockTransactionMockRecorder) SaveProposals(ctx any, proposals ...any) *TransactionSaveProposalsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, proposals...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveProposals", reflect.TypeOf((*MockTransaction)(nil).SaveProposals), varargs...)
+	return &TransactionSaveProposalsCall{Call: call}
+}
+
+// TransactionSaveProposalsCall wrap *gomock.Call
+type TransactionSaveProposalsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveProposalsCall) Return(arg0 error) *TransactionSaveProposalsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveProposalsCall) Do(f func(context.Context, ...*storage.Proposal) error) *TransactionSaveProposalsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveProposalsCall) DoAndReturn(f func(context.Context, ...*storage.Proposal) error) *TransactionSaveProposalsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SaveProviders mocks base method.
 func (m *MockTransaction) SaveProviders(ctx context.Context, providers ...storage.RollupProvider) error {
 	m.ctrl.T.Helper()
@@ -2592,251 +4554,466 @@ func (c *TransactionSaveProvidersCall) DoAndReturn(f func(context.Context, ...st
 func (m *MockTransaction) SaveRedelegations(ctx context.Context, redelegations ...storage.Redelegation) error {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
-	for _, a := range redelegations {
+	for _, a := range redelegations {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveRedelegations", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRedelegations indicates an expected call of SaveRedelegations.
+func (mr *MockTransactionMockRecorder) SaveRedelegations(ctx any, redelegations ...any) *TransactionSaveRedelegationsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, redelegations...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRedelegations", reflect.TypeOf((*MockTransaction)(nil).SaveRedelegations), varargs...)
+	return &TransactionSaveRedelegationsCall{Call: call}
+}
+
+// TransactionSaveRedelegationsCall wrap *gomock.Call
+type TransactionSaveRedelegationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveRedelegationsCall) Return(arg0 error) *TransactionSaveRedelegationsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveRedelegationsCall) Do(f func(context.Context, ...storage.Redelegation) error) *TransactionSaveRedelegationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveRedelegationsCall) DoAndReturn(f func(context.Context, ...storage.Redelegation) error) *TransactionSaveRedelegationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveRollup mocks base method.
+func (m *MockTransaction) SaveRollup(ctx context.Context, rollup *storage.Rollup) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRollup", ctx, rollup)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRollup indicates an expected call of SaveRollup.
+func (mr *MockTransactionMockRecorder) SaveRollup(ctx, rollup any) *TransactionSaveRollupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRollup", reflect.TypeOf((*MockTransaction)(nil).SaveRollup), ctx, rollup)
+	return &TransactionSaveRollupCall{Call: call}
+}
+
+// TransactionSaveRollupCall wrap *gomock.Call
+type TransactionSaveRollupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveRollupCall) Return(arg0 error) *TransactionSaveRollupCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveRollupCall) Do(f func(context.Context, *storage.Rollup) error) *TransactionSaveRollupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveRollupCall) DoAndReturn(f func(context.Context, *storage.Rollup) error) *TransactionSaveRollupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveSigners mocks base method.
+func (m *MockTransaction) SaveSigners(ctx context.Context, addresses ...storage.Signer) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range addresses {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveSigners", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveSigners indicates an expected call of SaveSigners.
+func (mr *MockTransactionMockRecorder) SaveSigners(ctx any, addresses ...any) *TransactionSaveSignersCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, addresses...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSigners", reflect.TypeOf((*MockTransaction)(nil).SaveSigners), varargs...)
+	return &TransactionSaveSignersCall{Call: call}
+}
+
+// TransactionSaveSignersCall wrap *gomock.Call
+type TransactionSaveSignersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveSignersCall) Return(arg0 error) *TransactionSaveSignersCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveSignersCall) Do(f func(context.Context, ...storage.Signer) error) *TransactionSaveSignersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveSignersCall) DoAndReturn(f func(context.Context, ...storage.Signer) error) *TransactionSaveSignersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveSlashes mocks base method.
+func (m *MockTransaction) SaveSlashes(ctx context.Context, slashes ...*storage.Slash) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range slashes {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveSlashes", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveSlashes indicates an expected call of SaveSlashes.
+func (mr *MockTransactionMockRecorder) SaveSlashes(ctx any, slashes ...any) *TransactionSaveSlashesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, slashes...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSlashes", reflect.TypeOf((*MockTransaction)(nil).SaveSlashes), varargs...)
+	return &TransactionSaveSlashesCall{Call: call}
+}
+
+// TransactionSaveSlashesCall wrap *gomock.Call
+type TransactionSaveSlashesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveSlashesCall) Return(arg0 error) *TransactionSaveSlashesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveSlashesCall) Do(f func(context.Context, ...*storage.Slash) error) *TransactionSaveSlashesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveSlashesCall) DoAndReturn(f func(context.Context, ...*storage.Slash) error) *TransactionSaveSlashesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveStakingLogs mocks base method.
+func (m *MockTransaction) SaveStakingLogs(ctx context.Context, logs ...storage.StakingLog) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range logs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveStakingLogs", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveStakingLogs indicates an expected call of SaveStakingLogs.
+func (mr *MockTransactionMockRecorder) SaveStakingLogs(ctx any, logs ...any) *TransactionSaveStakingLogsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, logs...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveStakingLogs", reflect.TypeOf((*MockTransaction)(nil).SaveStakingLogs), varargs...)
+	return &TransactionSaveStakingLogsCall{Call: call}
+}
+
+// TransactionSaveStakingLogsCall wrap *gomock.Call
+type TransactionSaveStakingLogsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveStakingLogsCall) Return(arg0 error) *TransactionSaveStakingLogsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveStakingLogsCall) Do(f func(context.Context, ...storage.StakingLog) error) *TransactionSaveStakingLogsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveStakingLogsCall) DoAndReturn(f func(context.Context, ...storage.StakingLog) error) *TransactionSaveStakingLogsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveTransactions mocks base method.
+func (m *MockTransaction) SaveTransactions(ctx context.Context, txs ...storage.Tx) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range txs {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SaveRedelegations", varargs...)
+	ret := m.ctrl.Call(m, "SaveTransactions", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SaveRedelegations indicates an expected call of SaveRedelegations.
-func (mr *MockTransactionMockRecorder) SaveRedelegations(ctx any, redelegations ...any) *TransactionSaveRedelegationsCall {
+// SaveTransactions indicates an expected call of SaveTransactions.
+func (mr *MockTransactionMockRecorder) SaveTransactions(ctx any, txs ...any) *TransactionSaveTransactionsCall {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, redelegations...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRedelegations", reflect.TypeOf((*MockTransaction)(nil).SaveRedelegations), varargs...)
-	return &TransactionSaveRedelegationsCall{Call: call}
+	varargs := append([]any{ctx}, txs...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTransactions", reflect.TypeOf((*MockTransaction)(nil).SaveTransactions), varargs...)
+	return &TransactionSaveTransactionsCall{Call: call}
 }
 
-// TransactionSaveRedelegationsCall wrap *gomock.Call
-type TransactionSaveRedelegationsCall struct {
+// TransactionSaveTransactionsCall wrap *gomock.Call
+type TransactionSaveTransactionsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionSaveRedelegationsCall) Return(arg0 error) *TransactionSaveRedelegationsCall {
+func (c *TransactionSaveTransactionsCall) Return(arg0 error) *TransactionSaveTransactionsCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionSaveRedelegationsCall) Do(f func(context.Context, ...storage.Redelegation) error) *TransactionSaveRedelegationsCall {
+func (c *TransactionSaveTransactionsCall) Do(f func(context.Context, ...storage.Tx) error) *TransactionSaveTransactionsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionSaveRedelegationsCall) DoAndReturn(f func(context.Context, ...storage.Redelegation) error) *TransactionSaveRedelegationsCall {
+func (c *TransactionSaveTransactionsCall) DoAndReturn(f func(context.Context, ...storage.Tx) error) *TransactionSaveTransactionsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// SaveRollup mocks base method.
-func (m *MockTransaction) SaveRollup(ctx context.Context, rollup *storage.Rollup) error {
+// SaveTransfers mocks base method.
+func (m *MockTransaction) SaveTransfers(ctx context.Context, transfers ...*storage.Transfer) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SaveRollup", ctx, rollup)
+	varargs := []any{ctx}
+	for _, a := range transfers {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveTransfers", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SaveRollup indicates an expected call of SaveRollup.
-func (mr *MockTransactionMockRecorder) SaveRollup(ctx, rollup any) *TransactionSaveRollupCall {
+// SaveTransfers indicates an expected call of SaveTransfers.
+func (mr *MockTransactionMockRecorder) SaveTransfers(ctx any, transfers ...any) *TransactionSaveTransfersCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRollup", reflect.TypeOf((*MockTransaction)(nil).SaveRollup), ctx, rollup)
-	return &TransactionSaveRollupCall{Call: call}
+	varargs := append([]any{ctx}, transfers...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTransfers", reflect.TypeOf((*MockTransaction)(nil).SaveTransfers), varargs...)
+	return &TransactionSaveTransfersCall{Call: call}
 }
 
-// TransactionSaveRollupCall wrap *gomock.Call
-type TransactionSaveRollupCall struct {
+// TransactionSaveTransfersCall wrap *gomock.Call
+type TransactionSaveTransfersCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionSaveRollupCall) Return(arg0 error) *TransactionSaveRollupCall {
+func (c *TransactionSaveTransfersCall) Return(arg0 error) *TransactionSaveTransfersCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionSaveRollupCall) Do(f func(context.Context, *storage.Rollup) error) *TransactionSaveRollupCall {
+func (c *TransactionSaveTransfersCall) Do(f func(context.Context, ...*storage.Transfer) error) *TransactionSaveTransfersCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionSaveRollupCall) DoAndReturn(f func(context.Context, *storage.Rollup) error) *TransactionSaveRollupCall {
+func (c *TransactionSaveTransfersCall) DoAndReturn(f func(context.Context, ...*storage.Transfer) error) *TransactionSaveTransfersCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// SaveSigners mocks base method.
-func (m *MockTransaction) SaveSigners(ctx context.Context, addresses ...storage.Signer) error {
+// SaveTxAddresses mocks base method.
+func (m *MockTransaction) SaveTxAddresses(ctx context.Context, addresses ...storage.TxAddress) error {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
 	for _, a := range addresses {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SaveSigners", varargs...)
+	ret := m.ctrl.Call(m, "SaveTxAddresses", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SaveSigners indicates an expected call of SaveSigners.
-func (mr *MockTransactionMockRecorder) SaveSigners(ctx any, addresses ...any) *TransactionSaveSignersCall {
+// SaveTxAddresses indicates an expected call of SaveTxAddresses.
+func (mr *MockTransactionMockRecorder) SaveTxAddresses(ctx any, addresses ...any) *TransactionSaveTxAddressesCall {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx}, addresses...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSigners", reflect.TypeOf((*MockTransaction)(nil).SaveSigners), varargs...)
-	return &TransactionSaveSignersCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTxAddresses", reflect.TypeOf((*MockTransaction)(nil).SaveTxAddresses), varargs...)
+	return &TransactionSaveTxAddressesCall{Call: call}
 }
 
-// TransactionSaveSignersCall wrap *gomock.Call
-type TransactionSaveSignersCall struct {
+// TransactionSaveTxAddressesCall wrap *gomock.Call
+type TransactionSaveTxAddressesCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionSaveSignersCall) Return(arg0 error) *TransactionSaveSignersCall {
+func (c *TransactionSaveTxAddressesCall) Return(arg0 error) *TransactionSaveTxAddressesCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionSaveSignersCall) Do(f func(context.Context, ...storage.Signer) error) *TransactionSaveSignersCall {
+func (c *TransactionSaveTxAddressesCall) Do(f func(context.Context, ...storage.TxAddress) error) *TransactionSaveTxAddressesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionSaveSignersCall) DoAndReturn(f func(context.Context, ...storage.Signer) error) *TransactionSaveSignersCall {
+func (c *TransactionSaveTxAddressesCall) DoAndReturn(f func(context.Context, ...storage.TxAddress) error) *TransactionSaveTxAddressesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// SaveStakingLogs mocks base method.
-func (m *MockTransaction) SaveStakingLogs(ctx context.Context, logs ...storage.StakingLog) error {
+// SaveUndelegations mocks base method.
+func (m *MockTransaction) SaveUndelegations(ctx context.Context, undelegations ...storage.Undelegation) error {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
-	for _, a := range logs {
+	for _, a := range undelegations {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SaveStakingLogs", varargs...)
+	ret := m.ctrl.Call(m, "SaveUndelegations", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SaveStakingLogs indicates an expected call of SaveStakingLogs.
-func (mr *MockTransactionMockRecorder) SaveStakingLogs(ctx any, logs ...any) *TransactionSaveStakingLogsCall {
+// SaveUndelegations indicates an expected call of SaveUndelegations.
+func (mr *MockTransactionMockRecorder) SaveUndelegations(ctx any, undelegations ...any) *TransactionSaveUndelegationsCall {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, logs...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveStakingLogs", reflect.TypeOf((*MockTransaction)(nil).SaveStakingLogs), varargs...)
-	return &TransactionSaveStakingLogsCall{Call: call}
+	varargs := append([]any{ctx}, undelegations...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveUndelegations", reflect.TypeOf((*MockTransaction)(nil).SaveUndelegations), varargs...)
+	return &TransactionSaveUndelegationsCall{Call: call}
 }
 
-// TransactionSaveStakingLogsCall wrap *gomock.Call
-type TransactionSaveStakingLogsCall struct {
+// TransactionSaveUndelegationsCall wrap *gomock.Call
+type TransactionSaveUndelegationsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionSaveStakingLogsCall) Return(arg0 error) *TransactionSaveStakingLogsCall {
+func (c *TransactionSaveUndelegationsCall) Return(arg0 error) *TransactionSaveUndelegationsCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionSaveStakingLogsCall) Do(f func(context.Context, ...storage.StakingLog) error) *TransactionSaveStakingLogsCall {
+func (c *TransactionSaveUndelegationsCall) Do(f func(context.Context, ...storage.Undelegation) error) *TransactionSaveUndelegationsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionSaveStakingLogsCall) DoAndReturn(f func(context.Context, ...storage.StakingLog) error) *TransactionSaveStakingLogsCall {
+func (c *TransactionSaveUndelegationsCall) DoAndReturn(f func(context.Context, ...storage.Undelegation) error) *TransactionSaveUndelegationsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// SaveTransactions mocks base method.
-func (m *MockTransaction) SaveTransactions(ctx context.Context, txs ...storage.Tx) error {
+// SaveUpgrades mocks base method.
+func (m *MockTransaction) SaveUpgrades(ctx context.Context, upgrades ...*storage.Upgrade) error {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
-	for _, a := range txs {
+	for _, a := range upgrades {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SaveTransactions", varargs...)
+	ret := m.ctrl.Call(m, "SaveUpgrades", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SaveTransactions indicates an expected call of SaveTransactions.
-func (mr *MockTransactionMockRecorder) SaveTransactions(ctx any, txs ...any) *TransactionSaveTransactionsCall {
+// SaveUpgrades indicates an expected call of SaveUpgrades.
+func (mr *MockTransactionMockRecorder) SaveUpgrades(ctx any, upgrades ...any) *TransactionSaveUpgradesCall {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, txs...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTransactions", reflect.TypeOf((*MockTransaction)(nil).SaveTransactions), varargs...)
-	return &TransactionSaveTransactionsCall{Call: call}
+	varargs := append([]any{ctx}, upgrades...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveUpgrades", reflect.TypeOf((*MockTransaction)(nil).SaveUpgrades), varargs...)
+	return &TransactionSaveUpgradesCall{Call: call}
 }
 
-// TransactionSaveTransactionsCall wrap *gomock.Call
-type TransactionSaveTransactionsCall struct {
+// TransactionSaveUpgradesCall wrap *gomock.Call
+type TransactionSaveUpgradesCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionSaveTransactionsCall) Return(arg0 error) *TransactionSaveTransactionsCall {
+func (c *TransactionSaveUpgradesCall) Return(arg0 error) *TransactionSaveUpgradesCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionSaveTransactionsCall) Do(f func(context.Context, ...storage.Tx) error) *TransactionSaveTransactionsCall {
+func (c *TransactionSaveUpgradesCall) Do(f func(context.Context, ...*storage.Upgrade) error) *TransactionSaveUpgradesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionSaveTransactionsCall) DoAndReturn(f func(context.Context, ...storage.Tx) error) *TransactionSaveTransactionsCall {
+func (c *TransactionSaveUpgradesCall) DoAndReturn(f func(context.Context, ...*storage.Upgrade) error) *TransactionSaveUpgradesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// SaveUndelegations mocks base method.
-func (m *MockTransaction) SaveUndelegations(ctx context.Context, undelegations ...storage.Undelegation) error {
+// SaveValidatorHistory mocks base method.
+func (m *MockTransaction) SaveValidatorHistory(ctx context.Context, height types0.Level, t time.Time, validatorIds ...uint64) error {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range undelegations {
+	varargs := []any{ctx, height, t}
+	for _, a := range validatorIds {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SaveUndelegations", varargs...)
+	ret := m.ctrl.Call(m, "SaveValidatorHistory", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SaveUndelegations indicates an expected call of SaveUndelegations.
-func (mr *MockTransactionMockRecorder) SaveUndelegations(ctx any, undelegations ...any) *TransactionSaveUndelegationsCall {
+// SaveValidatorHistory indicates an expected call of SaveValidatorHistory.
+func (mr *MockTransactionMockRecorder) SaveValidatorHistory(ctx, height, t any, validatorIds ...any) *TransactionSaveValidatorHistoryCall {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, undelegations...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveUndelegations", reflect.TypeOf((*MockTransaction)(nil).SaveUndelegations), varargs...)
-	return &TransactionSaveUndelegationsCall{Call: call}
+	varargs := append([]any{ctx, height, t}, validatorIds...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveValidatorHistory", reflect.TypeOf((*MockTransaction)(nil).SaveValidatorHistory), varargs...)
+	return &TransactionSaveValidatorHistoryCall{Call: call}
 }
 
-// TransactionSaveUndelegationsCall wrap *gomock.Call
-type TransactionSaveUndelegationsCall struct {
+// TransactionSaveValidatorHistoryCall wrap *gomock.Call
+type TransactionSaveValidatorHistoryCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionSaveUndelegationsCall) Return(arg0 error) *TransactionSaveUndelegationsCall {
+func (c *TransactionSaveValidatorHistoryCall) Return(arg0 error) *TransactionSaveValidatorHistoryCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionSaveUndelegationsCall) Do(f func(context.Context, ...storage.Undelegation) error) *TransactionSaveUndelegationsCall {
+func (c *TransactionSaveValidatorHistoryCall) Do(f func(context.Context, types0.Level, time.Time, ...uint64) error) *TransactionSaveValidatorHistoryCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionSaveUndelegationsCall) DoAndReturn(f func(context.Context, ...storage.Undelegation) error) *TransactionSaveUndelegationsCall {
+func (c *TransactionSaveValidatorHistoryCall) DoAndReturn(f func(context.Context, types0.Level, time.Time, ...uint64) error) *TransactionSaveValidatorHistoryCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -2971,6 +5148,92 @@ func (c *TransactionSaveVestingPeriodsCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// SaveVotes mocks base method.
+func (m *MockTransaction) SaveVotes(ctx context.Context, votes ...storage.Vote) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range votes {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveVotes", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveVotes indicates an expected call of SaveVotes.
+func (mr *MockTransactionMockRecorder) SaveVotes(ctx any, votes ...any) *TransactionSaveVotesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, votes...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveVotes", reflect.TypeOf((*MockTransaction)(nil).SaveVotes), varargs...)
+	return &TransactionSaveVotesCall{Call: call}
+}
+
+// TransactionSaveVotesCall wrap *gomock.Call
+type TransactionSaveVotesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveVotesCall) Return(arg0 error) *TransactionSaveVotesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveVotesCall) Do(f func(context.Context, ...storage.Vote) error) *TransactionSaveVotesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveVotesCall) DoAndReturn(f func(context.Context, ...storage.Vote) error) *TransactionSaveVotesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SpendFeeGrants mocks base method.
+func (m *MockTransaction) SpendFeeGrants(ctx context.Context, grants ...storage.Grant) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range grants {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SpendFeeGrants", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SpendFeeGrants indicates an expected call of SpendFeeGrants.
+func (mr *MockTransactionMockRecorder) SpendFeeGrants(ctx any, grants ...any) *TransactionSpendFeeGrantsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, grants...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendFeeGrants", reflect.TypeOf((*MockTransaction)(nil).SpendFeeGrants), varargs...)
+	return &TransactionSpendFeeGrantsCall{Call: call}
+}
+
+// TransactionSpendFeeGrantsCall wrap *gomock.Call
+type TransactionSpendFeeGrantsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSpendFeeGrantsCall) Return(arg0 error) *TransactionSpendFeeGrantsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSpendFeeGrantsCall) Do(f func(context.Context, ...storage.Grant) error) *TransactionSpendFeeGrantsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSpendFeeGrantsCall) DoAndReturn(f func(context.Context, ...storage.Grant) error) *TransactionSpendFeeGrantsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // State mocks base method.
 func (m *MockTransaction) State(ctx context.Context, name string) (storage.State, error) {
 	m.ctrl.T.Helper()
@@ -3086,6 +5349,135 @@ func (c *TransactionUpdateCall) DoAndReturn(f func(context.Context, any) error)
 	return c
 }
 
+// UpdateGroupWeights mocks base method.
+func (m *MockTransaction) UpdateGroupWeights(ctx context.Context, groupIds ...uint64) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range groupIds {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateGroupWeights", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGroupWeights indicates an expected call of UpdateGroupWeights.
+func (mr *MockTransactionMockRecorder) UpdateGroupWeights(ctx any, groupIds ...any) *TransactionUpdateGroupWeightsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, groupIds...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGroupWeights", reflect.TypeOf((*MockTransaction)(nil).UpdateGroupWeights), varargs...)
+	return &TransactionUpdateGroupWeightsCall{Call: call}
+}
+
+// TransactionUpdateGroupWeightsCall wrap *gomock.Call
+type TransactionUpdateGroupWeightsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionUpdateGroupWeightsCall) Return(arg0 error) *TransactionUpdateGroupWeightsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionUpdateGroupWeightsCall) Do(f func(context.Context, ...uint64) error) *TransactionUpdateGroupWeightsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionUpdateGroupWeightsCall) DoAndReturn(f func(context.Context, ...uint64) error) *TransactionUpdateGroupWeightsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateIbcTransfers mocks base method.
+func (m *MockTransaction) UpdateIbcTransfers(ctx context.Context, transfers ...*storage.IbcTransfer) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range transfers {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateIbcTransfers", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateIbcTransfers indicates an expected call of UpdateIbcTransfers.
+func (mr *MockTransactionMockRecorder) UpdateIbcTransfers(ctx any, transfers ...any) *TransactionUpdateIbcTransfersCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, transfers...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIbcTransfers", reflect.TypeOf((*MockTransaction)(nil).UpdateIbcTransfers), varargs...)
+	return &TransactionUpdateIbcTransfersCall{Call: call}
+}
+
+// TransactionUpdateIbcTransfersCall wrap *gomock.Call
+type TransactionUpdateIbcTransfersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionUpdateIbcTransfersCall) Return(arg0 error) *TransactionUpdateIbcTransfersCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionUpdateIbcTransfersCall) Do(f func(context.Context, ...*storage.IbcTransfer) error) *TransactionUpdateIbcTransfersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionUpdateIbcTransfersCall) DoAndReturn(f func(context.Context, ...*storage.IbcTransfer) error) *TransactionUpdateIbcTransfersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateProposalsTally mocks base method.
+func (m *MockTransaction) UpdateProposalsTally(ctx context.Context, proposalIds ...uint64) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range proposalIds {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateProposalsTally", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProposalsTally indicates an expected call of UpdateProposalsTally.
+func (mr *MockTransactionMockRecorder) UpdateProposalsTally(ctx any, proposalIds ...any) *TransactionUpdateProposalsTallyCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, proposalIds...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProposalsTally", reflect.TypeOf((*MockTransaction)(nil).UpdateProposalsTally), varargs...)
+	return &TransactionUpdateProposalsTallyCall{Call: call}
+}
+
+// TransactionUpdateProposalsTallyCall wrap *gomock.Call
+type TransactionUpdateProposalsTallyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionUpdateProposalsTallyCall) Return(arg0 error) *TransactionUpdateProposalsTallyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionUpdateProposalsTallyCall) Do(f func(context.Context, ...uint64) error) *TransactionUpdateProposalsTallyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionUpdateProposalsTallyCall) DoAndReturn(f func(context.Context, ...uint64) error) *TransactionUpdateProposalsTallyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateRollup mocks base method.
 func (m *MockTransaction) UpdateRollup(ctx context.Context, rollup *storage.Rollup) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,392 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: group.go
+//
+// Generated by this command:
+//
+//	mockgen -source=group.go -destination=mock/group.go -package=mock -typed
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/celestia-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIGroup is a mock of IGroup interface.
+type MockIGroup struct {
+	ctrl     *gomock.Controller
+	recorder *MockIGroupMockRecorder
+}
+
+// MockIGroupMockRecorder is the mock recorder for MockIGroup.
+type MockIGroupMockRecorder struct {
+	mock *MockIGroup
+}
+
+// NewMockIGroup creates a new mock instance.
+func NewMockIGroup(ctrl *gomock.Controller) *MockIGroup {
+	mock := &MockIGroup{ctrl: ctrl}
+	mock.recorder = &MockIGroupMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIGroup) EXPECT() *MockIGroupMockRecorder {
+	return m.recorder
+}
+
+// ById mocks base method.
+func (m *MockIGroup) ById(ctx context.Context, id uint64) (storage.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ById", ctx, id)
+	ret0, _ := ret[0].(storage.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ById indicates an expected call of ById.
+func (mr *MockIGroupMockRecorder) ById(ctx, id any) *IGroupByIdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ById", reflect.TypeOf((*MockIGroup)(nil).ById), ctx, id)
+	return &IGroupByIdCall{Call: call}
+}
+
+// IGroupByIdCall wrap *gomock.Call
+type IGroupByIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupByIdCall) Return(arg0 storage.Group, arg1 error) *IGroupByIdCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupByIdCall) Do(f func(context.Context, uint64) (storage.Group, error)) *IGroupByIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupByIdCall) DoAndReturn(f func(context.Context, uint64) (storage.Group, error)) *IGroupByIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIGroup) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIGroupMockRecorder) CursorList(ctx, id, limit, order, cmp any) *IGroupCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIGroup)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &IGroupCursorListCall{Call: call}
+}
+
+// IGroupCursorListCall wrap *gomock.Call
+type IGroupCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupCursorListCall) Return(arg0 []*storage.Group, arg1 error) *IGroupCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Group, error)) *IGroupCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Group, error)) *IGroupCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIGroup) GetByID(ctx context.Context, id uint64) (*storage.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIGroupMockRecorder) GetByID(ctx, id any) *IGroupGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIGroup)(nil).GetByID), ctx, id)
+	return &IGroupGetByIDCall{Call: call}
+}
+
+// IGroupGetByIDCall wrap *gomock.Call
+type IGroupGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupGetByIDCall) Return(arg0 *storage.Group, arg1 error) *IGroupGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupGetByIDCall) Do(f func(context.Context, uint64) (*storage.Group, error)) *IGroupGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.Group, error)) *IGroupGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIGroup) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIGroupMockRecorder) IsNoRows(err any) *IGroupIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIGroup)(nil).IsNoRows), err)
+	return &IGroupIsNoRowsCall{Call: call}
+}
+
+// IGroupIsNoRowsCall wrap *gomock.Call
+type IGroupIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupIsNoRowsCall) Return(arg0 bool) *IGroupIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupIsNoRowsCall) Do(f func(error) bool) *IGroupIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupIsNoRowsCall) DoAndReturn(f func(error) bool) *IGroupIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIGroup) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIGroupMockRecorder) LastID(ctx any) *IGroupLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIGroup)(nil).LastID), ctx)
+	return &IGroupLastIDCall{Call: call}
+}
+
+// IGroupLastIDCall wrap *gomock.Call
+type IGroupLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupLastIDCall) Return(arg0 uint64, arg1 error) *IGroupLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupLastIDCall) Do(f func(context.Context) (uint64, error)) *IGroupLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *IGroupLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIGroup) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIGroupMockRecorder) List(ctx, limit, offset, order any) *IGroupListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIGroup)(nil).List), ctx, limit, offset, order)
+	return &IGroupListCall{Call: call}
+}
+
+// IGroupListCall wrap *gomock.Call
+type IGroupListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupListCall) Return(arg0 []*storage.Group, arg1 error) *IGroupListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Group, error)) *IGroupListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Group, error)) *IGroupListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListWithFilters mocks base method.
+func (m *MockIGroup) ListWithFilters(ctx context.Context, filters storage.GroupFilters) ([]storage.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWithFilters", ctx, filters)
+	ret0, _ := ret[0].([]storage.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWithFilters indicates an expected call of ListWithFilters.
+func (mr *MockIGroupMockRecorder) ListWithFilters(ctx, filters any) *IGroupListWithFiltersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithFilters", reflect.TypeOf((*MockIGroup)(nil).ListWithFilters), ctx, filters)
+	return &IGroupListWithFiltersCall{Call: call}
+}
+
+// IGroupListWithFiltersCall wrap *gomock.Call
+type IGroupListWithFiltersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupListWithFiltersCall) Return(arg0 []storage.Group, arg1 error) *IGroupListWithFiltersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupListWithFiltersCall) Do(f func(context.Context, storage.GroupFilters) ([]storage.Group, error)) *IGroupListWithFiltersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupListWithFiltersCall) DoAndReturn(f func(context.Context, storage.GroupFilters) ([]storage.Group, error)) *IGroupListWithFiltersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIGroup) Save(ctx context.Context, m *storage.Group) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIGroupMockRecorder) Save(ctx, m any) *IGroupSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIGroup)(nil).Save), ctx, m)
+	return &IGroupSaveCall{Call: call}
+}
+
+// IGroupSaveCall wrap *gomock.Call
+type IGroupSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupSaveCall) Return(arg0 error) *IGroupSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupSaveCall) Do(f func(context.Context, *storage.Group) error) *IGroupSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupSaveCall) DoAndReturn(f func(context.Context, *storage.Group) error) *IGroupSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIGroup) Update(ctx context.Context, m *storage.Group) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIGroupMockRecorder) Update(ctx, m any) *IGroupUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIGroup)(nil).Update), ctx, m)
+	return &IGroupUpdateCall{Call: call}
+}
+
+// IGroupUpdateCall wrap *gomock.Call
+type IGroupUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupUpdateCall) Return(arg0 error) *IGroupUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupUpdateCall) Do(f func(context.Context, *storage.Group) error) *IGroupUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupUpdateCall) DoAndReturn(f func(context.Context, *storage.Group) error) *IGroupUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,353 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: group_member.go
+//
+// Generated by this command:
+//
+//	mockgen -source=group_member.go -destination=mock/group_member.go -package=mock -typed
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/celestia-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIGroupMember is a mock of IGroupMember interface.
+type MockIGroupMember struct {
+	ctrl     *gomock.Controller
+	recorder *MockIGroupMemberMockRecorder
+}
+
+// MockIGroupMemberMockRecorder is the mock recorder for MockIGroupMember.
+type MockIGroupMemberMockRecorder struct {
+	mock *MockIGroupMember
+}
+
+// NewMockIGroupMember creates a new mock instance.
+func NewMockIGroupMember(ctrl *gomock.Controller) *MockIGroupMember {
+	mock := &MockIGroupMember{ctrl: ctrl}
+	mock.recorder = &MockIGroupMemberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIGroupMember) EXPECT() *MockIGroupMemberMockRecorder {
+	return m.recorder
+}
+
+// ByGroupId mocks base method.
+func (m *MockIGroupMember) ByGroupId(ctx context.Context, groupId uint64, limit, offset int) ([]storage.GroupMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByGroupId", ctx, groupId, limit, offset)
+	ret0, _ := ret[0].([]storage.GroupMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByGroupId indicates an expected call of ByGroupId.
+func (mr *MockIGroupMemberMockRecorder) ByGroupId(ctx, groupId, limit, offset any) *IGroupMemberByGroupIdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByGroupId", reflect.TypeOf((*MockIGroupMember)(nil).ByGroupId), ctx, groupId, limit, offset)
+	return &IGroupMemberByGroupIdCall{Call: call}
+}
+
+// IGroupMemberByGroupIdCall wrap *gomock.Call
+type IGroupMemberByGroupIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupMemberByGroupIdCall) Return(arg0 []storage.GroupMember, arg1 error) *IGroupMemberByGroupIdCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupMemberByGroupIdCall) Do(f func(context.Context, uint64, int, int) ([]storage.GroupMember, error)) *IGroupMemberByGroupIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupMemberByGroupIdCall) DoAndReturn(f func(context.Context, uint64, int, int) ([]storage.GroupMember, error)) *IGroupMemberByGroupIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIGroupMember) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.GroupMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.GroupMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIGroupMemberMockRecorder) CursorList(ctx, id, limit, order, cmp any) *IGroupMemberCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIGroupMember)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &IGroupMemberCursorListCall{Call: call}
+}
+
+// IGroupMemberCursorListCall wrap *gomock.Call
+type IGroupMemberCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupMemberCursorListCall) Return(arg0 []*storage.GroupMember, arg1 error) *IGroupMemberCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupMemberCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.GroupMember, error)) *IGroupMemberCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupMemberCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.GroupMember, error)) *IGroupMemberCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIGroupMember) GetByID(ctx context.Context, id uint64) (*storage.GroupMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.GroupMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIGroupMemberMockRecorder) GetByID(ctx, id any) *IGroupMemberGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIGroupMember)(nil).GetByID), ctx, id)
+	return &IGroupMemberGetByIDCall{Call: call}
+}
+
+// IGroupMemberGetByIDCall wrap *gomock.Call
+type IGroupMemberGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupMemberGetByIDCall) Return(arg0 *storage.GroupMember, arg1 error) *IGroupMemberGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupMemberGetByIDCall) Do(f func(context.Context, uint64) (*storage.GroupMember, error)) *IGroupMemberGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupMemberGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.GroupMember, error)) *IGroupMemberGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIGroupMember) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIGroupMemberMockRecorder) IsNoRows(err any) *IGroupMemberIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIGroupMember)(nil).IsNoRows), err)
+	return &IGroupMemberIsNoRowsCall{Call: call}
+}
+
+// IGroupMemberIsNoRowsCall wrap *gomock.Call
+type IGroupMemberIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupMemberIsNoRowsCall) Return(arg0 bool) *IGroupMemberIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupMemberIsNoRowsCall) Do(f func(error) bool) *IGroupMemberIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupMemberIsNoRowsCall) DoAndReturn(f func(error) bool) *IGroupMemberIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIGroupMember) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIGroupMemberMockRecorder) LastID(ctx any) *IGroupMemberLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIGroupMember)(nil).LastID), ctx)
+	return &IGroupMemberLastIDCall{Call: call}
+}
+
+// IGroupMemberLastIDCall wrap *gomock.Call
+type IGroupMemberLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupMemberLastIDCall) Return(arg0 uint64, arg1 error) *IGroupMemberLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupMemberLastIDCall) Do(f func(context.Context) (uint64, error)) *IGroupMemberLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupMemberLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *IGroupMemberLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIGroupMember) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.GroupMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.GroupMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIGroupMemberMockRecorder) List(ctx, limit, offset, order any) *IGroupMemberListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIGroupMember)(nil).List), ctx, limit, offset, order)
+	return &IGroupMemberListCall{Call: call}
+}
+
+// IGroupMemberListCall wrap *gomock.Call
+type IGroupMemberListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupMemberListCall) Return(arg0 []*storage.GroupMember, arg1 error) *IGroupMemberListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupMemberListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.GroupMember, error)) *IGroupMemberListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupMemberListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.GroupMember, error)) *IGroupMemberListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIGroupMember) Save(ctx context.Context, m *storage.GroupMember) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIGroupMemberMockRecorder) Save(ctx, m any) *IGroupMemberSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIGroupMember)(nil).Save), ctx, m)
+	return &IGroupMemberSaveCall{Call: call}
+}
+
+// IGroupMemberSaveCall wrap *gomock.Call
+type IGroupMemberSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupMemberSaveCall) Return(arg0 error) *IGroupMemberSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupMemberSaveCall) Do(f func(context.Context, *storage.GroupMember) error) *IGroupMemberSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupMemberSaveCall) DoAndReturn(f func(context.Context, *storage.GroupMember) error) *IGroupMemberSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIGroupMember) Update(ctx context.Context, m *storage.GroupMember) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIGroupMemberMockRecorder) Update(ctx, m any) *IGroupMemberUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIGroupMember)(nil).Update), ctx, m)
+	return &IGroupMemberUpdateCall{Call: call}
+}
+
+// IGroupMemberUpdateCall wrap *gomock.Call
+type IGroupMemberUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupMemberUpdateCall) Return(arg0 error) *IGroupMemberUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupMemberUpdateCall) Do(f func(context.Context, *storage.GroupMember) error) *IGroupMemberUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupMemberUpdateCall) DoAndReturn(f func(context.Context, *storage.GroupMember) error) *IGroupMemberUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,392 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: group_policy.go
+//
+// Generated by this command:
+//
+//	mockgen -source=group_policy.go -destination=mock/group_policy.go -package=mock -typed
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/celestia-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIGroupPolicy is a mock of IGroupPolicy interface.
+type MockIGroupPolicy struct {
+	ctrl     *gomock.Controller
+	recorder *MockIGroupPolicyMockRecorder
+}
+
+// MockIGroupPolicyMockRecorder is the mock recorder for MockIGroupPolicy.
+type MockIGroupPolicyMockRecorder struct {
+	mock *MockIGroupPolicy
+}
+
+// NewMockIGroupPolicy creates a new mock instance.
+func NewMockIGroupPolicy(ctrl *gomock.Controller) *MockIGroupPolicy {
+	mock := &MockIGroupPolicy{ctrl: ctrl}
+	mock.recorder = &MockIGroupPolicyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIGroupPolicy) EXPECT() *MockIGroupPolicyMockRecorder {
+	return m.recorder
+}
+
+// ByAddressId mocks base method.
+func (m *MockIGroupPolicy) ByAddressId(ctx context.Context, addressId uint64) (storage.GroupPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByAddressId", ctx, addressId)
+	ret0, _ := ret[0].(storage.GroupPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByAddressId indicates an expected call of ByAddressId.
+func (mr *MockIGroupPolicyMockRecorder) ByAddressId(ctx, addressId any) *IGroupPolicyByAddressIdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByAddressId", reflect.TypeOf((*MockIGroupPolicy)(nil).ByAddressId), ctx, addressId)
+	return &IGroupPolicyByAddressIdCall{Call: call}
+}
+
+// IGroupPolicyByAddressIdCall wrap *gomock.Call
+type IGroupPolicyByAddressIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupPolicyByAddressIdCall) Return(arg0 storage.GroupPolicy, arg1 error) *IGroupPolicyByAddressIdCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupPolicyByAddressIdCall) Do(f func(context.Context, uint64) (storage.GroupPolicy, error)) *IGroupPolicyByAddressIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupPolicyByAddressIdCall) DoAndReturn(f func(context.Context, uint64) (storage.GroupPolicy, error)) *IGroupPolicyByAddressIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ByGroupId mocks base method.
+func (m *MockIGroupPolicy) ByGroupId(ctx context.Context, groupId uint64, limit, offset int) ([]storage.GroupPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByGroupId", ctx, groupId, limit, offset)
+	ret0, _ := ret[0].([]storage.GroupPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByGroupId indicates an expected call of ByGroupId.
+func (mr *MockIGroupPolicyMockRecorder) ByGroupId(ctx, groupId, limit, offset any) *IGroupPolicyByGroupIdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByGroupId", reflect.TypeOf((*MockIGroupPolicy)(nil).ByGroupId), ctx, groupId, limit, offset)
+	return &IGroupPolicyByGroupIdCall{Call: call}
+}
+
+// IGroupPolicyByGroupIdCall wrap *gomock.Call
+type IGroupPolicyByGroupIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupPolicyByGroupIdCall) Return(arg0 []storage.GroupPolicy, arg1 error) *IGroupPolicyByGroupIdCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupPolicyByGroupIdCall) Do(f func(context.Context, uint64, int, int) ([]storage.GroupPolicy, error)) *IGroupPolicyByGroupIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupPolicyByGroupIdCall) DoAndReturn(f func(context.Context, uint64, int, int) ([]storage.GroupPolicy, error)) *IGroupPolicyByGroupIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIGroupPolicy) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.GroupPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.GroupPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIGroupPolicyMockRecorder) CursorList(ctx, id, limit, order, cmp any) *IGroupPolicyCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIGroupPolicy)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &IGroupPolicyCursorListCall{Call: call}
+}
+
+// IGroupPolicyCursorListCall wrap *gomock.Call
+type IGroupPolicyCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupPolicyCursorListCall) Return(arg0 []*storage.GroupPolicy, arg1 error) *IGroupPolicyCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupPolicyCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.GroupPolicy, error)) *IGroupPolicyCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupPolicyCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.GroupPolicy, error)) *IGroupPolicyCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIGroupPolicy) GetByID(ctx context.Context, id uint64) (*storage.GroupPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.GroupPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIGroupPolicyMockRecorder) GetByID(ctx, id any) *IGroupPolicyGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIGroupPolicy)(nil).GetByID), ctx, id)
+	return &IGroupPolicyGetByIDCall{Call: call}
+}
+
+// IGroupPolicyGetByIDCall wrap *gomock.Call
+type IGroupPolicyGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupPolicyGetByIDCall) Return(arg0 *storage.GroupPolicy, arg1 error) *IGroupPolicyGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupPolicyGetByIDCall) Do(f func(context.Context, uint64) (*storage.GroupPolicy, error)) *IGroupPolicyGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupPolicyGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.GroupPolicy, error)) *IGroupPolicyGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIGroupPolicy) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIGroupPolicyMockRecorder) IsNoRows(err any) *IGroupPolicyIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIGroupPolicy)(nil).IsNoRows), err)
+	return &IGroupPolicyIsNoRowsCall{Call: call}
+}
+
+// IGroupPolicyIsNoRowsCall wrap *gomock.Call
+type IGroupPolicyIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupPolicyIsNoRowsCall) Return(arg0 bool) *IGroupPolicyIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupPolicyIsNoRowsCall) Do(f func(error) bool) *IGroupPolicyIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupPolicyIsNoRowsCall) DoAndReturn(f func(error) bool) *IGroupPolicyIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIGroupPolicy) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIGroupPolicyMockRecorder) LastID(ctx any) *IGroupPolicyLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIGroupPolicy)(nil).LastID), ctx)
+	return &IGroupPolicyLastIDCall{Call: call}
+}
+
+// IGroupPolicyLastIDCall wrap *gomock.Call
+type IGroupPolicyLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupPolicyLastIDCall) Return(arg0 uint64, arg1 error) *IGroupPolicyLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupPolicyLastIDCall) Do(f func(context.Context) (uint64, error)) *IGroupPolicyLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupPolicyLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *IGroupPolicyLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIGroupPolicy) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.GroupPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.GroupPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIGroupPolicyMockRecorder) List(ctx, limit, offset, order any) *IGroupPolicyListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIGroupPolicy)(nil).List), ctx, limit, offset, order)
+	return &IGroupPolicyListCall{Call: call}
+}
+
+// IGroupPolicyListCall wrap *gomock.Call
+type IGroupPolicyListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupPolicyListCall) Return(arg0 []*storage.GroupPolicy, arg1 error) *IGroupPolicyListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupPolicyListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.GroupPolicy, error)) *IGroupPolicyListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupPolicyListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.GroupPolicy, error)) *IGroupPolicyListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIGroupPolicy) Save(ctx context.Context, m *storage.GroupPolicy) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIGroupPolicyMockRecorder) Save(ctx, m any) *IGroupPolicySaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIGroupPolicy)(nil).Save), ctx, m)
+	return &IGroupPolicySaveCall{Call: call}
+}
+
+// IGroupPolicySaveCall wrap *gomock.Call
+type IGroupPolicySaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupPolicySaveCall) Return(arg0 error) *IGroupPolicySaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupPolicySaveCall) Do(f func(context.Context, *storage.GroupPolicy) error) *IGroupPolicySaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupPolicySaveCall) DoAndReturn(f func(context.Context, *storage.GroupPolicy) error) *IGroupPolicySaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIGroupPolicy) Update(ctx context.Context, m *storage.GroupPolicy) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIGroupPolicyMockRecorder) Update(ctx, m any) *IGroupPolicyUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIGroupPolicy)(nil).Update), ctx, m)
+	return &IGroupPolicyUpdateCall{Call: call}
+}
+
+// IGroupPolicyUpdateCall wrap *gomock.Call
+type IGroupPolicyUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupPolicyUpdateCall) Return(arg0 error) *IGroupPolicyUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupPolicyUpdateCall) Do(f func(context.Context, *storage.GroupPolicy) error) *IGroupPolicyUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupPolicyUpdateCall) DoAndReturn(f func(context.Context, *storage.GroupPolicy) error) *IGroupPolicyUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,392 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: group_proposal.go
+//
+// Generated by this command:
+//
+//	mockgen -source=group_proposal.go -destination=mock/group_proposal.go -package=mock -typed
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/celestia-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIGroupProposal is a mock of IGroupProposal interface.
+type MockIGroupProposal struct {
+	ctrl     *gomock.Controller
+	recorder *MockIGroupProposalMockRecorder
+}
+
+// MockIGroupProposalMockRecorder is the mock recorder for MockIGroupProposal.
+type MockIGroupProposalMockRecorder struct {
+	mock *MockIGroupProposal
+}
+
+// NewMockIGroupProposal creates a new mock instance.
+func NewMockIGroupProposal(ctrl *gomock.Controller) *MockIGroupProposal {
+	mock := &MockIGroupProposal{ctrl: ctrl}
+	mock.recorder = &MockIGroupProposalMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIGroupProposal) EXPECT() *MockIGroupProposalMockRecorder {
+	return m.recorder
+}
+
+// ById mocks base method.
+func (m *MockIGroupProposal) ById(ctx context.Context, id uint64) (storage.GroupProposal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ById", ctx, id)
+	ret0, _ := ret[0].(storage.GroupProposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ById indicates an expected call of ById.
+func (mr *MockIGroupProposalMockRecorder) ById(ctx, id any) *IGroupProposalByIdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ById", reflect.TypeOf((*MockIGroupProposal)(nil).ById), ctx, id)
+	return &IGroupProposalByIdCall{Call: call}
+}
+
+// IGroupProposalByIdCall wrap *gomock.Call
+type IGroupProposalByIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupProposalByIdCall) Return(arg0 storage.GroupProposal, arg1 error) *IGroupProposalByIdCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupProposalByIdCall) Do(f func(context.Context, uint64) (storage.GroupProposal, error)) *IGroupProposalByIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupProposalByIdCall) DoAndReturn(f func(context.Context, uint64) (storage.GroupProposal, error)) *IGroupProposalByIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIGroupProposal) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.GroupProposal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.GroupProposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIGroupProposalMockRecorder) CursorList(ctx, id, limit, order, cmp any) *IGroupProposalCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIGroupProposal)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &IGroupProposalCursorListCall{Call: call}
+}
+
+// IGroupProposalCursorListCall wrap *gomock.Call
+type IGroupProposalCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupProposalCursorListCall) Return(arg0 []*storage.GroupProposal, arg1 error) *IGroupProposalCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupProposalCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.GroupProposal, error)) *IGroupProposalCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupProposalCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.GroupProposal, error)) *IGroupProposalCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIGroupProposal) GetByID(ctx context.Context, id uint64) (*storage.GroupProposal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.GroupProposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIGroupProposalMockRecorder) GetByID(ctx, id any) *IGroupProposalGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIGroupProposal)(nil).GetByID), ctx, id)
+	return &IGroupProposalGetByIDCall{Call: call}
+}
+
+// IGroupProposalGetByIDCall wrap *gomock.Call
+type IGroupProposalGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupProposalGetByIDCall) Return(arg0 *storage.GroupProposal, arg1 error) *IGroupProposalGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupProposalGetByIDCall) Do(f func(context.Context, uint64) (*storage.GroupProposal, error)) *IGroupProposalGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupProposalGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.GroupProposal, error)) *IGroupProposalGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIGroupProposal) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIGroupProposalMockRecorder) IsNoRows(err any) *IGroupProposalIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIGroupProposal)(nil).IsNoRows), err)
+	return &IGroupProposalIsNoRowsCall{Call: call}
+}
+
+// IGroupProposalIsNoRowsCall wrap *gomock.Call
+type IGroupProposalIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupProposalIsNoRowsCall) Return(arg0 bool) *IGroupProposalIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupProposalIsNoRowsCall) Do(f func(error) bool) *IGroupProposalIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupProposalIsNoRowsCall) DoAndReturn(f func(error) bool) *IGroupProposalIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIGroupProposal) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIGroupProposalMockRecorder) LastID(ctx any) *IGroupProposalLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIGroupProposal)(nil).LastID), ctx)
+	return &IGroupProposalLastIDCall{Call: call}
+}
+
+// IGroupProposalLastIDCall wrap *gomock.Call
+type IGroupProposalLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupProposalLastIDCall) Return(arg0 uint64, arg1 error) *IGroupProposalLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupProposalLastIDCall) Do(f func(context.Context) (uint64, error)) *IGroupProposalLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupProposalLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *IGroupProposalLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIGroupProposal) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.GroupProposal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.GroupProposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIGroupProposalMockRecorder) List(ctx, limit, offset, order any) *IGroupProposalListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIGroupProposal)(nil).List), ctx, limit, offset, order)
+	return &IGroupProposalListCall{Call: call}
+}
+
+// IGroupProposalListCall wrap *gomock.Call
+type IGroupProposalListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupProposalListCall) Return(arg0 []*storage.GroupProposal, arg1 error) *IGroupProposalListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupProposalListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.GroupProposal, error)) *IGroupProposalListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupProposalListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.GroupProposal, error)) *IGroupProposalListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListWithFilters mocks base method.
+func (m *MockIGroupProposal) ListWithFilters(ctx context.Context, filters storage.GroupProposalFilters) ([]storage.GroupProposal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWithFilters", ctx, filters)
+	ret0, _ := ret[0].([]storage.GroupProposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWithFilters indicates an expected call of ListWithFilters.
+func (mr *MockIGroupProposalMockRecorder) ListWithFilters(ctx, filters any) *IGroupProposalListWithFiltersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithFilters", reflect.TypeOf((*MockIGroupProposal)(nil).ListWithFilters), ctx, filters)
+	return &IGroupProposalListWithFiltersCall{Call: call}
+}
+
+// IGroupProposalListWithFiltersCall wrap *gomock.Call
+type IGroupProposalListWithFiltersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupProposalListWithFiltersCall) Return(arg0 []storage.GroupProposal, arg1 error) *IGroupProposalListWithFiltersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupProposalListWithFiltersCall) Do(f func(context.Context, storage.GroupProposalFilters) ([]storage.GroupProposal, error)) *IGroupProposalListWithFiltersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupProposalListWithFiltersCall) DoAndReturn(f func(context.Context, storage.GroupProposalFilters) ([]storage.GroupProposal, error)) *IGroupProposalListWithFiltersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIGroupProposal) Save(ctx context.Context, m *storage.GroupProposal) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIGroupProposalMockRecorder) Save(ctx, m any) *IGroupProposalSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIGroupProposal)(nil).Save), ctx, m)
+	return &IGroupProposalSaveCall{Call: call}
+}
+
+// IGroupProposalSaveCall wrap *gomock.Call
+type IGroupProposalSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupProposalSaveCall) Return(arg0 error) *IGroupProposalSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupProposalSaveCall) Do(f func(context.Context, *storage.GroupProposal) error) *IGroupProposalSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupProposalSaveCall) DoAndReturn(f func(context.Context, *storage.GroupProposal) error) *IGroupProposalSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIGroupProposal) Update(ctx context.Context, m *storage.GroupProposal) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIGroupProposalMockRecorder) Update(ctx, m any) *IGroupProposalUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIGroupProposal)(nil).Update), ctx, m)
+	return &IGroupProposalUpdateCall{Call: call}
+}
+
+// IGroupProposalUpdateCall wrap *gomock.Call
+type IGroupProposalUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupProposalUpdateCall) Return(arg0 error) *IGroupProposalUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupProposalUpdateCall) Do(f func(context.Context, *storage.GroupProposal) error) *IGroupProposalUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupProposalUpdateCall) DoAndReturn(f func(context.Context, *storage.GroupProposal) error) *IGroupProposalUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,353 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: group_vote.go
+//
+// Generated by this command:
+//
+//	mockgen -source=group_vote.go -destination=mock/group_vote.go -package=mock -typed
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/celestia-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIGroupVote is a mock of IGroupVote interface.
+type MockIGroupVote struct {
+	ctrl     *gomock.Controller
+	recorder *MockIGroupVoteMockRecorder
+}
+
+// MockIGroupVoteMockRecorder is the mock recorder for MockIGroupVote.
+type MockIGroupVoteMockRecorder struct {
+	mock *MockIGroupVote
+}
+
+// NewMockIGroupVote creates a new mock instance.
+func NewMockIGroupVote(ctrl *gomock.Controller) *MockIGroupVote {
+	mock := &MockIGroupVote{ctrl: ctrl}
+	mock.recorder = &MockIGroupVoteMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIGroupVote) EXPECT() *MockIGroupVoteMockRecorder {
+	return m.recorder
+}
+
+// ByProposalId mocks base method.
+func (m *MockIGroupVote) ByProposalId(ctx context.Context, proposalId uint64, limit, offset int) ([]storage.GroupVote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByProposalId", ctx, proposalId, limit, offset)
+	ret0, _ := ret[0].([]storage.GroupVote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByProposalId indicates an expected call of ByProposalId.
+func (mr *MockIGroupVoteMockRecorder) ByProposalId(ctx, proposalId, limit, offset any) *IGroupVoteByProposalIdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByProposalId", reflect.TypeOf((*MockIGroupVote)(nil).ByProposalId), ctx, proposalId, limit, offset)
+	return &IGroupVoteByProposalIdCall{Call: call}
+}
+
+// IGroupVoteByProposalIdCall wrap *gomock.Call
+type IGroupVoteByProposalIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupVoteByProposalIdCall) Return(arg0 []storage.GroupVote, arg1 error) *IGroupVoteByProposalIdCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupVoteByProposalIdCall) Do(f func(context.Context, uint64, int, int) ([]storage.GroupVote, error)) *IGroupVoteByProposalIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupVoteByProposalIdCall) DoAndReturn(f func(context.Context, uint64, int, int) ([]storage.GroupVote, error)) *IGroupVoteByProposalIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIGroupVote) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.GroupVote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.GroupVote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIGroupVoteMockRecorder) CursorList(ctx, id, limit, order, cmp any) *IGroupVoteCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIGroupVote)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &IGroupVoteCursorListCall{Call: call}
+}
+
+// IGroupVoteCursorListCall wrap *gomock.Call
+type IGroupVoteCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupVoteCursorListCall) Return(arg0 []*storage.GroupVote, arg1 error) *IGroupVoteCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupVoteCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.GroupVote, error)) *IGroupVoteCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupVoteCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.GroupVote, error)) *IGroupVoteCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIGroupVote) GetByID(ctx context.Context, id uint64) (*storage.GroupVote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.GroupVote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIGroupVoteMockRecorder) GetByID(ctx, id any) *IGroupVoteGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIGroupVote)(nil).GetByID), ctx, id)
+	return &IGroupVoteGetByIDCall{Call: call}
+}
+
+// IGroupVoteGetByIDCall wrap *gomock.Call
+type IGroupVoteGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupVoteGetByIDCall) Return(arg0 *storage.GroupVote, arg1 error) *IGroupVoteGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupVoteGetByIDCall) Do(f func(context.Context, uint64) (*storage.GroupVote, error)) *IGroupVoteGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupVoteGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.GroupVote, error)) *IGroupVoteGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIGroupVote) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIGroupVoteMockRecorder) IsNoRows(err any) *IGroupVoteIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIGroupVote)(nil).IsNoRows), err)
+	return &IGroupVoteIsNoRowsCall{Call: call}
+}
+
+// IGroupVoteIsNoRowsCall wrap *gomock.Call
+type IGroupVoteIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupVoteIsNoRowsCall) Return(arg0 bool) *IGroupVoteIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupVoteIsNoRowsCall) Do(f func(error) bool) *IGroupVoteIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupVoteIsNoRowsCall) DoAndReturn(f func(error) bool) *IGroupVoteIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIGroupVote) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIGroupVoteMockRecorder) LastID(ctx any) *IGroupVoteLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIGroupVote)(nil).LastID), ctx)
+	return &IGroupVoteLastIDCall{Call: call}
+}
+
+// IGroupVoteLastIDCall wrap *gomock.Call
+type IGroupVoteLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupVoteLastIDCall) Return(arg0 uint64, arg1 error) *IGroupVoteLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupVoteLastIDCall) Do(f func(context.Context) (uint64, error)) *IGroupVoteLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupVoteLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *IGroupVoteLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIGroupVote) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.GroupVote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.GroupVote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIGroupVoteMockRecorder) List(ctx, limit, offset, order any) *IGroupVoteListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIGroupVote)(nil).List), ctx, limit, offset, order)
+	return &IGroupVoteListCall{Call: call}
+}
+
+// IGroupVoteListCall wrap *gomock.Call
+type IGroupVoteListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupVoteListCall) Return(arg0 []*storage.GroupVote, arg1 error) *IGroupVoteListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupVoteListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.GroupVote, error)) *IGroupVoteListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupVoteListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.GroupVote, error)) *IGroupVoteListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIGroupVote) Save(ctx context.Context, m *storage.GroupVote) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIGroupVoteMockRecorder) Save(ctx, m any) *IGroupVoteSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIGroupVote)(nil).Save), ctx, m)
+	return &IGroupVoteSaveCall{Call: call}
+}
+
+// IGroupVoteSaveCall wrap *gomock.Call
+type IGroupVoteSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupVoteSaveCall) Return(arg0 error) *IGroupVoteSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupVoteSaveCall) Do(f func(context.Context, *storage.GroupVote) error) *IGroupVoteSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupVoteSaveCall) DoAndReturn(f func(context.Context, *storage.GroupVote) error) *IGroupVoteSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIGroupVote) Update(ctx context.Context, m *storage.GroupVote) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIGroupVoteMockRecorder) Update(ctx, m any) *IGroupVoteUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIGroupVote)(nil).Update), ctx, m)
+	return &IGroupVoteUpdateCall{Call: call}
+}
+
+// IGroupVoteUpdateCall wrap *gomock.Call
+type IGroupVoteUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IGroupVoteUpdateCall) Return(arg0 error) *IGroupVoteUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IGroupVoteUpdateCall) Do(f func(context.Context, *storage.GroupVote) error) *IGroupVoteUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IGroupVoteUpdateCall) DoAndReturn(f func(context.Context, *storage.GroupVote) error) *IGroupVoteUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,392 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ibc_channel.go
+//
+// Generated by this command:
+//
+//	mockgen -source=ibc_channel.go -destination=mock/ibc_channel.go -package=mock -typed
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/celestia-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIIbcChannel is a mock of IIbcChannel interface.
+type MockIIbcChannel struct {
+	ctrl     *gomock.Controller
+	recorder *MockIIbcChannelMockRecorder
+}
+
+// MockIIbcChannelMockRecorder is the mock recorder for MockIIbcChannel.
+type MockIIbcChannelMockRecorder struct {
+	mock *MockIIbcChannel
+}
+
+// NewMockIIbcChannel creates a new mock instance.
+func NewMockIIbcChannel(ctrl *gomock.Controller) *MockIIbcChannel {
+	mock := &MockIIbcChannel{ctrl: ctrl}
+	mock.recorder = &MockIIbcChannelMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIIbcChannel) EXPECT() *MockIIbcChannelMockRecorder {
+	return m.recorder
+}
+
+// ById mocks base method.
+func (m *MockIIbcChannel) ById(ctx context.Context, id string) (storage.IbcChannel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ById", ctx, id)
+	ret0, _ := ret[0].(storage.IbcChannel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ById indicates an expected call of ById.
+func (mr *MockIIbcChannelMockRecorder) ById(ctx, id any) *IIbcChannelByIdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ById", reflect.TypeOf((*MockIIbcChannel)(nil).ById), ctx, id)
+	return &IIbcChannelByIdCall{Call: call}
+}
+
+// IIbcChannelByIdCall wrap *gomock.Call
+type IIbcChannelByIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcChannelByIdCall) Return(arg0 storage.IbcChannel, arg1 error) *IIbcChannelByIdCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcChannelByIdCall) Do(f func(context.Context, string) (storage.IbcChannel, error)) *IIbcChannelByIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcChannelByIdCall) DoAndReturn(f func(context.Context, string) (storage.IbcChannel, error)) *IIbcChannelByIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIIbcChannel) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.IbcChannel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.IbcChannel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIIbcChannelMockRecorder) CursorList(ctx, id, limit, order, cmp any) *IIbcChannelCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIIbcChannel)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &IIbcChannelCursorListCall{Call: call}
+}
+
+// IIbcChannelCursorListCall wrap *gomock.Call
+type IIbcChannelCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcChannelCursorListCall) Return(arg0 []*storage.IbcChannel, arg1 error) *IIbcChannelCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcChannelCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.IbcChannel, error)) *IIbcChannelCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcChannelCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.IbcChannel, error)) *IIbcChannelCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIIbcChannel) GetByID(ctx context.Context, id uint64) (*storage.IbcChannel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.IbcChannel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIIbcChannelMockRecorder) GetByID(ctx, id any) *IIbcChannelGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIIbcChannel)(nil).GetByID), ctx, id)
+	return &IIbcChannelGetByIDCall{Call: call}
+}
+
+// IIbcChannelGetByIDCall wrap *gomock.Call
+type IIbcChannelGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcChannelGetByIDCall) Return(arg0 *storage.IbcChannel, arg1 error) *IIbcChannelGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcChannelGetByIDCall) Do(f func(context.Context, uint64) (*storage.IbcChannel, error)) *IIbcChannelGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcChannelGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.IbcChannel, error)) *IIbcChannelGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIIbcChannel) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIIbcChannelMockRecorder) IsNoRows(err any) *IIbcChannelIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIIbcChannel)(nil).IsNoRows), err)
+	return &IIbcChannelIsNoRowsCall{Call: call}
+}
+
+// IIbcChannelIsNoRowsCall wrap *gomock.Call
+type IIbcChannelIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcChannelIsNoRowsCall) Return(arg0 bool) *IIbcChannelIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcChannelIsNoRowsCall) Do(f func(error) bool) *IIbcChannelIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcChannelIsNoRowsCall) DoAndReturn(f func(error) bool) *IIbcChannelIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIIbcChannel) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIIbcChannelMockRecorder) LastID(ctx any) *IIbcChannelLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIIbcChannel)(nil).LastID), ctx)
+	return &IIbcChannelLastIDCall{Call: call}
+}
+
+// IIbcChannelLastIDCall wrap *gomock.Call
+type IIbcChannelLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcChannelLastIDCall) Return(arg0 uint64, arg1 error) *IIbcChannelLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcChannelLastIDCall) Do(f func(context.Context) (uint64, error)) *IIbcChannelLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcChannelLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *IIbcChannelLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIIbcChannel) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.IbcChannel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.IbcChannel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIIbcChannelMockRecorder) List(ctx, limit, offset, order any) *IIbcChannelListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIIbcChannel)(nil).List), ctx, limit, offset, order)
+	return &IIbcChannelListCall{Call: call}
+}
+
+// IIbcChannelListCall wrap *gomock.Call
+type IIbcChannelListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcChannelListCall) Return(arg0 []*storage.IbcChannel, arg1 error) *IIbcChannelListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcChannelListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.IbcChannel, error)) *IIbcChannelListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcChannelListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.IbcChannel, error)) *IIbcChannelListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListWithFilters mocks base method.
+func (m *MockIIbcChannel) ListWithFilters(ctx context.Context, filters storage.ListIbcChannelsFilters) ([]storage.IbcChannel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWithFilters", ctx, filters)
+	ret0, _ := ret[0].([]storage.IbcChannel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWithFilters indicates an expected call of ListWithFilters.
+func (mr *MockIIbcChannelMockRecorder) ListWithFilters(ctx, filters any) *IIbcChannelListWithFiltersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithFilters", reflect.TypeOf((*MockIIbcChannel)(nil).ListWithFilters), ctx, filters)
+	return &IIbcChannelListWithFiltersCall{Call: call}
+}
+
+// IIbcChannelListWithFiltersCall wrap *gomock.Call
+type IIbcChannelListWithFiltersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcChannelListWithFiltersCall) Return(arg0 []storage.IbcChannel, arg1 error) *IIbcChannelListWithFiltersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcChannelListWithFiltersCall) Do(f func(context.Context, storage.ListIbcChannelsFilters) ([]storage.IbcChannel, error)) *IIbcChannelListWithFiltersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcChannelListWithFiltersCall) DoAndReturn(f func(context.Context, storage.ListIbcChannelsFilters) ([]storage.IbcChannel, error)) *IIbcChannelListWithFiltersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIIbcChannel) Save(ctx context.Context, m *storage.IbcChannel) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIIbcChannelMockRecorder) Save(ctx, m any) *IIbcChannelSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIIbcChannel)(nil).Save), ctx, m)
+	return &IIbcChannelSaveCall{Call: call}
+}
+
+// IIbcChannelSaveCall wrap *gomock.Call
+type IIbcChannelSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcChannelSaveCall) Return(arg0 error) *IIbcChannelSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcChannelSaveCall) Do(f func(context.Context, *storage.IbcChannel) error) *IIbcChannelSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcChannelSaveCall) DoAndReturn(f func(context.Context, *storage.IbcChannel) error) *IIbcChannelSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIIbcChannel) Update(ctx context.Context, m *storage.IbcChannel) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIIbcChannelMockRecorder) Update(ctx, m any) *IIbcChannelUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIIbcChannel)(nil).Update), ctx, m)
+	return &IIbcChannelUpdateCall{Call: call}
+}
+
+// IIbcChannelUpdateCall wrap *gomock.Call
+type IIbcChannelUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcChannelUpdateCall) Return(arg0 error) *IIbcChannelUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcChannelUpdateCall) Do(f func(context.Context, *storage.IbcChannel) error) *IIbcChannelUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcChannelUpdateCall) DoAndReturn(f func(context.Context, *storage.IbcChannel) error) *IIbcChannelUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
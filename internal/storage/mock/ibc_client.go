@@ -0,0 +1,392 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ibc_client.go
+//
+// Generated by this command:
+//
+//	mockgen -source=ibc_client.go -destination=mock/ibc_client.go -package=mock -typed
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/celestia-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIIbcClient is a mock of IIbcClient interface.
+type MockIIbcClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockIIbcClientMockRecorder
+}
+
+// MockIIbcClientMockRecorder is the mock recorder for MockIIbcClient.
+type MockIIbcClientMockRecorder struct {
+	mock *MockIIbcClient
+}
+
+// NewMockIIbcClient creates a new mock instance.
+func NewMockIIbcClient(ctrl *gomock.Controller) *MockIIbcClient {
+	mock := &MockIIbcClient{ctrl: ctrl}
+	mock.recorder = &MockIIbcClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIIbcClient) EXPECT() *MockIIbcClientMockRecorder {
+	return m.recorder
+}
+
+// ById mocks base method.
+func (m *MockIIbcClient) ById(ctx context.Context, id string) (storage.IbcClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ById", ctx, id)
+	ret0, _ := ret[0].(storage.IbcClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ById indicates an expected call of ById.
+func (mr *MockIIbcClientMockRecorder) ById(ctx, id any) *IIbcClientByIdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ById", reflect.TypeOf((*MockIIbcClient)(nil).ById), ctx, id)
+	return &IIbcClientByIdCall{Call: call}
+}
+
+// IIbcClientByIdCall wrap *gomock.Call
+type IIbcClientByIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcClientByIdCall) Return(arg0 storage.IbcClient, arg1 error) *IIbcClientByIdCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcClientByIdCall) Do(f func(context.Context, string) (storage.IbcClient, error)) *IIbcClientByIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcClientByIdCall) DoAndReturn(f func(context.Context, string) (storage.IbcClient, error)) *IIbcClientByIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIIbcClient) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.IbcClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.IbcClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIIbcClientMockRecorder) CursorList(ctx, id, limit, order, cmp any) *IIbcClientCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIIbcClient)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &IIbcClientCursorListCall{Call: call}
+}
+
+// IIbcClientCursorListCall wrap *gomock.Call
+type IIbcClientCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcClientCursorListCall) Return(arg0 []*storage.IbcClient, arg1 error) *IIbcClientCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcClientCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.IbcClient, error)) *IIbcClientCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcClientCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.IbcClient, error)) *IIbcClientCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIIbcClient) GetByID(ctx context.Context, id uint64) (*storage.IbcClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.IbcClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIIbcClientMockRecorder) GetByID(ctx, id any) *IIbcClientGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIIbcClient)(nil).GetByID), ctx, id)
+	return &IIbcClientGetByIDCall{Call: call}
+}
+
+// IIbcClientGetByIDCall wrap *gomock.Call
+type IIbcClientGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcClientGetByIDCall) Return(arg0 *storage.IbcClient, arg1 error) *IIbcClientGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcClientGetByIDCall) Do(f func(context.Context, uint64) (*storage.IbcClient, error)) *IIbcClientGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcClientGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.IbcClient, error)) *IIbcClientGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIIbcClient) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIIbcClientMockRecorder) IsNoRows(err any) *IIbcClientIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIIbcClient)(nil).IsNoRows), err)
+	return &IIbcClientIsNoRowsCall{Call: call}
+}
+
+// IIbcClientIsNoRowsCall wrap *gomock.Call
+type IIbcClientIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcClientIsNoRowsCall) Return(arg0 bool) *IIbcClientIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcClientIsNoRowsCall) Do(f func(error) bool) *IIbcClientIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcClientIsNoRowsCall) DoAndReturn(f func(error) bool) *IIbcClientIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIIbcClient) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIIbcClientMockRecorder) LastID(ctx any) *IIbcClientLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIIbcClient)(nil).LastID), ctx)
+	return &IIbcClientLastIDCall{Call: call}
+}
+
+// IIbcClientLastIDCall wrap *gomock.Call
+type IIbcClientLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcClientLastIDCall) Return(arg0 uint64, arg1 error) *IIbcClientLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcClientLastIDCall) Do(f func(context.Context) (uint64, error)) *IIbcClientLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcClientLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *IIbcClientLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIIbcClient) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.IbcClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.IbcClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIIbcClientMockRecorder) List(ctx, limit, offset, order any) *IIbcClientListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIIbcClient)(nil).List), ctx, limit, offset, order)
+	return &IIbcClientListCall{Call: call}
+}
+
+// IIbcClientListCall wrap *gomock.Call
+type IIbcClientListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcClientListCall) Return(arg0 []*storage.IbcClient, arg1 error) *IIbcClientListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcClientListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.IbcClient, error)) *IIbcClientListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcClientListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.IbcClient, error)) *IIbcClientListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListWithFilters mocks base method.
+func (m *MockIIbcClient) ListWithFilters(ctx context.Context, filters storage.ListIbcClientsFilters) ([]storage.IbcClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWithFilters", ctx, filters)
+	ret0, _ := ret[0].([]storage.IbcClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWithFilters indicates an expected call of ListWithFilters.
+func (mr *MockIIbcClientMockRecorder) ListWithFilters(ctx, filters any) *IIbcClientListWithFiltersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithFilters", reflect.TypeOf((*MockIIbcClient)(nil).ListWithFilters), ctx, filters)
+	return &IIbcClientListWithFiltersCall{Call: call}
+}
+
+// IIbcClientListWithFiltersCall wrap *gomock.Call
+type IIbcClientListWithFiltersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcClientListWithFiltersCall) Return(arg0 []storage.IbcClient, arg1 error) *IIbcClientListWithFiltersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcClientListWithFiltersCall) Do(f func(context.Context, storage.ListIbcClientsFilters) ([]storage.IbcClient, error)) *IIbcClientListWithFiltersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcClientListWithFiltersCall) DoAndReturn(f func(context.Context, storage.ListIbcClientsFilters) ([]storage.IbcClient, error)) *IIbcClientListWithFiltersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIIbcClient) Save(ctx context.Context, m *storage.IbcClient) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIIbcClientMockRecorder) Save(ctx, m any) *IIbcClientSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIIbcClient)(nil).Save), ctx, m)
+	return &IIbcClientSaveCall{Call: call}
+}
+
+// IIbcClientSaveCall wrap *gomock.Call
+type IIbcClientSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcClientSaveCall) Return(arg0 error) *IIbcClientSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcClientSaveCall) Do(f func(context.Context, *storage.IbcClient) error) *IIbcClientSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcClientSaveCall) DoAndReturn(f func(context.Context, *storage.IbcClient) error) *IIbcClientSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIIbcClient) Update(ctx context.Context, m *storage.IbcClient) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIIbcClientMockRecorder) Update(ctx, m any) *IIbcClientUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIIbcClient)(nil).Update), ctx, m)
+	return &IIbcClientUpdateCall{Call: call}
+}
+
+// IIbcClientUpdateCall wrap *gomock.Call
+type IIbcClientUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcClientUpdateCall) Return(arg0 error) *IIbcClientUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcClientUpdateCall) Do(f func(context.Context, *storage.IbcClient) error) *IIbcClientUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcClientUpdateCall) DoAndReturn(f func(context.Context, *storage.IbcClient) error) *IIbcClientUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,392 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ibc_connection.go
+//
+// Generated by this command:
+//
+//	mockgen -source=ibc_connection.go -destination=mock/ibc_connection.go -package=mock -typed
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/celestia-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIIbcConnection is a mock of IIbcConnection interface.
+type MockIIbcConnection struct {
+	ctrl     *gomock.Controller
+	recorder *MockIIbcConnectionMockRecorder
+}
+
+// MockIIbcConnectionMockRecorder is the mock recorder for MockIIbcConnection.
+type MockIIbcConnectionMockRecorder struct {
+	mock *MockIIbcConnection
+}
+
+// NewMockIIbcConnection creates a new mock instance.
+func NewMockIIbcConnection(ctrl *gomock.Controller) *MockIIbcConnection {
+	mock := &MockIIbcConnection{ctrl: ctrl}
+	mock.recorder = &MockIIbcConnectionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIIbcConnection) EXPECT() *MockIIbcConnectionMockRecorder {
+	return m.recorder
+}
+
+// ById mocks base method.
+func (m *MockIIbcConnection) ById(ctx context.Context, id string) (storage.IbcConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ById", ctx, id)
+	ret0, _ := ret[0].(storage.IbcConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ById indicates an expected call of ById.
+func (mr *MockIIbcConnectionMockRecorder) ById(ctx, id any) *IIbcConnectionByIdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ById", reflect.TypeOf((*MockIIbcConnection)(nil).ById), ctx, id)
+	return &IIbcConnectionByIdCall{Call: call}
+}
+
+// IIbcConnectionByIdCall wrap *gomock.Call
+type IIbcConnectionByIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcConnectionByIdCall) Return(arg0 storage.IbcConnection, arg1 error) *IIbcConnectionByIdCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcConnectionByIdCall) Do(f func(context.Context, string) (storage.IbcConnection, error)) *IIbcConnectionByIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcConnectionByIdCall) DoAndReturn(f func(context.Context, string) (storage.IbcConnection, error)) *IIbcConnectionByIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIIbcConnection) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.IbcConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.IbcConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIIbcConnectionMockRecorder) CursorList(ctx, id, limit, order, cmp any) *IIbcConnectionCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIIbcConnection)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &IIbcConnectionCursorListCall{Call: call}
+}
+
+// IIbcConnectionCursorListCall wrap *gomock.Call
+type IIbcConnectionCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcConnectionCursorListCall) Return(arg0 []*storage.IbcConnection, arg1 error) *IIbcConnectionCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcConnectionCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.IbcConnection, error)) *IIbcConnectionCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcConnectionCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.IbcConnection, error)) *IIbcConnectionCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIIbcConnection) GetByID(ctx context.Context, id uint64) (*storage.IbcConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.IbcConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIIbcConnectionMockRecorder) GetByID(ctx, id any) *IIbcConnectionGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIIbcConnection)(nil).GetByID), ctx, id)
+	return &IIbcConnectionGetByIDCall{Call: call}
+}
+
+// IIbcConnectionGetByIDCall wrap *gomock.Call
+type IIbcConnectionGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcConnectionGetByIDCall) Return(arg0 *storage.IbcConnection, arg1 error) *IIbcConnectionGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcConnectionGetByIDCall) Do(f func(context.Context, uint64) (*storage.IbcConnection, error)) *IIbcConnectionGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcConnectionGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.IbcConnection, error)) *IIbcConnectionGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIIbcConnection) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIIbcConnectionMockRecorder) IsNoRows(err any) *IIbcConnectionIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIIbcConnection)(nil).IsNoRows), err)
+	return &IIbcConnectionIsNoRowsCall{Call: call}
+}
+
+// IIbcConnectionIsNoRowsCall wrap *gomock.Call
+type IIbcConnectionIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcConnectionIsNoRowsCall) Return(arg0 bool) *IIbcConnectionIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcConnectionIsNoRowsCall) Do(f func(error) bool) *IIbcConnectionIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcConnectionIsNoRowsCall) DoAndReturn(f func(error) bool) *IIbcConnectionIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIIbcConnection) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIIbcConnectionMockRecorder) LastID(ctx any) *IIbcConnectionLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIIbcConnection)(nil).LastID), ctx)
+	return &IIbcConnectionLastIDCall{Call: call}
+}
+
+// IIbcConnectionLastIDCall wrap *gomock.Call
+type IIbcConnectionLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcConnectionLastIDCall) Return(arg0 uint64, arg1 error) *IIbcConnectionLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcConnectionLastIDCall) Do(f func(context.Context) (uint64, error)) *IIbcConnectionLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcConnectionLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *IIbcConnectionLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIIbcConnection) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.IbcConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.IbcConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIIbcConnectionMockRecorder) List(ctx, limit, offset, order any) *IIbcConnectionListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIIbcConnection)(nil).List), ctx, limit, offset, order)
+	return &IIbcConnectionListCall{Call: call}
+}
+
+// IIbcConnectionListCall wrap *gomock.Call
+type IIbcConnectionListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcConnectionListCall) Return(arg0 []*storage.IbcConnection, arg1 error) *IIbcConnectionListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcConnectionListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.IbcConnection, error)) *IIbcConnectionListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcConnectionListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.IbcConnection, error)) *IIbcConnectionListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListWithFilters mocks base method.
+func (m *MockIIbcConnection) ListWithFilters(ctx context.Context, filters storage.ListIbcConnectionsFilters) ([]storage.IbcConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWithFilters", ctx, filters)
+	ret0, _ := ret[0].([]storage.IbcConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWithFilters indicates an expected call of ListWithFilters.
+func (mr *MockIIbcConnectionMockRecorder) ListWithFilters(ctx, filters any) *IIbcConnectionListWithFiltersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithFilters", reflect.TypeOf((*MockIIbcConnection)(nil).ListWithFilters), ctx, filters)
+	return &IIbcConnectionListWithFiltersCall{Call: call}
+}
+
+// IIbcConnectionListWithFiltersCall wrap *gomock.Call
+type IIbcConnectionListWithFiltersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcConnectionListWithFiltersCall) Return(arg0 []storage.IbcConnection, arg1 error) *IIbcConnectionListWithFiltersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcConnectionListWithFiltersCall) Do(f func(context.Context, storage.ListIbcConnectionsFilters) ([]storage.IbcConnection, error)) *IIbcConnectionListWithFiltersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcConnectionListWithFiltersCall) DoAndReturn(f func(context.Context, storage.ListIbcConnectionsFilters) ([]storage.IbcConnection, error)) *IIbcConnectionListWithFiltersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIIbcConnection) Save(ctx context.Context, m *storage.IbcConnection) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIIbcConnectionMockRecorder) Save(ctx, m any) *IIbcConnectionSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIIbcConnection)(nil).Save), ctx, m)
+	return &IIbcConnectionSaveCall{Call: call}
+}
+
+// IIbcConnectionSaveCall wrap *gomock.Call
+type IIbcConnectionSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcConnectionSaveCall) Return(arg0 error) *IIbcConnectionSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcConnectionSaveCall) Do(f func(context.Context, *storage.IbcConnection) error) *IIbcConnectionSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcConnectionSaveCall) DoAndReturn(f func(context.Context, *storage.IbcConnection) error) *IIbcConnectionSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIIbcConnection) Update(ctx context.Context, m *storage.IbcConnection) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIIbcConnectionMockRecorder) Update(ctx, m any) *IIbcConnectionUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIIbcConnection)(nil).Update), ctx, m)
+	return &IIbcConnectionUpdateCall{Call: call}
+}
+
+// IIbcConnectionUpdateCall wrap *gomock.Call
+type IIbcConnectionUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcConnectionUpdateCall) Return(arg0 error) *IIbcConnectionUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcConnectionUpdateCall) Do(f func(context.Context, *storage.IbcConnection) error) *IIbcConnectionUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcConnectionUpdateCall) DoAndReturn(f func(context.Context, *storage.IbcConnection) error) *IIbcConnectionUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,431 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ibc_transfer.go
+//
+// Generated by this command:
+//
+//	mockgen -source=ibc_transfer.go -destination=mock/ibc_transfer.go -package=mock -typed
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/celestia-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIIbcTransfer is a mock of IIbcTransfer interface.
+type MockIIbcTransfer struct {
+	ctrl     *gomock.Controller
+	recorder *MockIIbcTransferMockRecorder
+}
+
+// MockIIbcTransferMockRecorder is the mock recorder for MockIIbcTransfer.
+type MockIIbcTransferMockRecorder struct {
+	mock *MockIIbcTransfer
+}
+
+// NewMockIIbcTransfer creates a new mock instance.
+func NewMockIIbcTransfer(ctrl *gomock.Controller) *MockIIbcTransfer {
+	mock := &MockIIbcTransfer{ctrl: ctrl}
+	mock.recorder = &MockIIbcTransferMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIIbcTransfer) EXPECT() *MockIIbcTransferMockRecorder {
+	return m.recorder
+}
+
+// ById mocks base method.
+func (m *MockIIbcTransfer) ById(ctx context.Context, id uint64) (storage.IbcTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ById", ctx, id)
+	ret0, _ := ret[0].(storage.IbcTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ById indicates an expected call of ById.
+func (mr *MockIIbcTransferMockRecorder) ById(ctx, id any) *IIbcTransferByIdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ById", reflect.TypeOf((*MockIIbcTransfer)(nil).ById), ctx, id)
+	return &IIbcTransferByIdCall{Call: call}
+}
+
+// IIbcTransferByIdCall wrap *gomock.Call
+type IIbcTransferByIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcTransferByIdCall) Return(arg0 storage.IbcTransfer, arg1 error) *IIbcTransferByIdCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcTransferByIdCall) Do(f func(context.Context, uint64) (storage.IbcTransfer, error)) *IIbcTransferByIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcTransferByIdCall) DoAndReturn(f func(context.Context, uint64) (storage.IbcTransfer, error)) *IIbcTransferByIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ChainStats mocks base method.
+func (m *MockIIbcTransfer) ChainStats(ctx context.Context, limit, offset int) ([]storage.IbcChainStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChainStats", ctx, limit, offset)
+	ret0, _ := ret[0].([]storage.IbcChainStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChainStats indicates an expected call of ChainStats.
+func (mr *MockIIbcTransferMockRecorder) ChainStats(ctx, limit, offset any) *IIbcTransferChainStatsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChainStats", reflect.TypeOf((*MockIIbcTransfer)(nil).ChainStats), ctx, limit, offset)
+	return &IIbcTransferChainStatsCall{Call: call}
+}
+
+// IIbcTransferChainStatsCall wrap *gomock.Call
+type IIbcTransferChainStatsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcTransferChainStatsCall) Return(arg0 []storage.IbcChainStats, arg1 error) *IIbcTransferChainStatsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcTransferChainStatsCall) Do(f func(context.Context, int, int) ([]storage.IbcChainStats, error)) *IIbcTransferChainStatsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcTransferChainStatsCall) DoAndReturn(f func(context.Context, int, int) ([]storage.IbcChainStats, error)) *IIbcTransferChainStatsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIIbcTransfer) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.IbcTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.IbcTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIIbcTransferMockRecorder) CursorList(ctx, id, limit, order, cmp any) *IIbcTransferCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIIbcTransfer)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &IIbcTransferCursorListCall{Call: call}
+}
+
+// IIbcTransferCursorListCall wrap *gomock.Call
+type IIbcTransferCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcTransferCursorListCall) Return(arg0 []*storage.IbcTransfer, arg1 error) *IIbcTransferCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcTransferCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.IbcTransfer, error)) *IIbcTransferCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcTransferCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.IbcTransfer, error)) *IIbcTransferCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIIbcTransfer) GetByID(ctx context.Context, id uint64) (*storage.IbcTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.IbcTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIIbcTransferMockRecorder) GetByID(ctx, id any) *IIbcTransferGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIIbcTransfer)(nil).GetByID), ctx, id)
+	return &IIbcTransferGetByIDCall{Call: call}
+}
+
+// IIbcTransferGetByIDCall wrap *gomock.Call
+type IIbcTransferGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcTransferGetByIDCall) Return(arg0 *storage.IbcTransfer, arg1 error) *IIbcTransferGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcTransferGetByIDCall) Do(f func(context.Context, uint64) (*storage.IbcTransfer, error)) *IIbcTransferGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcTransferGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.IbcTransfer, error)) *IIbcTransferGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIIbcTransfer) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIIbcTransferMockRecorder) IsNoRows(err any) *IIbcTransferIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIIbcTransfer)(nil).IsNoRows), err)
+	return &IIbcTransferIsNoRowsCall{Call: call}
+}
+
+// IIbcTransferIsNoRowsCall wrap *gomock.Call
+type IIbcTransferIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcTransferIsNoRowsCall) Return(arg0 bool) *IIbcTransferIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcTransferIsNoRowsCall) Do(f func(error) bool) *IIbcTransferIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcTransferIsNoRowsCall) DoAndReturn(f func(error) bool) *IIbcTransferIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIIbcTransfer) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIIbcTransferMockRecorder) LastID(ctx any) *IIbcTransferLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIIbcTransfer)(nil).LastID), ctx)
+	return &IIbcTransferLastIDCall{Call: call}
+}
+
+// IIbcTransferLastIDCall wrap *gomock.Call
+type IIbcTransferLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcTransferLastIDCall) Return(arg0 uint64, arg1 error) *IIbcTransferLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcTransferLastIDCall) Do(f func(context.Context) (uint64, error)) *IIbcTransferLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcTransferLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *IIbcTransferLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIIbcTransfer) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.IbcTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.IbcTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIIbcTransferMockRecorder) List(ctx, limit, offset, order any) *IIbcTransferListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIIbcTransfer)(nil).List), ctx, limit, offset, order)
+	return &IIbcTransferListCall{Call: call}
+}
+
+// IIbcTransferListCall wrap *gomock.Call
+type IIbcTransferListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcTransferListCall) Return(arg0 []*storage.IbcTransfer, arg1 error) *IIbcTransferListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcTransferListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.IbcTransfer, error)) *IIbcTransferListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcTransferListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.IbcTransfer, error)) *IIbcTransferListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListWithFilters mocks base method.
+func (m *MockIIbcTransfer) ListWithFilters(ctx context.Context, filters storage.ListIbcTransferFilters) ([]storage.IbcTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWithFilters", ctx, filters)
+	ret0, _ := ret[0].([]storage.IbcTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWithFilters indicates an expected call of ListWithFilters.
+func (mr *MockIIbcTransferMockRecorder) ListWithFilters(ctx, filters any) *IIbcTransferListWithFiltersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithFilters", reflect.TypeOf((*MockIIbcTransfer)(nil).ListWithFilters), ctx, filters)
+	return &IIbcTransferListWithFiltersCall{Call: call}
+}
+
+// IIbcTransferListWithFiltersCall wrap *gomock.Call
+type IIbcTransferListWithFiltersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcTransferListWithFiltersCall) Return(arg0 []storage.IbcTransfer, arg1 error) *IIbcTransferListWithFiltersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcTransferListWithFiltersCall) Do(f func(context.Context, storage.ListIbcTransferFilters) ([]storage.IbcTransfer, error)) *IIbcTransferListWithFiltersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcTransferListWithFiltersCall) DoAndReturn(f func(context.Context, storage.ListIbcTransferFilters) ([]storage.IbcTransfer, error)) *IIbcTransferListWithFiltersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIIbcTransfer) Save(ctx context.Context, m *storage.IbcTransfer) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIIbcTransferMockRecorder) Save(ctx, m any) *IIbcTransferSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIIbcTransfer)(nil).Save), ctx, m)
+	return &IIbcTransferSaveCall{Call: call}
+}
+
+// IIbcTransferSaveCall wrap *gomock.Call
+type IIbcTransferSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcTransferSaveCall) Return(arg0 error) *IIbcTransferSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcTransferSaveCall) Do(f func(context.Context, *storage.IbcTransfer) error) *IIbcTransferSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcTransferSaveCall) DoAndReturn(f func(context.Context, *storage.IbcTransfer) error) *IIbcTransferSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIIbcTransfer) Update(ctx context.Context, m *storage.IbcTransfer) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIIbcTransferMockRecorder) Update(ctx, m any) *IIbcTransferUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIIbcTransfer)(nil).Update), ctx, m)
+	return &IIbcTransferUpdateCall{Call: call}
+}
+
+// IIbcTransferUpdateCall wrap *gomock.Call
+type IIbcTransferUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IIbcTransferUpdateCall) Return(arg0 error) *IIbcTransferUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IIbcTransferUpdateCall) Do(f func(context.Context, *storage.IbcTransfer) error) *IIbcTransferUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IIbcTransferUpdateCall) DoAndReturn(f func(context.Context, *storage.IbcTransfer) error) *IIbcTransferUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,392 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interchain_account.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interchain_account.go -destination=mock/interchain_account.go -package=mock -typed
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/celestia-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIInterchainAccount is a mock of IInterchainAccount interface.
+type MockIInterchainAccount struct {
+	ctrl     *gomock.Controller
+	recorder *MockIInterchainAccountMockRecorder
+}
+
+// MockIInterchainAccountMockRecorder is the mock recorder for MockIInterchainAccount.
+type MockIInterchainAccountMockRecorder struct {
+	mock *MockIInterchainAccount
+}
+
+// NewMockIInterchainAccount creates a new mock instance.
+func NewMockIInterchainAccount(ctrl *gomock.Controller) *MockIInterchainAccount {
+	mock := &MockIInterchainAccount{ctrl: ctrl}
+	mock.recorder = &MockIInterchainAccountMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIInterchainAccount) EXPECT() *MockIInterchainAccountMockRecorder {
+	return m.recorder
+}
+
+// ByAddressId mocks base method.
+func (m *MockIInterchainAccount) ByAddressId(ctx context.Context, addressId uint64) (storage.InterchainAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByAddressId", ctx, addressId)
+	ret0, _ := ret[0].(storage.InterchainAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByAddressId indicates an expected call of ByAddressId.
+func (mr *MockIInterchainAccountMockRecorder) ByAddressId(ctx, addressId any) *IInterchainAccountByAddressIdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByAddressId", reflect.TypeOf((*MockIInterchainAccount)(nil).ByAddressId), ctx, addressId)
+	return &IInterchainAccountByAddressIdCall{Call: call}
+}
+
+// IInterchainAccountByAddressIdCall wrap *gomock.Call
+type IInterchainAccountByAddressIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IInterchainAccountByAddressIdCall) Return(arg0 storage.InterchainAccount, arg1 error) *IInterchainAccountByAddressIdCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IInterchainAccountByAddressIdCall) Do(f func(context.Context, uint64) (storage.InterchainAccount, error)) *IInterchainAccountByAddressIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IInterchainAccountByAddressIdCall) DoAndReturn(f func(context.Context, uint64) (storage.InterchainAccount, error)) *IInterchainAccountByAddressIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIInterchainAccount) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.InterchainAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.InterchainAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIInterchainAccountMockRecorder) CursorList(ctx, id, limit, order, cmp any) *IInterchainAccountCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIInterchainAccount)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &IInterchainAccountCursorListCall{Call: call}
+}
+
+// IInterchainAccountCursorListCall wrap *gomock.Call
+type IInterchainAccountCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IInterchainAccountCursorListCall) Return(arg0 []*storage.InterchainAccount, arg1 error) *IInterchainAccountCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IInterchainAccountCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.InterchainAccount, error)) *IInterchainAccountCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IInterchainAccountCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.InterchainAccount, error)) *IInterchainAccountCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIInterchainAccount) GetByID(ctx context.Context, id uint64) (*storage.InterchainAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.InterchainAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIInterchainAccountMockRecorder) GetByID(ctx, id any) *IInterchainAccountGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIInterchainAccount)(nil).GetByID), ctx, id)
+	return &IInterchainAccountGetByIDCall{Call: call}
+}
+
+// IInterchainAccountGetByIDCall wrap *gomock.Call
+type IInterchainAccountGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IInterchainAccountGetByIDCall) Return(arg0 *storage.InterchainAccount, arg1 error) *IInterchainAccountGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IInterchainAccountGetByIDCall) Do(f func(context.Context, uint64) (*storage.InterchainAccount, error)) *IInterchainAccountGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IInterchainAccountGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.InterchainAccount, error)) *IInterchainAccountGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIInterchainAccount) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIInterchainAccountMockRecorder) IsNoRows(err any) *IInterchainAccountIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIInterchainAccount)(nil).IsNoRows), err)
+	return &IInterchainAccountIsNoRowsCall{Call: call}
+}
+
+// IInterchainAccountIsNoRowsCall wrap *gomock.Call
+type IInterchainAccountIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IInterchainAccountIsNoRowsCall) Return(arg0 bool) *IInterchainAccountIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IInterchainAccountIsNoRowsCall) Do(f func(error) bool) *IInterchainAccountIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IInterchainAccountIsNoRowsCall) DoAndReturn(f func(error) bool) *IInterchainAccountIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIInterchainAccount) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIInterchainAccountMockRecorder) LastID(ctx any) *IInterchainAccountLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIInterchainAccount)(nil).LastID), ctx)
+	return &IInterchainAccountLastIDCall{Call: call}
+}
+
+// IInterchainAccountLastIDCall wrap *gomock.Call
+type IInterchainAccountLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IInterchainAccountLastIDCall) Return(arg0 uint64, arg1 error) *IInterchainAccountLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IInterchainAccountLastIDCall) Do(f func(context.Context) (uint64, error)) *IInterchainAccountLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IInterchainAccountLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *IInterchainAccountLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIInterchainAccount) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.InterchainAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.InterchainAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIInterchainAccountMockRecorder) List(ctx, limit, offset, order any) *IInterchainAccountListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIInterchainAccount)(nil).List), ctx, limit, offset, order)
+	return &IInterchainAccountListCall{Call: call}
+}
+
+// IInterchainAccountListCall wrap *gomock.Call
+type IInterchainAccountListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IInterchainAccountListCall) Return(arg0 []*storage.InterchainAccount, arg1 error) *IInterchainAccountListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IInterchainAccountListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.InterchainAccount, error)) *IInterchainAccountListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IInterchainAccountListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.InterchainAccount, error)) *IInterchainAccountListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Packets mocks base method.
+func (m *MockIInterchainAccount) Packets(ctx context.Context, filters storage.ListInterchainAccountPacketsFilters) ([]storage.InterchainAccountPacket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Packets", ctx, filters)
+	ret0, _ := ret[0].([]storage.InterchainAccountPacket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Packets indicates an expected call of Packets.
+func (mr *MockIInterchainAccountMockRecorder) Packets(ctx, filters any) *IInterchainAccountPacketsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Packets", reflect.TypeOf((*MockIInterchainAccount)(nil).Packets), ctx, filters)
+	return &IInterchainAccountPacketsCall{Call: call}
+}
+
+// IInterchainAccountPacketsCall wrap *gomock.Call
+type IInterchainAccountPacketsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IInterchainAccountPacketsCall) Return(arg0 []storage.InterchainAccountPacket, arg1 error) *IInterchainAccountPacketsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IInterchainAccountPacketsCall) Do(f func(context.Context, storage.ListInterchainAccountPacketsFilters) ([]storage.InterchainAccountPacket, error)) *IInterchainAccountPacketsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IInterchainAccountPacketsCall) DoAndReturn(f func(context.Context, storage.ListInterchainAccountPacketsFilters) ([]storage.InterchainAccountPacket, error)) *IInterchainAccountPacketsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIInterchainAccount) Save(ctx context.Context, m *storage.InterchainAccount) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIInterchainAccountMockRecorder) Save(ctx, m any) *IInterchainAccountSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIInterchainAccount)(nil).Save), ctx, m)
+	return &IInterchainAccountSaveCall{Call: call}
+}
+
+// IInterchainAccountSaveCall wrap *gomock.Call
+type IInterchainAccountSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IInterchainAccountSaveCall) Return(arg0 error) *IInterchainAccountSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IInterchainAccountSaveCall) Do(f func(context.Context, *storage.InterchainAccount) error) *IInterchainAccountSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IInterchainAccountSaveCall) DoAndReturn(f func(context.Context, *storage.InterchainAccount) error) *IInterchainAccountSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIInterchainAccount) Update(ctx context.Context, m *storage.InterchainAccount) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIInterchainAccountMockRecorder) Update(ctx, m any) *IInterchainAccountUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIInterchainAccount)(nil).Update), ctx, m)
+	return &IInterchainAccountUpdateCall{Call: call}
+}
+
+// IInterchainAccountUpdateCall wrap *gomock.Call
+type IInterchainAccountUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IInterchainAccountUpdateCall) Return(arg0 error) *IInterchainAccountUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IInterchainAccountUpdateCall) Do(f func(context.Context, *storage.InterchainAccount) error) *IInterchainAccountUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IInterchainAccountUpdateCall) DoAndReturn(f func(context.Context, *storage.InterchainAccount) error) *IInterchainAccountUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -199,6 +199,45 @@ func (c *IMessageGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*stor
 	return c
 }
 
+// InternalByTxId mocks base method.
+func (m *MockIMessage) InternalByTxId(ctx context.Context, txId uint64) ([]storage.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InternalByTxId", ctx, txId)
+	ret0, _ := ret[0].([]storage.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InternalByTxId indicates an expected call of InternalByTxId.
+func (mr *MockIMessageMockRecorder) InternalByTxId(ctx, txId any) *IMessageInternalByTxIdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InternalByTxId", reflect.TypeOf((*MockIMessage)(nil).InternalByTxId), ctx, txId)
+	return &IMessageInternalByTxIdCall{Call: call}
+}
+
+// IMessageInternalByTxIdCall wrap *gomock.Call
+type IMessageInternalByTxIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IMessageInternalByTxIdCall) Return(arg0 []storage.Message, arg1 error) *IMessageInternalByTxIdCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IMessageInternalByTxIdCall) Do(f func(context.Context, uint64) ([]storage.Message, error)) *IMessageInternalByTxIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IMessageInternalByTxIdCall) DoAndReturn(f func(context.Context, uint64) ([]storage.Message, error)) *IMessageInternalByTxIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // IsNoRows mocks base method.
 func (m *MockIMessage) IsNoRows(err error) bool {
 	m.ctrl.T.Helper()
@@ -0,0 +1,393 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: missed_block.go
+//
+// Generated by this command:
+//
+//	mockgen -source=missed_block.go -destination=mock/missed_block.go -package=mock -typed
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/celestia-indexer/internal/storage"
+	types "github.com/celenium-io/celestia-indexer/pkg/types"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIMissedBlock is a mock of IMissedBlock interface.
+type MockIMissedBlock struct {
+	ctrl     *gomock.Controller
+	recorder *MockIMissedBlockMockRecorder
+}
+
+// MockIMissedBlockMockRecorder is the mock recorder for MockIMissedBlock.
+type MockIMissedBlockMockRecorder struct {
+	mock *MockIMissedBlock
+}
+
+// NewMockIMissedBlock creates a new mock instance.
+func NewMockIMissedBlock(ctrl *gomock.Controller) *MockIMissedBlock {
+	mock := &MockIMissedBlock{ctrl: ctrl}
+	mock.recorder = &MockIMissedBlockMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIMissedBlock) EXPECT() *MockIMissedBlockMockRecorder {
+	return m.recorder
+}
+
+// CountByValidator mocks base method.
+func (m *MockIMissedBlock) CountByValidator(ctx context.Context, validatorId uint64, fromHeight types.Level) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByValidator", ctx, validatorId, fromHeight)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByValidator indicates an expected call of CountByValidator.
+func (mr *MockIMissedBlockMockRecorder) CountByValidator(ctx, validatorId, fromHeight any) *IMissedBlockCountByValidatorCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByValidator", reflect.TypeOf((*MockIMissedBlock)(nil).CountByValidator), ctx, validatorId, fromHeight)
+	return &IMissedBlockCountByValidatorCall{Call: call}
+}
+
+// IMissedBlockCountByValidatorCall wrap *gomock.Call
+type IMissedBlockCountByValidatorCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IMissedBlockCountByValidatorCall) Return(arg0 int64, arg1 error) *IMissedBlockCountByValidatorCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IMissedBlockCountByValidatorCall) Do(f func(context.Context, uint64, types.Level) (int64, error)) *IMissedBlockCountByValidatorCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IMissedBlockCountByValidatorCall) DoAndReturn(f func(context.Context, uint64, types.Level) (int64, error)) *IMissedBlockCountByValidatorCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIMissedBlock) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.MissedBlock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.MissedBlock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIMissedBlockMockRecorder) CursorList(ctx, id, limit, order, cmp any) *IMissedBlockCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIMissedBlock)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &IMissedBlockCursorListCall{Call: call}
+}
+
+// IMissedBlockCursorListCall wrap *gomock.Call
+type IMissedBlockCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IMissedBlockCursorListCall) Return(arg0 []*storage.MissedBlock, arg1 error) *IMissedBlockCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IMissedBlockCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.MissedBlock, error)) *IMissedBlockCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IMissedBlockCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.MissedBlock, error)) *IMissedBlockCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIMissedBlock) GetByID(ctx context.Context, id uint64) (*storage.MissedBlock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.MissedBlock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIMissedBlockMockRecorder) GetByID(ctx, id any) *IMissedBlockGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIMissedBlock)(nil).GetByID), ctx, id)
+	return &IMissedBlockGetByIDCall{Call: call}
+}
+
+// IMissedBlockGetByIDCall wrap *gomock.Call
+type IMissedBlockGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IMissedBlockGetByIDCall) Return(arg0 *storage.MissedBlock, arg1 error) *IMissedBlockGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IMissedBlockGetByIDCall) Do(f func(context.Context, uint64) (*storage.MissedBlock, error)) *IMissedBlockGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IMissedBlockGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.MissedBlock, error)) *IMissedBlockGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIMissedBlock) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIMissedBlockMockRecorder) IsNoRows(err any) *IMissedBlockIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIMissedBlock)(nil).IsNoRows), err)
+	return &IMissedBlockIsNoRowsCall{Call: call}
+}
+
+// IMissedBlockIsNoRowsCall wrap *gomock.Call
+type IMissedBlockIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IMissedBlockIsNoRowsCall) Return(arg0 bool) *IMissedBlockIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IMissedBlockIsNoRowsCall) Do(f func(error) bool) *IMissedBlockIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IMissedBlockIsNoRowsCall) DoAndReturn(f func(error) bool) *IMissedBlockIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIMissedBlock) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIMissedBlockMockRecorder) LastID(ctx any) *IMissedBlockLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIMissedBlock)(nil).LastID), ctx)
+	return &IMissedBlockLastIDCall{Call: call}
+}
+
+// IMissedBlockLastIDCall wrap *gomock.Call
+type IMissedBlockLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IMissedBlockLastIDCall) Return(arg0 uint64, arg1 error) *IMissedBlockLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IMissedBlockLastIDCall) Do(f func(context.Context) (uint64, error)) *IMissedBlockLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IMissedBlockLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *IMissedBlockLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIMissedBlock) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.MissedBlock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.MissedBlock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIMissedBlockMockRecorder) List(ctx, limit, offset, order any) *IMissedBlockListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIMissedBlock)(nil).List), ctx, limit, offset, order)
+	return &IMissedBlockListCall{Call: call}
+}
+
+// IMissedBlockListCall wrap *gomock.Call
+type IMissedBlockListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IMissedBlockListCall) Return(arg0 []*storage.MissedBlock, arg1 error) *IMissedBlockListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IMissedBlockListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.MissedBlock, error)) *IMissedBlockListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IMissedBlockListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.MissedBlock, error)) *IMissedBlockListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIMissedBlock) Save(ctx context.Context, m *storage.MissedBlock) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIMissedBlockMockRecorder) Save(ctx, m any) *IMissedBlockSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIMissedBlock)(nil).Save), ctx, m)
+	return &IMissedBlockSaveCall{Call: call}
+}
+
+// IMissedBlockSaveCall wrap *gomock.Call
+type IMissedBlockSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IMissedBlockSaveCall) Return(arg0 error) *IMissedBlockSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IMissedBlockSaveCall) Do(f func(context.Context, *storage.MissedBlock) error) *IMissedBlockSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IMissedBlockSaveCall) DoAndReturn(f func(context.Context, *storage.MissedBlock) error) *IMissedBlockSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Series mocks base method.
+func (m *MockIMissedBlock) Series(ctx context.Context, validatorId uint64, timeframe storage.Timeframe, req storage.SeriesRequest) ([]storage.HistogramItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Series", ctx, validatorId, timeframe, req)
+	ret0, _ := ret[0].([]storage.HistogramItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Series indicates an expected call of Series.
+func (mr *MockIMissedBlockMockRecorder) Series(ctx, validatorId, timeframe, req any) *IMissedBlockSeriesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Series", reflect.TypeOf((*MockIMissedBlock)(nil).Series), ctx, validatorId, timeframe, req)
+	return &IMissedBlockSeriesCall{Call: call}
+}
+
+// IMissedBlockSeriesCall wrap *gomock.Call
+type IMissedBlockSeriesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IMissedBlockSeriesCall) Return(arg0 []storage.HistogramItem, arg1 error) *IMissedBlockSeriesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IMissedBlockSeriesCall) Do(f func(context.Context, uint64, storage.Timeframe, storage.SeriesRequest) ([]storage.HistogramItem, error)) *IMissedBlockSeriesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IMissedBlockSeriesCall) DoAndReturn(f func(context.Context, uint64, storage.Timeframe, storage.SeriesRequest) ([]storage.HistogramItem, error)) *IMissedBlockSeriesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIMissedBlock) Update(ctx context.Context, m *storage.MissedBlock) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIMissedBlockMockRecorder) Update(ctx, m any) *IMissedBlockUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIMissedBlock)(nil).Update), ctx, m)
+	return &IMissedBlockUpdateCall{Call: call}
+}
+
+// IMissedBlockUpdateCall wrap *gomock.Call
+type IMissedBlockUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IMissedBlockUpdateCall) Return(arg0 error) *IMissedBlockUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IMissedBlockUpdateCall) Do(f func(context.Context, *storage.MissedBlock) error) *IMissedBlockUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IMissedBlockUpdateCall) DoAndReturn(f func(context.Context, *storage.MissedBlock) error) *IMissedBlockUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -587,6 +587,45 @@ func (c *IRollupSeriesCall) DoAndReturn(f func(context.Context, uint64, string,
 	return c
 }
 
+// Stacks mocks base method.
+func (m *MockIRollup) Stacks(ctx context.Context) ([]storage.RollupStack, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stacks", ctx)
+	ret0, _ := ret[0].([]storage.RollupStack)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stacks indicates an expected call of Stacks.
+func (mr *MockIRollupMockRecorder) Stacks(ctx any) *IRollupStacksCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stacks", reflect.TypeOf((*MockIRollup)(nil).Stacks), ctx)
+	return &IRollupStacksCall{Call: call}
+}
+
+// IRollupStacksCall wrap *gomock.Call
+type IRollupStacksCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IRollupStacksCall) Return(arg0 []storage.RollupStack, arg1 error) *IRollupStacksCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IRollupStacksCall) Do(f func(context.Context) ([]storage.RollupStack, error)) *IRollupStacksCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IRollupStacksCall) DoAndReturn(f func(context.Context) ([]storage.RollupStack, error)) *IRollupStacksCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Stats mocks base method.
 func (m *MockIRollup) Stats(ctx context.Context, rollupId uint64) (storage.RollupStats, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,353 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: slash.go
+//
+// Generated by this command:
+//
+//	mockgen -source=slash.go -destination=mock/slash.go -package=mock -typed
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/celestia-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockISlash is a mock of ISlash interface.
+type MockISlash struct {
+	ctrl     *gomock.Controller
+	recorder *MockISlashMockRecorder
+}
+
+// MockISlashMockRecorder is the mock recorder for MockISlash.
+type MockISlashMockRecorder struct {
+	mock *MockISlash
+}
+
+// NewMockISlash creates a new mock instance.
+func NewMockISlash(ctrl *gomock.Controller) *MockISlash {
+	mock := &MockISlash{ctrl: ctrl}
+	mock.recorder = &MockISlashMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockISlash) EXPECT() *MockISlashMockRecorder {
+	return m.recorder
+}
+
+// ByValidator mocks base method.
+func (m *MockISlash) ByValidator(ctx context.Context, id uint64, limit, offset int) ([]storage.Slash, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByValidator", ctx, id, limit, offset)
+	ret0, _ := ret[0].([]storage.Slash)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByValidator indicates an expected call of ByValidator.
+func (mr *MockISlashMockRecorder) ByValidator(ctx, id, limit, offset any) *ISlashByValidatorCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByValidator", reflect.TypeOf((*MockISlash)(nil).ByValidator), ctx, id, limit, offset)
+	return &ISlashByValidatorCall{Call: call}
+}
+
+// ISlashByValidatorCall wrap *gomock.Call
+type ISlashByValidatorCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *ISlashByValidatorCall) Return(arg0 []storage.Slash, arg1 error) *ISlashByValidatorCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *ISlashByValidatorCall) Do(f func(context.Context, uint64, int, int) ([]storage.Slash, error)) *ISlashByValidatorCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *ISlashByValidatorCall) DoAndReturn(f func(context.Context, uint64, int, int) ([]storage.Slash, error)) *ISlashByValidatorCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockISlash) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.Slash, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.Slash)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockISlashMockRecorder) CursorList(ctx, id, limit, order, cmp any) *ISlashCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockISlash)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &ISlashCursorListCall{Call: call}
+}
+
+// ISlashCursorListCall wrap *gomock.Call
+type ISlashCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *ISlashCursorListCall) Return(arg0 []*storage.Slash, arg1 error) *ISlashCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *ISlashCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Slash, error)) *ISlashCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *ISlashCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Slash, error)) *ISlashCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockISlash) GetByID(ctx context.Context, id uint64) (*storage.Slash, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.Slash)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockISlashMockRecorder) GetByID(ctx, id any) *ISlashGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockISlash)(nil).GetByID), ctx, id)
+	return &ISlashGetByIDCall{Call: call}
+}
+
+// ISlashGetByIDCall wrap *gomock.Call
+type ISlashGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *ISlashGetByIDCall) Return(arg0 *storage.Slash, arg1 error) *ISlashGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *ISlashGetByIDCall) Do(f func(context.Context, uint64) (*storage.Slash, error)) *ISlashGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *ISlashGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.Slash, error)) *ISlashGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockISlash) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockISlashMockRecorder) IsNoRows(err any) *ISlashIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockISlash)(nil).IsNoRows), err)
+	return &ISlashIsNoRowsCall{Call: call}
+}
+
+// ISlashIsNoRowsCall wrap *gomock.Call
+type ISlashIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *ISlashIsNoRowsCall) Return(arg0 bool) *ISlashIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *ISlashIsNoRowsCall) Do(f func(error) bool) *ISlashIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *ISlashIsNoRowsCall) DoAndReturn(f func(error) bool) *ISlashIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockISlash) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockISlashMockRecorder) LastID(ctx any) *ISlashLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockISlash)(nil).LastID), ctx)
+	return &ISlashLastIDCall{Call: call}
+}
+
+// ISlashLastIDCall wrap *gomock.Call
+type ISlashLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *ISlashLastIDCall) Return(arg0 uint64, arg1 error) *ISlashLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *ISlashLastIDCall) Do(f func(context.Context) (uint64, error)) *ISlashLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *ISlashLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *ISlashLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockISlash) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.Slash, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.Slash)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockISlashMockRecorder) List(ctx, limit, offset, order any) *ISlashListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockISlash)(nil).List), ctx, limit, offset, order)
+	return &ISlashListCall{Call: call}
+}
+
+// ISlashListCall wrap *gomock.Call
+type ISlashListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *ISlashListCall) Return(arg0 []*storage.Slash, arg1 error) *ISlashListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *ISlashListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Slash, error)) *ISlashListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *ISlashListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Slash, error)) *ISlashListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockISlash) Save(ctx context.Context, m *storage.Slash) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockISlashMockRecorder) Save(ctx, m any) *ISlashSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockISlash)(nil).Save), ctx, m)
+	return &ISlashSaveCall{Call: call}
+}
+
+// ISlashSaveCall wrap *gomock.Call
+type ISlashSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *ISlashSaveCall) Return(arg0 error) *ISlashSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *ISlashSaveCall) Do(f func(context.Context, *storage.Slash) error) *ISlashSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *ISlashSaveCall) DoAndReturn(f func(context.Context, *storage.Slash) error) *ISlashSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockISlash) Update(ctx context.Context, m *storage.Slash) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockISlashMockRecorder) Update(ctx, m any) *ISlashUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockISlash)(nil).Update), ctx, m)
+	return &ISlashUpdateCall{Call: call}
+}
+
+// ISlashUpdateCall wrap *gomock.Call
+type ISlashUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *ISlashUpdateCall) Return(arg0 error) *ISlashUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *ISlashUpdateCall) Do(f func(context.Context, *storage.Slash) error) *ISlashUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *ISlashUpdateCall) DoAndReturn(f func(context.Context, *storage.Slash) error) *ISlashUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
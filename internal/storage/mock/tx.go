@@ -123,6 +123,45 @@ func (c *ITxByHashCall) DoAndReturn(f func(context.Context, []byte) (storage.Tx,
 	return c
 }
 
+// ByHeightWithRelations mocks base method.
+func (m *MockITx) ByHeightWithRelations(ctx context.Context, height types.Level) ([]storage.Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByHeightWithRelations", ctx, height)
+	ret0, _ := ret[0].([]storage.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByHeightWithRelations indicates an expected call of ByHeightWithRelations.
+func (mr *MockITxMockRecorder) ByHeightWithRelations(ctx, height any) *ITxByHeightWithRelationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByHeightWithRelations", reflect.TypeOf((*MockITx)(nil).ByHeightWithRelations), ctx, height)
+	return &ITxByHeightWithRelationsCall{Call: call}
+}
+
+// ITxByHeightWithRelationsCall wrap *gomock.Call
+type ITxByHeightWithRelationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *ITxByHeightWithRelationsCall) Return(arg0 []storage.Tx, arg1 error) *ITxByHeightWithRelationsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *ITxByHeightWithRelationsCall) Do(f func(context.Context, types.Level) ([]storage.Tx, error)) *ITxByHeightWithRelationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *ITxByHeightWithRelationsCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.Tx, error)) *ITxByHeightWithRelationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ByIdWithRelations mocks base method.
 func (m *MockITx) ByIdWithRelations(ctx context.Context, id uint64) (storage.Tx, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,392 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: upgrade.go
+//
+// Generated by this command:
+//
+//	mockgen -source=upgrade.go -destination=mock/upgrade.go -package=mock -typed
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/celestia-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIUpgrade is a mock of IUpgrade interface.
+type MockIUpgrade struct {
+	ctrl     *gomock.Controller
+	recorder *MockIUpgradeMockRecorder
+}
+
+// MockIUpgradeMockRecorder is the mock recorder for MockIUpgrade.
+type MockIUpgradeMockRecorder struct {
+	mock *MockIUpgrade
+}
+
+// NewMockIUpgrade creates a new mock instance.
+func NewMockIUpgrade(ctrl *gomock.Controller) *MockIUpgrade {
+	mock := &MockIUpgrade{ctrl: ctrl}
+	mock.recorder = &MockIUpgradeMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIUpgrade) EXPECT() *MockIUpgradeMockRecorder {
+	return m.recorder
+}
+
+// CursorList mocks base method.
+func (m *MockIUpgrade) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.Upgrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.Upgrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIUpgradeMockRecorder) CursorList(ctx, id, limit, order, cmp any) *IUpgradeCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIUpgrade)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &IUpgradeCursorListCall{Call: call}
+}
+
+// IUpgradeCursorListCall wrap *gomock.Call
+type IUpgradeCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IUpgradeCursorListCall) Return(arg0 []*storage.Upgrade, arg1 error) *IUpgradeCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IUpgradeCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Upgrade, error)) *IUpgradeCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IUpgradeCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Upgrade, error)) *IUpgradeCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIUpgrade) GetByID(ctx context.Context, id uint64) (*storage.Upgrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.Upgrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIUpgradeMockRecorder) GetByID(ctx, id any) *IUpgradeGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIUpgrade)(nil).GetByID), ctx, id)
+	return &IUpgradeGetByIDCall{Call: call}
+}
+
+// IUpgradeGetByIDCall wrap *gomock.Call
+type IUpgradeGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IUpgradeGetByIDCall) Return(arg0 *storage.Upgrade, arg1 error) *IUpgradeGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IUpgradeGetByIDCall) Do(f func(context.Context, uint64) (*storage.Upgrade, error)) *IUpgradeGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IUpgradeGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.Upgrade, error)) *IUpgradeGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIUpgrade) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIUpgradeMockRecorder) IsNoRows(err any) *IUpgradeIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIUpgrade)(nil).IsNoRows), err)
+	return &IUpgradeIsNoRowsCall{Call: call}
+}
+
+// IUpgradeIsNoRowsCall wrap *gomock.Call
+type IUpgradeIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IUpgradeIsNoRowsCall) Return(arg0 bool) *IUpgradeIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IUpgradeIsNoRowsCall) Do(f func(error) bool) *IUpgradeIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IUpgradeIsNoRowsCall) DoAndReturn(f func(error) bool) *IUpgradeIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIUpgrade) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIUpgradeMockRecorder) LastID(ctx any) *IUpgradeLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIUpgrade)(nil).LastID), ctx)
+	return &IUpgradeLastIDCall{Call: call}
+}
+
+// IUpgradeLastIDCall wrap *gomock.Call
+type IUpgradeLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IUpgradeLastIDCall) Return(arg0 uint64, arg1 error) *IUpgradeLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IUpgradeLastIDCall) Do(f func(context.Context) (uint64, error)) *IUpgradeLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IUpgradeLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *IUpgradeLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIUpgrade) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.Upgrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.Upgrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIUpgradeMockRecorder) List(ctx, limit, offset, order any) *IUpgradeListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIUpgrade)(nil).List), ctx, limit, offset, order)
+	return &IUpgradeListCall{Call: call}
+}
+
+// IUpgradeListCall wrap *gomock.Call
+type IUpgradeListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IUpgradeListCall) Return(arg0 []*storage.Upgrade, arg1 error) *IUpgradeListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IUpgradeListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Upgrade, error)) *IUpgradeListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IUpgradeListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Upgrade, error)) *IUpgradeListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListWithFilters mocks base method.
+func (m *MockIUpgrade) ListWithFilters(ctx context.Context, filters storage.ListUpgradesFilters) ([]storage.Upgrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWithFilters", ctx, filters)
+	ret0, _ := ret[0].([]storage.Upgrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWithFilters indicates an expected call of ListWithFilters.
+func (mr *MockIUpgradeMockRecorder) ListWithFilters(ctx, filters any) *IUpgradeListWithFiltersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithFilters", reflect.TypeOf((*MockIUpgrade)(nil).ListWithFilters), ctx, filters)
+	return &IUpgradeListWithFiltersCall{Call: call}
+}
+
+// IUpgradeListWithFiltersCall wrap *gomock.Call
+type IUpgradeListWithFiltersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IUpgradeListWithFiltersCall) Return(arg0 []storage.Upgrade, arg1 error) *IUpgradeListWithFiltersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IUpgradeListWithFiltersCall) Do(f func(context.Context, storage.ListUpgradesFilters) ([]storage.Upgrade, error)) *IUpgradeListWithFiltersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IUpgradeListWithFiltersCall) DoAndReturn(f func(context.Context, storage.ListUpgradesFilters) ([]storage.Upgrade, error)) *IUpgradeListWithFiltersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Pending mocks base method.
+func (m *MockIUpgrade) Pending(ctx context.Context) (storage.Upgrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pending", ctx)
+	ret0, _ := ret[0].(storage.Upgrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pending indicates an expected call of Pending.
+func (mr *MockIUpgradeMockRecorder) Pending(ctx any) *IUpgradePendingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pending", reflect.TypeOf((*MockIUpgrade)(nil).Pending), ctx)
+	return &IUpgradePendingCall{Call: call}
+}
+
+// IUpgradePendingCall wrap *gomock.Call
+type IUpgradePendingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IUpgradePendingCall) Return(arg0 storage.Upgrade, arg1 error) *IUpgradePendingCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IUpgradePendingCall) Do(f func(context.Context) (storage.Upgrade, error)) *IUpgradePendingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IUpgradePendingCall) DoAndReturn(f func(context.Context) (storage.Upgrade, error)) *IUpgradePendingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIUpgrade) Save(ctx context.Context, m *storage.Upgrade) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIUpgradeMockRecorder) Save(ctx, m any) *IUpgradeSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIUpgrade)(nil).Save), ctx, m)
+	return &IUpgradeSaveCall{Call: call}
+}
+
+// IUpgradeSaveCall wrap *gomock.Call
+type IUpgradeSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IUpgradeSaveCall) Return(arg0 error) *IUpgradeSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IUpgradeSaveCall) Do(f func(context.Context, *storage.Upgrade) error) *IUpgradeSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IUpgradeSaveCall) DoAndReturn(f func(context.Context, *storage.Upgrade) error) *IUpgradeSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIUpgrade) Update(ctx context.Context, m *storage.Upgrade) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIUpgradeMockRecorder) Update(ctx, m any) *IUpgradeUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIUpgrade)(nil).Update), ctx, m)
+	return &IUpgradeUpdateCall{Call: call}
+}
+
+// IUpgradeUpdateCall wrap *gomock.Call
+type IUpgradeUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IUpgradeUpdateCall) Return(arg0 error) *IUpgradeUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IUpgradeUpdateCall) Do(f func(context.Context, *storage.Upgrade) error) *IUpgradeUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IUpgradeUpdateCall) DoAndReturn(f func(context.Context, *storage.Upgrade) error) *IUpgradeUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
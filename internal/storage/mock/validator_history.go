@@ -0,0 +1,353 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: validator_history.go
+//
+// Generated by this command:
+//
+//	mockgen -source=validator_history.go -destination=mock/validator_history.go -package=mock -typed
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/celestia-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIValidatorHistory is a mock of IValidatorHistory interface.
+type MockIValidatorHistory struct {
+	ctrl     *gomock.Controller
+	recorder *MockIValidatorHistoryMockRecorder
+}
+
+// MockIValidatorHistoryMockRecorder is the mock recorder for MockIValidatorHistory.
+type MockIValidatorHistoryMockRecorder struct {
+	mock *MockIValidatorHistory
+}
+
+// NewMockIValidatorHistory creates a new mock instance.
+func NewMockIValidatorHistory(ctrl *gomock.Controller) *MockIValidatorHistory {
+	mock := &MockIValidatorHistory{ctrl: ctrl}
+	mock.recorder = &MockIValidatorHistoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIValidatorHistory) EXPECT() *MockIValidatorHistoryMockRecorder {
+	return m.recorder
+}
+
+// ByValidator mocks base method.
+func (m *MockIValidatorHistory) ByValidator(ctx context.Context, id uint64, limit, offset int) ([]storage.ValidatorHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByValidator", ctx, id, limit, offset)
+	ret0, _ := ret[0].([]storage.ValidatorHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByValidator indicates an expected call of ByValidator.
+func (mr *MockIValidatorHistoryMockRecorder) ByValidator(ctx, id, limit, offset any) *IValidatorHistoryByValidatorCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByValidator", reflect.TypeOf((*MockIValidatorHistory)(nil).ByValidator), ctx, id, limit, offset)
+	return &IValidatorHistoryByValidatorCall{Call: call}
+}
+
+// IValidatorHistoryByValidatorCall wrap *gomock.Call
+type IValidatorHistoryByValidatorCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IValidatorHistoryByValidatorCall) Return(arg0 []storage.ValidatorHistory, arg1 error) *IValidatorHistoryByValidatorCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IValidatorHistoryByValidatorCall) Do(f func(context.Context, uint64, int, int) ([]storage.ValidatorHistory, error)) *IValidatorHistoryByValidatorCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IValidatorHistoryByValidatorCall) DoAndReturn(f func(context.Context, uint64, int, int) ([]storage.ValidatorHistory, error)) *IValidatorHistoryByValidatorCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIValidatorHistory) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.ValidatorHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.ValidatorHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIValidatorHistoryMockRecorder) CursorList(ctx, id, limit, order, cmp any) *IValidatorHistoryCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIValidatorHistory)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &IValidatorHistoryCursorListCall{Call: call}
+}
+
+// IValidatorHistoryCursorListCall wrap *gomock.Call
+type IValidatorHistoryCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IValidatorHistoryCursorListCall) Return(arg0 []*storage.ValidatorHistory, arg1 error) *IValidatorHistoryCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IValidatorHistoryCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.ValidatorHistory, error)) *IValidatorHistoryCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IValidatorHistoryCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.ValidatorHistory, error)) *IValidatorHistoryCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIValidatorHistory) GetByID(ctx context.Context, id uint64) (*storage.ValidatorHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.ValidatorHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIValidatorHistoryMockRecorder) GetByID(ctx, id any) *IValidatorHistoryGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIValidatorHistory)(nil).GetByID), ctx, id)
+	return &IValidatorHistoryGetByIDCall{Call: call}
+}
+
+// IValidatorHistoryGetByIDCall wrap *gomock.Call
+type IValidatorHistoryGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IValidatorHistoryGetByIDCall) Return(arg0 *storage.ValidatorHistory, arg1 error) *IValidatorHistoryGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IValidatorHistoryGetByIDCall) Do(f func(context.Context, uint64) (*storage.ValidatorHistory, error)) *IValidatorHistoryGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IValidatorHistoryGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.ValidatorHistory, error)) *IValidatorHistoryGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIValidatorHistory) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIValidatorHistoryMockRecorder) IsNoRows(err any) *IValidatorHistoryIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIValidatorHistory)(nil).IsNoRows), err)
+	return &IValidatorHistoryIsNoRowsCall{Call: call}
+}
+
+// IValidatorHistoryIsNoRowsCall wrap *gomock.Call
+type IValidatorHistoryIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IValidatorHistoryIsNoRowsCall) Return(arg0 bool) *IValidatorHistoryIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IValidatorHistoryIsNoRowsCall) Do(f func(error) bool) *IValidatorHistoryIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IValidatorHistoryIsNoRowsCall) DoAndReturn(f func(error) bool) *IValidatorHistoryIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIValidatorHistory) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIValidatorHistoryMockRecorder) LastID(ctx any) *IValidatorHistoryLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIValidatorHistory)(nil).LastID), ctx)
+	return &IValidatorHistoryLastIDCall{Call: call}
+}
+
+// IValidatorHistoryLastIDCall wrap *gomock.Call
+type IValidatorHistoryLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IValidatorHistoryLastIDCall) Return(arg0 uint64, arg1 error) *IValidatorHistoryLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IValidatorHistoryLastIDCall) Do(f func(context.Context) (uint64, error)) *IValidatorHistoryLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IValidatorHistoryLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *IValidatorHistoryLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIValidatorHistory) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.ValidatorHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.ValidatorHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIValidatorHistoryMockRecorder) List(ctx, limit, offset, order any) *IValidatorHistoryListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIValidatorHistory)(nil).List), ctx, limit, offset, order)
+	return &IValidatorHistoryListCall{Call: call}
+}
+
+// IValidatorHistoryListCall wrap *gomock.Call
+type IValidatorHistoryListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IValidatorHistoryListCall) Return(arg0 []*storage.ValidatorHistory, arg1 error) *IValidatorHistoryListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IValidatorHistoryListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.ValidatorHistory, error)) *IValidatorHistoryListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IValidatorHistoryListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.ValidatorHistory, error)) *IValidatorHistoryListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIValidatorHistory) Save(ctx context.Context, m *storage.ValidatorHistory) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIValidatorHistoryMockRecorder) Save(ctx, m any) *IValidatorHistorySaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIValidatorHistory)(nil).Save), ctx, m)
+	return &IValidatorHistorySaveCall{Call: call}
+}
+
+// IValidatorHistorySaveCall wrap *gomock.Call
+type IValidatorHistorySaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IValidatorHistorySaveCall) Return(arg0 error) *IValidatorHistorySaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IValidatorHistorySaveCall) Do(f func(context.Context, *storage.ValidatorHistory) error) *IValidatorHistorySaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IValidatorHistorySaveCall) DoAndReturn(f func(context.Context, *storage.ValidatorHistory) error) *IValidatorHistorySaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIValidatorHistory) Update(ctx context.Context, m *storage.ValidatorHistory) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIValidatorHistoryMockRecorder) Update(ctx, m any) *IValidatorHistoryUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIValidatorHistory)(nil).Update), ctx, m)
+	return &IValidatorHistoryUpdateCall{Call: call}
+}
+
+// IValidatorHistoryUpdateCall wrap *gomock.Call
+type IValidatorHistoryUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IValidatorHistoryUpdateCall) Return(arg0 error) *IValidatorHistoryUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IValidatorHistoryUpdateCall) Do(f func(context.Context, *storage.ValidatorHistory) error) *IValidatorHistoryUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IValidatorHistoryUpdateCall) DoAndReturn(f func(context.Context, *storage.ValidatorHistory) error) *IValidatorHistoryUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
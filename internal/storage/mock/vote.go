@@ -0,0 +1,431 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: vote.go
+//
+// Generated by this command:
+//
+//	mockgen -source=vote.go -destination=mock/vote.go -package=mock -typed
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/celestia-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIVote is a mock of IVote interface.
+type MockIVote struct {
+	ctrl     *gomock.Controller
+	recorder *MockIVoteMockRecorder
+}
+
+// MockIVoteMockRecorder is the mock recorder for MockIVote.
+type MockIVoteMockRecorder struct {
+	mock *MockIVote
+}
+
+// NewMockIVote creates a new mock instance.
+func NewMockIVote(ctrl *gomock.Controller) *MockIVote {
+	mock := &MockIVote{ctrl: ctrl}
+	mock.recorder = &MockIVoteMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIVote) EXPECT() *MockIVoteMockRecorder {
+	return m.recorder
+}
+
+// ByProposalId mocks base method.
+func (m *MockIVote) ByProposalId(ctx context.Context, proposalId uint64, fltrs storage.VoteFilters) ([]storage.Vote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByProposalId", ctx, proposalId, fltrs)
+	ret0, _ := ret[0].([]storage.Vote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByProposalId indicates an expected call of ByProposalId.
+func (mr *MockIVoteMockRecorder) ByProposalId(ctx, proposalId, fltrs any) *IVoteByProposalIdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByProposalId", reflect.TypeOf((*MockIVote)(nil).ByProposalId), ctx, proposalId, fltrs)
+	return &IVoteByProposalIdCall{Call: call}
+}
+
+// IVoteByProposalIdCall wrap *gomock.Call
+type IVoteByProposalIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IVoteByProposalIdCall) Return(arg0 []storage.Vote, arg1 error) *IVoteByProposalIdCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IVoteByProposalIdCall) Do(f func(context.Context, uint64, storage.VoteFilters) ([]storage.Vote, error)) *IVoteByProposalIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IVoteByProposalIdCall) DoAndReturn(f func(context.Context, uint64, storage.VoteFilters) ([]storage.Vote, error)) *IVoteByProposalIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ByValidatorId mocks base method.
+func (m *MockIVote) ByValidatorId(ctx context.Context, validatorId uint64, fltrs storage.VoteFilters) ([]storage.Vote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByValidatorId", ctx, validatorId, fltrs)
+	ret0, _ := ret[0].([]storage.Vote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByValidatorId indicates an expected call of ByValidatorId.
+func (mr *MockIVoteMockRecorder) ByValidatorId(ctx, validatorId, fltrs any) *IVoteByValidatorIdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByValidatorId", reflect.TypeOf((*MockIVote)(nil).ByValidatorId), ctx, validatorId, fltrs)
+	return &IVoteByValidatorIdCall{Call: call}
+}
+
+// IVoteByValidatorIdCall wrap *gomock.Call
+type IVoteByValidatorIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IVoteByValidatorIdCall) Return(arg0 []storage.Vote, arg1 error) *IVoteByValidatorIdCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IVoteByValidatorIdCall) Do(f func(context.Context, uint64, storage.VoteFilters) ([]storage.Vote, error)) *IVoteByValidatorIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IVoteByValidatorIdCall) DoAndReturn(f func(context.Context, uint64, storage.VoteFilters) ([]storage.Vote, error)) *IVoteByValidatorIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ByVoterId mocks base method.
+func (m *MockIVote) ByVoterId(ctx context.Context, voterId uint64, fltrs storage.VoteFilters) ([]storage.Vote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByVoterId", ctx, voterId, fltrs)
+	ret0, _ := ret[0].([]storage.Vote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByVoterId indicates an expected call of ByVoterId.
+func (mr *MockIVoteMockRecorder) ByVoterId(ctx, voterId, fltrs any) *IVoteByVoterIdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByVoterId", reflect.TypeOf((*MockIVote)(nil).ByVoterId), ctx, voterId, fltrs)
+	return &IVoteByVoterIdCall{Call: call}
+}
+
+// IVoteByVoterIdCall wrap *gomock.Call
+type IVoteByVoterIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IVoteByVoterIdCall) Return(arg0 []storage.Vote, arg1 error) *IVoteByVoterIdCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IVoteByVoterIdCall) Do(f func(context.Context, uint64, storage.VoteFilters) ([]storage.Vote, error)) *IVoteByVoterIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IVoteByVoterIdCall) DoAndReturn(f func(context.Context, uint64, storage.VoteFilters) ([]storage.Vote, error)) *IVoteByVoterIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIVote) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.Vote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.Vote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIVoteMockRecorder) CursorList(ctx, id, limit, order, cmp any) *IVoteCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIVote)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &IVoteCursorListCall{Call: call}
+}
+
+// IVoteCursorListCall wrap *gomock.Call
+type IVoteCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IVoteCursorListCall) Return(arg0 []*storage.Vote, arg1 error) *IVoteCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IVoteCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Vote, error)) *IVoteCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IVoteCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Vote, error)) *IVoteCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIVote) GetByID(ctx context.Context, id uint64) (*storage.Vote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.Vote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIVoteMockRecorder) GetByID(ctx, id any) *IVoteGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIVote)(nil).GetByID), ctx, id)
+	return &IVoteGetByIDCall{Call: call}
+}
+
+// IVoteGetByIDCall wrap *gomock.Call
+type IVoteGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IVoteGetByIDCall) Return(arg0 *storage.Vote, arg1 error) *IVoteGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IVoteGetByIDCall) Do(f func(context.Context, uint64) (*storage.Vote, error)) *IVoteGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IVoteGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.Vote, error)) *IVoteGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIVote) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIVoteMockRecorder) IsNoRows(err any) *IVoteIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIVote)(nil).IsNoRows), err)
+	return &IVoteIsNoRowsCall{Call: call}
+}
+
+// IVoteIsNoRowsCall wrap *gomock.Call
+type IVoteIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IVoteIsNoRowsCall) Return(arg0 bool) *IVoteIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IVoteIsNoRowsCall) Do(f func(error) bool) *IVoteIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IVoteIsNoRowsCall) DoAndReturn(f func(error) bool) *IVoteIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIVote) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIVoteMockRecorder) LastID(ctx any) *IVoteLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIVote)(nil).LastID), ctx)
+	return &IVoteLastIDCall{Call: call}
+}
+
+// IVoteLastIDCall wrap *gomock.Call
+type IVoteLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IVoteLastIDCall) Return(arg0 uint64, arg1 error) *IVoteLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IVoteLastIDCall) Do(f func(context.Context) (uint64, error)) *IVoteLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IVoteLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *IVoteLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIVote) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.Vote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.Vote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIVoteMockRecorder) List(ctx, limit, offset, order any) *IVoteListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIVote)(nil).List), ctx, limit, offset, order)
+	return &IVoteListCall{Call: call}
+}
+
+// IVoteListCall wrap *gomock.Call
+type IVoteListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IVoteListCall) Return(arg0 []*storage.Vote, arg1 error) *IVoteListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IVoteListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Vote, error)) *IVoteListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IVoteListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Vote, error)) *IVoteListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIVote) Save(ctx context.Context, m *storage.Vote) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIVoteMockRecorder) Save(ctx, m any) *IVoteSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIVote)(nil).Save), ctx, m)
+	return &IVoteSaveCall{Call: call}
+}
+
+// IVoteSaveCall wrap *gomock.Call
+type IVoteSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IVoteSaveCall) Return(arg0 error) *IVoteSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IVoteSaveCall) Do(f func(context.Context, *storage.Vote) error) *IVoteSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IVoteSaveCall) DoAndReturn(f func(context.Context, *storage.Vote) error) *IVoteSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIVote) Update(ctx context.Context, m *storage.Vote) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIVoteMockRecorder) Update(ctx, m any) *IVoteUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIVote)(nil).Update), ctx, m)
+	return &IVoteUpdateCall{Call: call}
+}
+
+// IVoteUpdateCall wrap *gomock.Call
+type IVoteUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IVoteUpdateCall) Return(arg0 error) *IVoteUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IVoteUpdateCall) Do(f func(context.Context, *storage.Vote) error) *IVoteUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IVoteUpdateCall) DoAndReturn(f func(context.Context, *storage.Vote) error) *IVoteUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
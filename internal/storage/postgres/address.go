@@ -54,6 +54,10 @@ func (a *Address) ListWithBalance(ctx context.Context, filters storage.AddressLi
 }
 
 func (a *Address) Series(ctx context.Context, addressId uint64, timeframe storage.Timeframe, column string, req storage.SeriesRequest) (items []storage.HistogramItem, err error) {
+	if column == "balance" {
+		return a.balanceSeries(ctx, addressId, timeframe, req)
+	}
+
 	query := a.DB().NewSelect().
 		Where("address_id = ?", addressId).
 		Order("time desc").
@@ -95,6 +99,40 @@ func (a *Address) Series(ctx context.Context, addressId uint64, timeframe storag
 	return
 }
 
+// balanceSeries - returns spendable balance at the end of each bucket in which balance was changed
+func (a *Address) balanceSeries(ctx context.Context, addressId uint64, timeframe storage.Timeframe, req storage.SeriesRequest) (items []storage.HistogramItem, err error) {
+	var interval string
+	switch timeframe {
+	case storage.TimeframeHour:
+		interval = "1 hour"
+	case storage.TimeframeDay:
+		interval = "1 day"
+	case storage.TimeframeMonth:
+		interval = "1 month"
+	default:
+		return nil, errors.Errorf("invalid timeframe: %s", timeframe)
+	}
+
+	query := a.DB().NewSelect().
+		Model((*storage.BalanceHistory)(nil)).
+		ColumnExpr("time_bucket(?::interval, time) as bucket", interval).
+		ColumnExpr("last(spendable, id) as value").
+		Where("address_id = ?", addressId).
+		Group("bucket").
+		Order("bucket desc").
+		Limit(100)
+
+	if !req.From.IsZero() {
+		query = query.Where("time >= ?", req.From)
+	}
+	if !req.To.IsZero() {
+		query = query.Where("time < ?", req.To)
+	}
+
+	err = query.Scan(ctx, &items)
+	return
+}
+
 // IdByHash -
 func (a *Address) IdByHash(ctx context.Context, hash []byte) (id uint64, err error) {
 	err = a.DB().NewSelect().
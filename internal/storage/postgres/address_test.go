@@ -164,6 +164,16 @@ func (s *StorageTestSuite) TestAddressStats() {
 	}
 }
 
+func (s *StorageTestSuite) TestAddressBalanceStats() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	series, err := s.storage.Address.Series(ctx, 1, storage.TimeframeDay, "balance", storage.NewSeriesRequest(0, 0))
+	s.Require().NoError(err)
+	s.Require().Len(series, 1)
+	s.Require().Equal("432", series[0].Value)
+}
+
 func (s *StorageTestSuite) TestAddressStatsError() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
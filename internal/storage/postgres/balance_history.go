@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
+)
+
+// BalanceHistory -
+type BalanceHistory struct {
+	*postgres.Table[*storage.BalanceHistory]
+}
+
+// NewBalanceHistory -
+func NewBalanceHistory(db *database.Bun) *BalanceHistory {
+	return &BalanceHistory{
+		Table: postgres.NewTable[*storage.BalanceHistory](db),
+	}
+}
+
+func (bh *BalanceHistory) ByAddress(ctx context.Context, addressId uint64, fltrs storage.BalanceHistoryFilters) (history []storage.BalanceHistory, err error) {
+	query := bh.DB().NewSelect().
+		Model(&history).
+		Where("address_id = ?", addressId)
+
+	query = limitScope(query, fltrs.Limit)
+	if fltrs.Offset > 0 {
+		query = query.Offset(fltrs.Offset)
+	}
+	if len(fltrs.Type) > 0 {
+		query = query.Where("type IN (?)", bun.In(fltrs.Type))
+	}
+	if fltrs.Height > 0 {
+		query = query.Where("height = ?", fltrs.Height)
+	}
+	if !fltrs.TimeFrom.IsZero() {
+		query = query.Where("time >= ?", fltrs.TimeFrom)
+	}
+	if !fltrs.TimeTo.IsZero() {
+		query = query.Where("time < ?", fltrs.TimeTo)
+	}
+	query = sortScope(query, "time", fltrs.Sort)
+	query = sortScope(query, "id", fltrs.Sort)
+
+	err = query.Scan(ctx)
+	return
+}
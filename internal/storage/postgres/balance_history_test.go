@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+)
+
+func (s *StorageTestSuite) TestBalanceHistoryByAddress() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	history, err := s.storage.BalanceHistory.ByAddress(ctx, 1, storage.BalanceHistoryFilters{
+		Limit: 10,
+		Sort:  sdk.SortOrderDesc,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(history, 3)
+
+	h := history[0]
+	s.Require().EqualValues(3, h.Id)
+	s.Require().EqualValues(1000, h.Height)
+	s.Require().EqualValues(1, h.AddressId)
+	s.Require().Equal(types.BalanceHistoryTypeDelegation, h.Type)
+	s.Require().Equal("10", h.DelegatedChange.String())
+	s.Require().Equal("432", h.Spendable.String())
+	s.Require().Equal("10", h.Delegated.String())
+}
+
+func (s *StorageTestSuite) TestBalanceHistoryByAddressWithFilters() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	history, err := s.storage.BalanceHistory.ByAddress(ctx, 1, storage.BalanceHistoryFilters{
+		Limit:    10,
+		Sort:     sdk.SortOrderAsc,
+		Type:     []types.BalanceHistoryType{types.BalanceHistoryTypeFee, types.BalanceHistoryTypeTransfer},
+		TimeFrom: time.Date(2023, 7, 4, 3, 10, 57, 0, time.UTC),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(history, 1)
+
+	h := history[0]
+	s.Require().EqualValues(2, h.Id)
+	s.Require().Equal(types.BalanceHistoryTypeFee, h.Type)
+	s.Require().Equal("-10", h.SpendableChange.String())
+
+	history, err = s.storage.BalanceHistory.ByAddress(ctx, 1, storage.BalanceHistoryFilters{
+		Limit:  10,
+		Height: 100,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(history, 1)
+	s.Require().EqualValues(1, history[0].Id)
+}
@@ -240,7 +240,7 @@ func (bl *BlobLog) Blob(ctx context.Context, height types.Level, nsId uint64, co
 
 	err = bl.DB().NewSelect().
 		ColumnExpr("blob_log.*").
-		ColumnExpr("rollup.id as rollup__id, rollup.name as rollup__name, rollup.logo as rollup__logo, rollup.slug as rollup__slug").
+		ColumnExpr("rollup.id as rollup__id, rollup.name as rollup__name, rollup.logo as rollup__logo, rollup.slug as rollup__slug, rollup.stack as rollup__stack").
 		ColumnExpr("signer.address as signer__address").
 		ColumnExpr("ns.id as namespace__id, ns.size as namespace__size, ns.blobs_count as namespace__blobs_count, ns.version as namespace__version, ns.namespace_id as namespace__namespace_id, ns.reserved as namespace__reserved, ns.pfb_count as namespace__pfb_count, ns.last_height as namespace__last_height, ns.last_message_time as namespace__last_message_time").
 		ColumnExpr("tx.id as tx__id, tx.height as tx__height, tx.time as tx__time, tx.position as tx__position, tx.gas_wanted as tx__gas_wanted, tx.gas_used as tx__gas_used, tx.timeout_height as tx__timeout_height, tx.events_count as tx__events_count, tx.messages_count as tx__messages_count, tx.fee as tx__fee, tx.status as tx__status, tx.error as tx__error, tx.codespace as tx__codespace, tx.hash as tx__hash, tx.memo as tx__memo, tx.message_types as tx__message_types").
@@ -253,3 +253,13 @@ func (bl *BlobLog) Blob(ctx context.Context, height types.Level, nsId uint64, co
 		Scan(ctx, &l)
 	return
 }
+
+func (bl *BlobLog) SharesByHeight(ctx context.Context, height types.Level) (logs []storage.BlobLog, err error) {
+	err = bl.DB().NewSelect().
+		Model(&logs).
+		Column("id", "height", "commitment", "namespace_id", "share_start", "share_count", "start_row", "start_col", "end_row", "end_col").
+		Where("height = ?", height).
+		Order("share_start asc").
+		Scan(ctx)
+	return
+}
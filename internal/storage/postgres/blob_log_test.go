@@ -67,6 +67,8 @@ func (s *StorageTestSuite) TestBlobLogsByNamespaceAndCommitment() {
 	s.Require().EqualValues(2, log.SignerId)
 	s.Require().EqualValues(1, log.MsgId)
 	s.Require().EqualValues(2, log.TxId)
+	s.Require().EqualValues("op_stack", log.Decoder)
+	s.Require().JSONEq(`{"channels":["aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"],"frames_count":1}`, string(log.Batch))
 
 	s.Require().NotNil(log.Signer)
 	s.Require().EqualValues("celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60", log.Signer.Address)
@@ -229,6 +231,30 @@ func (s *StorageTestSuite) TestBlobLogsByHeight() {
 	s.Require().Equal("Rollup 1", log.Rollup.Name)
 }
 
+func (s *StorageTestSuite) TestBlobLogsSharesByHeight() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	logs, err := s.storage.BlobLogs.SharesByHeight(ctx, 1000)
+	s.Require().NoError(err)
+	s.Require().Len(logs, 4)
+
+	for i, id := range []uint64{3, 4, 2, 5} {
+		s.Require().EqualValues(id, logs[i].Id)
+	}
+
+	log := logs[1]
+	s.Require().EqualValues(1000, log.Height)
+	s.Require().EqualValues("0CsLX630cjij9DR6nqoWfQcCH2pCQSoSuq63dTkd4Bw=", log.Commitment)
+	s.Require().EqualValues(3, log.NamespaceId)
+	s.Require().EqualValues(2, log.ShareStart)
+	s.Require().EqualValues(2, log.ShareCount)
+	s.Require().EqualValues(0, log.StartRow)
+	s.Require().EqualValues(2, log.StartCol)
+	s.Require().EqualValues(0, log.EndRow)
+	s.Require().EqualValues(3, log.EndCol)
+}
+
 func (s *StorageTestSuite) TestCountBlobLogsByHeight() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
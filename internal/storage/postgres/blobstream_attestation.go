@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
+)
+
+// BlobstreamAttestation -
+type BlobstreamAttestation struct {
+	*postgres.Table[*storage.BlobstreamAttestation]
+}
+
+// NewBlobstreamAttestation -
+func NewBlobstreamAttestation(db *database.Bun) *BlobstreamAttestation {
+	return &BlobstreamAttestation{
+		Table: postgres.NewTable[*storage.BlobstreamAttestation](db),
+	}
+}
+
+func (ba *BlobstreamAttestation) ListWithFilters(ctx context.Context, fltrs storage.BlobstreamAttestationFilters) (attestations []storage.BlobstreamAttestation, err error) {
+	query := ba.DB().NewSelect().
+		Model(&attestations)
+
+	query = limitScope(query, fltrs.Limit)
+	if fltrs.Offset > 0 {
+		query = query.Offset(fltrs.Offset)
+	}
+	query = sortScope(query, "nonce", fltrs.Sort)
+
+	if len(fltrs.Type) > 0 {
+		query = query.Where("type IN (?)", bun.In(fltrs.Type))
+	}
+	if !fltrs.TimeFrom.IsZero() {
+		query = query.Where("time >= ?", fltrs.TimeFrom)
+	}
+	if !fltrs.TimeTo.IsZero() {
+		query = query.Where("time < ?", fltrs.TimeTo)
+	}
+
+	err = query.Scan(ctx)
+	return
+}
+
+func (ba *BlobstreamAttestation) ByNonce(ctx context.Context, nonce uint64) (attestation storage.BlobstreamAttestation, err error) {
+	err = ba.DB().NewSelect().
+		Model(&attestation).
+		Where("nonce = ?", nonce).
+		Limit(1).
+		Scan(ctx)
+	return
+}
+
+// ByDataHeight - returns data commitment which range contains the height
+func (ba *BlobstreamAttestation) ByDataHeight(ctx context.Context, height pkgTypes.Level) (attestation storage.BlobstreamAttestation, err error) {
+	err = ba.DB().NewSelect().
+		Model(&attestation).
+		Where("type = ?", types.AttestationTypeDataCommitment).
+		Where("begin_block <= ?", height).
+		Where("end_block > ?", height).
+		Limit(1).
+		Scan(ctx)
+	return
+}
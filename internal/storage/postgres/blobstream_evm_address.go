@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+// BlobstreamEvmAddress -
+type BlobstreamEvmAddress struct {
+	*postgres.Table[*storage.BlobstreamEvmAddress]
+}
+
+// NewBlobstreamEvmAddress -
+func NewBlobstreamEvmAddress(db *database.Bun) *BlobstreamEvmAddress {
+	return &BlobstreamEvmAddress{
+		Table: postgres.NewTable[*storage.BlobstreamEvmAddress](db),
+	}
+}
+
+func (bea *BlobstreamEvmAddress) ListWithFilters(ctx context.Context, fltrs storage.BlobstreamEvmAddressFilters) (addresses []storage.BlobstreamEvmAddress, err error) {
+	query := bea.DB().NewSelect().
+		Model((*storage.BlobstreamEvmAddress)(nil))
+
+	query = limitScope(query, fltrs.Limit)
+	if fltrs.Offset > 0 {
+		query = query.Offset(fltrs.Offset)
+	}
+	query = sortScope(query, "id", fltrs.Sort)
+
+	if fltrs.ValidatorId != nil {
+		query = query.Where("validator_id = ?", *fltrs.ValidatorId)
+	}
+
+	outer := bea.DB().NewSelect().
+		TableExpr("(?) as blobstream_evm_address", query).
+		ColumnExpr("blobstream_evm_address.*").
+		ColumnExpr("validator.id as validator__id, validator.cons_address as validator__cons_address, validator.moniker as validator__moniker").
+		ColumnExpr("tx.hash as tx__hash").
+		Join("left join validator on validator.id = blobstream_evm_address.validator_id").
+		Join("left join tx on tx.id = blobstream_evm_address.tx_id")
+	outer = sortScope(outer, "blobstream_evm_address.id", fltrs.Sort)
+
+	err = outer.Scan(ctx, &addresses)
+	return
+}
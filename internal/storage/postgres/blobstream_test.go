@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	testsuite "github.com/celenium-io/celestia-indexer/internal/test_suite"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+)
+
+func (s *StorageTestSuite) TestBlobstreamAttestationListWithFilters() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	attestations, err := s.storage.Attestations.ListWithFilters(ctx, storage.BlobstreamAttestationFilters{
+		Limit: 10,
+		Sort:  sdk.SortOrderDesc,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(attestations, 3)
+	s.Require().EqualValues(3, attestations[0].Nonce)
+
+	attestations, err = s.storage.Attestations.ListWithFilters(ctx, storage.BlobstreamAttestationFilters{
+		Limit: 10,
+		Sort:  sdk.SortOrderAsc,
+		Type:  []types.AttestationType{types.AttestationTypeValset},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(attestations, 1)
+	s.Require().EqualValues(2, attestations[0].Nonce)
+	s.Require().Equal(types.AttestationTypeValset, attestations[0].Type)
+}
+
+func (s *StorageTestSuite) TestBlobstreamAttestationByNonce() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	attestation, err := s.storage.Attestations.ByNonce(ctx, 3)
+	s.Require().NoError(err)
+	s.Require().EqualValues(3, attestation.Id)
+	s.Require().EqualValues(401, attestation.BeginBlock)
+	s.Require().EqualValues(801, attestation.EndBlock)
+}
+
+func (s *StorageTestSuite) TestBlobstreamAttestationByDataHeight() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	attestation, err := s.storage.Attestations.ByDataHeight(ctx, 401)
+	s.Require().NoError(err)
+	s.Require().EqualValues(3, attestation.Nonce)
+
+	attestation, err = s.storage.Attestations.ByDataHeight(ctx, 400)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, attestation.Nonce)
+
+	_, err = s.storage.Attestations.ByDataHeight(ctx, 801)
+	s.Require().Error(err)
+	s.Require().True(s.storage.Attestations.IsNoRows(err))
+}
+
+func (s *StorageTestSuite) TestBlobstreamEvmAddressListWithFilters() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	addresses, err := s.storage.EvmAddresses.ListWithFilters(ctx, storage.BlobstreamEvmAddressFilters{
+		Limit: 10,
+		Sort:  sdk.SortOrderDesc,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(addresses, 2)
+	s.Require().EqualValues(2, addresses[0].Id)
+	s.Require().NotNil(addresses[0].Validator)
+	s.Require().EqualValues(2, addresses[0].Validator.Id)
+	s.Require().NotNil(addresses[0].Tx)
+	s.Require().NotEmpty(addresses[0].Tx.Hash)
+
+	addresses, err = s.storage.EvmAddresses.ListWithFilters(ctx, storage.BlobstreamEvmAddressFilters{
+		Limit:       10,
+		ValidatorId: testsuite.Ptr[uint64](1),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(addresses, 1)
+	s.Require().Equal("0xfDC46fBDd8AF50d9Bf7536Bf44ce8560E423352c", addresses[0].EvmAddress)
+	s.Require().Equal("Conqueror", addresses[0].Validator.Moniker)
+}
@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+// BlockDah -
+type BlockDah struct {
+	*postgres.Table[*storage.BlockDah]
+}
+
+// NewBlockDah -
+func NewBlockDah(db *database.Bun) *BlockDah {
+	return &BlockDah{
+		Table: postgres.NewTable[*storage.BlockDah](db),
+	}
+}
+
+func (bd *BlockDah) ByHeight(ctx context.Context, height types.Level) (dah storage.BlockDah, err error) {
+	err = bd.DB().NewSelect().
+		Model(&dah).
+		Where("height = ?", height).
+		Limit(1).
+		Scan(ctx)
+	return
+}
@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+)
+
+func (s *StorageTestSuite) TestBlockDahByHeight() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	dah, err := s.storage.BlockDah.ByHeight(ctx, 1000)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1000, dah.Height)
+	s.Require().True(dah.Valid)
+	s.Require().EqualValues(1, dah.SquareSize)
+	s.Require().Equal(dah.Hash, dah.DataHash)
+	s.Require().Len(dah.RowRoots, 2)
+	s.Require().Len(dah.ColumnRoots, 2)
+
+	_, err = s.storage.BlockDah.ByHeight(ctx, 1)
+	s.Require().Error(err)
+	s.Require().True(s.storage.BlockDah.IsNoRows(err))
+}
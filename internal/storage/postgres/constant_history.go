@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+// ConstantHistory -
+type ConstantHistory struct {
+	*postgres.Table[*storage.ConstantHistory]
+}
+
+// NewConstantHistory -
+func NewConstantHistory(db *database.Bun) *ConstantHistory {
+	return &ConstantHistory{
+		Table: postgres.NewTable[*storage.ConstantHistory](db),
+	}
+}
+
+// ByHeight - returns constant values which were effective at the height
+func (ch *ConstantHistory) ByHeight(ctx context.Context, height pkgTypes.Level) (c []storage.Constant, err error) {
+	err = ch.DB().NewSelect().
+		TableExpr("constant_history").
+		ColumnExpr("DISTINCT ON (module, name) module, name, value").
+		Where("height <= ?", height).
+		OrderExpr("module, name, height desc, id desc").
+		Scan(ctx, &c)
+	return
+}
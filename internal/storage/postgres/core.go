@@ -24,32 +24,55 @@ type Storage struct {
 	cfg        config.Database
 	scriptsDir string
 
-	Blocks          models.IBlock
-	BlockStats      models.IBlockStats
-	BlockSignatures models.IBlockSignature
-	BlobLogs        models.IBlobLog
-	Constants       models.IConstant
-	DenomMetadata   models.IDenomMetadata
-	Tx              models.ITx
-	Message         models.IMessage
-	Event           models.IEvent
-	Address         models.IAddress
-	VestingAccounts models.IVestingAccount
-	VestingPeriods  models.IVestingPeriod
-	Namespace       models.INamespace
-	Price           models.IPrice
-	State           models.IState
-	Stats           models.IStats
-	Search          models.ISearch
-	Validator       models.IValidator
-	StakingLogs     models.IStakingLog
-	Delegation      models.IDelegation
-	Redelegation    models.IRedelegation
-	Undelegation    models.IUndelegation
-	Jails           models.IJail
-	Rollup          models.IRollup
-	Grants          models.IGrant
-	Notificator     *Notificator
+	Blocks             models.IBlock
+	BlockStats         models.IBlockStats
+	BlockSignatures    models.IBlockSignature
+	MissedBlocks       models.IMissedBlock
+	BlockDah           models.IBlockDah
+	BlobLogs           models.IBlobLog
+	Constants          models.IConstant
+	DenomMetadata      models.IDenomMetadata
+	Tx                 models.ITx
+	Message            models.IMessage
+	Event              models.IEvent
+	Address            models.IAddress
+	VestingAccounts    models.IVestingAccount
+	VestingPeriods     models.IVestingPeriod
+	Namespace          models.INamespace
+	Price              models.IPrice
+	State              models.IState
+	Stats              models.IStats
+	Search             models.ISearch
+	Validator          models.IValidator
+	StakingLogs        models.IStakingLog
+	Delegation         models.IDelegation
+	Redelegation       models.IRedelegation
+	Undelegation       models.IUndelegation
+	Jails              models.IJail
+	Rollup             models.IRollup
+	Grants             models.IGrant
+	Proposals          models.IProposal
+	Votes              models.IVote
+	Deposits           models.IDeposit
+	IbcClients         models.IIbcClient
+	IbcConnections     models.IIbcConnection
+	IbcChannels        models.IIbcChannel
+	IbcTransfers       models.IIbcTransfer
+	InterchainAccounts models.IInterchainAccount
+	Upgrades           models.IUpgrade
+	BalanceHistory     models.IBalanceHistory
+	Transfers          models.ITransfer
+	ConstantHistory    models.IConstantHistory
+	Attestations       models.IBlobstreamAttestation
+	EvmAddresses       models.IBlobstreamEvmAddress
+	Slashes            models.ISlash
+	ValidatorHistory   models.IValidatorHistory
+	Groups             models.IGroup
+	GroupMembers       models.IGroupMember
+	GroupPolicies      models.IGroupPolicy
+	GroupProposals     models.IGroupProposal
+	GroupVotes         models.IGroupVote
+	Notificator        *Notificator
 
 	export models.Export
 }
@@ -64,35 +87,58 @@ func Create(ctx context.Context, cfg config.Database, scriptsDir string) (Storag
 	export := NewExport(cfg)
 
 	s := Storage{
-		cfg:             cfg,
-		scriptsDir:      scriptsDir,
-		Storage:         strg,
-		Blocks:          NewBlocks(strg.Connection()),
-		BlockStats:      NewBlockStats(strg.Connection()),
-		BlockSignatures: NewBlockSignature(strg.Connection()),
-		BlobLogs:        NewBlobLog(strg.Connection(), export),
-		Constants:       NewConstant(strg.Connection()),
-		DenomMetadata:   NewDenomMetadata(strg.Connection()),
-		Message:         NewMessage(strg.Connection()),
-		Event:           NewEvent(strg.Connection()),
-		Address:         NewAddress(strg.Connection()),
-		VestingAccounts: NewVestingAccount(strg.Connection()),
-		VestingPeriods:  NewVestingPeriod(strg.Connection()),
-		Price:           NewPrice(strg.Connection()),
-		Tx:              NewTx(strg.Connection()),
-		State:           NewState(strg.Connection()),
-		Namespace:       NewNamespace(strg.Connection()),
-		Stats:           NewStats(strg.Connection()),
-		Search:          NewSearch(strg.Connection()),
-		Validator:       NewValidator(strg.Connection()),
-		StakingLogs:     NewStakingLog(strg.Connection()),
-		Delegation:      NewDelegation(strg.Connection()),
-		Redelegation:    NewRedelegation(strg.Connection()),
-		Undelegation:    NewUndelegation(strg.Connection()),
-		Jails:           NewJail(strg.Connection()),
-		Rollup:          NewRollup(strg.Connection()),
-		Grants:          NewGrant(strg.Connection()),
-		Notificator:     NewNotificator(cfg, strg.Connection().DB()),
+		cfg:                cfg,
+		scriptsDir:         scriptsDir,
+		Storage:            strg,
+		Blocks:             NewBlocks(strg.Connection()),
+		BlockStats:         NewBlockStats(strg.Connection()),
+		BlockSignatures:    NewBlockSignature(strg.Connection()),
+		MissedBlocks:       NewMissedBlock(strg.Connection()),
+		BlockDah:           NewBlockDah(strg.Connection()),
+		BlobLogs:           NewBlobLog(strg.Connection(), export),
+		Constants:          NewConstant(strg.Connection()),
+		DenomMetadata:      NewDenomMetadata(strg.Connection()),
+		Message:            NewMessage(strg.Connection()),
+		Event:              NewEvent(strg.Connection()),
+		Address:            NewAddress(strg.Connection()),
+		VestingAccounts:    NewVestingAccount(strg.Connection()),
+		VestingPeriods:     NewVestingPeriod(strg.Connection()),
+		Price:              NewPrice(strg.Connection()),
+		Tx:                 NewTx(strg.Connection()),
+		State:              NewState(strg.Connection()),
+		Namespace:          NewNamespace(strg.Connection()),
+		Stats:              NewStats(strg.Connection()),
+		Search:             NewSearch(strg.Connection()),
+		Validator:          NewValidator(strg.Connection()),
+		StakingLogs:        NewStakingLog(strg.Connection()),
+		Delegation:         NewDelegation(strg.Connection()),
+		Redelegation:       NewRedelegation(strg.Connection()),
+		Undelegation:       NewUndelegation(strg.Connection()),
+		Jails:              NewJail(strg.Connection()),
+		Rollup:             NewRollup(strg.Connection()),
+		Grants:             NewGrant(strg.Connection()),
+		Proposals:          NewProposal(strg.Connection()),
+		Votes:              NewVote(strg.Connection()),
+		Deposits:           NewDeposit(strg.Connection()),
+		IbcClients:         NewIbcClient(strg.Connection()),
+		IbcConnections:     NewIbcConnection(strg.Connection()),
+		IbcChannels:        NewIbcChannel(strg.Connection()),
+		IbcTransfers:       NewIbcTransfer(strg.Connection()),
+		InterchainAccounts: NewInterchainAccount(strg.Connection()),
+		Upgrades:           NewUpgrade(strg.Connection()),
+		BalanceHistory:     NewBalanceHistory(strg.Connection()),
+		Transfers:          NewTransfer(strg.Connection()),
+		ConstantHistory:    NewConstantHistory(strg.Connection()),
+		Attestations:       NewBlobstreamAttestation(strg.Connection()),
+		EvmAddresses:       NewBlobstreamEvmAddress(strg.Connection()),
+		Slashes:            NewSlash(strg.Connection()),
+		ValidatorHistory:   NewValidatorHistory(strg.Connection()),
+		Groups:             NewGroup(strg.Connection()),
+		GroupMembers:       NewGroupMember(strg.Connection()),
+		GroupPolicies:      NewGroupPolicy(strg.Connection()),
+		GroupProposals:     NewGroupProposal(strg.Connection()),
+		GroupVotes:         NewGroupVote(strg.Connection()),
+		Notificator:        NewNotificator(cfg, strg.Connection().DB()),
 
 		export: export,
 	}
@@ -163,6 +209,11 @@ func createHypertables(ctx context.Context, conn *database.Bun) error {
 			&models.Jail{},
 			&models.StakingLog{},
 			&models.Price{},
+			&models.BalanceHistory{},
+			&models.Transfer{},
+			&models.Slash{},
+			&models.MissedBlock{},
+			&models.BlockDah{},
 		} {
 			if _, err := tx.ExecContext(ctx,
 				`SELECT create_hypertable(?, 'time', chunk_time_interval => INTERVAL '1 month', if_not_exists => TRUE);`,
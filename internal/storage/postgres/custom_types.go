@@ -94,6 +94,121 @@ func createTypes(ctx context.Context, conn *database.Bun) error {
 		); err != nil {
 			return err
 		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"proposal_status",
+			bun.Safe("proposal_status"),
+			bun.In(types.ProposalStatusValues()),
+		); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"proposal_type",
+			bun.Safe("proposal_type"),
+			bun.In(types.ProposalTypeValues()),
+		); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"vote_option",
+			bun.Safe("vote_option"),
+			bun.In(types.VoteOptionValues()),
+		); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"ibc_transfer_status",
+			bun.Safe("ibc_transfer_status"),
+			bun.In(types.IbcTransferStatusValues()),
+		); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"ibc_channel_status",
+			bun.Safe("ibc_channel_status"),
+			bun.In(types.IbcChannelStatusValues()),
+		); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"balance_history_type",
+			bun.Safe("balance_history_type"),
+			bun.In(types.BalanceHistoryTypeValues()),
+		); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"attestation_type",
+			bun.Safe("attestation_type"),
+			bun.In(types.AttestationTypeValues()),
+		); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"group_proposal_status",
+			bun.Safe("group_proposal_status"),
+			bun.In(types.GroupProposalStatusValues()),
+		); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"group_executor_result",
+			bun.Safe("group_executor_result"),
+			bun.In(types.GroupExecutorResultValues()),
+		); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"decision_policy_type",
+			bun.Safe("decision_policy_type"),
+			bun.In(types.DecisionPolicyTypeValues()),
+		); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"upgrade_status",
+			bun.Safe("upgrade_status"),
+			bun.In(types.UpgradeStatusValues()),
+		); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"tx_address_type",
+			bun.Safe("tx_address_type"),
+			bun.In(types.TxAddressTypeValues()),
+		); err != nil {
+			return err
+		}
 		return nil
 	})
 }
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
+)
+
+// Deposit -
+type Deposit struct {
+	*postgres.Table[*storage.Deposit]
+}
+
+// NewDeposit -
+func NewDeposit(db *database.Bun) *Deposit {
+	return &Deposit{
+		Table: postgres.NewTable[*storage.Deposit](db),
+	}
+}
+
+func (d *Deposit) withDepositor(ctx context.Context, query *bun.SelectQuery, sort sdk.SortOrder) (deposits []storage.Deposit, err error) {
+	outer := d.DB().NewSelect().
+		TableExpr("(?) as deposit", query).
+		ColumnExpr("deposit.*").
+		ColumnExpr("address.address as depositor__address").
+		Join("left join address on address.id = deposit.depositor_id")
+	outer = sortScope(outer, "deposit.id", sort)
+	err = outer.Scan(ctx, &deposits)
+	return
+}
+
+func (d *Deposit) ByProposalId(ctx context.Context, proposalId uint64, limit, offset int, sort sdk.SortOrder) ([]storage.Deposit, error) {
+	query := d.DB().NewSelect().
+		Model((*storage.Deposit)(nil)).
+		Where("proposal_id = ?", proposalId)
+
+	query = limitScope(query, limit)
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	query = sortScope(query, "id", sort)
+	return d.withDepositor(ctx, query, sort)
+}
+
+func (d *Deposit) ByDepositorId(ctx context.Context, depositorId uint64, limit, offset int, sort sdk.SortOrder) ([]storage.Deposit, error) {
+	query := d.DB().NewSelect().
+		Model((*storage.Deposit)(nil)).
+		Where("depositor_id = ?", depositorId)
+
+	query = limitScope(query, limit)
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	query = sortScope(query, "id", sort)
+	return d.withDepositor(ctx, query, sort)
+}
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
+)
+
+// Group -
+type Group struct {
+	*postgres.Table[*storage.Group]
+}
+
+// NewGroup -
+func NewGroup(db *database.Bun) *Group {
+	return &Group{
+		Table: postgres.NewTable[*storage.Group](db),
+	}
+}
+
+func (g *Group) withRelations(query *bun.SelectQuery) *bun.SelectQuery {
+	return g.DB().NewSelect().
+		TableExpr("(?) as group_info", query).
+		ColumnExpr("group_info.*").
+		ColumnExpr("admin.address as admin__address").
+		ColumnExpr("tx.hash as tx__hash").
+		Join("left join address as admin on admin.id = group_info.admin_id").
+		Join("left join tx on tx.id = group_info.tx_id")
+}
+
+func (g *Group) ListWithFilters(ctx context.Context, fltrs storage.GroupFilters) (groups []storage.Group, err error) {
+	query := g.DB().NewSelect().
+		Model((*storage.Group)(nil))
+
+	query = limitScope(query, fltrs.Limit)
+	if fltrs.Offset > 0 {
+		query = query.Offset(fltrs.Offset)
+	}
+	query = sortScope(query, "id", fltrs.Sort)
+
+	if fltrs.AdminId > 0 {
+		query = query.Where("admin_id = ?", fltrs.AdminId)
+	}
+
+	outer := g.withRelations(query)
+	outer = sortScope(outer, "group_info.id", fltrs.Sort)
+	err = outer.Scan(ctx, &groups)
+	return
+}
+
+func (g *Group) ById(ctx context.Context, id uint64) (group storage.Group, err error) {
+	query := g.DB().NewSelect().
+		Model((*storage.Group)(nil)).
+		Where("id = ?", id)
+
+	err = g.withRelations(query).Scan(ctx, &group)
+	return
+}
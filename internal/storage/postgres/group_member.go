@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+// GroupMember -
+type GroupMember struct {
+	*postgres.Table[*storage.GroupMember]
+}
+
+// NewGroupMember -
+func NewGroupMember(db *database.Bun) *GroupMember {
+	return &GroupMember{
+		Table: postgres.NewTable[*storage.GroupMember](db),
+	}
+}
+
+// ByGroupId - returns current members of the group: the last change of each address with positive weight
+func (gm *GroupMember) ByGroupId(ctx context.Context, groupId uint64, limit, offset int) (members []storage.GroupMember, err error) {
+	query := gm.DB().NewSelect().
+		Model((*storage.GroupMember)(nil)).
+		DistinctOn("address_id").
+		Where("group_id = ?", groupId).
+		OrderExpr("address_id, height desc, id desc")
+
+	outer := gm.DB().NewSelect().
+		TableExpr("(?) as group_member", query).
+		ColumnExpr("group_member.*").
+		ColumnExpr("address.address as address__address").
+		ColumnExpr("tx.hash as tx__hash").
+		Join("left join address on address.id = group_member.address_id").
+		Join("left join tx on tx.id = group_member.tx_id").
+		Where("group_member.weight > 0").
+		OrderExpr("group_member.weight desc, group_member.address_id asc")
+
+	outer = limitScope(outer, limit)
+	if offset > 0 {
+		outer = outer.Offset(offset)
+	}
+	err = outer.Scan(ctx, &members)
+	return
+}
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
+)
+
+// GroupPolicy -
+type GroupPolicy struct {
+	*postgres.Table[*storage.GroupPolicy]
+}
+
+// NewGroupPolicy -
+func NewGroupPolicy(db *database.Bun) *GroupPolicy {
+	return &GroupPolicy{
+		Table: postgres.NewTable[*storage.GroupPolicy](db),
+	}
+}
+
+func (gp *GroupPolicy) withRelations(query *bun.SelectQuery) *bun.SelectQuery {
+	return gp.DB().NewSelect().
+		TableExpr("(?) as group_policy", query).
+		ColumnExpr("group_policy.*").
+		ColumnExpr("address.address as address__address").
+		ColumnExpr("admin.address as admin__address").
+		ColumnExpr("tx.hash as tx__hash").
+		Join("left join address on address.id = group_policy.address_id").
+		Join("left join address as admin on admin.id = group_policy.admin_id").
+		Join("left join tx on tx.id = group_policy.tx_id")
+}
+
+func (gp *GroupPolicy) ByGroupId(ctx context.Context, groupId uint64, limit, offset int) (policies []storage.GroupPolicy, err error) {
+	query := gp.DB().NewSelect().
+		Model((*storage.GroupPolicy)(nil)).
+		Where("group_id = ?", groupId).
+		Order("height asc")
+
+	query = limitScope(query, limit)
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+
+	err = gp.withRelations(query).
+		Order("group_policy.height asc").
+		Scan(ctx, &policies)
+	return
+}
+
+func (gp *GroupPolicy) ByAddressId(ctx context.Context, addressId uint64) (policy storage.GroupPolicy, err error) {
+	query := gp.DB().NewSelect().
+		Model((*storage.GroupPolicy)(nil)).
+		Where("address_id = ?", addressId)
+
+	err = gp.withRelations(query).Scan(ctx, &policy)
+	return
+}
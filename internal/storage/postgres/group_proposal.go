@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
+)
+
+// GroupProposal -
+type GroupProposal struct {
+	*postgres.Table[*storage.GroupProposal]
+}
+
+// NewGroupProposal -
+func NewGroupProposal(db *database.Bun) *GroupProposal {
+	return &GroupProposal{
+		Table: postgres.NewTable[*storage.GroupProposal](db),
+	}
+}
+
+func (gp *GroupProposal) withRelations(query *bun.SelectQuery) *bun.SelectQuery {
+	return gp.DB().NewSelect().
+		TableExpr("(?) as group_proposal", query).
+		ColumnExpr("group_proposal.*").
+		ColumnExpr("address.address as policy_address__address").
+		ColumnExpr("tx.hash as tx__hash").
+		Join("left join address on address.id = group_proposal.policy_address_id").
+		Join("left join tx on tx.id = group_proposal.tx_id")
+}
+
+func (gp *GroupProposal) ListWithFilters(ctx context.Context, fltrs storage.GroupProposalFilters) (proposals []storage.GroupProposal, err error) {
+	query := gp.DB().NewSelect().
+		Model((*storage.GroupProposal)(nil))
+
+	query = limitScope(query, fltrs.Limit)
+	if fltrs.Offset > 0 {
+		query = query.Offset(fltrs.Offset)
+	}
+	query = sortScope(query, "id", fltrs.Sort)
+
+	if fltrs.PolicyAddressId > 0 {
+		query = query.Where("policy_address_id = ?", fltrs.PolicyAddressId)
+	}
+	if fltrs.GroupId > 0 {
+		policies := gp.DB().NewSelect().
+			Model((*storage.GroupPolicy)(nil)).
+			Column("address_id").
+			Where("group_id = ?", fltrs.GroupId)
+		query = query.Where("policy_address_id IN (?)", policies)
+	}
+	if len(fltrs.Status) > 0 {
+		query = query.Where("status IN (?)", bun.In(fltrs.Status))
+	}
+
+	outer := gp.withRelations(query)
+	outer = sortScope(outer, "group_proposal.id", fltrs.Sort)
+	err = outer.Scan(ctx, &proposals)
+	return
+}
+
+func (gp *GroupProposal) ById(ctx context.Context, id uint64) (proposal storage.GroupProposal, err error) {
+	query := gp.DB().NewSelect().
+		Model((*storage.GroupProposal)(nil)).
+		Where("id = ?", id)
+
+	err = gp.withRelations(query).Scan(ctx, &proposal)
+	return
+}
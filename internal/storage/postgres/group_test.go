@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+)
+
+func (s *StorageTestSuite) TestGroupListWithFilters() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	groups, err := s.storage.Groups.ListWithFilters(ctx, storage.GroupFilters{
+		Limit:   10,
+		Sort:    sdk.SortOrderAsc,
+		AdminId: 1,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(groups, 1)
+
+	group := groups[0]
+	s.Require().EqualValues(1, group.Id)
+	s.Require().EqualValues(1, group.AdminId)
+	s.Require().EqualValues("3", group.TotalWeight.String())
+	s.Require().EqualValues(2, group.MembersCount)
+	s.Require().NotNil(group.Admin)
+	s.Require().Equal("celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8", group.Admin.Address)
+	s.Require().NotNil(group.Tx)
+	s.Require().NotEmpty(group.Tx.Hash)
+}
+
+func (s *StorageTestSuite) TestGroupById() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	group, err := s.storage.Groups.ById(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, group.Id)
+	s.Require().Equal("first group", group.Metadata)
+	s.Require().NotNil(group.Admin)
+}
+
+func (s *StorageTestSuite) TestGroupMembersByGroupId() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	members, err := s.storage.GroupMembers.ByGroupId(ctx, 1, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(members, 2)
+
+	s.Require().EqualValues(2, members[0].AddressId)
+	s.Require().EqualValues("2", members[0].Weight.String())
+	s.Require().NotNil(members[0].Address)
+	s.Require().Equal("celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60", members[0].Address.Address)
+	s.Require().EqualValues(1, members[1].AddressId)
+}
+
+func (s *StorageTestSuite) TestGroupPolicyByGroupId() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	policies, err := s.storage.GroupPolicies.ByGroupId(ctx, 1, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(policies, 1)
+
+	policy := policies[0]
+	s.Require().EqualValues(2, policy.AddressId)
+	s.Require().EqualValues(1, policy.GroupId)
+	s.Require().Equal(types.DecisionPolicyTypeThreshold, policy.DecisionPolicyType)
+	s.Require().EqualValues("2", policy.Threshold.String())
+	s.Require().EqualValues(86400, policy.VotingPeriod)
+	s.Require().NotNil(policy.Address)
+	s.Require().NotNil(policy.Admin)
+}
+
+func (s *StorageTestSuite) TestGroupPolicyByAddressId() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	policy, err := s.storage.GroupPolicies.ByAddressId(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, policy.GroupId)
+	s.Require().Equal("policy", policy.Metadata)
+}
+
+func (s *StorageTestSuite) TestGroupProposalListWithFilters() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	proposals, err := s.storage.GroupProposals.ListWithFilters(ctx, storage.GroupProposalFilters{
+		Limit:   10,
+		Sort:    sdk.SortOrderDesc,
+		GroupId: 1,
+		Status:  []types.GroupProposalStatus{types.GroupProposalStatusSubmitted},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(proposals, 1)
+
+	proposal := proposals[0]
+	s.Require().EqualValues(1, proposal.Id)
+	s.Require().EqualValues(2, proposal.PolicyAddressId)
+	s.Require().Equal([]string{"celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8"}, proposal.Proposers)
+	s.Require().EqualValues(2, proposal.VotesCount)
+	s.Require().NotNil(proposal.PolicyAddress)
+
+	proposals, err = s.storage.GroupProposals.ListWithFilters(ctx, storage.GroupProposalFilters{
+		Limit:  10,
+		Status: []types.GroupProposalStatus{types.GroupProposalStatusAccepted},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(proposals, 0)
+}
+
+func (s *StorageTestSuite) TestGroupVotesByProposalId() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	votes, err := s.storage.GroupVotes.ByProposalId(ctx, 1, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(votes, 2)
+
+	s.Require().EqualValues(2, votes[0].Id)
+	s.Require().Equal(types.VoteOptionNo, votes[0].Option)
+	s.Require().NotNil(votes[0].Voter)
+	s.Require().NotNil(votes[0].Tx)
+}
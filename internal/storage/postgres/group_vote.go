@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+// GroupVote -
+type GroupVote struct {
+	*postgres.Table[*storage.GroupVote]
+}
+
+// NewGroupVote -
+func NewGroupVote(db *database.Bun) *GroupVote {
+	return &GroupVote{
+		Table: postgres.NewTable[*storage.GroupVote](db),
+	}
+}
+
+func (gv *GroupVote) ByProposalId(ctx context.Context, proposalId uint64, limit, offset int) (votes []storage.GroupVote, err error) {
+	query := gv.DB().NewSelect().
+		Model((*storage.GroupVote)(nil)).
+		Where("proposal_id = ?", proposalId).
+		Order("id desc")
+
+	query = limitScope(query, limit)
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+
+	err = gv.DB().NewSelect().
+		TableExpr("(?) as group_vote", query).
+		ColumnExpr("group_vote.*").
+		ColumnExpr("address.address as voter__address").
+		ColumnExpr("tx.hash as tx__hash").
+		Join("left join address on address.id = group_vote.voter_id").
+		Join("left join tx on tx.id = group_vote.tx_id").
+		Order("group_vote.id desc").
+		Scan(ctx, &votes)
+	return
+}
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
+)
+
+// IbcChannel -
+type IbcChannel struct {
+	*postgres.Table[*storage.IbcChannel]
+}
+
+// NewIbcChannel -
+func NewIbcChannel(db *database.Bun) *IbcChannel {
+	return &IbcChannel{
+		Table: postgres.NewTable[*storage.IbcChannel](db),
+	}
+}
+
+func (c *IbcChannel) withConnection(query *bun.SelectQuery) *bun.SelectQuery {
+	return c.DB().NewSelect().
+		TableExpr("(?) as ibc_channel", query).
+		ColumnExpr("ibc_channel.*").
+		ColumnExpr("connection.id as connection__id, connection.client_id as connection__client_id").
+		ColumnExpr("client.id as connection__client__id, client.chain_id as connection__client__chain_id").
+		Join("left join ibc_connection as connection on connection.id = ibc_channel.connection_id").
+		Join("left join ibc_client as client on client.id = connection.client_id")
+}
+
+func (c *IbcChannel) ById(ctx context.Context, id string) (channel storage.IbcChannel, err error) {
+	query := c.DB().NewSelect().
+		Model((*storage.IbcChannel)(nil)).
+		Where("id = ?", id)
+
+	err = c.withConnection(query).Scan(ctx, &channel)
+	return
+}
+
+func (c *IbcChannel) ListWithFilters(ctx context.Context, fltrs storage.ListIbcChannelsFilters) (channels []storage.IbcChannel, err error) {
+	query := c.DB().NewSelect().
+		Model((*storage.IbcChannel)(nil))
+
+	query = limitScope(query, fltrs.Limit)
+	if fltrs.Offset > 0 {
+		query = query.Offset(fltrs.Offset)
+	}
+	query = sortScope(query, "height", fltrs.Sort)
+
+	if fltrs.ConnectionId != "" {
+		query = query.Where("connection_id = ?", fltrs.ConnectionId)
+	}
+	if fltrs.Status != "" {
+		query = query.Where("status = ?", fltrs.Status)
+	}
+
+	outer := c.withConnection(query)
+	outer = sortScope(outer, "ibc_channel.height", fltrs.Sort)
+	err = outer.Scan(ctx, &channels)
+	return
+}
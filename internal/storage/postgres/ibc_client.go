@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
+)
+
+// IbcClient -
+type IbcClient struct {
+	*postgres.Table[*storage.IbcClient]
+}
+
+// NewIbcClient -
+func NewIbcClient(db *database.Bun) *IbcClient {
+	return &IbcClient{
+		Table: postgres.NewTable[*storage.IbcClient](db),
+	}
+}
+
+func (c *IbcClient) withCreator(query *bun.SelectQuery) *bun.SelectQuery {
+	return c.DB().NewSelect().
+		TableExpr("(?) as ibc_client", query).
+		ColumnExpr("ibc_client.*").
+		ColumnExpr("address.address as creator__address").
+		Join("left join address on address.id = ibc_client.creator_id")
+}
+
+func (c *IbcClient) ById(ctx context.Context, id string) (client storage.IbcClient, err error) {
+	query := c.DB().NewSelect().
+		Model((*storage.IbcClient)(nil)).
+		Where("id = ?", id)
+
+	err = c.withCreator(query).Scan(ctx, &client)
+	return
+}
+
+func (c *IbcClient) ListWithFilters(ctx context.Context, fltrs storage.ListIbcClientsFilters) (clients []storage.IbcClient, err error) {
+	query := c.DB().NewSelect().
+		Model((*storage.IbcClient)(nil))
+
+	query = limitScope(query, fltrs.Limit)
+	if fltrs.Offset > 0 {
+		query = query.Offset(fltrs.Offset)
+	}
+	query = sortScope(query, "height", fltrs.Sort)
+
+	if fltrs.ChainId != "" {
+		query = query.Where("chain_id = ?", fltrs.ChainId)
+	}
+
+	outer := c.withCreator(query)
+	outer = sortScope(outer, "ibc_client.height", fltrs.Sort)
+	err = outer.Scan(ctx, &clients)
+	return
+}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
+)
+
+// IbcConnection -
+type IbcConnection struct {
+	*postgres.Table[*storage.IbcConnection]
+}
+
+// NewIbcConnection -
+func NewIbcConnection(db *database.Bun) *IbcConnection {
+	return &IbcConnection{
+		Table: postgres.NewTable[*storage.IbcConnection](db),
+	}
+}
+
+func (c *IbcConnection) withClient(query *bun.SelectQuery) *bun.SelectQuery {
+	return c.DB().NewSelect().
+		TableExpr("(?) as ibc_connection", query).
+		ColumnExpr("ibc_connection.*").
+		ColumnExpr("client.id as client__id, client.type as client__type, client.chain_id as client__chain_id").
+		Join("left join ibc_client as client on client.id = ibc_connection.client_id")
+}
+
+func (c *IbcConnection) ById(ctx context.Context, id string) (conn storage.IbcConnection, err error) {
+	query := c.DB().NewSelect().
+		Model((*storage.IbcConnection)(nil)).
+		Where("id = ?", id)
+
+	err = c.withClient(query).Scan(ctx, &conn)
+	return
+}
+
+func (c *IbcConnection) ListWithFilters(ctx context.Context, fltrs storage.ListIbcConnectionsFilters) (conns []storage.IbcConnection, err error) {
+	query := c.DB().NewSelect().
+		Model((*storage.IbcConnection)(nil))
+
+	query = limitScope(query, fltrs.Limit)
+	if fltrs.Offset > 0 {
+		query = query.Offset(fltrs.Offset)
+	}
+	query = sortScope(query, "height", fltrs.Sort)
+
+	if fltrs.ClientId != "" {
+		query = query.Where("client_id = ?", fltrs.ClientId)
+	}
+
+	outer := c.withClient(query)
+	outer = sortScope(outer, "ibc_connection.height", fltrs.Sort)
+	err = outer.Scan(ctx, &conns)
+	return
+}
@@ -0,0 +1,190 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	testsuite "github.com/celenium-io/celestia-indexer/internal/test_suite"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+)
+
+func (s *StorageTestSuite) TestIbcClientById() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	client, err := s.storage.IbcClients.ById(ctx, "07-tendermint-0")
+	s.Require().NoError(err)
+	s.Require().EqualValues("07-tendermint", client.Type)
+	s.Require().EqualValues("osmosis-1", client.ChainId)
+	s.Require().EqualValues(1000, client.Height)
+	s.Require().NotNil(client.Creator)
+	s.Require().EqualValues("celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8", client.Creator.Address)
+}
+
+func (s *StorageTestSuite) TestIbcClientListWithFilters() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	clients, err := s.storage.IbcClients.ListWithFilters(ctx, storage.ListIbcClientsFilters{
+		Limit:   10,
+		ChainId: "cosmoshub-4",
+	})
+	s.Require().NoError(err)
+	s.Require().Len(clients, 1)
+	s.Require().EqualValues("07-tendermint-1", clients[0].Id)
+}
+
+func (s *StorageTestSuite) TestIbcConnectionById() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	conn, err := s.storage.IbcConnections.ById(ctx, "connection-0")
+	s.Require().NoError(err)
+	s.Require().EqualValues("07-tendermint-0", conn.ClientId)
+	s.Require().EqualValues("connection-2", conn.CounterpartyConnectionId)
+	s.Require().True(conn.Opened())
+	s.Require().NotNil(conn.Client)
+	s.Require().EqualValues("osmosis-1", conn.Client.ChainId)
+}
+
+func (s *StorageTestSuite) TestIbcConnectionListWithFilters() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	conns, err := s.storage.IbcConnections.ListWithFilters(ctx, storage.ListIbcConnectionsFilters{
+		Limit:    10,
+		ClientId: "07-tendermint-1",
+	})
+	s.Require().NoError(err)
+	s.Require().Len(conns, 1)
+	s.Require().EqualValues("connection-1", conns[0].Id)
+	s.Require().False(conns[0].Opened())
+}
+
+func (s *StorageTestSuite) TestIbcChannelById() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	channel, err := s.storage.IbcChannels.ById(ctx, "channel-0")
+	s.Require().NoError(err)
+	s.Require().EqualValues("transfer", channel.PortId)
+	s.Require().EqualValues("channel-6994", channel.CounterpartyChannelId)
+	s.Require().EqualValues(types.IbcChannelStatusOpened, channel.Status)
+	s.Require().NotNil(channel.Connection)
+	s.Require().NotNil(channel.Connection.Client)
+	s.Require().EqualValues("osmosis-1", channel.Connection.Client.ChainId)
+}
+
+func (s *StorageTestSuite) TestIbcChannelListWithFilters() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	channels, err := s.storage.IbcChannels.ListWithFilters(ctx, storage.ListIbcChannelsFilters{
+		Limit:  10,
+		Status: types.IbcChannelStatusInitialization,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(channels, 1)
+	s.Require().EqualValues("channel-1", channels[0].Id)
+	s.Require().EqualValues("connection-1", channels[0].ConnectionId)
+}
+
+func (s *StorageTestSuite) TestIbcTransferById() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	transfer, err := s.storage.IbcTransfers.ById(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, transfer.Sequence)
+	s.Require().EqualValues("1000", transfer.Amount.String())
+	s.Require().EqualValues("utia", transfer.Denom)
+	s.Require().EqualValues(types.IbcTransferStatusAcknowledged, transfer.Status)
+	s.Require().NotNil(transfer.Tx)
+	s.Require().NotNil(transfer.Connection)
+	s.Require().NotNil(transfer.Connection.Client)
+	s.Require().EqualValues("osmosis-1", transfer.Connection.Client.ChainId)
+}
+
+func (s *StorageTestSuite) TestIbcTransferListWithFilters() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	transfers, err := s.storage.IbcTransfers.ListWithFilters(ctx, storage.ListIbcTransferFilters{
+		Limit:     10,
+		Sort:      sdk.SortOrderAsc,
+		ChainId:   "osmosis-1",
+		AddressId: testsuite.Ptr[uint64](2),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(transfers, 1)
+	s.Require().EqualValues(2, transfers[0].Id)
+	s.Require().EqualValues(types.IbcTransferStatusReceived, transfers[0].Status)
+
+	transfers, err = s.storage.IbcTransfers.ListWithFilters(ctx, storage.ListIbcTransferFilters{
+		Limit:  10,
+		Status: []types.IbcTransferStatus{types.IbcTransferStatusSent, types.IbcTransferStatusAcknowledged},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(transfers, 1)
+	s.Require().EqualValues(1, transfers[0].Id)
+}
+
+func (s *StorageTestSuite) TestIbcChainStats() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	stats, err := s.storage.IbcTransfers.ChainStats(ctx, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(stats, 1)
+
+	item := stats[0]
+	s.Require().EqualValues("osmosis-1", item.ChainId)
+	s.Require().EqualValues(1, item.SentCount)
+	s.Require().EqualValues(1, item.ReceivedCount)
+	s.Require().EqualValues("1000", item.Sent.String())
+	s.Require().EqualValues("500", item.Received.String())
+}
+
+func (s *StorageTestSuite) TestInterchainAccountByAddressId() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	account, err := s.storage.InterchainAccounts.ByAddressId(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, account.Id)
+	s.Require().EqualValues("osmo1mm8yykm46ec3t0dgwls70g0jvtm055wkfcwl3e", account.Owner)
+	s.Require().EqualValues("connection-0", account.ConnectionId)
+	s.Require().EqualValues("connection-2", account.ControllerConnectionId)
+	s.Require().NotNil(account.Connection)
+	s.Require().NotNil(account.Connection.Client)
+	s.Require().EqualValues("osmosis-1", account.Connection.Client.ChainId)
+
+	_, err = s.storage.InterchainAccounts.ByAddressId(ctx, 1)
+	s.Require().Error(err)
+	s.Require().True(s.storage.InterchainAccounts.IsNoRows(err))
+}
+
+func (s *StorageTestSuite) TestInterchainAccountPackets() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	packets, err := s.storage.InterchainAccounts.Packets(ctx, storage.ListInterchainAccountPacketsFilters{
+		Limit:        10,
+		Sort:         sdk.SortOrderDesc,
+		ConnectionId: "connection-0",
+		Owner:        "osmo1mm8yykm46ec3t0dgwls70g0jvtm055wkfcwl3e",
+	})
+	s.Require().NoError(err)
+	s.Require().Len(packets, 2)
+	s.Require().EqualValues(2, packets[0].Id)
+	s.Require().False(packets[0].Success)
+	s.Require().NotEmpty(packets[0].Error)
+	s.Require().EqualValues([]string{"/cosmos.bank.v1beta1.MsgSend"}, packets[0].Messages)
+	s.Require().NotNil(packets[0].Tx)
+	s.Require().NotEmpty(packets[0].Tx.Hash)
+}
@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/currency"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
+)
+
+// IbcTransfer -
+type IbcTransfer struct {
+	*postgres.Table[*storage.IbcTransfer]
+}
+
+// NewIbcTransfer -
+func NewIbcTransfer(db *database.Bun) *IbcTransfer {
+	return &IbcTransfer{
+		Table: postgres.NewTable[*storage.IbcTransfer](db),
+	}
+}
+
+func (t *IbcTransfer) withRelations(query *bun.SelectQuery) *bun.SelectQuery {
+	return t.DB().NewSelect().
+		TableExpr("(?) as ibc_transfer", query).
+		ColumnExpr("ibc_transfer.*").
+		ColumnExpr("tx.hash as tx__hash").
+		ColumnExpr("connection.id as connection__id, connection.client_id as connection__client_id").
+		ColumnExpr("client.id as connection__client__id, client.chain_id as connection__client__chain_id").
+		Join("left join tx on tx.id = ibc_transfer.tx_id").
+		Join("left join ibc_connection as connection on connection.id = ibc_transfer.connection_id").
+		Join("left join ibc_client as client on client.id = connection.client_id")
+}
+
+func (t *IbcTransfer) ById(ctx context.Context, id uint64) (transfer storage.IbcTransfer, err error) {
+	query := t.DB().NewSelect().
+		Model((*storage.IbcTransfer)(nil)).
+		Where("id = ?", id)
+
+	err = t.withRelations(query).Scan(ctx, &transfer)
+	return
+}
+
+func (t *IbcTransfer) ListWithFilters(ctx context.Context, fltrs storage.ListIbcTransferFilters) (transfers []storage.IbcTransfer, err error) {
+	query := t.DB().NewSelect().
+		Model((*storage.IbcTransfer)(nil))
+
+	query = limitScope(query, fltrs.Limit)
+	if fltrs.Offset > 0 {
+		query = query.Offset(fltrs.Offset)
+	}
+	query = sortScope(query, "id", fltrs.Sort)
+
+	if fltrs.ChannelId != "" {
+		query = query.Where("channel_id = ?", fltrs.ChannelId)
+	}
+	if fltrs.AddressId != nil {
+		query = query.WhereGroup(" AND ", func(sq *bun.SelectQuery) *bun.SelectQuery {
+			return sq.Where("sender_id = ?", *fltrs.AddressId).WhereOr("receiver_id = ?", *fltrs.AddressId)
+		})
+	}
+	if len(fltrs.Status) > 0 {
+		query = query.Where("status IN (?)", bun.In(fltrs.Status))
+	}
+	if fltrs.ChainId != "" {
+		connections := t.DB().NewSelect().
+			Model((*storage.IbcConnection)(nil)).
+			Column("ibc_connection.id").
+			Join("join ibc_client on ibc_client.id = ibc_connection.client_id").
+			Where("ibc_client.chain_id = ?", fltrs.ChainId)
+		query = query.Where("connection_id IN (?)", connections)
+	}
+
+	outer := t.withRelations(query)
+	outer = sortScope(outer, "ibc_transfer.id", fltrs.Sort)
+	err = outer.Scan(ctx, &transfers)
+	return
+}
+
+func (t *IbcTransfer) ChainStats(ctx context.Context, limit, offset int) (stats []storage.IbcChainStats, err error) {
+	query := t.DB().NewSelect().
+		TableExpr("ibc_transfer as transfer").
+		ColumnExpr("client.chain_id").
+		ColumnExpr("count(*) filter (where transfer.status <> ?) as sent_count", types.IbcTransferStatusReceived).
+		ColumnExpr("count(*) filter (where transfer.status = ?) as received_count", types.IbcTransferStatusReceived).
+		ColumnExpr("coalesce(sum(transfer.amount) filter (where transfer.status in (?) and transfer.denom = ?), 0) as sent",
+			bun.In([]types.IbcTransferStatus{types.IbcTransferStatusSent, types.IbcTransferStatusAcknowledged}), currency.Utia).
+		ColumnExpr("coalesce(sum(transfer.amount) filter (where transfer.status = ? and transfer.denom = transfer.counterparty_port || '/' || transfer.counterparty_channel_id || '/' || ?), 0) as received",
+			types.IbcTransferStatusReceived, currency.Utia).
+		Join("join ibc_connection as connection on connection.id = transfer.connection_id").
+		Join("join ibc_client as client on client.id = connection.client_id").
+		Group("client.chain_id").
+		OrderExpr("count(*) desc")
+
+	query = limitScope(query, limit)
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+
+	err = query.Scan(ctx, &stats)
+	return
+}
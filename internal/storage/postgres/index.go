@@ -128,6 +128,16 @@ func createIndices(ctx context.Context, conn *database.Bun) error {
 			return err
 		}
 
+		// TxAddress
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.TxAddress)(nil)).
+			Index("tx_address_tx_id_idx").
+			Column("tx_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+
 		// Event
 		if _, err := tx.NewCreateIndex().
 			IfNotExists().
@@ -175,6 +185,15 @@ func createIndices(ctx context.Context, conn *database.Bun) error {
 			Exec(ctx); err != nil {
 			return err
 		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Message)(nil)).
+			Index("message_parent_id_idx").
+			Column("parent_id").
+			Where("parent_id IS NOT NULL").
+			Exec(ctx); err != nil {
+			return err
+		}
 
 		// Namespace
 		if _, err := tx.NewCreateIndex().
@@ -329,6 +348,36 @@ func createIndices(ctx context.Context, conn *database.Bun) error {
 			return err
 		}
 
+		// MissedBlock
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.MissedBlock)(nil)).
+			Index("missed_block_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.MissedBlock)(nil)).
+			Index("missed_block_validator_id_idx").
+			Column("validator_id", "height").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// BlockDah
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.BlockDah)(nil)).
+			Index("block_dah_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+
 		// StakingLog
 		if _, err := tx.NewCreateIndex().
 			IfNotExists().
@@ -509,6 +558,557 @@ func createIndices(ctx context.Context, conn *database.Bun) error {
 			return err
 		}
 
+		// GrantUpdate
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.GrantUpdate)(nil)).
+			Index("grant_update_height_idx").
+			Column("height").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Proposal
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Proposal)(nil)).
+			Index("proposal_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Proposal)(nil)).
+			Index("proposal_proposer_id_idx").
+			Column("proposer_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Proposal)(nil)).
+			Index("proposal_status_idx").
+			Column("status").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Vote
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Vote)(nil)).
+			Index("vote_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Vote)(nil)).
+			Index("vote_proposal_id_idx").
+			Column("proposal_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Vote)(nil)).
+			Index("vote_voter_id_idx").
+			Column("voter_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Vote)(nil)).
+			Index("vote_validator_id_idx").
+			Column("validator_id").
+			Where("validator_id IS NOT NULL").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Deposit
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Deposit)(nil)).
+			Index("deposit_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Deposit)(nil)).
+			Index("deposit_proposal_id_idx").
+			Column("proposal_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Deposit)(nil)).
+			Index("deposit_depositor_id_idx").
+			Column("depositor_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// IbcClient
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.IbcClient)(nil)).
+			Index("ibc_client_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.IbcClient)(nil)).
+			Index("ibc_client_chain_id_idx").
+			Column("chain_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// IbcConnection
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.IbcConnection)(nil)).
+			Index("ibc_connection_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.IbcConnection)(nil)).
+			Index("ibc_connection_connection_height_idx").
+			Column("connection_height").
+			Where("connection_height IS NOT NULL").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.IbcConnection)(nil)).
+			Index("ibc_connection_client_id_idx").
+			Column("client_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// IbcChannel
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.IbcChannel)(nil)).
+			Index("ibc_channel_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.IbcChannel)(nil)).
+			Index("ibc_channel_confirmation_height_idx").
+			Column("confirmation_height").
+			Where("confirmation_height IS NOT NULL").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.IbcChannel)(nil)).
+			Index("ibc_channel_connection_id_idx").
+			Column("connection_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// IbcTransfer
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.IbcTransfer)(nil)).
+			Index("ibc_transfer_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.IbcTransfer)(nil)).
+			Index("ibc_transfer_completed_height_idx").
+			Column("completed_height").
+			Where("completed_height IS NOT NULL").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.IbcTransfer)(nil)).
+			Index("ibc_transfer_packet_idx").
+			Column("channel_id", "port", "sequence").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.IbcTransfer)(nil)).
+			Index("ibc_transfer_connection_id_idx").
+			Column("connection_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.IbcTransfer)(nil)).
+			Index("ibc_transfer_sender_id_idx").
+			Column("sender_id").
+			Where("sender_id IS NOT NULL").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.IbcTransfer)(nil)).
+			Index("ibc_transfer_receiver_id_idx").
+			Column("receiver_id").
+			Where("receiver_id IS NOT NULL").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// InterchainAccount
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.InterchainAccount)(nil)).
+			Index("interchain_account_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.InterchainAccount)(nil)).
+			Index("interchain_account_address_id_idx").
+			Column("address_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// InterchainAccountPacket
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.InterchainAccountPacket)(nil)).
+			Index("interchain_account_packet_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.InterchainAccountPacket)(nil)).
+			Index("interchain_account_packet_owner_idx").
+			Column("connection_id", "owner").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Upgrade
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Upgrade)(nil)).
+			Index("upgrade_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Upgrade)(nil)).
+			Index("upgrade_status_idx").
+			Column("status").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// BalanceHistory
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.BalanceHistory)(nil)).
+			Index("balance_history_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.BalanceHistory)(nil)).
+			Index("balance_history_address_id_idx").
+			Column("address_id", "time").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Transfer
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Transfer)(nil)).
+			Index("transfer_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Transfer)(nil)).
+			Index("transfer_sender_id_idx").
+			Column("sender_id", "time").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Transfer)(nil)).
+			Index("transfer_receiver_id_idx").
+			Column("receiver_id", "time").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Transfer)(nil)).
+			Index("transfer_denom_idx").
+			Column("denom").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// ConstantHistory
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.ConstantHistory)(nil)).
+			Index("constant_history_module_name_idx").
+			Column("module", "name", "height").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// ValidatorHistory
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.ValidatorHistory)(nil)).
+			Index("validator_history_validator_id_idx").
+			Column("validator_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.ValidatorHistory)(nil)).
+			Index("validator_history_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Slash
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Slash)(nil)).
+			Index("slash_validator_id_idx").
+			Column("validator_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Slash)(nil)).
+			Index("slash_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Slash)(nil)).
+			Index("slash_jail_id_idx").
+			Column("jail_id").
+			Where("jail_id IS NOT NULL").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Group
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Group)(nil)).
+			Index("group_info_admin_id_idx").
+			Column("admin_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Group)(nil)).
+			Index("group_info_height_idx").
+			Column("height").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// GroupUpdate
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.GroupUpdate)(nil)).
+			Index("group_update_height_idx").
+			Column("height").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// GroupMember
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.GroupMember)(nil)).
+			Index("group_member_group_id_idx").
+			Column("group_id", "address_id", "height").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.GroupMember)(nil)).
+			Index("group_member_height_idx").
+			Column("height").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// GroupPolicy
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.GroupPolicy)(nil)).
+			Index("group_policy_group_id_idx").
+			Column("group_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.GroupPolicy)(nil)).
+			Index("group_policy_height_idx").
+			Column("height").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// GroupProposal
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.GroupProposal)(nil)).
+			Index("group_proposal_policy_address_id_idx").
+			Column("policy_address_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.GroupProposal)(nil)).
+			Index("group_proposal_status_idx").
+			Column("status").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.GroupProposal)(nil)).
+			Index("group_proposal_height_idx").
+			Column("height").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.GroupProposal)(nil)).
+			Index("group_proposal_end_height_idx").
+			Column("end_height").
+			Where("end_height IS NOT NULL").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// GroupVote
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.GroupVote)(nil)).
+			Index("group_vote_proposal_id_idx").
+			Column("proposal_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.GroupVote)(nil)).
+			Index("group_vote_height_idx").
+			Column("height").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// BlobstreamAttestation
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.BlobstreamAttestation)(nil)).
+			Index("blobstream_attestation_height_idx").
+			Column("height").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.BlobstreamAttestation)(nil)).
+			Index("blobstream_attestation_range_idx").
+			Column("begin_block", "end_block").
+			Where("type = 'data_commitment'").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// BlobstreamEvmAddress
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.BlobstreamEvmAddress)(nil)).
+			Index("blobstream_evm_address_validator_id_idx").
+			Column("validator_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.BlobstreamEvmAddress)(nil)).
+			Index("blobstream_evm_address_height_idx").
+			Column("height").
+			Exec(ctx); err != nil {
+			return err
+		}
+
 		return nil
 	})
 }
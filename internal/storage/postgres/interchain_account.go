@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+// InterchainAccount -
+type InterchainAccount struct {
+	*postgres.Table[*storage.InterchainAccount]
+}
+
+// NewInterchainAccount -
+func NewInterchainAccount(db *database.Bun) *InterchainAccount {
+	return &InterchainAccount{
+		Table: postgres.NewTable[*storage.InterchainAccount](db),
+	}
+}
+
+func (ica *InterchainAccount) ByAddressId(ctx context.Context, addressId uint64) (account storage.InterchainAccount, err error) {
+	query := ica.DB().NewSelect().
+		Model((*storage.InterchainAccount)(nil)).
+		Where("address_id = ?", addressId).
+		Order("id desc").
+		Limit(1)
+
+	err = ica.DB().NewSelect().
+		TableExpr("(?) as interchain_account", query).
+		ColumnExpr("interchain_account.*").
+		ColumnExpr("connection.id as connection__id, connection.client_id as connection__client_id, connection.counterparty_connection_id as connection__counterparty_connection_id").
+		ColumnExpr("client.id as connection__client__id, client.chain_id as connection__client__chain_id").
+		Join("left join ibc_connection as connection on connection.id = interchain_account.connection_id").
+		Join("left join ibc_client as client on client.id = connection.client_id").
+		Scan(ctx, &account)
+	return
+}
+
+func (ica *InterchainAccount) Packets(ctx context.Context, fltrs storage.ListInterchainAccountPacketsFilters) (packets []storage.InterchainAccountPacket, err error) {
+	query := ica.DB().NewSelect().
+		Model((*storage.InterchainAccountPacket)(nil)).
+		Where("connection_id = ?", fltrs.ConnectionId).
+		Where("owner = ?", fltrs.Owner)
+
+	query = limitScope(query, fltrs.Limit)
+	if fltrs.Offset > 0 {
+		query = query.Offset(fltrs.Offset)
+	}
+	query = sortScope(query, "id", fltrs.Sort)
+
+	outer := ica.DB().NewSelect().
+		TableExpr("(?) as interchain_account_packet", query).
+		ColumnExpr("interchain_account_packet.*").
+		ColumnExpr("tx.hash as tx__hash").
+		Join("left join tx on tx.id = interchain_account_packet.tx_id")
+	outer = sortScope(outer, "interchain_account_packet.id", fltrs.Sort)
+	err = outer.Scan(ctx, &packets)
+	return
+}
@@ -25,8 +25,9 @@ func NewJail(db *database.Bun) *Jail {
 
 func (j *Jail) ByValidator(ctx context.Context, id uint64, limit, offset int) (jails []storage.Jail, err error) {
 	query := j.DB().NewSelect().Model(&jails).
-		Where("validator_id = ?", id).
-		Order("time desc")
+		Relation("Slash").
+		Where("jail.validator_id = ?", id).
+		Order("jail.time desc")
 
 	query = limitScope(query, limit)
 	if offset > 0 {
@@ -21,4 +21,7 @@ func (s *StorageTestSuite) TestJailByValidator() {
 	s.Require().EqualValues(1, j.ValidatorId)
 	s.Require().EqualValues("double_sign", j.Reason)
 	s.Require().EqualValues("10000", j.Burned.String())
+	s.Require().NotNil(j.Slash)
+	s.Require().EqualValues(1, j.Slash.Id)
+	s.Require().EqualValues(995, j.Slash.InfractionHeight)
 }
@@ -28,6 +28,7 @@ func NewMessage(db *database.Bun) *Message {
 func (m *Message) ByTxId(ctx context.Context, txId uint64, limit, offset int) (messages []storage.Message, err error) {
 	query := m.DB().NewSelect().Model(&messages).
 		Where("tx_id = ?", txId).
+		Where("parent_id IS NULL").
 		Order("id asc")
 
 	query = limitScope(query, limit)
@@ -39,6 +40,16 @@ func (m *Message) ByTxId(ctx context.Context, txId uint64, limit, offset int) (m
 	return
 }
 
+// InternalByTxId - returns messages executed inside MsgExec messages of the transaction
+func (m *Message) InternalByTxId(ctx context.Context, txId uint64) (messages []storage.Message, err error) {
+	err = m.DB().NewSelect().Model(&messages).
+		Where("tx_id = ?", txId).
+		Where("parent_id IS NOT NULL").
+		Order("id asc").
+		Scan(ctx)
+	return
+}
+
 func (m *Message) ListWithTx(ctx context.Context, filters storage.MessageListWithTxFilters) (msgs []storage.MessageWithTx, err error) {
 	query := m.DB().NewSelect().Model(&msgs).Offset(filters.Offset)
 	query = messagesFilter(query, filters)
@@ -56,7 +67,7 @@ func (m *Message) ByAddress(ctx context.Context, addressId uint64, filters stora
 	query = sortScope(query, "msg_id", filters.Sort)
 
 	wrapQuery := m.DB().NewSelect().TableExpr("(?) as msg_address", query).
-		ColumnExpr(`msg_address.address_id, msg_address.msg_id, msg_address.type, msg.id AS msg__id, msg.height AS msg__height, msg.time AS msg__time, msg.position AS msg__position, msg.type AS msg__type, msg.tx_id AS msg__tx_id, msg.size AS msg__size, msg.data AS msg__data`).
+		ColumnExpr(`msg_address.address_id, msg_address.msg_id, msg_address.type, msg.id AS msg__id, msg.height AS msg__height, msg.time AS msg__time, msg.position AS msg__position, msg.type AS msg__type, msg.tx_id AS msg__tx_id, msg.parent_id AS msg__parent_id, msg.size AS msg__size, msg.data AS msg__data`).
 		ColumnExpr("tx.messages_count as tx__messages_count, tx.fee as tx__fee, tx.status as tx__status, tx.hash as tx__hash, tx.message_types as tx__message_types").
 		Join("left join message as msg on msg_address.msg_id = msg.id").
 		Join("left join tx on tx.id = msg.tx_id")
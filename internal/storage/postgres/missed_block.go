@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/pkg/errors"
+)
+
+// MissedBlock -
+type MissedBlock struct {
+	*postgres.Table[*storage.MissedBlock]
+}
+
+// NewMissedBlock -
+func NewMissedBlock(db *database.Bun) *MissedBlock {
+	return &MissedBlock{
+		Table: postgres.NewTable[*storage.MissedBlock](db),
+	}
+}
+
+func (mb *MissedBlock) CountByValidator(ctx context.Context, validatorId uint64, fromHeight types.Level) (int64, error) {
+	count, err := mb.DB().NewSelect().
+		Model((*storage.MissedBlock)(nil)).
+		Where("validator_id = ?", validatorId).
+		Where("height > ?", fromHeight).
+		Count(ctx)
+	return int64(count), err
+}
+
+func (mb *MissedBlock) Series(ctx context.Context, validatorId uint64, timeframe storage.Timeframe, req storage.SeriesRequest) (items []storage.HistogramItem, err error) {
+	query := mb.DB().NewSelect().
+		ColumnExpr("count as value, time as bucket").
+		Where("validator_id = ?", validatorId).
+		Order("time desc").
+		Limit(100)
+
+	switch timeframe {
+	case storage.TimeframeHour:
+		query = query.Table(storage.ViewMissedBlocksByHour)
+	case storage.TimeframeDay:
+		query = query.Table(storage.ViewMissedBlocksByDay)
+	default:
+		return nil, errors.Errorf("invalid timeframe: %s", timeframe)
+	}
+
+	if !req.From.IsZero() {
+		query = query.Where("time >= ?", req.From)
+	}
+	if !req.To.IsZero() {
+		query = query.Where("time < ?", req.To)
+	}
+
+	err = query.Scan(ctx, &items)
+	return
+}
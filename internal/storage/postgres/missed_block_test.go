@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+)
+
+func (s *StorageTestSuite) TestMissedBlockCountByValidator() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	count, err := s.storage.MissedBlocks.CountByValidator(ctx, 2, 997)
+	s.Require().NoError(err)
+	s.Require().EqualValues(2, count)
+
+	count, err = s.storage.MissedBlocks.CountByValidator(ctx, 1, 0)
+	s.Require().NoError(err)
+	s.Require().EqualValues(0, count)
+}
+
+func (s *StorageTestSuite) TestMissedBlockSeries() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	for _, tf := range []storage.Timeframe{
+		storage.TimeframeHour,
+		storage.TimeframeDay,
+	} {
+		items, err := s.storage.MissedBlocks.Series(ctx, 2, tf, storage.SeriesRequest{})
+		s.Require().NoError(err, tf)
+		s.Require().Len(items, 1, tf)
+		s.Require().Equal("3", items[0].Value, tf)
+	}
+
+	_, err := s.storage.MissedBlocks.Series(ctx, 2, storage.TimeframeMonth, storage.SeriesRequest{})
+	s.Require().Error(err)
+}
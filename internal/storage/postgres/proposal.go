@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
+)
+
+// Proposal -
+type Proposal struct {
+	*postgres.Table[*storage.Proposal]
+}
+
+// NewProposal -
+func NewProposal(db *database.Bun) *Proposal {
+	return &Proposal{
+		Table: postgres.NewTable[*storage.Proposal](db),
+	}
+}
+
+func (p *Proposal) ListWithFilters(ctx context.Context, filters storage.ListProposalFilters) (proposals []storage.Proposal, err error) {
+	query := p.DB().NewSelect().
+		Model((*storage.Proposal)(nil))
+
+	query = limitScope(query, filters.Limit)
+	if filters.Offset > 0 {
+		query = query.Offset(filters.Offset)
+	}
+	query = sortScope(query, "id", filters.Sort)
+
+	if filters.ProposerId > 0 {
+		query = query.Where("proposer_id = ?", filters.ProposerId)
+	}
+	if len(filters.Status) > 0 {
+		query = query.Where("status IN (?)", bun.In(filters.Status))
+	}
+	if len(filters.Type) > 0 {
+		query = query.Where("type IN (?)", bun.In(filters.Type))
+	}
+
+	outer := p.DB().NewSelect().
+		TableExpr("(?) as proposal", query).
+		ColumnExpr("proposal.*").
+		ColumnExpr("address.address as proposer__address").
+		Join("left join address on address.id = proposal.proposer_id")
+	outer = sortScope(outer, "proposal.id", filters.Sort)
+
+	err = outer.Scan(ctx, &proposals)
+	return
+}
+
+func (p *Proposal) ById(ctx context.Context, id uint64) (proposal storage.Proposal, err error) {
+	query := p.DB().NewSelect().
+		Model((*storage.Proposal)(nil)).
+		Where("id = ?", id)
+
+	err = p.DB().NewSelect().
+		TableExpr("(?) as proposal", query).
+		ColumnExpr("proposal.*").
+		ColumnExpr("address.address as proposer__address").
+		Join("left join address on address.id = proposal.proposer_id").
+		Scan(ctx, &proposal)
+	return
+}
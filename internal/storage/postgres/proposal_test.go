@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+)
+
+func (s *StorageTestSuite) TestProposalListWithFilters() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	proposals, err := s.storage.Proposals.ListWithFilters(ctx, storage.ListProposalFilters{
+		Limit: 10,
+		Sort:  sdk.SortOrderAsc,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(proposals, 2)
+
+	proposal := proposals[0]
+	s.Require().EqualValues(1, proposal.Id)
+	s.Require().EqualValues(types.ProposalStatusActive, proposal.Status)
+	s.Require().EqualValues(types.ProposalTypeText, proposal.Type)
+	s.Require().EqualValues(2, proposal.VotesCount)
+	s.Require().NotNil(proposal.Proposer)
+	s.Require().EqualValues("celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8", proposal.Proposer.Address)
+}
+
+func (s *StorageTestSuite) TestProposalListWithFiltersByStatus() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	proposals, err := s.storage.Proposals.ListWithFilters(ctx, storage.ListProposalFilters{
+		Limit:      10,
+		ProposerId: 2,
+		Status:     []types.ProposalStatus{types.ProposalStatusInactive},
+		Type:       []types.ProposalType{types.ProposalTypeParamChanged},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(proposals, 1)
+	s.Require().EqualValues(2, proposals[0].Id)
+	s.Require().NotEmpty(proposals[0].Changes)
+}
+
+func (s *StorageTestSuite) TestVoteByProposalId() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	votes, err := s.storage.Votes.ByProposalId(ctx, 1, storage.VoteFilters{
+		Limit: 10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(votes, 2)
+
+	vote := votes[0]
+	s.Require().EqualValues(2, vote.Id)
+	s.Require().EqualValues(types.VoteOptionNo, vote.Option)
+	s.Require().NotNil(vote.Voter)
+	s.Require().EqualValues("celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60", vote.Voter.Address)
+	s.Require().NotNil(vote.Validator)
+	s.Require().EqualValues("Conqueror", vote.Validator.Moniker)
+}
+
+func (s *StorageTestSuite) TestVoteByProposalIdWithFilters() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	votes, err := s.storage.Votes.ByProposalId(ctx, 1, storage.VoteFilters{
+		Limit:     10,
+		Option:    []types.VoteOption{types.VoteOptionYes},
+		VoterType: storage.VoterTypeDelegator,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(votes, 1)
+	s.Require().EqualValues(1, votes[0].Id)
+}
+
+func (s *StorageTestSuite) TestVoteByValidatorId() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	votes, err := s.storage.Votes.ByValidatorId(ctx, 1, storage.VoteFilters{
+		Limit: 10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(votes, 1)
+	s.Require().EqualValues(2, votes[0].Id)
+}
+
+func (s *StorageTestSuite) TestDepositByProposalId() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	deposits, err := s.storage.Deposits.ByProposalId(ctx, 1, 10, 0, sdk.SortOrderDesc)
+	s.Require().NoError(err)
+	s.Require().Len(deposits, 1)
+
+	deposit := deposits[0]
+	s.Require().EqualValues(1, deposit.Id)
+	s.Require().EqualValues("10000000000", deposit.Amount.String())
+	s.Require().NotNil(deposit.Depositor)
+	s.Require().EqualValues("celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8", deposit.Depositor.Address)
+}
+
+func (s *StorageTestSuite) TestDepositByDepositorId() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	deposits, err := s.storage.Deposits.ByDepositorId(ctx, 2, 10, 0, sdk.SortOrderDesc)
+	s.Require().NoError(err)
+	s.Require().Len(deposits, 1)
+	s.Require().EqualValues(2, deposits[0].ProposalId)
+}
+
+func (s *StorageTestSuite) TestProposalById() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	proposal, err := s.storage.Proposals.ById(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().EqualValues(2, proposal.Id)
+	s.Require().EqualValues("Param changed", proposal.Title)
+	s.Require().NotNil(proposal.Proposer)
+	s.Require().EqualValues("celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60", proposal.Proposer.Address)
+}
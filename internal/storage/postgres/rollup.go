@@ -262,3 +262,15 @@ func (r *Rollup) Distribution(ctx context.Context, rollupId uint64, series, grou
 		Scan(ctx, &items)
 	return
 }
+
+func (r *Rollup) Stacks(ctx context.Context) (stacks []storage.RollupStack, err error) {
+	err = r.DB().NewSelect().
+		TableExpr("rollup_provider as rp").
+		ColumnExpr("rollup.stack, address.address, coalesce(namespace.version, 0) as version, namespace.namespace_id").
+		Join("inner join rollup on rollup.id = rp.rollup_id").
+		Join("inner join address on address.id = rp.address_id").
+		Join("left join namespace on namespace.id = rp.namespace_id").
+		Where("rollup.stack != ''").
+		Scan(ctx, &stacks)
+	return
+}
@@ -109,6 +109,29 @@ func (s *StorageTestSuite) TestRollupsByNamespace() {
 	s.Require().NotEmpty(rollup.Name)
 }
 
+func (s *StorageTestSuite) TestRollupStacks() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	stacks, err := s.storage.Rollup.Stacks(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(stacks, 3)
+
+	for _, stack := range stacks {
+		switch stack.Stack {
+		case "op_stack":
+			s.Require().Equal("celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8", stack.Address)
+			s.Require().Len(stack.NamespaceId, 18)
+		case "rollkit":
+			s.Require().Equal("celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60", stack.Address)
+			s.Require().Nil(stack.NamespaceId)
+			s.Require().Zero(stack.Version)
+		default:
+			s.Fail("unexpected stack", stack.Stack)
+		}
+	}
+}
+
 func (s *StorageTestSuite) TestRollupDistribution() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
@@ -64,7 +64,7 @@ func txFilter(query *bun.SelectQuery, fltrs storage.TxFilter) *bun.SelectQuery {
 		query = query.Where("time < ?", fltrs.TimeTo)
 	}
 	if fltrs.WithMessages {
-		query = query.Relation("Messages")
+		query = query.Relation("Messages", topLevelMessages)
 	}
 	return query
 }
@@ -129,3 +129,27 @@ func blobLogSort(query *bun.SelectQuery, fltrs storage.BlobLogFilters) *bun.Sele
 	}
 	return query
 }
+
+func voteFilters(query *bun.SelectQuery, fltrs storage.VoteFilters) *bun.SelectQuery {
+	query = limitScope(query, fltrs.Limit)
+	if fltrs.Offset > 0 {
+		query = query.Offset(fltrs.Offset)
+	}
+	query = query.Order("id desc")
+
+	if len(fltrs.Option) > 0 {
+		query = query.Where("option IN (?)", bun.In(fltrs.Option))
+	}
+	switch fltrs.VoterType {
+	case storage.VoterTypeValidator:
+		query = query.Where("validator_id IS NOT NULL")
+	case storage.VoterTypeDelegator:
+		query = query.Where("validator_id IS NULL")
+	}
+	return query
+}
+
+// topLevelMessages - filters out messages executed inside MsgExec. They are linked with parent message.
+func topLevelMessages(q *bun.SelectQuery) *bun.SelectQuery {
+	return q.Where("parent_id IS NULL")
+}
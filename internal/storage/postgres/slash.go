@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+// Slash -
+type Slash struct {
+	*postgres.Table[*storage.Slash]
+}
+
+// NewSlash -
+func NewSlash(db *database.Bun) *Slash {
+	return &Slash{
+		Table: postgres.NewTable[*storage.Slash](db),
+	}
+}
+
+func (s *Slash) ByValidator(ctx context.Context, id uint64, limit, offset int) (slashes []storage.Slash, err error) {
+	query := s.DB().NewSelect().
+		Model((*storage.Slash)(nil)).
+		Where("validator_id = ?", id).
+		Order("time desc")
+
+	query = limitScope(query, limit)
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+
+	err = s.DB().NewSelect().
+		TableExpr("(?) as slash", query).
+		ColumnExpr("slash.*").
+		ColumnExpr("tx.hash as tx__hash").
+		Join("left join tx on tx.id = slash.tx_id").
+		Order("slash.time desc").
+		Scan(ctx, &slashes)
+	return
+}
@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+)
+
+func (s *StorageTestSuite) TestSlashByValidator() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	slashes, err := s.storage.Slashes.ByValidator(ctx, 1, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(slashes, 1)
+
+	slash := slashes[0]
+	s.Require().EqualValues(1, slash.Id)
+	s.Require().EqualValues(1000, slash.Height)
+	s.Require().EqualValues(995, slash.InfractionHeight)
+	s.Require().EqualValues("double_sign", slash.Reason)
+	s.Require().NotNil(slash.JailId)
+	s.Require().EqualValues(1, *slash.JailId)
+	s.Require().Nil(slash.TxId)
+	s.Require().EqualValues("0.02", slash.Fraction.String())
+	s.Require().EqualValues("100", slash.Amount.String())
+}
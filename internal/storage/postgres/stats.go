@@ -263,6 +263,10 @@ func (s Stats) CumulativeSeries(ctx context.Context, timeframe storage.Timeframe
 }
 
 func (s Stats) StakingSeries(ctx context.Context, timeframe storage.Timeframe, name string, validatorId uint64, req storage.SeriesRequest) (response []storage.SeriesItem, err error) {
+	if name == storage.SeriesCommission {
+		return s.commissionSeries(ctx, timeframe, validatorId, req)
+	}
+
 	var view string
 	switch timeframe {
 	case storage.TimeframeHour:
@@ -298,3 +302,37 @@ func (s Stats) StakingSeries(ctx context.Context, timeframe storage.Timeframe, n
 	err = query.Limit(100).Scan(ctx, &response)
 	return
 }
+
+// commissionSeries - returns validator commission rate at the end of each bucket in which commission was changed
+func (s Stats) commissionSeries(ctx context.Context, timeframe storage.Timeframe, validatorId uint64, req storage.SeriesRequest) (response []storage.SeriesItem, err error) {
+	var interval string
+	switch timeframe {
+	case storage.TimeframeHour:
+		interval = "1 hour"
+	case storage.TimeframeDay:
+		interval = "1 day"
+	case storage.TimeframeMonth:
+		interval = "1 month"
+	default:
+		return nil, errors.Errorf("unexpected timeframe %s", timeframe)
+	}
+
+	query := s.db.DB().NewSelect().
+		Model((*storage.ValidatorHistory)(nil)).
+		ColumnExpr("time_bucket(?::interval, time) as ts", interval).
+		ColumnExpr("last(rate, id) as value").
+		Where("validator_id = ?", validatorId).
+		Group("ts").
+		Order("ts desc").
+		Limit(100)
+
+	if !req.From.IsZero() {
+		query = query.Where("time >= ?", req.From)
+	}
+	if !req.To.IsZero() {
+		query = query.Where("time < ?", req.To)
+	}
+
+	err = query.Scan(ctx, &response)
+	return
+}
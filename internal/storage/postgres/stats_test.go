@@ -286,6 +286,16 @@ func (s *StatsTestSuite) TestNamespaceSeries() {
 	s.Require().Len(items, 1)
 }
 
+func (s *StatsTestSuite) TestStakingCommissionSeries() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	items, err := s.storage.Stats.StakingSeries(ctx, storage.TimeframeHour, storage.SeriesCommission, 1, storage.SeriesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(items, 1)
+	s.Require().Equal("0.07", items[0].Value)
+}
+
 func TestSuiteStats_Run(t *testing.T) {
 	suite.Run(t, new(StatsTestSuite))
 }
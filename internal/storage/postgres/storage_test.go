@@ -283,6 +283,26 @@ func (s *StorageTestSuite) TestConstantByModule() {
 	s.Require().EqualValues("10", c[1].Value)
 }
 
+func (s *StorageTestSuite) TestConstantHistoryByHeight() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	c, err := s.storage.ConstantHistory.ByHeight(ctx, 999)
+	s.Require().NoError(err)
+	s.Require().Len(c, 2)
+
+	s.Require().EqualValues("max_memo_characters", c[0].Name)
+	s.Require().EqualValues("256", c[0].Value)
+	s.Require().EqualValues("gas_per_blob_byte", c[1].Name)
+	s.Require().EqualValues("16", c[1].Value)
+
+	c, err = s.storage.ConstantHistory.ByHeight(ctx, 1000)
+	s.Require().NoError(err)
+	s.Require().Len(c, 2)
+	s.Require().EqualValues("gas_per_blob_byte", c[1].Name)
+	s.Require().EqualValues("8", c[1].Value)
+}
+
 func (s *StorageTestSuite) TestDenomMetadata() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
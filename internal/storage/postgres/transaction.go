@@ -14,6 +14,7 @@ import (
 	"github.com/vmihailenco/msgpack/v5"
 
 	models "github.com/celenium-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage"
 )
 
@@ -31,7 +32,19 @@ func (tx Transaction) SaveConstants(ctx context.Context, constants ...models.Con
 		return nil
 	}
 
-	_, err := tx.Tx().NewInsert().Model(&constants).Exec(ctx)
+	_, err := tx.Tx().NewInsert().Model(&constants).
+		On("CONFLICT (module, name) DO UPDATE").
+		Set("value = EXCLUDED.value").
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveConstantHistory(ctx context.Context, history ...models.ConstantHistory) error {
+	if len(history) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewInsert().Model(&history).Exec(ctx)
 	return err
 }
 
@@ -139,10 +152,43 @@ func (tx Transaction) SaveBalances(ctx context.Context, balances ...models.Balan
 		Set("spendable = EXCLUDED.spendable + balance.spendable").
 		Set("delegated = EXCLUDED.delegated + balance.delegated").
 		Set("unbonding = EXCLUDED.unbonding + balance.unbonding").
+		Returning("spendable, delegated, unbonding").
 		Exec(ctx)
 	return err
 }
 
+func (tx Transaction) SaveBalanceHistory(ctx context.Context, history ...models.BalanceHistory) error {
+	if len(history) == 0 {
+		return nil
+	}
+	_, err := tx.Tx().NewInsert().Model(&history).Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveTransfers(ctx context.Context, transfers ...*models.Transfer) error {
+	if len(transfers) == 0 {
+		return nil
+	}
+	_, err := tx.Tx().NewInsert().Model(&transfers).Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveBlobstreamAttestations(ctx context.Context, attestations ...*models.BlobstreamAttestation) error {
+	if len(attestations) == 0 {
+		return nil
+	}
+	_, err := tx.Tx().NewInsert().Model(&attestations).Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveBlobstreamEvmAddresses(ctx context.Context, addresses ...*models.BlobstreamEvmAddress) error {
+	if len(addresses) == 0 {
+		return nil
+	}
+	_, err := tx.Tx().NewInsert().Model(&addresses).Exec(ctx)
+	return err
+}
+
 func (tx Transaction) SaveEvents(ctx context.Context, events ...models.Event) error {
 	switch {
 	case len(events) == 0:
@@ -197,6 +243,15 @@ func (tx Transaction) SaveSigners(ctx context.Context, addresses ...models.Signe
 	return err
 }
 
+func (tx Transaction) SaveTxAddresses(ctx context.Context, addresses ...models.TxAddress) error {
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewInsert().Model(&addresses).Exec(ctx)
+	return err
+}
+
 func (tx Transaction) SaveBlobLogs(ctx context.Context, logs ...models.BlobLog) error {
 	if len(logs) == 0 {
 		return nil
@@ -232,6 +287,22 @@ func (tx Transaction) SaveBlockSignatures(ctx context.Context, signs ...models.B
 	return err
 }
 
+func (tx Transaction) SaveMissedBlocks(ctx context.Context, blocks ...models.MissedBlock) error {
+	if len(blocks) == 0 {
+		return nil
+	}
+	_, err := tx.Tx().NewInsert().Model(&blocks).Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveBlockDah(ctx context.Context, dah *models.BlockDah) error {
+	if dah == nil {
+		return nil
+	}
+	_, err := tx.Tx().NewInsert().Model(dah).Exec(ctx)
+	return err
+}
+
 func (tx Transaction) SaveVestingAccounts(ctx context.Context, accs ...*models.VestingAccount) error {
 	if len(accs) == 0 {
 		return nil
@@ -255,16 +326,109 @@ func (tx Transaction) SaveGrants(ctx context.Context, grants ...models.Grant) er
 		return nil
 	}
 
+	if err := tx.saveGrantUpdates(ctx, grants...); err != nil {
+		return err
+	}
+
 	_, err := tx.Tx().NewInsert().
 		Model(&grants).
-		Column("height", "time", "granter_id", "grantee_id", "authorization", "expiration", "revoked", "revoke_height", "params").
+		Column("height", "time", "granter_id", "grantee_id", "authorization", "expiration", "revoked", "revoke_height", "params", "spend_limit").
 		On("CONFLICT ON CONSTRAINT grant_key DO UPDATE").
 		Set("revoked = EXCLUDED.revoked").
 		Set("revoke_height = EXCLUDED.revoke_height").
+		// new grant replaces the previous one, revocation keeps its values
+		Set(`height = CASE WHEN EXCLUDED.revoked THEN "grant".height ELSE EXCLUDED.height END`).
+		Set(`time = CASE WHEN EXCLUDED.revoked THEN "grant".time ELSE EXCLUDED.time END`).
+		Set(`expiration = CASE WHEN EXCLUDED.revoked THEN "grant".expiration ELSE EXCLUDED.expiration END`).
+		Set(`params = CASE WHEN EXCLUDED.revoked THEN "grant".params ELSE EXCLUDED.params END`).
+		Set(`spend_limit = CASE WHEN EXCLUDED.revoked THEN "grant".spend_limit ELSE EXCLUDED.spend_limit END`).
+		Set(`spent = CASE WHEN EXCLUDED.revoked THEN "grant".spent ELSE 0 END`).
 		Exec(ctx)
 	return err
 }
 
+// saveGrantUpdates - logs values of existing grants which are granted again in the block to restore them on rollback
+func (tx Transaction) saveGrantUpdates(ctx context.Context, grants ...models.Grant) error {
+	regrants := make([]models.Grant, 0)
+	for i := range grants {
+		if !grants[i].Revoked {
+			regrants = append(regrants, grants[i])
+		}
+	}
+	if len(regrants) == 0 {
+		return nil
+	}
+
+	var existing []models.Grant
+	if err := tx.Tx().NewSelect().
+		Model(&existing).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			for i := range regrants {
+				q.WhereGroup(" OR ", func(sq *bun.SelectQuery) *bun.SelectQuery {
+					return sq.
+						Where("granter_id = ?", regrants[i].GranterId).
+						Where("grantee_id = ?", regrants[i].GranteeId).
+						Where("authorization = ?", regrants[i].Authorization)
+				})
+			}
+			return q
+		}).
+		Scan(ctx); err != nil {
+		return err
+	}
+
+	height := regrants[0].Height
+	updates := make([]models.GrantUpdate, 0, len(existing))
+	for i := range existing {
+		// grants created in the same block are removed on rollback
+		if existing[i].Height >= height {
+			continue
+		}
+		updates = append(updates, models.GrantUpdate{
+			Height:       height,
+			GrantId:      existing[i].Id,
+			GrantHeight:  existing[i].Height,
+			Time:         existing[i].Time,
+			Expiration:   existing[i].Expiration,
+			Revoked:      existing[i].Revoked,
+			RevokeHeight: existing[i].RevokeHeight,
+			Params:       existing[i].Params,
+			SpendLimit:   existing[i].SpendLimit,
+			Spent:        existing[i].Spent,
+		})
+	}
+	if len(updates) == 0 {
+		return nil
+	}
+	_, err := tx.Tx().NewInsert().Model(&updates).Exec(ctx)
+	return err
+}
+
+// SpendFeeGrants - decreases spend limits of fee allowances by the value of `Spent` field. Negative value restores spent fee.
+// `Height` field is the block height of the spend. Allowances revoked before that block are skipped.
+func (tx Transaction) SpendFeeGrants(ctx context.Context, grants ...models.Grant) error {
+	for i := range grants {
+		if grants[i].Spent.IsZero() {
+			continue
+		}
+		if _, err := tx.Tx().NewUpdate().
+			Model((*models.Grant)(nil)).
+			Set("spent = spent + ?", grants[i].Spent).
+			Set("spend_limit = spend_limit - ?", grants[i].Spent).
+			Where("granter_id = ?", grants[i].GranterId).
+			Where("grantee_id = ?", grants[i].GranteeId).
+			Where("authorization = 'fee'").
+			WhereGroup(" AND ", func(q *bun.UpdateQuery) *bun.UpdateQuery {
+				// fee is spent before revocation if the grant is revoked in the same block
+				return q.Where("revoked = false").WhereOr("revoke_height = ?", grants[i].Height)
+			}).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type addedValidator struct {
 	bun.BaseModel `bun:"validator"`
 	*models.Validator
@@ -285,9 +449,14 @@ func (tx Transaction) SaveValidators(ctx context.Context, validators ...*models.
 		query := tx.Tx().NewInsert().Model(&model).
 			Column("id", "delegator", "address", "cons_address", "moniker", "website", "identity", "contacts", "details", "rate", "max_rate", "max_change_rate", "min_self_delegation", "stake", "jailed", "commissions", "rewards", "height").
 			On("CONFLICT ON CONSTRAINT address_validator DO UPDATE").
-			Set("rate = EXCLUDED.rate").
-			Set("min_self_delegation = EXCLUDED.min_self_delegation")
+			Set("address = EXCLUDED.address") // no-op update to return id of existing validator
 
+		if validators[i].RateChanged {
+			query.Set("rate = EXCLUDED.rate")
+		}
+		if validators[i].MinSelfDelegationChanged {
+			query.Set("min_self_delegation = EXCLUDED.min_self_delegation")
+		}
 		if !validators[i].Stake.IsZero() {
 			query.Set("stake = added_validator.stake + EXCLUDED.stake")
 		}
@@ -327,6 +496,44 @@ func (tx Transaction) SaveValidators(ctx context.Context, validators ...*models.
 	return count, nil
 }
 
+func (tx Transaction) SaveValidatorHistory(ctx context.Context, height types.Level, t time.Time, validatorIds ...uint64) error {
+	if len(validatorIds) == 0 {
+		return nil
+	}
+
+	var validators []models.Validator
+	if err := tx.Tx().NewSelect().
+		Model(&validators).
+		Where("id IN (?)", bun.In(validatorIds)).
+		Order("id asc").
+		Scan(ctx); err != nil {
+		return err
+	}
+	if len(validators) == 0 {
+		return nil
+	}
+
+	history := make([]models.ValidatorHistory, len(validators))
+	for i := range validators {
+		history[i] = models.ValidatorHistory{
+			Height:            height,
+			Time:              t,
+			ValidatorId:       validators[i].Id,
+			Moniker:           validators[i].Moniker,
+			Website:           validators[i].Website,
+			Identity:          validators[i].Identity,
+			Contacts:          validators[i].Contacts,
+			Details:           validators[i].Details,
+			Rate:              validators[i].Rate,
+			MaxRate:           validators[i].MaxRate,
+			MaxChangeRate:     validators[i].MaxChangeRate,
+			MinSelfDelegation: validators[i].MinSelfDelegation,
+		}
+	}
+	_, err := tx.Tx().NewInsert().Model(&history).Exec(ctx)
+	return err
+}
+
 func (tx Transaction) SaveUndelegations(ctx context.Context, undelegations ...models.Undelegation) error {
 	if len(undelegations) == 0 {
 		return nil
@@ -363,31 +570,517 @@ func (tx Transaction) SaveDelegations(ctx context.Context, delegations ...models
 	return err
 }
 
-func (tx Transaction) SaveJails(ctx context.Context, jails ...models.Jail) error {
-	if len(jails) == 0 {
+func (tx Transaction) SaveJails(ctx context.Context, jails ...models.Jail) error {
+	if len(jails) == 0 {
+		return nil
+	}
+	_, err := tx.Tx().NewInsert().Model(&jails).Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveSlashes(ctx context.Context, slashes ...*models.Slash) error {
+	if len(slashes) == 0 {
+		return nil
+	}
+	_, err := tx.Tx().NewInsert().Model(&slashes).Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveGroups(ctx context.Context, groups ...*models.Group) error {
+	if len(groups) == 0 {
+		return nil
+	}
+
+	if err := tx.saveGroupUpdates(ctx, groups...); err != nil {
+		return err
+	}
+
+	for i := range groups {
+		query := tx.Tx().NewInsert().Model(groups[i]).
+			Column("id", "height", "time", "tx_id", "admin_id", "metadata", "total_weight", "members_count")
+
+		updated := false
+		if groups[i].AdminId > 0 {
+			query = query.Set("admin_id = EXCLUDED.admin_id")
+			updated = true
+		}
+		if groups[i].Metadata != models.DoNotModify {
+			query = query.Set("metadata = EXCLUDED.metadata")
+			updated = true
+		}
+
+		if updated {
+			query = query.On("CONFLICT (id) DO UPDATE")
+		} else {
+			query = query.On("CONFLICT (id) DO NOTHING")
+		}
+
+		if _, err := query.Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (tx Transaction) SaveGroupMembers(ctx context.Context, members ...*models.GroupMember) error {
+	if len(members) == 0 {
+		return nil
+	}
+	_, err := tx.Tx().NewInsert().Model(&members).Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveGroupPolicies(ctx context.Context, policies ...*models.GroupPolicy) error {
+	if len(policies) == 0 {
+		return nil
+	}
+
+	if err := tx.saveGroupPolicyUpdates(ctx, policies...); err != nil {
+		return err
+	}
+
+	for i := range policies {
+		query := tx.Tx().NewInsert().Model(policies[i]).
+			Column("address_id", "group_id", "height", "time", "tx_id", "admin_id", "metadata", "decision_policy_type", "threshold", "voting_period", "min_execution_period")
+
+		updated := false
+		if policies[i].AdminId > 0 {
+			query = query.Set("admin_id = EXCLUDED.admin_id")
+			updated = true
+		}
+		if policies[i].Metadata != models.DoNotModify {
+			query = query.Set("metadata = EXCLUDED.metadata")
+			updated = true
+		}
+		if policies[i].DecisionPolicyType != "" {
+			query = query.
+				Set("decision_policy_type = EXCLUDED.decision_policy_type").
+				Set("threshold = EXCLUDED.threshold").
+				Set("voting_period = EXCLUDED.voting_period").
+				Set("min_execution_period = EXCLUDED.min_execution_period")
+			updated = true
+		}
+
+		if updated {
+			query = query.On("CONFLICT (address_id) DO UPDATE")
+		} else {
+			query = query.On("CONFLICT (address_id) DO NOTHING")
+		}
+
+		if _, err := query.Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// saveGroupUpdates - logs values of existing groups which are changed in the block to restore them on rollback
+func (tx Transaction) saveGroupUpdates(ctx context.Context, groups ...*models.Group) error {
+	heights := make(map[uint64]types.Level)
+	for i := range groups {
+		if groups[i].AdminId > 0 || groups[i].Metadata != models.DoNotModify {
+			heights[groups[i].Id] = groups[i].Height
+		}
+	}
+	if len(heights) == 0 {
+		return nil
+	}
+
+	ids := make([]uint64, 0, len(heights))
+	for id := range heights {
+		ids = append(ids, id)
+	}
+
+	var existing []models.Group
+	if err := tx.Tx().NewSelect().
+		Model(&existing).
+		Where("id IN (?)", bun.In(ids)).
+		Scan(ctx); err != nil {
+		return err
+	}
+
+	updates := make([]models.GroupUpdate, 0, len(existing))
+	for i := range existing {
+		// groups created in the same block are removed on rollback
+		if existing[i].Height >= heights[existing[i].Id] {
+			continue
+		}
+		updates = append(updates, models.GroupUpdate{
+			Height:   heights[existing[i].Id],
+			GroupId:  existing[i].Id,
+			AdminId:  existing[i].AdminId,
+			Metadata: existing[i].Metadata,
+		})
+	}
+	if len(updates) == 0 {
+		return nil
+	}
+	_, err := tx.Tx().NewInsert().Model(&updates).Exec(ctx)
+	return err
+}
+
+// saveGroupPolicyUpdates - logs values of existing group policies which are changed in the block to restore them on rollback
+func (tx Transaction) saveGroupPolicyUpdates(ctx context.Context, policies ...*models.GroupPolicy) error {
+	heights := make(map[uint64]types.Level)
+	for i := range policies {
+		if policies[i].AdminId > 0 || policies[i].Metadata != models.DoNotModify || policies[i].DecisionPolicyType != "" {
+			heights[policies[i].AddressId] = policies[i].Height
+		}
+	}
+	if len(heights) == 0 {
+		return nil
+	}
+
+	ids := make([]uint64, 0, len(heights))
+	for id := range heights {
+		ids = append(ids, id)
+	}
+
+	var existing []models.GroupPolicy
+	if err := tx.Tx().NewSelect().
+		Model(&existing).
+		Where("address_id IN (?)", bun.In(ids)).
+		Scan(ctx); err != nil {
+		return err
+	}
+
+	updates := make([]models.GroupUpdate, 0, len(existing))
+	for i := range existing {
+		// group policies created in the same block are removed on rollback
+		if existing[i].Height >= heights[existing[i].AddressId] {
+			continue
+		}
+		updates = append(updates, models.GroupUpdate{
+			Height:             heights[existing[i].AddressId],
+			PolicyAddressId:    existing[i].AddressId,
+			AdminId:            existing[i].AdminId,
+			Metadata:           existing[i].Metadata,
+			DecisionPolicyType: existing[i].DecisionPolicyType,
+			Threshold:          existing[i].Threshold,
+			VotingPeriod:       existing[i].VotingPeriod,
+			MinExecutionPeriod: existing[i].MinExecutionPeriod,
+		})
+	}
+	if len(updates) == 0 {
+		return nil
+	}
+	_, err := tx.Tx().NewInsert().Model(&updates).Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveGroupProposals(ctx context.Context, proposals ...*models.GroupProposal) error {
+	if len(proposals) == 0 {
+		return nil
+	}
+
+	for i := range proposals {
+		query := tx.Tx().NewInsert().Model(proposals[i]).
+			Column("id", "height", "time", "tx_id", "policy_address_id", "proposers", "metadata", "messages", "status", "executor_result", "end_height", "end_time", "votes_count", "yes", "no", "no_with_veto", "abstain").
+			On("CONFLICT (id) DO UPDATE").
+			Set("votes_count = group_proposal.votes_count + EXCLUDED.votes_count").
+			Set("yes = group_proposal.yes + EXCLUDED.yes").
+			Set("no = group_proposal.no + EXCLUDED.no").
+			Set("no_with_veto = group_proposal.no_with_veto + EXCLUDED.no_with_veto").
+			Set("abstain = group_proposal.abstain + EXCLUDED.abstain")
+
+		if proposals[i].Status != "" {
+			query.Set("status = EXCLUDED.status")
+		}
+		if proposals[i].ExecutorResult != "" {
+			query.Set("executor_result = EXCLUDED.executor_result")
+		}
+		if proposals[i].EndHeight != nil {
+			query.Set("end_height = EXCLUDED.end_height")
+			query.Set("end_time = EXCLUDED.end_time")
+		}
+
+		if _, err := query.Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (tx Transaction) SaveGroupVotes(ctx context.Context, votes ...*models.GroupVote) error {
+	if len(votes) == 0 {
+		return nil
+	}
+	_, err := tx.Tx().NewInsert().Model(&votes).Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveInterchainAccounts(ctx context.Context, accounts ...*models.InterchainAccount) error {
+	if len(accounts) == 0 {
+		return nil
+	}
+	_, err := tx.Tx().NewInsert().Model(&accounts).
+		On("CONFLICT (owner, connection_id) DO NOTHING").
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveInterchainAccountPackets(ctx context.Context, packets ...*models.InterchainAccountPacket) error {
+	if len(packets) == 0 {
+		return nil
+	}
+	_, err := tx.Tx().NewInsert().Model(&packets).Exec(ctx)
+	return err
+}
+
+// UpdateGroupWeights - recalculates total weight and members count of groups by the last changes of their members
+func (tx Transaction) UpdateGroupWeights(ctx context.Context, groupIds ...uint64) error {
+	if len(groupIds) == 0 {
+		return nil
+	}
+
+	members := tx.Tx().NewSelect().
+		Model((*models.GroupMember)(nil)).
+		Column("group_id", "weight").
+		DistinctOn("group_id, address_id").
+		Where("group_id IN (?)", bun.In(groupIds)).
+		OrderExpr("group_id, address_id, height desc, id desc")
+
+	totals := tx.Tx().NewSelect().
+		TableExpr("(?) as members", members).
+		ColumnExpr("members.group_id").
+		ColumnExpr("coalesce(sum(members.weight), 0) as total_weight").
+		ColumnExpr("count(*) filter (where members.weight > 0) as members_count").
+		GroupExpr("members.group_id")
+
+	_, err := tx.Tx().NewUpdate().
+		With("totals", totals).
+		Model((*models.Group)(nil)).
+		TableExpr("totals").
+		Set("total_weight = totals.total_weight").
+		Set("members_count = totals.members_count").
+		Where("group_info.id = totals.group_id").
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) Jail(ctx context.Context, validators ...*models.Validator) error {
+	if len(validators) == 0 {
+		return nil
+	}
+
+	values := tx.Tx().NewValues(&validators)
+	_, err := tx.Tx().NewUpdate().
+		With("_data", values).
+		Model((*models.Validator)(nil)).
+		TableExpr("_data").
+		Set("jailed = true").
+		Set("stake = _data.stake + validator.stake").
+		Where("validator.id = _data.id").
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveProposals(ctx context.Context, proposals ...*models.Proposal) error {
+	if len(proposals) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewInsert().Model(&proposals).
+		Column("id", "height", "proposer_id", "created_at", "activation_height", "activation_time", "end_height", "end_time", "status", "type", "title", "description", "metadata", "changes", "deposit", "votes_count", "yes", "no", "no_with_veto", "abstain").
+		On("CONFLICT (id) DO UPDATE").
+		Set("deposit = proposal.deposit + EXCLUDED.deposit").
+		Set("status = coalesce(EXCLUDED.status, proposal.status)").
+		Set("activation_height = coalesce(EXCLUDED.activation_height, proposal.activation_height)").
+		Set("activation_time = coalesce(EXCLUDED.activation_time, proposal.activation_time)").
+		Set("end_height = coalesce(EXCLUDED.end_height, proposal.end_height)").
+		Set("end_time = coalesce(EXCLUDED.end_time, proposal.end_time)").
+		Returning("type, changes").
+		Exec(ctx)
+	return err
+}
+
+// UpdateProposalsTally - recomputes tally of proposals from the latest votes of each voter
+func (tx Transaction) UpdateProposalsTally(ctx context.Context, proposalIds ...uint64) error {
+	if len(proposalIds) == 0 {
+		return nil
+	}
+
+	latest := tx.Tx().NewSelect().
+		Model((*models.Vote)(nil)).
+		ColumnExpr("proposal_id, voter_id, max(height)").
+		Where("proposal_id IN (?)", bun.In(proposalIds)).
+		GroupExpr("proposal_id, voter_id")
+
+	votes := tx.Tx().NewSelect().
+		Model((*models.Vote)(nil)).
+		Column("proposal_id", "voter_id", "option").
+		Where("(proposal_id, voter_id, height) IN (?)", latest)
+
+	tally := tx.Tx().NewSelect().
+		TableExpr("proposal as p").
+		ColumnExpr("p.id").
+		ColumnExpr("count(distinct votes.voter_id) as votes_count").
+		ColumnExpr("count(distinct votes.voter_id) filter (where votes.option = ?) as yes", storageTypes.VoteOptionYes).
+		ColumnExpr("count(distinct votes.voter_id) filter (where votes.option = ?) as no", storageTypes.VoteOptionNo).
+		ColumnExpr("count(distinct votes.voter_id) filter (where votes.option = ?) as no_with_veto", storageTypes.VoteOptionNoWithVeto).
+		ColumnExpr("count(distinct votes.voter_id) filter (where votes.option = ?) as abstain", storageTypes.VoteOptionAbstain).
+		Join("left join (?) as votes on votes.proposal_id = p.id", votes).
+		Where("p.id IN (?)", bun.In(proposalIds)).
+		GroupExpr("p.id")
+
+	_, err := tx.Tx().NewUpdate().
+		With("tally", tally).
+		Model((*models.Proposal)(nil)).
+		TableExpr("tally").
+		Set("votes_count = tally.votes_count").
+		Set("yes = tally.yes").
+		Set("no = tally.no").
+		Set("no_with_veto = tally.no_with_veto").
+		Set("abstain = tally.abstain").
+		Where("proposal.id = tally.id").
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveVotes(ctx context.Context, votes ...models.Vote) error {
+	if len(votes) == 0 {
+		return nil
+	}
+	_, err := tx.Tx().NewInsert().Model(&votes).Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveDeposits(ctx context.Context, deposits ...models.Deposit) error {
+	if len(deposits) == 0 {
+		return nil
+	}
+	_, err := tx.Tx().NewInsert().Model(&deposits).Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveIbcClients(ctx context.Context, clients ...*models.IbcClient) error {
+	if len(clients) == 0 {
+		return nil
+	}
+	_, err := tx.Tx().NewInsert().Model(&clients).Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveIbcConnections(ctx context.Context, connections ...*models.IbcConnection) error {
+	if len(connections) == 0 {
+		return nil
+	}
+
+	for i := range connections {
+		query := tx.Tx().NewInsert().Model(connections[i]).
+			Column("id", "client_id", "counterparty_connection_id", "counterparty_client_id", "height", "created_at", "connection_height", "connected_at", "create_tx_id", "connection_tx_id")
+
+		if connections[i].Opened() {
+			query = query.On("CONFLICT (id) DO UPDATE").
+				Set("counterparty_connection_id = EXCLUDED.counterparty_connection_id").
+				Set("connection_height = EXCLUDED.connection_height").
+				Set("connected_at = EXCLUDED.connected_at").
+				Set("connection_tx_id = EXCLUDED.connection_tx_id")
+		} else {
+			query = query.On("CONFLICT (id) DO NOTHING")
+		}
+
+		if _, err := query.Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (tx Transaction) SaveIbcChannels(ctx context.Context, channels ...*models.IbcChannel) error {
+	if len(channels) == 0 {
+		return nil
+	}
+
+	for i := range channels {
+		query := tx.Tx().NewInsert().Model(channels[i]).
+			Column("id", "port_id", "counterparty_port_id", "counterparty_channel_id", "connection_id", "version", "height", "created_at", "confirmation_height", "confirmed_at", "create_tx_id", "confirmation_tx_id", "status")
+
+		if channels[i].Status == storageTypes.IbcChannelStatusOpened {
+			query = query.On("CONFLICT (id) DO UPDATE").
+				Set("counterparty_channel_id = EXCLUDED.counterparty_channel_id").
+				Set("confirmation_height = EXCLUDED.confirmation_height").
+				Set("confirmed_at = EXCLUDED.confirmed_at").
+				Set("confirmation_tx_id = EXCLUDED.confirmation_tx_id").
+				Set("status = EXCLUDED.status")
+		} else {
+			query = query.On("CONFLICT (id) DO NOTHING")
+		}
+
+		if _, err := query.Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (tx Transaction) SaveIbcTransfers(ctx context.Context, transfers ...*models.IbcTransfer) error {
+	if len(transfers) == 0 {
+		return nil
+	}
+	_, err := tx.Tx().NewInsert().Model(&transfers).Exec(ctx)
+	return err
+}
+
+func (tx Transaction) UpdateIbcTransfers(ctx context.Context, transfers ...*models.IbcTransfer) error {
+	if len(transfers) == 0 {
 		return nil
 	}
-	_, err := tx.Tx().NewInsert().Model(&jails).Exec(ctx)
-	return err
+
+	for i := range transfers {
+		if _, err := tx.Tx().NewUpdate().
+			Model((*models.IbcTransfer)(nil)).
+			Set("status = ?", transfers[i].Status).
+			Set("completed_height = ?", transfers[i].CompletedHeight).
+			Set("completed_at = ?", transfers[i].CompletedAt).
+			Where("port = ?", transfers[i].Port).
+			Where("channel_id = ?", transfers[i].ChannelId).
+			Where("sequence = ?", transfers[i].Sequence).
+			Where("status = ?", storageTypes.IbcTransferStatusSent).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func (tx Transaction) Jail(ctx context.Context, validators ...*models.Validator) error {
-	if len(validators) == 0 {
+func (tx Transaction) SaveUpgrades(ctx context.Context, upgrades ...*models.Upgrade) error {
+	if len(upgrades) == 0 {
 		return nil
 	}
+	_, err := tx.Tx().NewInsert().Model(&upgrades).Exec(ctx)
+	return err
+}
 
-	values := tx.Tx().NewValues(&validators)
+// CancelUpgrades - cancels scheduled upgrade. Upgrade module keeps the only plan, so scheduling of new plan cancels the previous one too.
+func (tx Transaction) CancelUpgrades(ctx context.Context, height types.Level, t time.Time) error {
 	_, err := tx.Tx().NewUpdate().
-		With("_data", values).
-		Model((*models.Validator)(nil)).
-		TableExpr("_data").
-		Set("jailed = true").
-		Set("stake = _data.stake + validator.stake").
-		Where("validator.id = _data.id").
+		Model((*models.Upgrade)(nil)).
+		Set("status = ?", storageTypes.UpgradeStatusCancelled).
+		Set("cancel_height = ?", height).
+		Set("cancel_time = ?", t).
+		Where("status = ?", storageTypes.UpgradeStatusScheduled).
 		Exec(ctx)
 	return err
 }
 
+// ApplyUpgrades - marks scheduled upgrades which target height is reached as applied. Returns count of applied upgrades.
+func (tx Transaction) ApplyUpgrades(ctx context.Context, height types.Level, t time.Time, appVersion uint64) (int64, error) {
+	result, err := tx.Tx().NewUpdate().
+		Model((*models.Upgrade)(nil)).
+		Set("status = ?", storageTypes.UpgradeStatusApplied).
+		Set("app_version = ?", appVersion).
+		Set("activation_height = ?", height).
+		Set("activation_time = ?", t).
+		Where("status = ?", storageTypes.UpgradeStatusScheduled).
+		Where("plan_height <= ?", height).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 func (tx Transaction) UpdateSlashedDelegations(ctx context.Context, validatorId uint64, fraction decimal.Decimal) (balances []models.Balance, err error) {
 	if validatorId == 0 || !fraction.IsPositive() {
 		return nil, nil
@@ -408,6 +1101,25 @@ func (tx Transaction) LastBlock(ctx context.Context) (block models.Block, err er
 	return
 }
 
+func (tx Transaction) LastConstant(ctx context.Context, module storageTypes.ModuleName, name string) (constant models.ConstantHistory, err error) {
+	err = tx.Tx().NewSelect().Model(&constant).
+		Where("module = ?", module).
+		Where("name = ?", name).
+		Order("height desc", "id desc").
+		Limit(1).
+		Scan(ctx)
+	return
+}
+
+func (tx Transaction) LastDataCommitment(ctx context.Context) (attestation models.BlobstreamAttestation, err error) {
+	err = tx.Tx().NewSelect().Model(&attestation).
+		Where("type = ?", storageTypes.AttestationTypeDataCommitment).
+		Order("nonce desc").
+		Limit(1).
+		Scan(ctx)
+	return
+}
+
 func (tx Transaction) State(ctx context.Context, name string) (state models.State, err error) {
 	err = tx.Tx().NewSelect().Model(&state).Where("name = ?", name).Scan(ctx)
 	return
@@ -471,7 +1183,34 @@ func (tx Transaction) RollbackBlobLog(ctx context.Context, height types.Level) (
 	return
 }
 
+// RollbackGrants - restores grants which were granted again at the height from the update log,
+// removes grants created at the height and cancels revocations made at the height
 func (tx Transaction) RollbackGrants(ctx context.Context, height types.Level) (err error) {
+	if _, err = tx.Tx().NewUpdate().
+		With("_data", tx.Tx().NewSelect().
+			Model((*models.GrantUpdate)(nil)).
+			Where("height = ?", height)).
+		Model((*models.Grant)(nil)).
+		TableExpr("_data").
+		Set("height = _data.grant_height").
+		Set("time = _data.time").
+		Set("expiration = _data.expiration").
+		Set("revoked = _data.revoked").
+		Set("revoke_height = _data.revoke_height").
+		Set("params = _data.params").
+		Set("spend_limit = _data.spend_limit").
+		Set("spent = _data.spent").
+		Where(`"grant".id = _data.grant_id`).
+		Exec(ctx); err != nil {
+		return err
+	}
+	if _, err = tx.Tx().NewDelete().
+		Model((*models.GrantUpdate)(nil)).
+		Where("height = ?", height).
+		Exec(ctx); err != nil {
+		return err
+	}
+
 	if _, err = tx.Tx().NewDelete().
 		Model((*models.Grant)(nil)).
 		Where("height = ?", height).
@@ -516,6 +1255,15 @@ func (tx Transaction) RollbackSigners(ctx context.Context, txIds []uint64) (err
 	return
 }
 
+func (tx Transaction) RollbackTxAddresses(ctx context.Context, txIds []uint64) (addresses []models.TxAddress, err error) {
+	_, err = tx.Tx().NewDelete().
+		Model(&addresses).
+		Where("tx_id IN (?)", bun.In(txIds)).
+		Returning("*").
+		Exec(ctx)
+	return
+}
+
 func (tx Transaction) RollbackMessageAddresses(ctx context.Context, msgIds []uint64) (err error) {
 	_, err = tx.Tx().NewDelete().
 		Model((*models.MsgAddress)(nil)).
@@ -530,6 +1278,23 @@ func (tx Transaction) RollbackBlockSignatures(ctx context.Context, height types.
 	return
 }
 
+// RollbackMissedBlocks - removes missed blocks received from the last commit of block with `height`
+func (tx Transaction) RollbackMissedBlocks(ctx context.Context, height types.Level) error {
+	_, err := tx.Tx().NewDelete().
+		Model((*models.MissedBlock)(nil)).
+		Where("height = ?", height-1).
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) RollbackBlockDah(ctx context.Context, height types.Level) error {
+	_, err := tx.Tx().NewDelete().
+		Model((*models.BlockDah)(nil)).
+		Where("height = ?", height).
+		Exec(ctx)
+	return err
+}
+
 func (tx Transaction) RollbackJails(ctx context.Context, height types.Level) (jails []models.Jail, err error) {
 	_, err = tx.Tx().NewDelete().Model(&jails).
 		Where("height = ?", height).
@@ -538,6 +1303,200 @@ func (tx Transaction) RollbackJails(ctx context.Context, height types.Level) (ja
 	return
 }
 
+func (tx Transaction) RollbackSlashes(ctx context.Context, height types.Level) error {
+	_, err := tx.Tx().NewDelete().
+		Model((*models.Slash)(nil)).
+		Where("height = ?", height).
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) RollbackValidatorHistory(ctx context.Context, height types.Level) error {
+	var history []models.ValidatorHistory
+	if _, err := tx.Tx().NewDelete().
+		Model(&history).
+		Where("height = ?", height).
+		Returning("validator_id").
+		Exec(ctx); err != nil {
+		return err
+	}
+	if len(history) == 0 {
+		return nil
+	}
+
+	ids := make([]uint64, len(history))
+	for i := range history {
+		ids[i] = history[i].ValidatorId
+	}
+
+	// restore description and commission from the previous snapshot
+	previous := tx.Tx().NewSelect().
+		Model((*models.ValidatorHistory)(nil)).
+		DistinctOn("validator_id").
+		Where("validator_id IN (?)", bun.In(ids)).
+		Order("validator_id", "id desc")
+
+	_, err := tx.Tx().NewUpdate().
+		With("_data", previous).
+		Model((*models.Validator)(nil)).
+		TableExpr("_data").
+		Set("moniker = _data.moniker").
+		Set("website = _data.website").
+		Set("identity = _data.identity").
+		Set("contacts = _data.contacts").
+		Set("details = _data.details").
+		Set("rate = _data.rate").
+		Set("max_rate = _data.max_rate").
+		Set("max_change_rate = _data.max_change_rate").
+		Set("min_self_delegation = _data.min_self_delegation").
+		Where("validator.id = _data.validator_id").
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) RollbackGroups(ctx context.Context, height types.Level) error {
+	_, err := tx.Tx().NewDelete().
+		Model((*models.Group)(nil)).
+		Where("height = ?", height).
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) RollbackGroupMembers(ctx context.Context, height types.Level) (members []models.GroupMember, err error) {
+	_, err = tx.Tx().NewDelete().Model(&members).
+		Where("height = ?", height).
+		Returning("*").
+		Exec(ctx)
+	return
+}
+
+func (tx Transaction) RollbackGroupPolicies(ctx context.Context, height types.Level) error {
+	_, err := tx.Tx().NewDelete().
+		Model((*models.GroupPolicy)(nil)).
+		Where("height = ?", height).
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) RollbackGroupProposals(ctx context.Context, height types.Level) (err error) {
+	if _, err = tx.Tx().NewDelete().
+		Model((*models.GroupProposal)(nil)).
+		Where("height = ?", height).
+		Exec(ctx); err != nil {
+		return
+	}
+
+	_, err = tx.Tx().NewUpdate().
+		Model((*models.GroupProposal)(nil)).
+		Where("end_height = ?", height).
+		Set("status = ?", storageTypes.GroupProposalStatusSubmitted).
+		Set("executor_result = ?", storageTypes.GroupExecutorResultNotRun).
+		Set("end_height = NULL").
+		Set("end_time = NULL").
+		Exec(ctx)
+	return
+}
+
+func (tx Transaction) RollbackGroupVotes(ctx context.Context, height types.Level) (votes []models.GroupVote, err error) {
+	_, err = tx.Tx().NewDelete().Model(&votes).
+		Where("height = ?", height).
+		Returning("*").
+		Exec(ctx)
+	return
+}
+
+// RollbackGroupUpdates - restores groups and group policies changed at the height from the update log
+func (tx Transaction) RollbackGroupUpdates(ctx context.Context, height types.Level) error {
+	groups := tx.Tx().NewSelect().
+		Model((*models.GroupUpdate)(nil)).
+		DistinctOn("group_id").
+		Where("height = ?", height).
+		Where("group_id IS NOT NULL").
+		Order("group_id", "id asc")
+
+	if _, err := tx.Tx().NewUpdate().
+		With("_data", groups).
+		Model((*models.Group)(nil)).
+		TableExpr("_data").
+		Set("admin_id = _data.admin_id").
+		Set("metadata = _data.metadata").
+		Where("group_info.id = _data.group_id").
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	policies := tx.Tx().NewSelect().
+		Model((*models.GroupUpdate)(nil)).
+		DistinctOn("policy_address_id").
+		Where("height = ?", height).
+		Where("policy_address_id IS NOT NULL").
+		Order("policy_address_id", "id asc")
+
+	if _, err := tx.Tx().NewUpdate().
+		With("_data", policies).
+		Model((*models.GroupPolicy)(nil)).
+		TableExpr("_data").
+		Set("admin_id = _data.admin_id").
+		Set("metadata = _data.metadata").
+		Set("decision_policy_type = _data.decision_policy_type").
+		Set("threshold = _data.threshold").
+		Set("voting_period = _data.voting_period").
+		Set("min_execution_period = _data.min_execution_period").
+		Where("group_policy.address_id = _data.policy_address_id").
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	_, err := tx.Tx().NewDelete().
+		Model((*models.GroupUpdate)(nil)).
+		Where("height = ?", height).
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) RollbackInterchainAccounts(ctx context.Context, height types.Level) (err error) {
+	_, err = tx.Tx().NewDelete().
+		Model((*models.InterchainAccount)(nil)).
+		Where("height = ?", height).
+		Exec(ctx)
+	return
+}
+
+func (tx Transaction) RollbackInterchainAccountPackets(ctx context.Context, height types.Level) (err error) {
+	_, err = tx.Tx().NewDelete().
+		Model((*models.InterchainAccountPacket)(nil)).
+		Where("height = ?", height).
+		Exec(ctx)
+	return
+}
+
+func (tx Transaction) RollbackUpgrades(ctx context.Context, height types.Level) error {
+	if _, err := tx.Tx().NewDelete().
+		Model((*models.Upgrade)(nil)).
+		Where("height = ?", height).
+		Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.Tx().NewUpdate().
+		Model((*models.Upgrade)(nil)).
+		Set("status = ?", storageTypes.UpgradeStatusScheduled).
+		Set("cancel_height = NULL").
+		Set("cancel_time = NULL").
+		Where("cancel_height = ?", height).
+		Exec(ctx); err != nil {
+		return err
+	}
+	_, err := tx.Tx().NewUpdate().
+		Model((*models.Upgrade)(nil)).
+		Set("status = ?", storageTypes.UpgradeStatusScheduled).
+		Set("app_version = 0").
+		Set("activation_height = NULL").
+		Set("activation_time = NULL").
+		Where("activation_height = ?", height).
+		Exec(ctx)
+	return err
+}
+
 func (tx Transaction) RollbackStakingLogs(ctx context.Context, height types.Level) (logs []models.StakingLog, err error) {
 	_, err = tx.Tx().NewDelete().Model(&logs).
 		Where("height = ?", height).
@@ -546,6 +1505,90 @@ func (tx Transaction) RollbackStakingLogs(ctx context.Context, height types.Leve
 	return
 }
 
+func (tx Transaction) RollbackBalanceHistory(ctx context.Context, height types.Level) error {
+	_, err := tx.Tx().NewDelete().
+		Model((*models.BalanceHistory)(nil)).
+		Where("height = ?", height).
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) RollbackTransfers(ctx context.Context, height types.Level) error {
+	_, err := tx.Tx().NewDelete().
+		Model((*models.Transfer)(nil)).
+		Where("height = ?", height).
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) RollbackConstantHistory(ctx context.Context, height types.Level) (history []models.ConstantHistory, err error) {
+	_, err = tx.Tx().NewDelete().Model(&history).
+		Where("height = ?", height).
+		Returning("*").
+		Exec(ctx)
+	return
+}
+
+func (tx Transaction) RollbackBlobstreamAttestations(ctx context.Context, height types.Level) error {
+	_, err := tx.Tx().NewDelete().
+		Model((*models.BlobstreamAttestation)(nil)).
+		Where("height = ?", height).
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) RollbackBlobstreamEvmAddresses(ctx context.Context, height types.Level) error {
+	_, err := tx.Tx().NewDelete().
+		Model((*models.BlobstreamEvmAddress)(nil)).
+		Where("height = ?", height).
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) RollbackProposals(ctx context.Context, height types.Level) (err error) {
+	if _, err = tx.Tx().NewDelete().
+		Model((*models.Proposal)(nil)).
+		Where("height = ?", height).
+		Exec(ctx); err != nil {
+		return
+	}
+
+	if _, err = tx.Tx().NewUpdate().
+		Model((*models.Proposal)(nil)).
+		Where("end_height = ?", height).
+		Set("status = CASE WHEN activation_height IS NULL THEN ?::proposal_status ELSE ?::proposal_status END", storageTypes.ProposalStatusInactive, storageTypes.ProposalStatusActive).
+		Set("end_height = NULL").
+		Set("end_time = NULL").
+		Exec(ctx); err != nil {
+		return
+	}
+
+	_, err = tx.Tx().NewUpdate().
+		Model((*models.Proposal)(nil)).
+		Where("activation_height = ?", height).
+		Set("status = ?", storageTypes.ProposalStatusInactive).
+		Set("activation_height = NULL").
+		Set("activation_time = NULL").
+		Exec(ctx)
+	return
+}
+
+func (tx Transaction) RollbackVotes(ctx context.Context, height types.Level) (votes []models.Vote, err error) {
+	_, err = tx.Tx().NewDelete().Model(&votes).
+		Where("height = ?", height).
+		Returning("*").
+		Exec(ctx)
+	return
+}
+
+func (tx Transaction) RollbackDeposits(ctx context.Context, height types.Level) (deposits []models.Deposit, err error) {
+	_, err = tx.Tx().NewDelete().Model(&deposits).
+		Where("height = ?", height).
+		Returning("*").
+		Exec(ctx)
+	return
+}
+
 func (tx Transaction) DeleteBalances(ctx context.Context, ids []uint64) error {
 	if len(ids) == 0 {
 		return nil
@@ -767,3 +1810,63 @@ func (tx Transaction) Delegation(ctx context.Context, validatorId, addressId uin
 		Scan(ctx)
 	return
 }
+
+func (tx Transaction) RollbackIbcClients(ctx context.Context, height types.Level) (err error) {
+	_, err = tx.Tx().NewDelete().Model((*models.IbcClient)(nil)).Where("height = ?", height).Exec(ctx)
+	return
+}
+
+func (tx Transaction) RollbackIbcConnections(ctx context.Context, height types.Level) (err error) {
+	if _, err = tx.Tx().NewDelete().
+		Model((*models.IbcConnection)(nil)).
+		Where("height = ?", height).
+		Exec(ctx); err != nil {
+		return
+	}
+
+	_, err = tx.Tx().NewUpdate().
+		Model((*models.IbcConnection)(nil)).
+		Where("connection_height = ?", height).
+		Set("connection_height = NULL").
+		Set("connected_at = NULL").
+		Set("connection_tx_id = NULL").
+		Exec(ctx)
+	return
+}
+
+func (tx Transaction) RollbackIbcChannels(ctx context.Context, height types.Level) (err error) {
+	if _, err = tx.Tx().NewDelete().
+		Model((*models.IbcChannel)(nil)).
+		Where("height = ?", height).
+		Exec(ctx); err != nil {
+		return
+	}
+
+	_, err = tx.Tx().NewUpdate().
+		Model((*models.IbcChannel)(nil)).
+		Where("confirmation_height = ?", height).
+		Set("status = ?", storageTypes.IbcChannelStatusInitialization).
+		Set("confirmation_height = NULL").
+		Set("confirmed_at = NULL").
+		Set("confirmation_tx_id = NULL").
+		Exec(ctx)
+	return
+}
+
+func (tx Transaction) RollbackIbcTransfers(ctx context.Context, height types.Level) (err error) {
+	if _, err = tx.Tx().NewDelete().
+		Model((*models.IbcTransfer)(nil)).
+		Where("height = ?", height).
+		Exec(ctx); err != nil {
+		return
+	}
+
+	_, err = tx.Tx().NewUpdate().
+		Model((*models.IbcTransfer)(nil)).
+		Where("completed_height = ?", height).
+		Set("status = ?", storageTypes.IbcTransferStatusSent).
+		Set("completed_height = NULL").
+		Set("completed_at = NULL").
+		Exec(ctx)
+	return
+}
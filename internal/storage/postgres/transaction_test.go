@@ -360,6 +360,327 @@ func (s *TransactionTestSuite) TestRollbackBlockSignatures() {
 	s.Require().NoError(tx.Close(ctx))
 }
 
+func (s *TransactionTestSuite) TestRollbackMissedBlocks() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.RollbackMissedBlocks(ctx, 1000)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	count, err := s.storage.MissedBlocks.CountByValidator(ctx, 2, 0)
+	s.Require().NoError(err)
+	s.Require().EqualValues(2, count)
+}
+
+func (s *TransactionTestSuite) TestSaveAndRollbackBlockDah() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.SaveBlockDah(ctx, &storage.BlockDah{
+		Height:      1001,
+		Time:        time.Now(),
+		Hash:        []byte{0x01},
+		DataHash:    []byte{0x02},
+		SquareSize:  1,
+		RowRoots:    []string{"01", "02"},
+		ColumnRoots: []string{"03", "04"},
+	})
+	s.Require().NoError(err)
+
+	err = tx.RollbackBlockDah(ctx, 1000)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	_, err = s.storage.BlockDah.ByHeight(ctx, 1000)
+	s.Require().Error(err)
+
+	dah, err := s.storage.BlockDah.ByHeight(ctx, 1001)
+	s.Require().NoError(err)
+	s.Require().False(dah.Valid)
+	s.Require().Equal([]string{"01", "02"}, dah.RowRoots)
+	s.Require().Equal([]string{"03", "04"}, dah.ColumnRoots)
+}
+
+func (s *TransactionTestSuite) TestUpdateProposalsTally() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	// voter 1 changes vote to weighted one and voter 3 votes for the first time
+	err = tx.SaveVotes(ctx,
+		storage.Vote{Height: 1001, Time: time.Now(), ProposalId: 1, VoterId: 1, Option: types.VoteOptionNo, Weight: decimal.RequireFromString("0.5")},
+		storage.Vote{Height: 1001, Time: time.Now(), ProposalId: 1, VoterId: 1, Option: types.VoteOptionAbstain, Weight: decimal.RequireFromString("0.5")},
+		storage.Vote{Height: 1001, Time: time.Now(), ProposalId: 1, VoterId: 3, Option: types.VoteOptionYes, Weight: decimal.RequireFromString("1")},
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(tx.UpdateProposalsTally(ctx, 1, 2))
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	proposal, err := s.storage.Proposals.ById(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().EqualValues(3, proposal.VotesCount)
+	s.Require().EqualValues(1, proposal.Yes)
+	s.Require().EqualValues(2, proposal.No)
+	s.Require().EqualValues(1, proposal.Abstain)
+	s.Require().EqualValues(0, proposal.NoWithVeto)
+
+	proposal, err = s.storage.Proposals.ById(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().EqualValues(0, proposal.VotesCount)
+
+	tx, err = BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	votes, err := tx.RollbackVotes(ctx, 1001)
+	s.Require().NoError(err)
+	s.Require().Len(votes, 3)
+	s.Require().NoError(tx.UpdateProposalsTally(ctx, 1))
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	proposal, err = s.storage.Proposals.ById(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().EqualValues(2, proposal.VotesCount)
+	s.Require().EqualValues(1, proposal.Yes)
+	s.Require().EqualValues(1, proposal.No)
+	s.Require().EqualValues(0, proposal.Abstain)
+}
+
+func (s *TransactionTestSuite) TestSpendFeeGrants() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.SpendFeeGrants(ctx, storage.Grant{
+		GranterId: 1,
+		GranteeId: 2,
+		Spent:     decimal.RequireFromString("1000"),
+	}, storage.Grant{
+		GranterId: 2,
+		GranteeId: 1,
+		Spent:     decimal.RequireFromString("1000"),
+	})
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	grant, err := s.storage.Grants.GetByID(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().NotNil(grant.SpendLimit)
+	s.Require().Equal("999000", grant.SpendLimit.String())
+	s.Require().Equal("1000", grant.Spent.String())
+
+	grant, err = s.storage.Grants.GetByID(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Nil(grant.SpendLimit)
+	s.Require().True(grant.Spent.IsZero())
+}
+
+func (s *TransactionTestSuite) TestSaveGrantsRegrant() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	revokeHeight := pkgTypes.Level(1001)
+	err = tx.SaveGrants(ctx, storage.Grant{
+		Height:        1001,
+		GranterId:     1,
+		GranteeId:     2,
+		Authorization: "fee",
+		Revoked:       true,
+		RevokeHeight:  &revokeHeight,
+	})
+	s.Require().NoError(err)
+
+	// allowance revoked before the block of spend is not charged
+	err = tx.SpendFeeGrants(ctx, storage.Grant{
+		Height:    1002,
+		GranterId: 1,
+		GranteeId: 2,
+		Spent:     decimal.RequireFromString("1000"),
+	})
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	grant, err := s.storage.Grants.GetByID(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().True(grant.Revoked)
+	s.Require().NotNil(grant.SpendLimit)
+	s.Require().Equal("1000000", grant.SpendLimit.String())
+	s.Require().True(grant.Spent.IsZero())
+	s.Require().EqualValues(1000, grant.Height)
+
+	tx, err = BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	spendLimit := decimal.RequireFromString("5000")
+	err = tx.SaveGrants(ctx, storage.Grant{
+		Height:        1002,
+		GranterId:     1,
+		GranteeId:     2,
+		Authorization: "fee",
+		SpendLimit:    &spendLimit,
+		Params: map[string]any{
+			"SpendLimit": "5000utia",
+		},
+	})
+	s.Require().NoError(err)
+
+	err = tx.SpendFeeGrants(ctx, storage.Grant{
+		Height:    1002,
+		GranterId: 1,
+		GranteeId: 2,
+		Spent:     decimal.RequireFromString("100"),
+	})
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	grant, err = s.storage.Grants.GetByID(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().False(grant.Revoked)
+	s.Require().Nil(grant.RevokeHeight)
+	s.Require().NotNil(grant.SpendLimit)
+	s.Require().Equal("4900", grant.SpendLimit.String())
+	s.Require().Equal("100", grant.Spent.String())
+	s.Require().EqualValues(1002, grant.Height)
+	s.Require().Equal("5000utia", grant.Params["SpendLimit"])
+
+	// rollback of the regrant block restores the previous grant instead of removing it
+	tx, err = BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.SpendFeeGrants(ctx, storage.Grant{
+		Height:    1002,
+		GranterId: 1,
+		GranteeId: 2,
+		Spent:     decimal.RequireFromString("-100"),
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(tx.RollbackGrants(ctx, 1002))
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	grant, err = s.storage.Grants.GetByID(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().True(grant.Revoked)
+	s.Require().NotNil(grant.RevokeHeight)
+	s.Require().EqualValues(1001, *grant.RevokeHeight)
+	s.Require().NotNil(grant.SpendLimit)
+	s.Require().Equal("1000000", grant.SpendLimit.String())
+	s.Require().True(grant.Spent.IsZero())
+	s.Require().EqualValues(1000, grant.Height)
+	s.Require().Equal("1000000utia", grant.Params["SpendLimit"])
+
+	var updates []storage.GrantUpdate
+	s.Require().NoError(s.storage.Connection().DB().NewSelect().Model(&updates).Scan(ctx))
+	s.Require().Len(updates, 0)
+}
+
+func (s *TransactionTestSuite) TestSpendFeeGrantsRevokedInBlock() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	revokeHeight := pkgTypes.Level(1001)
+	err = tx.SaveGrants(ctx, storage.Grant{
+		Height:        1001,
+		GranterId:     1,
+		GranteeId:     2,
+		Authorization: "fee",
+		Revoked:       true,
+		RevokeHeight:  &revokeHeight,
+	})
+	s.Require().NoError(err)
+
+	// fee spent in the block of revocation is charged
+	err = tx.SpendFeeGrants(ctx, storage.Grant{
+		Height:    1001,
+		GranterId: 1,
+		GranteeId: 2,
+		Spent:     decimal.RequireFromString("1000"),
+	})
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	grant, err := s.storage.Grants.GetByID(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().True(grant.Revoked)
+	s.Require().Equal("999000", grant.SpendLimit.String())
+	s.Require().Equal("1000", grant.Spent.String())
+
+	// rollback restores spends before revocation is cancelled
+	tx, err = BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.SpendFeeGrants(ctx, storage.Grant{
+		Height:    1001,
+		GranterId: 1,
+		GranteeId: 2,
+		Spent:     decimal.RequireFromString("-1000"),
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(tx.RollbackGrants(ctx, 1001))
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	grant, err = s.storage.Grants.GetByID(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().False(grant.Revoked)
+	s.Require().Nil(grant.RevokeHeight)
+	s.Require().Equal("1000000", grant.SpendLimit.String())
+	s.Require().True(grant.Spent.IsZero())
+}
+
+func (s *TransactionTestSuite) TestRollbackTxAddresses() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	addresses, err := tx.RollbackTxAddresses(ctx, []uint64{2})
+	s.Require().NoError(err)
+	s.Require().Len(addresses, 2)
+
+	for i := range addresses {
+		s.Require().EqualValues(2, addresses[i].TxId)
+	}
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+}
+
 func (s *TransactionTestSuite) TestRollbackBlock() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
@@ -605,6 +926,74 @@ func (s *TransactionTestSuite) TestRollbackNamespaceMessages() {
 	s.Require().NoError(tx.Close(ctx))
 }
 
+func (s *TransactionTestSuite) TestRollbackConstantHistory() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	deleted, err := tx.RollbackConstantHistory(ctx, 1000)
+	s.Require().NoError(err)
+	s.Require().Len(deleted, 1)
+	s.Require().EqualValues("gas_per_blob_byte", deleted[0].Name)
+	s.Require().EqualValues("8", deleted[0].Value)
+
+	last, err := tx.LastConstant(ctx, types.ModuleNameBlob, "gas_per_blob_byte")
+	s.Require().NoError(err)
+	s.Require().EqualValues(0, last.Height)
+	s.Require().EqualValues("16", last.Value)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+}
+
+func (s *TransactionTestSuite) TestRollbackBlobstream() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.RollbackBlobstreamAttestations(ctx, 1000)
+	s.Require().NoError(err)
+
+	err = tx.RollbackBlobstreamEvmAddresses(ctx, 1000)
+	s.Require().NoError(err)
+
+	last, err := tx.LastDataCommitment(ctx)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, last.Nonce)
+	s.Require().EqualValues(401, last.EndBlock)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	addresses, err := s.storage.EvmAddresses.ListWithFilters(ctx, storage.BlobstreamEvmAddressFilters{
+		Limit: 10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(addresses, 1)
+}
+
+func (s *TransactionTestSuite) TestRollbackSlashes() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.RollbackSlashes(ctx, 1000)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	slashes, err := s.storage.Slashes.ByValidator(ctx, 1, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(slashes, 0)
+}
+
 func (s *TransactionTestSuite) TestDeleteBalances() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
@@ -975,3 +1364,244 @@ func (s *TransactionTestSuite) TestUpdateSlashedDelegations() {
 func TestSuiteTransaction_Run(t *testing.T) {
 	suite.Run(t, new(TransactionTestSuite))
 }
+
+func (s *TransactionTestSuite) TestRollbackGroups() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	votes, err := tx.RollbackGroupVotes(ctx, 1000)
+	s.Require().NoError(err)
+	s.Require().Len(votes, 2)
+
+	err = tx.RollbackGroupProposals(ctx, 1000)
+	s.Require().NoError(err)
+
+	members, err := tx.RollbackGroupMembers(ctx, 1000)
+	s.Require().NoError(err)
+	s.Require().Len(members, 2)
+
+	err = tx.RollbackGroupPolicies(ctx, 1000)
+	s.Require().NoError(err)
+
+	err = tx.RollbackGroups(ctx, 1000)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	groups, err := s.storage.Groups.List(ctx, 10, 0, sdk.SortOrderAsc)
+	s.Require().NoError(err)
+	s.Require().Len(groups, 0)
+
+	proposals, err := s.storage.GroupProposals.List(ctx, 10, 0, sdk.SortOrderAsc)
+	s.Require().NoError(err)
+	s.Require().Len(proposals, 0)
+}
+
+func (s *TransactionTestSuite) TestRollbackGroupUpdates() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.SaveGroups(ctx, &storage.Group{
+		Id:       1,
+		Height:   1001,
+		AdminId:  2,
+		Metadata: "changed",
+	})
+	s.Require().NoError(err)
+
+	err = tx.SaveGroupPolicies(ctx, &storage.GroupPolicy{
+		AddressId:          2,
+		GroupId:            1,
+		Height:             1001,
+		Metadata:           storage.DoNotModify,
+		DecisionPolicyType: types.DecisionPolicyTypePercentage,
+		Threshold:          decimal.RequireFromString("0.5"),
+		VotingPeriod:       3600,
+	})
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	group, err := s.storage.Groups.ById(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().EqualValues(2, group.AdminId)
+	s.Require().Equal("changed", group.Metadata)
+
+	tx, err = BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.RollbackGroupUpdates(ctx, 1001)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	group, err = s.storage.Groups.ById(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, group.AdminId)
+	s.Require().Equal("first group", group.Metadata)
+
+	policy, err := s.storage.GroupPolicies.ByAddressId(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().Equal("policy", policy.Metadata)
+	s.Require().Equal(types.DecisionPolicyTypeThreshold, policy.DecisionPolicyType)
+	s.Require().Equal("2", policy.Threshold.String())
+	s.Require().EqualValues(86400, policy.VotingPeriod)
+}
+
+func (s *TransactionTestSuite) TestUpdateGroupWeights() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.SaveGroupMembers(ctx, &storage.GroupMember{
+		Height:    1001,
+		Time:      time.Now(),
+		GroupId:   1,
+		AddressId: 2,
+		Weight:    decimal.Zero,
+	})
+	s.Require().NoError(err)
+
+	err = tx.UpdateGroupWeights(ctx, 1)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	group, err := s.storage.Groups.GetByID(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().EqualValues("1", group.TotalWeight.String())
+	s.Require().EqualValues(1, group.MembersCount)
+}
+
+func (s *TransactionTestSuite) TestApplyUpgrades() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	count, err := tx.ApplyUpgrades(ctx, 2999, time.Now(), 3)
+	s.Require().NoError(err)
+	s.Require().EqualValues(0, count)
+
+	count, err = tx.ApplyUpgrades(ctx, 3000, time.Now(), 3)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, count)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	upgrade, err := s.storage.Upgrades.GetByID(ctx, 3)
+	s.Require().NoError(err)
+	s.Require().EqualValues(types.UpgradeStatusApplied, upgrade.Status)
+	s.Require().EqualValues(3, upgrade.AppVersion)
+	s.Require().NotNil(upgrade.ActivationHeight)
+	s.Require().EqualValues(3000, *upgrade.ActivationHeight)
+}
+
+func (s *TransactionTestSuite) TestRollbackUpgrades() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.RollbackUpgrades(ctx, 1000)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	upgrades, err := s.storage.Upgrades.ListWithFilters(ctx, storage.ListUpgradesFilters{
+		Limit: 10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(upgrades, 1)
+	s.Require().EqualValues(1, upgrades[0].Id)
+	s.Require().EqualValues(types.UpgradeStatusScheduled, upgrades[0].Status)
+	s.Require().EqualValues(0, upgrades[0].AppVersion)
+	s.Require().Nil(upgrades[0].ActivationHeight)
+}
+
+func (s *TransactionTestSuite) TestSaveValidatorHistory() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.SaveValidatorHistory(ctx, 1001, time.Now(), 2)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	history, err := s.storage.ValidatorHistory.ByValidator(ctx, 2, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(history, 2)
+	s.Require().EqualValues(1001, history[0].Height)
+	s.Require().Equal("Witval", history[0].Moniker)
+	s.Require().Equal("0.05", history[0].Rate.String())
+	s.Require().Equal("0.2", history[0].MaxRate.String())
+}
+
+func (s *TransactionTestSuite) TestSaveValidatorsZeroRate() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	validator := storage.EmptyValidator()
+	validator.Address = "celestiavaloper189ecvq5avj0wehrcfnagpd5sd8pup9aqmdglmr"
+	validator.RateChanged = true
+
+	count, err := tx.SaveValidators(ctx, &validator)
+	s.Require().NoError(err)
+	s.Require().EqualValues(0, count)
+	s.Require().EqualValues(2, validator.Id)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	saved, err := s.storage.Validator.GetByID(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().Equal("0", saved.Rate.String())
+	s.Require().Equal("1", saved.MinSelfDelegation.String())
+	s.Require().Equal("Witval", saved.Moniker)
+}
+
+func (s *TransactionTestSuite) TestRollbackValidatorHistory() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.RollbackValidatorHistory(ctx, 1000)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	history, err := s.storage.ValidatorHistory.ByValidator(ctx, 1, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(history, 1)
+
+	validator, err := s.storage.Validator.GetByID(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Equal("0.05", validator.Rate.String())
+	s.Require().Equal("Conqueror", validator.Moniker)
+}
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
+)
+
+// Transfer -
+type Transfer struct {
+	*postgres.Table[*storage.Transfer]
+}
+
+// NewTransfer -
+func NewTransfer(db *database.Bun) *Transfer {
+	return &Transfer{
+		Table: postgres.NewTable[*storage.Transfer](db),
+	}
+}
+
+func (t *Transfer) ListWithFilters(ctx context.Context, fltrs storage.TransferFilters) (transfers []storage.Transfer, err error) {
+	query := t.DB().NewSelect().
+		Model((*storage.Transfer)(nil))
+
+	query = limitScope(query, fltrs.Limit)
+	if fltrs.Offset > 0 {
+		query = query.Offset(fltrs.Offset)
+	}
+	query = sortScope(query, "time", fltrs.Sort)
+	query = sortScope(query, "id", fltrs.Sort)
+
+	if fltrs.AddressId != nil {
+		query = query.WhereGroup(" AND ", func(sq *bun.SelectQuery) *bun.SelectQuery {
+			return sq.Where("sender_id = ?", *fltrs.AddressId).WhereOr("receiver_id = ?", *fltrs.AddressId)
+		})
+	}
+	if fltrs.Denom != "" {
+		query = query.Where("denom = ?", fltrs.Denom)
+	}
+	if fltrs.Height > 0 {
+		query = query.Where("height = ?", fltrs.Height)
+	}
+	if fltrs.AmountFrom.IsPositive() {
+		query = query.Where("amount >= ?", fltrs.AmountFrom)
+	}
+	if fltrs.AmountTo.IsPositive() {
+		query = query.Where("amount < ?", fltrs.AmountTo)
+	}
+	if !fltrs.TimeFrom.IsZero() {
+		query = query.Where("time >= ?", fltrs.TimeFrom)
+	}
+	if !fltrs.TimeTo.IsZero() {
+		query = query.Where("time < ?", fltrs.TimeTo)
+	}
+
+	outer := t.DB().NewSelect().
+		TableExpr("(?) as transfer", query).
+		ColumnExpr("transfer.*").
+		ColumnExpr("tx.hash as tx__hash").
+		ColumnExpr("sender.address as sender__address").
+		ColumnExpr("receiver.address as receiver__address").
+		Join("left join tx on tx.id = transfer.tx_id").
+		Join("left join address as sender on sender.id = transfer.sender_id").
+		Join("left join address as receiver on receiver.id = transfer.receiver_id")
+	outer = sortScope(outer, "transfer.time", fltrs.Sort)
+	outer = sortScope(outer, "transfer.id", fltrs.Sort)
+
+	err = outer.Scan(ctx, &transfers)
+	return
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	testsuite "github.com/celenium-io/celestia-indexer/internal/test_suite"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/shopspring/decimal"
+)
+
+func (s *StorageTestSuite) TestTransferListWithFilters() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	transfers, err := s.storage.Transfers.ListWithFilters(ctx, storage.TransferFilters{
+		Limit: 10,
+		Sort:  sdk.SortOrderDesc,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(transfers, 3)
+
+	t := transfers[0]
+	s.Require().EqualValues(2, t.Id)
+	s.Require().EqualValues(1000, t.Height)
+	s.Require().Equal("10", t.Amount.String())
+	s.Require().NotNil(t.Tx)
+	s.Require().NotEmpty(t.Tx.Hash)
+	s.Require().NotNil(t.Sender)
+	s.Require().Equal("celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60", t.Sender.Address)
+	s.Require().NotNil(t.Receiver)
+	s.Require().Equal("celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8", t.Receiver.Address)
+
+	s.Require().Nil(transfers[2].TxId)
+}
+
+func (s *StorageTestSuite) TestTransferListWithFiltersByAddress() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	transfers, err := s.storage.Transfers.ListWithFilters(ctx, storage.TransferFilters{
+		Limit:      10,
+		Sort:       sdk.SortOrderAsc,
+		AddressId:  testsuite.Ptr[uint64](1),
+		Denom:      "utia",
+		AmountFrom: decimal.RequireFromString("100"),
+		TimeFrom:   time.Date(2023, 7, 4, 3, 10, 57, 0, time.UTC),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(transfers, 1)
+	s.Require().EqualValues(1, transfers[0].Id)
+	s.Require().Equal("1000", transfers[0].Amount.String())
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/celenium-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
 	"github.com/celenium-io/celestia-indexer/pkg/types"
 	"github.com/dipdup-net/go-lib/database"
 	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
@@ -97,7 +98,7 @@ func (tx *Tx) Filter(ctx context.Context, fltrs storage.TxFilter) (txs []storage
 func (tx *Tx) ByIdWithRelations(ctx context.Context, id uint64) (transaction storage.Tx, err error) {
 	if err = tx.DB().NewSelect().Model(&transaction).
 		Where("id = ?", id).
-		Relation("Messages").
+		Relation("Messages", topLevelMessages).
 		Scan(ctx); err != nil {
 		return
 	}
@@ -116,7 +117,75 @@ func (tx *Tx) ByIdWithRelations(ctx context.Context, id uint64) (transaction sto
 	return
 }
 
+// ByHeightWithRelations - returns all transactions of the block with signers, messages, message addresses and namespaces
+func (tx *Tx) ByHeightWithRelations(ctx context.Context, height types.Level) (txs []storage.Tx, err error) {
+	if err = tx.DB().NewSelect().Model(&txs).
+		Where("height = ?", height).
+		Relation("Messages", topLevelMessages).
+		Order("position asc").
+		Scan(ctx); err != nil {
+		return
+	}
+	if len(txs) == 0 {
+		return
+	}
+
+	if err = tx.setSigners(ctx, txs); err != nil {
+		return
+	}
+
+	messages := make(map[uint64]*storage.Message)
+	msgIds := make([]uint64, 0)
+	for i := range txs {
+		for j := range txs[i].Messages {
+			msgIds = append(msgIds, txs[i].Messages[j].Id)
+			messages[txs[i].Messages[j].Id] = &txs[i].Messages[j]
+		}
+	}
+	if len(msgIds) == 0 {
+		return
+	}
+
+	var addresses []storage.MsgAddress
+	if err = tx.DB().NewSelect().Model(&addresses).
+		Where("msg_id IN (?)", bun.In(msgIds)).
+		Relation("Address").
+		Scan(ctx); err != nil {
+		return
+	}
+	for i := range addresses {
+		msg, ok := messages[addresses[i].MsgId]
+		if !ok || addresses[i].Address == nil {
+			continue
+		}
+		msg.Addresses = append(msg.Addresses, storage.AddressWithType{
+			Address: *addresses[i].Address,
+			Type:    addresses[i].Type,
+		})
+	}
+
+	var namespaces []storage.NamespaceMessage
+	if err = tx.DB().NewSelect().Model(&namespaces).
+		Where("msg_id IN (?)", bun.In(msgIds)).
+		Relation("Namespace").
+		Scan(ctx); err != nil {
+		return
+	}
+	for i := range namespaces {
+		msg, ok := messages[namespaces[i].MsgId]
+		if !ok || namespaces[i].Namespace == nil {
+			continue
+		}
+		msg.Namespace = append(msg.Namespace, *namespaces[i].Namespace)
+	}
+	return
+}
+
 func (tx *Tx) ByAddress(ctx context.Context, addressId uint64, fltrs storage.TxFilter) ([]storage.Tx, error) {
+	if fltrs.FeePaid {
+		return tx.paidByAddress(ctx, addressId, fltrs)
+	}
+
 	var relations []storage.Signer
 	query := tx.DB().NewSelect().
 		Model(&relations).
@@ -137,6 +206,33 @@ func (tx *Tx) ByAddress(ctx context.Context, addressId uint64, fltrs storage.TxF
 	return transactions, nil
 }
 
+// paidByAddress - returns transactions which fee was paid by the address: as a fee granter or as a fee payer without granter
+func (tx *Tx) paidByAddress(ctx context.Context, addressId uint64, fltrs storage.TxFilter) ([]storage.Tx, error) {
+	var relations []storage.TxAddress
+	query := tx.DB().NewSelect().
+		Model(&relations).
+		Where("address_id = ?", addressId).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("tx_address.type = ?", storageTypes.TxAddressTypeFeeGranter).
+				WhereOr("tx_address.type = ? AND NOT EXISTS (SELECT 1 FROM tx_address AS granter WHERE granter.tx_id = tx_address.tx_id AND granter.type = ?)", storageTypes.TxAddressTypeFeePayer, storageTypes.TxAddressTypeFeeGranter)
+		}).
+		Relation("Tx").
+		Offset(fltrs.Offset)
+
+	query = txFilter(query, fltrs)
+
+	if err := query.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	transactions := make([]storage.Tx, len(relations))
+	for i := range relations {
+		transactions[i] = *relations[i].Tx
+	}
+	return transactions, nil
+}
+
 func (tx *Tx) Genesis(ctx context.Context, limit, offset int, sortOrder sdk.SortOrder) (txs []storage.Tx, err error) {
 	query := tx.DB().NewSelect().Model(&txs).Offset(offset).Where("hash IS NULL")
 	query = limitScope(query, limit)
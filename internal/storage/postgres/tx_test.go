@@ -252,6 +252,30 @@ func (s *StorageTestSuite) TestTxByIdWithRelations() {
 	s.Require().Len(tx.Signers, 2)
 }
 
+func (s *StorageTestSuite) TestTxByHeightWithRelations() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	txs, err := s.storage.Tx.ByHeightWithRelations(ctx, 1000)
+	s.Require().NoError(err)
+	s.Require().Len(txs, 2)
+
+	tx := txs[0]
+	s.Require().EqualValues(1, tx.Id)
+	s.Require().Len(tx.Signers, 1)
+	s.Require().Len(tx.Messages, 2)
+	s.Require().Len(tx.Messages[0].Addresses, 2)
+	s.Require().Len(tx.Messages[1].Namespace, 1)
+	s.Require().EqualValues(1, tx.Messages[1].Namespace[0].Id)
+
+	tx = txs[1]
+	s.Require().EqualValues(2, tx.Id)
+	s.Require().Len(tx.Signers, 2)
+	s.Require().Len(tx.Messages, 2)
+	s.Require().Len(tx.Messages[0].Namespace, 1)
+	s.Require().EqualValues(2, tx.Messages[0].Namespace[0].Id)
+}
+
 func (s *StorageTestSuite) TestTxGenesis() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
@@ -314,6 +338,27 @@ func (s *StorageTestSuite) TestTxByAddressAndTime() {
 	s.Require().Len(txs, 2)
 }
 
+func (s *StorageTestSuite) TestTxByAddressFeePaid() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	txs, err := s.storage.Tx.ByAddress(ctx, 2, storage.TxFilter{
+		Limit:   10,
+		FeePaid: true,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(txs, 1)
+	s.Require().EqualValues(1, txs[0].Id)
+
+	txs, err = s.storage.Tx.ByAddress(ctx, 1, storage.TxFilter{
+		Limit:   10,
+		FeePaid: true,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(txs, 1)
+	s.Require().EqualValues(2, txs[0].Id)
+}
+
 func (s *StorageTestSuite) TestTxGas() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
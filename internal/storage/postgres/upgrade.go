@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
+)
+
+// Upgrade -
+type Upgrade struct {
+	*postgres.Table[*storage.Upgrade]
+}
+
+// NewUpgrade -
+func NewUpgrade(db *database.Bun) *Upgrade {
+	return &Upgrade{
+		Table: postgres.NewTable[*storage.Upgrade](db),
+	}
+}
+
+func (u *Upgrade) ListWithFilters(ctx context.Context, fltrs storage.ListUpgradesFilters) (upgrades []storage.Upgrade, err error) {
+	query := u.DB().NewSelect().
+		Model(&upgrades)
+
+	query = limitScope(query, fltrs.Limit)
+	if fltrs.Offset > 0 {
+		query = query.Offset(fltrs.Offset)
+	}
+	query = sortScope(query, "id", fltrs.Sort)
+
+	if len(fltrs.Status) > 0 {
+		query = query.Where("status IN (?)", bun.In(fltrs.Status))
+	}
+
+	err = query.Scan(ctx)
+	return
+}
+
+func (u *Upgrade) Pending(ctx context.Context) (upgrade storage.Upgrade, err error) {
+	err = u.DB().NewSelect().
+		Model(&upgrade).
+		Where("status = ?", types.UpgradeStatusScheduled).
+		Order("id desc").
+		Limit(1).
+		Scan(ctx)
+	return
+}
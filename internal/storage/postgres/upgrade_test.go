@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+)
+
+func (s *StorageTestSuite) TestUpgradeListWithFilters() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	upgrades, err := s.storage.Upgrades.ListWithFilters(ctx, storage.ListUpgradesFilters{
+		Limit: 10,
+		Sort:  sdk.SortOrderAsc,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(upgrades, 3)
+	s.Require().EqualValues(1, upgrades[0].Id)
+	s.Require().EqualValues("v2", upgrades[0].Name)
+	s.Require().EqualValues(types.UpgradeStatusApplied, upgrades[0].Status)
+	s.Require().EqualValues(2, upgrades[0].AppVersion)
+	s.Require().NotNil(upgrades[0].ActivationHeight)
+	s.Require().EqualValues(1000, *upgrades[0].ActivationHeight)
+
+	upgrades, err = s.storage.Upgrades.ListWithFilters(ctx, storage.ListUpgradesFilters{
+		Limit:  10,
+		Sort:   sdk.SortOrderDesc,
+		Status: []types.UpgradeStatus{types.UpgradeStatusCancelled},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(upgrades, 1)
+	s.Require().EqualValues(2, upgrades[0].Id)
+	s.Require().NotNil(upgrades[0].CancelHeight)
+	s.Require().EqualValues(1000, *upgrades[0].CancelHeight)
+}
+
+func (s *StorageTestSuite) TestUpgradePending() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	upgrade, err := s.storage.Upgrades.Pending(ctx)
+	s.Require().NoError(err)
+	s.Require().EqualValues(3, upgrade.Id)
+	s.Require().EqualValues(3000, upgrade.PlanHeight)
+	s.Require().EqualValues(types.UpgradeStatusScheduled, upgrade.Status)
+}
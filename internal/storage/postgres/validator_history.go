@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+// ValidatorHistory -
+type ValidatorHistory struct {
+	*postgres.Table[*storage.ValidatorHistory]
+}
+
+// NewValidatorHistory -
+func NewValidatorHistory(db *database.Bun) *ValidatorHistory {
+	return &ValidatorHistory{
+		Table: postgres.NewTable[*storage.ValidatorHistory](db),
+	}
+}
+
+func (vh *ValidatorHistory) ByValidator(ctx context.Context, id uint64, limit, offset int) (history []storage.ValidatorHistory, err error) {
+	query := vh.DB().NewSelect().
+		Model(&history).
+		Where("validator_id = ?", id).
+		Order("id desc")
+
+	query = limitScope(query, limit)
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+
+	err = query.Scan(ctx)
+	return
+}
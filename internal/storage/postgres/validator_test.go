@@ -55,3 +55,19 @@ func (s *StorageTestSuite) TestJailedCOunt() {
 	s.Require().NoError(err)
 	s.Require().EqualValues(0, count)
 }
+
+func (s *StorageTestSuite) TestValidatorHistoryByValidator() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	history, err := s.storage.ValidatorHistory.ByValidator(ctx, 1, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(history, 2)
+
+	s.Require().EqualValues(2, history[0].Id)
+	s.Require().EqualValues(1000, history[0].Height)
+	s.Require().EqualValues(1, history[0].ValidatorId)
+	s.Require().Equal("Conqueror", history[0].Moniker)
+	s.Require().Equal("0.07", history[0].Rate.String())
+	s.Require().Equal("0.05", history[1].Rate.String())
+}
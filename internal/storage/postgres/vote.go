@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
+)
+
+// Vote -
+type Vote struct {
+	*postgres.Table[*storage.Vote]
+}
+
+// NewVote -
+func NewVote(db *database.Bun) *Vote {
+	return &Vote{
+		Table: postgres.NewTable[*storage.Vote](db),
+	}
+}
+
+func (v *Vote) withRelations(ctx context.Context, query *bun.SelectQuery) (votes []storage.Vote, err error) {
+	err = v.DB().NewSelect().
+		TableExpr("(?) as vote", query).
+		ColumnExpr("vote.*").
+		ColumnExpr("address.address as voter__address").
+		ColumnExpr("validator.id as validator__id, validator.cons_address as validator__cons_address, validator.moniker as validator__moniker").
+		Join("left join address on address.id = vote.voter_id").
+		Join("left join validator on validator.id = vote.validator_id").
+		OrderExpr("vote.id desc").
+		Scan(ctx, &votes)
+	return
+}
+
+func (v *Vote) ByProposalId(ctx context.Context, proposalId uint64, fltrs storage.VoteFilters) ([]storage.Vote, error) {
+	query := v.DB().NewSelect().
+		Model((*storage.Vote)(nil)).
+		Where("proposal_id = ?", proposalId)
+	query = voteFilters(query, fltrs)
+	return v.withRelations(ctx, query)
+}
+
+func (v *Vote) ByVoterId(ctx context.Context, voterId uint64, fltrs storage.VoteFilters) ([]storage.Vote, error) {
+	query := v.DB().NewSelect().
+		Model((*storage.Vote)(nil)).
+		Where("voter_id = ?", voterId)
+	query = voteFilters(query, fltrs)
+	return v.withRelations(ctx, query)
+}
+
+func (v *Vote) ByValidatorId(ctx context.Context, validatorId uint64, fltrs storage.VoteFilters) ([]storage.Vote, error) {
+	query := v.DB().NewSelect().
+		Model((*storage.Vote)(nil)).
+		Where("validator_id = ?", validatorId)
+	query = voteFilters(query, fltrs)
+	return v.withRelations(ctx, query)
+}
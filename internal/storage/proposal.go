@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+type ListProposalFilters struct {
+	Limit      int
+	Offset     int
+	Sort       storage.SortOrder
+	ProposerId uint64
+	Status     []types.ProposalStatus
+	Type       []types.ProposalType
+}
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IProposal interface {
+	storage.Table[*Proposal]
+
+	ListWithFilters(ctx context.Context, filters ListProposalFilters) ([]Proposal, error)
+	ById(ctx context.Context, id uint64) (Proposal, error)
+}
+
+// Proposal -
+type Proposal struct {
+	bun.BaseModel `bun:"proposal" comment:"Table with governance proposals."`
+
+	Id               uint64               `bun:"id,pk,notnull"                        comment:"Proposal identity from the chain"`
+	Height           pkgTypes.Level       `bun:"height,notnull"                       comment:"The number (height) of block when proposal was submitted"`
+	ProposerId       uint64               `bun:"proposer_id"                          comment:"Proposer internal identity"`
+	CreatedAt        time.Time            `bun:"created_at,notnull"                   comment:"Submission time"`
+	ActivationHeight *pkgTypes.Level      `bun:"activation_height"                    comment:"Height of block when voting period was started"`
+	ActivationTime   *time.Time           `bun:"activation_time"                      comment:"Time when voting period was started"`
+	EndHeight        *pkgTypes.Level      `bun:"end_height"                           comment:"Height of block when proposal was finished"`
+	EndTime          *time.Time           `bun:"end_time"                             comment:"Time when proposal was finished"`
+	Status           types.ProposalStatus `bun:"status,type:proposal_status,nullzero" comment:"Proposal status"`
+	Type             types.ProposalType   `bun:"type,type:proposal_type,nullzero"     comment:"Proposal type"`
+	Title            string               `bun:"title"                                comment:"Title"`
+	Description      string               `bun:"description"                          comment:"Description"`
+	Metadata         string               `bun:"metadata"                             comment:"Metadata"`
+	Changes          json.RawMessage      `bun:"changes,type:jsonb,nullzero"          comment:"Proposal changes"`
+	Deposit          decimal.Decimal      `bun:"deposit,type:numeric"                 comment:"Total deposit"`
+	VotesCount       int64                `bun:"votes_count"                          comment:"Count of votes"`
+	Yes              int64                `bun:"yes"                                  comment:"Count of yes votes"`
+	No               int64                `bun:"no"                                   comment:"Count of no votes"`
+	NoWithVeto       int64                `bun:"no_with_veto"                         comment:"Count of no with veto votes"`
+	Abstain          int64                `bun:"abstain"                              comment:"Count of abstain votes"`
+
+	Proposer *Address `bun:"rel:belongs-to,join:proposer_id=id"`
+}
+
+// TableName -
+func (Proposal) TableName() string {
+	return "proposal"
+}
@@ -26,6 +26,7 @@ type IRollup interface {
 	Count(ctx context.Context) (int64, error)
 	Distribution(ctx context.Context, rollupId uint64, series, groupBy string) (items []DistributionItem, err error)
 	BySlug(ctx context.Context, slug string) (Rollup, error)
+	Stacks(ctx context.Context) ([]RollupStack, error)
 }
 
 // Rollup -
@@ -68,6 +69,15 @@ func (r Rollup) IsEmpty() bool {
 		r.Links == nil
 }
 
+// RollupStack - stack of the rollup which pushes blobs from the address to the namespace.
+// Namespace is empty if the rollup pushes blobs to any namespace.
+type RollupStack struct {
+	Stack       string `bun:"stack"`
+	Address     string `bun:"address"`
+	Version     byte   `bun:"version"`
+	NamespaceId []byte `bun:"namespace_id"`
+}
+
 type RollupWithStats struct {
 	Rollup
 	RollupStats
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type ISlash interface {
+	storage.Table[*Slash]
+
+	ByValidator(ctx context.Context, id uint64, limit, offset int) ([]Slash, error)
+}
+
+// Slash - validator slashing for double sign or downtime
+type Slash struct {
+	bun.BaseModel `bun:"slash" comment:"Table with validator slashing events."`
+
+	Id               uint64          `bun:"id,pk,notnull,autoincrement" comment:"Unique internal id"`
+	Height           pkgTypes.Level  `bun:"height,notnull"              comment:"The number (height) of block when validator was slashed"`
+	Time             time.Time       `bun:"time,pk,notnull"             comment:"The time of block when validator was slashed"`
+	ValidatorId      uint64          `bun:"validator_id,notnull"        comment:"Internal validator id"`
+	TxId             *uint64         `bun:"tx_id"                       comment:"Transaction id of submitted evidence"`
+	JailId           *uint64         `bun:"jail_id"                     comment:"Internal id of the jail caused by slashing"`
+	InfractionHeight pkgTypes.Level  `bun:"infraction_height"           comment:"The number (height) of block when infraction was committed"`
+	Reason           string          `bun:"reason"                      comment:"Reason"`
+	Power            decimal.Decimal `bun:"power,type:numeric"          comment:"Validator voting power at infraction height"`
+	Fraction         decimal.Decimal `bun:"fraction,type:numeric"       comment:"Slashed fraction of stake"`
+	Amount           decimal.Decimal `bun:"amount,type:numeric"         comment:"Burned coins"`
+
+	Validator *Validator `bun:"rel:belongs-to,join:validator_id=id"`
+	Tx        *Tx        `bun:"rel:belongs-to,join:tx_id=id"`
+}
+
+// TableName -
+func (Slash) TableName() string {
+	return "slash"
+}
@@ -41,6 +41,7 @@ type State struct {
 	TotalStake      decimal.Decimal `bun:"total_stake,type:numeric"  comment:"Total stake"`
 
 	TotalVotingPower decimal.Decimal `bun:"-"`
+	UpgradePending   bool            `bun:"-"`
 }
 
 // TableName -
@@ -149,6 +149,7 @@ const (
 	SeriesBytesInBlock  = "bytes_in_block"
 	SeriesRewards       = "rewards"
 	SeriesCommissions   = "commissions"
+	SeriesCommission    = "commission"
 	SeriesFlow          = "flow"
 )
 
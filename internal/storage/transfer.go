@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+type TransferFilters struct {
+	Limit      int
+	Offset     int
+	Sort       storage.SortOrder
+	AddressId  *uint64
+	Denom      string
+	Height     uint64
+	AmountFrom decimal.Decimal
+	AmountTo   decimal.Decimal
+	TimeFrom   time.Time
+	TimeTo     time.Time
+}
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type ITransfer interface {
+	storage.Table[*Transfer]
+
+	ListWithFilters(ctx context.Context, fltrs TransferFilters) ([]Transfer, error)
+}
+
+// Transfer - transfer of tokens between accounts parsed from `transfer` event
+type Transfer struct {
+	bun.BaseModel `bun:"transfer" comment:"Table with token transfers."`
+
+	Id         uint64          `bun:"id,pk,notnull,autoincrement" comment:"Unique internal identity"`
+	Height     pkgTypes.Level  `bun:"height,notnull"              comment:"The number (height) of block"`
+	Time       time.Time       `bun:"time,pk,notnull"             comment:"The time of block"`
+	TxId       *uint64         `bun:"tx_id"                       comment:"Internal identity of transaction. Null for transfers made in begin or end block"`
+	SenderId   uint64          `bun:"sender_id,notnull"           comment:"Internal identity of sender address"`
+	ReceiverId uint64          `bun:"receiver_id,notnull"         comment:"Internal identity of receiver address"`
+	Amount     decimal.Decimal `bun:"amount,type:numeric"         comment:"Transferred amount"`
+	Denom      string          `bun:"denom"                       comment:"Denomination of transferred tokens"`
+
+	Tx       *Tx      `bun:"rel:belongs-to,join:tx_id=id"`
+	Sender   *Address `bun:"rel:belongs-to,join:sender_id=id"`
+	Receiver *Address `bun:"rel:belongs-to,join:receiver_id=id"`
+}
+
+// TableName -
+func (Transfer) TableName() string {
+	return "transfer"
+}
@@ -23,6 +23,7 @@ type ITx interface {
 	IdByHash(ctx context.Context, hash []byte) (uint64, error)
 	Filter(ctx context.Context, fltrs TxFilter) ([]Tx, error)
 	ByIdWithRelations(ctx context.Context, id uint64) (Tx, error)
+	ByHeightWithRelations(ctx context.Context, height pkgTypes.Level) ([]Tx, error)
 	ByAddress(ctx context.Context, addressId uint64, fltrs TxFilter) ([]Tx, error)
 	Genesis(ctx context.Context, limit, offset int, sortOrder storage.SortOrder) ([]Tx, error)
 	Gas(ctx context.Context, height pkgTypes.Level, ts time.Time) ([]Gas, error)
@@ -52,6 +53,7 @@ type TxFilter struct {
 	TimeFrom             time.Time
 	TimeTo               time.Time
 	WithMessages         bool
+	FeePaid              bool
 }
 
 // Tx -
@@ -80,6 +82,8 @@ type Tx struct {
 	Events   []Event   `bun:"rel:has-many"`
 
 	Signers    []Address `bun:"-"`
+	FeePayer   *Address  `bun:"-"`
+	FeeGranter *Address  `bun:"-"`
 	BlobsSize  int64     `bun:"-"`
 	BytesSize  int64     `bun:"-"`
 	BlobsCount int       `bun:"-"`
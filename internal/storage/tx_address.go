@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"fmt"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/uptrace/bun"
+)
+
+type TxAddress struct {
+	bun.BaseModel `bun:"tx_address" comment:"Table with relation tx to fee payer and fee granter"`
+
+	AddressId uint64              `bun:"address_id,pk"            comment:"Address internal id"`
+	TxId      uint64              `bun:"tx_id,pk"                 comment:"Transaction internal id"`
+	Type      types.TxAddressType `bun:",pk,type:tx_address_type" comment:"The reason why address link to transaction"`
+
+	Address *Address `bun:"rel:belongs-to,join:address_id=id"`
+	Tx      *Tx      `bun:"rel:belongs-to,join:tx_id=id"`
+}
+
+func (TxAddress) TableName() string {
+	return "tx_address"
+}
+
+func (t TxAddress) String() string {
+	return fmt.Sprintf("%d_%d_%s", t.AddressId, t.TxId, t.Type)
+}
@@ -0,0 +1,14 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package types
+
+// swagger:enum AttestationType
+/*
+	ENUM(
+		valset,
+		data_commitment
+	)
+*/
+//go:generate go-enum --marshal --sql --values --names
+type AttestationType string
@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by go-enum DO NOT EDIT.
+// Version: 0.5.7
+// Revision: bf63e108589bbd2327b13ec2c5da532aad234029
+// Build Date: 2023-07-25T23:27:55Z
+// Built By: goreleaser
+
+package types
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// AttestationTypeValset is a AttestationType of type valset.
+	AttestationTypeValset AttestationType = "valset"
+	// AttestationTypeDataCommitment is a AttestationType of type data_commitment.
+	AttestationTypeDataCommitment AttestationType = "data_commitment"
+)
+
+var ErrInvalidAttestationType = fmt.Errorf("not a valid AttestationType, try [%s]", strings.Join(_AttestationTypeNames, ", "))
+
+var _AttestationTypeNames = []string{
+	string(AttestationTypeValset),
+	string(AttestationTypeDataCommitment),
+}
+
+// AttestationTypeNames returns a list of possible string values of AttestationType.
+func AttestationTypeNames() []string {
+	tmp := make([]string, len(_AttestationTypeNames))
+	copy(tmp, _AttestationTypeNames)
+	return tmp
+}
+
+// AttestationTypeValues returns a list of the values for AttestationType
+func AttestationTypeValues() []AttestationType {
+	return []AttestationType{
+		AttestationTypeValset,
+		AttestationTypeDataCommitment,
+	}
+}
+
+// String implements the Stringer interface.
+func (x AttestationType) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x AttestationType) IsValid() bool {
+	_, err := ParseAttestationType(string(x))
+	return err == nil
+}
+
+var _AttestationTypeValue = map[string]AttestationType{
+	"valset":          AttestationTypeValset,
+	"data_commitment": AttestationTypeDataCommitment,
+}
+
+// ParseAttestationType attempts to convert a string to a AttestationType.
+func ParseAttestationType(name string) (AttestationType, error) {
+	if x, ok := _AttestationTypeValue[name]; ok {
+		return x, nil
+	}
+	return AttestationType(""), fmt.Errorf("%s is %w", name, ErrInvalidAttestationType)
+}
+
+// MarshalText implements the text marshaller method.
+func (x AttestationType) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *AttestationType) UnmarshalText(text []byte) error {
+	tmp, err := ParseAttestationType(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+var errAttestationTypeNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *AttestationType) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = AttestationType("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseAttestationType(v)
+	case []byte:
+		*x, err = ParseAttestationType(string(v))
+	case AttestationType:
+		*x = v
+	case *AttestationType:
+		if v == nil {
+			return errAttestationTypeNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errAttestationTypeNilPtr
+		}
+		*x, err = ParseAttestationType(*v)
+	default:
+		return errors.New("invalid type for AttestationType")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x AttestationType) Value() (driver.Value, error) {
+	return x.String(), nil
+}
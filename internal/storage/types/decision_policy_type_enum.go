@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by go-enum DO NOT EDIT.
+// Version: 0.5.7
+// Revision: bf63e108589bbd2327b13ec2c5da532aad234029
+// Build Date: 2023-07-25T23:27:55Z
+// Built By: goreleaser
+
+package types
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// DecisionPolicyTypeThreshold is a DecisionPolicyType of type threshold.
+	DecisionPolicyTypeThreshold DecisionPolicyType = "threshold"
+	// DecisionPolicyTypePercentage is a DecisionPolicyType of type percentage.
+	DecisionPolicyTypePercentage DecisionPolicyType = "percentage"
+)
+
+var ErrInvalidDecisionPolicyType = fmt.Errorf("not a valid DecisionPolicyType, try [%s]", strings.Join(_DecisionPolicyTypeNames, ", "))
+
+var _DecisionPolicyTypeNames = []string{
+	string(DecisionPolicyTypeThreshold),
+	string(DecisionPolicyTypePercentage),
+}
+
+// DecisionPolicyTypeNames returns a list of possible string values of DecisionPolicyType.
+func DecisionPolicyTypeNames() []string {
+	tmp := make([]string, len(_DecisionPolicyTypeNames))
+	copy(tmp, _DecisionPolicyTypeNames)
+	return tmp
+}
+
+// DecisionPolicyTypeValues returns a list of the values for DecisionPolicyType
+func DecisionPolicyTypeValues() []DecisionPolicyType {
+	return []DecisionPolicyType{
+		DecisionPolicyTypeThreshold,
+		DecisionPolicyTypePercentage,
+	}
+}
+
+// String implements the Stringer interface.
+func (x DecisionPolicyType) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x DecisionPolicyType) IsValid() bool {
+	_, err := ParseDecisionPolicyType(string(x))
+	return err == nil
+}
+
+var _DecisionPolicyTypeValue = map[string]DecisionPolicyType{
+	"threshold":  DecisionPolicyTypeThreshold,
+	"percentage": DecisionPolicyTypePercentage,
+}
+
+// ParseDecisionPolicyType attempts to convert a string to a DecisionPolicyType.
+func ParseDecisionPolicyType(name string) (DecisionPolicyType, error) {
+	if x, ok := _DecisionPolicyTypeValue[name]; ok {
+		return x, nil
+	}
+	return DecisionPolicyType(""), fmt.Errorf("%s is %w", name, ErrInvalidDecisionPolicyType)
+}
+
+// MarshalText implements the text marshaller method.
+func (x DecisionPolicyType) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *DecisionPolicyType) UnmarshalText(text []byte) error {
+	tmp, err := ParseDecisionPolicyType(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+var errDecisionPolicyTypeNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *DecisionPolicyType) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = DecisionPolicyType("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseDecisionPolicyType(v)
+	case []byte:
+		*x, err = ParseDecisionPolicyType(string(v))
+	case DecisionPolicyType:
+		*x = v
+	case *DecisionPolicyType:
+		if v == nil {
+			return errDecisionPolicyTypeNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errDecisionPolicyTypeNilPtr
+		}
+		*x, err = ParseDecisionPolicyType(*v)
+	default:
+		return errors.New("invalid type for DecisionPolicyType")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x DecisionPolicyType) Value() (driver.Value, error) {
+	return x.String(), nil
+}
@@ -73,7 +73,20 @@ package types
 
 		cosmos.authz.v1beta1.EventRevoke,
 		cosmos.authz.v1.EventRevoke,
-		cancel_unbonding_delegation
+		cancel_unbonding_delegation,
+		active_proposal,
+		inactive_proposal,
+
+		cosmos.group.v1.EventCreateGroup,
+		cosmos.group.v1.EventUpdateGroup,
+		cosmos.group.v1.EventCreateGroupPolicy,
+		cosmos.group.v1.EventUpdateGroupPolicy,
+		cosmos.group.v1.EventSubmitProposal,
+		cosmos.group.v1.EventWithdrawProposal,
+		cosmos.group.v1.EventVote,
+		cosmos.group.v1.EventExec,
+		cosmos.group.v1.EventLeaveGroup,
+		cosmos.group.v1.EventProposalPruned
 	)
 */
 //go:generate go-enum --marshal --sql --values --names
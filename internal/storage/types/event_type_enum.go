@@ -127,6 +127,30 @@ const (
 	EventTypeCosmosauthzv1EventRevoke EventType = "cosmos.authz.v1.EventRevoke"
 	// EventTypeCancelUnbondingDelegation is a EventType of type cancel_unbonding_delegation.
 	EventTypeCancelUnbondingDelegation EventType = "cancel_unbonding_delegation"
+	// EventTypeActiveProposal is a EventType of type active_proposal.
+	EventTypeActiveProposal EventType = "active_proposal"
+	// EventTypeInactiveProposal is a EventType of type inactive_proposal.
+	EventTypeInactiveProposal EventType = "inactive_proposal"
+	// EventTypeCosmosgroupv1EventCreateGroup is a EventType of type cosmos.group.v1.EventCreateGroup.
+	EventTypeCosmosgroupv1EventCreateGroup EventType = "cosmos.group.v1.EventCreateGroup"
+	// EventTypeCosmosgroupv1EventUpdateGroup is a EventType of type cosmos.group.v1.EventUpdateGroup.
+	EventTypeCosmosgroupv1EventUpdateGroup EventType = "cosmos.group.v1.EventUpdateGroup"
+	// EventTypeCosmosgroupv1EventCreateGroupPolicy is a EventType of type cosmos.group.v1.EventCreateGroupPolicy.
+	EventTypeCosmosgroupv1EventCreateGroupPolicy EventType = "cosmos.group.v1.EventCreateGroupPolicy"
+	// EventTypeCosmosgroupv1EventUpdateGroupPolicy is a EventType of type cosmos.group.v1.EventUpdateGroupPolicy.
+	EventTypeCosmosgroupv1EventUpdateGroupPolicy EventType = "cosmos.group.v1.EventUpdateGroupPolicy"
+	// EventTypeCosmosgroupv1EventSubmitProposal is a EventType of type cosmos.group.v1.EventSubmitProposal.
+	EventTypeCosmosgroupv1EventSubmitProposal EventType = "cosmos.group.v1.EventSubmitProposal"
+	// EventTypeCosmosgroupv1EventWithdrawProposal is a EventType of type cosmos.group.v1.EventWithdrawProposal.
+	EventTypeCosmosgroupv1EventWithdrawProposal EventType = "cosmos.group.v1.EventWithdrawProposal"
+	// EventTypeCosmosgroupv1EventVote is a EventType of type cosmos.group.v1.EventVote.
+	EventTypeCosmosgroupv1EventVote EventType = "cosmos.group.v1.EventVote"
+	// EventTypeCosmosgroupv1EventExec is a EventType of type cosmos.group.v1.EventExec.
+	EventTypeCosmosgroupv1EventExec EventType = "cosmos.group.v1.EventExec"
+	// EventTypeCosmosgroupv1EventLeaveGroup is a EventType of type cosmos.group.v1.EventLeaveGroup.
+	EventTypeCosmosgroupv1EventLeaveGroup EventType = "cosmos.group.v1.EventLeaveGroup"
+	// EventTypeCosmosgroupv1EventProposalPruned is a EventType of type cosmos.group.v1.EventProposalPruned.
+	EventTypeCosmosgroupv1EventProposalPruned EventType = "cosmos.group.v1.EventProposalPruned"
 )
 
 var ErrInvalidEventType = fmt.Errorf("not a valid EventType, try [%s]", strings.Join(_EventTypeNames, ", "))
@@ -187,6 +211,18 @@ var _EventTypeNames = []string{
 	string(EventTypeCosmosauthzv1beta1EventRevoke),
 	string(EventTypeCosmosauthzv1EventRevoke),
 	string(EventTypeCancelUnbondingDelegation),
+	string(EventTypeActiveProposal),
+	string(EventTypeInactiveProposal),
+	string(EventTypeCosmosgroupv1EventCreateGroup),
+	string(EventTypeCosmosgroupv1EventUpdateGroup),
+	string(EventTypeCosmosgroupv1EventCreateGroupPolicy),
+	string(EventTypeCosmosgroupv1EventUpdateGroupPolicy),
+	string(EventTypeCosmosgroupv1EventSubmitProposal),
+	string(EventTypeCosmosgroupv1EventWithdrawProposal),
+	string(EventTypeCosmosgroupv1EventVote),
+	string(EventTypeCosmosgroupv1EventExec),
+	string(EventTypeCosmosgroupv1EventLeaveGroup),
+	string(EventTypeCosmosgroupv1EventProposalPruned),
 }
 
 // EventTypeNames returns a list of possible string values of EventType.
@@ -254,6 +290,18 @@ func EventTypeValues() []EventType {
 		EventTypeCosmosauthzv1beta1EventRevoke,
 		EventTypeCosmosauthzv1EventRevoke,
 		EventTypeCancelUnbondingDelegation,
+		EventTypeActiveProposal,
+		EventTypeInactiveProposal,
+		EventTypeCosmosgroupv1EventCreateGroup,
+		EventTypeCosmosgroupv1EventUpdateGroup,
+		EventTypeCosmosgroupv1EventCreateGroupPolicy,
+		EventTypeCosmosgroupv1EventUpdateGroupPolicy,
+		EventTypeCosmosgroupv1EventSubmitProposal,
+		EventTypeCosmosgroupv1EventWithdrawProposal,
+		EventTypeCosmosgroupv1EventVote,
+		EventTypeCosmosgroupv1EventExec,
+		EventTypeCosmosgroupv1EventLeaveGroup,
+		EventTypeCosmosgroupv1EventProposalPruned,
 	}
 }
 
@@ -270,61 +318,73 @@ func (x EventType) IsValid() bool {
 }
 
 var _EventTypeValue = map[string]EventType{
-	"unknown":                           EventTypeUnknown,
-	"coin_received":                     EventTypeCoinReceived,
-	"coinbase":                          EventTypeCoinbase,
-	"coin_spent":                        EventTypeCoinSpent,
-	"burn":                              EventTypeBurn,
-	"mint":                              EventTypeMint,
-	"message":                           EventTypeMessage,
-	"proposer_reward":                   EventTypeProposerReward,
-	"rewards":                           EventTypeRewards,
-	"commission":                        EventTypeCommission,
-	"liveness":                          EventTypeLiveness,
-	"transfer":                          EventTypeTransfer,
-	"celestia.blob.v1.EventPayForBlobs": EventTypeCelestiablobv1EventPayForBlobs,
-	"redelegate":                        EventTypeRedelegate,
-	"AttestationRequest":                EventTypeAttestationRequest,
-	"withdraw_rewards":                  EventTypeWithdrawRewards,
-	"withdraw_commission":               EventTypeWithdrawCommission,
-	"set_withdraw_address":              EventTypeSetWithdrawAddress,
-	"create_validator":                  EventTypeCreateValidator,
-	"delegate":                          EventTypeDelegate,
-	"edit_validator":                    EventTypeEditValidator,
-	"unbond":                            EventTypeUnbond,
-	"tx":                                EventTypeTx,
-	"complete_redelegation":             EventTypeCompleteRedelegation,
-	"complete_unbonding":                EventTypeCompleteUnbonding,
-	"use_feegrant":                      EventTypeUseFeegrant,
-	"revoke_feegrant":                   EventTypeRevokeFeegrant,
-	"set_feegrant":                      EventTypeSetFeegrant,
-	"update_feegrant":                   EventTypeUpdateFeegrant,
-	"slash":                             EventTypeSlash,
-	"proposal_vote":                     EventTypeProposalVote,
-	"proposal_deposit":                  EventTypeProposalDeposit,
-	"submit_proposal":                   EventTypeSubmitProposal,
-	"cosmos.authz.v1beta1.EventGrant":   EventTypeCosmosauthzv1beta1EventGrant,
-	"send_packet":                       EventTypeSendPacket,
-	"ibc_transfer":                      EventTypeIbcTransfer,
-	"fungible_token_packet":             EventTypeFungibleTokenPacket,
-	"acknowledge_packet":                EventTypeAcknowledgePacket,
-	"create_client":                     EventTypeCreateClient,
-	"update_client":                     EventTypeUpdateClient,
-	"connection_open_try":               EventTypeConnectionOpenTry,
-	"connection_open_init":              EventTypeConnectionOpenInit,
-	"connection_open_confirm":           EventTypeConnectionOpenConfirm,
-	"connection_open_ack":               EventTypeConnectionOpenAck,
-	"channel_open_try":                  EventTypeChannelOpenTry,
-	"channel_open_init":                 EventTypeChannelOpenInit,
-	"channel_open_confirm":              EventTypeChannelOpenConfirm,
-	"channel_open_ack":                  EventTypeChannelOpenAck,
-	"recv_packet":                       EventTypeRecvPacket,
-	"write_acknowledgement":             EventTypeWriteAcknowledgement,
-	"timeout":                           EventTypeTimeout,
-	"timeout_packet":                    EventTypeTimeoutPacket,
-	"cosmos.authz.v1beta1.EventRevoke":  EventTypeCosmosauthzv1beta1EventRevoke,
-	"cosmos.authz.v1.EventRevoke":       EventTypeCosmosauthzv1EventRevoke,
-	"cancel_unbonding_delegation":       EventTypeCancelUnbondingDelegation,
+	"unknown":                                EventTypeUnknown,
+	"coin_received":                          EventTypeCoinReceived,
+	"coinbase":                               EventTypeCoinbase,
+	"coin_spent":                             EventTypeCoinSpent,
+	"burn":                                   EventTypeBurn,
+	"mint":                                   EventTypeMint,
+	"message":                                EventTypeMessage,
+	"proposer_reward":                        EventTypeProposerReward,
+	"rewards":                                EventTypeRewards,
+	"commission":                             EventTypeCommission,
+	"liveness":                               EventTypeLiveness,
+	"transfer":                               EventTypeTransfer,
+	"celestia.blob.v1.EventPayForBlobs":      EventTypeCelestiablobv1EventPayForBlobs,
+	"redelegate":                             EventTypeRedelegate,
+	"AttestationRequest":                     EventTypeAttestationRequest,
+	"withdraw_rewards":                       EventTypeWithdrawRewards,
+	"withdraw_commission":                    EventTypeWithdrawCommission,
+	"set_withdraw_address":                   EventTypeSetWithdrawAddress,
+	"create_validator":                       EventTypeCreateValidator,
+	"delegate":                               EventTypeDelegate,
+	"edit_validator":                         EventTypeEditValidator,
+	"unbond":                                 EventTypeUnbond,
+	"tx":                                     EventTypeTx,
+	"complete_redelegation":                  EventTypeCompleteRedelegation,
+	"complete_unbonding":                     EventTypeCompleteUnbonding,
+	"use_feegrant":                           EventTypeUseFeegrant,
+	"revoke_feegrant":                        EventTypeRevokeFeegrant,
+	"set_feegrant":                           EventTypeSetFeegrant,
+	"update_feegrant":                        EventTypeUpdateFeegrant,
+	"slash":                                  EventTypeSlash,
+	"proposal_vote":                          EventTypeProposalVote,
+	"proposal_deposit":                       EventTypeProposalDeposit,
+	"submit_proposal":                        EventTypeSubmitProposal,
+	"cosmos.authz.v1beta1.EventGrant":        EventTypeCosmosauthzv1beta1EventGrant,
+	"send_packet":                            EventTypeSendPacket,
+	"ibc_transfer":                           EventTypeIbcTransfer,
+	"fungible_token_packet":                  EventTypeFungibleTokenPacket,
+	"acknowledge_packet":                     EventTypeAcknowledgePacket,
+	"create_client":                          EventTypeCreateClient,
+	"update_client":                          EventTypeUpdateClient,
+	"connection_open_try":                    EventTypeConnectionOpenTry,
+	"connection_open_init":                   EventTypeConnectionOpenInit,
+	"connection_open_confirm":                EventTypeConnectionOpenConfirm,
+	"connection_open_ack":                    EventTypeConnectionOpenAck,
+	"channel_open_try":                       EventTypeChannelOpenTry,
+	"channel_open_init":                      EventTypeChannelOpenInit,
+	"channel_open_confirm":                   EventTypeChannelOpenConfirm,
+	"channel_open_ack":                       EventTypeChannelOpenAck,
+	"recv_packet":                            EventTypeRecvPacket,
+	"write_acknowledgement":                  EventTypeWriteAcknowledgement,
+	"timeout":                                EventTypeTimeout,
+	"timeout_packet":                         EventTypeTimeoutPacket,
+	"cosmos.authz.v1beta1.EventRevoke":       EventTypeCosmosauthzv1beta1EventRevoke,
+	"cosmos.authz.v1.EventRevoke":            EventTypeCosmosauthzv1EventRevoke,
+	"cancel_unbonding_delegation":            EventTypeCancelUnbondingDelegation,
+	"active_proposal":                        EventTypeActiveProposal,
+	"inactive_proposal":                      EventTypeInactiveProposal,
+	"cosmos.group.v1.EventCreateGroup":       EventTypeCosmosgroupv1EventCreateGroup,
+	"cosmos.group.v1.EventUpdateGroup":       EventTypeCosmosgroupv1EventUpdateGroup,
+	"cosmos.group.v1.EventCreateGroupPolicy": EventTypeCosmosgroupv1EventCreateGroupPolicy,
+	"cosmos.group.v1.EventUpdateGroupPolicy": EventTypeCosmosgroupv1EventUpdateGroupPolicy,
+	"cosmos.group.v1.EventSubmitProposal":    EventTypeCosmosgroupv1EventSubmitProposal,
+	"cosmos.group.v1.EventWithdrawProposal":  EventTypeCosmosgroupv1EventWithdrawProposal,
+	"cosmos.group.v1.EventVote":              EventTypeCosmosgroupv1EventVote,
+	"cosmos.group.v1.EventExec":              EventTypeCosmosgroupv1EventExec,
+	"cosmos.group.v1.EventLeaveGroup":        EventTypeCosmosgroupv1EventLeaveGroup,
+	"cosmos.group.v1.EventProposalPruned":    EventTypeCosmosgroupv1EventProposalPruned,
 }
 
 // ParseEventType attempts to convert a string to a EventType.
@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by go-enum DO NOT EDIT.
+// Version: 0.5.7
+// Revision: bf63e108589bbd2327b13ec2c5da532aad234029
+// Build Date: 2023-07-25T23:27:55Z
+// Built By: goreleaser
+
+package types
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// GroupExecutorResultNotRun is a GroupExecutorResult of type not_run.
+	GroupExecutorResultNotRun GroupExecutorResult = "not_run"
+	// GroupExecutorResultSuccess is a GroupExecutorResult of type success.
+	GroupExecutorResultSuccess GroupExecutorResult = "success"
+	// GroupExecutorResultFailure is a GroupExecutorResult of type failure.
+	GroupExecutorResultFailure GroupExecutorResult = "failure"
+)
+
+var ErrInvalidGroupExecutorResult = fmt.Errorf("not a valid GroupExecutorResult, try [%s]", strings.Join(_GroupExecutorResultNames, ", "))
+
+var _GroupExecutorResultNames = []string{
+	string(GroupExecutorResultNotRun),
+	string(GroupExecutorResultSuccess),
+	string(GroupExecutorResultFailure),
+}
+
+// GroupExecutorResultNames returns a list of possible string values of GroupExecutorResult.
+func GroupExecutorResultNames() []string {
+	tmp := make([]string, len(_GroupExecutorResultNames))
+	copy(tmp, _GroupExecutorResultNames)
+	return tmp
+}
+
+// GroupExecutorResultValues returns a list of the values for GroupExecutorResult
+func GroupExecutorResultValues() []GroupExecutorResult {
+	return []GroupExecutorResult{
+		GroupExecutorResultNotRun,
+		GroupExecutorResultSuccess,
+		GroupExecutorResultFailure,
+	}
+}
+
+// String implements the Stringer interface.
+func (x GroupExecutorResult) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x GroupExecutorResult) IsValid() bool {
+	_, err := ParseGroupExecutorResult(string(x))
+	return err == nil
+}
+
+var _GroupExecutorResultValue = map[string]GroupExecutorResult{
+	"not_run": GroupExecutorResultNotRun,
+	"success": GroupExecutorResultSuccess,
+	"failure": GroupExecutorResultFailure,
+}
+
+// ParseGroupExecutorResult attempts to convert a string to a GroupExecutorResult.
+func ParseGroupExecutorResult(name string) (GroupExecutorResult, error) {
+	if x, ok := _GroupExecutorResultValue[name]; ok {
+		return x, nil
+	}
+	return GroupExecutorResult(""), fmt.Errorf("%s is %w", name, ErrInvalidGroupExecutorResult)
+}
+
+// MarshalText implements the text marshaller method.
+func (x GroupExecutorResult) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *GroupExecutorResult) UnmarshalText(text []byte) error {
+	tmp, err := ParseGroupExecutorResult(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+var errGroupExecutorResultNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *GroupExecutorResult) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = GroupExecutorResult("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseGroupExecutorResult(v)
+	case []byte:
+		*x, err = ParseGroupExecutorResult(string(v))
+	case GroupExecutorResult:
+		*x = v
+	case *GroupExecutorResult:
+		if v == nil {
+			return errGroupExecutorResultNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errGroupExecutorResultNilPtr
+		}
+		*x, err = ParseGroupExecutorResult(*v)
+	default:
+		return errors.New("invalid type for GroupExecutorResult")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x GroupExecutorResult) Value() (driver.Value, error) {
+	return x.String(), nil
+}
@@ -0,0 +1,17 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package types
+
+// swagger:enum GroupProposalStatus
+/*
+	ENUM(
+		submitted,
+		accepted,
+		rejected,
+		aborted,
+		withdrawn
+	)
+*/
+//go:generate go-enum --marshal --sql --values --names
+type GroupProposalStatus string
@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by go-enum DO NOT EDIT.
+// Version: 0.5.7
+// Revision: bf63e108589bbd2327b13ec2c5da532aad234029
+// Build Date: 2023-07-25T23:27:55Z
+// Built By: goreleaser
+
+package types
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// GroupProposalStatusSubmitted is a GroupProposalStatus of type submitted.
+	GroupProposalStatusSubmitted GroupProposalStatus = "submitted"
+	// GroupProposalStatusAccepted is a GroupProposalStatus of type accepted.
+	GroupProposalStatusAccepted GroupProposalStatus = "accepted"
+	// GroupProposalStatusRejected is a GroupProposalStatus of type rejected.
+	GroupProposalStatusRejected GroupProposalStatus = "rejected"
+	// GroupProposalStatusAborted is a GroupProposalStatus of type aborted.
+	GroupProposalStatusAborted GroupProposalStatus = "aborted"
+	// GroupProposalStatusWithdrawn is a GroupProposalStatus of type withdrawn.
+	GroupProposalStatusWithdrawn GroupProposalStatus = "withdrawn"
+)
+
+var ErrInvalidGroupProposalStatus = fmt.Errorf("not a valid GroupProposalStatus, try [%s]", strings.Join(_GroupProposalStatusNames, ", "))
+
+var _GroupProposalStatusNames = []string{
+	string(GroupProposalStatusSubmitted),
+	string(GroupProposalStatusAccepted),
+	string(GroupProposalStatusRejected),
+	string(GroupProposalStatusAborted),
+	string(GroupProposalStatusWithdrawn),
+}
+
+// GroupProposalStatusNames returns a list of possible string values of GroupProposalStatus.
+func GroupProposalStatusNames() []string {
+	tmp := make([]string, len(_GroupProposalStatusNames))
+	copy(tmp, _GroupProposalStatusNames)
+	return tmp
+}
+
+// GroupProposalStatusValues returns a list of the values for GroupProposalStatus
+func GroupProposalStatusValues() []GroupProposalStatus {
+	return []GroupProposalStatus{
+		GroupProposalStatusSubmitted,
+		GroupProposalStatusAccepted,
+		GroupProposalStatusRejected,
+		GroupProposalStatusAborted,
+		GroupProposalStatusWithdrawn,
+	}
+}
+
+// String implements the Stringer interface.
+func (x GroupProposalStatus) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x GroupProposalStatus) IsValid() bool {
+	_, err := ParseGroupProposalStatus(string(x))
+	return err == nil
+}
+
+var _GroupProposalStatusValue = map[string]GroupProposalStatus{
+	"submitted": GroupProposalStatusSubmitted,
+	"accepted":  GroupProposalStatusAccepted,
+	"rejected":  GroupProposalStatusRejected,
+	"aborted":   GroupProposalStatusAborted,
+	"withdrawn": GroupProposalStatusWithdrawn,
+}
+
+// ParseGroupProposalStatus attempts to convert a string to a GroupProposalStatus.
+func ParseGroupProposalStatus(name string) (GroupProposalStatus, error) {
+	if x, ok := _GroupProposalStatusValue[name]; ok {
+		return x, nil
+	}
+	return GroupProposalStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidGroupProposalStatus)
+}
+
+// MarshalText implements the text marshaller method.
+func (x GroupProposalStatus) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *GroupProposalStatus) UnmarshalText(text []byte) error {
+	tmp, err := ParseGroupProposalStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+var errGroupProposalStatusNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *GroupProposalStatus) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = GroupProposalStatus("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseGroupProposalStatus(v)
+	case []byte:
+		*x, err = ParseGroupProposalStatus(string(v))
+	case GroupProposalStatus:
+		*x = v
+	case *GroupProposalStatus:
+		if v == nil {
+			return errGroupProposalStatusNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errGroupProposalStatusNilPtr
+		}
+		*x, err = ParseGroupProposalStatus(*v)
+	default:
+		return errors.New("invalid type for GroupProposalStatus")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x GroupProposalStatus) Value() (driver.Value, error) {
+	return x.String(), nil
+}
@@ -0,0 +1,16 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package types
+
+// swagger:enum IbcTransferStatus
+/*
+	ENUM(
+		sent,
+		received,
+		acknowledged,
+		timeout
+	)
+*/
+//go:generate go-enum --marshal --sql --values --names
+type IbcTransferStatus string
@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by go-enum DO NOT EDIT.
+// Version: 0.5.7
+// Revision: bf63e108589bbd2327b13ec2c5da532aad234029
+// Build Date: 2023-07-25T23:27:55Z
+// Built By: goreleaser
+
+package types
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// IbcTransferStatusSent is a IbcTransferStatus of type sent.
+	IbcTransferStatusSent IbcTransferStatus = "sent"
+	// IbcTransferStatusReceived is a IbcTransferStatus of type received.
+	IbcTransferStatusReceived IbcTransferStatus = "received"
+	// IbcTransferStatusAcknowledged is a IbcTransferStatus of type acknowledged.
+	IbcTransferStatusAcknowledged IbcTransferStatus = "acknowledged"
+	// IbcTransferStatusTimeout is a IbcTransferStatus of type timeout.
+	IbcTransferStatusTimeout IbcTransferStatus = "timeout"
+)
+
+var ErrInvalidIbcTransferStatus = fmt.Errorf("not a valid IbcTransferStatus, try [%s]", strings.Join(_IbcTransferStatusNames, ", "))
+
+var _IbcTransferStatusNames = []string{
+	string(IbcTransferStatusSent),
+	string(IbcTransferStatusReceived),
+	string(IbcTransferStatusAcknowledged),
+	string(IbcTransferStatusTimeout),
+}
+
+// IbcTransferStatusNames returns a list of possible string values of IbcTransferStatus.
+func IbcTransferStatusNames() []string {
+	tmp := make([]string, len(_IbcTransferStatusNames))
+	copy(tmp, _IbcTransferStatusNames)
+	return tmp
+}
+
+// IbcTransferStatusValues returns a list of the values for IbcTransferStatus
+func IbcTransferStatusValues() []IbcTransferStatus {
+	return []IbcTransferStatus{
+		IbcTransferStatusSent,
+		IbcTransferStatusReceived,
+		IbcTransferStatusAcknowledged,
+		IbcTransferStatusTimeout,
+	}
+}
+
+// String implements the Stringer interface.
+func (x IbcTransferStatus) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x IbcTransferStatus) IsValid() bool {
+	_, err := ParseIbcTransferStatus(string(x))
+	return err == nil
+}
+
+var _IbcTransferStatusValue = map[string]IbcTransferStatus{
+	"sent":         IbcTransferStatusSent,
+	"received":     IbcTransferStatusReceived,
+	"acknowledged": IbcTransferStatusAcknowledged,
+	"timeout":      IbcTransferStatusTimeout,
+}
+
+// ParseIbcTransferStatus attempts to convert a string to a IbcTransferStatus.
+func ParseIbcTransferStatus(name string) (IbcTransferStatus, error) {
+	if x, ok := _IbcTransferStatusValue[name]; ok {
+		return x, nil
+	}
+	return IbcTransferStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidIbcTransferStatus)
+}
+
+// MarshalText implements the text marshaller method.
+func (x IbcTransferStatus) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *IbcTransferStatus) UnmarshalText(text []byte) error {
+	tmp, err := ParseIbcTransferStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+var errIbcTransferStatusNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *IbcTransferStatus) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = IbcTransferStatus("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseIbcTransferStatus(v)
+	case []byte:
+		*x, err = ParseIbcTransferStatus(string(v))
+	case IbcTransferStatus:
+		*x = v
+	case *IbcTransferStatus:
+		if v == nil {
+			return errIbcTransferStatusNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errIbcTransferStatusNilPtr
+		}
+		*x, err = ParseIbcTransferStatus(*v)
+	default:
+		return errors.New("invalid type for IbcTransferStatus")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x IbcTransferStatus) Value() (driver.Value, error) {
+	return x.String(), nil
+}
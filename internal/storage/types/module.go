@@ -14,7 +14,8 @@ package types
 		gov,
 		slashing,
 		staking,
-		consensus
+		consensus,
+		blobstream
 	)
 */
 //go:generate go-enum --marshal --sql --values
@@ -34,6 +34,8 @@ const (
 	ModuleNameStaking ModuleName = "staking"
 	// ModuleNameConsensus is a ModuleName of type consensus.
 	ModuleNameConsensus ModuleName = "consensus"
+	// ModuleNameBlobstream is a ModuleName of type blobstream.
+	ModuleNameBlobstream ModuleName = "blobstream"
 )
 
 var ErrInvalidModuleName = errors.New("not a valid ModuleName")
@@ -50,6 +52,7 @@ func ModuleNameValues() []ModuleName {
 		ModuleNameSlashing,
 		ModuleNameStaking,
 		ModuleNameConsensus,
+		ModuleNameBlobstream,
 	}
 }
 
@@ -75,6 +78,7 @@ var _ModuleNameValue = map[string]ModuleName{
 	"slashing":     ModuleNameSlashing,
 	"staking":      ModuleNameStaking,
 	"consensus":    ModuleNameConsensus,
+	"blobstream":   ModuleNameBlobstream,
 }
 
 // ParseModuleName attempts to convert a string to a ModuleName.
@@ -7,6 +7,7 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"fmt"
+	"math/big"
 
 	"github.com/pkg/errors"
 )
@@ -614,7 +615,10 @@ func (mask MsgTypeBits) Names() []MsgType {
 }
 
 func (mask MsgTypeBits) HasOne(value MsgTypeBits) bool {
-	return mask.value.And(mask.value, value.value).Cmp(zero) > 0
+	if mask.value == nil || value.value == nil {
+		return false
+	}
+	return new(big.Int).And(mask.value, value.value).Cmp(zero) > 0
 }
 
 var _ sql.Scanner = (*MsgTypeBits)(nil)
@@ -0,0 +1,18 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package types
+
+// swagger:enum ProposalStatus
+/*
+	ENUM(
+		inactive,
+		active,
+		removed,
+		applied,
+		rejected,
+		failed
+	)
+*/
+//go:generate go-enum --marshal --sql --values --names
+type ProposalStatus string
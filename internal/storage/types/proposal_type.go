@@ -0,0 +1,19 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package types
+
+// swagger:enum ProposalType
+/*
+	ENUM(
+		unknown,
+		text,
+		param_changed,
+		community_pool_spend,
+		client_update,
+		software_upgrade,
+		cancel_software_upgrade
+	)
+*/
+//go:generate go-enum --marshal --sql --values --names
+type ProposalType string
@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by go-enum DO NOT EDIT.
+// Version: 0.5.7
+// Revision: bf63e108589bbd2327b13ec2c5da532aad234029
+// Build Date: 2023-07-25T23:27:55Z
+// Built By: goreleaser
+
+package types
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// ProposalTypeUnknown is a ProposalType of type unknown.
+	ProposalTypeUnknown ProposalType = "unknown"
+	// ProposalTypeText is a ProposalType of type text.
+	ProposalTypeText ProposalType = "text"
+	// ProposalTypeParamChanged is a ProposalType of type param_changed.
+	ProposalTypeParamChanged ProposalType = "param_changed"
+	// ProposalTypeCommunityPoolSpend is a ProposalType of type community_pool_spend.
+	ProposalTypeCommunityPoolSpend ProposalType = "community_pool_spend"
+	// ProposalTypeClientUpdate is a ProposalType of type client_update.
+	ProposalTypeClientUpdate ProposalType = "client_update"
+	// ProposalTypeSoftwareUpgrade is a ProposalType of type software_upgrade.
+	ProposalTypeSoftwareUpgrade ProposalType = "software_upgrade"
+	// ProposalTypeCancelSoftwareUpgrade is a ProposalType of type cancel_software_upgrade.
+	ProposalTypeCancelSoftwareUpgrade ProposalType = "cancel_software_upgrade"
+)
+
+var ErrInvalidProposalType = fmt.Errorf("not a valid ProposalType, try [%s]", strings.Join(_ProposalTypeNames, ", "))
+
+var _ProposalTypeNames = []string{
+	string(ProposalTypeUnknown),
+	string(ProposalTypeText),
+	string(ProposalTypeParamChanged),
+	string(ProposalTypeCommunityPoolSpend),
+	string(ProposalTypeClientUpdate),
+	string(ProposalTypeSoftwareUpgrade),
+	string(ProposalTypeCancelSoftwareUpgrade),
+}
+
+// ProposalTypeNames returns a list of possible string values of ProposalType.
+func ProposalTypeNames() []string {
+	tmp := make([]string, len(_ProposalTypeNames))
+	copy(tmp, _ProposalTypeNames)
+	return tmp
+}
+
+// ProposalTypeValues returns a list of the values for ProposalType
+func ProposalTypeValues() []ProposalType {
+	return []ProposalType{
+		ProposalTypeUnknown,
+		ProposalTypeText,
+		ProposalTypeParamChanged,
+		ProposalTypeCommunityPoolSpend,
+		ProposalTypeClientUpdate,
+		ProposalTypeSoftwareUpgrade,
+		ProposalTypeCancelSoftwareUpgrade,
+	}
+}
+
+// String implements the Stringer interface.
+func (x ProposalType) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x ProposalType) IsValid() bool {
+	_, err := ParseProposalType(string(x))
+	return err == nil
+}
+
+var _ProposalTypeValue = map[string]ProposalType{
+	"unknown":                 ProposalTypeUnknown,
+	"text":                    ProposalTypeText,
+	"param_changed":           ProposalTypeParamChanged,
+	"community_pool_spend":    ProposalTypeCommunityPoolSpend,
+	"client_update":           ProposalTypeClientUpdate,
+	"software_upgrade":        ProposalTypeSoftwareUpgrade,
+	"cancel_software_upgrade": ProposalTypeCancelSoftwareUpgrade,
+}
+
+// ParseProposalType attempts to convert a string to a ProposalType.
+func ParseProposalType(name string) (ProposalType, error) {
+	if x, ok := _ProposalTypeValue[name]; ok {
+		return x, nil
+	}
+	return ProposalType(""), fmt.Errorf("%s is %w", name, ErrInvalidProposalType)
+}
+
+// MarshalText implements the text marshaller method.
+func (x ProposalType) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *ProposalType) UnmarshalText(text []byte) error {
+	tmp, err := ParseProposalType(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+var errProposalTypeNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *ProposalType) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = ProposalType("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseProposalType(v)
+	case []byte:
+		*x, err = ParseProposalType(string(v))
+	case ProposalType:
+		*x = v
+	case *ProposalType:
+		if v == nil {
+			return errProposalTypeNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errProposalTypeNilPtr
+		}
+		*x, err = ParseProposalType(*v)
+	default:
+		return errors.New("invalid type for ProposalType")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x ProposalType) Value() (driver.Value, error) {
+	return x.String(), nil
+}
@@ -0,0 +1,14 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package types
+
+// swagger:enum TxAddressType
+/*
+	ENUM(
+		fee_payer,
+		fee_granter
+	)
+*/
+//go:generate go-enum --marshal --sql --values --names
+type TxAddressType string
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+type ListUpgradesFilters struct {
+	Limit  int
+	Offset int
+	Sort   storage.SortOrder
+	Status []types.UpgradeStatus
+}
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IUpgrade interface {
+	storage.Table[*Upgrade]
+
+	ListWithFilters(ctx context.Context, filters ListUpgradesFilters) ([]Upgrade, error)
+	Pending(ctx context.Context) (Upgrade, error)
+}
+
+// Upgrade - software upgrade plan scheduled by governance or app version transition observed in block headers
+type Upgrade struct {
+	bun.BaseModel `bun:"upgrade" comment:"Table with software upgrades."`
+
+	Id               uint64              `bun:"id,pk,notnull,autoincrement" comment:"Unique internal identity"`
+	Height           pkgTypes.Level      `bun:"height,notnull"              comment:"The number (height) of block when upgrade was scheduled"`
+	Time             time.Time           `bun:"time,notnull"                comment:"The time of block when upgrade was scheduled"`
+	Name             string              `bun:"name"                        comment:"Plan name"`
+	PlanHeight       int64               `bun:"plan_height"                 comment:"Target height of the plan"`
+	Info             string              `bun:"info"                        comment:"Plan info"`
+	ProposalId       *uint64             `bun:"proposal_id"                 comment:"Proposal identity which scheduled upgrade"`
+	Authority        string              `bun:"authority"                   comment:"Address which is allowed to schedule upgrade"`
+	Status           types.UpgradeStatus `bun:"status,type:upgrade_status"  comment:"Upgrade status"`
+	CancelHeight     *pkgTypes.Level     `bun:"cancel_height"               comment:"The number (height) of block when upgrade was cancelled"`
+	CancelTime       *time.Time          `bun:"cancel_time"                 comment:"The time of block when upgrade was cancelled"`
+	AppVersion       uint64              `bun:"app_version"                 comment:"App version which became active after upgrade"`
+	ActivationHeight *pkgTypes.Level     `bun:"activation_height"           comment:"The number (height) of the first block with new app version"`
+	ActivationTime   *time.Time          `bun:"activation_time"             comment:"The time of the first block with new app version"`
+}
+
+// TableName -
+func (Upgrade) TableName() string {
+	return "upgrade"
+}
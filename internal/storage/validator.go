@@ -48,6 +48,9 @@ type Validator struct {
 	Height      pkgTypes.Level  `bun:"height"                   comment:"Height when validator was created"`
 
 	Jailed *bool `bun:"jailed" comment:"True if validator was punished"`
+
+	RateChanged              bool `bun:"-"` // internal field: commission rate was set by the message, zero rate is valid
+	MinSelfDelegationChanged bool `bun:"-"` // internal field: min self delegation was set by the message
 }
 
 func (Validator) TableName() string {
@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IValidatorHistory interface {
+	storage.Table[*ValidatorHistory]
+
+	ByValidator(ctx context.Context, id uint64, limit, offset int) ([]ValidatorHistory, error)
+}
+
+// ValidatorHistory - snapshot of validator description and commission after its change
+type ValidatorHistory struct {
+	bun.BaseModel `bun:"validator_history" comment:"Table with history of validator description and commission changes."`
+
+	Id          uint64         `bun:"id,pk,notnull,autoincrement" comment:"Unique internal id"`
+	Height      pkgTypes.Level `bun:"height,notnull"              comment:"The number (height) of block when validator was changed"`
+	Time        time.Time      `bun:"time,notnull"                comment:"The time of block when validator was changed"`
+	ValidatorId uint64         `bun:"validator_id,notnull"        comment:"Internal validator id"`
+
+	Moniker  string `bun:"moniker,type:text"  comment:"Human-readable name for the validator"`
+	Website  string `bun:"website,type:text"  comment:"Website link"`
+	Identity string `bun:"identity,type:text" comment:"Optional identity signature"`
+	Contacts string `bun:"contacts,type:text" comment:"Contacts"`
+	Details  string `bun:"details,type:text"  comment:"Detailed information about validator"`
+
+	Rate              decimal.Decimal `bun:"rate,type:numeric"                comment:"Commission rate charged to delegators, as a fraction"`
+	MaxRate           decimal.Decimal `bun:"max_rate,type:numeric"            comment:"Maximum commission rate which validator can ever charge, as a fraction"`
+	MaxChangeRate     decimal.Decimal `bun:"max_change_rate,type:numeric"     comment:"Maximum daily increase of the validator commission, as a fraction"`
+	MinSelfDelegation decimal.Decimal `bun:"min_self_delegation,type:numeric" comment:"Minimum self delegation"`
+}
+
+// TableName -
+func (ValidatorHistory) TableName() string {
+	return "validator_history"
+}
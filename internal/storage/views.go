@@ -18,4 +18,6 @@ const (
 	ViewStakingByHour         = "staking_by_hour"
 	ViewStakingByDay          = "staking_by_day"
 	ViewStakingByMonth        = "staking_by_month"
+	ViewMissedBlocksByHour    = "missed_blocks_by_hour"
+	ViewMissedBlocksByDay     = "missed_blocks_by_day"
 )
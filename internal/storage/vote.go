@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+type VoterType string
+
+const (
+	VoterTypeValidator VoterType = "validator"
+	VoterTypeDelegator VoterType = "delegator"
+)
+
+type VoteFilters struct {
+	Limit     int
+	Offset    int
+	Option    []types.VoteOption
+	VoterType VoterType
+}
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IVote interface {
+	storage.Table[*Vote]
+
+	ByProposalId(ctx context.Context, proposalId uint64, fltrs VoteFilters) ([]Vote, error)
+	ByVoterId(ctx context.Context, voterId uint64, fltrs VoteFilters) ([]Vote, error)
+	ByValidatorId(ctx context.Context, validatorId uint64, fltrs VoteFilters) ([]Vote, error)
+}
+
+// Vote -
+type Vote struct {
+	bun.BaseModel `bun:"vote" comment:"Table with governance votes."`
+
+	Id          uint64           `bun:"id,pk,notnull,autoincrement"  comment:"Unique internal identity"`
+	Height      pkgTypes.Level   `bun:"height,notnull"               comment:"The number (height) of this block"`
+	Time        time.Time        `bun:"time,notnull"                 comment:"The time of block"`
+	Option      types.VoteOption `bun:"option,type:vote_option"      comment:"Selected vote option"`
+	Weight      decimal.Decimal  `bun:"weight,type:numeric"          comment:"Weight of the option"`
+	ProposalId  uint64           `bun:"proposal_id,notnull"          comment:"Proposal identity"`
+	VoterId     uint64           `bun:"voter_id,notnull"             comment:"Voter internal identity"`
+	ValidatorId uint64           `bun:"validator_id,nullzero"        comment:"Validator internal identity if voter is a validator"`
+
+	Voter     *Address   `bun:"rel:belongs-to,join:voter_id=id"`
+	Validator *Validator `bun:"rel:belongs-to,join:validator_id=id"`
+	Proposal  *Proposal  `bun:"rel:belongs-to,join:proposal_id=id"`
+}
+
+// TableName -
+func (Vote) TableName() string {
+	return "vote"
+}
@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package blobsaver
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/celenium-io/celestia-indexer/internal/blob"
+	"github.com/celenium-io/celestia-indexer/pkg/node"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const progressStep = 100
+
+// Backfill - saves blobs of historical blocks to blob storage. Blocks are processed by `threads` workers in parallel.
+// The last height below which all blocks are saved is written to the progress file. So backfill can be resumed after restart.
+type Backfill struct {
+	api          node.Api
+	storage      blob.Storage
+	threads      int
+	progressFile string
+	log          zerolog.Logger
+}
+
+// NewBackfill -
+func NewBackfill(api node.Api, storage blob.Storage, threads int, progressFile string) *Backfill {
+	if threads < 1 {
+		threads = 1
+	}
+	return &Backfill{
+		api:          api,
+		storage:      storage,
+		threads:      threads,
+		progressFile: progressFile,
+		log:          log.With().Str("module", "blob_backfill").Logger(),
+	}
+}
+
+// Run - saves blobs of blocks in range [from, to]. Heights which were saved before according to progress file are skipped.
+func (bf *Backfill) Run(ctx context.Context, from, to pkgTypes.Level) error {
+	saved, err := bf.progress()
+	if err != nil {
+		return errors.Wrap(err, "can't read progress")
+	}
+	if saved >= from {
+		from = saved + 1
+	}
+	if from > to {
+		bf.log.Info().Uint64("height", uint64(saved)).Msg("blobs are already backfilled")
+		return nil
+	}
+
+	bf.log.Info().
+		Uint64("from", uint64(from)).
+		Uint64("to", uint64(to)).
+		Int("threads", bf.threads).
+		Msg("starting blob backfill...")
+
+	workerCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg      = new(sync.WaitGroup)
+		heights = make(chan pkgTypes.Level)
+		done    = make(chan pkgTypes.Level, bf.threads)
+		errs    = make(chan error, bf.threads)
+	)
+
+	go func() {
+		defer close(heights)
+		for height := from; height <= to; height++ {
+			select {
+			case <-workerCtx.Done():
+				return
+			case heights <- height:
+			}
+		}
+	}()
+
+	for i := 0; i < bf.threads; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for height := range heights {
+				if err := bf.save(workerCtx, height); err != nil {
+					errs <- errors.Wrapf(err, "height %d", height)
+					cancel()
+					return
+				}
+				done <- height
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	var (
+		completed = make(map[pkgTypes.Level]struct{})
+		next      = from
+		written   = from - 1
+	)
+	for height := range done {
+		completed[height] = struct{}{}
+		for {
+			if _, ok := completed[next]; !ok {
+				break
+			}
+			delete(completed, next)
+			next++
+		}
+
+		if next-1-written >= progressStep {
+			if err := bf.saveProgress(next - 1); err != nil {
+				cancel()
+				return errors.Wrap(err, "can't save progress")
+			}
+			written = next - 1
+			bf.log.Info().Uint64("height", uint64(written)).Uint64("to", uint64(to)).Msg("blobs backfilled")
+		}
+	}
+
+	if next-1 > written {
+		if err := bf.saveProgress(next - 1); err != nil {
+			return errors.Wrap(err, "can't save progress")
+		}
+	}
+
+	select {
+	case err := <-errs:
+		return err
+	default:
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	bf.log.Info().Uint64("height", uint64(to)).Msg("blob backfill is finished")
+	return nil
+}
+
+func (bf *Backfill) save(ctx context.Context, height pkgTypes.Level) error {
+	block, err := bf.api.BlockData(ctx, height)
+	if err != nil {
+		return errors.Wrap(err, "can't receive block")
+	}
+
+	blobs, err := BlockBlobs(block)
+	if err != nil {
+		return err
+	}
+	if len(blobs) == 0 {
+		return nil
+	}
+
+	if err := bf.storage.SaveBulk(ctx, blobs); err != nil {
+		return errors.Wrap(err, "can't save blobs")
+	}
+	return nil
+}
+
+func (bf *Backfill) progress() (pkgTypes.Level, error) {
+	if bf.progressFile == "" {
+		return 0, nil
+	}
+
+	data, err := os.ReadFile(bf.progressFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	var height pkgTypes.Level
+	err = json.Unmarshal(data, &height)
+	return height, err
+}
+
+func (bf *Backfill) saveProgress(height pkgTypes.Level) error {
+	if bf.progressFile == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(height)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(bf.progressFile, data, 0o644)
+}
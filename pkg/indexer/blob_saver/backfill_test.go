@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package blobsaver
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/celenium-io/celestia-indexer/internal/blob"
+	"github.com/celenium-io/celestia-indexer/pkg/node/mock"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/stretchr/testify/require"
+	blobTypes "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+	"go.uber.org/mock/gomock"
+)
+
+func testBlockData(t *testing.T, height pkgTypes.Level, failed bool, blobs ...*blobTypes.Blob) pkgTypes.BlockData {
+	tx, err := tmTypes.MarshalBlobTx([]byte{0x01, 0x02}, blobs...)
+	require.NoError(t, err)
+
+	var code uint32
+	if failed {
+		code = 1
+	}
+
+	return pkgTypes.BlockData{
+		ResultBlock: pkgTypes.ResultBlock{
+			Block: &pkgTypes.Block{
+				Header: pkgTypes.Header{
+					Height: int64(height),
+				},
+				Data: pkgTypes.Data{
+					Txs: tmTypes.Txs{tx, []byte{0x03}},
+				},
+			},
+		},
+		ResultBlockResults: pkgTypes.ResultBlockResults{
+			Height: height,
+			TxsResults: []*pkgTypes.ResponseDeliverTx{
+				{Code: code},
+				{Code: 0},
+			},
+		},
+	}
+}
+
+func TestBlockBlobs(t *testing.T) {
+	b := &blobTypes.Blob{
+		NamespaceId: []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10},
+		Data:        []byte{0, 1, 2, 3},
+	}
+
+	blobs, err := BlockBlobs(testBlockData(t, 100, false, b, b))
+	require.NoError(t, err)
+	require.Len(t, blobs, 1)
+	require.EqualValues(t, 100, blobs[0].Height)
+	require.Equal(t, "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAo=/100/uwghsElFtoHNqQ3JrsDGj8uLW456izVbegVL_AunMOw=", blobs[0].String())
+
+	blobs, err = BlockBlobs(testBlockData(t, 100, true, b))
+	require.NoError(t, err)
+	require.Len(t, blobs, 0)
+}
+
+func TestBackfill(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	api := mock.NewMockApi(ctrl)
+	storage := blob.NewMockStorage(ctrl)
+
+	b := &blobTypes.Blob{
+		NamespaceId: []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10},
+		Data:        []byte{0, 1, 2, 3},
+	}
+
+	progress := filepath.Join(t.TempDir(), "progress.json")
+	require.NoError(t, os.WriteFile(progress, []byte("10"), 0o644))
+
+	for height := pkgTypes.Level(11); height <= 20; height++ {
+		api.EXPECT().
+			BlockData(gomock.Any(), height).
+			Return(testBlockData(t, height, height%2 == 0, b), nil).
+			Times(1)
+	}
+
+	storage.EXPECT().
+		SaveBulk(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, blobs []blob.Blob) error {
+			require.Len(t, blobs, 1)
+			require.EqualValues(t, 1, blobs[0].Height%2)
+			return nil
+		}).
+		Times(5)
+
+	backfill := NewBackfill(api, storage, 4, progress)
+	require.NoError(t, backfill.Run(ctx, 1, 20))
+
+	saved, err := backfill.progress()
+	require.NoError(t, err)
+	require.EqualValues(t, 20, saved)
+
+	// already backfilled range doesn't request node
+	require.NoError(t, backfill.Run(ctx, 5, 20))
+}
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package blobsaver
+
+import (
+	"github.com/celenium-io/celestia-indexer/internal/blob"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	sqBlob "github.com/celestiaorg/go-square/blob"
+	"github.com/celestiaorg/go-square/inclusion"
+	"github.com/celestiaorg/go-square/merkle"
+	"github.com/celestiaorg/go-square/namespace"
+	"github.com/pkg/errors"
+	blobTypes "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+// NewBlob - creates blob for saving and computes its commitment
+func NewBlob(height pkgTypes.Level, b *blobTypes.Blob) (blob.Blob, error) {
+	ns, err := namespace.New(uint8(b.NamespaceVersion), b.NamespaceId)
+	if err != nil {
+		return blob.Blob{}, errors.Wrapf(err, "can't parse namespace: version=%d id=%x", b.NamespaceVersion, b.NamespaceId)
+	}
+	sb := sqBlob.New(ns, b.Data, uint8(b.ShareVersion))
+	commitment, err := inclusion.CreateCommitment(sb, merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold(uint64(b.ShareVersion)))
+	if err != nil {
+		return blob.Blob{}, errors.Wrap(err, "can't create commitment")
+	}
+
+	return blob.Blob{
+		Commitment: commitment,
+		Blob:       b,
+		Height:     uint64(height),
+	}, nil
+}
+
+// BlockBlobs - returns blobs of successful transactions in the block. Blobs with the same commitments are returned once
+// as parser and blob saver module do.
+func BlockBlobs(block pkgTypes.BlockData) ([]blob.Blob, error) {
+	var (
+		blobs  = make([]blob.Blob, 0)
+		unique = make(map[string]struct{})
+	)
+
+	for i := range block.TxsResults {
+		if block.TxsResults[i].IsFailed() || i >= len(block.Block.Txs) {
+			continue
+		}
+
+		bTx, isBlob := tmTypes.UnmarshalBlobTx(block.Block.Txs[i])
+		if !isBlob {
+			continue
+		}
+
+		for j := range bTx.Blobs {
+			b, err := NewBlob(block.Height, bTx.Blobs[j])
+			if err != nil {
+				return nil, errors.Wrapf(err, "tx %d blob %d", i, j)
+			}
+			key := b.String()
+			if _, ok := unique[key]; ok {
+				continue
+			}
+			unique[key] = struct{}{}
+			blobs = append(blobs, b)
+		}
+	}
+	return blobs, nil
+}
@@ -5,15 +5,10 @@ package blobsaver
 
 import (
 	"context"
-	"os"
 
 	"github.com/celenium-io/celestia-indexer/internal/blob"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
 	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
-	"github.com/celestiaorg/celestia-app/pkg/appconsts"
-	sqBlob "github.com/celestiaorg/go-square/blob"
-	"github.com/celestiaorg/go-square/inclusion"
-	"github.com/celestiaorg/go-square/merkle"
-	"github.com/celestiaorg/go-square/namespace"
 	"github.com/dipdup-net/indexer-sdk/pkg/modules"
 	"github.com/dipdup-net/indexer-sdk/pkg/sync"
 	"github.com/pkg/errors"
@@ -21,10 +16,14 @@ import (
 )
 
 const (
-	InputName  = "blobs"
-	StopOutput = "stop"
+	InputName         = "blobs"
+	RollbackInputName = "rollback"
+	StopOutput        = "stop"
 )
 
+// maxSaveFailures - count of consecutive failed attempts to save blobs of the block after which the indexer is stopped
+const maxSaveFailures = 10
+
 type Msg struct {
 	*blobTypes.Blob
 
@@ -37,16 +36,22 @@ type Msg struct {
 //	                     |----------------|
 //	                     |                |
 //	-- storage.Block ->  |     MODULE     |
-//	                     |                |
+//	-- storage.State ->  |                |
 //	                     |----------------|
+//
+// storage.State is received from rollback module. Blobs above its last height are removed from storage.
 type Module struct {
 	modules.BaseModule
 
 	kind    string
+	cfg     blob.Config
 	blocks  *sync.Map[pkgTypes.Level, *[]blob.Blob]
 	blobs   *sync.Map[string, struct{}]
 	storage blob.Storage
 	head    pkgTypes.Level
+
+	pending  []pkgTypes.Level
+	failures int
 }
 
 var _ modules.Module = (*Module)(nil)
@@ -54,15 +59,18 @@ var _ modules.Module = (*Module)(nil)
 // NewModule -
 func NewModule(
 	kind string,
+	cfg blob.Config,
 ) (*Module, error) {
 	m := Module{
 		BaseModule: modules.New("blob_saver"),
 		blocks:     sync.NewMap[pkgTypes.Level, *[]blob.Blob](),
 		blobs:      sync.NewMap[string, struct{}](),
 		kind:       kind,
+		cfg:        cfg,
 	}
 
 	m.CreateInputWithCapacity(InputName, 1024)
+	m.CreateInput(RollbackInputName)
 	m.CreateOutput(StopOutput)
 
 	return &m, nil
@@ -81,17 +89,12 @@ func (module *Module) Start(ctx context.Context) {
 
 func (module *Module) init(ctx context.Context) error {
 	switch module.kind {
-	case "r2":
-		r2 := blob.NewR2(blob.R2Config{
-			BucketName:      os.Getenv("R2_BUCKET"),
-			AccountId:       os.Getenv("R2_ACCOUNT_ID"),
-			AccessKeyId:     os.Getenv("R2_ACCESS_KEY_ID"),
-			AccessKeySecret: os.Getenv("R2_ACCESS_KEY_SECRET"),
-		})
-		if err := r2.Init(ctx); err != nil {
-			return errors.Wrap(err, "r2 initialization")
+	case blob.KindR2, blob.KindS3, blob.KindFS:
+		storage, err := blob.New(ctx, module.kind, module.cfg)
+		if err != nil {
+			return err
 		}
-		module.storage = r2
+		module.storage = storage
 	case "mock":
 	default:
 		return errors.Errorf("unknown blob saver datasource: %s", module.kind)
@@ -107,6 +110,7 @@ func (module *Module) init(ctx context.Context) error {
 func (module *Module) listen(ctx context.Context) {
 	module.Log.Info().Msg("module started")
 	input := module.MustInput(InputName)
+	rollbackInput := module.MustInput(RollbackInputName)
 
 	for {
 		select {
@@ -127,10 +131,88 @@ func (module *Module) listen(ctx context.Context) {
 			if err := module.processMessage(ctx, message); err != nil {
 				module.Log.Err(err).Msg("blob processing")
 			}
+		case msg, ok := <-rollbackInput.Listen():
+			if !ok {
+				module.Log.Warn().Msg("can't read message from rollback input")
+				module.MustOutput(StopOutput).Push(struct{}{})
+				continue
+			}
+			state, ok := msg.(storage.State)
+			if !ok {
+				module.Log.Warn().Msgf("invalid message type: %T", msg)
+				continue
+			}
+
+			module.dropQueued(ctx, state.LastHeight)
+			if err := module.rollback(ctx, state.LastHeight); err != nil {
+				module.Log.Err(err).Msg("blob rollback")
+			}
+		}
+	}
+}
+
+// dropQueued - discards blobs of orphaned blocks above the height which are already waiting in the input.
+// Blobs of other blocks are processed in the received order.
+func (module *Module) dropQueued(ctx context.Context, height pkgTypes.Level) {
+	input := module.MustInput(InputName)
+	for {
+		select {
+		case msg, ok := <-input.Listen():
+			if !ok {
+				return
+			}
+			message, ok := msg.(*Msg)
+			if !ok || message.Height > height {
+				continue
+			}
+			if err := module.processMessage(ctx, message); err != nil {
+				module.Log.Err(err).Msg("blob processing")
+			}
+		default:
+			return
 		}
 	}
 }
 
+func (module *Module) rollback(ctx context.Context, height pkgTypes.Level) error {
+	if height < module.head {
+		if err := module.storage.Rollback(ctx, uint64(height)); err != nil {
+			return errors.Wrapf(err, "can't rollback blobs to %d", height)
+		}
+
+		module.Log.Info().
+			Uint64("height", uint64(height)).
+			Uint64("old_head", uint64(module.head)).
+			Msg("blobs rolled back")
+
+		module.head = height
+	}
+
+	pending := module.pending[:0]
+	for i := range module.pending {
+		if module.pending[i] <= height {
+			pending = append(pending, module.pending[i])
+		}
+	}
+	module.pending = pending
+
+	var outdated []pkgTypes.Level
+	if err := module.blocks.Range(func(level pkgTypes.Level, _ *[]blob.Blob) (error, bool) {
+		if level > height {
+			outdated = append(outdated, level)
+		}
+		return nil, false
+	}); err != nil {
+		return err
+	}
+	for i := range outdated {
+		module.blocks.Delete(outdated[i])
+	}
+
+	module.blobs.Clear()
+	return nil
+}
+
 func (module *Module) processMessage(ctx context.Context, msg *Msg) error {
 	if msg.Height <= module.head {
 		return nil
@@ -142,7 +224,31 @@ func (module *Module) processMessage(ctx context.Context, msg *Msg) error {
 	return module.processBlob(msg)
 }
 
+// processEndOfBlock - saves blobs of the finished block. If saving failed the block is kept and saved again
+// before the next blocks, so head never moves past the block with unsaved blobs.
 func (module *Module) processEndOfBlock(ctx context.Context, height pkgTypes.Level) error {
+	module.blobs.Clear()
+	module.pending = append(module.pending, height)
+
+	for len(module.pending) > 0 {
+		if err := module.saveBlock(ctx, module.pending[0]); err != nil {
+			module.failures += 1
+			if module.failures >= maxSaveFailures {
+				module.Log.Error().
+					Uint64("height", uint64(module.pending[0])).
+					Int("failures", module.failures).
+					Msg("can't save blobs, stopping")
+				module.MustOutput(StopOutput).Push(struct{}{})
+			}
+			return errors.Wrapf(err, "height %d", module.pending[0])
+		}
+		module.pending = module.pending[1:]
+		module.failures = 0
+	}
+	return nil
+}
+
+func (module *Module) saveBlock(ctx context.Context, height pkgTypes.Level) error {
 	if blobs, ok := module.blocks.Get(height); ok {
 		if err := module.storage.SaveBulk(ctx, *blobs); err != nil {
 			return errors.Wrap(err, "can't save blobs")
@@ -151,29 +257,17 @@ func (module *Module) processEndOfBlock(ctx context.Context, height pkgTypes.Lev
 		if err := module.storage.UpdateHead(ctx, uint64(height)); err != nil {
 			return errors.Wrap(err, "can't update head")
 		}
+		module.blocks.Delete(height)
 	}
 
 	module.head = height
-	module.blocks.Delete(height)
-	module.blobs.Clear()
 	return nil
 }
 
 func (module *Module) processBlob(msg *Msg) error {
-	ns, err := namespace.New(uint8(msg.Blob.NamespaceVersion), msg.Blob.NamespaceId)
+	blb, err := NewBlob(msg.Height, msg.Blob)
 	if err != nil {
-		return errors.Wrapf(err, "can't parse namespace: version=%d id=%x", msg.Blob.NamespaceVersion, msg.Blob.NamespaceId)
-	}
-	b := sqBlob.New(ns, msg.Blob.Data, uint8(msg.Blob.ShareVersion))
-	commitment, err := inclusion.CreateCommitment(b, merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold(uint64(msg.Blob.ShareVersion)))
-	if err != nil {
-		return errors.Wrap(err, "can't create commitment")
-	}
-
-	blb := blob.Blob{
-		Commitment: commitment,
-		Blob:       msg.Blob,
-		Height:     uint64(msg.Height),
+		return err
 	}
 	key := blb.String()
 
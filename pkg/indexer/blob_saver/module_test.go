@@ -6,10 +6,12 @@ package blobsaver
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/celenium-io/celestia-indexer/internal/blob"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/proto/tendermint/types"
 	"go.uber.org/mock/gomock"
@@ -23,7 +25,7 @@ func TestBlobSaverModule(t *testing.T) {
 
 	storage := blob.NewMockStorage(ctrl)
 
-	module, err := NewModule("mock")
+	module, err := NewModule("mock", blob.Config{})
 	require.NoError(t, err, "create module")
 	module.storage = storage
 
@@ -108,3 +110,121 @@ func TestBlobSaverModule(t *testing.T) {
 	err = module.Close()
 	require.NoError(t, err, "closing module")
 }
+
+func TestBlobSaverModuleRollback(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	blobStorage := blob.NewMockStorage(ctrl)
+
+	module, err := NewModule("mock", blob.Config{})
+	require.NoError(t, err, "create module")
+	module.storage = blobStorage
+
+	blobStorage.EXPECT().
+		Head(ctx).
+		Return(100, nil).
+		Times(1)
+
+	blobStorage.EXPECT().
+		Rollback(ctx, uint64(98)).
+		Return(nil).
+		Times(1)
+
+	module.Start(ctx)
+
+	module.blocks.Set(99, &[]blob.Blob{})
+	module.blocks.Set(101, &[]blob.Blob{})
+
+	input := module.MustInput(RollbackInputName)
+	input.Push(storage.State{LastHeight: 100})
+	input.Push(storage.State{LastHeight: 98})
+
+	for module.blocks.Len() != 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	cancel()
+	err = module.Close()
+	require.NoError(t, err, "closing module")
+	require.EqualValues(t, 98, module.head)
+}
+
+func TestBlobSaverModuleDropQueued(t *testing.T) {
+	module, err := NewModule("mock", blob.Config{})
+	require.NoError(t, err, "create module")
+	module.head = 90
+
+	b := &types.Blob{
+		NamespaceId: []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10},
+		Data:        []byte{0, 1, 2, 3},
+	}
+
+	input := module.MustInput(InputName)
+	input.Push(&Msg{Height: 95, Blob: b})
+	input.Push(&Msg{Height: 99, Blob: b})
+	input.Push(&Msg{Height: 100, Blob: b})
+
+	module.dropQueued(context.Background(), 98)
+
+	require.Equal(t, 1, module.blocks.Len())
+	_, ok := module.blocks.Get(95)
+	require.True(t, ok)
+	require.Len(t, input.Listen(), 0)
+}
+
+func TestBlobSaverModuleRetryFailedBlock(t *testing.T) {
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	blobStorage := blob.NewMockStorage(ctrl)
+
+	module, err := NewModule("mock", blob.Config{})
+	require.NoError(t, err, "create module")
+	module.storage = blobStorage
+	module.head = 100
+
+	block101 := []blob.Blob{{Height: 101}}
+	block102 := []blob.Blob{{Height: 102}}
+	module.blocks.Set(101, &block101)
+
+	gomock.InOrder(
+		blobStorage.EXPECT().
+			SaveBulk(ctx, block101).
+			Return(errors.New("unavailable")).
+			Times(1),
+		blobStorage.EXPECT().
+			SaveBulk(ctx, block101).
+			Return(nil).
+			Times(1),
+		blobStorage.EXPECT().
+			UpdateHead(ctx, uint64(101)).
+			Return(nil).
+			Times(1),
+		blobStorage.EXPECT().
+			SaveBulk(ctx, block102).
+			Return(nil).
+			Times(1),
+		blobStorage.EXPECT().
+			UpdateHead(ctx, uint64(102)).
+			Return(nil).
+			Times(1),
+	)
+
+	err = module.processEndOfBlock(ctx, 101)
+	require.Error(t, err)
+	require.EqualValues(t, 100, module.head)
+	_, ok := module.blocks.Get(101)
+	require.True(t, ok)
+
+	module.blocks.Set(102, &block102)
+	err = module.processEndOfBlock(ctx, 102)
+	require.NoError(t, err)
+	require.EqualValues(t, 102, module.head)
+	require.Equal(t, 0, module.blocks.Len())
+	require.Len(t, module.pending, 0)
+}
@@ -4,6 +4,8 @@
 package config
 
 import (
+	"github.com/celenium-io/celestia-indexer/internal/blob"
+	"github.com/celenium-io/celestia-indexer/internal/blob/decoder"
 	"github.com/celenium-io/celestia-indexer/internal/profiler"
 	"github.com/dipdup-net/go-lib/config"
 )
@@ -16,12 +18,15 @@ type Config struct {
 }
 
 type Indexer struct {
-	Name         string `validate:"omitempty"          yaml:"name"`
-	ThreadsCount uint32 `validate:"omitempty,min=1"    yaml:"threads_count"`
-	StartLevel   int64  `validate:"omitempty"          yaml:"start_level"`
-	BlockPeriod  int64  `validate:"omitempty"          yaml:"block_period"`
-	ScriptsDir   string `validate:"omitempty,dir"      yaml:"scripts_dir"`
-	BlobSaver    string `validate:"omitempty,oneof=r2" yaml:"blob_saver"`
+	Name         string         `validate:"omitempty"                yaml:"name"`
+	ThreadsCount uint32         `validate:"omitempty,min=1"          yaml:"threads_count"`
+	StartLevel   int64          `validate:"omitempty"                yaml:"start_level"`
+	BlockPeriod  int64          `validate:"omitempty"                yaml:"block_period"`
+	ScriptsDir   string         `validate:"omitempty,dir"            yaml:"scripts_dir"`
+	BlobSaver    string         `validate:"omitempty,oneof=r2 s3 fs" yaml:"blob_saver"`
+	BlobStorage  blob.Config    `validate:"omitempty"                yaml:"blob_storage"`
+	VerifyDah    bool           `validate:"omitempty"                yaml:"verify_dah"`
+	BlobDecoders decoder.Config `validate:"omitempty"                yaml:"blob_decoders"`
 }
 
 // Substitute -
@@ -6,6 +6,7 @@ package context
 import (
 	"cosmossdk.io/errors"
 	"github.com/celenium-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
 	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode/decoder"
 	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
 	"github.com/dipdup-net/indexer-sdk/pkg/sync"
@@ -16,13 +17,34 @@ type Context struct {
 	Addresses   *sync.Map[string, *storage.Address]
 	Delegations *sync.Map[string, *storage.Delegation]
 	Jails       *sync.Map[string, *storage.Jail]
+	Proposals   *sync.Map[uint64, *storage.Proposal]
+
+	Groups         *sync.Map[uint64, *storage.Group]
+	GroupPolicies  *sync.Map[string, *storage.GroupPolicy]
+	GroupProposals *sync.Map[uint64, *storage.GroupProposal]
 
 	Redelegations   []storage.Redelegation
 	Undelegations   []storage.Undelegation
 	CancelUnbonding []storage.Undelegation
 	StakingLogs     []storage.StakingLog
+	Votes           []storage.Vote
+	Deposits        []storage.Deposit
+	BalanceHistory  []*storage.BalanceHistory
+	Transfers       []*storage.Transfer
+	Attestations    []*storage.BlobstreamAttestation
+	Slashes         []*storage.Slash
+	GroupVotes      []*storage.GroupVote
+
+	// BeginBlockHistory - count of balance history items made by begin block events. They are placed at the head of BalanceHistory.
+	BeginBlockHistory int
 
 	Block *storage.Block
+
+	tx                *storage.Tx
+	balanceHistory    map[string]*storage.BalanceHistory
+	balanceCause      storageTypes.BalanceHistoryType
+	eventBalanceCause storageTypes.BalanceHistoryType
+	infractions       map[string]pkgTypes.Level
 }
 
 func NewContext() *Context {
@@ -31,14 +53,30 @@ func NewContext() *Context {
 		Addresses:       sync.NewMap[string, *storage.Address](),
 		Delegations:     sync.NewMap[string, *storage.Delegation](),
 		Jails:           sync.NewMap[string, *storage.Jail](),
+		Proposals:       sync.NewMap[uint64, *storage.Proposal](),
+		Groups:          sync.NewMap[uint64, *storage.Group](),
+		GroupPolicies:   sync.NewMap[string, *storage.GroupPolicy](),
+		GroupProposals:  sync.NewMap[uint64, *storage.GroupProposal](),
 		Redelegations:   make([]storage.Redelegation, 0),
 		Undelegations:   make([]storage.Undelegation, 0),
 		CancelUnbonding: make([]storage.Undelegation, 0),
 		StakingLogs:     make([]storage.StakingLog, 0),
+		Votes:           make([]storage.Vote, 0),
+		Deposits:        make([]storage.Deposit, 0),
+		BalanceHistory:  make([]*storage.BalanceHistory, 0),
+		Transfers:       make([]*storage.Transfer, 0),
+		Attestations:    make([]*storage.BlobstreamAttestation, 0),
+		Slashes:         make([]*storage.Slash, 0),
+		GroupVotes:      make([]*storage.GroupVote, 0),
+		infractions:     make(map[string]pkgTypes.Level),
+		balanceHistory:  make(map[string]*storage.BalanceHistory),
+		balanceCause:    storageTypes.BalanceHistoryTypeTransfer,
 	}
 }
 
 func (ctx *Context) AddAddress(address *storage.Address) error {
+	ctx.addBalanceHistory(address)
+
 	if addr, ok := ctx.Addresses.Get(address.String()); ok {
 		addr.Balance.Spendable = addr.Balance.Spendable.Add(address.Balance.Spendable)
 		addr.Balance.Delegated = addr.Balance.Delegated.Add(address.Balance.Delegated)
@@ -56,6 +94,110 @@ func (ctx *Context) AddAddress(address *storage.Address) error {
 	return nil
 }
 
+// SetBalanceCause - sets cause of the following balance changes. It resets cause set by SetEventBalanceCause.
+func (ctx *Context) SetBalanceCause(cause storageTypes.BalanceHistoryType) {
+	ctx.balanceCause = cause
+	ctx.eventBalanceCause = ""
+}
+
+// SetEventBalanceCause - overrides cause of balance changes until the next call. Empty cause removes overriding.
+func (ctx *Context) SetEventBalanceCause(cause storageTypes.BalanceHistoryType) {
+	ctx.eventBalanceCause = cause
+}
+
+// SetTx - sets transaction which events are parsed. Nil is set for begin and end block events.
+func (ctx *Context) SetTx(tx *storage.Tx) {
+	ctx.tx = tx
+}
+
+// FinishBeginBlock - marks the end of begin block events. It should be called before parsing of transactions.
+func (ctx *Context) FinishBeginBlock() {
+	ctx.BeginBlockHistory = len(ctx.BalanceHistory)
+	ctx.StartBalancePhase()
+}
+
+// StartBalancePhase - starts the next phase of the block: transactions or end block events.
+// Balance changes of different phases are not merged into the same balance history item.
+func (ctx *Context) StartBalancePhase() {
+	ctx.balanceHistory = make(map[string]*storage.BalanceHistory)
+}
+
+// AddTransfer - adds transfer and links it with the current transaction
+func (ctx *Context) AddTransfer(transfer *storage.Transfer) {
+	transfer.Tx = ctx.tx
+	ctx.Transfers = append(ctx.Transfers, transfer)
+}
+
+// AddAttestation - adds blobstream attestation request
+func (ctx *Context) AddAttestation(attestation *storage.BlobstreamAttestation) {
+	ctx.Attestations = append(ctx.Attestations, attestation)
+}
+
+// AddSlash - adds slashing of validator and links it with the current transaction.
+// Infraction height is set from evidence of the validator if it was not set before.
+func (ctx *Context) AddSlash(slash *storage.Slash) {
+	slash.Tx = ctx.tx
+	if slash.InfractionHeight == 0 {
+		slash.InfractionHeight = ctx.infractions[slash.Validator.ConsAddress]
+	}
+	ctx.Slashes = append(ctx.Slashes, slash)
+}
+
+// AddInfraction - saves infraction height of the validator received from evidence and sets it to already added slashes of the validator
+func (ctx *Context) AddInfraction(consAddress string, height pkgTypes.Level) {
+	if ctx.infractions == nil {
+		ctx.infractions = make(map[string]pkgTypes.Level)
+	}
+	ctx.infractions[consAddress] = height
+
+	for i := range ctx.Slashes {
+		if ctx.Slashes[i].InfractionHeight == 0 && ctx.Slashes[i].Validator.ConsAddress == consAddress {
+			ctx.Slashes[i].InfractionHeight = height
+		}
+	}
+}
+
+func (ctx *Context) addBalanceHistory(address *storage.Address) {
+	balance := address.Balance
+	if balance.Spendable.IsZero() && balance.Delegated.IsZero() && balance.Unbonding.IsZero() {
+		return
+	}
+
+	cause := ctx.balanceCause
+	if ctx.eventBalanceCause != "" {
+		cause = ctx.eventBalanceCause
+	}
+
+	if ctx.balanceHistory == nil {
+		ctx.balanceHistory = make(map[string]*storage.BalanceHistory)
+	}
+
+	key := address.String() + "/" + cause.String()
+	if h, ok := ctx.balanceHistory[key]; ok {
+		h.SpendableChange = h.SpendableChange.Add(balance.Spendable)
+		h.DelegatedChange = h.DelegatedChange.Add(balance.Delegated)
+		h.UnbondingChange = h.UnbondingChange.Add(balance.Unbonding)
+		return
+	}
+
+	h := &storage.BalanceHistory{
+		Currency:        balance.Currency,
+		Type:            cause,
+		SpendableChange: balance.Spendable.Copy(),
+		DelegatedChange: balance.Delegated.Copy(),
+		UnbondingChange: balance.Unbonding.Copy(),
+		Address: &storage.Address{
+			Address: address.Address,
+		},
+	}
+	if ctx.Block != nil {
+		h.Height = ctx.Block.Height
+		h.Time = ctx.Block.Time
+	}
+	ctx.balanceHistory[key] = h
+	ctx.BalanceHistory = append(ctx.BalanceHistory, h)
+}
+
 func (ctx *Context) AddValidator(validator storage.Validator) {
 	if val, ok := ctx.Validators.Get(validator.Address); ok {
 		if !validator.Stake.IsZero() {
@@ -73,11 +215,13 @@ func (ctx *Context) AddValidator(validator storage.Validator) {
 		if !validator.MaxRate.IsZero() {
 			val.MaxRate = validator.MaxRate.Copy()
 		}
-		if !validator.MinSelfDelegation.IsZero() {
+		if validator.MinSelfDelegationChanged {
 			val.MinSelfDelegation = validator.MinSelfDelegation.Copy()
+			val.MinSelfDelegationChanged = true
 		}
-		if !validator.Rate.IsZero() {
+		if validator.RateChanged {
 			val.Rate = validator.Rate.Copy()
+			val.RateChanged = true
 		}
 		if validator.Delegator != "" {
 			val.Delegator = validator.Delegator
@@ -172,3 +316,196 @@ func (ctx *Context) AddJail(jail storage.Jail) {
 func (ctx *Context) AddStakingLog(l storage.StakingLog) {
 	ctx.StakingLogs = append(ctx.StakingLogs, l)
 }
+
+func (ctx *Context) AddProposal(proposal *storage.Proposal) {
+	if p, ok := ctx.Proposals.Get(proposal.Id); ok {
+		if proposal.Status != "" {
+			p.Status = proposal.Status
+		}
+		if proposal.ActivationHeight != nil {
+			p.ActivationHeight = proposal.ActivationHeight
+			p.ActivationTime = proposal.ActivationTime
+		}
+		if proposal.EndHeight != nil {
+			p.EndHeight = proposal.EndHeight
+			p.EndTime = proposal.EndTime
+		}
+		p.Deposit = p.Deposit.Add(proposal.Deposit)
+		p.VotesCount += proposal.VotesCount
+		p.Yes += proposal.Yes
+		p.No += proposal.No
+		p.NoWithVeto += proposal.NoWithVeto
+		p.Abstain += proposal.Abstain
+	} else {
+		ctx.Proposals.Set(proposal.Id, proposal)
+	}
+}
+
+func (ctx *Context) GetProposals() []*storage.Proposal {
+	proposals := make([]*storage.Proposal, 0)
+	_ = ctx.Proposals.Range(func(_ uint64, value *storage.Proposal) (error, bool) {
+		proposals = append(proposals, value)
+		return nil, false
+	})
+	return proposals
+}
+
+// AddVotes - adds options of the voter's vote. Previous vote of the voter for the same proposal in the block is replaced
+// because only the latest vote is counted. Proposal tally is recomputed from stored votes.
+func (ctx *Context) AddVotes(votes ...storage.Vote) {
+	if len(votes) == 0 {
+		return
+	}
+
+	filtered := ctx.Votes[:0]
+	for i := range ctx.Votes {
+		if ctx.Votes[i].ProposalId == votes[0].ProposalId && ctx.Votes[i].Voter.Address == votes[0].Voter.Address {
+			continue
+		}
+		filtered = append(filtered, ctx.Votes[i])
+	}
+	ctx.Votes = append(filtered, votes...)
+}
+
+func (ctx *Context) AddDeposit(deposit storage.Deposit) {
+	ctx.Deposits = append(ctx.Deposits, deposit)
+	ctx.AddProposal(&storage.Proposal{
+		Id:      deposit.ProposalId,
+		Deposit: deposit.Amount,
+	})
+}
+
+// AddGroup - adds group or merges changes of already added group. Changes are linked with the current transaction.
+func (ctx *Context) AddGroup(group *storage.Group) {
+	for i := range group.Members {
+		if group.Members[i].Tx == nil {
+			group.Members[i].Tx = ctx.tx
+		}
+	}
+
+	g, ok := ctx.Groups.Get(group.Id)
+	if !ok {
+		group.Tx = ctx.tx
+		ctx.Groups.Set(group.Id, group)
+		return
+	}
+	if group.Admin != nil {
+		g.Admin = group.Admin
+	}
+	if group.Metadata != storage.DoNotModify {
+		g.Metadata = group.Metadata
+	}
+	g.Members = append(g.Members, group.Members...)
+}
+
+func (ctx *Context) GetGroups() []*storage.Group {
+	groups := make([]*storage.Group, 0)
+	_ = ctx.Groups.Range(func(_ uint64, value *storage.Group) (error, bool) {
+		groups = append(groups, value)
+		return nil, false
+	})
+	return groups
+}
+
+// AddGroupPolicy - adds group policy or merges changes of already added policy. Changes are linked with the current transaction.
+func (ctx *Context) AddGroupPolicy(policy *storage.GroupPolicy) {
+	p, ok := ctx.GroupPolicies.Get(policy.Address.Address)
+	if !ok {
+		policy.Tx = ctx.tx
+		ctx.GroupPolicies.Set(policy.Address.Address, policy)
+		return
+	}
+	if policy.GroupId > 0 {
+		p.GroupId = policy.GroupId
+	}
+	if policy.Admin != nil {
+		p.Admin = policy.Admin
+	}
+	if policy.Metadata != storage.DoNotModify {
+		p.Metadata = policy.Metadata
+	}
+	if policy.DecisionPolicyType != "" {
+		p.DecisionPolicyType = policy.DecisionPolicyType
+		p.Threshold = policy.Threshold
+		p.VotingPeriod = policy.VotingPeriod
+		p.MinExecutionPeriod = policy.MinExecutionPeriod
+	}
+}
+
+func (ctx *Context) GetGroupPolicies() []*storage.GroupPolicy {
+	policies := make([]*storage.GroupPolicy, 0)
+	_ = ctx.GroupPolicies.Range(func(_ string, value *storage.GroupPolicy) (error, bool) {
+		policies = append(policies, value)
+		return nil, false
+	})
+	return policies
+}
+
+// AddGroupProposal - adds group proposal or merges changes of already added proposal.
+// Final status of proposal received from events is not overwritten by status of submission.
+func (ctx *Context) AddGroupProposal(proposal *storage.GroupProposal) {
+	p, ok := ctx.GroupProposals.Get(proposal.Id)
+	if !ok {
+		if proposal.Height > 0 {
+			proposal.Tx = ctx.tx
+		}
+		ctx.GroupProposals.Set(proposal.Id, proposal)
+		return
+	}
+
+	if p.Height == 0 && proposal.Height > 0 {
+		p.Height = proposal.Height
+		p.Time = proposal.Time
+		p.Tx = ctx.tx
+		p.PolicyAddress = proposal.PolicyAddress
+		p.Proposers = proposal.Proposers
+		p.Metadata = proposal.Metadata
+		p.Messages = proposal.Messages
+	}
+	if proposal.Status != "" && (p.Status == "" || p.Status == storageTypes.GroupProposalStatusSubmitted) {
+		p.Status = proposal.Status
+	}
+	if proposal.ExecutorResult != "" {
+		p.ExecutorResult = proposal.ExecutorResult
+	}
+	if proposal.EndHeight != nil {
+		p.EndHeight = proposal.EndHeight
+		p.EndTime = proposal.EndTime
+	}
+	p.VotesCount += proposal.VotesCount
+	p.Yes += proposal.Yes
+	p.No += proposal.No
+	p.NoWithVeto += proposal.NoWithVeto
+	p.Abstain += proposal.Abstain
+}
+
+func (ctx *Context) GetGroupProposals() []*storage.GroupProposal {
+	proposals := make([]*storage.GroupProposal, 0)
+	_ = ctx.GroupProposals.Range(func(_ uint64, value *storage.GroupProposal) (error, bool) {
+		proposals = append(proposals, value)
+		return nil, false
+	})
+	return proposals
+}
+
+// AddGroupVote - adds vote for group proposal, links it with the current transaction and increments proposal votes counters
+func (ctx *Context) AddGroupVote(vote *storage.GroupVote) {
+	vote.Tx = ctx.tx
+	ctx.GroupVotes = append(ctx.GroupVotes, vote)
+
+	proposal := storage.GroupProposal{
+		Id:         vote.ProposalId,
+		VotesCount: 1,
+	}
+	switch vote.Option {
+	case storageTypes.VoteOptionYes:
+		proposal.Yes = 1
+	case storageTypes.VoteOptionNo:
+		proposal.No = 1
+	case storageTypes.VoteOptionNoWithVeto:
+		proposal.NoWithVeto = 1
+	case storageTypes.VoteOptionAbstain:
+		proposal.Abstain = 1
+	}
+	ctx.AddGroupProposal(&proposal)
+}
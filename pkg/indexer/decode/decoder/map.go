@@ -57,6 +57,18 @@ func BalanceFromMap(m map[string]any, key string) (*types.Coin, error) {
 	return &coin, nil
 }
 
+func CoinsFromMap(m map[string]any, key string) (types.Coins, error) {
+	str := StringFromMap(m, key)
+	if str == "" {
+		return nil, nil
+	}
+	coins, err := types.ParseCoinsNormalized(str)
+	if err != nil {
+		return nil, err
+	}
+	return coins, nil
+}
+
 func AmountFromMap(m map[string]any, key string) decimal.Decimal {
 	str := StringFromMap(m, key)
 	if str == "" {
@@ -102,3 +114,15 @@ func AuthMsgIndexFromMap(m map[string]any) (*int64, error) {
 	i, err := strconv.ParseInt(str, 10, 64)
 	return &i, err
 }
+
+func Uint64FromMap(m map[string]any, key string) (uint64, error) {
+	val, ok := m[key]
+	if !ok {
+		return 0, errors.Errorf("can't find key: %s", key)
+	}
+	str, ok := val.(string)
+	if !ok {
+		return 0, errors.Errorf("key '%s' is not a string", key)
+	}
+	return strconv.ParseUint(str, 10, 64)
+}
@@ -4,10 +4,17 @@
 package decode
 
 import (
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
 
+	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
 	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode/decoder"
 	"github.com/cosmos/cosmos-sdk/types"
+	icaTypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	ibcTypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	"github.com/goccy/go-json"
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
 )
@@ -42,6 +49,27 @@ func NewCoinSpent(m map[string]any) (body CoinSpent, err error) {
 	return
 }
 
+type Transfer struct {
+	Amount    types.Coins
+	Recipient string
+	Sender    string
+}
+
+func NewTransfer(m map[string]any) (body Transfer, err error) {
+	body.Recipient = decoder.StringFromMap(m, "recipient")
+	if body.Recipient == "" {
+		err = errors.Errorf("recipient key not found in %##v", m)
+		return
+	}
+	body.Sender = decoder.StringFromMap(m, "sender")
+	if body.Sender == "" {
+		err = errors.Errorf("sender key not found in %##v", m)
+		return
+	}
+	body.Amount, err = decoder.CoinsFromMap(m, "amount")
+	return
+}
+
 type CompleteRedelegation struct {
 	Amount        *types.Coin
 	Delegator     string
@@ -257,3 +285,410 @@ func NewSlash(m map[string]any) (body Slash, err error) {
 	body.Address = decoder.StringFromMap(m, "address")
 	return
 }
+
+type SubmitProposal struct {
+	ProposalId        uint64
+	VotingPeriodStart uint64
+}
+
+func NewSubmitProposal(m map[string]any) (body SubmitProposal, err error) {
+	if _, ok := m["voting_period_start"]; ok {
+		body.VotingPeriodStart, err = decoder.Uint64FromMap(m, "voting_period_start")
+		return
+	}
+	body.ProposalId, err = decoder.Uint64FromMap(m, "proposal_id")
+	return
+}
+
+type ProposalDeposit struct {
+	Amount            decimal.Decimal
+	ProposalId        uint64
+	VotingPeriodStart uint64
+}
+
+func NewProposalDeposit(m map[string]any) (body ProposalDeposit, err error) {
+	if _, ok := m["voting_period_start"]; ok {
+		body.VotingPeriodStart, err = decoder.Uint64FromMap(m, "voting_period_start")
+		return
+	}
+	body.ProposalId, err = decoder.Uint64FromMap(m, "proposal_id")
+	if err != nil {
+		return
+	}
+	body.Amount = decoder.Amount(m)
+	return
+}
+
+var voteOptionRe = regexp.MustCompile(`option:(VOTE_OPTION_[A-Z_]+)\s+weight:"([0-9.]+)"`)
+
+type WeightedVoteOption struct {
+	Option storageTypes.VoteOption
+	Weight decimal.Decimal
+}
+
+type ProposalVote struct {
+	Voter      string
+	ProposalId uint64
+	Options    []WeightedVoteOption
+}
+
+func NewProposalVote(m map[string]any) (body ProposalVote, err error) {
+	body.Voter = decoder.StringFromMap(m, "voter")
+	if body.Voter == "" {
+		err = errors.Errorf("voter key not found in %##v", m)
+		return
+	}
+	body.ProposalId, err = decoder.Uint64FromMap(m, "proposal_id")
+	if err != nil {
+		return
+	}
+	options := decoder.StringFromMap(m, "option")
+	for _, match := range voteOptionRe.FindAllStringSubmatch(options, -1) {
+		option, err := parseVoteOption(match[1])
+		if err != nil {
+			return body, err
+		}
+		weight, err := decimal.NewFromString(match[2])
+		if err != nil {
+			return body, err
+		}
+		body.Options = append(body.Options, WeightedVoteOption{
+			Option: option,
+			Weight: weight,
+		})
+	}
+	if len(body.Options) == 0 {
+		err = errors.Errorf("can't parse vote options: %s", options)
+	}
+	return
+}
+
+func parseVoteOption(value string) (storageTypes.VoteOption, error) {
+	switch value {
+	case "VOTE_OPTION_YES":
+		return storageTypes.VoteOptionYes, nil
+	case "VOTE_OPTION_NO":
+		return storageTypes.VoteOptionNo, nil
+	case "VOTE_OPTION_NO_WITH_VETO":
+		return storageTypes.VoteOptionNoWithVeto, nil
+	case "VOTE_OPTION_ABSTAIN":
+		return storageTypes.VoteOptionAbstain, nil
+	default:
+		return "", errors.Errorf("unknown vote option: %s", value)
+	}
+}
+
+type ProposalStatus struct {
+	ProposalId uint64
+	Result     storageTypes.ProposalStatus
+}
+
+func NewProposalStatus(m map[string]any) (body ProposalStatus, err error) {
+	body.ProposalId, err = decoder.Uint64FromMap(m, "proposal_id")
+	if err != nil {
+		return
+	}
+	result := decoder.StringFromMap(m, "proposal_result")
+	switch result {
+	case "proposal_passed":
+		body.Result = storageTypes.ProposalStatusApplied
+	case "proposal_rejected":
+		body.Result = storageTypes.ProposalStatusRejected
+	case "proposal_failed":
+		body.Result = storageTypes.ProposalStatusFailed
+	case "proposal_dropped":
+		body.Result = storageTypes.ProposalStatusRemoved
+	default:
+		err = errors.Errorf("unknown proposal result: %s", result)
+	}
+	return
+}
+
+type CreateClient struct {
+	Id   string
+	Type string
+}
+
+func NewCreateClient(m map[string]any) (body CreateClient, err error) {
+	body.Id = decoder.StringFromMap(m, "client_id")
+	if body.Id == "" {
+		err = errors.Errorf("client_id key not found in %##v", m)
+		return
+	}
+	body.Type = decoder.StringFromMap(m, "client_type")
+	return
+}
+
+type ConnectionChange struct {
+	ConnectionId             string
+	ClientId                 string
+	CounterpartyConnectionId string
+	CounterpartyClientId     string
+}
+
+func NewConnectionChange(m map[string]any) (body ConnectionChange, err error) {
+	body.ConnectionId = decoder.StringFromMap(m, "connection_id")
+	if body.ConnectionId == "" {
+		err = errors.Errorf("connection_id key not found in %##v", m)
+		return
+	}
+	body.ClientId = decoder.StringFromMap(m, "client_id")
+	body.CounterpartyConnectionId = decoder.StringFromMap(m, "counterparty_connection_id")
+	body.CounterpartyClientId = decoder.StringFromMap(m, "counterparty_client_id")
+	return
+}
+
+type ChannelChange struct {
+	ChannelId             string
+	PortId                string
+	CounterpartyChannelId string
+	CounterpartyPortId    string
+	ConnectionId          string
+	Version               string
+}
+
+func NewChannelChange(m map[string]any) (body ChannelChange, err error) {
+	body.ChannelId = decoder.StringFromMap(m, "channel_id")
+	if body.ChannelId == "" {
+		err = errors.Errorf("channel_id key not found in %##v", m)
+		return
+	}
+	body.PortId = decoder.StringFromMap(m, "port_id")
+	body.CounterpartyChannelId = decoder.StringFromMap(m, "counterparty_channel_id")
+	body.CounterpartyPortId = decoder.StringFromMap(m, "counterparty_port_id")
+	body.ConnectionId = decoder.StringFromMap(m, "connection_id")
+	body.Version = decoder.StringFromMap(m, "version")
+	return
+}
+
+type Packet struct {
+	Sequence   uint64
+	SrcPort    string
+	SrcChannel string
+	DstPort    string
+	DstChannel string
+	Connection string
+	Timeout    *time.Time
+	Data       string
+}
+
+func NewPacket(m map[string]any) (body Packet, err error) {
+	body.Sequence, err = decoder.Uint64FromMap(m, "packet_sequence")
+	if err != nil {
+		return
+	}
+	body.SrcPort = decoder.StringFromMap(m, "packet_src_port")
+	body.SrcChannel = decoder.StringFromMap(m, "packet_src_channel")
+	body.DstPort = decoder.StringFromMap(m, "packet_dst_port")
+	body.DstChannel = decoder.StringFromMap(m, "packet_dst_channel")
+	body.Connection = decoder.StringFromMap(m, "packet_connection")
+	body.Data = decoder.StringFromMap(m, "packet_data")
+
+	if _, ok := m["packet_timeout_timestamp"]; ok {
+		timeout, err := decoder.Int64FromMap(m, "packet_timeout_timestamp")
+		if err != nil {
+			return body, err
+		}
+		if timeout > 0 {
+			t := time.Unix(0, timeout).UTC()
+			body.Timeout = &t
+		}
+	}
+	return
+}
+
+type FungibleTokenPacketData struct {
+	Denom    string
+	Amount   decimal.Decimal
+	Sender   string
+	Receiver string
+	Memo     string
+}
+
+func NewFungibleTokenPacketData(data string) (body FungibleTokenPacketData, err error) {
+	var packet ibcTypes.FungibleTokenPacketData
+	if err = json.Unmarshal([]byte(data), &packet); err != nil {
+		return
+	}
+	body.Amount, err = decimal.NewFromString(packet.Amount)
+	if err != nil {
+		return
+	}
+	body.Denom = packet.Denom
+	body.Sender = packet.Sender
+	body.Receiver = packet.Receiver
+	body.Memo = packet.Memo
+	return
+}
+
+type InterchainAccountMetadata struct {
+	ControllerConnectionId string
+	HostConnectionId       string
+	Address                string
+}
+
+func NewInterchainAccountMetadata(version string) (body InterchainAccountMetadata, err error) {
+	var metadata icaTypes.Metadata
+	if err = icaTypes.ModuleCdc.UnmarshalJSON([]byte(version), &metadata); err != nil {
+		return
+	}
+	body.ControllerConnectionId = metadata.ControllerConnectionId
+	body.HostConnectionId = metadata.HostConnectionId
+	body.Address = metadata.Address
+	return
+}
+
+type InterchainAccountPacketData struct {
+	Memo     string
+	Messages []string
+}
+
+func NewInterchainAccountPacketData(data string) (body InterchainAccountPacketData, err error) {
+	var packet icaTypes.InterchainAccountPacketData
+	if err = icaTypes.ModuleCdc.UnmarshalJSON([]byte(data), &packet); err != nil {
+		return
+	}
+	if packet.Type != icaTypes.EXECUTE_TX {
+		err = errors.Errorf("unexpected interchain account packet type: %s", packet.Type)
+		return
+	}
+
+	var tx icaTypes.CosmosTx
+	if err = tx.Unmarshal(packet.Data); err != nil {
+		return
+	}
+	body.Memo = packet.Memo
+	body.Messages = make([]string, len(tx.Messages))
+	for i := range tx.Messages {
+		body.Messages[i] = tx.Messages[i].TypeUrl
+	}
+	return
+}
+
+type Acknowledgement struct {
+	Success bool
+	Error   string
+}
+
+func NewAcknowledgement(m map[string]any) (body Acknowledgement, err error) {
+	ack := decoder.StringFromMap(m, "packet_ack")
+	if ack == "" {
+		err = errors.Errorf("packet_ack key not found in %##v", m)
+		return
+	}
+	var data struct {
+		Result []byte `json:"result"`
+		Error  string `json:"error"`
+	}
+	if err = json.Unmarshal([]byte(ack), &data); err != nil {
+		return
+	}
+	body.Success = data.Error == ""
+	body.Error = data.Error
+	return
+}
+
+type AttestationRequest struct {
+	Nonce uint64
+}
+
+func NewAttestationRequest(m map[string]any) (body AttestationRequest, err error) {
+	body.Nonce, err = decoder.Uint64FromMap(m, "nonce")
+	return
+}
+
+// group module emits typed events which attribute values are JSON encoded
+func groupString(m map[string]any, key string) string {
+	return strings.Trim(decoder.StringFromMap(m, key), `"`)
+}
+
+func groupUint64(m map[string]any, key string) (uint64, error) {
+	if _, ok := m[key]; !ok {
+		return 0, errors.Errorf("can't find key: %s", key)
+	}
+	return strconv.ParseUint(groupString(m, key), 10, 64)
+}
+
+type GroupEvent struct {
+	GroupId uint64
+}
+
+func NewGroupEvent(m map[string]any) (body GroupEvent, err error) {
+	body.GroupId, err = groupUint64(m, "group_id")
+	return
+}
+
+type GroupPolicyEvent struct {
+	Address string
+}
+
+func NewGroupPolicyEvent(m map[string]any) (body GroupPolicyEvent, err error) {
+	body.Address = groupString(m, "address")
+	if body.Address == "" {
+		err = errors.Errorf("address key not found in %##v", m)
+	}
+	return
+}
+
+type GroupProposalEvent struct {
+	ProposalId uint64
+}
+
+func NewGroupProposalEvent(m map[string]any) (body GroupProposalEvent, err error) {
+	body.ProposalId, err = groupUint64(m, "proposal_id")
+	return
+}
+
+type GroupExec struct {
+	ProposalId uint64
+	Result     storageTypes.GroupExecutorResult
+}
+
+func NewGroupExec(m map[string]any) (body GroupExec, err error) {
+	body.ProposalId, err = groupUint64(m, "proposal_id")
+	if err != nil {
+		return
+	}
+	switch result := groupString(m, "result"); result {
+	case "PROPOSAL_EXECUTOR_RESULT_NOT_RUN":
+		body.Result = storageTypes.GroupExecutorResultNotRun
+	case "PROPOSAL_EXECUTOR_RESULT_SUCCESS":
+		body.Result = storageTypes.GroupExecutorResultSuccess
+	case "PROPOSAL_EXECUTOR_RESULT_FAILURE":
+		body.Result = storageTypes.GroupExecutorResultFailure
+	default:
+		err = errors.Errorf("unknown proposal executor result: %s", result)
+	}
+	return
+}
+
+type GroupProposalPruned struct {
+	ProposalId uint64
+	Status     storageTypes.GroupProposalStatus
+}
+
+func NewGroupProposalPruned(m map[string]any) (body GroupProposalPruned, err error) {
+	body.ProposalId, err = groupUint64(m, "proposal_id")
+	if err != nil {
+		return
+	}
+	body.Status, err = parseGroupProposalStatus(groupString(m, "status"))
+	return
+}
+
+func parseGroupProposalStatus(value string) (storageTypes.GroupProposalStatus, error) {
+	switch value {
+	case "PROPOSAL_STATUS_SUBMITTED":
+		return storageTypes.GroupProposalStatusSubmitted, nil
+	case "PROPOSAL_STATUS_ACCEPTED":
+		return storageTypes.GroupProposalStatusAccepted, nil
+	case "PROPOSAL_STATUS_REJECTED":
+		return storageTypes.GroupProposalStatusRejected, nil
+	case "PROPOSAL_STATUS_ABORTED":
+		return storageTypes.GroupProposalStatusAborted, nil
+	case "PROPOSAL_STATUS_WITHDRAWN":
+		return storageTypes.GroupProposalStatusWithdrawn, nil
+	default:
+		return "", errors.Errorf("unknown group proposal status: %s", value)
+	}
+}
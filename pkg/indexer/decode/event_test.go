@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
 	testsuite "github.com/celenium-io/celestia-indexer/internal/test_suite"
 	"github.com/cosmos/cosmos-sdk/types"
 	"github.com/shopspring/decimal"
@@ -105,6 +106,70 @@ func TestNewCoinReceived(t *testing.T) {
 	}
 }
 
+func TestNewTransfer(t *testing.T) {
+	tests := []struct {
+		name     string
+		m        map[string]any
+		wantBody Transfer
+		wantErr  bool
+	}{
+		{
+			name: "test 1",
+			m: map[string]any{
+				"recipient": "recipient",
+				"sender":    "sender",
+				"amount":    "1utia",
+			},
+			wantBody: Transfer{
+				Recipient: "recipient",
+				Sender:    "sender",
+				Amount:    types.NewCoins(types.NewCoin("utia", types.OneInt())),
+			},
+		}, {
+			name: "test 2",
+			m: map[string]any{
+				"recipient": "recipient",
+				"sender":    "sender",
+				"amount":    "2ibc/C4CFF46FD6DE35CA4CF4CE031E643C8FDC9BA4B99AE598E9B0ED98FE3A2319F9,1utia",
+			},
+			wantBody: Transfer{
+				Recipient: "recipient",
+				Sender:    "sender",
+				Amount: types.NewCoins(
+					types.NewCoin("utia", types.OneInt()),
+					types.NewCoin("ibc/C4CFF46FD6DE35CA4CF4CE031E643C8FDC9BA4B99AE598E9B0ED98FE3A2319F9", types.NewInt(2)),
+				),
+			},
+		}, {
+			name: "test 3",
+			m: map[string]any{
+				"sender": "sender",
+				"amount": "1utia",
+			},
+			wantErr: true,
+		}, {
+			name: "test 4",
+			m: map[string]any{
+				"recipient": "recipient",
+				"sender":    "sender",
+				"amount":    "invalid",
+			},
+			wantErr: true,
+			wantBody: Transfer{
+				Recipient: "recipient",
+				Sender:    "sender",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotBody, err := NewTransfer(tt.m)
+			require.True(t, (err != nil) == tt.wantErr)
+			require.Equal(t, tt.wantBody, gotBody)
+		})
+	}
+}
+
 func TestNewCompleteRedelegation(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -343,3 +408,354 @@ func TestNewUnbond(t *testing.T) {
 		})
 	}
 }
+
+func TestNewProposalVote(t *testing.T) {
+	tests := []struct {
+		name     string
+		m        map[string]any
+		wantBody ProposalVote
+		wantErr  bool
+	}{
+		{
+			name: "test 1",
+			m: map[string]any{
+				"voter":       "celestia1vnflc6322f8z7cpl28r7un5dxhmjxghc20aydq",
+				"option":      "option:VOTE_OPTION_YES weight:\"1.000000000000000000\"",
+				"proposal_id": "2",
+			},
+			wantBody: ProposalVote{
+				Voter:      "celestia1vnflc6322f8z7cpl28r7un5dxhmjxghc20aydq",
+				ProposalId: 2,
+				Options: []WeightedVoteOption{
+					{
+						Option: storageTypes.VoteOptionYes,
+						Weight: decimal.RequireFromString("1.000000000000000000"),
+					},
+				},
+			},
+		}, {
+			name: "test 2",
+			m: map[string]any{
+				"voter":       "celestia1vnflc6322f8z7cpl28r7un5dxhmjxghc20aydq",
+				"option":      "option:VOTE_OPTION_NO weight:\"0.700000000000000000\"\noption:VOTE_OPTION_NO_WITH_VETO weight:\"0.300000000000000000\"",
+				"proposal_id": "3",
+			},
+			wantBody: ProposalVote{
+				Voter:      "celestia1vnflc6322f8z7cpl28r7un5dxhmjxghc20aydq",
+				ProposalId: 3,
+				Options: []WeightedVoteOption{
+					{
+						Option: storageTypes.VoteOptionNo,
+						Weight: decimal.RequireFromString("0.700000000000000000"),
+					}, {
+						Option: storageTypes.VoteOptionNoWithVeto,
+						Weight: decimal.RequireFromString("0.300000000000000000"),
+					},
+				},
+			},
+		}, {
+			name: "test 3",
+			m: map[string]any{
+				"option":      "option:VOTE_OPTION_YES weight:\"1.000000000000000000\"",
+				"proposal_id": "2",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotBody, err := NewProposalVote(tt.m)
+			require.True(t, (err != nil) == tt.wantErr)
+			require.Equal(t, tt.wantBody, gotBody)
+		})
+	}
+}
+
+func TestNewProposalStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		m        map[string]any
+		wantBody ProposalStatus
+		wantErr  bool
+	}{
+		{
+			name: "passed",
+			m: map[string]any{
+				"proposal_id":     "1",
+				"proposal_result": "proposal_passed",
+			},
+			wantBody: ProposalStatus{
+				ProposalId: 1,
+				Result:     storageTypes.ProposalStatusApplied,
+			},
+		}, {
+			name: "dropped",
+			m: map[string]any{
+				"proposal_id":     "4",
+				"proposal_result": "proposal_dropped",
+			},
+			wantBody: ProposalStatus{
+				ProposalId: 4,
+				Result:     storageTypes.ProposalStatusRemoved,
+			},
+		}, {
+			name: "unknown result",
+			m: map[string]any{
+				"proposal_id":     "4",
+				"proposal_result": "unknown",
+			},
+			wantBody: ProposalStatus{
+				ProposalId: 4,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotBody, err := NewProposalStatus(tt.m)
+			require.True(t, (err != nil) == tt.wantErr)
+			require.Equal(t, tt.wantBody, gotBody)
+		})
+	}
+}
+
+func TestNewPacket(t *testing.T) {
+	timeout := time.Unix(0, 1705495524000000000).UTC()
+
+	tests := []struct {
+		name     string
+		m        map[string]any
+		wantBody Packet
+		wantErr  bool
+	}{
+		{
+			name: "send packet",
+			m: map[string]any{
+				"packet_channel_ordering":  "ORDER_UNORDERED",
+				"packet_connection":        "connection-2",
+				"packet_data":              `{"amount":"1000","denom":"utia","receiver":"osmo1mm8yykm46ec3t0dgwls70g0jvtm055wkuvxanc","sender":"celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8"}`,
+				"packet_dst_channel":       "channel-6994",
+				"packet_dst_port":          "transfer",
+				"packet_sequence":          "12",
+				"packet_src_channel":       "channel-2",
+				"packet_src_port":          "transfer",
+				"packet_timeout_height":    "0-0",
+				"packet_timeout_timestamp": "1705495524000000000",
+			},
+			wantBody: Packet{
+				Sequence:   12,
+				SrcPort:    "transfer",
+				SrcChannel: "channel-2",
+				DstPort:    "transfer",
+				DstChannel: "channel-6994",
+				Connection: "connection-2",
+				Timeout:    &timeout,
+				Data:       `{"amount":"1000","denom":"utia","receiver":"osmo1mm8yykm46ec3t0dgwls70g0jvtm055wkuvxanc","sender":"celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8"}`,
+			},
+		}, {
+			name: "timeout packet without timestamp",
+			m: map[string]any{
+				"packet_dst_channel":       "channel-6994",
+				"packet_dst_port":          "transfer",
+				"packet_sequence":          "13",
+				"packet_src_channel":       "channel-2",
+				"packet_src_port":          "transfer",
+				"packet_timeout_height":    "1-100",
+				"packet_timeout_timestamp": "0",
+			},
+			wantBody: Packet{
+				Sequence:   13,
+				SrcPort:    "transfer",
+				SrcChannel: "channel-2",
+				DstPort:    "transfer",
+				DstChannel: "channel-6994",
+			},
+		}, {
+			name: "without sequence",
+			m: map[string]any{
+				"packet_src_channel": "channel-2",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotBody, err := NewPacket(tt.m)
+			require.True(t, (err != nil) == tt.wantErr)
+			require.Equal(t, tt.wantBody, gotBody)
+		})
+	}
+}
+
+func TestNewFungibleTokenPacketData(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		wantBody FungibleTokenPacketData
+		wantErr  bool
+	}{
+		{
+			name: "test 1",
+			data: `{"amount":"1000","denom":"transfer/channel-2/utia","memo":"memo","receiver":"celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8","sender":"osmo1mm8yykm46ec3t0dgwls70g0jvtm055wkuvxanc"}`,
+			wantBody: FungibleTokenPacketData{
+				Amount:   decimal.RequireFromString("1000"),
+				Denom:    "transfer/channel-2/utia",
+				Memo:     "memo",
+				Receiver: "celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8",
+				Sender:   "osmo1mm8yykm46ec3t0dgwls70g0jvtm055wkuvxanc",
+			},
+		}, {
+			name:    "invalid json",
+			data:    `{"amount":`,
+			wantErr: true,
+		}, {
+			name:    "invalid amount",
+			data:    `{"amount":"abc","denom":"utia"}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotBody, err := NewFungibleTokenPacketData(tt.data)
+			require.True(t, (err != nil) == tt.wantErr)
+			if !tt.wantErr {
+				require.Equal(t, tt.wantBody, gotBody)
+			}
+		})
+	}
+}
+
+func TestNewInterchainAccountMetadata(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  string
+		wantBody InterchainAccountMetadata
+		wantErr  bool
+	}{
+		{
+			name:    "test 1",
+			version: `{"version":"ics27-1","controller_connection_id":"connection-1","host_connection_id":"connection-0","address":"celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8","encoding":"proto3","tx_type":"sdk_multi_msg"}`,
+			wantBody: InterchainAccountMetadata{
+				ControllerConnectionId: "connection-1",
+				HostConnectionId:       "connection-0",
+				Address:                "celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8",
+			},
+		}, {
+			name:    "ics20 version",
+			version: "ics20-1",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotBody, err := NewInterchainAccountMetadata(tt.version)
+			require.True(t, (err != nil) == tt.wantErr)
+			if !tt.wantErr {
+				require.Equal(t, tt.wantBody, gotBody)
+			}
+		})
+	}
+}
+
+func TestNewInterchainAccountPacketData(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		wantBody InterchainAccountPacketData
+		wantErr  bool
+	}{
+		{
+			name: "test 1",
+			data: `{"data":"CiUKIy9jb3Ntb3Muc3Rha2luZy52MWJldGExLk1zZ0RlbGVnYXRlCh4KHC9jb3Ntb3MuYmFuay52MWJldGExLk1zZ1NlbmQ=","memo":"memo","type":"TYPE_EXECUTE_TX"}`,
+			wantBody: InterchainAccountPacketData{
+				Memo:     "memo",
+				Messages: []string{"/cosmos.staking.v1beta1.MsgDelegate", "/cosmos.bank.v1beta1.MsgSend"},
+			},
+		}, {
+			name:    "unspecified type",
+			data:    `{"data":"","type":"TYPE_UNSPECIFIED"}`,
+			wantErr: true,
+		}, {
+			name:    "invalid json",
+			data:    `{"data":`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotBody, err := NewInterchainAccountPacketData(tt.data)
+			require.True(t, (err != nil) == tt.wantErr)
+			if !tt.wantErr {
+				require.Equal(t, tt.wantBody, gotBody)
+			}
+		})
+	}
+}
+
+func TestNewAcknowledgement(t *testing.T) {
+	tests := []struct {
+		name     string
+		m        map[string]any
+		wantBody Acknowledgement
+		wantErr  bool
+	}{
+		{
+			name: "result",
+			m: map[string]any{
+				"packet_ack": `{"result":"EgA="}`,
+			},
+			wantBody: Acknowledgement{
+				Success: true,
+			},
+		}, {
+			name: "error",
+			m: map[string]any{
+				"packet_ack": `{"error":"ABCI code: 5: error handling packet: see events for details"}`,
+			},
+			wantBody: Acknowledgement{
+				Error: "ABCI code: 5: error handling packet: see events for details",
+			},
+		}, {
+			name:    "without ack",
+			m:       map[string]any{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotBody, err := NewAcknowledgement(tt.m)
+			require.True(t, (err != nil) == tt.wantErr)
+			require.Equal(t, tt.wantBody, gotBody)
+		})
+	}
+}
+
+func TestNewAttestationRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		m        map[string]any
+		wantBody AttestationRequest
+		wantErr  bool
+	}{
+		{
+			name: "test 1",
+			m: map[string]any{
+				"nonce": "12",
+			},
+			wantBody: AttestationRequest{
+				Nonce: 12,
+			},
+		}, {
+			name:    "without nonce",
+			m:       map[string]any{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotBody, err := NewAttestationRequest(tt.m)
+			require.True(t, (err != nil) == tt.wantErr)
+			require.Equal(t, tt.wantBody, gotBody)
+		})
+	}
+}
@@ -35,7 +35,7 @@ func MsgGrant(ctx *context.Context, status types.Status, m *authz.MsgGrant) (sto
 // MsgExec attempts to execute the provided messages using
 // authorizations granted to the grantee. Each message should have only
 // one signer corresponding to the granter of the authorization.
-func MsgExec(ctx *context.Context, status types.Status, m *authz.MsgExec) (storageTypes.MsgType, []storage.AddressWithType, []string, error) {
+func MsgExec(ctx *context.Context, m *authz.MsgExec) (storageTypes.MsgType, []storage.AddressWithType, error) {
 	msgType := storageTypes.MsgExec
 	addresses, err := createAddresses(ctx, addressesData{
 		{t: storageTypes.MsgAddressTypeGrantee, address: m.Grantee},
@@ -44,22 +44,9 @@ func MsgExec(ctx *context.Context, status types.Status, m *authz.MsgExec) (stora
 	// MsgExecute also has Msgs field, where also can be addresses.
 	// Authorization Msg requests to execute. Each msg must implement Authorization interface
 	// The x/authz will try to find a grant matching (msg.signers[0], grantee, MsgTypeURL(msg))
-	// triple and validate it.
+	// triple and validate it. Internal messages are decoded separately as child messages.
 
-	if err != nil {
-		return msgType, addresses, nil, err
-	}
-
-	if status == types.StatusFailed {
-		return msgType, addresses, nil, nil
-	}
-
-	msgs := make([]string, len(m.Msgs))
-	for i := range m.Msgs {
-		msgs[i] = m.Msgs[i].TypeUrl
-	}
-
-	return msgType, addresses, msgs, nil
+	return msgType, addresses, err
 }
 
 // MsgRevoke revokes any authorization with the provided sdk.Msg type on the
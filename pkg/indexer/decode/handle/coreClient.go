@@ -12,13 +12,34 @@ import (
 	tmTypes "github.com/cosmos/ibc-go/v6/modules/light-clients/07-tendermint/types"
 )
 
+const tendermintClientStateType = "/ibc.lightclients.tendermint.v1.ClientState"
+
 // MsgCreateClient defines a message to create an IBC client
-func MsgCreateClient(ctx *context.Context, m *coreClient.MsgCreateClient) (storageTypes.MsgType, []storage.AddressWithType, error) {
+func MsgCreateClient(ctx *context.Context, status types.Status, m *coreClient.MsgCreateClient) (storageTypes.MsgType, []storage.AddressWithType, *storage.IbcClient, error) {
 	msgType := storageTypes.MsgCreateClient
 	addresses, err := createAddresses(ctx, addressesData{
 		{t: storageTypes.MsgAddressTypeSigner, address: m.Signer},
 	}, ctx.Block.Height)
-	return msgType, addresses, err
+	if err != nil || status == types.StatusFailed {
+		return msgType, addresses, nil, err
+	}
+
+	client := &storage.IbcClient{
+		Height:    ctx.Block.Height,
+		CreatedAt: ctx.Block.Time,
+		Creator: &storage.Address{
+			Address: m.Signer,
+		},
+	}
+
+	if m.ClientState != nil && m.ClientState.TypeUrl == tendermintClientStateType {
+		var cs tmTypes.ClientState
+		if err := cs.Unmarshal(m.ClientState.Value); err != nil {
+			return msgType, addresses, nil, err
+		}
+		client.ChainId = cs.ChainId
+	}
+	return msgType, addresses, client, nil
 }
 
 // MsgUpdateClient defines a sdk.Msg to update an IBC client state using the given header
@@ -4,18 +4,42 @@
 package handle
 
 import (
+	"encoding/hex"
+	"strings"
+
 	"github.com/celenium-io/celestia-indexer/internal/storage"
 	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
 	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode/context"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
 	evidenceTypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	"github.com/pkg/errors"
 )
 
+const typeUrlEquivocation = "/cosmos.evidence.v1beta1.Equivocation"
+
 // MsgSubmitEvidence represents a message that supports submitting arbitrary
 // Evidence of misbehavior such as equivocation or counterfactual signing.
-func MsgSubmitEvidence(ctx *context.Context, m *evidenceTypes.MsgSubmitEvidence) (storageTypes.MsgType, []storage.AddressWithType, error) {
+func MsgSubmitEvidence(ctx *context.Context, status storageTypes.Status, m *evidenceTypes.MsgSubmitEvidence) (storageTypes.MsgType, []storage.AddressWithType, error) {
 	msgType := storageTypes.MsgSubmitEvidence
 	addresses, err := createAddresses(ctx, addressesData{
 		{t: storageTypes.MsgAddressTypeSubmitter, address: m.Submitter},
 	}, ctx.Block.Height)
-	return msgType, addresses, err
+	if err != nil || status == storageTypes.StatusFailed {
+		return msgType, addresses, err
+	}
+
+	if m.Evidence == nil || m.Evidence.TypeUrl != typeUrlEquivocation {
+		return msgType, addresses, nil
+	}
+
+	var equivocation evidenceTypes.Equivocation
+	if err := equivocation.Unmarshal(m.Evidence.Value); err != nil {
+		return msgType, addresses, errors.Wrap(err, "equivocation evidence")
+	}
+	_, hash, err := pkgTypes.Address(equivocation.ConsensusAddress).Decode()
+	if err != nil {
+		return msgType, addresses, errors.Wrap(err, "equivocation consensus address")
+	}
+	ctx.AddInfraction(strings.ToUpper(hex.EncodeToString(hash)), pkgTypes.Level(equivocation.Height))
+	return msgType, addresses, nil
 }
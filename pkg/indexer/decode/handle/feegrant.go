@@ -4,12 +4,15 @@
 package handle
 
 import (
+	"github.com/celenium-io/celestia-indexer/internal/currency"
 	"github.com/celenium-io/celestia-indexer/internal/storage"
 	"github.com/celenium-io/celestia-indexer/internal/storage/types"
 	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
 	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode/context"
+	cosmosTypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	"github.com/fatih/structs"
+	"github.com/shopspring/decimal"
 )
 
 // MsgGrantAllowance adds permission for Grantee to spend up to Allowance
@@ -75,6 +78,7 @@ func parseGrantFee(m *feegrant.MsgGrantAllowance, g *storage.Grant) error {
 		}
 		g.Params = structs.Map(body)
 		g.Expiration = body.Expiration
+		g.SpendLimit = feeSpendLimit(body.SpendLimit)
 	case "/cosmos.feegrant.v1beta1.PeriodicAllowance":
 		var body feegrant.PeriodicAllowance
 		if err := body.Unmarshal(m.Allowance.Value); err != nil {
@@ -82,6 +86,7 @@ func parseGrantFee(m *feegrant.MsgGrantAllowance, g *storage.Grant) error {
 		}
 		g.Params = structs.Map(body)
 		g.Expiration = body.Basic.Expiration
+		g.SpendLimit = feeSpendLimit(body.Basic.SpendLimit)
 	case "/cosmos.feegrant.v1beta1.AllowedMsgAllowance":
 		var body feegrant.AllowedMsgAllowance
 		if err := body.Unmarshal(m.Allowance.Value); err != nil {
@@ -95,6 +100,15 @@ func parseGrantFee(m *feegrant.MsgGrantAllowance, g *storage.Grant) error {
 		g.Params = structs.Map(body)
 		g.Params["Allowance"] = basic
 		g.Expiration = basic.Expiration
+		g.SpendLimit = feeSpendLimit(basic.SpendLimit)
 	}
 	return nil
 }
+
+func feeSpendLimit(coins cosmosTypes.Coins) *decimal.Decimal {
+	if coins.Empty() {
+		return nil
+	}
+	limit := decimal.NewFromBigInt(coins.AmountOf(currency.Utia).BigInt(), 0)
+	return &limit
+}
@@ -4,15 +4,63 @@
 package handle
 
 import (
+	"encoding/json"
+	"sort"
+	"strings"
+
 	"github.com/celenium-io/celestia-indexer/internal/storage"
 	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
 	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode/context"
+	cosmosTypes "github.com/cosmos/cosmos-sdk/types"
+	distrTypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	cosmosGovTypesV1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	cosmosGovTypesV1Beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	paramsProposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	upgradeTypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	ibcClientTypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	"github.com/pkg/errors"
 )
 
-// MsgSubmitProposal defines a sdk.Msg type that supports submitting arbitrary
+// MsgSubmitProposalV1 defines a sdk.Msg type that supports submitting arbitrary
+// proposal Content.
+func MsgSubmitProposalV1(ctx *context.Context, status storageTypes.Status, m *cosmosGovTypesV1.MsgSubmitProposal) (storageTypes.MsgType, []storage.AddressWithType, *storage.Proposal, error) {
+	msgType, addresses, err := msgSubmitProposal(ctx, m.Proposer)
+	if err != nil || status == storageTypes.StatusFailed {
+		return msgType, addresses, nil, err
+	}
+
+	proposal := newProposal(ctx, m.Proposer)
+	proposal.Metadata = m.Metadata
+
+	// type and changes of the proposal are defined by its first message
+	if len(m.Messages) > 0 {
+		if err := proposalFromMessage(proposal, m.Messages[0].GetCachedValue()); err != nil {
+			return msgType, addresses, nil, err
+		}
+	}
+	return msgType, addresses, proposal, nil
+}
+
+// MsgSubmitProposalV1Beta1 defines a sdk.Msg type that supports submitting arbitrary
 // proposal Content.
-func MsgSubmitProposal(ctx *context.Context, proposerAddress string) (storageTypes.MsgType, []storage.AddressWithType, error) {
+func MsgSubmitProposalV1Beta1(ctx *context.Context, status storageTypes.Status, m *cosmosGovTypesV1Beta1.MsgSubmitProposal) (storageTypes.MsgType, []storage.AddressWithType, *storage.Proposal, error) {
+	msgType, addresses, err := msgSubmitProposal(ctx, m.Proposer)
+	if err != nil || status == storageTypes.StatusFailed {
+		return msgType, addresses, nil, err
+	}
+
+	proposal := newProposal(ctx, m.Proposer)
+	if m.Content != nil {
+		if content := m.GetContent(); content != nil {
+			if err := proposalFromContent(proposal, content); err != nil {
+				return msgType, addresses, nil, err
+			}
+		}
+	}
+	return msgType, addresses, proposal, nil
+}
+
+func msgSubmitProposal(ctx *context.Context, proposerAddress string) (storageTypes.MsgType, []storage.AddressWithType, error) {
 	msgType := storageTypes.MsgSubmitProposal
 	addresses, err := createAddresses(ctx, addressesData{
 		{t: storageTypes.MsgAddressTypeProposer, address: proposerAddress},
@@ -20,6 +68,114 @@ func MsgSubmitProposal(ctx *context.Context, proposerAddress string) (storageTyp
 	return msgType, addresses, err
 }
 
+func newProposal(ctx *context.Context, proposer string) *storage.Proposal {
+	return &storage.Proposal{
+		Height:    ctx.Block.Height,
+		CreatedAt: ctx.Block.Time,
+		Status:    storageTypes.ProposalStatusInactive,
+		Type:      storageTypes.ProposalTypeUnknown,
+		Proposer: &storage.Address{
+			Address: proposer,
+		},
+	}
+}
+
+func proposalFromMessage(proposal *storage.Proposal, msg any) (err error) {
+	switch typedMsg := msg.(type) {
+	case *cosmosGovTypesV1.MsgExecLegacyContent:
+		content, err := cosmosGovTypesV1.LegacyContentFromMessage(typedMsg)
+		if err != nil {
+			return errors.Wrap(err, "legacy content of proposal")
+		}
+		return proposalFromContent(proposal, content)
+	case *upgradeTypes.MsgSoftwareUpgrade:
+		proposal.Type = storageTypes.ProposalTypeSoftwareUpgrade
+		proposal.Changes, err = json.Marshal(typedMsg.Plan)
+	case *upgradeTypes.MsgCancelUpgrade:
+		proposal.Type = storageTypes.ProposalTypeCancelSoftwareUpgrade
+	case cosmosTypes.Msg:
+		changes, ok, paramsErr := paramChangesFromMessage(cosmosTypes.MsgTypeURL(typedMsg), typedMsg)
+		switch {
+		case paramsErr != nil:
+			return errors.Wrap(paramsErr, "params of proposal")
+		case ok:
+			proposal.Type = storageTypes.ProposalTypeParamChanged
+			proposal.Changes, err = json.Marshal(changes)
+		default:
+			proposal.Changes, err = json.Marshal(typedMsg)
+		}
+	}
+	return
+}
+
+// paramChangesFromMessage - converts `MsgUpdateParams`-style message to the list of parameter changes.
+// Module of the parameters is taken from the package of message type url, e.g. `/cosmos.staking.v1beta1.MsgUpdateParams`.
+func paramChangesFromMessage(typeUrl string, msg any) ([]paramsProposal.ParamChange, bool, error) {
+	parts := strings.Split(strings.TrimPrefix(typeUrl, "/"), ".")
+	if len(parts) < 3 {
+		return nil, false, nil
+	}
+	name := parts[len(parts)-1]
+	if !strings.HasPrefix(name, "MsgUpdate") || !strings.HasSuffix(name, "Params") {
+		return nil, false, nil
+	}
+
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return nil, false, err
+	}
+	var body struct {
+		Params map[string]json.RawMessage `json:"params"`
+	}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return nil, false, err
+	}
+	if len(body.Params) == 0 {
+		return nil, false, nil
+	}
+
+	keys := make([]string, 0, len(body.Params))
+	for key := range body.Params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	changes := make([]paramsProposal.ParamChange, len(keys))
+	for i := range keys {
+		changes[i] = paramsProposal.ParamChange{
+			Subspace: parts[len(parts)-3],
+			Key:      keys[i],
+			Value:    string(body.Params[keys[i]]),
+		}
+	}
+	return changes, true, nil
+}
+
+func proposalFromContent(proposal *storage.Proposal, content cosmosGovTypesV1Beta1.Content) (err error) {
+	proposal.Title = content.GetTitle()
+	proposal.Description = content.GetDescription()
+
+	switch typedContent := content.(type) {
+	case *cosmosGovTypesV1Beta1.TextProposal:
+		proposal.Type = storageTypes.ProposalTypeText
+	case *paramsProposal.ParameterChangeProposal:
+		proposal.Type = storageTypes.ProposalTypeParamChanged
+		proposal.Changes, err = json.Marshal(typedContent.Changes)
+	case *distrTypes.CommunityPoolSpendProposal:
+		proposal.Type = storageTypes.ProposalTypeCommunityPoolSpend
+		proposal.Changes, err = json.Marshal(typedContent)
+	case *ibcClientTypes.ClientUpdateProposal:
+		proposal.Type = storageTypes.ProposalTypeClientUpdate
+		proposal.Changes, err = json.Marshal(typedContent)
+	case *upgradeTypes.SoftwareUpgradeProposal:
+		proposal.Type = storageTypes.ProposalTypeSoftwareUpgrade
+		proposal.Changes, err = json.Marshal(typedContent.Plan)
+	case *upgradeTypes.CancelSoftwareUpgradeProposal:
+		proposal.Type = storageTypes.ProposalTypeCancelSoftwareUpgrade
+	}
+	return
+}
+
 // MsgExecLegacyContent is used to wrap the legacy content field into a message.
 // This ensures backwards compatibility with v1beta1.MsgSubmitProposal.
 func MsgExecLegacyContent(ctx *context.Context, m *cosmosGovTypesV1.MsgExecLegacyContent) (storageTypes.MsgType, []storage.AddressWithType, error) {
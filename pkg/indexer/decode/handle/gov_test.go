@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handle
+
+import (
+	"testing"
+
+	paramsProposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/stretchr/testify/require"
+)
+
+type testUpdateParams struct {
+	Authority string         `json:"authority"`
+	Params    map[string]any `json:"params"`
+}
+
+func TestParamChangesFromMessage(t *testing.T) {
+	msg := testUpdateParams{
+		Authority: "celestia10d07y265gmmuvt4z0w9aw880jnsr700jtgz4v7",
+		Params: map[string]any{
+			"max_validators":  100,
+			"unbonding_time":  "1814400s",
+			"historical_keys": []string{"a", "b"},
+		},
+	}
+
+	changes, ok, err := paramChangesFromMessage("/cosmos.staking.v1beta1.MsgUpdateParams", msg)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, []paramsProposal.ParamChange{
+		{Subspace: "staking", Key: "historical_keys", Value: `["a","b"]`},
+		{Subspace: "staking", Key: "max_validators", Value: "100"},
+		{Subspace: "staking", Key: "unbonding_time", Value: `"1814400s"`},
+	}, changes)
+
+	changes, ok, err = paramChangesFromMessage("/celestia.blob.v1.MsgUpdateBlobParams", msg)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Len(t, changes, 3)
+	require.Equal(t, "blob", changes[0].Subspace)
+
+	_, ok, err = paramChangesFromMessage("/cosmos.bank.v1beta1.MsgSend", msg)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, ok, err = paramChangesFromMessage("/cosmos.staking.v1beta1.MsgUpdateParams", testUpdateParams{})
+	require.NoError(t, err)
+	require.False(t, ok)
+}
@@ -4,108 +4,236 @@
 package handle
 
 import (
+	"encoding/json"
+
 	"github.com/celenium-io/celestia-indexer/internal/storage"
 	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
 	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode/context"
+	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cosmosTypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
 )
 
 // MsgCreateGroup is the Msg/CreateGroup request type.
-func MsgCreateGroup(ctx *context.Context, m *group.MsgCreateGroup) (storageTypes.MsgType, []storage.AddressWithType, error) {
+func MsgCreateGroup(ctx *context.Context, status storageTypes.Status, m *group.MsgCreateGroup) (storageTypes.MsgType, []storage.AddressWithType, *storage.Group, error) {
 	msgType := storageTypes.MsgCreateGroup
-	addresses, err := createAddresses(ctx, addressesData{
+	addresses, err := createAddresses(ctx, append(addressesData{
 		{t: storageTypes.MsgAddressTypeAdmin, address: m.Admin},
-	}, ctx.Block.Height)
-	return msgType, addresses, err
+	}, groupMembersAddresses(m.Members)...), ctx.Block.Height)
+	if err != nil || status == storageTypes.StatusFailed {
+		return msgType, addresses, nil, err
+	}
+
+	g, err := newGroup(ctx, m.Admin, m.Metadata, m.Members)
+	return msgType, addresses, g, err
 }
 
 // MsgUpdateGroupMembers is the Msg/UpdateGroupMembers request type.
-func MsgUpdateGroupMembers(ctx *context.Context, m *group.MsgUpdateGroupMembers) (storageTypes.MsgType, []storage.AddressWithType, error) {
+func MsgUpdateGroupMembers(ctx *context.Context, status storageTypes.Status, m *group.MsgUpdateGroupMembers) (storageTypes.MsgType, []storage.AddressWithType, error) {
 	msgType := storageTypes.MsgUpdateGroupMembers
-	addresses, err := createAddresses(ctx, addressesData{
+	addresses, err := createAddresses(ctx, append(addressesData{
 		{t: storageTypes.MsgAddressTypeAdmin, address: m.Admin},
-	}, ctx.Block.Height)
-	return msgType, addresses, err
+	}, groupMembersAddresses(m.MemberUpdates)...), ctx.Block.Height)
+	if err != nil || status == storageTypes.StatusFailed {
+		return msgType, addresses, err
+	}
+
+	members, err := newGroupMembers(ctx, m.MemberUpdates)
+	if err != nil {
+		return msgType, addresses, err
+	}
+	ctx.AddGroup(&storage.Group{
+		Id:       m.GroupId,
+		Height:   ctx.Block.Height,
+		Time:     ctx.Block.Time,
+		Metadata: storage.DoNotModify,
+		Members:  members,
+	})
+	return msgType, addresses, nil
 }
 
 // MsgUpdateGroupAdmin is the Msg/UpdateGroupAdmin request type.
-func MsgUpdateGroupAdmin(ctx *context.Context, m *group.MsgUpdateGroupAdmin) (storageTypes.MsgType, []storage.AddressWithType, error) {
+func MsgUpdateGroupAdmin(ctx *context.Context, status storageTypes.Status, m *group.MsgUpdateGroupAdmin) (storageTypes.MsgType, []storage.AddressWithType, error) {
 	msgType := storageTypes.MsgUpdateGroupAdmin
 	addresses, err := createAddresses(ctx, addressesData{
 		{t: storageTypes.MsgAddressTypeAdmin, address: m.Admin},
 		{t: storageTypes.MsgAddressTypeNewAdmin, address: m.NewAdmin},
 	}, ctx.Block.Height)
-	return msgType, addresses, err
+	if err != nil || status == storageTypes.StatusFailed {
+		return msgType, addresses, err
+	}
+
+	ctx.AddGroup(&storage.Group{
+		Id:       m.GroupId,
+		Height:   ctx.Block.Height,
+		Time:     ctx.Block.Time,
+		Metadata: storage.DoNotModify,
+		Admin: &storage.Address{
+			Address: m.NewAdmin,
+		},
+	})
+	return msgType, addresses, nil
 }
 
 // MsgUpdateGroupMetadata is the Msg/UpdateGroupMetadata request type.
-func MsgUpdateGroupMetadata(ctx *context.Context, m *group.MsgUpdateGroupMetadata) (storageTypes.MsgType, []storage.AddressWithType, error) {
+func MsgUpdateGroupMetadata(ctx *context.Context, status storageTypes.Status, m *group.MsgUpdateGroupMetadata) (storageTypes.MsgType, []storage.AddressWithType, error) {
 	msgType := storageTypes.MsgUpdateGroupMetadata
 	addresses, err := createAddresses(ctx, addressesData{
 		{t: storageTypes.MsgAddressTypeAdmin, address: m.Admin},
 	}, ctx.Block.Height)
-	return msgType, addresses, err
+	if err != nil || status == storageTypes.StatusFailed {
+		return msgType, addresses, err
+	}
+
+	ctx.AddGroup(&storage.Group{
+		Id:       m.GroupId,
+		Height:   ctx.Block.Height,
+		Time:     ctx.Block.Time,
+		Metadata: m.Metadata,
+	})
+	return msgType, addresses, nil
 }
 
 // MsgCreateGroupPolicy is the Msg/CreateGroupPolicy request type.
-func MsgCreateGroupPolicy(ctx *context.Context, m *group.MsgCreateGroupPolicy) (storageTypes.MsgType, []storage.AddressWithType, error) {
+func MsgCreateGroupPolicy(ctx *context.Context, status storageTypes.Status, m *group.MsgCreateGroupPolicy) (storageTypes.MsgType, []storage.AddressWithType, *storage.GroupPolicy, error) {
 	msgType := storageTypes.MsgCreateGroupPolicy
 	addresses, err := createAddresses(ctx, addressesData{
 		{t: storageTypes.MsgAddressTypeAdmin, address: m.Admin},
 	}, ctx.Block.Height)
-	return msgType, addresses, err
+	if err != nil || status == storageTypes.StatusFailed {
+		return msgType, addresses, nil, err
+	}
+
+	policy := newGroupPolicy(ctx, m.Admin, m.Metadata)
+	policy.GroupId = m.GroupId
+	if m.DecisionPolicy != nil {
+		if err := setDecisionPolicy(policy, m.GetDecisionPolicy); err != nil {
+			return msgType, addresses, nil, err
+		}
+	}
+	return msgType, addresses, policy, nil
 }
 
 // MsgUpdateGroupPolicyAdmin is the Msg/UpdateGroupPolicyAdmin request type.
-func MsgUpdateGroupPolicyAdmin(ctx *context.Context, m *group.MsgUpdateGroupPolicyAdmin) (storageTypes.MsgType, []storage.AddressWithType, error) {
+func MsgUpdateGroupPolicyAdmin(ctx *context.Context, status storageTypes.Status, m *group.MsgUpdateGroupPolicyAdmin) (storageTypes.MsgType, []storage.AddressWithType, error) {
 	msgType := storageTypes.MsgUpdateGroupPolicyAdmin
 	addresses, err := createAddresses(ctx, addressesData{
 		{t: storageTypes.MsgAddressTypeAdmin, address: m.Admin},
 	}, ctx.Block.Height)
-	return msgType, addresses, err
+	if err != nil || status == storageTypes.StatusFailed {
+		return msgType, addresses, err
+	}
+
+	policy := newGroupPolicy(ctx, m.NewAdmin, storage.DoNotModify)
+	policy.Address = &storage.Address{
+		Address: m.GroupPolicyAddress,
+	}
+	ctx.AddGroupPolicy(policy)
+	return msgType, addresses, nil
 }
 
 // MsgCreateGroupWithPolicy is the Msg/CreateGroupWithPolicy request type.
-func MsgCreateGroupWithPolicy(ctx *context.Context, m *group.MsgCreateGroupWithPolicy) (storageTypes.MsgType, []storage.AddressWithType, error) {
+func MsgCreateGroupWithPolicy(ctx *context.Context, status storageTypes.Status, m *group.MsgCreateGroupWithPolicy) (storageTypes.MsgType, []storage.AddressWithType, *storage.Group, *storage.GroupPolicy, error) {
 	msgType := storageTypes.MsgCreateGroupWithPolicy
-	addresses, err := createAddresses(ctx, addressesData{
+	addresses, err := createAddresses(ctx, append(addressesData{
 		{t: storageTypes.MsgAddressTypeAdmin, address: m.Admin},
-	}, ctx.Block.Height)
-	return msgType, addresses, err
+	}, groupMembersAddresses(m.Members)...), ctx.Block.Height)
+	if err != nil || status == storageTypes.StatusFailed {
+		return msgType, addresses, nil, nil, err
+	}
+
+	g, err := newGroup(ctx, m.Admin, m.GroupMetadata, m.Members)
+	if err != nil {
+		return msgType, addresses, nil, nil, err
+	}
+	policy := newGroupPolicy(ctx, m.Admin, m.GroupPolicyMetadata)
+	if m.DecisionPolicy != nil {
+		if err := setDecisionPolicy(policy, m.GetDecisionPolicy); err != nil {
+			return msgType, addresses, nil, nil, err
+		}
+	}
+
+	// admin of group and policy is the policy account. It will be set in events handler when policy address is known.
+	if m.GroupPolicyAsAdmin {
+		g.Admin = nil
+		policy.Admin = nil
+	}
+	return msgType, addresses, g, policy, nil
 }
 
 // MsgUpdateGroupPolicyDecisionPolicy is the Msg/UpdateGroupPolicyDecisionPolicy request type.
-func MsgUpdateGroupPolicyDecisionPolicy(ctx *context.Context, m *group.MsgUpdateGroupPolicyDecisionPolicy) (storageTypes.MsgType, []storage.AddressWithType, error) {
+func MsgUpdateGroupPolicyDecisionPolicy(ctx *context.Context, status storageTypes.Status, m *group.MsgUpdateGroupPolicyDecisionPolicy) (storageTypes.MsgType, []storage.AddressWithType, error) {
 	msgType := storageTypes.MsgUpdateGroupPolicyDecisionPolicy
 	addresses, err := createAddresses(ctx, addressesData{
 		{t: storageTypes.MsgAddressTypeAdmin, address: m.Admin},
 		{t: storageTypes.MsgAddressTypeGroupPolicyAddress, address: m.GroupPolicyAddress},
 	}, ctx.Block.Height)
-	return msgType, addresses, err
+	if err != nil || status == storageTypes.StatusFailed || m.DecisionPolicy == nil {
+		return msgType, addresses, err
+	}
+
+	policy := newGroupPolicy(ctx, "", storage.DoNotModify)
+	policy.Address = &storage.Address{
+		Address: m.GroupPolicyAddress,
+	}
+	if err := setDecisionPolicy(policy, m.GetDecisionPolicy); err != nil {
+		return msgType, addresses, err
+	}
+	ctx.AddGroupPolicy(policy)
+	return msgType, addresses, nil
 }
 
 // MsgUpdateGroupPolicyMetadata is the Msg/UpdateGroupPolicyMetadata request type.
-func MsgUpdateGroupPolicyMetadata(ctx *context.Context, m *group.MsgUpdateGroupPolicyMetadata) (storageTypes.MsgType, []storage.AddressWithType, error) {
+func MsgUpdateGroupPolicyMetadata(ctx *context.Context, status storageTypes.Status, m *group.MsgUpdateGroupPolicyMetadata) (storageTypes.MsgType, []storage.AddressWithType, error) {
 	msgType := storageTypes.MsgUpdateGroupPolicyMetadata
 	addresses, err := createAddresses(ctx, addressesData{
 		{t: storageTypes.MsgAddressTypeAdmin, address: m.Admin},
 		{t: storageTypes.MsgAddressTypeGroupPolicyAddress, address: m.GroupPolicyAddress},
 	}, ctx.Block.Height)
-	return msgType, addresses, err
+	if err != nil || status == storageTypes.StatusFailed {
+		return msgType, addresses, err
+	}
+
+	policy := newGroupPolicy(ctx, "", m.Metadata)
+	policy.Address = &storage.Address{
+		Address: m.GroupPolicyAddress,
+	}
+	ctx.AddGroupPolicy(policy)
+	return msgType, addresses, nil
 }
 
 // MsgSubmitProposal is the Msg/SubmitProposal request type.
-func MsgSubmitProposalGroup(ctx *context.Context, m *group.MsgSubmitProposal) (storageTypes.MsgType, []storage.AddressWithType, error) {
+func MsgSubmitProposalGroup(ctx *context.Context, status storageTypes.Status, m *group.MsgSubmitProposal) (storageTypes.MsgType, []storage.AddressWithType, *storage.GroupProposal, error) {
 	msgType := storageTypes.MsgSubmitProposalGroup
-	addresses, err := createAddresses(ctx, addressesData{
+	data := addressesData{
 		{t: storageTypes.MsgAddressTypeGroupPolicyAddress, address: m.GroupPolicyAddress},
-		// Proposers - list of proposer addresses
-	}, ctx.Block.Height)
-	return msgType, addresses, err
+	}
+	for i := range m.Proposers {
+		data = append(data, addressData{t: storageTypes.MsgAddressTypeProposer, address: m.Proposers[i]})
+	}
+	addresses, err := createAddresses(ctx, data, ctx.Block.Height)
+	if err != nil || status == storageTypes.StatusFailed {
+		return msgType, addresses, nil, err
+	}
+
+	proposal := &storage.GroupProposal{
+		Height:    ctx.Block.Height,
+		Time:      ctx.Block.Time,
+		Proposers: m.Proposers,
+		Metadata:  m.Metadata,
+		Status:    storageTypes.GroupProposalStatusSubmitted,
+		PolicyAddress: &storage.Address{
+			Address: m.GroupPolicyAddress,
+		},
+	}
+	proposal.Messages, err = groupProposalMessages(m.Messages)
+	return msgType, addresses, proposal, err
 }
 
 // MsgWithdrawProposal is the Msg/WithdrawProposal request type.
-func MsgWithdrawProposal(ctx *context.Context, m *group.MsgWithdrawProposal) (storageTypes.MsgType, []storage.AddressWithType, error) {
+func MsgWithdrawProposal(ctx *context.Context, status storageTypes.Status, m *group.MsgWithdrawProposal) (storageTypes.MsgType, []storage.AddressWithType, error) {
 	msgType := storageTypes.MsgWithdrawProposal
 	addresses, err := createAddresses(ctx, addressesData{
 		{t: storageTypes.MsgAddressTypeAdmin, address: m.Address}, // address is the admin of the group policy or one of the proposer of the proposal.
@@ -114,16 +242,34 @@ func MsgWithdrawProposal(ctx *context.Context, m *group.MsgWithdrawProposal) (st
 }
 
 // MsgVote is the Msg/Vote request type.
-func MsgVoteGroup(ctx *context.Context, m *group.MsgVote) (storageTypes.MsgType, []storage.AddressWithType, error) {
+func MsgVoteGroup(ctx *context.Context, status storageTypes.Status, m *group.MsgVote) (storageTypes.MsgType, []storage.AddressWithType, error) {
 	msgType := storageTypes.MsgVoteGroup
 	addresses, err := createAddresses(ctx, addressesData{
 		{t: storageTypes.MsgAddressTypeVoter, address: m.Voter},
 	}, ctx.Block.Height)
-	return msgType, addresses, err
+	if err != nil || status == storageTypes.StatusFailed {
+		return msgType, addresses, err
+	}
+
+	option, err := groupVoteOption(m.Option)
+	if err != nil {
+		return msgType, addresses, err
+	}
+	ctx.AddGroupVote(&storage.GroupVote{
+		Height:     ctx.Block.Height,
+		Time:       ctx.Block.Time,
+		ProposalId: m.ProposalId,
+		Option:     option,
+		Metadata:   m.Metadata,
+		Voter: &storage.Address{
+			Address: m.Voter,
+		},
+	})
+	return msgType, addresses, nil
 }
 
 // MsgExec is the Msg/Exec request type.
-func MsgExecGroup(ctx *context.Context, m *group.MsgExec) (storageTypes.MsgType, []storage.AddressWithType, error) {
+func MsgExecGroup(ctx *context.Context, status storageTypes.Status, m *group.MsgExec) (storageTypes.MsgType, []storage.AddressWithType, error) {
 	msgType := storageTypes.MsgExecGroup
 	addresses, err := createAddresses(ctx, addressesData{
 		{t: storageTypes.MsgAddressTypeExecutor, address: m.Executor},
@@ -132,10 +278,150 @@ func MsgExecGroup(ctx *context.Context, m *group.MsgExec) (storageTypes.MsgType,
 }
 
 // MsgLeaveGroup is the Msg/LeaveGroup request type.
-func MsgLeaveGroup(ctx *context.Context, m *group.MsgLeaveGroup) (storageTypes.MsgType, []storage.AddressWithType, error) {
+func MsgLeaveGroup(ctx *context.Context, status storageTypes.Status, m *group.MsgLeaveGroup) (storageTypes.MsgType, []storage.AddressWithType, error) {
 	msgType := storageTypes.MsgLeaveGroup
 	addresses, err := createAddresses(ctx, addressesData{
 		{t: storageTypes.MsgAddressTypeGroupMember, address: m.Address},
 	}, ctx.Block.Height)
-	return msgType, addresses, err
+	if err != nil || status == storageTypes.StatusFailed {
+		return msgType, addresses, err
+	}
+
+	ctx.AddGroup(&storage.Group{
+		Id:       m.GroupId,
+		Height:   ctx.Block.Height,
+		Time:     ctx.Block.Time,
+		Metadata: storage.DoNotModify,
+		Members: []*storage.GroupMember{
+			{
+				Height:  ctx.Block.Height,
+				Time:    ctx.Block.Time,
+				GroupId: m.GroupId,
+				Weight:  decimal.Zero,
+				Address: &storage.Address{
+					Address: m.Address,
+				},
+			},
+		},
+	})
+	return msgType, addresses, nil
+}
+
+func groupMembersAddresses(members []group.MemberRequest) addressesData {
+	data := make(addressesData, len(members))
+	for i := range members {
+		data[i] = addressData{t: storageTypes.MsgAddressTypeGroupMember, address: members[i].Address}
+	}
+	return data
+}
+
+func newGroup(ctx *context.Context, admin, metadata string, members []group.MemberRequest) (*storage.Group, error) {
+	groupMembers, err := newGroupMembers(ctx, members)
+	if err != nil {
+		return nil, err
+	}
+	return &storage.Group{
+		Height:   ctx.Block.Height,
+		Time:     ctx.Block.Time,
+		Metadata: metadata,
+		Admin: &storage.Address{
+			Address: admin,
+		},
+		Members: groupMembers,
+	}, nil
+}
+
+// newGroupMembers - converts member requests to members changes. Zero weight means removing of member.
+func newGroupMembers(ctx *context.Context, members []group.MemberRequest) ([]*storage.GroupMember, error) {
+	result := make([]*storage.GroupMember, len(members))
+	for i := range members {
+		weight, err := decimal.NewFromString(members[i].Weight)
+		if err != nil {
+			return nil, errors.Wrapf(err, "weight of group member %s", members[i].Address)
+		}
+		result[i] = &storage.GroupMember{
+			Height:   ctx.Block.Height,
+			Time:     ctx.Block.Time,
+			Weight:   weight,
+			Metadata: members[i].Metadata,
+			Address: &storage.Address{
+				Address: members[i].Address,
+			},
+		}
+	}
+	return result, nil
+}
+
+func newGroupPolicy(ctx *context.Context, admin, metadata string) *storage.GroupPolicy {
+	policy := &storage.GroupPolicy{
+		Height:   ctx.Block.Height,
+		Time:     ctx.Block.Time,
+		Metadata: metadata,
+	}
+	if admin != "" {
+		policy.Admin = &storage.Address{
+			Address: admin,
+		}
+	}
+	return policy
+}
+
+func setDecisionPolicy(policy *storage.GroupPolicy, getter func() (group.DecisionPolicy, error)) error {
+	decisionPolicy, err := getter()
+	if err != nil {
+		return errors.Wrap(err, "decision policy")
+	}
+
+	switch typed := decisionPolicy.(type) {
+	case *group.ThresholdDecisionPolicy:
+		policy.DecisionPolicyType = storageTypes.DecisionPolicyTypeThreshold
+		policy.Threshold, err = decimal.NewFromString(typed.Threshold)
+	case *group.PercentageDecisionPolicy:
+		policy.DecisionPolicyType = storageTypes.DecisionPolicyTypePercentage
+		policy.Threshold, err = decimal.NewFromString(typed.Percentage)
+	default:
+		return errors.Errorf("unknown decision policy type: %T", decisionPolicy)
+	}
+	if err != nil {
+		return errors.Wrap(err, "decision policy threshold")
+	}
+
+	policy.VotingPeriod = int64(decisionPolicy.GetVotingPeriod().Seconds())
+	policy.MinExecutionPeriod = int64(decisionPolicy.GetMinExecutionPeriod().Seconds())
+	return nil
+}
+
+type groupProposalMessage struct {
+	Type  string `json:"type"`
+	Value any    `json:"value"`
+}
+
+func groupProposalMessages(messages []*codecTypes.Any) (json.RawMessage, error) {
+	if len(messages) == 0 {
+		return nil, nil
+	}
+
+	result := make([]groupProposalMessage, len(messages))
+	for i := range messages {
+		result[i].Type = messages[i].TypeUrl
+		if msg, ok := messages[i].GetCachedValue().(cosmosTypes.Msg); ok {
+			result[i].Value = msg
+		}
+	}
+	return json.Marshal(result)
+}
+
+func groupVoteOption(option group.VoteOption) (storageTypes.VoteOption, error) {
+	switch option {
+	case group.VOTE_OPTION_YES:
+		return storageTypes.VoteOptionYes, nil
+	case group.VOTE_OPTION_NO:
+		return storageTypes.VoteOptionNo, nil
+	case group.VOTE_OPTION_NO_WITH_VETO:
+		return storageTypes.VoteOptionNoWithVeto, nil
+	case group.VOTE_OPTION_ABSTAIN:
+		return storageTypes.VoteOptionAbstain, nil
+	default:
+		return "", errors.Errorf("unknown group vote option: %s", option.String())
+	}
 }
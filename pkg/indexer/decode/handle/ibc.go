@@ -7,6 +7,7 @@ import (
 	"github.com/celenium-io/celestia-indexer/internal/storage"
 	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
 	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode/context"
+	"github.com/celenium-io/celestia-indexer/pkg/types"
 	ibcTypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
 )
 
@@ -15,10 +16,13 @@ import (
 // https://github.com/cosmos/ibc/tree/master/spec/app/ics-020-fungible-token-transfer#data-structures
 func IBCTransfer(ctx *context.Context, m *ibcTypes.MsgTransfer) (storageTypes.MsgType, []storage.AddressWithType, error) {
 	msgType := storageTypes.IBCTransfer
-	addresses, err := createAddresses(ctx, addressesData{
+	data := addressesData{
 		{t: storageTypes.MsgAddressTypeSender, address: m.Sender},
-		// {t: storageTypes.MsgAddressTypeReceiver,
-		// address: m.Receiver}, // TODO: is it data to do IBC Transfer on cosmos network?
-	}, ctx.Block.Height)
+	}
+	// receiver is an address on counterparty chain. It's saved only if it's celestia address
+	if types.Address(m.Receiver).IsCelestia() {
+		data = append(data, addressData{t: storageTypes.MsgAddressTypeReceiver, address: m.Receiver})
+	}
+	addresses, err := createAddresses(ctx, data, ctx.Block.Height)
 	return msgType, addresses, err
 }
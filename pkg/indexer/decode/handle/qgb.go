@@ -11,11 +11,22 @@ import (
 )
 
 // MsgRegisterEVMAddress registers an evm address to a validator.
-func MsgRegisterEVMAddress(ctx *context.Context, m *qgbTypes.MsgRegisterEVMAddress) (storageTypes.MsgType, []storage.AddressWithType, error) {
+func MsgRegisterEVMAddress(ctx *context.Context, status storageTypes.Status, m *qgbTypes.MsgRegisterEVMAddress) (storageTypes.MsgType, []storage.AddressWithType, *storage.BlobstreamEvmAddress, error) {
 	msgType := storageTypes.MsgRegisterEVMAddress
 	addresses, err := createAddresses(ctx, addressesData{
 		{t: storageTypes.MsgAddressTypeValidator, address: m.ValidatorAddress},
-		// TODO: think about EVM addresses
 	}, ctx.Block.Height)
-	return msgType, addresses, err
+	if err != nil || status == storageTypes.StatusFailed {
+		return msgType, addresses, nil, err
+	}
+
+	evmAddress := &storage.BlobstreamEvmAddress{
+		Height:     ctx.Block.Height,
+		Time:       ctx.Block.Time,
+		EvmAddress: m.EvmAddress,
+		Validator: &storage.Validator{
+			Address: m.ValidatorAddress,
+		},
+	}
+	return msgType, addresses, evmAddress, nil
 }
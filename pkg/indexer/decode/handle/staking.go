@@ -93,6 +93,7 @@ func MsgCreateValidator(ctx *context.Context, status storageTypes.Status, m *cos
 
 	if !m.Commission.Rate.IsNil() {
 		validator.Rate = decimal.RequireFromString(m.Commission.Rate.String())
+		validator.RateChanged = true
 	}
 
 	if !m.Commission.MaxRate.IsNil() {
@@ -105,6 +106,7 @@ func MsgCreateValidator(ctx *context.Context, status storageTypes.Status, m *cos
 
 	if !m.MinSelfDelegation.IsNil() {
 		validator.MinSelfDelegation = decimal.RequireFromString(m.MinSelfDelegation.String())
+		validator.MinSelfDelegationChanged = true
 	}
 
 	ctx.AddValidator(validator)
@@ -141,9 +143,11 @@ func MsgEditValidator(ctx *context.Context, status storageTypes.Status, m *cosmo
 
 	if m.CommissionRate != nil && !m.CommissionRate.IsNil() {
 		validator.Rate = decimal.RequireFromString(m.CommissionRate.String())
+		validator.RateChanged = true
 	}
 	if m.MinSelfDelegation != nil && !m.MinSelfDelegation.IsNil() {
 		validator.MinSelfDelegation = decimal.RequireFromString(m.MinSelfDelegation.String())
+		validator.MinSelfDelegationChanged = true
 	}
 	ctx.AddValidator(validator)
 	return msgType, addresses, err
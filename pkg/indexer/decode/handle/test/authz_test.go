@@ -6,6 +6,7 @@ package handle_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/celenium-io/celestia-indexer/internal/storage"
 	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
 	testsuite "github.com/celenium-io/celestia-indexer/internal/test_suite"
@@ -14,8 +15,10 @@ import (
 	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
+	cosmosStakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/fatih/structs"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // MsgGrant
@@ -156,7 +159,7 @@ func TestDecodeMsg_SuccessOnMsgExec(t *testing.T) {
 		Namespace:    nil,
 		Size:         49,
 		Addresses:    addressesExpected,
-		InternalMsgs: make([]string, 0),
+		InternalMsgs: make([]*storage.Message, 0),
 	}
 
 	assert.NoError(t, err)
@@ -165,6 +168,48 @@ func TestDecodeMsg_SuccessOnMsgExec(t *testing.T) {
 	assert.Equal(t, addressesExpected, dm.Addresses)
 }
 
+func TestDecodeMsg_SuccessOnMsgExecWithInternalMsgs(t *testing.T) {
+	delegate := cosmosStakingTypes.MsgDelegate{
+		DelegatorAddress: "celestia1vysgwc9mykfz5249g9thjlffx6nha0kkwsvs37",
+		ValidatorAddress: "celestiavaloper12c6cwd0kqlg48sdhjnn9f0z82g0c82fmrl7j9y",
+		Amount: types.Coin{
+			Denom:  "utia",
+			Amount: math.NewInt(1000),
+		},
+	}
+	delegateAny, err := codecTypes.NewAnyWithValue(&delegate)
+	require.NoError(t, err)
+
+	m := &authz.MsgExec{
+		Grantee: "celestia1vnflc6322f8z7cpl28r7un5dxhmjxghc20aydq",
+		Msgs:    []*codecTypes.Any{delegateAny},
+	}
+	blob, now := testsuite.EmptyBlock()
+
+	decodeCtx := context.NewContext()
+	decodeCtx.Block = &storage.Block{
+		Height: blob.Height,
+		Time:   blob.Block.Time,
+	}
+
+	dm, err := decode.Message(decodeCtx, m, 1, storageTypes.StatusSuccess)
+	require.NoError(t, err)
+
+	require.Equal(t, storageTypes.MsgExec, dm.Msg.Type)
+	require.Len(t, dm.Msg.InternalMsgs, 1)
+	require.Len(t, dm.Msg.Addresses, 1)
+
+	internal := dm.Msg.InternalMsgs[0]
+	require.Equal(t, storageTypes.MsgDelegate, internal.Type)
+	require.EqualValues(t, 0, internal.Position)
+	require.Equal(t, blob.Height, internal.Height)
+	require.Equal(t, now, internal.Time)
+	require.Len(t, internal.Addresses, 2)
+	require.Equal(t, storageTypes.MsgAddressTypeDelegator, internal.Addresses[0].Type)
+	require.Equal(t, "celestia1vysgwc9mykfz5249g9thjlffx6nha0kkwsvs37", internal.Addresses[0].Address.Address)
+	require.Positive(t, internal.Size)
+}
+
 // MsgRevoke
 
 func createMsgRevoke() types.Msg {
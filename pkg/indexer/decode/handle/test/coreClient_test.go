@@ -60,6 +60,13 @@ func TestDecodeMsg_SuccessOnMsgCreateClient(t *testing.T) {
 		Size:      49,
 		Namespace: nil,
 		Addresses: addressesExpected,
+		IbcClient: &storage.IbcClient{
+			Height:    blob.Height,
+			CreatedAt: now,
+			Creator: &storage.Address{
+				Address: "celestia1j33593mn9urzydakw06jdun8f37shlucmhr8p6",
+			},
+		},
 	}
 
 	assert.NoError(t, err)
@@ -68,6 +75,39 @@ func TestDecodeMsg_SuccessOnMsgCreateClient(t *testing.T) {
 	assert.Equal(t, addressesExpected, dm.Addresses)
 }
 
+func TestDecodeMsg_MsgCreateClientChainId(t *testing.T) {
+	cs := tmTypes.ClientState{
+		ChainId: "osmosis-1",
+	}
+	value, err := cs.Marshal()
+	require.NoError(t, err)
+
+	msg := &coreClient.MsgCreateClient{
+		Signer: "celestia1j33593mn9urzydakw06jdun8f37shlucmhr8p6",
+		ClientState: &types.Any{
+			TypeUrl: "/ibc.lightclients.tendermint.v1.ClientState",
+			Value:   value,
+		},
+	}
+	blob, _ := testsuite.EmptyBlock()
+
+	decodeCtx := context.NewContext()
+	decodeCtx.Block = &storage.Block{
+		Height: blob.Height,
+		Time:   blob.Block.Time,
+	}
+
+	dm, err := decode.Message(decodeCtx, msg, 0, storageTypes.StatusSuccess)
+	require.NoError(t, err)
+	require.NotNil(t, dm.Msg.IbcClient)
+	require.Equal(t, "osmosis-1", dm.Msg.IbcClient.ChainId)
+	require.Equal(t, blob.Height, dm.Msg.IbcClient.Height)
+
+	failed, err := decode.Message(decodeCtx, msg, 0, storageTypes.StatusFailed)
+	require.NoError(t, err)
+	require.Nil(t, failed.Msg.IbcClient)
+}
+
 func TestDecodeMsg_SuccessOnMsgUpdateClient(t *testing.T) {
 	value, err := base64.StdEncoding.DecodeString("CqZWCpIDCgIICxIJb3Ntb3Npcy0xGLb36AYiDAip5MyvBhDAuay2AipICiATmPisH2eX5zq9KR3jOzaMv/bh57Gx5+A7Hg0wF42bxRIkCAQSIKJKRaIfTvfMOSNupJ0oxjE4HKdN0oLPuUI/7zDZFmrjMiAWNf11qBG6Pkny/HDTroDlRRvh/iDVcqPebfBLwklpjTogKw0uj0GkDRLLSINg7+RbY+112/DpnTgtMFngAItvhVNCIGliDcpEUtVwicwncbbwtI+4+v0slqOsjfphn8Iq4iw2SiADpnscraoMvLDVvG9sq0hxpxNimBQ0bEoWwTmX32Y8GlIgcYa03WeyQ+BeT/3zwHkjq34kT8/6CXOfb099ll3EfqlaIAOU2eEt9gJx4Wht9TAqApMsRX1w/CrXozXqS/fsYBeaYiB3RHZq770lJEKbI3iJPPuS7yGipCae7Z6YaelUgWw1QGog47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFVyFHZVUCKM8wm90z8/XnaDULo9acOxEo5TCLb36AYaSAogNOeTIo56bjSTQmZmSdaSV4l6n94N5zvrb3l/OSI4eL0SJAgBEiCX+3KICP8RcBx6cboXKbWRrFYH+z8SmpwQG3ah7nxEiSJoCAISFB9ySfQYuQcUv1J5cza3cbWtRnUzGgwIruTMrwYQx63hogMiQEPsZRYuDS82guQbq4AtBuVUftRc9PxGGOs/2JIEwXiDKUmkpbEJK4O1dkj4pWGn5q4y2sAKYbjXI+PdQGiNoQoiaAgCEhTLWmO5Ho9O6NuTWULL4lckY2R54BoMCK7kzK8GENXl9qoDIkChfi2FXz6S4kULVlptIoRrBEJtHdzVIbXE7JptnaM9HIqjGXqnNi0rnEOJWhbvkdAjnHJZbRwg8vLe5Ky4py4PImgIAhIU4I+6D+mZcH0UlrqrdD6rJ3hNwcUaDAiu5MyvBhCe79eKAyJAoeYLA6UVfrgDhayGgWHA/Fb4YASzIxDbUDIycWjvvqrN+LRlM44rPgPW7sBcqY8MD44F9g84r9cDiJtD+wSBAyJoCAISFHZVUCKM8wm90z8/XnaDULo9acOxGgwIruTMrwYQzYedpwMiQHvFvX6uN8ynfawQiDA5h0QgltC8gGPiARExqM0lM9lVp3MEjDSPHoYuh59+6qAtXBRqT78Ss0vXFdkoi2KUewIiaAgCEhSdAoF4aHLTu+U8WPvsoRjYb6ghdxoMCK7kzK8GEPPa2McDIkAamUZbJSWnDlrtVJVqLpHAWxGYZ5bvID5b+58zUX9BIXvu+ra2FB62Q+bbFeyUXAYI0uOzjLoUVIW/Q6arY9gLIg8IARoLCICSuMOY/v///wEiaAgCEhTYI0P81adJacC0hFfXDo1V2Pa4ARoMCK7kzK8GEMuomZkDIkC3MlOQuHofosRpt43PPVGfwH5nqdmkbMrqYJSv98dFm+hSS2YOLM5xWaK0RacVXtaK8+SVEQ72M/xZFi90vCwEImgIAhIUZraWZuv3dufry+GXq6RmpxLicHYaDAiu5MyvBhDGuZayAyJA22+TKAUhc/JSKod5xTcnotp8FTfIV+M+QqviEg817gUoCda3gMuwVY5sYtB9AaXAnBN9fBOSVe2WAzh3VjgmDyJoCAISFKFuSAUk1jay2irRhIMyfC4QpeigGgwIruTMrwYQ/caOzQMiQJSflMkuItORrVu+5wV3d4SsR+PQ62YFa6JuopP2hMQmrBskTCLdhK6zZz2/i+7cxTRcf0GXvKf+E/dBJKMflwgiaAgCEhQbACtuvrhlPHITAbG1ZHKxtN5yRxoMCK7kzK8GEIWh+sIDIkAoLRM51HJPIJPyC4IhnatbPpeRhYHRLOHQVg8E039B5r9liHRDIQ044SC12ANSWZfCdiBBf3MX7dseB/rPpsQHImgIAhIUcrFInvtXpoBXeoOKW6rr4WKnyAIaDAiu5MyvBhDfrNyAAyJA7rtnsSxxNtGltmE4CXI2YvZ2jS7GUlu0oq2VN2D7adQnzqgfxefhT3O7LkkEmPL0U6fZlmIVgdRyA2q3UnENCSJoCAISFFHX0FplkgimV2GQrrvo8HYDhRUVGgwIruTMrwYQws2AlgMiQPC+MZ1RMHKHz3vI4JkgE+pxi3H84Ziq+N2t1CIpqIC7Jd+7iXaxrlAsIDAA7TrNLvb7AjdWlHHIr+ugf7qmhAEiDwgBGgsIgJK4w5j+////ASJoCAISFBMfx556AS2efu8h3jul1QM/zbwfGgwIruTMrwYQsKKkrwMiQDHBBF/hRxixYg+95Com5xukuTPrw3c6x0zu3ZmkiUcAgf2UmT13vRs3ukZ8EiY2we5ZK+ZPn59fSYWIoYmWbwUiaAgCEhRzQelwubPv+CsgYNNGn8UNevBBRhoMCK7kzK8GEKb9q7gDIkC8pbnOtPJ9IEpdUdv8eciZtriTTF/gBu3WAwA4kD7WJjrkf3xcZAuWl10A9rKStqBri5yY1SC+9zWlFkOmFIcIIg8IARoLCICSuMOY/v///wEiDwgBGgsIgJK4w5j+////ASIPCAEaCwiAkrjDmP7///8BImgIAhIUnnyuAJ7/9NFj8/uHgaB7JcKxCzIaDAiu5MyvBhCvr/uRAyJAGsTY+OQPsO5HGuOI031JyRhhBjWKisl9mJ9L3uWq9uXXD2IuwN//dDQ8mb6e2n/4ehqduWugdg9l1LHjuc5pCyJoCAISFJkGO5GUBLaVCnmmox43A3j+BwINGgwIruTMrwYQ8qCK2AMiQK237Ei1Z0LeHhoJw2ctBNMP6KOGnTP9OPFVgxiJOL8lM8kwDvxSLQRMLC1hE2tDscpI6DmA+vC97br1psXTSwkiaAgCEhQ5MnaSwlileXDvU/CqTTwA+VmIuBoMCK7kzK8GELfWlp0DIkCRP0nsX6hsVZXB2C41dLSTyALuB1cBnTwEq7AvQRZClJtybA3UOinrYdsyqbLZdRPKGmdTRtYnqidzABfg7bkPImgIAhIUFqFplRqHgkfb4lj93HFjj2YG0VYaDAiu5MyvBhDSo+m5AyJAlDFZxXWKmGRBURTxRocffWzUhd91i17AOKNsc+xdi7O8zIhSnNjIWkqwP39Xnly7IKg7CiaYIUOvRIqFF7PjCiJoCAISFPP1XaJLtH2mCw+3HsGpySdLzu2yGgwIruTMrwYQx+S7jAMiQHtvoRhKDYjWwTZD+edqaWFMbGzvQtYj0GAjhHvlM8SXk1cNP8VsGPZosJO8/b+KPH5irYQsTnvnOx6BBXwK+Q8iaAgCEhQgIv6MxJ5IYwx2Fg4RqIBFkhnSRBoMCK7kzK8GEO72zJ8DIkCcqlI7We8zEUrXgcjn2oKqs5NSIGVHFtpyZRICjX5eXVhwsny2c2R+TRolKeXBxb1GNzd7lKPydC1V0IDlr3IEImgIAhIUftsAZSJhDFgoPjBkShTye8wNMu0aDAiu5MyvBhDThdWMAyJAwpncs/JFP37bzEcLmhs/ULgvDo2NaTZIC/sMnv/5ma4/vj5l8eo6r+BymXXlcvkSZj3FnzqtCikb6BZ7kffBACIPCAEaCwiAkrjDmP7///8BImgIAhIUYjmkmMIt8+w/sMovltFVNfbzOHoaDAiu5MyvBhCzr+TXAyJAq1xF1u/xqWYodWuAJVItrgvTDbfJmjzUgsHinM5CiJP5/2VaLF4bvSsbw36gHP1QAHuVkv9UV6bk9QKPMrgKDyIPCAEaCwiAkrjDmP7///8BImgIAhIUsLNf7UDapf+dS8aFx1klGH9iIRkaDAiu5MyvBhCw29mfAyJAVbjdHRlxtneuAczxLailE/KJBzEX6o4kVcMk/Gq4c/cCNRsAlWvH1WyVRXT9C256bn/Db2IHCPC7xyZyuawYAiIPCAEaCwiAkrjDmP7///8BIg8IARoLCICSuMOY/v///wEiDwgBGgsIgJK4w5j+////ASJoCAISFGkS4Lo4zQDJ8vyecelx7O1Qe0L9GgwIruTMrwYQndiV2AMiQNKlQA3iK+nWkDb3pc9C6CESqaIZY8VPXvLQPMwhkYKYm7LECoJ7YzouL1R/Omv2LuRA2x42JISu24RKGIi8Ew0iDwgBGgsIgJK4w5j+////ASIPCAEaCwiAkrjDmP7///8BIg8IARoLCICSuMOY/v///wEiDwgBGgsIgJK4w5j+////ASJoCAISFI4FRbEiLntchc5p7cePKAyyt50YGgwIruTMrwYQ65nBrgMiQMZcUec2yw7wiKKQS8pVq4yyuBiC19O4sHqgxhz3ANlE3gRJVI8Wk8lpEcXTT7mdozINhLKWvlrHCOhazp6+Og4iaAgCEhScvsjL1O06rUuysDRu/IamxB+RYBoMCK7kzK8GEJ/fmZ8DIkDqkyLF9BGlYuoJ4njzkYBC2YfZmtcKGNfqMGqyvOzv5NyrTwlcNi7pbJ8uZ8mHe81Qjbi9eEPNn1Kf1tsnFVQCImgIAhIUQMSIOc1IfYoT1llVt/xsT1YNj3IaDAiu5MyvBhCS3sLVAyJAdVaHnZqnRvJTOZNzhrbB6wY5kUlywiysbnQum5H+G8is7VLAI7YRAAeBnbs9fPx21OjKB+MNco9r3ob72C0XBSJnCAISFF6AnpHqtp04V4TRkRQOnIz23RA3GgsIr+TMrwYQ49SFASJAPl1Rwp2sE2icUqZLuPw9KY7hZeUyD7Sg7VdiGgxPB6g4wPdnsejOmwJWRzwHuepOMWOiv+VJKc63p+45Hj2ICyJoCAISFNimxUxUojbUhDulZlILoD9g8J41GgwIruTMrwYQhtC8vgMiQP1p1dXEIZcbZTmFZb/9G9dZ05bnkE8suw2fJMIXW9BypYa5FpO+l/ks7MK6Rx04UZfNmV3B35+XiXBMbsHi7AoiaAgCEhThLO84cblZXvFUAe7SRm6TEOSBaxoMCK7kzK8GEPDonrUDIkDNaF7zazRLkn68iSrAkYaZCbWtlHOK6EaGOgr6jJPLOQtKPLtaPJ42g5ZcKU6oQQqi+sMVVCIOCmoAR1DvA+AAImgIAhIUfg7XaJtlw0XRyBfFsDMv0TLeWHUaDAiu5MyvBhDD5JXOAyJAeCELoyD+fhbXd84s/+ePQMp5nAzH+LP8WCTP/vpQT6d1uPvURX/1vxgV2h2Q7xoAR87fa8gaCKFAKS/Mtl6pCyIPCAEaCwiAkrjDmP7///8BIg8IARoLCICSuMOY/v///wEiDwgBGgsIgJK4w5j+////ASJoCAISFGNIH23Kr3M9L8lTozXCIA7hkIYsGgwIruTMrwYQnpvw2QMiQBepCAWtI+HDxZvtDawYDb0+BL8ewRpXkTayuz6RnWW8yi1MQeE9wKDfUMUGCc3bee72wRYYaZXn93xOC/d8RgMiDwgBGgsIgJK4w5j+////ASJoCAISFCc/cu5VmHr6dxsn03D6Ex9gi4OsGgwIruTMrwYQ+qjqtAMiQCFOxrX0n8OrJtRDGHpp/qfvH8uOfh93KxGh3IPRtejwhnWIlGgLfsEoVanZ3o8LNduqv47/Y93fBpK1Xibg4w0iDwgBGgsIgJK4w5j+////ASIPCAEaCwiAkrjDmP7///8BImgIAhIUn47C71gc4lYwyBnxm1SEA550jRoaDAiu5MyvBhD0ge2mAyJAsk+gosovm3fCgl6dRldQ0l6wr+myfFPIEqsGp8GBfbVZSCwfHuG8Gvz/twcyx5NCO3K0rCU9mOLE6I1O9Oc0AyJoCAISFHEryJGutyHacnMrww1THgwertrgGgwIruTMrwYQloOmsgMiQMqG1IvhSclVXZsRQix6gXijPjO5BgKTumHmEaPkNb+21+yaz0RI1IcQAVtTUJkXKtZ7romSePI8XkyeDJ7YXQoiaAgCEhTbzXZdsmQGMZRsE5O6JVh2x22jjhoMCK7kzK8GEMSZ270DIkA+ny9ytDPillITE+AsIjYdahFcwJHjv8Wk5pbbWL+14pZQPYKVn/yW6OWEz9qFdzSzkR3e7lWHxgAjV8C2lgQAIg8IARoLCICSuMOY/v///wEiDwgBGgsIgJK4w5j+////ASJoCAISFOA7mF5siQXhhNiMmVzGMqoniYHrGgwIruTMrwYQyaLvkAMiQIrRZE6lSXjy5eAy651NBFIoNwK9x5fxAisOzl0qHzAHicHSUDkaHiQx7BJV9bbHqsC8K4N73MfuztHkk79w/gwiDwgBGgsIgJK4w5j+////ASJoCAISFMnmFSidHZLlApLDsL2DWNmy5AKQGgwIruTMrwYQm/zGmgMiQKjTtJLjXWoV9yetBSfVLUC9i2ZAPPxcpARuJXdTxSVZ+R8igl/ImeGORvUWpZLOcYcYRdcVXKdRIRwsh9cQMgciDwgBGgsIgJK4w5j+////ASIPCAEaCwiAkrjDmP7///8BImgIAhIUlDVHysspxVeX4SGstOWGxJ2dOf0aDAiu5MyvBhC8pt2VAyJAzLgo+nevqijs7B8i5zASeQlFME4h4VVmVIhuzQJ3RshHDlG22TPlcee09ML/6oYnT6C/zOBcW4tGfg0nLVncDiIPCAEaCwiAkrjDmP7///8BIg8IARoLCICSuMOY/v///wEiaAgCEhRpIXSz/7uoA5SpTckmZdwBRPuoNxoMCK7kzK8GEMiSx4UDIkCI/VjqMV2zIbEiUiBq74tP4C0P8I3Bi5JeIyyNTJ+B08g8Gjt0UYmhTQf8kp20Vqq/lbGOvpHFQyvAla21HxwPIg8IARoLCICSuMOY/v///wEiDwgBGgsIgJK4w5j+////ASIPCAEaCwiAkrjDmP7///8BIg8IARoLCICSuMOY/v///wEiaAgCEhSEQpBTHuWbQP7v3lJZhXNov3EZ7BoMCK7kzK8GELHa8p4DIkCEkZWY6H4dov83AaNQMKH4u5IJa7ZBvKJ7dKIV0o13seJJFwtHeFZC87B+wDvUJfX80T5qKecCLkH0JVPtv4IGIg8IARoLCICSuMOY/v///wEiDwgBGgsIgJK4w5j+////ASIPCAEaCwiAkrjDmP7///8BIg8IARoLCICSuMOY/v///wEiDwgBGgsIgJK4w5j+////ASJoCAISFGnQYFIpxmWXTrtzb8d+FiRcP3mqGgwIruTMrwYQiM+ZnQMiQJ0p2gQRnpTfu+v061MpkymsIgSe8+c253vRYUpYAvDK9m/gjBd602hTs0d+IzPLYKlPDMnl6G6dkkLuEBdVgQYiDwgBGgsIgJK4w5j+////ASIPCAEaCwiAkrjDmP7///8BIg8IARoLCICSuMOY/v///wEiaAgCEhQ/9smIeZwa3zrKDaVhQ8gWOJCFmhoMCK7kzK8GEKXFus4DIkClWx6E3TFpzEgb/9kHvEjeOVVAdWuFOIUKvpNvidt6eRQ5vSl/jLH0/THTOF5USm5i78xjTg9lk8y42CNdWnEBImgIAhIU5cuhmeBF5wNnEdgU5X4rZsPMA5EaDAiu5MyvBhDU65moAyJA20kXHKDv7lCXk7mw09UPoh+bF9MQH1ypQwtct7UnBjOxWN1RPDR/UbSdrjlcBRO65ebl9ppjkOcrbqUtKJ72DCJoCAISFCb3d3vVKRiucYAQIrDi3u2X3dUEGgwIruTMrwYQ6sONnwMiQEL7lKoxeHkPPc5blAZlp0pwwKq2oi74LhFTFgzTRcOnZC0EiYbouQPvij0B5Bm24bk0WAAW6M/c2S2by+uc2AkiaAgCEhTIlpFx+bWjNUxxKiDwB83gZIyZDxoMCK7kzK8GEOCEmpsDIkD991XFTVHAmLfwtsyppfuVH3d90pm+ci4r+kVzYmCLERp8kF+mj+0IWlc0njd3F/dKcDHMzm+ygJmLTOxFNmMJImgIAhIUFf7BBBbjWcwd20JMaRZrJnHyUUgaDAiu5MyvBhDyrr+5AyJAVSNehhs6/kUWS9WAzge5WOf99eTQ+VGGIzSzTBThgXNkPVjoFLFIqCFNgB4UgjdUvpmo/CpTIK59p6xysHM+ASIPCAEaCwiAkrjDmP7///8BImgIAhIU1MQcHhfpMh0Ge6Tj5HbnZrLCwr0aDAiu5MyvBhD91JqwAyJATokGwyL4UgB/79VpJ7Fmmm7/6J9RMQEIXhP+x7J2+QD+pOSLTxCK5yab6c+0s802zeWpQ5vGXzZRpTYWot0cCiIPCAEaCwiAkrjDmP7///8BImgIAhIU2krxmjeMCbVMJsNGfLCt+IkpKVQaDAiu5MyvBhCDr9+8AyJAiakrXCh7zLyEvx6aCBlf+1t6uxSySmX7zej910yVKNivttxEcsWit/rW1aBbe+yR9sni67ap5ihXmDenMgleCCJoCAISFJAf0SLMUS7xPejho9eVO/3cB4bWGgwIruTMrwYQ1JfFhQMiQIRXzazClXPshKgWGNLrwT5nFY7UezMDy1Bx1z83ErtDCAKpIeG4Tk0edaDXzNBGdxxWgpxaNpXCzALsJNN7PwwiDwgBGgsIgJK4w5j+////ASJoCAISFKnE4OKvABg9oRQ07UEyGZBemoaNGgwIruTMrwYQ78nYigMiQPZ9iPO/jvMrDp8+55d2o1dFKlXCyw67kORBcVGJzXTeN5znLM5D2XOzLYEtjsAeMK9hSFpSF/bgGOss7btk/wkiaAgCEhTAKsunZTrDeCdQtT0DpnLhkfADYRoMCK7kzK8GEMT8trADIkC5/+jNmaZB89TdRTe1MXzHi/BBBvFWXTQzIffRg9TUGgOOknqDxdc4vDbDKIcTa9CfTBT0E498g6r7cT/NvrkMImgIAhIUBhQIjEHmqF+1vzRFUqUSDloBOfwaDAiu5MyvBhC5vs+pAyJAxZilx6G3t41iIkGYiC7Dmjwha2J28JqBWQblJH9CtWV7EnSloemQQzdrdT6f0SbS5G5dAOtydFFQ5oOO6hN4CiJoCAISFD/3GfFmS+6T1IK0gGd8A6R+wLZDGgwIruTMrwYQ4aCevwMiQLGzlW6TG8719vr0kq221jOdsq7FOG+dUeR1zgL9lzDhRM+CYRxcJA3XDdqhrCsQIIngqJjyxvyCuA6P92bF4AEiaAgCEhSZk4SVQHwJs0NWKq7Dq1UaXCRiMhoMCK7kzK8GEJHIibMDIkAZ816AS586Yfm2ypXsErVe5PmuQCmGIEHNWlAQSWEwVAWrhq04f/xmt2TkG3rISMrMEkNTVowI/CgdCvlM2pMNImgIAhIU9ng9j7MOKDCBwWOYKT9ILcoOkS0aDAiu5MyvBhCSjYnOAyJAotkzjdvktKKGB/LgAzhF4Gpmumprh4Od8t0JhSO/CEHejLfJ6A51qc6BSeC3q9PaqmyqaYl57k/9sltXfuFQBCJoCAISFCM1RlsnuVSDE6r0ZSF3h/2OYRPTGgwIruTMrwYQveK2hQMiQPQbsX/bWLQFrSL7RQs1+5xjNThPS4Bq8R9QtA4AaicmA8XcOw67MNOWQ/YbGT9e7HXDVDjCaiJkm7ps3DKwEwwiaAgCEhTgba3rQTgpVY98lTOf+2FJnFobuRoMCK7kzK8GEJ/SopIDIkDgCtFviWmzVUvh9Em9dW78kK4MtA7KchBjafEub5YukZd87Iuoz/p9IN6HyV2tP+Nq+OihmJv+shmnLwIh+HoDImgIAhIUN3FMTaQHydE82kJKrnjDsoUVoVwaDAiu5MyvBhCzvquoAyJA2lmDN45W773MezuW5jxG2n+o4X0BWT4aRYuIirQ00Wyj6UftOihcgieMrp+YRHkldXlpmeHYv4/P+6PvOkDzBSIPCAEaCwiAkrjDmP7///8BImgIAhIU4jr88ANfsBrNAv6W9oAGaXTXBysaDAiu5MyvBhDZiKm7AyJAAi4U+m15LkMoYg1+vH1e1VgrBr/Uk5RP0l+r+FD8nzG5df5WjhyOM3hM6beDMRxS2By//CU0rLWq16qESjAcDSJoCAISFF/s7JQIonEIM/L32EgzlGLCnBREGgwIruTMrwYQ2Nr7pQMiQOstskKVj+BEC0ocfwjCUq4ARdwiCjo7LPXv7DarHu2TUugaE6wwVOUKcc63ynS6xYNlvcBZ5F3Z+JPlRAvu0gYiDwgBGgsIgJK4w5j+////ASJoCAISFGgnXDfP+Gu1PSnWI3rTcOj9UJf8GgwIruTMrwYQpIqkuAMiQAqVkfk9UmDkO7TOo0Ct0v/jJeIFeVIhkD0j0aamMrZf+iyD0WAfGko6fExgs6Ib/f+hq2hQp0fK/4PAQFY3FAgiaAgCEhSJTFbWz8Oo4J620aLjNGfEz3fA9RoMCK7kzK8GEMz/x8UDIkAhUAH5hFvUi+VWNqqzMSXa8pt/c6LTzwIv45PmpZP82eTEaB80R0Z52brlz/Z/FsADDh6zjT/ADpNr8KKwlRQHIg8IARoLCICSuMOY/v///wEiaAgCEhRzZL5sx7bkBL0cIFDMtqdHJ4bjthoMCK7kzK8GEK/hj8oDIkA33NuYM4Baxp+T0UTAbP7qHRpeJrN5GKve2XnGFZYn3haIHJy+ngikl0viDGSqqzlR8+wA5z24luuAG5qMzDcJIg8IARoLCICSuMOY/v///wEiaAgCEhTiQtssuSnW9EoaL+SFzH0/Yg/66xoMCK7kzK8GEL+I57YDIkBBP4Jj6wbe7sqgliDUdpJ1ixIwfKtbYkbuvyfAvDaRfpgNDFnXfZsVAiiqIFLjVMUNGwgNxfosvf0z/Ftt4YsCImgIAhIUQbVD6RR5qVzVyp8QnCbfrBSRJvoaDAiu5MyvBhCA1PHQAyJAVrZ15Ve2wkQcbqXx3GvleESFv7N2VfRy2K+N/EY3HWr3BJZSm/unkTYvn+/hPNUNlXZ7lWTg4w3JaBTQZ1B6DyIPCAEaCwiAkrjDmP7///8BIg8IARoLCICSuMOY/v///wEiaAgCEhSRJ9+mF1DdHVbLHSqI+IMaKz+bDhoMCK7kzK8GENqeoZcDIkCS4RSis7JrYCLK680nhqd6CjPziNYAeFo7z26yL+OAX6uQ0Ovl9hE2JcBhiPdTODj0H80TwtB72mgozGo+AmUDIg8IARoLCICSuMOY/v///wEiaAgCEhTVuTGQdxpQYEpfeEmq8GfEqdqvnBoMCK7kzK8GEK+91K8DIkBzrLJ1e/gxwIFF06o6sCGhPYiRYihVsb60Iu3QQg1EpR4Pvz4x36/OdyRepCSxKUq8O2tngaLj/qcdh2zYnaMHImgIAhIUxe0SLlEf+dfeqYb9dCPGGusTnTQaDAiu5MyvBhDZlvbQAyJA3EH4dWTzcpwA3zJ8p8olf3JE+bzQVMrGJk4Qjp5IGbrgugFVW3MHPk77tOKn+5uOzFLecamvJxFsXOcYY4cRCSJoCAISFEUazsqn3EzObgt83gL0Vd+XNTXlGgwIruTMrwYQubCjwwMiQA6QSk736T5jldtfBWBtLhv4iSBn+B8NhrRTGqng+mxxvLcj1spO5Bbn4tYu+nluqsd67xRlJt+KZuuM1OUWMQQiDwgBGgsIgJK4w5j+////ASJoCAISFPbD94crBG2nGYkF5stYwbd1tIvqGgwIruTMrwYQkPi2mwMiQM5X6einOB/o7qQfxVJV84FWysOAqrEN2lSZDf4Tn0OqeRiIBwb6CGgkmyaxWttOkKj+fvHuuhd1aJikrvc40w8iDwgBGgsIgJK4w5j+////ASJoCAISFOgNH1UZpbPJ0pDT6jFPoFVkU1waGgwIruTMrwYQitbtvwMiQMmPRN9uMRhzDkgjSDgiw0HUSAnCmJk7B9BLWH1D94JoCgV69Y1n5mvzTvW6XAniPd1YQJouWGw6y7rypSd7cAciaAgCEhQKcJEtGOE9eMsy5jIqTlf4YebDyBoMCK7kzK8GEOjIp5QDIkDrbTh05mW4/D2FYK5WJzkNIx2Vt1Sm5mL7G/FCNCuirloNg/rbqtauI/IL+wA8csFQplB65MMNHwwGkwWtNmYPImgIAhIUybdT7Sl+X5iU1KQxSc/J97IHtrIaDAiu5MyvBhDn8aqTAyJA+1L5cOmuhO9aIRtOXBa/xKlqjOBe0clyRtDh5Dz7AkmAdp6bmh93vwZx79ZkcZw2243zjHVm9sMHCxDWS66FACJoCAISFC04fZXhP2gdMxIuRHX5t9/Cpo9kGgwIruTMrwYQterCuwMiQCND+N0SaLKna/L1h2SUs5jvTDhEQ4aKtF4TxahwBmmm0liGvS8ZKT1IjhzyqTE0QDpVRuh4THNpH6g0GaYcGAYiaAgCEhTdV1FhP9fTGpUjUwFL05/1YJzirxoMCK7kzK8GEJicvp0DIkDXagqIdZGH1FP47aTfsoQKOwDYmvr+jYPzGLD3uwGPKkJT4vUy5Qgps5qntKK7x9sXhQX9OSizV4MftAEryH0NIg8IARoLCICSuMOY/v///wEiaAgCEhQZHolqEcCnepapmr7phqKkA1XARBoMCK7kzK8GEPWeuJoDIkDVwSsZDdNaGQ8DyhT+eHSG+eUYAzlQkmxgTAHrH+lpwksTPbFuJ4rtARqp/mkJjc/9VWCw9RkuyzjR2b/2tAYIImgIAhIUQUb9ehq4uGG3AYl4vNE9LR+mPr4aDAiu5MyvBhDZ1dapAyJAjcBlv5PHL1/Xn7ubSCXmmzykZUhxNmctFFcfAnssy5xeffCR1USMwIo6lR9enPWqU/OnJk2jU+PVZNGiJbQMACIPCAEaCwiAkrjDmP7///8BIg8IARoLCICSuMOY/v///wEiaAgCEhSXKmhPNkzOMUabN6nUOZhRFetaQBoMCK7kzK8GEOPG1Z0DIkDcKOA/iU2RhPT0ttdMhiaEq1jtEHIS1GwgRtYLHw4C6f3/rSiNOKT+qcxCEQpjHLQY1c2jfVfMNXoAppXGi8oKImgIAhIUXVZPhE1BFpSxMbHEpP07OJSU9I8aDAiu5MyvBhDV+JOnAyJAcV+lZBezWyq0idQbcryHr+TPH6xS4vCJpqI/Q4wLy7gxLN0xxDu3XNM0aLaaoIrb0eT/m7z7K1uBrZzexYmGBCIPCAEaCwiAkrjDmP7///8BImgIAhIUL4nX09HhR4+I7zrYqtdqiBifYSQaDAiu5MyvBhC00vO4AyJA0ntkWVwoMlV8lm+PqjImQjfbOH9ULnM+4TKyo1Pc+qCb4b/Plsmk9GPi+7V8/OkJRFIyfsIi3qh9UG7rKUA9ByIPCAEaCwiAkrjDmP7///8BImgIAhIUgBS6IS7TiFl1ENBkJY9eMKow1ZEaDAiu5MyvBhDH4OSYAyJAR52w+KG1UVPrfohfxzmwrZjodcfS4NpUN2lGeG4a4J1Gw6IN3+QG+uiGAZyDwGEln9rkFh1c+RJ5fxPxpVpcDiIPCAEaCwiAkrjDmP7///8BImgIAhIUsVBp5BsaYP8Dro2PdB94x7EUT74aDAiu5MyvBhDd0YOmAyJABlA7qKNaoRX9c82PfJVo6na2RLaDYLr7UPcdiyun6sod+etIfDnObE+2OC45Lrjp1xjD5l/cgJAXAsXM9pP6BCIPCAEaCwiAkrjDmP7///8BIg8IARoLCICSuMOY/v///wEiDwgBGgsIgJK4w5j+////ASJoCAISFAaqNL1tHdNBGePcFz762U9DCrdOGgwIruTMrwYQ+sS7kwMiQBgACf5TE4ycnDoViVfIfvrb/7lbOOvbOrK748aW2WOrtz4HN1q0Db8ioJyVn2UXDqDkc4FvqQCpDISbw5zSUgoiaAgCEhSNjLnCZ0C6dKKqCr+dK6+YImSFphoMCK3kzK8GEIPugOEBIkDyJmVRofHx9Vvbaml2PlyeowmPlUJpvGXPI5erh0RJEH/oRRE7A10gHjwcqiyoqTO3awO6qkUO9AR+3GZUe98CImgIAhIUWDrnNuZ9+dcv6Huap9MhDTtLDloaDAiu5MyvBhC4qd+dAyJAxnPiMYKbwbWJqn8+2iaLYxi1vN6nk6y0hIFiF9FyoyUGeJqtzdJM2taObG9/P6Wb5AWVlMEtBZ4f9YTRoeYtDSJoCAISFPDItq3a98xOzlcIZgepoMfqYnXgGgwIruTMrwYQzM/OsgMiQH1jLLYgjJlRs6EEkTk71sItpUDbzHMJlTt6x0ulCEymudHFniW08V3k5NaXErjBafl6jYDiL1IS457bEkzcLA4iaAgCEhQvTWcwR2QHGVrzwb9Di2HLbXhblRoMCK7kzK8GEJz+ro8DIkDadFQo6Hkk9VIfdUI629pdehu7Vfntv9oFkWjufl7QAJI2dhdFBx2Kxd+SGDVonbOpNbwvGalyxpwmhT+cl/EAImgIAhIUIGWL9A7UjtAaLQh8f/eHTyGlYzMaDAiu5MyvBhCo+JycAyJAr0GKbj6nLGH/hKq3cUgGR5jK6CGCIVpO83WIIU9xs6K1q0pSP0csaG/Xn/463d6pgvbQHMxgh3AtGw0fcVOwBCJoCAISFKmavOgj30S3IzdyWq3/QfD6q03/GgwIruTMrwYQ5NjnuQMiQCfAeaX+2nTWk0uzQnOkO6mZnJ4O+mipS5oK9LcJHbPfLU0SUx3x2LGhLa07dKzVYPgSGdjYsEaZx5tHAhK4LgwiaAgCEhQJYO8/1Y/n27jyD8mCadO4QEUWAxoMCK7kzK8GEPCy+pYDIkB3VQKutv0fNIq292JutEQEdk+GG+5cpSgBBaa7cCfF2W/qaDGwfF+lJXU0TmtJ6fiRgkRAsG0HifTJGmgQzckFEoFMCj8KFB9ySfQYuQcUv1J5cza3cbWtRnUzEiIKILH9OxZ6SAPXeBD2dReYIdKKXGyYgerulsxh2e6quhVjGMrFkgoKPwoUy1pjuR6PTujbk1lCy+JXJGNkeeASIgog6Nz09YGHzwWxjczG0IhK4Iv0qY2IcX0P/5KitvRXTUcYoPGRCgo/ChTgj7oP6ZlwfRSWuqt0PqsneE3BxRIiCiDeDkoLp8nZhhGg3nzeYp2y0uLl6DC3YKTJHTKJYhGVDBitiroHCj8KFHZVUCKM8wm90z8/XnaDULo9acOxEiIKIFbcnN0QMlH/b1oUOUZbEHWkDRS7dtmRWMb0h1OndCCnGMCb2AYKPwoUnQKBeGhy07vlPFj77KEY2G+oIXcSIgogdfTEd6+krWYzqsBcpqdxySq+iwh7SGcwnBO8XaW2qKYYlICtBgo/ChRAzHIzFLbruTtJ+9nTMO7ItGQcqxIiCiAGCd7/xrepQl8svhZm9UoUdjoWW5USWnOYbtcKoEZpABjegpcGCj8KFNgjQ/zVp0lpwLSEV9cOjVXY9rgBEiIKIH/E4xdpZN9LTBrIxSvxey4L489uHd4YcRLroUcpxD1bGIX2vQMKPwoUZraWZuv3dufry+GXq6RmpxLicHYSIgogwB25StLxbzmD0uTiFiH6xySZd0H13kyanNUvvlUpa34YysWnAwo/ChShbkgFJNY2stoq0YSDMnwuEKXooBIiCiBh3ZFgfILQjcbfhkyV3muz3h7hLkvVOdZoENoMiGGlKBjp6YEDCj8KFBsAK26+uGU8chMBsbVkcrG03nJHEiIKIIGpceCTexW4xdrHMSo9Lu4dacDUPzcj7veq4SHq5gurGOPOvwIKPwoUcrFInvtXpoBXeoOKW6rr4WKnyAISIgogcNRUrOt4I5rJcsAVhUOiBvOLYRSFx4dso22bcUEhtnIY59K7Ago/ChRR19BaZZIIpldhkK676PB2A4UVFRIiCiBi6x7Mr0D+K9V5hCX0A7TTHtpLicWTERuKfBkeGyBU+BjrvLkCCj8KFNJLejJBMzjCqib8ABbZH75zu16uEiIKIKe77AJoQCpSo+zDmBMeR7dDdMBrmtDp9+Vs1u6t3hYeGMaRsQIKPwoUEx/HnnoBLZ5+7yHeO6XVAz/NvB8SIgogjd4Dwn3GrqBTcwNpuXaOmge1gNyja9SmYZso6e8xAHAYuLOrAgo/ChRzQelwubPv+CsgYNNGn8UNevBBRhIiCiA7+wbrh+Y73+udF+Ql8JkM2bspjeJ5Ezy8JTmEJ3JTNRjtpokCCj8KFAPAFqt+wy2fjXev2xkfv1PqCNkXEiIKIDnVaU3ywrz8gS3hOozcXrIMtx4NSIgPteuZUrrGQ4C7GKql/gEKPwoUcd+NmHnCBWOk4qvtqVzR/Ffb9qoSIgogDCJh91Oqh9P76gYC71pFSzd0cxnvqRDyZ6/PLFZfgLEY0fr9AQo/ChR2ioJwDjBG5tr4SWZFeeZJWXzBtBIiCiA27dgAuZV/uS9FdsILGWLBw8eYPy+ZEyv1Df2VsrjXDhio5f0BCj8KFJ58rgCe//TRY/P7h4GgeyXCsQsyEiIKIJv5+0tWTvpFLSO/sYAhp5JaOYl4DHSPUL4n9lXLx2I4GKGj+QEKPwoUmQY7kZQEtpUKeaajHjcDeP4HAg0SIgogT1wp5ELzvqVOqBm6eGYiQQWe0TaC2LV2BThHaVxh/rkYqdz3AQo/ChQ5MnaSwlileXDvU/CqTTwA+VmIuBIiCiBVmDqFINGRp4RqxP0G5i/9o1DXRGgsx5MTB6K3IpU2vxjw6PYBCj8KFBahaZUah4JH2+JY/dxxY49mBtFWEiIKIG++8wof1bEYFb3xl7luHQfg4YOD6zFlgQuRtiv6hYIlGIq+8wEKPwoU8/Vdoku0faYLD7cewanJJ0vO7bISIgogjjcSFNFQ8q+t/xHr1uYdI2/c1sPwAD17uKg+AyOWTe4Yx5rlAQo/ChQgIv6MxJ5IYwx2Fg4RqIBFkhnSRBIiCiCerr6gMFdhIoshHa+Sw54Yz2JVPf5G0jJYBYRSpw6g7hjfhOEBCj8KFH7bAGUiYQxYKD4wZEoU8nvMDTLtEiIKIM82VmQKUQmgl9WCM2UAMXB0eSV/q/81ib/50+5npOLdGOeG3wEKPwoUBMg6og91Y7vLz2qhUO9rDIGAjaoSIgog95HMDW5006ssh50NO61qSXj94ZqRXGe1Ta78qy7u6Z0YnYreAQo/ChRiOaSYwi3z7D+wyi+W0VU19vM4ehIiCiD+TtfxgQ4Tce1EL3Uar2taHcp12TaWzmDJ5ROFmOrgOhjw39cBCj8KFIsdVnb0wMhxoMeGSFDUUdaorI47EiIKILamh0ZNcJLqhzI3zepA0tcplOVWQwtVy5hTRSEZLaN6GOLk1gEKPwoUsLNf7UDapf+dS8aFx1klGH9iIRkSIgogw/cYALnzUUHfP0Ej/N0GcWk8Kl6q5+CnIqxbrX4A2vkYyvjHAQo/ChRfmZpL4lSGmSWn8v6gTXs7g2z/CxIiCiAU+lliVpfWeNxk1dL5bSkCbdq4+qg5Dp5yhlh8RQF3lRjlrcMBCj8KFKBrW2grQlrSBqNcryRv1w3QmOUGEiIKINNFRrmRQHnp5/2X/K2KjaMMlJ08z6N83LvyyqbRiJx1GNKivQEKPwoUrxlZQ+RP4dYlAHa4vBkQ6ryF8fISIgogj4Mh60mc9b88H4uCOpmOQ4jYkzBbX41x+AP+CFWm3NsYlI+7AQo/ChRpEuC6OM0AyfL8nnHpceztUHtC/RIiCiCjUC5feam4dOzobPgGhTxH0mPTzPv+MnrJVWipzZUUkBj+o7cBCj8KFMAvUx2bu6SQdRHvJoBCHOcUoR47EiIKICPbH0qbbH58BDiGPH0U9xnswIjrOx/Ip/5gdJZEf6xOGJOJtwEKPwoUfvJEhowwSqWzSIk3Li34dK/WNc0SIgogAmRwY/dqPSBXDY/YO/huPokiInnsXm0h+wM4ORwQivYY85O2AQo/ChSXr+RTlbdOeEyI1F5cyimVAZ+uCBIiCiBkfZNa+Ttt3wwuMMcsi284lS/4SWB8i0k7hXhedd51XBi/6rQBCj8KFOGR5lTQa59yFWi7lFsutR3cHI/cEiIKIEiIpwn+BiprqhtbtNRGQsvjzWP3/vLEIz8MpxtmpJIPGICEsAEKPwoUjgVFsSIue1yFzmntx48oDLK3nRgSIgogvYNTZWxKhe3Un6gF+qX6cVB3o/KG4YxMC4/IgRTK0z4Yq7WsAQo/ChScvsjL1O06rUuysDRu/IamxB+RYBIiCiBvzgXGhobiT0gpBg2nOofXqcIDfnOYC6auG1I8m8whxxiE4qsBCj8KFEDEiDnNSH2KE9ZZVbf8bE9WDY9yEiIKILuomSFRjNYpdvnmorIbgjNYDoyTCZTOpjy+ba1lrG2qGJjqpgEKPwoUXoCekeq2nThXhNGRFA6cjPbdEDcSIgogLCCt4CBSrkDvSDHWMB7vepYZ4swoq1A0rOpmRM4+Y6cY6dylAQo/ChTYpsVMVKI21IQ7pWZSC6A/YPCeNRIiCiDxhGQThh2sE9dUr2GCItgpuuCc1RgmwYZ9zwp3+05a6hj81KABCj8KFOEs7zhxuVle8VQB7tJGbpMQ5IFrEiIKIAt52JfhSwHW5E5K7z/DoYHclEB+A6SA+aU9u6Eo/F5aGPTpngEKPwoUfg7XaJtlw0XRyBfFsDMv0TLeWHUSIgogRfEMj+BAig8+YIjwIlquGWA7VRO1CMfign6PtwEct6kY1cGeAQo/ChSWb9ibHbUVNfLYmM+bDxTaN077lhIiCiBGV1ilhAKPboB5mrq0fq4LTJKn28xivAi4mjDJaJVpOBjtt54BCj8KFBOP2at6vguu0Uyn1B2IW3gFKkqhEiIKIJpmEJtpwJ61CiuSjct9RQlfZQTJffyoEZWuLwbIfyVwGKGbngEKPwoUlbAC3mdwcxPRI9BkkvGnpYR45UYSIgogPA+RvSpVTcAWBD/+nxXltHLaOdaUzbrpNGfjMak/usUYx7OYAQo/ChRjSB9tyq9zPS/JU6M1wiAO4ZCGLBIiCiBncDR8KRopHT2+8NPvHXwjYhzPG9Wl7OSw+mxjIrWfZhjC9pYBCj8KFPmpaKQF+wQpQQrlFl5fIZMnHmeKEiIKIMForkEegmKtkt9aIL6w62A/IiN1dXISCyesYnvia0SfGID9lAEKPwoUJz9y7lWYevp3GyfTcPoTH2CLg6wSIgogGPlrdJ5z/EbXbUKc3xIcQn+LOU1UaF4kyk7CfvephJgYqIGUAQo/ChQG9Fw2/LlX5V2SOm1OkFwtcVEVrRIiCiAs5RoNNLRhlLvQeMHCv0BF6tcxdtfEU+xLFDju42YC8Bix+JIBCj8KFHb3Bq5zqCUWUrxyy4AeQpTiE1r7EiIKILzcmukXniLIE0d4e3DatLg8CF/WXJ+Esf2QBUFvaEu1GLvDjwEKPwoUn47C71gc4lYwyBnxm1SEA550jRoSIgogd2hzX/3wrmjtrb+jAKUUvO95VeejdRoxTHdQY7/8gLoYgPWMAQo/ChRxK8iRrrch2nJzK8MNUx4MHq7a4BIiCiCiS2ONlxaft52PHXhuVY7tXWaGEKSC8F6BfNdYP2z1oBiAxIwBCj8KFNvNdl2yZAYxlGwTk7olWHbHbaOOEiIKIFDd2pMeCzcU+vr1Y39Nnc5GG+Zz6dl29YgfVQYrMR3oGJ6xjAEKPwoU8ZTdSorYMyPD6cKpPbJfBJYhx7QSIgogoboi39aVwyap22GNEnO/xV39Kvv+/njYlKzqXDHQ/u4Y94mHAQo/ChTO/n1lS1I96iqe1xilkRJsdBcWiRIiCiAkUEOjuz82VaegjqFvLhrKqy1LOyIPxPoppvFVMNlTPRiF8YIBCj8KFOA7mF5siQXhhNiMmVzGMqoniYHrEiIKICQPgYSN54nvbUfpUQg2958mQetLW4R14ezDVqU2qybiGI6fgAEKPgoUJxLPaK9pgrS9dTa5TN0NEEoDE/QSIgog4ICana8QvCEuAcXm/O35Kr6TFEQWQhP6KU1vCyOm54gYuYJ+Cj4KFMnmFSidHZLlApLDsL2DWNmy5AKQEiIKIIFreqvvNi0dpzFh6O5NquSxi7F87mRmo2UFRcqpM8oGGMrDego+ChQg7+GG2pGgCsfwQs1stqHogsWDxxIiCiD8mfpRfRBVZU6q4SDlFZTEyRRHxD11HJzuCSa/3YMElBiqmXoKPgoUfVPXby24a+MKmybK3qaQeFMaubsSIgogQuejs9DfnCsgGElTXxULslAU06c4bzyrxDSTtDZ6VO8Yu7J4Cj4KFJQ1R8rLKcVXl+EhrLTlhsSdnTn9EiIKIPlinzbm6KAqRHSRPd2vpCjH5/2IyYYvQweWmWTUvXaeGIW9dgo+ChRG3qE3z7ELxBmyV3qppYcYaA4YuhIiCiAkwXWbMbx+PRrSBKW+r20/VoMvtmdxNaX+rsuiujK1GxjA5nMKPgoUBERtoLzEMQAD+Xsb7QerKr7G/qcSIgogMKPR9uSBYxuCswBITqGu0MUDx/NBd2PKPkOyZqZTCbEYloZzCj4KFGkhdLP/u6gDlKlNySZl3AFE+6g3EiIKIPonBlFXsmzWmHON4jQy9Ir3Jpvbff4lotv9QHOBda3FGK+ycAo+ChROFUySiOMUNrqBTdktF8TtbO/T8RIiCiB3Rt1QU+mjQeGWKTMclREughe9SJQS87byrgidyM/L1RiP4GwKPgoUnL8u/9VXCzqaQTRiRHV82j4Y1AESIgogPwTrMSmKul2l8xfN3RmAryyVv2+PvwG9XpZxf4Vc4uMYt9tpCj4KFMoPKnEh+G07bZE0lzAVW5paMcVUEiIKIEC04AhCMtM59n+dfU+jB72oivfudrEE487J08OfdenRGJqWaQo+ChSERc9Vy1EnjmOyExrbZagdwjidjhIiCiD3klqMklR7/l0zIVE4dAalvFto7ACER9DdLLw7k0vmZxit+GMKPgoUhEKQUx7lm0D+795SWYVzaL9xGewSIgogOPsZnvTy3S90rA8kv2FQEKmFigTn5hHdAop0qNZgNTcYq/JjCj4KFL1PgPDBpntJUHcmYvbrytWKJYkzEiIKIEMT4c1xQ/4XgVU2pPNJm94NHADeH6N4iAHxoc1tyrZLGPWTYwo+ChQXOGswjvlnDN1BL7LzwJxbh1+4uBIiCiBe9gw8N9+8eGUHIf5PaYoIRdzMpnQWhRBY+2i7RDjX5RjQwmIKPgoUaKOTx+1JaHEVDAp8rQysCbjkWPsSIgogbU1m3aDQwxIpqFYubjZIhSvYCia1MQSfTaaXJBg38nwYsZ5fCj4KFH/B2kCyVo3b1Tz/O3bEnOia4oaAEiIKIOW9FXzJFyNWBKLamfwL7U/EzsLqJ+/kYICi+Gem9m2YGKLBXAo+ChS1wzpAmlicCU6J930kE58lxqbe6RIiCiD6t/4rLoR1+mTmSp026/f6efuahaZtEUJXAuAzAxJHVRiw/1sKPgoUadBgUinGZZdOu3Nvx34WJFw/eaoSIgog+uY7CLC1Wj16/aR50vw3LcJbwIl2/F/zorbUIhKqK/EY9/5bCj4KFCwkZxgLuoTy8dRWXmb1ZaNAA+5PEiIKILXez4zhnKmvWK6WnjfMe4Ou/fk5f4FooZESRhi8JB9dGP66Wwo+ChQ+iOfFT2RkKpiy4d3VvbpIeU8GxxIiCiB64m5t3CnRY2Nf3E9VFhwxoE93Q0I/2YooxxRW0+GpCRjOoFoKPgoUAApZWWNLQpbk3lNkgd4AqKDrmlgSIgogXYCvhdcOlm/5g1gHSO+7/hAuCQ0kZPU7XKvc1C5e1U4YzvBWCj4KFD/2yYh5nBrfOsoNpWFDyBY4kIWaEiIKIJd2lnpYn+KksJ8Xd/D3MbzgfRfKKHeKASMcEI3Cm/6IGNrjVAo+ChTly6GZ4EXnA2cR2BTlfitmw8wDkRIiCiBT0ni6rmLrMooeRF5Qqwx60lK/II2sJqosgLIiipdQTBiHnFIKPgoUJvd3e9UpGK5xgBAisOLe7Zfd1QQSIgogzYocxweamZm6GOE4NMZ+VzaPpeGdoSIskIA4QHo+/oEYg+VNCj4KFMiWkXH5taM1THEqIPAHzeBkjJkPEiIKIK30LfrwCeCF/pKQGgQA6xeSyT496UwWrCyS+HyvyowsGKjiTQo+ChQV/sEEFuNZzB3bQkxpFmsmcfJRSBIiCiA6Mkkz6UI+jnyU1YOAQOpoToqaogRcaPiafUH98CWZRxjRvEwKPgoUYKQz0osIeIxy4hM1VL1cxodp3OwSIgogHo34cqIRxDu28ioc64/VuIebfKeS3mcjvzBl+mxCZvEYu9BECj4KFNTEHB4X6TIdBnuk4+R252aywsK9EiIKIEqPagoZmehF3b/Sv1dVnLi6h4Yz3Z2Gkd1refX6Ai/cGOrSPwo+ChTyM+A2JIo2/HPBVP+nkmG8vcS7dhIiCiC6qGl8rhpnwxpMLxnEPjGGByBcMKeSjh53rMR3PuMN6xi/4zwKPgoU2krxmjeMCbVMJsNGfLCt+IkpKVQSIgogdOk2pUzVZHq8JDdyNLOyHH9k+ry/Xvxl7d/vZivYE0IY//I5Cj4KFJAf0SLMUS7xPejho9eVO/3cB4bWEiIKIF4CLtZV99fC0icpa6Ai80YwJYiaDiPp8nu697xvEuxXGJGKOQo+ChRLZSVYV+Q5N1TwSdvpRcWsh/Vj2BIiCiDZUKbh9yUuXKW0qZxi4lkTr5SBn4e0vW6FrGdGK21cFBif6jgKPgoUqcTg4q8AGD2hFDTtQTIZkF6aho0SIgogtXnmhUMcIybIb9Wzmx5tiLD4xojGQc5irbOcNb/RThoY7IM0Cj4KFMAqy6dlOsN4J1C1PQOmcuGR8ANhEiIKIBxt6nIOMqgs5QRuTamLWWkSrVaeVinMZxE0Ga9BkyZmGODrMgo+ChQGFAiMQeaoX7W/NEVSpRIOWgE5/BIiCiDDCxtDqaQTB5m3ZmE9qedBqn8WKbWwO+IY51ClrSDIDxjTwTIKPgoUP/cZ8WZL7pPUgrSAZ3wDpH7AtkMSIgoggJvkPLiHo2+12JJGprhwVsABASXzXO/dSWtnk0ywNB4YyNswCj4KFJmThJVAfAmzQ1YqrsOrVRpcJGIyEiIKIBLzGurD6RCUM/G6mpRjBEjrwyqtmGFz6Pfom96qvps/GNzoLgo+ChT2eD2Psw4oMIHBY5gpP0gtyg6RLRIiCiDZy5WpQhvLTnvFnyd2+iwKTd+6i/MGgkYszIHvgmxk2BjF5S0KPgoUIzVGWye5VIMTqvRlIXeH/Y5hE9MSIgogrYPM5swt2DJmKFgI7v0Bzt/7yUIaUWF+Lq0hu6qF/fcY958tCj4KFOBtretBOClVj3yVM5/7YUmcWhu5EiIKIHd6jZNjXOQ82qUUTzSf/3Y2+lCfHEZSUbWMXuPEJVvoGK26LAo+ChQ3cUxNpAfJ0TzaQkqueMOyhRWhXBIiCiDzHAwRAMjjGgPZnfTR/y+mNviRthBW53ntGBaGWu0G0xi3yCsKPgoUzkhVF2SeT4xxRp732vz5pVi/Fn8SIgogTWhrgLe+T+LFELUqUHzcvmFoRUy+A+NL1ZvKIGdc1NUYrIgrCj4KFOI6/PADX7AazQL+lvaABml01wcrEiIKINYB0PpTONa81Yasb8Cv4JbSDHSIgNis6yru0Sa2Oa+0GI/9Kgo+ChRf7OyUCKJxCDPy99hIM5RiwpwURBIiCiAJJIWl5OYXduLpDgYdOdRn3TuCEdNDOvbeCb8Efjf0ARjSwyoKPgoUDOuRfeTfHEtPjt/ErOb9bTnx5h4SIgog1XjgGivzBPhmBVgB1OIFpn7zCrwcTYaemyu8gxlBJNAYovopCj4KFGgnXDfP+Gu1PSnWI3rTcOj9UJf8EiIKIFGr9XYEIjoULgl7XEG0NBndNiEVAYkw9ZjoBwhq+u8RGMjaKQo+ChSJTFbWz8Oo4J620aLjNGfEz3fA9RIiCiCIZDWcGAh5sKKWcJDCup/2TMq+gDEPMDJ/WP8EdLxvPxj1vikKPgoUlJZTWo8pRb22BXIBXS1vchq2/tkSIgogeAeAUKnPvGxb6hEQ4VxzVO+6ZWvyqn1OjtzBjqzBKxQY3NsoCj4KFHNkvmzHtuQEvRwgUMy2p0cnhuO2EiIKIFZcoXtUQ9DCPThDW2zdso5k07VSYEmGGTokjQvpNCblGK6uKAo+ChQ3SQhrbYW949rPvkSF49+V5wm22xIiCiDX/+njP/3Am/rqr1cFl18Ct2JDfybgvYcWh+zb2sbj8RjcpigKPgoU4kLbLLkp1vRKGi/khcx9P2IP+usSIgogs5PS5N/ichjOxyDowRowgjqZChE3Q/qEweTIK3Sn/X4Y5ZcnCj4KFEG1Q+kUealc1cqfEJwm36wUkSb6EiIKIAvPLqW7XuOvKelZ1GMRUrVboGlfhc4ugt2HJCuJcKYCGOWtJQo+ChR+Ee190G+uewvttGlyEVHy8xy7ahIiCiBMdpwGNSXwTH1jYWuUoyuPmhluir+xWlNYy1qbBRaWhBja+iMKPgoUbnBUJCMdzuwzfrRRvEwdLF+6SMkSIgogvDkQt47yOHfxAXX1JTCU2cf8H64Mmd5RTWxGI22hBBIYpbkjCj4KFJEn36YXUN0dVssdKoj4gxorP5sOEiIKICK0NhPrq+4MYZO+/4GsR8Q9Az8iEVltNiILqOex/92cGJb8Hwo+ChQt1tIpae58LKH4tCjROomVwEMETBIiCiBwzYdTNq7YvcPbTrcsojlgaYdyFrv27m+ZAVbIqjcD/hixlR8KPgoU1bkxkHcaUGBKX3hJqvBnxKnar5wSIgog+osHwBB/qS9rLDxvKZSBWBjojocJGffFr0QUe0Qm7VkYlYAfCj4KFMXtEi5RH/nX3qmG/XQjxhrrE500EiIKIORtbDu7EBIIBKTUzqFUxgI5BOu1uZi+8NPrufZXk3drGLvQHgo+ChRFGs7Kp9xMzm4LfN4C9FXflzU15RIiCiDbqo52LL2TwuBaQgXqsM6qCwWXvLT+ouA2f41D9BhWWBjTnB4KPgoUH62hTe6EO3M+zV3i50VSrSNKVFESIgogTYPVhAi4+57tjTnNwjtKruQDZR6znYbSjSgsJ0UgsRcY8swdCj4KFPbD94crBG2nGYkF5stYwbd1tIvqEiIKIEeA4FPqch7hdO06Mtze5bAzQqC+WIIMjhMPN9AMFwVgGOT4HAo+ChQVcQOLWqq0MewBH2qxCURjxu2YQhIiCiDwPzBeQxn13+nzqw7EjOz9S/cwxjJ6vUAyPpSc4p1xbRjZ5RwKPgoU6A0fVRmls8nSkNPqMU+gVWRTXBoSIgogbDnUx51OClMaBjtynE3RLmCb0mlPlkaWLVZDBmrC1UcYzqwcCj4KFApwkS0Y4T14yzLmMipOV/hh5sPIEiIKIAamrL2XxN4Tw7J/aD/oALQokjOb666HGWyZWvJ2oagFGLn9Gwo+ChTJt1PtKX5fmJTUpDFJz8n3sge2shIiCiBASlfFQNq6e/bCadpDVDDTNhL/McI5rfAkgiZIieGNrBiQ6xkKPgoULTh9leE/aB0zEi5Edfm338Kmj2QSIgog4X05OkyOP1QKCiTFfZsIQTHi7oXqiU9FtN/bibpnCrYY0rwZCj4KFN1XUWE/19MalSNTAUvTn/VgnOKvEiIKILIXD5ow350OKF8azTLta8mvOmn1iOguRkll/UbS4aIuGNmLGAo+ChQiulmsKRivpMG1bT5vhgg+RwzYyxIiCiBvYNU0EusgVeRDX1ZeZvOVQqH6xCsFpJjWzyFI+QUUxhiB7RcKPgoUGR6JahHAp3qWqZq+6YaipANVwEQSIgogjG3YOPzLxEOmfC2xN8qxqtF+A1IGLvn19AhTvhSmor8Y8r4XCj4KFEFG/XoauLhhtwGJeLzRPS0fpj6+EiIKIK6rM9z2ufwG6QrkBCVJf5oeqM9gf5m5JyTsXVU3gJFDGN/3Fgo+ChQ3P4bLN1Wh3njMadPl961ddhW4XRIiCiBgyiAGSrGtK06aN0q5A59SltSD04LtKw5OaqBLVmMlbRi43xYKPgoUfFqoflIDxm6jXGQmL1du3Sm62YASIgogYzA8yyHSvymqCplrh0r50T1DRhlNscmxK38Q4T0U4oIYrfIVCj4KFJcqaE82TM4xRps3qdQ5mFEV61pAEiIKIAPzZT8WA8yN3IDWZJfFUmB1wXvdAQr9ZoKneV/PHmGNGOe5FQo+ChRdVk+ETUEWlLExscSk/Ts4lJT0jxIiCiC4PFXKl/p4R49cK0UHy/cZEqtfEbEtCrGoY7oREE2LXhianhUKPgoUzcAYgidHAkvq/RCkWr7MesGcurASIgog5SA1hEf4+ry5n7qOWjxrN7cBRfwb9SqwPmbwIzEkAXkY4f0UCj4KFC+J19PR4UePiO862KrXaogYn2EkEiIKIJwg+8KQYGh5uQkzL7Fq6OFvTg5r87y86YWKCAJkluUfGNPFEwo+ChTallZNI3ms7gDdn6pVhoG7SZdX/RIiCiCFlwF18VCLPQLUQ6QQ+SrALl+nvzWO4HOc5y28UAizUhi+rBMKPgoUgBS6IS7TiFl1ENBkJY9eMKow1ZESIgogxP04sdg7xwZqPpOYaoTIjOhCgQjXK5adgghQLpkP6MMYnbwSCj4KFGNNL17XydgvQimNiSIwTRtK2yD3EiIKINgxlnBd5LgdC2QM6geC4PG1wA6G+41G43sHqPvnrXiGGKizEgo+ChSxUGnkGxpg/wOujY90H3jHsRRPvhIiCiBYrGKIJwfoWx5xhHvg5z6jV/Xhnb/ur6TMm4Pb3FEuqRiIphIKPgoU4gAEUVMRsgVhj61QT7Upo97uLnESIgogomxGXH85dkmGesQ5GwQy0YKZ6qvm+/2e8fxoahlKorQYttEQCj4KFE4vDknhpHmyohOoQeXoofO8drP3EiIKIBVU3MUHkELOAnsRJki1ZDBa8aWEir+7aO6znZcPu3o9GPKSEAo+ChRHyJYh9Hun/yNiwbL5ek9jEbZG+RIiCiDJfH6WB3qQNoyN/DO/XsMJj1ZpsgXHcLoe0hmNc+sHAxjS+A8KPgoUBqo0vW0d00EZ49wXPvrZT0MKt04SIgoguVZQNzphlMWeGEVvSibKtX9NPCd2acqvpTC2xQI7Tc8Yk+MPCj4KFI2MucJnQLp0oqoKv50rr5giZIWmEiIKILkVA80HeTzq5//j1Lo08dAr8DurGca8NDCBCXIBRgjVGJuVDwo+ChRYOuc25n351y/oe5qn0yENO0sOWhIiCiBD4515jfjqObuMMtDFcGb7p5RlvwwNKaeI5Hx1WrnfJhi4hQ8KPgoU8Mi2rdr3zE7OVwhmB6mgx+pideASIgogJ8pHYf6n68tf77wVQrLJGwLU295p5grf4InmCZ7F6sMYkucOCj4KFC9NZzBHZAcZWvPBv0OLYctteFuVEiIKIOKhGoE5Qu7iQu8POM74q+7Z5Ss99AC9AzCd6tL5qM0oGNzaDgo+ChQgZYv0DtSO0BotCHx/94dPIaVjMxIiCiBx/5c+YCC1c0r8Hga7O8Skl2HBoUWjV+fr0o+Fo9dA5Rj3qw4KPgoUqZq86CPfRLcjN3Jarf9B8PqrTf8SIgogczJCRp13SYx70y1D+yenTEZUX6cSIhHSXHOaclbd+aUYj/ENCj4KFAlg7z/Vj+fbuPIPyYJp07hARRYDEiIKII0jR6kIebt9V+U6idXSlXRYHhRYoaoz/g9VLb6OZXs4GMOKChI/ChR2VVAijPMJvdM/P152g1C6PWnDsRIiCiBW3JzdEDJR/29aFDlGWxB1pA0Uu3bZkVjG9IdTp3QgpxjAm9gGGP7S3aoBGgcIARC09+gGIoFMCj8KFB9ySfQYuQcUv1J5cza3cbWtRnUzEiIKILH9OxZ6SAPXeBD2dReYIdKKXGyYgerulsxh2e6quhVjGMrFkgoKPwoUy1pjuR6PTujbk1lCy+JXJGNkeeASIgog6Nz09YGHzwWxjczG0IhK4Iv0qY2IcX0P/5KitvRXTUcYoPGRCgo/ChTgj7oP6ZlwfRSWuqt0PqsneE3BxRIiCiDeDkoLp8nZhhGg3nzeYp2y0uLl6DC3YKTJHTKJYhGVDBitiroHCj8KFHZVUCKM8wm90z8/XnaDULo9acOxEiIKIFbcnN0QMlH/b1oUOUZbEHWkDRS7dtmRWMb0h1OndCCnGMCb2AYKPwoUnQKBeGhy07vlPFj77KEY2G+oIXcSIgogdfTEd6+krWYzqsBcpqdxySq+iwh7SGcwnBO8XaW2qKYYlICtBgo/ChRAzHIzFLbruTtJ+9nTMO7ItGQcqxIiCiAGCd7/xrepQl8svhZm9UoUdjoWW5USWnOYbtcKoEZpABjegpcGCj8KFNgjQ/zVp0lpwLSEV9cOjVXY9rgBEiIKIH/E4xdpZN9LTBrIxSvxey4L489uHd4YcRLroUcpxD1bGIX2vQMKPwoUZraWZuv3dufry+GXq6RmpxLicHYSIgogwB25StLxbzmD0uTiFiH6xySZd0H13kyanNUvvlUpa34YysWnAwo/ChShbkgFJNY2stoq0YSDMnwuEKXooBIiCiBh3ZFgfILQjcbfhkyV3muz3h7hLkvVOdZoENoMiGGlKBjp6YEDCj8KFBsAK26+uGU8chMBsbVkcrG03nJHEiIKIIGpceCTexW4xdrHMSo9Lu4dacDUPzcj7veq4SHq5gurGOPOvwIKPwoUcrFInvtXpoBXeoOKW6rr4WKnyAISIgogcNRUrOt4I5rJcsAVhUOiBvOLYRSFx4dso22bcUEhtnIY59K7Ago/ChRR19BaZZIIpldhkK676PB2A4UVFRIiCiBi6x7Mr0D+K9V5hCX0A7TTHtpLicWTERuKfBkeGyBU+BjrvLkCCj8KFNJLejJBMzjCqib8ABbZH75zu16uEiIKIKe77AJoQCpSo+zDmBMeR7dDdMBrmtDp9+Vs1u6t3hYeGMaRsQIKPwoUEx/HnnoBLZ5+7yHeO6XVAz/NvB8SIgogjd4Dwn3GrqBTcwNpuXaOmge1gNyja9SmYZso6e8xAHAYuLOrAgo/ChRzQelwubPv+CsgYNNGn8UNevBBRhIiCiA7+wbrh+Y73+udF+Ql8JkM2bspjeJ5Ezy8JTmEJ3JTNRjtpokCCj8KFAPAFqt+wy2fjXev2xkfv1PqCNkXEiIKIDnVaU3ywrz8gS3hOozcXrIMtx4NSIgPteuZUrrGQ4C7GKql/gEKPwoUcd+NmHnCBWOk4qvtqVzR/Ffb9qoSIgogDCJh91Oqh9P76gYC71pFSzd0cxnvqRDyZ6/PLFZfgLEY0fr9AQo/ChR2ioJwDjBG5tr4SWZFeeZJWXzBtBIiCiA27dgAuZV/uS9FdsILGWLBw8eYPy+ZEyv1Df2VsrjXDhio5f0BCj8KFJ58rgCe//TRY/P7h4GgeyXCsQsyEiIKIJv5+0tWTvpFLSO/sYAhp5JaOYl4DHSPUL4n9lXLx2I4GKGj+QEKPwoUmQY7kZQEtpUKeaajHjcDeP4HAg0SIgogT1wp5ELzvqVOqBm6eGYiQQWe0TaC2LV2BThHaVxh/rkYqdz3AQo/ChQ5MnaSwlileXDvU/CqTTwA+VmIuBIiCiBVmDqFINGRp4RqxP0G5i/9o1DXRGgsx5MTB6K3IpU2vxjw6PYBCj8KFBahaZUah4JH2+JY/dxxY49mBtFWEiIKIG++8wof1bEYFb3xl7luHQfg4YOD6zFlgQuRtiv6hYIlGIq+8wEKPwoU8/Vdoku0faYLD7cewanJJ0vO7bISIgogjjcSFNFQ8q+t/xHr1uYdI2/c1sPwAD17uKg+AyOWTe4Yx5rlAQo/ChQgIv6MxJ5IYwx2Fg4RqIBFkhnSRBIiCiCerr6gMFdhIoshHa+Sw54Yz2JVPf5G0jJYBYRSpw6g7hjfhOEBCj8KFH7bAGUiYQxYKD4wZEoU8nvMDTLtEiIKIM82VmQKUQmgl9WCM2UAMXB0eSV/q/81ib/50+5npOLdGOeG3wEKPwoUBMg6og91Y7vLz2qhUO9rDIGAjaoSIgog95HMDW5006ssh50NO61qSXj94ZqRXGe1Ta78qy7u6Z0YnYreAQo/ChRiOaSYwi3z7D+wyi+W0VU19vM4ehIiCiD+TtfxgQ4Tce1EL3Uar2taHcp12TaWzmDJ5ROFmOrgOhjw39cBCj8KFIsdVnb0wMhxoMeGSFDUUdaorI47EiIKILamh0ZNcJLqhzI3zepA0tcplOVWQwtVy5hTRSEZLaN6GOLk1gEKPwoUsLNf7UDapf+dS8aFx1klGH9iIRkSIgogw/cYALnzUUHfP0Ej/N0GcWk8Kl6q5+CnIqxbrX4A2vkYyvjHAQo/ChRfmZpL4lSGmSWn8v6gTXs7g2z/CxIiCiAU+lliVpfWeNxk1dL5bSkCbdq4+qg5Dp5yhlh8RQF3lRjlrcMBCj8KFKBrW2grQlrSBqNcryRv1w3QmOUGEiIKINNFRrmRQHnp5/2X/K2KjaMMlJ08z6N83LvyyqbRiJx1GNKivQEKPwoUrxlZQ+RP4dYlAHa4vBkQ6ryF8fISIgogj4Mh60mc9b88H4uCOpmOQ4jYkzBbX41x+AP+CFWm3NsYlI+7AQo/ChRpEuC6OM0AyfL8nnHpceztUHtC/RIiCiCjUC5feam4dOzobPgGhTxH0mPTzPv+MnrJVWipzZUUkBj+o7cBCj8KFMAvUx2bu6SQdRHvJoBCHOcUoR47EiIKICPbH0qbbH58BDiGPH0U9xnswIjrOx/Ip/5gdJZEf6xOGJOJtwEKPwoUfvJEhowwSqWzSIk3Li34dK/WNc0SIgogAmRwY/dqPSBXDY/YO/huPokiInnsXm0h+wM4ORwQivYY85O2AQo/ChSXr+RTlbdOeEyI1F5cyimVAZ+uCBIiCiBkfZNa+Ttt3wwuMMcsi284lS/4SWB8i0k7hXhedd51XBi/6rQBCj8KFOGR5lTQa59yFWi7lFsutR3cHI/cEiIKIEiIpwn+BiprqhtbtNRGQsvjzWP3/vLEIz8MpxtmpJIPGICEsAEKPwoUjgVFsSIue1yFzmntx48oDLK3nRgSIgogvYNTZWxKhe3Un6gF+qX6cVB3o/KG4YxMC4/IgRTK0z4Yq7WsAQo/ChScvsjL1O06rUuysDRu/IamxB+RYBIiCiBvzgXGhobiT0gpBg2nOofXqcIDfnOYC6auG1I8m8whxxiE4qsBCj8KFEDEiDnNSH2KE9ZZVbf8bE9WDY9yEiIKILuomSFRjNYpdvnmorIbgjNYDoyTCZTOpjy+ba1lrG2qGJjqpgEKPwoUXoCekeq2nThXhNGRFA6cjPbdEDcSIgogLCCt4CBSrkDvSDHWMB7vepYZ4swoq1A0rOpmRM4+Y6cY6dylAQo/ChTYpsVMVKI21IQ7pWZSC6A/YPCeNRIiCiDxhGQThh2sE9dUr2GCItgpuuCc1RgmwYZ9zwp3+05a6hj81KABCj8KFOEs7zhxuVle8VQB7tJGbpMQ5IFrEiIKIAt52JfhSwHW5E5K7z/DoYHclEB+A6SA+aU9u6Eo/F5aGPTpngEKPwoUfg7XaJtlw0XRyBfFsDMv0TLeWHUSIgogRfEMj+BAig8+YIjwIlquGWA7VRO1CMfign6PtwEct6kY1cGeAQo/ChSWb9ibHbUVNfLYmM+bDxTaN077lhIiCiBGV1ilhAKPboB5mrq0fq4LTJKn28xivAi4mjDJaJVpOBjtt54BCj8KFBOP2at6vguu0Uyn1B2IW3gFKkqhEiIKIJpmEJtpwJ61CiuSjct9RQlfZQTJffyoEZWuLwbIfyVwGKGbngEKPwoUlbAC3mdwcxPRI9BkkvGnpYR45UYSIgogPA+RvSpVTcAWBD/+nxXltHLaOdaUzbrpNGfjMak/usUYx7OYAQo/ChRjSB9tyq9zPS/JU6M1wiAO4ZCGLBIiCiBncDR8KRopHT2+8NPvHXwjYhzPG9Wl7OSw+mxjIrWfZhjC9pYBCj8KFPmpaKQF+wQpQQrlFl5fIZMnHmeKEiIKIMForkEegmKtkt9aIL6w62A/IiN1dXISCyesYnvia0SfGID9lAEKPwoUJz9y7lWYevp3GyfTcPoTH2CLg6wSIgogGPlrdJ5z/EbXbUKc3xIcQn+LOU1UaF4kyk7CfvephJgYqIGUAQo/ChQG9Fw2/LlX5V2SOm1OkFwtcVEVrRIiCiAs5RoNNLRhlLvQeMHCv0BF6tcxdtfEU+xLFDju42YC8Bix+JIBCj8KFHb3Bq5zqCUWUrxyy4AeQpTiE1r7EiIKILzcmukXniLIE0d4e3DatLg8CF/WXJ+Esf2QBUFvaEu1GLvDjwEKPwoUn47C71gc4lYwyBnxm1SEA550jRoSIgogd2hzX/3wrmjtrb+jAKUUvO95VeejdRoxTHdQY7/8gLoYgPWMAQo/ChRxK8iRrrch2nJzK8MNUx4MHq7a4BIiCiCiS2ONlxaft52PHXhuVY7tXWaGEKSC8F6BfNdYP2z1oBiAxIwBCj8KFNvNdl2yZAYxlGwTk7olWHbHbaOOEiIKIFDd2pMeCzcU+vr1Y39Nnc5GG+Zz6dl29YgfVQYrMR3oGJ6xjAEKPwoU8ZTdSorYMyPD6cKpPbJfBJYhx7QSIgogoboi39aVwyap22GNEnO/xV39Kvv+/njYlKzqXDHQ/u4Y94mHAQo/ChTO/n1lS1I96iqe1xilkRJsdBcWiRIiCiAkUEOjuz82VaegjqFvLhrKqy1LOyIPxPoppvFVMNlTPRiF8YIBCj8KFOA7mF5siQXhhNiMmVzGMqoniYHrEiIKICQPgYSN54nvbUfpUQg2958mQetLW4R14ezDVqU2qybiGI6fgAEKPgoUJxLPaK9pgrS9dTa5TN0NEEoDE/QSIgog4ICana8QvCEuAcXm/O35Kr6TFEQWQhP6KU1vCyOm54gYuYJ+Cj4KFMnmFSidHZLlApLDsL2DWNmy5AKQEiIKIIFreqvvNi0dpzFh6O5NquSxi7F87mRmo2UFRcqpM8oGGMrDego+ChQg7+GG2pGgCsfwQs1stqHogsWDxxIiCiD8mfpRfRBVZU6q4SDlFZTEyRRHxD11HJzuCSa/3YMElBiqmXoKPgoUfVPXby24a+MKmybK3qaQeFMaubsSIgogQuejs9DfnCsgGElTXxULslAU06c4bzyrxDSTtDZ6VO8Yu7J4Cj4KFJQ1R8rLKcVXl+EhrLTlhsSdnTn9EiIKIPlinzbm6KAqRHSRPd2vpCjH5/2IyYYvQweWmWTUvXaeGIW9dgo+ChRG3qE3z7ELxBmyV3qppYcYaA4YuhIiCiAkwXWbMbx+PRrSBKW+r20/VoMvtmdxNaX+rsuiujK1GxjA5nMKPgoUBERtoLzEMQAD+Xsb7QerKr7G/qcSIgogMKPR9uSBYxuCswBITqGu0MUDx/NBd2PKPkOyZqZTCbEYloZzCj4KFGkhdLP/u6gDlKlNySZl3AFE+6g3EiIKIPonBlFXsmzWmHON4jQy9Ir3Jpvbff4lotv9QHOBda3FGK+ycAo+ChROFUySiOMUNrqBTdktF8TtbO/T8RIiCiB3Rt1QU+mjQeGWKTMclREughe9SJQS87byrgidyM/L1RiP4GwKPgoUnL8u/9VXCzqaQTRiRHV82j4Y1AESIgogPwTrMSmKul2l8xfN3RmAryyVv2+PvwG9XpZxf4Vc4uMYt9tpCj4KFMoPKnEh+G07bZE0lzAVW5paMcVUEiIKIEC04AhCMtM59n+dfU+jB72oivfudrEE487J08OfdenRGJqWaQo+ChSERc9Vy1EnjmOyExrbZagdwjidjhIiCiD3klqMklR7/l0zIVE4dAalvFto7ACER9DdLLw7k0vmZxit+GMKPgoUhEKQUx7lm0D+795SWYVzaL9xGewSIgogOPsZnvTy3S90rA8kv2FQEKmFigTn5hHdAop0qNZgNTcYq/JjCj4KFL1PgPDBpntJUHcmYvbrytWKJYkzEiIKIEMT4c1xQ/4XgVU2pPNJm94NHADeH6N4iAHxoc1tyrZLGPWTYwo+ChQXOGswjvlnDN1BL7LzwJxbh1+4uBIiCiBe9gw8N9+8eGUHIf5PaYoIRdzMpnQWhRBY+2i7RDjX5RjQwmIKPgoUaKOTx+1JaHEVDAp8rQysCbjkWPsSIgogbU1m3aDQwxIpqFYubjZIhSvYCia1MQSfTaaXJBg38nwYsZ5fCj4KFH/B2kCyVo3b1Tz/O3bEnOia4oaAEiIKIOW9FXzJFyNWBKLamfwL7U/EzsLqJ+/kYICi+Gem9m2YGKLBXAo+ChS1wzpAmlicCU6J930kE58lxqbe6RIiCiD6t/4rLoR1+mTmSp026/f6efuahaZtEUJXAuAzAxJHVRiw/1sKPgoUadBgUinGZZdOu3Nvx34WJFw/eaoSIgog+uY7CLC1Wj16/aR50vw3LcJbwIl2/F/zorbUIhKqK/EY9/5bCj4KFCwkZxgLuoTy8dRWXmb1ZaNAA+5PEiIKILXez4zhnKmvWK6WnjfMe4Ou/fk5f4FooZESRhi8JB9dGP66Wwo+ChQ+iOfFT2RkKpiy4d3VvbpIeU8GxxIiCiB64m5t3CnRY2Nf3E9VFhwxoE93Q0I/2YooxxRW0+GpCRjOoFoKPgoUAApZWWNLQpbk3lNkgd4AqKDrmlgSIgogXYCvhdcOlm/5g1gHSO+7/hAuCQ0kZPU7XKvc1C5e1U4YzvBWCj4KFD/2yYh5nBrfOsoNpWFDyBY4kIWaEiIKIJd2lnpYn+KksJ8Xd/D3MbzgfRfKKHeKASMcEI3Cm/6IGNrjVAo+ChTly6GZ4EXnA2cR2BTlfitmw8wDkRIiCiBT0ni6rmLrMooeRF5Qqwx60lK/II2sJqosgLIiipdQTBiHnFIKPgoUJvd3e9UpGK5xgBAisOLe7Zfd1QQSIgogzYocxweamZm6GOE4NMZ+VzaPpeGdoSIskIA4QHo+/oEYg+VNCj4KFMiWkXH5taM1THEqIPAHzeBkjJkPEiIKIK30LfrwCeCF/pKQGgQA6xeSyT496UwWrCyS+HyvyowsGKjiTQo+ChQV/sEEFuNZzB3bQkxpFmsmcfJRSBIiCiA6Mkkz6UI+jnyU1YOAQOpoToqaogRcaPiafUH98CWZRxjRvEwKPgoUYKQz0osIeIxy4hM1VL1cxodp3OwSIgogHo34cqIRxDu28ioc64/VuIebfKeS3mcjvzBl+mxCZvEYu9BECj4KFNTEHB4X6TIdBnuk4+R252aywsK9EiIKIEqPagoZmehF3b/Sv1dVnLi6h4Yz3Z2Gkd1refX6Ai/cGOrSPwo+ChTyM+A2JIo2/HPBVP+nkmG8vcS7dhIiCiC6qGl8rhpnwxpMLxnEPjGGByBcMKeSjh53rMR3PuMN6xi/4zwKPgoU2krxmjeMCbVMJsNGfLCt+IkpKVQSIgogdOk2pUzVZHq8JDdyNLOyHH9k+ry/Xvxl7d/vZivYE0IY//I5Cj4KFJAf0SLMUS7xPejho9eVO/3cB4bWEiIKIF4CLtZV99fC0icpa6Ai80YwJYiaDiPp8nu697xvEuxXGJGKOQo+ChRLZSVYV+Q5N1TwSdvpRcWsh/Vj2BIiCiDZUKbh9yUuXKW0qZxi4lkTr5SBn4e0vW6FrGdGK21cFBif6jgKPgoUqcTg4q8AGD2hFDTtQTIZkF6aho0SIgogtXnmhUMcIybIb9Wzmx5tiLD4xojGQc5irbOcNb/RThoY7IM0Cj4KFMAqy6dlOsN4J1C1PQOmcuGR8ANhEiIKIBxt6nIOMqgs5QRuTamLWWkSrVaeVinMZxE0Ga9BkyZmGODrMgo+ChQGFAiMQeaoX7W/NEVSpRIOWgE5/BIiCiDDCxtDqaQTB5m3ZmE9qedBqn8WKbWwO+IY51ClrSDIDxjTwTIKPgoUP/cZ8WZL7pPUgrSAZ3wDpH7AtkMSIgoggJvkPLiHo2+12JJGprhwVsABASXzXO/dSWtnk0ywNB4YyNswCj4KFJmThJVAfAmzQ1YqrsOrVRpcJGIyEiIKIBLzGurD6RCUM/G6mpRjBEjrwyqtmGFz6Pfom96qvps/GNzoLgo+ChT2eD2Psw4oMIHBY5gpP0gtyg6RLRIiCiDZy5WpQhvLTnvFnyd2+iwKTd+6i/MGgkYszIHvgmxk2BjF5S0KPgoUIzVGWye5VIMTqvRlIXeH/Y5hE9MSIgogrYPM5swt2DJmKFgI7v0Bzt/7yUIaUWF+Lq0hu6qF/fcY958tCj4KFOBtretBOClVj3yVM5/7YUmcWhu5EiIKIHd6jZNjXOQ82qUUTzSf/3Y2+lCfHEZSUbWMXuPEJVvoGK26LAo+ChQ3cUxNpAfJ0TzaQkqueMOyhRWhXBIiCiDzHAwRAMjjGgPZnfTR/y+mNviRthBW53ntGBaGWu0G0xi3yCsKPgoUzkhVF2SeT4xxRp732vz5pVi/Fn8SIgogTWhrgLe+T+LFELUqUHzcvmFoRUy+A+NL1ZvKIGdc1NUYrIgrCj4KFOI6/PADX7AazQL+lvaABml01wcrEiIKINYB0PpTONa81Yasb8Cv4JbSDHSIgNis6yru0Sa2Oa+0GI/9Kgo+ChRf7OyUCKJxCDPy99hIM5RiwpwURBIiCiAJJIWl5OYXduLpDgYdOdRn3TuCEdNDOvbeCb8Efjf0ARjSwyoKPgoUDOuRfeTfHEtPjt/ErOb9bTnx5h4SIgog1XjgGivzBPhmBVgB1OIFpn7zCrwcTYaemyu8gxlBJNAYovopCj4KFGgnXDfP+Gu1PSnWI3rTcOj9UJf8EiIKIFGr9XYEIjoULgl7XEG0NBndNiEVAYkw9ZjoBwhq+u8RGMjaKQo+ChSJTFbWz8Oo4J620aLjNGfEz3fA9RIiCiCIZDWcGAh5sKKWcJDCup/2TMq+gDEPMDJ/WP8EdLxvPxj1vikKPgoUlJZTWo8pRb22BXIBXS1vchq2/tkSIgogeAeAUKnPvGxb6hEQ4VxzVO+6ZWvyqn1OjtzBjqzBKxQY3NsoCj4KFHNkvmzHtuQEvRwgUMy2p0cnhuO2EiIKIFZcoXtUQ9DCPThDW2zdso5k07VSYEmGGTokjQvpNCblGK6uKAo+ChQ3SQhrbYW949rPvkSF49+V5wm22xIiCiDX/+njP/3Am/rqr1cFl18Ct2JDfybgvYcWh+zb2sbj8RjcpigKPgoU4kLbLLkp1vRKGi/khcx9P2IP+usSIgogs5PS5N/ichjOxyDowRowgjqZChE3Q/qEweTIK3Sn/X4Y5ZcnCj4KFEG1Q+kUealc1cqfEJwm36wUkSb6EiIKIAvPLqW7XuOvKelZ1GMRUrVboGlfhc4ugt2HJCuJcKYCGOWtJQo+ChR+Ee190G+uewvttGlyEVHy8xy7ahIiCiBMdpwGNSXwTH1jYWuUoyuPmhluir+xWlNYy1qbBRaWhBja+iMKPgoUbnBUJCMdzuwzfrRRvEwdLF+6SMkSIgogvDkQt47yOHfxAXX1JTCU2cf8H64Mmd5RTWxGI22hBBIYpbkjCj4KFJEn36YXUN0dVssdKoj4gxorP5sOEiIKICK0NhPrq+4MYZO+/4GsR8Q9Az8iEVltNiILqOex/92cGJb8Hwo+ChQt1tIpae58LKH4tCjROomVwEMETBIiCiBwzYdTNq7YvcPbTrcsojlgaYdyFrv27m+ZAVbIqjcD/hixlR8KPgoU1bkxkHcaUGBKX3hJqvBnxKnar5wSIgog+osHwBB/qS9rLDxvKZSBWBjojocJGffFr0QUe0Qm7VkYlYAfCj4KFMXtEi5RH/nX3qmG/XQjxhrrE500EiIKIORtbDu7EBIIBKTUzqFUxgI5BOu1uZi+8NPrufZXk3drGLvQHgo+ChRFGs7Kp9xMzm4LfN4C9FXflzU15RIiCiDbqo52LL2TwuBaQgXqsM6qCwWXvLT+ouA2f41D9BhWWBjTnB4KPgoUH62hTe6EO3M+zV3i50VSrSNKVFESIgogTYPVhAi4+57tjTnNwjtKruQDZR6znYbSjSgsJ0UgsRcY8swdCj4KFPbD94crBG2nGYkF5stYwbd1tIvqEiIKIEeA4FPqch7hdO06Mtze5bAzQqC+WIIMjhMPN9AMFwVgGOT4HAo+ChQVcQOLWqq0MewBH2qxCURjxu2YQhIiCiDwPzBeQxn13+nzqw7EjOz9S/cwxjJ6vUAyPpSc4p1xbRjZ5RwKPgoU6A0fVRmls8nSkNPqMU+gVWRTXBoSIgogbDnUx51OClMaBjtynE3RLmCb0mlPlkaWLVZDBmrC1UcYzqwcCj4KFApwkS0Y4T14yzLmMipOV/hh5sPIEiIKIAamrL2XxN4Tw7J/aD/oALQokjOb666HGWyZWvJ2oagFGLn9Gwo+ChTJt1PtKX5fmJTUpDFJz8n3sge2shIiCiBASlfFQNq6e/bCadpDVDDTNhL/McI5rfAkgiZIieGNrBiQ6xkKPgoULTh9leE/aB0zEi5Edfm338Kmj2QSIgog4X05OkyOP1QKCiTFfZsIQTHi7oXqiU9FtN/bibpnCrYY0rwZCj4KFN1XUWE/19MalSNTAUvTn/VgnOKvEiIKILIXD5ow350OKF8azTLta8mvOmn1iOguRkll/UbS4aIuGNmLGAo+ChQiulmsKRivpMG1bT5vhgg+RwzYyxIiCiBvYNU0EusgVeRDX1ZeZvOVQqH6xCsFpJjWzyFI+QUUxhiB7RcKPgoUGR6JahHAp3qWqZq+6YaipANVwEQSIgogjG3YOPzLxEOmfC2xN8qxqtF+A1IGLvn19AhTvhSmor8Y8r4XCj4KFEFG/XoauLhhtwGJeLzRPS0fpj6+EiIKIK6rM9z2ufwG6QrkBCVJf5oeqM9gf5m5JyTsXVU3gJFDGN/3Fgo+ChQ3P4bLN1Wh3njMadPl961ddhW4XRIiCiBgyiAGSrGtK06aN0q5A59SltSD04LtKw5OaqBLVmMlbRi43xYKPgoUfFqoflIDxm6jXGQmL1du3Sm62YASIgogYzA8yyHSvymqCplrh0r50T1DRhlNscmxK38Q4T0U4oIYrfIVCj4KFJcqaE82TM4xRps3qdQ5mFEV61pAEiIKIAPzZT8WA8yN3IDWZJfFUmB1wXvdAQr9ZoKneV/PHmGNGOe5FQo+ChRdVk+ETUEWlLExscSk/Ts4lJT0jxIiCiC4PFXKl/p4R49cK0UHy/cZEqtfEbEtCrGoY7oREE2LXhianhUKPgoUzcAYgidHAkvq/RCkWr7MesGcurASIgog5SA1hEf4+ry5n7qOWjxrN7cBRfwb9SqwPmbwIzEkAXkY4f0UCj4KFC+J19PR4UePiO862KrXaogYn2EkEiIKIJwg+8KQYGh5uQkzL7Fq6OFvTg5r87y86YWKCAJkluUfGNPFEwo+ChTallZNI3ms7gDdn6pVhoG7SZdX/RIiCiCFlwF18VCLPQLUQ6QQ+SrALl+nvzWO4HOc5y28UAizUhi+rBMKPgoUgBS6IS7TiFl1ENBkJY9eMKow1ZESIgogxP04sdg7xwZqPpOYaoTIjOhCgQjXK5adgghQLpkP6MMYnbwSCj4KFGNNL17XydgvQimNiSIwTRtK2yD3EiIKINgxlnBd5LgdC2QM6geC4PG1wA6G+41G43sHqPvnrXiGGKizEgo+ChSxUGnkGxpg/wOujY90H3jHsRRPvhIiCiBYrGKIJwfoWx5xhHvg5z6jV/Xhnb/ur6TMm4Pb3FEuqRiIphIKPgoU4gAEUVMRsgVhj61QT7Upo97uLnESIgogomxGXH85dkmGesQ5GwQy0YKZ6qvm+/2e8fxoahlKorQYttEQCj4KFE4vDknhpHmyohOoQeXoofO8drP3EiIKIBVU3MUHkELOAnsRJki1ZDBa8aWEir+7aO6znZcPu3o9GPKSEAo+ChRHyJYh9Hun/yNiwbL5ek9jEbZG+RIiCiDJfH6WB3qQNoyN/DO/XsMJj1ZpsgXHcLoe0hmNc+sHAxjS+A8KPgoUBqo0vW0d00EZ49wXPvrZT0MKt04SIgoguVZQNzphlMWeGEVvSibKtX9NPCd2acqvpTC2xQI7Tc8Yk+MPCj4KFI2MucJnQLp0oqoKv50rr5giZIWmEiIKILkVA80HeTzq5//j1Lo08dAr8DurGca8NDCBCXIBRgjVGJuVDwo+ChRYOuc25n351y/oe5qn0yENO0sOWhIiCiBD4515jfjqObuMMtDFcGb7p5RlvwwNKaeI5Hx1WrnfJhi4hQ8KPgoU8Mi2rdr3zE7OVwhmB6mgx+pideASIgogJ8pHYf6n68tf77wVQrLJGwLU295p5grf4InmCZ7F6sMYkucOCj4KFC9NZzBHZAcZWvPBv0OLYctteFuVEiIKIOKhGoE5Qu7iQu8POM74q+7Z5Ss99AC9AzCd6tL5qM0oGNzaDgo+ChQgZYv0DtSO0BotCHx/94dPIaVjMxIiCiBx/5c+YCC1c0r8Hga7O8Skl2HBoUWjV+fr0o+Fo9dA5Rj3qw4KPgoUqZq86CPfRLcjN3Jarf9B8PqrTf8SIgogczJCRp13SYx70y1D+yenTEZUX6cSIhHSXHOaclbd+aUYj/ENCj4KFAlg7z/Vj+fbuPIPyYJp07hARRYDEiIKII0jR6kIebt9V+U6idXSlXRYHhRYoaoz/g9VLb6OZXs4GMOKChI/ChR2VVAijPMJvdM/P152g1C6PWnDsRIiCiBW3JzdEDJR/29aFDlGWxB1pA0Uu3bZkVjG9IdTp3QgpxjAm9gGGP7S3aoB")
 	require.NoError(t, err)
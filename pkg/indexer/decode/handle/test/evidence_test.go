@@ -11,10 +11,13 @@ import (
 	testsuite "github.com/celenium-io/celestia-indexer/internal/test_suite"
 	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode"
 	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode/context"
+	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	evidenceTypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
 	"github.com/fatih/structs"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // MsgSubmitEvidence
@@ -72,3 +75,39 @@ func TestDecodeMsg_SuccessOnMsgSubmitEvidence(t *testing.T) {
 	assert.Equal(t, msgExpected, dm.Msg)
 	assert.Equal(t, addressesExpected, dm.Addresses)
 }
+
+func TestDecodeMsg_MsgSubmitEvidenceEquivocation(t *testing.T) {
+	consAddress, err := bech32.ConvertAndEncode("celestiavalcons", []byte{0x81, 0xa2, 0x4e, 0xe5, 0x34, 0xde, 0xfe, 0x15, 0x57, 0xa4, 0xc7, 0xc4, 0x37, 0xe8, 0xe8, 0xfb, 0xc2, 0xf8, 0x34, 0xe8})
+	require.NoError(t, err)
+
+	evidence, err := codecTypes.NewAnyWithValue(&evidenceTypes.Equivocation{
+		Height:           90,
+		Power:            100,
+		ConsensusAddress: consAddress,
+	})
+	require.NoError(t, err)
+
+	msg := &evidenceTypes.MsgSubmitEvidence{
+		Submitter: "celestia1j33593mn9urzydakw06jdun8f37shlucmhr8p6",
+		Evidence:  evidence,
+	}
+	blob, _ := testsuite.EmptyBlock()
+
+	decodeCtx := context.NewContext()
+	decodeCtx.Block = &storage.Block{
+		Height: blob.Height,
+		Time:   blob.Block.Time,
+	}
+	// slash event of transaction is parsed before message
+	decodeCtx.AddSlash(&storage.Slash{
+		Reason: "double_sign",
+		Validator: &storage.Validator{
+			ConsAddress: "81A24EE534DEFE1557A4C7C437E8E8FBC2F834E8",
+		},
+	})
+
+	_, err = decode.Message(decodeCtx, msg, 0, storageTypes.StatusSuccess)
+	require.NoError(t, err)
+	require.Len(t, decodeCtx.Slashes, 1)
+	require.EqualValues(t, 90, decodeCtx.Slashes[0].InfractionHeight)
+}
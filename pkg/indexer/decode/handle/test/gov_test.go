@@ -96,6 +96,15 @@ func TestDecodeMsg_SuccessOnMsgSubmitProposal_V1(t *testing.T) {
 		storageTypes.MsgSubmitProposal,
 		49,
 	)
+	msgExpected.Proposal = &storage.Proposal{
+		Height:    blob.Height,
+		CreatedAt: now,
+		Status:    storageTypes.ProposalStatusInactive,
+		Type:      storageTypes.ProposalTypeUnknown,
+		Proposer: &storage.Address{
+			Address: "celestia10d07y265gmmuvt4z0w9aw880jnsr700jtgz4v7",
+		},
+	}
 
 	assert.NoError(t, err)
 	assert.Equal(t, int64(0), dm.BlobsSize)
@@ -137,6 +146,15 @@ func TestDecodeMsg_SuccessOnMsgSubmitProposal_V1Beta1(t *testing.T) {
 		storageTypes.MsgSubmitProposal,
 		49,
 	)
+	msgExpected.Proposal = &storage.Proposal{
+		Height:    blob.Height,
+		CreatedAt: now,
+		Status:    storageTypes.ProposalStatusInactive,
+		Type:      storageTypes.ProposalTypeUnknown,
+		Proposer: &storage.Address{
+			Address: "celestia10d07y265gmmuvt4z0w9aw880jnsr700jtgz4v7",
+		},
+	}
 
 	assert.NoError(t, err)
 	assert.Equal(t, int64(0), dm.BlobsSize)
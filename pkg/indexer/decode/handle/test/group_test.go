@@ -56,6 +56,14 @@ func TestDecodeMsg_SuccessOnMsgCreateGroup(t *testing.T) {
 		Size:      49,
 		Namespace: nil,
 		Addresses: addressesExpected,
+		Group: &storage.Group{
+			Height: blob.Height,
+			Time:   now,
+			Admin: &storage.Address{
+				Address: "celestia1j33593mn9urzydakw06jdun8f37shlucmhr8p6",
+			},
+			Members: []*storage.GroupMember{},
+		},
 	}
 
 	assert.NoError(t, err)
@@ -259,6 +267,13 @@ func TestDecodeMsg_SuccessOnMsgCreateGroupPolicy(t *testing.T) {
 		Size:      49,
 		Namespace: nil,
 		Addresses: addressesExpected,
+		GroupPolicy: &storage.GroupPolicy{
+			Height: blob.Height,
+			Time:   now,
+			Admin: &storage.Address{
+				Address: "celestia1j33593mn9urzydakw06jdun8f37shlucmhr8p6",
+			},
+		},
 	}
 
 	assert.NoError(t, err)
@@ -355,6 +370,21 @@ func TestDecodeMsg_SuccessOnMsgCreateGroupWithPolicy(t *testing.T) {
 		Size:      49,
 		Namespace: nil,
 		Addresses: addressesExpected,
+		Group: &storage.Group{
+			Height: blob.Height,
+			Time:   now,
+			Admin: &storage.Address{
+				Address: "celestia1j33593mn9urzydakw06jdun8f37shlucmhr8p6",
+			},
+			Members: []*storage.GroupMember{},
+		},
+		GroupPolicy: &storage.GroupPolicy{
+			Height: blob.Height,
+			Time:   now,
+			Admin: &storage.Address{
+				Address: "celestia1j33593mn9urzydakw06jdun8f37shlucmhr8p6",
+			},
+		},
 	}
 
 	assert.NoError(t, err)
@@ -518,6 +548,14 @@ func TestDecodeMsg_SuccessOnMsgSubmitProposalGroup(t *testing.T) {
 		Size:      49,
 		Namespace: nil,
 		Addresses: addressesExpected,
+		GroupProposal: &storage.GroupProposal{
+			Height: blob.Height,
+			Time:   now,
+			Status: storageTypes.GroupProposalStatusSubmitted,
+			PolicyAddress: &storage.Address{
+				Address: "celestia1vsvx8n7f8dh5udesqqhgrjutyun7zqrgehdq2l",
+			},
+		},
 	}
 
 	assert.NoError(t, err)
@@ -576,7 +614,9 @@ func TestDecodeMsg_SuccessOnMsgWithdrawProposal(t *testing.T) {
 
 func TestDecodeMsg_SuccessOnMsgVoteGroup(t *testing.T) {
 	msg := &group.MsgVote{
-		Voter: "celestia1j33593mn9urzydakw06jdun8f37shlucmhr8p6",
+		ProposalId: 1,
+		Voter:      "celestia1j33593mn9urzydakw06jdun8f37shlucmhr8p6",
+		Option:     group.VOTE_OPTION_YES,
 	}
 	blob, now := testsuite.EmptyBlock()
 	position := 0
@@ -611,7 +651,7 @@ func TestDecodeMsg_SuccessOnMsgVoteGroup(t *testing.T) {
 		Type:      storageTypes.MsgVoteGroup,
 		TxId:      0,
 		Data:      structs.Map(msg),
-		Size:      49,
+		Size:      53,
 		Namespace: nil,
 		Addresses: addressesExpected,
 	}
@@ -620,6 +660,13 @@ func TestDecodeMsg_SuccessOnMsgVoteGroup(t *testing.T) {
 	assert.Equal(t, int64(0), dm.BlobsSize)
 	assert.Equal(t, msgExpected, dm.Msg)
 	assert.Equal(t, addressesExpected, dm.Addresses)
+
+	assert.Len(t, decodeCtx.GroupVotes, 1)
+	assert.Equal(t, storageTypes.VoteOptionYes, decodeCtx.GroupVotes[0].Option)
+	proposal, ok := decodeCtx.GroupProposals.Get(1)
+	assert.True(t, ok)
+	assert.EqualValues(t, 1, proposal.Yes)
+	assert.EqualValues(t, 1, proposal.VotesCount)
 }
 
 func TestDecodeMsg_SuccessOnMsgExecGroup(t *testing.T) {
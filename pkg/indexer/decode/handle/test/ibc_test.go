@@ -59,6 +59,16 @@ func TestDecodeMsg_SuccessOnIBCMsgTransfer(t *testing.T) {
 				Hash:       []byte{0x94, 0x63, 0x42, 0xc7, 0x73, 0x2f, 0x6, 0x22, 0x37, 0xb6, 0x73, 0xf5, 0x26, 0xf2, 0x67, 0x4c, 0x7d, 0xb, 0xff, 0x98},
 				Balance:    storage.EmptyBalance(),
 			},
+		}, {
+			Type: storageTypes.MsgAddressTypeReceiver,
+			Address: storage.Address{
+				Id:         0,
+				Height:     blob.Height,
+				LastHeight: blob.Height,
+				Address:    "celestia1vsvx8n7f8dh5udesqqhgrjutyun7zqrgehdq2l",
+				Hash:       []byte{0x64, 0x18, 0x63, 0xcf, 0xc9, 0x3b, 0x6f, 0x4e, 0x37, 0x30, 0x0, 0x2e, 0x81, 0xcb, 0x8b, 0x27, 0x27, 0xe1, 0x0, 0x68},
+				Balance:    storage.EmptyBalance(),
+			},
 		},
 	}
 
@@ -80,3 +90,26 @@ func TestDecodeMsg_SuccessOnIBCMsgTransfer(t *testing.T) {
 	assert.Equal(t, msgExpected, dm.Msg)
 	assert.Equal(t, addressesExpected, dm.Addresses)
 }
+
+func TestDecodeMsg_SuccessOnIBCMsgTransferToCounterparty(t *testing.T) {
+	msgSend := &ibcTypes.MsgTransfer{
+		SourcePort:    "transfer",
+		SourceChannel: "channel-2",
+		Token:         types.NewCoin("utia", types.NewInt(1000)),
+		Sender:        "celestia1j33593mn9urzydakw06jdun8f37shlucmhr8p6",
+		Receiver:      "osmo1mm8yykm46ec3t0dgwls70g0jvtm055wkuvxanc",
+	}
+	blob, _ := testsuite.EmptyBlock()
+
+	decodeCtx := context.NewContext()
+	decodeCtx.Block = &storage.Block{
+		Height: blob.Height,
+		Time:   blob.Block.Time,
+	}
+
+	dm, err := decode.Message(decodeCtx, msgSend, 0, storageTypes.StatusSuccess)
+	assert.NoError(t, err)
+	assert.Len(t, dm.Addresses, 1)
+	assert.Equal(t, storageTypes.MsgAddressTypeSender, dm.Addresses[0].Type)
+	assert.Equal(t, "celestia1j33593mn9urzydakw06jdun8f37shlucmhr8p6", dm.Addresses[0].Address.Address)
+}
@@ -66,6 +66,14 @@ func TestDecodeMsg_SuccessOnMsgRegisterEvmAddress(t *testing.T) {
 		Size:      100,
 		Namespace: nil,
 		Addresses: addressesExpected,
+		EvmAddress: &storage.BlobstreamEvmAddress{
+			Height:     blob.Height,
+			Time:       now,
+			EvmAddress: "0xfDC46fBDd8AF50d9Bf7536Bf44ce8560E423352c",
+			Validator: &storage.Validator{
+				Address: "celestiavaloper1f5crra7r5m9kd6saw077u76x0n7dyjkkzk0qup",
+			},
+		},
 	}
 
 	assert.NoError(t, err)
@@ -73,3 +81,19 @@ func TestDecodeMsg_SuccessOnMsgRegisterEvmAddress(t *testing.T) {
 	assert.Equal(t, msgExpected, dm.Msg)
 	assert.Equal(t, addressesExpected, dm.Addresses)
 }
+
+func TestDecodeMsg_FailedMsgRegisterEvmAddress(t *testing.T) {
+	m := createMsgRegisterEvmAddress()
+	blob, _ := testsuite.EmptyBlock()
+
+	decodeCtx := context.NewContext()
+	decodeCtx.Block = &storage.Block{
+		Height: blob.Height,
+		Time:   blob.Block.Time,
+	}
+
+	dm, err := decode.Message(decodeCtx, m, 0, storageTypes.StatusFailed)
+	assert.NoError(t, err)
+	assert.Nil(t, dm.Msg.EvmAddress)
+	assert.Len(t, dm.Addresses, 1)
+}
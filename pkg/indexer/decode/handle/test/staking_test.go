@@ -106,6 +106,37 @@ func TestDecodeMsg_SuccessOnMsgEditValidator(t *testing.T) {
 	}
 }
 
+func TestDecodeMsg_SuccessOnMsgEditValidatorZeroRate(t *testing.T) {
+	rate := types.ZeroDec()
+	m := &cosmosStakingTypes.MsgEditValidator{
+		Description: cosmosStakingTypes.Description{
+			Moniker:         cosmosStakingTypes.DoNotModifyDesc,
+			Identity:        cosmosStakingTypes.DoNotModifyDesc,
+			Website:         cosmosStakingTypes.DoNotModifyDesc,
+			SecurityContact: cosmosStakingTypes.DoNotModifyDesc,
+			Details:         cosmosStakingTypes.DoNotModifyDesc,
+		},
+		ValidatorAddress: "celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x",
+		CommissionRate:   &rate,
+	}
+	blob, _ := testsuite.EmptyBlock()
+
+	decodeCtx := context.NewContext()
+	decodeCtx.Block = &storage.Block{
+		Height: blob.Height,
+		Time:   blob.Block.Time,
+	}
+
+	_, err := decode.Message(decodeCtx, m, 0, storageTypes.StatusSuccess)
+	require.NoError(t, err)
+
+	val, ok := decodeCtx.Validators.Get("celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x")
+	require.True(t, ok)
+	require.True(t, val.RateChanged)
+	require.True(t, val.Rate.IsZero())
+	require.False(t, val.MinSelfDelegationChanged)
+}
+
 // MsgBeginRedelegate
 
 func createMsgBeginRedelegate() types.Msg {
@@ -256,6 +287,8 @@ func TestDecodeMsg_SuccessOnMsgCreateValidator(t *testing.T) {
 			MinSelfDelegation: decimal.RequireFromString("1"),
 			Height:            blob.Height,
 			Jailed:            testsuite.Ptr(false),
+
+			MinSelfDelegationChanged: true,
 		},
 	}
 
@@ -125,18 +125,19 @@ func Message(
 
 	// qgb module
 	case *qgbTypes.MsgRegisterEVMAddress:
-		d.Msg.Type, d.Msg.Addresses, err = handle.MsgRegisterEVMAddress(ctx, typedMsg)
+		d.Msg.Type, d.Msg.Addresses, d.Msg.EvmAddress, err = handle.MsgRegisterEVMAddress(ctx, status, typedMsg)
 
 	// authz module
 	case *authz.MsgGrant:
 		d.Msg.Type, d.Msg.Addresses, d.Msg.Grants, err = handle.MsgGrant(ctx, status, typedMsg)
 	case *authz.MsgExec:
-		d.Msg.Type, d.Msg.Addresses, d.Msg.InternalMsgs, err = handle.MsgExec(ctx, status, typedMsg)
+		d.Msg.Type, d.Msg.Addresses, err = handle.MsgExec(ctx, typedMsg)
 		if err != nil {
 			return d, err
 		}
 
 		msgs := make([]any, 0)
+		d.Msg.InternalMsgs = make([]*storage.Message, 0, len(typedMsg.Msgs))
 		for i := range typedMsg.Msgs {
 			msg, err := cosmosTypes.GetMsgFromTypeURL(cfg.Codec, typedMsg.Msgs[i].TypeUrl)
 			if err != nil {
@@ -146,6 +147,13 @@ func Message(
 				return d, err
 			}
 			msgs = append(msgs, structs.Map(msg))
+
+			internal, err := Message(ctx, msg, i, status)
+			if err != nil {
+				return d, errors.Wrap(err, "while decoding internal message of MsgExec")
+			}
+			d.Msg.InternalMsgs = append(d.Msg.InternalMsgs, &internal.Msg)
+			d.BlobsSize += internal.BlobsSize
 		}
 		d.Msg.Data["Msgs"] = msgs
 
@@ -154,9 +162,9 @@ func Message(
 
 	// gov module
 	case *cosmosGovTypesV1.MsgSubmitProposal:
-		d.Msg.Type, d.Msg.Addresses, err = handle.MsgSubmitProposal(ctx, typedMsg.Proposer)
+		d.Msg.Type, d.Msg.Addresses, d.Msg.Proposal, err = handle.MsgSubmitProposalV1(ctx, status, typedMsg)
 	case *cosmosGovTypesV1Beta1.MsgSubmitProposal:
-		d.Msg.Type, d.Msg.Addresses, err = handle.MsgSubmitProposal(ctx, typedMsg.Proposer)
+		d.Msg.Type, d.Msg.Addresses, d.Msg.Proposal, err = handle.MsgSubmitProposalV1Beta1(ctx, status, typedMsg)
 	case *cosmosGovTypesV1.MsgExecLegacyContent:
 		d.Msg.Type, d.Msg.Addresses, err = handle.MsgExecLegacyContent(ctx, typedMsg)
 	case *cosmosGovTypesV1.MsgVote:
@@ -182,7 +190,7 @@ func Message(
 
 	// evidence module
 	case *evidenceTypes.MsgSubmitEvidence:
-		d.Msg.Type, d.Msg.Addresses, err = handle.MsgSubmitEvidence(ctx, typedMsg)
+		d.Msg.Type, d.Msg.Addresses, err = handle.MsgSubmitEvidence(ctx, status, typedMsg)
 
 	// nft module
 	case *nft.MsgSend:
@@ -190,33 +198,33 @@ func Message(
 
 	// group module
 	case *group.MsgCreateGroup:
-		d.Msg.Type, d.Msg.Addresses, err = handle.MsgCreateGroup(ctx, typedMsg)
+		d.Msg.Type, d.Msg.Addresses, d.Msg.Group, err = handle.MsgCreateGroup(ctx, status, typedMsg)
 	case *group.MsgUpdateGroupMembers:
-		d.Msg.Type, d.Msg.Addresses, err = handle.MsgUpdateGroupMembers(ctx, typedMsg)
+		d.Msg.Type, d.Msg.Addresses, err = handle.MsgUpdateGroupMembers(ctx, status, typedMsg)
 	case *group.MsgUpdateGroupAdmin:
-		d.Msg.Type, d.Msg.Addresses, err = handle.MsgUpdateGroupAdmin(ctx, typedMsg)
+		d.Msg.Type, d.Msg.Addresses, err = handle.MsgUpdateGroupAdmin(ctx, status, typedMsg)
 	case *group.MsgUpdateGroupMetadata:
-		d.Msg.Type, d.Msg.Addresses, err = handle.MsgUpdateGroupMetadata(ctx, typedMsg)
+		d.Msg.Type, d.Msg.Addresses, err = handle.MsgUpdateGroupMetadata(ctx, status, typedMsg)
 	case *group.MsgCreateGroupPolicy:
-		d.Msg.Type, d.Msg.Addresses, err = handle.MsgCreateGroupPolicy(ctx, typedMsg)
+		d.Msg.Type, d.Msg.Addresses, d.Msg.GroupPolicy, err = handle.MsgCreateGroupPolicy(ctx, status, typedMsg)
 	case *group.MsgUpdateGroupPolicyAdmin:
-		d.Msg.Type, d.Msg.Addresses, err = handle.MsgUpdateGroupPolicyAdmin(ctx, typedMsg)
+		d.Msg.Type, d.Msg.Addresses, err = handle.MsgUpdateGroupPolicyAdmin(ctx, status, typedMsg)
 	case *group.MsgCreateGroupWithPolicy:
-		d.Msg.Type, d.Msg.Addresses, err = handle.MsgCreateGroupWithPolicy(ctx, typedMsg)
+		d.Msg.Type, d.Msg.Addresses, d.Msg.Group, d.Msg.GroupPolicy, err = handle.MsgCreateGroupWithPolicy(ctx, status, typedMsg)
 	case *group.MsgUpdateGroupPolicyDecisionPolicy:
-		d.Msg.Type, d.Msg.Addresses, err = handle.MsgUpdateGroupPolicyDecisionPolicy(ctx, typedMsg)
+		d.Msg.Type, d.Msg.Addresses, err = handle.MsgUpdateGroupPolicyDecisionPolicy(ctx, status, typedMsg)
 	case *group.MsgUpdateGroupPolicyMetadata:
-		d.Msg.Type, d.Msg.Addresses, err = handle.MsgUpdateGroupPolicyMetadata(ctx, typedMsg)
+		d.Msg.Type, d.Msg.Addresses, err = handle.MsgUpdateGroupPolicyMetadata(ctx, status, typedMsg)
 	case *group.MsgSubmitProposal:
-		d.Msg.Type, d.Msg.Addresses, err = handle.MsgSubmitProposalGroup(ctx, typedMsg)
+		d.Msg.Type, d.Msg.Addresses, d.Msg.GroupProposal, err = handle.MsgSubmitProposalGroup(ctx, status, typedMsg)
 	case *group.MsgWithdrawProposal:
-		d.Msg.Type, d.Msg.Addresses, err = handle.MsgWithdrawProposal(ctx, typedMsg)
+		d.Msg.Type, d.Msg.Addresses, err = handle.MsgWithdrawProposal(ctx, status, typedMsg)
 	case *group.MsgVote:
-		d.Msg.Type, d.Msg.Addresses, err = handle.MsgVoteGroup(ctx, typedMsg)
+		d.Msg.Type, d.Msg.Addresses, err = handle.MsgVoteGroup(ctx, status, typedMsg)
 	case *group.MsgExec:
-		d.Msg.Type, d.Msg.Addresses, err = handle.MsgExecGroup(ctx, typedMsg)
+		d.Msg.Type, d.Msg.Addresses, err = handle.MsgExecGroup(ctx, status, typedMsg)
 	case *group.MsgLeaveGroup:
-		d.Msg.Type, d.Msg.Addresses, err = handle.MsgLeaveGroup(ctx, typedMsg)
+		d.Msg.Type, d.Msg.Addresses, err = handle.MsgLeaveGroup(ctx, status, typedMsg)
 
 	// upgrade module
 	case *upgrade.MsgSoftwareUpgrade:
@@ -242,7 +250,7 @@ func Message(
 
 	// coreClient module
 	case *coreClient.MsgCreateClient:
-		d.Msg.Type, d.Msg.Addresses, err = handle.MsgCreateClient(ctx, typedMsg)
+		d.Msg.Type, d.Msg.Addresses, d.Msg.IbcClient, err = handle.MsgCreateClient(ctx, status, typedMsg)
 	case *coreClient.MsgUpdateClient:
 		typ, addrs, header, errParse := handle.MsgUpdateClient(ctx, status, typedMsg)
 		d.Msg.Addresses = addrs
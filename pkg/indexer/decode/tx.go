@@ -23,6 +23,8 @@ type DecodedTx struct {
 	Messages      []cosmosTypes.Msg
 	Fee           decimal.Decimal
 	Signers       map[types.Address][]byte
+	FeePayer      types.Address
+	FeeGranter    types.Address
 	Blobs         []*blobTypes.Blob
 }
 
@@ -59,9 +61,19 @@ func Tx(b types.BlockData, index int) (d DecodedTx, err error) {
 				return d, err
 			}
 			d.Signers[address] = signerBytes
+
+			// fee payer is the first signer if it's not set explicitly
+			if d.FeePayer == "" {
+				d.FeePayer = address
+			}
 		}
 	}
 
+	if payer := d.AuthInfo.GetFee().GetPayer(); payer != "" {
+		d.FeePayer = types.Address(payer)
+	}
+	d.FeeGranter = types.Address(d.AuthInfo.GetFee().GetGranter())
+
 	return
 }
 
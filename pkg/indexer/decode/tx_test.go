@@ -40,6 +40,8 @@ func TestDecodeTx_TxWithMemo(t *testing.T) {
 	assert.Equal(t, "test ui redelegate tx ", dTx.Memo)
 	assert.Equal(t, 1, len(dTx.Messages))
 	assert.Equal(t, decimal.NewFromInt(72431), dTx.Fee)
+	assert.EqualValues(t, "celestia1davz40kat93t49ljrkmkl5uqhqq45e0tedgf8a", dTx.FeePayer)
+	assert.Empty(t, dTx.FeeGranter)
 }
 
 func TestDecodeAuthInfo_WithNilAmount(t *testing.T) {
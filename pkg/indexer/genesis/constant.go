@@ -85,6 +85,13 @@ func (module *Module) parseConstants(appState types.AppState, consensus pkgTypes
 		Value:  appState.Blob.Params.GovMaxSquareSize,
 	})
 
+	// blobstream
+	data.constants = append(data.constants, storage.Constant{
+		Module: storageTypes.ModuleNameBlobstream,
+		Name:   "data_commitment_window",
+		Value:  appState.Qgb.Params.DataCommitmentWindow,
+	})
+
 	// crisis
 	data.constants = append(data.constants, storage.Constant{
 		Module: storageTypes.ModuleNameCrisis,
@@ -25,6 +25,20 @@ func (module *Module) save(ctx context.Context, data parsedData) error {
 		return tx.HandleError(ctx, err)
 	}
 
+	history := make([]storage.ConstantHistory, len(data.constants))
+	for i := range data.constants {
+		history[i] = storage.ConstantHistory{
+			Height: data.block.Height,
+			Time:   data.block.Time,
+			Module: data.constants[i].Module,
+			Name:   data.constants[i].Name,
+			Value:  data.constants[i].Value,
+		}
+	}
+	if err := tx.SaveConstantHistory(ctx, history...); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
 	for i := range data.denomMetadata {
 		if err := tx.Add(ctx, &data.denomMetadata[i]); err != nil {
 			return tx.HandleError(ctx, err)
@@ -125,6 +139,14 @@ func (module *Module) save(ctx context.Context, data parsedData) error {
 		return tx.HandleError(ctx, err)
 	}
 
+	validatorIds := make([]uint64, len(data.validators))
+	for i := range data.validators {
+		validatorIds[i] = data.validators[i].Id
+	}
+	if err := tx.SaveValidatorHistory(ctx, data.block.Height, data.block.Time, validatorIds...); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
 	totalStake := decimal.NewFromInt(0)
 	for i := range data.validators {
 		totalStake = totalStake.Add(data.validators[i].Stake)
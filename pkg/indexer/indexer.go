@@ -12,6 +12,7 @@ import (
 
 	"github.com/dipdup-net/indexer-sdk/pkg/modules"
 
+	"github.com/celenium-io/celestia-indexer/internal/blob/decoder"
 	internalStorage "github.com/celenium-io/celestia-indexer/internal/storage"
 	blobsaver "github.com/celenium-io/celestia-indexer/pkg/indexer/blob_saver"
 	"github.com/celenium-io/celestia-indexer/pkg/indexer/genesis"
@@ -60,12 +61,12 @@ func New(ctx context.Context, cfg config.Config, stopperModule modules.Module) (
 		return Indexer{}, errors.Wrap(err, "while creating rollback module")
 	}
 
-	p, err := createParser(cfg.Indexer, r)
+	p, err := createParser(cfg.Indexer, r, pg)
 	if err != nil {
 		return Indexer{}, errors.Wrap(err, "while creating parser module")
 	}
 
-	s, err := createStorage(pg, cfg, p)
+	s, err := createStorage(pg, cfg, r, p, rb)
 	if err != nil {
 		return Indexer{}, errors.Wrap(err, "while creating storage module")
 	}
@@ -75,7 +76,7 @@ func New(ctx context.Context, cfg config.Config, stopperModule modules.Module) (
 		return Indexer{}, errors.Wrap(err, "while creating genesis module")
 	}
 
-	blobSaver, err := createBlobSaver(cfg, p)
+	blobSaver, err := createBlobSaver(cfg, p, rb)
 	if err != nil {
 		return Indexer{}, errors.Wrap(err, "while creating blob saver module")
 	}
@@ -168,16 +169,15 @@ func createRollback(receiverModule modules.Module, pg postgres.Storage, api node
 		return nil, errors.Wrap(err, "while attaching rollback to receiver")
 	}
 
-	// receiver <- listen state -- rollback
-	if err := receiverModule.AttachTo(&rollbackModule, rollback.OutputName, receiver.RollbackInput); err != nil {
-		return nil, errors.Wrap(err, "while attaching receiver to rollback")
-	}
-
 	return &rollbackModule, nil
 }
 
-func createParser(cfg config.Indexer, receiverModule modules.Module) (*parser.Module, error) {
-	parserModule := parser.NewModule(cfg)
+func createParser(cfg config.Indexer, receiverModule modules.Module, pg postgres.Storage) (*parser.Module, error) {
+	decoders, err := decoder.NewRegistryFromConfig(cfg.BlobDecoders)
+	if err != nil {
+		return nil, errors.Wrap(err, "while creating blob decoders")
+	}
+	parserModule := parser.NewModule(cfg, decoders, pg.Rollup)
 
 	if err := parserModule.AttachTo(receiverModule, receiver.BlocksOutput, parser.InputName); err != nil {
 		return nil, errors.Wrap(err, "while attaching parser to receiver")
@@ -186,13 +186,28 @@ func createParser(cfg config.Indexer, receiverModule modules.Module) (*parser.Mo
 	return &parserModule, nil
 }
 
-func createStorage(pg postgres.Storage, cfg config.Config, parserModule modules.Module) (*storage.Module, error) {
+func createStorage(
+	pg postgres.Storage,
+	cfg config.Config,
+	receiverModule modules.Module,
+	parserModule modules.Module,
+	rollbackModule *rollback.Module,
+) (*storage.Module, error) {
 	storageModule := storage.NewModule(pg.Transactable, pg.Constants, pg.Validator, pg.Notificator, cfg.Indexer)
 
 	if err := storageModule.AttachTo(parserModule, parser.OutputName, storage.InputName); err != nil {
 		return nil, errors.Wrap(err, "while attaching storage to parser")
 	}
 
+	if err := storageModule.AttachTo(rollbackModule, rollback.OutputName, storage.RollbackInputName); err != nil {
+		return nil, errors.Wrap(err, "while attaching storage to rollback")
+	}
+
+	// receiver <- listen state -- storage: block receiving is resumed after storage reloaded its cache
+	if err := receiverModule.AttachTo(&storageModule, storage.RollbackOutput, receiver.RollbackInput); err != nil {
+		return nil, errors.Wrap(err, "while attaching receiver to storage")
+	}
+
 	return &storageModule, nil
 }
 
@@ -211,8 +226,8 @@ func createGenesis(pg postgres.Storage, cfg config.Config, receiverModule module
 	return genesisModulePtr, nil
 }
 
-func createBlobSaver(cfg config.Config, parserModule modules.Module) (*blobsaver.Module, error) {
-	blobSaverModule, err := blobsaver.NewModule(cfg.Indexer.BlobSaver)
+func createBlobSaver(cfg config.Config, parserModule modules.Module, rollbackModule *rollback.Module) (*blobsaver.Module, error) {
+	blobSaverModule, err := blobsaver.NewModule(cfg.Indexer.BlobSaver, cfg.Indexer.BlobStorage)
 	if err != nil {
 		return nil, err
 	}
@@ -221,6 +236,13 @@ func createBlobSaver(cfg config.Config, parserModule modules.Module) (*blobsaver
 		return nil, errors.Wrap(err, "while attaching blob saver to parser")
 	}
 
+	// disabled blob saver doesn't read its inputs, so rollback would be blocked on full input
+	if cfg.Indexer.BlobSaver != "" {
+		if err := blobSaverModule.AttachTo(rollbackModule, rollback.OutputName, blobsaver.RollbackInputName); err != nil {
+			return nil, errors.Wrap(err, "while attaching blob saver to rollback")
+		}
+	}
+
 	return blobSaverModule, nil
 }
 
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package parser
+
+import (
+	"strings"
+
+	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrTypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// moduleBalanceCause - causes of balance changes by module accounts which send coins to users
+var moduleBalanceCause = map[string]storageTypes.BalanceHistoryType{
+	moduleAddress(distrTypes.ModuleName):          storageTypes.BalanceHistoryTypeReward,
+	moduleAddress(stakingTypes.BondedPoolName):    storageTypes.BalanceHistoryTypeDelegation,
+	moduleAddress(stakingTypes.NotBondedPoolName): storageTypes.BalanceHistoryTypeDelegation,
+}
+
+func moduleAddress(name string) string {
+	address, _ := pkgTypes.NewAddressFromBytes(authTypes.NewModuleAddress(name))
+	return address.String()
+}
+
+// balanceCauseByAction - returns cause of balance changes made by message with type url `action`
+func balanceCauseByAction(action string) storageTypes.BalanceHistoryType {
+	idx := strings.LastIndex(action, ".")
+	switch action[idx+1:] {
+	case "MsgDelegate", "MsgUndelegate", "MsgBeginRedelegate", "MsgCancelUnbondingDelegation":
+		return storageTypes.BalanceHistoryTypeDelegation
+	case "MsgWithdrawDelegatorReward", "MsgWithdrawValidatorCommission":
+		return storageTypes.BalanceHistoryTypeReward
+	default:
+		return storageTypes.BalanceHistoryTypeTransfer
+	}
+}
+
+// balanceCauseBySpender - returns cause of balance changes if coins are sent by known module account
+func balanceCauseBySpender(spender string) storageTypes.BalanceHistoryType {
+	return moduleBalanceCause[spender]
+}
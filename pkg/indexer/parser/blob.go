@@ -4,6 +4,8 @@
 package parser
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"net/http"
 
 	"github.com/celenium-io/celestia-indexer/internal/storage"
@@ -12,6 +14,8 @@ import (
 	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
 	"github.com/celestiaorg/celestia-app/pkg/appconsts"
 	appshares "github.com/celestiaorg/celestia-app/pkg/shares"
+	"github.com/celestiaorg/go-square/square"
+	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
 	blobTypes "github.com/tendermint/tendermint/proto/tendermint/types"
 )
@@ -51,6 +55,128 @@ func processBlob(blobs []*storage.BlobLog, d decode.DecodedTx, t *storage.Tx) {
 	}
 }
 
+func (module *Module) decodingEnabled() bool {
+	return module.cfg.BlobDecoders.Enabled && module.decoders != nil
+}
+
+// decodeBlobs - recognizes blob payloads by decoders of the rollup stack or the namespace and keeps decoded rollup batch metadata
+func (module *Module) decodeBlobs(blobs []*storage.BlobLog, d decode.DecodedTx) {
+	if !module.decodingEnabled() || len(blobs) == 0 || len(d.Blobs) != len(blobs) {
+		return
+	}
+
+	for i := range blobs {
+		ns := base64.StdEncoding.EncodeToString(append([]byte{byte(d.Blobs[i].NamespaceVersion)}, d.Blobs[i].NamespaceId...))
+
+		var stack string
+		if blobs[i].Signer != nil {
+			stack = module.stacks.get(blobs[i].Signer.Address, ns)
+		}
+
+		result, err := module.decoders.Decode(stack, ns, d.Blobs[i].Data)
+		if err != nil {
+			continue
+		}
+		blobs[i].Decoder = result.Decoder
+
+		if result.Batch == nil {
+			continue
+		}
+		batch, err := json.Marshal(result.Batch)
+		if err != nil {
+			continue
+		}
+		blobs[i].Batch = batch
+	}
+}
+
+// blockSquare - lazily built original data square of the block. It's built once per block and shared
+// between computing of the data availability header and finding the position of the blob shares in the square.
+type blockSquare struct {
+	block   *pkgTypes.Block
+	builder *square.Builder
+	square  square.Square
+	width   int64
+}
+
+func newBlockSquare(block *pkgTypes.Block) *blockSquare {
+	return &blockSquare{
+		block: block,
+	}
+}
+
+func (s *blockSquare) build() error {
+	if s.builder != nil {
+		return nil
+	}
+
+	builder, err := square.NewBuilder(
+		appconsts.SquareSizeUpperBound(0),
+		appconsts.SubtreeRootThreshold(0),
+		s.block.Txs.ToSliceOfBytes()...,
+	)
+	if err != nil {
+		return errors.Wrap(err, "create square builder")
+	}
+
+	dataSquare, err := builder.Export()
+	if err != nil {
+		return errors.Wrap(err, "export square")
+	}
+
+	s.builder = builder
+	s.square = dataSquare
+	s.width = int64(dataSquare.Size())
+	return nil
+}
+
+// dataSquare - returns shares of the original data square of the block
+func (s *blockSquare) dataSquare() (square.Square, error) {
+	if err := s.build(); err != nil {
+		return nil, err
+	}
+	return s.square, nil
+}
+
+// setShares - sets share range and row/column span of blobs of the transaction with index txIndex in the block.
+// Blobs are left untouched if share range of any of them can't be found.
+func (s *blockSquare) setShares(txIndex int, blobs []*storage.BlobLog) error {
+	if len(blobs) == 0 {
+		return nil
+	}
+
+	if err := s.build(); err != nil {
+		return err
+	}
+
+	starts := make([]int, len(blobs))
+	counts := make([]int, len(blobs))
+	for i := range blobs {
+		start, err := s.builder.FindBlobStartingIndex(txIndex, i)
+		if err != nil {
+			return errors.Wrapf(err, "find blob starting index: tx=%d blob=%d", txIndex, i)
+		}
+		count, err := s.builder.BlobShareLength(txIndex, i)
+		if err != nil {
+			return errors.Wrapf(err, "blob share length: tx=%d blob=%d", txIndex, i)
+		}
+		starts[i] = start
+		counts[i] = count
+	}
+
+	for i := range blobs {
+		start, count := starts[i], counts[i]
+		end := int64(start + count - 1)
+		blobs[i].ShareStart = int64(start)
+		blobs[i].ShareCount = int64(count)
+		blobs[i].StartRow = blobs[i].ShareStart / s.width
+		blobs[i].StartCol = blobs[i].ShareStart % s.width
+		blobs[i].EndRow = end / s.width
+		blobs[i].EndCol = end % s.width
+	}
+	return nil
+}
+
 func (module *Module) notifyBlobs(height pkgTypes.Level, blobs []*blobTypes.Blob) {
 	if len(blobs) == 0 || module.cfg.BlobSaver == "" {
 		return
@@ -4,13 +4,25 @@
 package parser
 
 import (
+	"bytes"
+	"context"
 	"testing"
 
+	"github.com/celenium-io/celestia-indexer/internal/blob/decoder"
+	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+
 	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/pkg/indexer/config"
 	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	sqBlob "github.com/celestiaorg/go-square/blob"
+	"github.com/celestiaorg/go-square/namespace"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 	blobTypes "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/encoding/protowire"
 )
 
 func Test_processBlob(t *testing.T) {
@@ -103,3 +115,140 @@ func Test_processBlob(t *testing.T) {
 		require.Equal(t, "50.8", blobs[1].Fee.String())
 	})
 }
+
+func Test_blockSquareSetShares(t *testing.T) {
+	small := sqBlob.New(namespace.MustNewV0(bytes.Repeat([]byte{1}, namespace.NamespaceVersionZeroIDSize)), bytes.Repeat([]byte{1}, 100), 0)
+	large := sqBlob.New(namespace.MustNewV0(bytes.Repeat([]byte{2}, namespace.NamespaceVersionZeroIDSize)), bytes.Repeat([]byte{2}, 2000), 0)
+
+	blobTx, err := sqBlob.MarshalBlobTx([]byte("pfb"), small, large)
+	require.NoError(t, err)
+
+	bs := newBlockSquare(&pkgTypes.Block{
+		Data: pkgTypes.Data{
+			Txs: tmTypes.Txs{[]byte("send"), blobTx},
+		},
+	})
+
+	require.NoError(t, bs.setShares(0, nil))
+
+	blobs := []*storage.BlobLog{{}, {}}
+	require.NoError(t, bs.setShares(1, blobs))
+	require.EqualValues(t, 4, bs.width)
+
+	// square is built once and reused for data availability header
+	builder := bs.builder
+	dataSquare, err := bs.dataSquare()
+	require.NoError(t, err)
+	require.Same(t, builder, bs.builder)
+	require.EqualValues(t, 4, dataSquare.Size())
+
+	require.EqualValues(t, 2, blobs[0].ShareStart)
+	require.EqualValues(t, 1, blobs[0].ShareCount)
+	require.EqualValues(t, 0, blobs[0].StartRow)
+	require.EqualValues(t, 2, blobs[0].StartCol)
+	require.EqualValues(t, 0, blobs[0].EndRow)
+	require.EqualValues(t, 2, blobs[0].EndCol)
+
+	require.EqualValues(t, 3, blobs[1].ShareStart)
+	require.EqualValues(t, 5, blobs[1].ShareCount)
+	require.EqualValues(t, 0, blobs[1].StartRow)
+	require.EqualValues(t, 3, blobs[1].StartCol)
+	require.EqualValues(t, 1, blobs[1].EndRow)
+	require.EqualValues(t, 3, blobs[1].EndCol)
+
+	require.Error(t, bs.setShares(0, blobs))
+
+	// the second blob doesn't exist, so the first one must stay unset too
+	unset := []*storage.BlobLog{{}, {}, {}}
+	require.Error(t, bs.setShares(1, unset))
+	for i := range unset {
+		require.Zero(t, unset[i].ShareStart)
+		require.Zero(t, unset[i].ShareCount)
+	}
+}
+
+func TestModule_decodeBlobs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rollups := mock.NewMockIRollup(ctrl)
+	rollups.EXPECT().
+		Stacks(gomock.Any()).
+		Return([]storage.RollupStack{
+			{
+				Stack:   "rollkit",
+				Address: "celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60",
+			},
+		}, nil).
+		Times(1)
+
+	module := NewModule(config.Indexer{
+		BlobDecoders: decoder.Config{
+			Enabled: true,
+		},
+	}, decoder.NewDefaultRegistry(), rollups)
+	module.refreshStacks(context.Background())
+
+	frame := append([]byte{0x00}, bytes.Repeat([]byte{0xaa}, 16)...)
+	frame = append(frame, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01)
+
+	proto := protowire.AppendTag(nil, 1, protowire.VarintType)
+	proto = protowire.AppendVarint(proto, 1)
+
+	newBlobs := func() []*storage.BlobLog {
+		return []*storage.BlobLog{
+			{Signer: &storage.Address{Address: "celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8"}},
+			{Signer: &storage.Address{Address: "celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8"}},
+			{Signer: &storage.Address{Address: "celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8"}},
+			{Signer: &storage.Address{Address: "celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8"}},
+			{Signer: &storage.Address{Address: "celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"}},
+		}
+	}
+	d := decode.DecodedTx{
+		Blobs: []*blobTypes.Blob{
+			{
+				NamespaceId: bytes.Repeat([]byte{1}, 28),
+				Data:        []byte(`{"key":"value"}`),
+			}, {
+				NamespaceId: bytes.Repeat([]byte{2}, 28),
+				Data:        frame,
+			}, {
+				NamespaceId: bytes.Repeat([]byte{3}, 28),
+				Data:        []byte("plain text"),
+			}, {
+				NamespaceId: bytes.Repeat([]byte{4}, 28),
+				Data:        proto,
+			}, {
+				NamespaceId: bytes.Repeat([]byte{4}, 28),
+				Data:        proto,
+			},
+		},
+	}
+
+	blobs := newBlobs()
+	module.decodeBlobs(blobs, d)
+
+	require.Equal(t, "json", blobs[0].Decoder)
+	require.Nil(t, blobs[0].Batch)
+
+	require.Equal(t, "op_stack", blobs[1].Decoder)
+	require.JSONEq(t, `{"channels":["aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"],"frames_count":1}`, string(blobs[1].Batch))
+
+	require.Empty(t, blobs[2].Decoder)
+	require.Nil(t, blobs[2].Batch)
+
+	// protobuf is decoded only for rollups built on rollkit
+	require.Empty(t, blobs[3].Decoder)
+	require.Equal(t, "protobuf", blobs[4].Decoder)
+
+	t.Run("decoding is disabled", func(t *testing.T) {
+		module := NewModule(config.Indexer{}, decoder.NewDefaultRegistry(), nil)
+
+		blobs := newBlobs()
+		module.decodeBlobs(blobs, d)
+		for i := range blobs {
+			require.Empty(t, blobs[i].Decoder)
+			require.Nil(t, blobs[i].Batch)
+		}
+	})
+}
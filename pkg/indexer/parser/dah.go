@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package parser
+
+import (
+	"bytes"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/celestiaorg/celestia-app/pkg/da"
+	"github.com/celestiaorg/go-square/shares"
+	"github.com/pkg/errors"
+)
+
+// parseDah - extends the data square of the block and computes its data availability header
+func (p *Module) parseDah(b pkgTypes.BlockData, bs *blockSquare) (*storage.BlockDah, error) {
+	dataSquare, err := bs.dataSquare()
+	if err != nil {
+		return nil, errors.Wrap(err, "construct square")
+	}
+
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	if err != nil {
+		return nil, errors.Wrap(err, "extend shares")
+	}
+
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return nil, errors.Wrap(err, "data availability header")
+	}
+
+	blockDah := &storage.BlockDah{
+		Height:      b.Height,
+		Time:        b.Block.Time,
+		Hash:        dah.Hash(),
+		DataHash:    b.Block.DataHash,
+		SquareSize:  int64(dataSquare.Size()),
+		RowRoots:    make([]string, len(dah.RowRoots)),
+		ColumnRoots: make([]string, len(dah.ColumnRoots)),
+	}
+	blockDah.Valid = bytes.Equal(blockDah.Hash, blockDah.DataHash)
+
+	for i := range dah.RowRoots {
+		blockDah.RowRoots[i] = pkgTypes.Hex(dah.RowRoots[i]).String()
+	}
+	for i := range dah.ColumnRoots {
+		blockDah.ColumnRoots[i] = pkgTypes.Hex(dah.ColumnRoots[i]).String()
+	}
+
+	if !blockDah.Valid {
+		p.Log.Warn().
+			Uint64("height", uint64(b.Height)).
+			Str("data_hash", blockDah.DataHash.String()).
+			Str("computed_hash", blockDah.Hash.String()).
+			Msg("data availability header mismatch")
+	}
+
+	return blockDah, nil
+}
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package parser
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/pkg/indexer/config"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/celestiaorg/celestia-app/pkg/da"
+	sqBlob "github.com/celestiaorg/go-square/blob"
+	"github.com/celestiaorg/go-square/namespace"
+	"github.com/stretchr/testify/require"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+func TestParseDah(t *testing.T) {
+	p := NewModule(config.Indexer{VerifyDah: true}, nil, nil)
+	blockTime := time.Now()
+
+	t.Run("empty block", func(t *testing.T) {
+		minDah := da.MinDataAvailabilityHeader()
+		b := pkgTypes.BlockData{
+			ResultBlock: pkgTypes.ResultBlock{
+				Block: &pkgTypes.Block{
+					Header: pkgTypes.Header{
+						Time:     blockTime,
+						DataHash: minDah.Hash(),
+					},
+				},
+			},
+		}
+		b.Height = 100
+
+		dah, err := p.parseDah(b, newBlockSquare(b.Block))
+		require.NoError(t, err)
+		require.True(t, dah.Valid)
+		require.EqualValues(t, 100, dah.Height)
+		require.Equal(t, blockTime, dah.Time)
+		require.EqualValues(t, 1, dah.SquareSize)
+		require.Len(t, dah.RowRoots, 2)
+		require.Len(t, dah.ColumnRoots, 2)
+		require.Equal(t, pkgTypes.Hex(minDah.RowRoots[0]).String(), dah.RowRoots[0])
+	})
+
+	t.Run("block with blob", func(t *testing.T) {
+		blob := sqBlob.New(namespace.MustNewV0(bytes.Repeat([]byte{1}, namespace.NamespaceVersionZeroIDSize)), bytes.Repeat([]byte{1}, 2000), 0)
+		blobTx, err := sqBlob.MarshalBlobTx([]byte("pfb"), blob)
+		require.NoError(t, err)
+
+		b := pkgTypes.BlockData{
+			ResultBlock: pkgTypes.ResultBlock{
+				Block: &pkgTypes.Block{
+					Header: pkgTypes.Header{
+						Time:     blockTime,
+						DataHash: bytes.Repeat([]byte{1}, 32),
+					},
+					Data: pkgTypes.Data{
+						Txs: tmTypes.Txs{[]byte("send"), blobTx},
+					},
+				},
+			},
+		}
+
+		dah, err := p.parseDah(b, newBlockSquare(b.Block))
+		require.NoError(t, err)
+		require.False(t, dah.Valid)
+		require.EqualValues(t, 4, dah.SquareSize)
+		require.Len(t, dah.RowRoots, 8)
+		require.Len(t, dah.ColumnRoots, 8)
+
+		b.Block.DataHash = dah.Hash
+		dah, err = p.parseDah(b, newBlockSquare(b.Block))
+		require.NoError(t, err)
+		require.True(t, dah.Valid)
+	})
+}
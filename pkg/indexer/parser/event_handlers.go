@@ -16,6 +16,14 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+const (
+	reasonMissingSignature = "missing_signature"
+
+	// slashingInfractionDelay - slashing module sets infraction height of downtime to `current - ValidatorUpdateDelay - 1`,
+	// where ValidatorUpdateDelay is 1. Validator power is taken at this height for slashing.
+	slashingInfractionDelay pkgTypes.Level = 2
+)
+
 func parseCoinSpent(ctx *context.Context, data map[string]any, height pkgTypes.Level) error {
 	coinSpent, err := decode.NewCoinSpent(data)
 	if err != nil {
@@ -49,6 +57,33 @@ func parseCoinSpent(ctx *context.Context, data map[string]any, height pkgTypes.L
 	return ctx.AddAddress(address)
 }
 
+func parseTransfer(ctx *context.Context, event *storage.Event) error {
+	transfer, err := decode.NewTransfer(event.Data)
+	if err != nil {
+		return err
+	}
+
+	for i := range transfer.Amount {
+		amount, err := decimal.NewFromString(transfer.Amount[i].Amount.String())
+		if err != nil {
+			return err
+		}
+		ctx.AddTransfer(&storage.Transfer{
+			Height: event.Height,
+			Time:   event.Time,
+			Amount: amount,
+			Denom:  transfer.Amount[i].Denom,
+			Sender: &storage.Address{
+				Address: transfer.Sender,
+			},
+			Receiver: &storage.Address{
+				Address: transfer.Recipient,
+			},
+		})
+	}
+	return nil
+}
+
 func parseCoinReceived(ctx *context.Context, data map[string]any, height pkgTypes.Level) error {
 	coinReceived, err := decode.NewCoinReceived(data)
 	if err != nil {
@@ -187,6 +222,22 @@ func parseSlash(ctx *context.Context, data map[string]any) error {
 		}
 		consAddress := strings.ToUpper(hex.EncodeToString(hash))
 
+		var infractionHeight pkgTypes.Level
+		if slash.Reason == reasonMissingSignature && ctx.Block.Height > slashingInfractionDelay {
+			infractionHeight = ctx.Block.Height - slashingInfractionDelay
+		}
+		ctx.AddSlash(&storage.Slash{
+			Height:           ctx.Block.Height,
+			Time:             ctx.Block.Time,
+			InfractionHeight: infractionHeight,
+			Reason:           slash.Reason,
+			Power:            slash.Power,
+			Amount:           slash.BurnedCoins,
+			Validator: &storage.Validator{
+				ConsAddress: consAddress,
+			},
+		})
+
 		jailed := true
 		ctx.AddJail(storage.Jail{
 			Height: ctx.Block.Height,
@@ -203,3 +254,82 @@ func parseSlash(ctx *context.Context, data map[string]any) error {
 
 	return nil
 }
+
+func parseProposalStatus(ctx *context.Context, data map[string]any) error {
+	status, err := decode.NewProposalStatus(data)
+	if err != nil {
+		return err
+	}
+
+	ctx.AddProposal(&storage.Proposal{
+		Id:        status.ProposalId,
+		Status:    status.Result,
+		EndHeight: &ctx.Block.Height,
+		EndTime:   &ctx.Block.Time,
+	})
+	return nil
+}
+
+func parseAttestationRequest(ctx *context.Context, data map[string]any) error {
+	request, err := decode.NewAttestationRequest(data)
+	if err != nil {
+		return err
+	}
+
+	ctx.AddAttestation(&storage.BlobstreamAttestation{
+		Height: ctx.Block.Height,
+		Time:   ctx.Block.Time,
+		Nonce:  request.Nonce,
+	})
+	return nil
+}
+
+func parseGroupExec(ctx *context.Context, data map[string]any) error {
+	exec, err := decode.NewGroupExec(data)
+	if err != nil {
+		return err
+	}
+
+	proposal := &storage.GroupProposal{
+		Id:             exec.ProposalId,
+		ExecutorResult: exec.Result,
+		EndHeight:      &ctx.Block.Height,
+		EndTime:        &ctx.Block.Time,
+	}
+	// proposal can be executed only if it was accepted
+	if exec.Result != types.GroupExecutorResultNotRun {
+		proposal.Status = types.GroupProposalStatusAccepted
+	}
+	ctx.AddGroupProposal(proposal)
+	return nil
+}
+
+func parseGroupWithdrawProposal(ctx *context.Context, data map[string]any) error {
+	withdraw, err := decode.NewGroupProposalEvent(data)
+	if err != nil {
+		return err
+	}
+
+	ctx.AddGroupProposal(&storage.GroupProposal{
+		Id:        withdraw.ProposalId,
+		Status:    types.GroupProposalStatusWithdrawn,
+		EndHeight: &ctx.Block.Height,
+		EndTime:   &ctx.Block.Time,
+	})
+	return nil
+}
+
+func parseGroupProposalPruned(ctx *context.Context, data map[string]any) error {
+	pruned, err := decode.NewGroupProposalPruned(data)
+	if err != nil {
+		return err
+	}
+
+	ctx.AddGroupProposal(&storage.GroupProposal{
+		Id:        pruned.ProposalId,
+		Status:    pruned.Status,
+		EndHeight: &ctx.Block.Height,
+		EndTime:   &ctx.Block.Time,
+	})
+	return nil
+}
@@ -5,9 +5,11 @@ package parser
 
 import (
 	"testing"
+	"time"
 
 	"github.com/celenium-io/celestia-indexer/internal/currency"
 	"github.com/celenium-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
 	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode/context"
 	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
 	"github.com/shopspring/decimal"
@@ -75,3 +77,89 @@ func Test_parseCoinSpent(t *testing.T) {
 		})
 	}
 }
+
+func Test_parseProposalStatus(t *testing.T) {
+	ctx := context.NewContext()
+	ctx.Block = &storage.Block{
+		Height: 1000,
+		Time:   time.Now(),
+	}
+
+	err := parseProposalStatus(ctx, map[string]any{
+		"proposal_id":     "3",
+		"proposal_result": "proposal_rejected",
+	})
+	require.NoError(t, err)
+
+	proposal, ok := ctx.Proposals.Get(3)
+	require.True(t, ok)
+	require.EqualValues(t, storageTypes.ProposalStatusRejected, proposal.Status)
+	require.NotNil(t, proposal.EndHeight)
+	require.EqualValues(t, 1000, *proposal.EndHeight)
+
+	err = parseProposalStatus(ctx, map[string]any{
+		"proposal_id": "4",
+	})
+	require.Error(t, err)
+}
+
+func Test_parseAttestationRequest(t *testing.T) {
+	ctx := context.NewContext()
+	ctx.Block = &storage.Block{
+		Height: 1000,
+		Time:   time.Now(),
+	}
+
+	err := parseAttestationRequest(ctx, map[string]any{
+		"nonce": "5",
+	})
+	require.NoError(t, err)
+	require.Len(t, ctx.Attestations, 1)
+	require.EqualValues(t, 5, ctx.Attestations[0].Nonce)
+	require.EqualValues(t, 1000, ctx.Attestations[0].Height)
+
+	err = parseAttestationRequest(ctx, map[string]any{})
+	require.Error(t, err)
+}
+
+func Test_parseSlash(t *testing.T) {
+	ctx := context.NewContext()
+	ctx.Block = &storage.Block{
+		Height: 1000,
+		Time:   time.Now(),
+	}
+	ctx.AddInfraction("81A24EE534DEFE1557A4C7C437E8E8FBC2F834E8", 990)
+
+	err := parseSlash(ctx, map[string]any{
+		"address":      "celestiavalcons1sx3yaef5mmlp24ayclzr068gl0p0sd8gj9fwey",
+		"power":        "100",
+		"reason":       "double_sign",
+		"burned_coins": "5000",
+	})
+	require.NoError(t, err)
+
+	err = parseSlash(ctx, map[string]any{
+		"address": "celestiavalcons1sx3yaef5mmlp24ayclzr068gl0p0sd8gj9fwey",
+		"power":   "100",
+		"reason":  "missing_signature",
+		"jailed":  "celestiavalcons1sx3yaef5mmlp24ayclzr068gl0p0sd8gj9fwey",
+	})
+	require.NoError(t, err)
+
+	err = parseSlash(ctx, map[string]any{
+		"jailed": "celestiavalcons1sx3yaef5mmlp24ayclzr068gl0p0sd8gj9fwey",
+	})
+	require.NoError(t, err)
+
+	require.Len(t, ctx.Slashes, 2)
+	require.Equal(t, "81A24EE534DEFE1557A4C7C437E8E8FBC2F834E8", ctx.Slashes[0].Validator.ConsAddress)
+	require.EqualValues(t, 990, ctx.Slashes[0].InfractionHeight)
+	require.Equal(t, "5000", ctx.Slashes[0].Amount.String())
+	require.Equal(t, "100", ctx.Slashes[0].Power.String())
+	require.EqualValues(t, 998, ctx.Slashes[1].InfractionHeight)
+	require.Equal(t, "missing_signature", ctx.Slashes[1].Reason)
+
+	jail, ok := ctx.Jails.Get("81A24EE534DEFE1557A4C7C437E8E8FBC2F834E8")
+	require.True(t, ok)
+	require.Equal(t, "missing_signature", jail.Reason)
+}
@@ -5,6 +5,7 @@ package events
 
 import (
 	"github.com/celenium-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
 	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode/context"
 	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode/decoder"
 	"github.com/pkg/errors"
@@ -23,68 +24,82 @@ func handleExec(ctx *context.Context, events []storage.Event, msg *storage.Messa
 	*idx += 1
 
 	for i := range msg.InternalMsgs {
-		switch msg.InternalMsgs[i] {
-		case "/cosmos.staking.v1beta1.MsgCancelUnbondingDelegation":
-			if err := processCancelUnbonding(ctx, events, msg, idx); err != nil {
+		internalMsg := msg.InternalMsgs[i]
+		if internalMsg == nil {
+			return errors.Errorf("nil internal message in MsgExec on position %d", i)
+		}
+
+		switch internalMsg.Type {
+		case storageTypes.MsgCancelUnbondingDelegation:
+			if err := processCancelUnbonding(ctx, events, internalMsg, idx); err != nil {
 				return err
 			}
-		case "/cosmos.staking.v1beta1.MsgDelegate":
-			if err := processDelegate(ctx, events, msg, idx); err != nil {
+		case storageTypes.MsgDelegate:
+			if err := processDelegate(ctx, events, internalMsg, idx); err != nil {
 				return err
 			}
-		case "/cosmos.staking.v1beta1.MsgBeginRedelegate":
-			msgsAny, ok := msg.Data["Msgs"]
-			if !ok {
-				return errors.Errorf("can't find Msgs key in MsgExec: %##v", msg.Data)
+		case storageTypes.MsgBeginRedelegate:
+			if err := processRedelegate(ctx, events, internalMsg, idx); err != nil {
+				return err
 			}
-			msgsArr, ok := msgsAny.([]any)
-			if !ok {
-				return errors.Errorf("Msgs is not an array in MsgExec: %T", msgsAny)
+		case storageTypes.MsgUndelegate:
+			if err := processUndelegate(ctx, events, internalMsg, idx); err != nil {
+				return err
 			}
-			msgs, ok := msgsArr[i].(map[string]any)
-			if !ok {
-				return errors.Errorf("Msgs invalid type in MsgExec: %T", msgsArr[i])
+		case storageTypes.MsgWithdrawValidatorCommission:
+			if err := processWithdrawValidatorCommission(ctx, events, internalMsg, idx); err != nil {
+				return err
 			}
-			if err := processRedelegate(ctx, events, &storage.Message{
-				Data: msgs,
-			}, idx); err != nil {
+		case storageTypes.MsgWithdrawDelegatorReward:
+			if err := processWithdrawDelegatorRewards(ctx, events, internalMsg, idx); err != nil {
 				return err
 			}
-		case "/cosmos.staking.v1beta1.MsgUndelegate":
-			if err := processUndelegate(ctx, events, msg, idx); err != nil {
+		case storageTypes.MsgUnjail:
+			if err := processUnjail(ctx, events, idx); err != nil {
 				return err
 			}
-		case "/cosmos.distribution.v1beta1.MsgWithdrawValidatorCommission":
-			if err := processWithdrawValidatorCommission(ctx, events, msg, idx); err != nil {
+		case storageTypes.MsgVote, storageTypes.MsgVoteWeighted:
+			if err := processVote(ctx, events, internalMsg, idx); err != nil {
 				return err
 			}
-		case "cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward":
-			if err := processWithdrawDelegatorRewards(ctx, events, msg, idx); err != nil {
+		case storageTypes.MsgDeposit:
+			if err := processDeposit(ctx, events, internalMsg, idx); err != nil {
 				return err
 			}
-		case "/cosmos.slashing.v1beta1.MsgUnjail":
-			if err := processUnjail(ctx, events, idx); err != nil {
+		case storageTypes.MsgSubmitProposal:
+			if err := processSubmitProposal(ctx, events, internalMsg, idx); err != nil {
 				return err
 			}
 		default:
-			for j := *idx; j < len(events); j++ {
-				authMsgIdxPtr, err := decoder.AuthMsgIndexFromMap(events[*idx].Data)
-				if err != nil {
-					return err
-				}
-				if authMsgIdxPtr == nil {
-					break
-				}
+			msgEvents, err := execMsgEvents(events, idx, i)
+			if err != nil {
+				return err
+			}
 
-				if *authMsgIdxPtr != int64(i) {
-					break
+			// events of internal message don't contain message action, so processor is bounded by events of the internal message
+			if processor, ok := execEventProcessors[internalMsg.Type]; ok {
+				var msgIdx int
+				if err := processor(ctx, msgEvents, internalMsg, &msgIdx); err != nil {
+					return err
 				}
-
-				*idx = j + 1
 			}
-
 		}
 	}
 
 	return nil
 }
+
+// execMsgEvents - returns events of the internal message of MsgExec with the index. Events of internal messages are marked by `authz_msg_index` attribute.
+func execMsgEvents(events []storage.Event, idx *int, msgIndex int) ([]storage.Event, error) {
+	start := *idx
+	for ; *idx < len(events); *idx++ {
+		authMsgIdxPtr, err := decoder.AuthMsgIndexFromMap(events[*idx].Data)
+		if err != nil {
+			return nil, err
+		}
+		if authMsgIdxPtr == nil || *authMsgIdxPtr != int64(msgIndex) {
+			break
+		}
+	}
+	return events[start:*idx], nil
+}
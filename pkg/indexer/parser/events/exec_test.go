@@ -332,11 +332,11 @@ func Test_handleExec(t *testing.T) {
 			msg: &storage.Message{
 				Type:   types.MsgExec,
 				Height: 844359,
-				InternalMsgs: []string{
-					"/cosmos.staking.v1beta1.MsgDelegate",
-					"/cosmos.staking.v1beta1.MsgDelegate",
-					"/cosmos.staking.v1beta1.MsgDelegate",
-					"/cosmos.staking.v1beta1.MsgDelegate",
+				InternalMsgs: []*storage.Message{
+					{Type: types.MsgDelegate, Height: 844359},
+					{Type: types.MsgDelegate, Height: 844359},
+					{Type: types.MsgDelegate, Height: 844359},
+					{Type: types.MsgDelegate, Height: 844359},
 				},
 			},
 			idx: testsuite.Ptr(0),
@@ -497,15 +497,16 @@ func Test_handleExec(t *testing.T) {
 			msg: &storage.Message{
 				Type:   types.MsgExec,
 				Height: 595997,
-				InternalMsgs: []string{
-					"/cosmos.staking.v1beta1.MsgUndelegate",
-					"/cosmos.staking.v1beta1.MsgUndelegate",
-					"/cosmos.staking.v1beta1.MsgUndelegate",
+				InternalMsgs: []*storage.Message{
+					{Type: types.MsgUndelegate, Height: 595997},
+					{Type: types.MsgUndelegate, Height: 595997},
+					{Type: types.MsgUndelegate, Height: 595997},
 				},
 			},
 			idx: testsuite.Ptr(0),
 		}, {
 			name: "MsgWithdrawDelegatorReward",
+			ctx:  context.NewContext(),
 			events: []storage.Event{
 				{
 					Height: 977944,
@@ -663,9 +664,9 @@ func Test_handleExec(t *testing.T) {
 			msg: &storage.Message{
 				Type:   types.MsgExec,
 				Height: 977944,
-				InternalMsgs: []string{
-					"/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward",
-					"/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward",
+				InternalMsgs: []*storage.Message{
+					{Type: types.MsgWithdrawDelegatorReward, Height: 977944},
+					{Type: types.MsgWithdrawDelegatorReward, Height: 977944},
 				},
 			},
 			idx: testsuite.Ptr(7),
@@ -753,8 +754,8 @@ func Test_handleExec(t *testing.T) {
 			msg: &storage.Message{
 				Type:   types.MsgGrant,
 				Height: 45631,
-				InternalMsgs: []string{
-					"/cosmos.authz.v1beta1.MsgGrant",
+				InternalMsgs: []*storage.Message{
+					{Type: types.MsgGrant, Height: 45631},
 				},
 			},
 			idx: testsuite.Ptr(9),
@@ -767,3 +768,77 @@ func Test_handleExec(t *testing.T) {
 		})
 	}
 }
+
+func Test_handleExec_IbcTransfer(t *testing.T) {
+	ctx := context.NewContext()
+
+	withIndex := func(data map[string]any, index string) map[string]any {
+		data["authz_msg_index"] = index
+		return data
+	}
+	events := []storage.Event{
+		{
+			Height: 1000,
+			Type:   types.EventTypeMessage,
+			Data: map[string]any{
+				"action": "/cosmos.authz.v1beta1.MsgExec",
+			},
+		}, {
+			Height: 1000,
+			Type:   types.EventTypeSendPacket,
+			Data:   withIndex(testPacket("12", testPacketSend), "0"),
+		}, {
+			Height: 1000,
+			Type:   types.EventTypeIbcTransfer,
+			Data: map[string]any{
+				"authz_msg_index": "0",
+				"receiver":        "osmo1mm8yykm46ec3t0dgwls70g0jvtm055wkuvxanc",
+				"sender":          "celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8",
+			},
+		}, {
+			Height: 1000,
+			Type:   types.EventTypeSendPacket,
+			Data:   withIndex(testPacket("13", testPacketSend), "1"),
+		}, {
+			Height: 1000,
+			Type:   types.EventTypeIbcTransfer,
+			Data: map[string]any{
+				"authz_msg_index": "1",
+				"receiver":        "osmo1mm8yykm46ec3t0dgwls70g0jvtm055wkuvxanc",
+				"sender":          "celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8",
+			},
+		}, {
+			Height: 1000,
+			Type:   types.EventTypeMessage,
+			Data: map[string]any{
+				"action": "/cosmos.bank.v1beta1.MsgSend",
+			},
+		},
+	}
+	msg := &storage.Message{
+		Type:   types.MsgExec,
+		Height: 1000,
+		InternalMsgs: []*storage.Message{
+			{
+				Type:   types.IBCTransfer,
+				Height: 1000,
+			}, {
+				Type:   types.IBCTransfer,
+				Height: 1000,
+			},
+		},
+	}
+	idx := testsuite.Ptr(0)
+
+	err := handleExec(ctx, events, msg, idx)
+	require.NoError(t, err)
+	require.EqualValues(t, 5, *idx)
+
+	for i, sequence := range []uint64{12, 13} {
+		transfer := msg.InternalMsgs[i].IbcTransfer
+		require.NotNil(t, transfer, i)
+		require.EqualValues(t, types.IbcTransferStatusSent, transfer.Status)
+		require.EqualValues(t, sequence, transfer.Sequence)
+		require.EqualValues(t, "1000000", transfer.Amount.String())
+	}
+}
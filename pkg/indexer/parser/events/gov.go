@@ -0,0 +1,251 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package events
+
+import (
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode"
+	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode/context"
+	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode/decoder"
+	"github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+const govModule = "governance"
+
+func handleSubmitProposal(ctx *context.Context, events []storage.Event, msg *storage.Message, idx *int) error {
+	if idx == nil {
+		return errors.New("nil event index")
+	}
+	if msg == nil {
+		return errors.New("nil message in events hanler")
+	}
+	if action := decoder.StringFromMap(events[*idx].Data, "action"); action != "/cosmos.gov.v1.MsgSubmitProposal" && action != "/cosmos.gov.v1beta1.MsgSubmitProposal" {
+		return errors.Errorf("unexpected event action %s for message type %s", action, msg.Type.String())
+	}
+	*idx += 1
+	return processSubmitProposal(ctx, events, msg, idx)
+}
+
+func processSubmitProposal(ctx *context.Context, events []storage.Event, msg *storage.Message, idx *int) error {
+	proposal := msg.Proposal
+	if proposal == nil {
+		proposal = &storage.Proposal{
+			Height:    msg.Height,
+			CreatedAt: msg.Time,
+			Status:    storageTypes.ProposalStatusInactive,
+			Type:      storageTypes.ProposalTypeUnknown,
+		}
+	}
+
+	var deposit decimal.Decimal
+	for i := *idx; i < len(events); i++ {
+		switch events[i].Type {
+		case storageTypes.EventTypeSubmitProposal:
+			submit, err := decode.NewSubmitProposal(events[i].Data)
+			if err != nil {
+				return errors.Wrap(err, "parse submit proposal event")
+			}
+			proposal.Id = submit.ProposalId
+		case storageTypes.EventTypeProposalDeposit:
+			d, err := decode.NewProposalDeposit(events[i].Data)
+			if err != nil {
+				return errors.Wrap(err, "parse proposal deposit event")
+			}
+			deposit = deposit.Add(d.Amount)
+		case storageTypes.EventTypeMessage:
+			if module := decoder.StringFromMap(events[i].Data, "module"); module != govModule {
+				continue
+			}
+			if proposal.Id == 0 {
+				return errors.New("can't find proposal id in submit proposal events")
+			}
+
+			proposer := decoder.StringFromMap(events[i].Data, "sender")
+			if proposal.Proposer == nil || proposal.Proposer.Address != proposer {
+				proposal.Proposer = &storage.Address{
+					Address: proposer,
+				}
+			}
+			if err := addGovAddress(ctx, proposer, msg.Height); err != nil {
+				return err
+			}
+			ctx.AddProposal(proposal)
+
+			if deposit.IsPositive() {
+				ctx.AddDeposit(storage.Deposit{
+					Height:     msg.Height,
+					Time:       msg.Time,
+					ProposalId: proposal.Id,
+					Amount:     deposit,
+					Depositor:  proposal.Proposer,
+				})
+			}
+
+			*idx = i + 1
+			if *idx < len(events) && events[*idx].Type == storageTypes.EventTypeSubmitProposal {
+				submit, err := decode.NewSubmitProposal(events[*idx].Data)
+				if err != nil {
+					return errors.Wrap(err, "parse submit proposal event")
+				}
+				if submit.VotingPeriodStart > 0 {
+					activateProposal(ctx, submit.VotingPeriodStart, msg.Height, msg.Time)
+					*idx += 1
+				}
+			}
+			return nil
+		}
+	}
+
+	*idx = len(events) - 1
+	return nil
+}
+
+func handleDeposit(ctx *context.Context, events []storage.Event, msg *storage.Message, idx *int) error {
+	if idx == nil {
+		return errors.New("nil event index")
+	}
+	if msg == nil {
+		return errors.New("nil message in events hanler")
+	}
+	if action := decoder.StringFromMap(events[*idx].Data, "action"); action != "/cosmos.gov.v1.MsgDeposit" && action != "/cosmos.gov.v1beta1.MsgDeposit" {
+		return errors.Errorf("unexpected event action %s for message type %s", action, msg.Type.String())
+	}
+	*idx += 1
+	return processDeposit(ctx, events, msg, idx)
+}
+
+func processDeposit(ctx *context.Context, events []storage.Event, msg *storage.Message, idx *int) error {
+	var deposit = storage.Deposit{
+		Height: msg.Height,
+		Time:   msg.Time,
+	}
+
+	for i := *idx; i < len(events); i++ {
+		switch events[i].Type {
+		case storageTypes.EventTypeProposalDeposit:
+			d, err := decode.NewProposalDeposit(events[i].Data)
+			if err != nil {
+				return errors.Wrap(err, "parse proposal deposit event")
+			}
+			deposit.ProposalId = d.ProposalId
+			deposit.Amount = deposit.Amount.Add(d.Amount)
+		case storageTypes.EventTypeMessage:
+			if module := decoder.StringFromMap(events[i].Data, "module"); module != govModule {
+				continue
+			}
+			if deposit.ProposalId == 0 {
+				return errors.New("can't find proposal id in deposit events")
+			}
+
+			depositor := decoder.StringFromMap(events[i].Data, "sender")
+			if err := addGovAddress(ctx, depositor, msg.Height); err != nil {
+				return err
+			}
+			deposit.Depositor = &storage.Address{
+				Address: depositor,
+			}
+			ctx.AddDeposit(deposit)
+
+			*idx = i + 1
+			if *idx < len(events) && events[*idx].Type == storageTypes.EventTypeProposalDeposit {
+				d, err := decode.NewProposalDeposit(events[*idx].Data)
+				if err != nil {
+					return errors.Wrap(err, "parse proposal deposit event")
+				}
+				if d.VotingPeriodStart > 0 {
+					activateProposal(ctx, d.VotingPeriodStart, msg.Height, msg.Time)
+					*idx += 1
+				}
+			}
+			return nil
+		}
+	}
+
+	*idx = len(events) - 1
+	return nil
+}
+
+func handleVote(ctx *context.Context, events []storage.Event, msg *storage.Message, idx *int) error {
+	if idx == nil {
+		return errors.New("nil event index")
+	}
+	if msg == nil {
+		return errors.New("nil message in events hanler")
+	}
+	switch action := decoder.StringFromMap(events[*idx].Data, "action"); action {
+	case "/cosmos.gov.v1.MsgVote", "/cosmos.gov.v1beta1.MsgVote",
+		"/cosmos.gov.v1.MsgVoteWeighted", "/cosmos.gov.v1beta1.MsgVoteWeighted":
+	default:
+		return errors.Errorf("unexpected event action %s for message type %s", action, msg.Type.String())
+	}
+	*idx += 1
+	return processVote(ctx, events, msg, idx)
+}
+
+func processVote(ctx *context.Context, events []storage.Event, msg *storage.Message, idx *int) error {
+	var votes []storage.Vote
+
+	for i := *idx; i < len(events); i++ {
+		switch events[i].Type {
+		case storageTypes.EventTypeProposalVote:
+			v, err := decode.NewProposalVote(events[i].Data)
+			if err != nil {
+				return errors.Wrap(err, "parse proposal vote event")
+			}
+			voter := &storage.Address{
+				Address: v.Voter,
+			}
+			for _, opt := range v.Options {
+				votes = append(votes, storage.Vote{
+					Height:     msg.Height,
+					Time:       msg.Time,
+					ProposalId: v.ProposalId,
+					Option:     opt.Option,
+					Weight:     opt.Weight,
+					Voter:      voter,
+				})
+			}
+		case storageTypes.EventTypeMessage:
+			if module := decoder.StringFromMap(events[i].Data, "module"); module != govModule {
+				continue
+			}
+			if len(votes) == 0 {
+				return errors.New("can't find proposal vote event")
+			}
+			if err := addGovAddress(ctx, votes[0].Voter.Address, msg.Height); err != nil {
+				return err
+			}
+			ctx.AddVotes(votes...)
+
+			*idx = i + 1
+			return nil
+		}
+	}
+
+	*idx = len(events) - 1
+	return nil
+}
+
+func activateProposal(ctx *context.Context, id uint64, height types.Level, t time.Time) {
+	ctx.AddProposal(&storage.Proposal{
+		Id:               id,
+		Status:           storageTypes.ProposalStatusActive,
+		ActivationHeight: &height,
+		ActivationTime:   &t,
+	})
+}
+
+func addGovAddress(ctx *context.Context, address string, height types.Level) error {
+	return ctx.AddAddress(&storage.Address{
+		Address:    address,
+		Height:     height,
+		LastHeight: height,
+		Balance:    storage.EmptyBalance(),
+	})
+}
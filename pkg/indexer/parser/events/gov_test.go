@@ -0,0 +1,252 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	testsuite "github.com/celenium-io/celestia-indexer/internal/test_suite"
+	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode/context"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_handleSubmitProposal(t *testing.T) {
+	ts := time.Now()
+	ctx := context.NewContext()
+	events := []storage.Event{
+		{
+			Height: 1000,
+			Type:   "message",
+			Data: map[string]any{
+				"action": "/cosmos.gov.v1.MsgSubmitProposal",
+			},
+		}, {
+			Height: 1000,
+			Type:   "submit_proposal",
+			Data: map[string]any{
+				"proposal_id":       "5",
+				"proposal_messages": ",/cosmos.gov.v1.MsgExecLegacyContent",
+			},
+		}, {
+			Height: 1000,
+			Type:   "coin_spent",
+			Data: map[string]any{
+				"amount":  "10000000000utia",
+				"spender": "celestia1vnflc6322f8z7cpl28r7un5dxhmjxghc20aydq",
+			},
+		}, {
+			Height: 1000,
+			Type:   "proposal_deposit",
+			Data: map[string]any{
+				"amount":      "10000000000utia",
+				"proposal_id": "5",
+			},
+		}, {
+			Height: 1000,
+			Type:   "message",
+			Data: map[string]any{
+				"module": "governance",
+				"sender": "celestia1vnflc6322f8z7cpl28r7un5dxhmjxghc20aydq",
+			},
+		}, {
+			Height: 1000,
+			Type:   "submit_proposal",
+			Data: map[string]any{
+				"voting_period_start": "5",
+			},
+		},
+	}
+	msg := &storage.Message{
+		Type:   types.MsgSubmitProposal,
+		Height: 1000,
+		Time:   ts,
+		Proposal: &storage.Proposal{
+			Height:    1000,
+			CreatedAt: ts,
+			Status:    types.ProposalStatusInactive,
+			Type:      types.ProposalTypeText,
+			Title:     "title",
+		},
+	}
+	idx := testsuite.Ptr(0)
+
+	err := handleSubmitProposal(ctx, events, msg, idx)
+	require.NoError(t, err)
+	require.EqualValues(t, 6, *idx)
+
+	proposal, ok := ctx.Proposals.Get(5)
+	require.True(t, ok)
+	require.EqualValues(t, "title", proposal.Title)
+	require.EqualValues(t, types.ProposalStatusActive, proposal.Status)
+	require.EqualValues(t, "10000000000", proposal.Deposit.String())
+	require.NotNil(t, proposal.ActivationHeight)
+	require.EqualValues(t, 1000, *proposal.ActivationHeight)
+	require.NotNil(t, proposal.Proposer)
+	require.EqualValues(t, "celestia1vnflc6322f8z7cpl28r7un5dxhmjxghc20aydq", proposal.Proposer.Address)
+
+	require.Len(t, ctx.Deposits, 1)
+	require.EqualValues(t, 5, ctx.Deposits[0].ProposalId)
+	require.EqualValues(t, "10000000000", ctx.Deposits[0].Amount.String())
+
+	_, ok = ctx.Addresses.Get("celestia1vnflc6322f8z7cpl28r7un5dxhmjxghc20aydq")
+	require.True(t, ok)
+}
+
+func Test_handleDeposit(t *testing.T) {
+	ctx := context.NewContext()
+	events := []storage.Event{
+		{
+			Height: 1000,
+			Type:   "message",
+			Data: map[string]any{
+				"action": "/cosmos.gov.v1beta1.MsgDeposit",
+			},
+		}, {
+			Height: 1000,
+			Type:   "proposal_deposit",
+			Data: map[string]any{
+				"amount":      "500utia",
+				"proposal_id": "2",
+			},
+		}, {
+			Height: 1000,
+			Type:   "message",
+			Data: map[string]any{
+				"module": "governance",
+				"sender": "celestia1vnflc6322f8z7cpl28r7un5dxhmjxghc20aydq",
+			},
+		},
+	}
+	msg := &storage.Message{
+		Type:   types.MsgDeposit,
+		Height: 1000,
+	}
+	idx := testsuite.Ptr(0)
+
+	err := handleDeposit(ctx, events, msg, idx)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, *idx)
+
+	require.Len(t, ctx.Deposits, 1)
+	require.EqualValues(t, 2, ctx.Deposits[0].ProposalId)
+	require.EqualValues(t, "500", ctx.Deposits[0].Amount.String())
+	require.EqualValues(t, "celestia1vnflc6322f8z7cpl28r7un5dxhmjxghc20aydq", ctx.Deposits[0].Depositor.Address)
+
+	proposal, ok := ctx.Proposals.Get(2)
+	require.True(t, ok)
+	require.EqualValues(t, "500", proposal.Deposit.String())
+	require.Nil(t, proposal.ActivationHeight)
+}
+
+func Test_handleVote(t *testing.T) {
+	ctx := context.NewContext()
+	events := []storage.Event{
+		{
+			Height: 1000,
+			Type:   "message",
+			Data: map[string]any{
+				"action": "/cosmos.gov.v1beta1.MsgVoteWeighted",
+			},
+		}, {
+			Height: 1000,
+			Type:   "proposal_vote",
+			Data: map[string]any{
+				"option":      "option:VOTE_OPTION_YES weight:\"0.500000000000000000\"\noption:VOTE_OPTION_ABSTAIN weight:\"0.500000000000000000\"",
+				"proposal_id": "3",
+				"voter":       "celestia1vnflc6322f8z7cpl28r7un5dxhmjxghc20aydq",
+			},
+		}, {
+			Height: 1000,
+			Type:   "message",
+			Data: map[string]any{
+				"module": "governance",
+				"sender": "celestia1vnflc6322f8z7cpl28r7un5dxhmjxghc20aydq",
+			},
+		},
+	}
+	msg := &storage.Message{
+		Type:   types.MsgVoteWeighted,
+		Height: 1000,
+	}
+	idx := testsuite.Ptr(0)
+
+	err := handleVote(ctx, events, msg, idx)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, *idx)
+
+	require.Len(t, ctx.Votes, 2)
+	require.EqualValues(t, types.VoteOptionYes, ctx.Votes[0].Option)
+	require.True(t, decimal.RequireFromString("0.5").Equal(ctx.Votes[0].Weight))
+	require.EqualValues(t, types.VoteOptionAbstain, ctx.Votes[1].Option)
+
+	// tally is recomputed from stored votes
+	_, ok := ctx.Proposals.Get(3)
+	require.False(t, ok)
+}
+
+func Test_handleVote_Revote(t *testing.T) {
+	ctx := context.NewContext()
+	voteEvents := func(option string) []storage.Event {
+		return []storage.Event{
+			{
+				Height: 1000,
+				Type:   "message",
+				Data: map[string]any{
+					"action": "/cosmos.gov.v1.MsgVote",
+				},
+			}, {
+				Height: 1000,
+				Type:   "proposal_vote",
+				Data: map[string]any{
+					"option":      option,
+					"proposal_id": "3",
+					"voter":       "celestia1vnflc6322f8z7cpl28r7un5dxhmjxghc20aydq",
+				},
+			}, {
+				Height: 1000,
+				Type:   "message",
+				Data: map[string]any{
+					"module": "governance",
+					"sender": "celestia1vnflc6322f8z7cpl28r7un5dxhmjxghc20aydq",
+				},
+			},
+		}
+	}
+	msg := &storage.Message{
+		Type:   types.MsgVote,
+		Height: 1000,
+	}
+
+	err := handleVote(ctx, voteEvents("option:VOTE_OPTION_YES weight:\"0.500000000000000000\"\noption:VOTE_OPTION_NO weight:\"0.500000000000000000\""), msg, testsuite.Ptr(0))
+	require.NoError(t, err)
+	require.Len(t, ctx.Votes, 2)
+
+	err = handleVote(ctx, voteEvents("option:VOTE_OPTION_ABSTAIN weight:\"1.000000000000000000\""), msg, testsuite.Ptr(0))
+	require.NoError(t, err)
+	require.Len(t, ctx.Votes, 1)
+	require.EqualValues(t, types.VoteOptionAbstain, ctx.Votes[0].Option)
+	require.True(t, decimal.RequireFromString("1").Equal(ctx.Votes[0].Weight))
+}
+
+func Test_handleVote_UnexpectedAction(t *testing.T) {
+	events := []storage.Event{
+		{
+			Height: 1000,
+			Type:   "message",
+			Data: map[string]any{
+				"action": "/cosmos.gov.v1beta1.MsgDeposit",
+			},
+		},
+	}
+	msg := &storage.Message{
+		Type:   types.MsgVote,
+		Height: 1000,
+	}
+	err := handleVote(context.NewContext(), events, msg, testsuite.Ptr(0))
+	require.Error(t, err)
+}
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package events
+
+import (
+	"strings"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode"
+	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode/context"
+	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode/decoder"
+	"github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+)
+
+const groupActionPrefix = "/cosmos.group.v1."
+
+// handleGroup - receives identities of created group, group policy and proposal from typed events of group module
+func handleGroup(ctx *context.Context, events []storage.Event, msg *storage.Message, idx *int) error {
+	if idx == nil {
+		return errors.New("nil event index")
+	}
+	if msg == nil {
+		return errors.New("nil message in events hanler")
+	}
+	if action := decoder.StringFromMap(events[*idx].Data, "action"); !strings.HasPrefix(action, groupActionPrefix) {
+		return errors.Errorf("unexpected event action %s for message type %s", action, msg.Type.String())
+	}
+	*idx += 1
+	return processGroup(ctx, events, msg, idx)
+}
+
+func processGroup(ctx *context.Context, events []storage.Event, msg *storage.Message, idx *int) error {
+	var votes int
+
+	for ; *idx < len(events); *idx++ {
+		switch events[*idx].Type {
+		case storageTypes.EventTypeMessage:
+			if action := decoder.StringFromMap(events[*idx].Data, "action"); action != "" {
+				return nil
+			}
+		case storageTypes.EventTypeCosmosgroupv1EventCreateGroup:
+			if msg.Group == nil {
+				continue
+			}
+			event, err := decode.NewGroupEvent(events[*idx].Data)
+			if err != nil {
+				return errors.Wrap(err, "parse create group event")
+			}
+			msg.Group.Id = event.GroupId
+			for i := range msg.Group.Members {
+				msg.Group.Members[i].GroupId = event.GroupId
+			}
+			if msg.GroupPolicy != nil {
+				msg.GroupPolicy.GroupId = event.GroupId
+			}
+			if msg.Group.Admin != nil {
+				ctx.AddGroup(msg.Group)
+			}
+		case storageTypes.EventTypeCosmosgroupv1EventCreateGroupPolicy:
+			if msg.GroupPolicy == nil {
+				continue
+			}
+			event, err := decode.NewGroupPolicyEvent(events[*idx].Data)
+			if err != nil {
+				return errors.Wrap(err, "parse create group policy event")
+			}
+			if err := addGroupAddress(ctx, event.Address, msg.Height); err != nil {
+				return err
+			}
+			msg.GroupPolicy.Address = &storage.Address{
+				Address: event.Address,
+			}
+
+			// group policy is admin of the group and itself
+			if msg.GroupPolicy.Admin == nil {
+				msg.GroupPolicy.Admin = &storage.Address{
+					Address: event.Address,
+				}
+				if msg.Group != nil {
+					msg.Group.Admin = &storage.Address{
+						Address: event.Address,
+					}
+					ctx.AddGroup(msg.Group)
+				}
+			}
+			ctx.AddGroupPolicy(msg.GroupPolicy)
+		case storageTypes.EventTypeCosmosgroupv1EventSubmitProposal:
+			if msg.GroupProposal == nil {
+				continue
+			}
+			event, err := decode.NewGroupProposalEvent(events[*idx].Data)
+			if err != nil {
+				return errors.Wrap(err, "parse submit group proposal event")
+			}
+			msg.GroupProposal.Id = event.ProposalId
+			ctx.AddGroupProposal(msg.GroupProposal)
+		case storageTypes.EventTypeCosmosgroupv1EventVote:
+			// proposers vote `yes` when proposal is submitted with `EXEC_TRY`
+			if msg.GroupProposal == nil || msg.GroupProposal.Id == 0 || votes >= len(msg.GroupProposal.Proposers) {
+				continue
+			}
+			ctx.AddGroupVote(&storage.GroupVote{
+				Height:     msg.Height,
+				Time:       msg.Time,
+				ProposalId: msg.GroupProposal.Id,
+				Option:     storageTypes.VoteOptionYes,
+				Voter: &storage.Address{
+					Address: msg.GroupProposal.Proposers[votes],
+				},
+			})
+			votes++
+		}
+	}
+	return nil
+}
+
+func addGroupAddress(ctx *context.Context, address string, height types.Level) error {
+	return ctx.AddAddress(&storage.Address{
+		Address:    address,
+		Height:     height,
+		LastHeight: height,
+		Balance:    storage.EmptyBalance(),
+	})
+}
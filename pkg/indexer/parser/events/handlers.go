@@ -22,6 +22,50 @@ var eventHandlers = map[storageTypes.MsgType]EventHandler{
 	storageTypes.MsgWithdrawValidatorCommission: handleWithdrawValidatorCommission,
 	storageTypes.MsgWithdrawDelegatorReward:     handleWithdrawDelegatorRewards,
 	storageTypes.MsgUnjail:                      handleUnjail,
+	storageTypes.MsgSubmitProposal:              handleSubmitProposal,
+	storageTypes.MsgDeposit:                     handleDeposit,
+	storageTypes.MsgVote:                        handleVote,
+	storageTypes.MsgVoteWeighted:                handleVote,
+	storageTypes.IBCTransfer:                    handleIbc,
+	storageTypes.MsgRecvPacket:                  handleIbc,
+	storageTypes.MsgAcknowledgement:             handleIbc,
+	storageTypes.MsgTimeout:                     handleIbc,
+	storageTypes.MsgTimeoutOnClose:              handleIbc,
+	storageTypes.MsgCreateClient:                handleIbc,
+	storageTypes.MsgConnectionOpenInit:          handleIbc,
+	storageTypes.MsgConnectionOpenTry:           handleIbc,
+	storageTypes.MsgConnectionOpenAck:           handleIbc,
+	storageTypes.MsgConnectionOpenConfirm:       handleIbc,
+	storageTypes.MsgChannelOpenInit:             handleIbc,
+	storageTypes.MsgChannelOpenTry:              handleIbc,
+	storageTypes.MsgChannelOpenAck:              handleIbc,
+	storageTypes.MsgChannelOpenConfirm:          handleIbc,
+	storageTypes.MsgCreateGroup:                 handleGroup,
+	storageTypes.MsgCreateGroupWithPolicy:       handleGroup,
+	storageTypes.MsgCreateGroupPolicy:           handleGroup,
+	storageTypes.MsgSubmitProposalGroup:         handleGroup,
+}
+
+// execEventProcessors - processors of events of internal messages of MsgExec which are not handled by handleExec directly
+var execEventProcessors = map[storageTypes.MsgType]EventHandler{
+	storageTypes.IBCTransfer:              processIbc,
+	storageTypes.MsgRecvPacket:            processIbc,
+	storageTypes.MsgAcknowledgement:       processIbc,
+	storageTypes.MsgTimeout:               processIbc,
+	storageTypes.MsgTimeoutOnClose:        processIbc,
+	storageTypes.MsgCreateClient:          processIbc,
+	storageTypes.MsgConnectionOpenInit:    processIbc,
+	storageTypes.MsgConnectionOpenTry:     processIbc,
+	storageTypes.MsgConnectionOpenAck:     processIbc,
+	storageTypes.MsgConnectionOpenConfirm: processIbc,
+	storageTypes.MsgChannelOpenInit:       processIbc,
+	storageTypes.MsgChannelOpenTry:        processIbc,
+	storageTypes.MsgChannelOpenAck:        processIbc,
+	storageTypes.MsgChannelOpenConfirm:    processIbc,
+	storageTypes.MsgCreateGroup:           processGroup,
+	storageTypes.MsgCreateGroupWithPolicy: processGroup,
+	storageTypes.MsgCreateGroupPolicy:     processGroup,
+	storageTypes.MsgSubmitProposalGroup:   processGroup,
 }
 
 func Handle(ctx *context.Context, events []storage.Event, msg *storage.Message, idx *int) error {
@@ -0,0 +1,298 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package events
+
+import (
+	"strings"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode"
+	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode/context"
+	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode/decoder"
+	"github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	ibcTransferPort     = "transfer"
+	icaHostPort         = "icahost"
+	icaControllerPrefix = "icacontroller-"
+)
+
+func handleIbc(ctx *context.Context, events []storage.Event, msg *storage.Message, idx *int) error {
+	if idx == nil {
+		return errors.New("nil event index")
+	}
+	if msg == nil {
+		return errors.New("nil message in events hanler")
+	}
+	if action := decoder.StringFromMap(events[*idx].Data, "action"); !strings.HasPrefix(action, "/ibc.") {
+		return errors.Errorf("unexpected event action %s for message type %s", action, msg.Type.String())
+	}
+	*idx += 1
+	return processIbc(ctx, events, msg, idx)
+}
+
+// processIbc - handles IBC events of the message till the next action. Packet events of relayed messages
+// are emitted even for redundant relaying, so transfer is changed only if transfer module event is found.
+// Transfer module emits its event after recv_packet and acknowledge_packet but before timeout_packet.
+func processIbc(ctx *context.Context, events []storage.Event, msg *storage.Message, idx *int) error {
+	var (
+		packet     *decode.Packet
+		packetType storageTypes.EventType
+		timedOut   bool
+	)
+
+	for ; *idx < len(events); *idx++ {
+		event := events[*idx]
+
+		switch event.Type {
+		case storageTypes.EventTypeMessage:
+			if action := decoder.StringFromMap(event.Data, "action"); action != "" {
+				return nil
+			}
+
+		case storageTypes.EventTypeCreateClient:
+			client, err := decode.NewCreateClient(event.Data)
+			if err != nil {
+				return errors.Wrap(err, "parse create client event")
+			}
+			if msg.IbcClient == nil {
+				msg.IbcClient = &storage.IbcClient{
+					Height:    msg.Height,
+					CreatedAt: msg.Time,
+				}
+			}
+			msg.IbcClient.Id = client.Id
+			msg.IbcClient.Type = client.Type
+
+		case storageTypes.EventTypeConnectionOpenInit, storageTypes.EventTypeConnectionOpenTry,
+			storageTypes.EventTypeConnectionOpenAck, storageTypes.EventTypeConnectionOpenConfirm:
+			conn, err := decode.NewConnectionChange(event.Data)
+			if err != nil {
+				return errors.Wrapf(err, "parse %s event", event.Type)
+			}
+			msg.IbcConnection = &storage.IbcConnection{
+				Id:                       conn.ConnectionId,
+				ClientId:                 conn.ClientId,
+				CounterpartyConnectionId: conn.CounterpartyConnectionId,
+				CounterpartyClientId:     conn.CounterpartyClientId,
+				Height:                   msg.Height,
+				CreatedAt:                msg.Time,
+			}
+			if event.Type == storageTypes.EventTypeConnectionOpenAck || event.Type == storageTypes.EventTypeConnectionOpenConfirm {
+				msg.IbcConnection.ConnectionHeight = &msg.Height
+				msg.IbcConnection.ConnectedAt = &msg.Time
+			}
+
+		case storageTypes.EventTypeChannelOpenInit, storageTypes.EventTypeChannelOpenTry,
+			storageTypes.EventTypeChannelOpenAck, storageTypes.EventTypeChannelOpenConfirm:
+			channel, err := decode.NewChannelChange(event.Data)
+			if err != nil {
+				return errors.Wrapf(err, "parse %s event", event.Type)
+			}
+			msg.IbcChannel = &storage.IbcChannel{
+				Id:                    channel.ChannelId,
+				PortId:                channel.PortId,
+				CounterpartyPortId:    channel.CounterpartyPortId,
+				CounterpartyChannelId: channel.CounterpartyChannelId,
+				ConnectionId:          channel.ConnectionId,
+				Version:               channel.Version,
+				Height:                msg.Height,
+				CreatedAt:             msg.Time,
+				Status:                storageTypes.IbcChannelStatusInitialization,
+			}
+			if event.Type == storageTypes.EventTypeChannelOpenAck || event.Type == storageTypes.EventTypeChannelOpenConfirm {
+				msg.IbcChannel.Status = storageTypes.IbcChannelStatusOpened
+				msg.IbcChannel.ConfirmationHeight = &msg.Height
+				msg.IbcChannel.ConfirmedAt = &msg.Time
+			}
+			if event.Type == storageTypes.EventTypeChannelOpenTry && channel.PortId == icaHostPort {
+				if err := processInterchainAccount(ctx, msg, channel); err != nil {
+					return err
+				}
+			}
+
+		case storageTypes.EventTypeSendPacket:
+			p, err := decode.NewPacket(event.Data)
+			if err != nil {
+				return errors.Wrap(err, "parse send packet event")
+			}
+			if p.SrcPort != ibcTransferPort {
+				continue
+			}
+			transfer, err := newIbcTransfer(msg, p, storageTypes.IbcTransferStatusSent)
+			if err != nil {
+				return err
+			}
+			msg.IbcTransfer = transfer
+
+		case storageTypes.EventTypeRecvPacket, storageTypes.EventTypeAcknowledgePacket:
+			p, err := decode.NewPacket(event.Data)
+			if err != nil {
+				return errors.Wrapf(err, "parse %s event", event.Type)
+			}
+			packet = &p
+			packetType = event.Type
+
+			if event.Type == storageTypes.EventTypeRecvPacket && p.DstPort == icaHostPort {
+				icaPacket, err := newInterchainAccountPacket(msg, p)
+				if err != nil {
+					return err
+				}
+				msg.InterchainAccountPacket = icaPacket
+			}
+
+		case storageTypes.EventTypeWriteAcknowledgement:
+			if msg.InterchainAccountPacket == nil {
+				continue
+			}
+			ack, err := decode.NewAcknowledgement(event.Data)
+			if err != nil {
+				return errors.Wrap(err, "parse write acknowledgement event")
+			}
+			msg.InterchainAccountPacket.Success = ack.Success
+			msg.InterchainAccountPacket.Error = ack.Error
+
+		case storageTypes.EventTypeTimeoutPacket:
+			if !timedOut {
+				continue
+			}
+			p, err := decode.NewPacket(event.Data)
+			if err != nil {
+				return errors.Wrap(err, "parse timeout packet event")
+			}
+			msg.IbcTransfer = completeIbcTransfer(msg, p, storageTypes.IbcTransferStatusTimeout)
+
+		case storageTypes.EventTypeFungibleTokenPacket:
+			if packet == nil {
+				continue
+			}
+			switch packetType {
+			case storageTypes.EventTypeRecvPacket:
+				if decoder.StringFromMap(event.Data, "success") == "false" {
+					continue
+				}
+				transfer, err := newIbcTransfer(msg, *packet, storageTypes.IbcTransferStatusReceived)
+				if err != nil {
+					return err
+				}
+				if types.Address(transfer.Receiver).IsCelestia() {
+					if err := ctx.AddAddress(&storage.Address{
+						Address:    transfer.Receiver,
+						Height:     msg.Height,
+						LastHeight: msg.Height,
+						Balance:    storage.EmptyBalance(),
+					}); err != nil {
+						return err
+					}
+				}
+				msg.IbcTransfer = transfer
+			case storageTypes.EventTypeAcknowledgePacket:
+				msg.IbcTransfer = completeIbcTransfer(msg, *packet, storageTypes.IbcTransferStatusAcknowledged)
+			}
+
+		case storageTypes.EventTypeTimeout:
+			timedOut = true
+		}
+	}
+
+	return nil
+}
+
+func newIbcTransfer(msg *storage.Message, packet decode.Packet, status storageTypes.IbcTransferStatus) (*storage.IbcTransfer, error) {
+	data, err := decode.NewFungibleTokenPacketData(packet.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse fungible token packet data")
+	}
+
+	transfer := &storage.IbcTransfer{
+		Height:       msg.Height,
+		Time:         msg.Time,
+		Timeout:      packet.Timeout,
+		Sequence:     packet.Sequence,
+		ConnectionId: packet.Connection,
+		Amount:       data.Amount,
+		Denom:        data.Denom,
+		Memo:         data.Memo,
+		Sender:       data.Sender,
+		Receiver:     data.Receiver,
+		Status:       status,
+	}
+
+	if status == storageTypes.IbcTransferStatusReceived {
+		transfer.Port = packet.DstPort
+		transfer.ChannelId = packet.DstChannel
+		transfer.CounterpartyPort = packet.SrcPort
+		transfer.CounterpartyChannelId = packet.SrcChannel
+	} else {
+		transfer.Port = packet.SrcPort
+		transfer.ChannelId = packet.SrcChannel
+		transfer.CounterpartyPort = packet.DstPort
+		transfer.CounterpartyChannelId = packet.DstChannel
+	}
+	return transfer, nil
+}
+
+func completeIbcTransfer(msg *storage.Message, packet decode.Packet, status storageTypes.IbcTransferStatus) *storage.IbcTransfer {
+	return &storage.IbcTransfer{
+		Port:                  packet.SrcPort,
+		ChannelId:             packet.SrcChannel,
+		CounterpartyPort:      packet.DstPort,
+		CounterpartyChannelId: packet.DstChannel,
+		Sequence:              packet.Sequence,
+		Status:                status,
+		CompletedHeight:       &msg.Height,
+		CompletedAt:           &msg.Time,
+	}
+}
+
+// processInterchainAccount - registers interchain account hosted on Celestia. Host chain generates account address
+// during channel opening handshake and returns it in channel version metadata.
+func processInterchainAccount(ctx *context.Context, msg *storage.Message, channel decode.ChannelChange) error {
+	metadata, err := decode.NewInterchainAccountMetadata(channel.Version)
+	if err != nil {
+		return errors.Wrap(err, "parse interchain account metadata")
+	}
+	if metadata.Address == "" {
+		return nil
+	}
+
+	msg.InterchainAccount = &storage.InterchainAccount{
+		Address:                metadata.Address,
+		Owner:                  strings.TrimPrefix(channel.CounterpartyPortId, icaControllerPrefix),
+		ConnectionId:           channel.ConnectionId,
+		ControllerConnectionId: metadata.ControllerConnectionId,
+		ChannelId:              channel.ChannelId,
+		CounterpartyChannelId:  channel.CounterpartyChannelId,
+		Height:                 msg.Height,
+		CreatedAt:              msg.Time,
+	}
+
+	return ctx.AddAddress(&storage.Address{
+		Address:    metadata.Address,
+		Height:     msg.Height,
+		LastHeight: msg.Height,
+		Balance:    storage.EmptyBalance(),
+	})
+}
+
+func newInterchainAccountPacket(msg *storage.Message, packet decode.Packet) (*storage.InterchainAccountPacket, error) {
+	data, err := decode.NewInterchainAccountPacketData(packet.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse interchain account packet data")
+	}
+
+	return &storage.InterchainAccountPacket{
+		Height:       msg.Height,
+		Time:         msg.Time,
+		ConnectionId: packet.Connection,
+		ChannelId:    packet.DstChannel,
+		Owner:        strings.TrimPrefix(packet.SrcPort, icaControllerPrefix),
+		Sequence:     packet.Sequence,
+		Memo:         data.Memo,
+		Messages:     data.Messages,
+	}, nil
+}
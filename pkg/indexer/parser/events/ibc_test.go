@@ -0,0 +1,510 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	testsuite "github.com/celenium-io/celestia-indexer/internal/test_suite"
+	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode/context"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testPacketSend = `{"amount":"1000000","denom":"utia","receiver":"osmo1mm8yykm46ec3t0dgwls70g0jvtm055wkuvxanc","sender":"celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8"}`
+	testPacketRecv = `{"amount":"500","denom":"transfer/channel-6994/utia","receiver":"celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8","sender":"osmo1mm8yykm46ec3t0dgwls70g0jvtm055wkuvxanc"}`
+	testIcaPacket  = `{"data":"CiUKIy9jb3Ntb3Muc3Rha2luZy52MWJldGExLk1zZ0RlbGVnYXRlCh4KHC9jb3Ntb3MuYmFuay52MWJldGExLk1zZ1NlbmQ=","memo":"memo","type":"TYPE_EXECUTE_TX"}`
+	testIcaVersion = `{"version":"ics27-1","controller_connection_id":"connection-1","host_connection_id":"connection-0","address":"celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8","encoding":"proto3","tx_type":"sdk_multi_msg"}`
+)
+
+func testPacket(sequence, data string) map[string]any {
+	return map[string]any{
+		"packet_channel_ordering":  "ORDER_UNORDERED",
+		"packet_connection":        "connection-2",
+		"packet_data":              data,
+		"packet_dst_channel":       "channel-6994",
+		"packet_dst_port":          "transfer",
+		"packet_sequence":          sequence,
+		"packet_src_channel":       "channel-2",
+		"packet_src_port":          "transfer",
+		"packet_timeout_height":    "0-0",
+		"packet_timeout_timestamp": "1705495524000000000",
+	}
+}
+
+func Test_handleIbc_Transfer(t *testing.T) {
+	ts := time.Now()
+	ctx := context.NewContext()
+	events := []storage.Event{
+		{
+			Height: 1000,
+			Type:   types.EventTypeMessage,
+			Data: map[string]any{
+				"action": "/ibc.applications.transfer.v1.MsgTransfer",
+			},
+		}, {
+			Height: 1000,
+			Type:   types.EventTypeSendPacket,
+			Data:   testPacket("12", testPacketSend),
+		}, {
+			Height: 1000,
+			Type:   types.EventTypeIbcTransfer,
+			Data: map[string]any{
+				"receiver": "osmo1mm8yykm46ec3t0dgwls70g0jvtm055wkuvxanc",
+				"sender":   "celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8",
+			},
+		}, {
+			Height: 1000,
+			Type:   types.EventTypeMessage,
+			Data: map[string]any{
+				"action": "/cosmos.bank.v1beta1.MsgSend",
+			},
+		},
+	}
+	msg := &storage.Message{
+		Type:   types.IBCTransfer,
+		Height: 1000,
+		Time:   ts,
+	}
+	idx := testsuite.Ptr(0)
+
+	err := handleIbc(ctx, events, msg, idx)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, *idx)
+
+	require.NotNil(t, msg.IbcTransfer)
+	require.EqualValues(t, types.IbcTransferStatusSent, msg.IbcTransfer.Status)
+	require.EqualValues(t, 12, msg.IbcTransfer.Sequence)
+	require.EqualValues(t, "channel-2", msg.IbcTransfer.ChannelId)
+	require.EqualValues(t, "transfer", msg.IbcTransfer.Port)
+	require.EqualValues(t, "channel-6994", msg.IbcTransfer.CounterpartyChannelId)
+	require.EqualValues(t, "connection-2", msg.IbcTransfer.ConnectionId)
+	require.EqualValues(t, "1000000", msg.IbcTransfer.Amount.String())
+	require.EqualValues(t, "utia", msg.IbcTransfer.Denom)
+	require.EqualValues(t, "celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8", msg.IbcTransfer.Sender)
+	require.EqualValues(t, "osmo1mm8yykm46ec3t0dgwls70g0jvtm055wkuvxanc", msg.IbcTransfer.Receiver)
+	require.NotNil(t, msg.IbcTransfer.Timeout)
+	require.EqualValues(t, 1000, msg.IbcTransfer.Height)
+}
+
+func Test_handleIbc_RecvPacket(t *testing.T) {
+	tests := []struct {
+		name        string
+		events      []storage.Event
+		wantNil     bool
+		wantAddress bool
+	}{
+		{
+			name: "received",
+			events: []storage.Event{
+				{
+					Type: types.EventTypeMessage,
+					Data: map[string]any{"action": "/ibc.core.channel.v1.MsgRecvPacket"},
+				}, {
+					Type: types.EventTypeRecvPacket,
+					Data: testPacket("7", testPacketRecv),
+				}, {
+					Type: types.EventTypeMessage,
+					Data: map[string]any{"module": "ibc_channel"},
+				}, {
+					Type: types.EventTypeFungibleTokenPacket,
+					Data: map[string]any{
+						"module":  "transfer",
+						"amount":  "500",
+						"success": "true",
+					},
+				}, {
+					Type: types.EventTypeWriteAcknowledgement,
+					Data: map[string]any{},
+				},
+			},
+			wantAddress: true,
+		}, {
+			name: "redundant relay",
+			events: []storage.Event{
+				{
+					Type: types.EventTypeMessage,
+					Data: map[string]any{"action": "/ibc.core.channel.v1.MsgRecvPacket"},
+				}, {
+					Type: types.EventTypeRecvPacket,
+					Data: testPacket("7", testPacketRecv),
+				}, {
+					Type: types.EventTypeMessage,
+					Data: map[string]any{"module": "ibc_channel"},
+				},
+			},
+			wantNil: true,
+		}, {
+			name: "error acknowledgement",
+			events: []storage.Event{
+				{
+					Type: types.EventTypeMessage,
+					Data: map[string]any{"action": "/ibc.core.channel.v1.MsgRecvPacket"},
+				}, {
+					Type: types.EventTypeRecvPacket,
+					Data: testPacket("7", testPacketRecv),
+				}, {
+					Type: types.EventTypeFungibleTokenPacket,
+					Data: map[string]any{
+						"module":  "transfer",
+						"success": "false",
+					},
+				},
+			},
+			wantNil: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.NewContext()
+			msg := &storage.Message{
+				Type:   types.MsgRecvPacket,
+				Height: 1000,
+				Time:   time.Now(),
+			}
+			idx := testsuite.Ptr(0)
+
+			err := handleIbc(ctx, tt.events, msg, idx)
+			require.NoError(t, err)
+			require.EqualValues(t, len(tt.events), *idx)
+
+			if tt.wantNil {
+				require.Nil(t, msg.IbcTransfer)
+				return
+			}
+			require.NotNil(t, msg.IbcTransfer)
+			require.EqualValues(t, types.IbcTransferStatusReceived, msg.IbcTransfer.Status)
+			require.EqualValues(t, 7, msg.IbcTransfer.Sequence)
+			require.EqualValues(t, "channel-6994", msg.IbcTransfer.ChannelId)
+			require.EqualValues(t, "channel-2", msg.IbcTransfer.CounterpartyChannelId)
+			require.EqualValues(t, "transfer/channel-6994/utia", msg.IbcTransfer.Denom)
+
+			_, ok := ctx.Addresses.Get("celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8")
+			require.Equal(t, tt.wantAddress, ok)
+		})
+	}
+}
+
+func Test_handleIbc_Acknowledgement(t *testing.T) {
+	ctx := context.NewContext()
+	events := []storage.Event{
+		{
+			Type: types.EventTypeMessage,
+			Data: map[string]any{"action": "/ibc.core.channel.v1.MsgAcknowledgement"},
+		}, {
+			Type: types.EventTypeAcknowledgePacket,
+			Data: testPacket("12", ""),
+		}, {
+			Type: types.EventTypeMessage,
+			Data: map[string]any{"module": "ibc_channel"},
+		}, {
+			Type: types.EventTypeFungibleTokenPacket,
+			Data: map[string]any{"module": "transfer", "acknowledgement": "result:\"\\001\" "},
+		}, {
+			Type: types.EventTypeFungibleTokenPacket,
+			Data: map[string]any{"success": "\x01"},
+		},
+	}
+	msg := &storage.Message{
+		Type:   types.MsgAcknowledgement,
+		Height: 1001,
+		Time:   time.Now(),
+	}
+	idx := testsuite.Ptr(0)
+
+	err := handleIbc(ctx, events, msg, idx)
+	require.NoError(t, err)
+
+	require.NotNil(t, msg.IbcTransfer)
+	require.True(t, msg.IbcTransfer.IsCompletion())
+	require.EqualValues(t, types.IbcTransferStatusAcknowledged, msg.IbcTransfer.Status)
+	require.EqualValues(t, 12, msg.IbcTransfer.Sequence)
+	require.EqualValues(t, "channel-2", msg.IbcTransfer.ChannelId)
+	require.EqualValues(t, "transfer", msg.IbcTransfer.Port)
+	require.NotNil(t, msg.IbcTransfer.CompletedHeight)
+	require.EqualValues(t, 1001, *msg.IbcTransfer.CompletedHeight)
+}
+
+func Test_handleIbc_Timeout(t *testing.T) {
+	tests := []struct {
+		name    string
+		events  []storage.Event
+		wantNil bool
+	}{
+		{
+			name: "timeout",
+			events: []storage.Event{
+				{
+					Type: types.EventTypeMessage,
+					Data: map[string]any{"action": "/ibc.core.channel.v1.MsgTimeout"},
+				}, {
+					Type: types.EventTypeTimeout,
+					Data: map[string]any{
+						"module":          "transfer",
+						"refund_amount":   "1000000",
+						"refund_denom":    "utia",
+						"refund_receiver": "celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8",
+					},
+				}, {
+					Type: types.EventTypeTimeoutPacket,
+					Data: testPacket("12", ""),
+				},
+			},
+		}, {
+			name: "redundant relay",
+			events: []storage.Event{
+				{
+					Type: types.EventTypeMessage,
+					Data: map[string]any{"action": "/ibc.core.channel.v1.MsgTimeout"},
+				}, {
+					Type: types.EventTypeTimeoutPacket,
+					Data: testPacket("12", ""),
+				},
+			},
+			wantNil: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.NewContext()
+			msg := &storage.Message{
+				Type:   types.MsgTimeout,
+				Height: 1001,
+				Time:   time.Now(),
+			}
+			idx := testsuite.Ptr(0)
+
+			err := handleIbc(ctx, tt.events, msg, idx)
+			require.NoError(t, err)
+
+			if tt.wantNil {
+				require.Nil(t, msg.IbcTransfer)
+				return
+			}
+			require.NotNil(t, msg.IbcTransfer)
+			require.EqualValues(t, types.IbcTransferStatusTimeout, msg.IbcTransfer.Status)
+			require.EqualValues(t, 12, msg.IbcTransfer.Sequence)
+			require.EqualValues(t, "channel-2", msg.IbcTransfer.ChannelId)
+		})
+	}
+}
+
+func Test_handleIbc_Handshake(t *testing.T) {
+	ts := time.Now()
+
+	t.Run("create client", func(t *testing.T) {
+		msg := &storage.Message{
+			Type:   types.MsgCreateClient,
+			Height: 100,
+			Time:   ts,
+			IbcClient: &storage.IbcClient{
+				Height:    100,
+				CreatedAt: ts,
+				ChainId:   "osmosis-1",
+			},
+		}
+		events := []storage.Event{
+			{
+				Type: types.EventTypeMessage,
+				Data: map[string]any{"action": "/ibc.core.client.v1.MsgCreateClient"},
+			}, {
+				Type: types.EventTypeCreateClient,
+				Data: map[string]any{
+					"client_id":        "07-tendermint-0",
+					"client_type":      "07-tendermint",
+					"consensus_height": "1-100",
+				},
+			},
+		}
+		err := handleIbc(context.NewContext(), events, msg, testsuite.Ptr(0))
+		require.NoError(t, err)
+		require.NotNil(t, msg.IbcClient)
+		require.Equal(t, "07-tendermint-0", msg.IbcClient.Id)
+		require.Equal(t, "07-tendermint", msg.IbcClient.Type)
+		require.Equal(t, "osmosis-1", msg.IbcClient.ChainId)
+	})
+
+	t.Run("connection open ack", func(t *testing.T) {
+		msg := &storage.Message{
+			Type:   types.MsgConnectionOpenAck,
+			Height: 101,
+			Time:   ts,
+		}
+		events := []storage.Event{
+			{
+				Type: types.EventTypeMessage,
+				Data: map[string]any{"action": "/ibc.core.connection.v1.MsgConnectionOpenAck"},
+			}, {
+				Type: types.EventTypeConnectionOpenAck,
+				Data: map[string]any{
+					"client_id":                  "07-tendermint-0",
+					"connection_id":              "connection-0",
+					"counterparty_client_id":     "07-tendermint-3012",
+					"counterparty_connection_id": "connection-2503",
+				},
+			},
+		}
+		err := handleIbc(context.NewContext(), events, msg, testsuite.Ptr(0))
+		require.NoError(t, err)
+		require.NotNil(t, msg.IbcConnection)
+		require.True(t, msg.IbcConnection.Opened())
+		require.Equal(t, "connection-0", msg.IbcConnection.Id)
+		require.Equal(t, "connection-2503", msg.IbcConnection.CounterpartyConnectionId)
+		require.EqualValues(t, 101, *msg.IbcConnection.ConnectionHeight)
+	})
+
+	t.Run("channel open init", func(t *testing.T) {
+		msg := &storage.Message{
+			Type:   types.MsgChannelOpenInit,
+			Height: 102,
+			Time:   ts,
+		}
+		events := []storage.Event{
+			{
+				Type: types.EventTypeMessage,
+				Data: map[string]any{"action": "/ibc.core.channel.v1.MsgChannelOpenInit"},
+			}, {
+				Type: types.EventTypeChannelOpenInit,
+				Data: map[string]any{
+					"channel_id":              "channel-0",
+					"connection_id":           "connection-0",
+					"counterparty_channel_id": "",
+					"counterparty_port_id":    "transfer",
+					"port_id":                 "transfer",
+					"version":                 "ics20-1",
+				},
+			},
+		}
+		err := handleIbc(context.NewContext(), events, msg, testsuite.Ptr(0))
+		require.NoError(t, err)
+		require.NotNil(t, msg.IbcChannel)
+		require.Equal(t, "channel-0", msg.IbcChannel.Id)
+		require.Equal(t, "ics20-1", msg.IbcChannel.Version)
+		require.Equal(t, types.IbcChannelStatusInitialization, msg.IbcChannel.Status)
+		require.Nil(t, msg.IbcChannel.ConfirmationHeight)
+	})
+
+	t.Run("unexpected action", func(t *testing.T) {
+		msg := &storage.Message{
+			Type: types.MsgChannelOpenInit,
+		}
+		events := []storage.Event{
+			{
+				Type: types.EventTypeMessage,
+				Data: map[string]any{"action": "/cosmos.bank.v1beta1.MsgSend"},
+			},
+		}
+		err := handleIbc(context.NewContext(), events, msg, testsuite.Ptr(0))
+		require.Error(t, err)
+	})
+}
+
+func Test_handleIbc_InterchainAccount(t *testing.T) {
+	ts := time.Now()
+
+	t.Run("channel open try", func(t *testing.T) {
+		ctx := context.NewContext()
+		msg := &storage.Message{
+			Type:   types.MsgChannelOpenTry,
+			Height: 103,
+			Time:   ts,
+		}
+		events := []storage.Event{
+			{
+				Type: types.EventTypeMessage,
+				Data: map[string]any{"action": "/ibc.core.channel.v1.MsgChannelOpenTry"},
+			}, {
+				Type: types.EventTypeChannelOpenTry,
+				Data: map[string]any{
+					"channel_id":              "channel-5",
+					"connection_id":           "connection-0",
+					"counterparty_channel_id": "channel-9",
+					"counterparty_port_id":    "icacontroller-osmo1mm8yykm46ec3t0dgwls70g0jvtm055wkuvxanc",
+					"port_id":                 "icahost",
+					"version":                 testIcaVersion,
+				},
+			},
+		}
+		err := handleIbc(ctx, events, msg, testsuite.Ptr(0))
+		require.NoError(t, err)
+		require.NotNil(t, msg.IbcChannel)
+		require.NotNil(t, msg.InterchainAccount)
+
+		ica := msg.InterchainAccount
+		require.Equal(t, "celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8", ica.Address)
+		require.Equal(t, "osmo1mm8yykm46ec3t0dgwls70g0jvtm055wkuvxanc", ica.Owner)
+		require.Equal(t, "connection-0", ica.ConnectionId)
+		require.Equal(t, "connection-1", ica.ControllerConnectionId)
+		require.Equal(t, "channel-5", ica.ChannelId)
+		require.Equal(t, "channel-9", ica.CounterpartyChannelId)
+		require.EqualValues(t, 103, ica.Height)
+
+		_, ok := ctx.Addresses.Get("celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8")
+		require.True(t, ok)
+	})
+
+	icaPacket := func(ack string) []storage.Event {
+		packet := testPacket("4", testIcaPacket)
+		packet["packet_dst_port"] = "icahost"
+		packet["packet_dst_channel"] = "channel-5"
+		packet["packet_src_port"] = "icacontroller-osmo1mm8yykm46ec3t0dgwls70g0jvtm055wkuvxanc"
+		packet["packet_connection"] = "connection-0"
+
+		return []storage.Event{
+			{
+				Type: types.EventTypeMessage,
+				Data: map[string]any{"action": "/ibc.core.channel.v1.MsgRecvPacket"},
+			}, {
+				Type: types.EventTypeRecvPacket,
+				Data: packet,
+			}, {
+				Type: types.EventTypeMessage,
+				Data: map[string]any{"module": "ibc_channel"},
+			}, {
+				Type: types.EventTypeWriteAcknowledgement,
+				Data: map[string]any{"packet_ack": ack},
+			},
+		}
+	}
+
+	t.Run("packet success", func(t *testing.T) {
+		msg := &storage.Message{
+			Type:   types.MsgRecvPacket,
+			Height: 104,
+			Time:   ts,
+		}
+		err := handleIbc(context.NewContext(), icaPacket(`{"result":"EgA="}`), msg, testsuite.Ptr(0))
+		require.NoError(t, err)
+		require.Nil(t, msg.IbcTransfer)
+		require.NotNil(t, msg.InterchainAccountPacket)
+
+		packet := msg.InterchainAccountPacket
+		require.Equal(t, "connection-0", packet.ConnectionId)
+		require.Equal(t, "channel-5", packet.ChannelId)
+		require.Equal(t, "osmo1mm8yykm46ec3t0dgwls70g0jvtm055wkuvxanc", packet.Owner)
+		require.EqualValues(t, 4, packet.Sequence)
+		require.Equal(t, "memo", packet.Memo)
+		require.Equal(t, []string{"/cosmos.staking.v1beta1.MsgDelegate", "/cosmos.bank.v1beta1.MsgSend"}, packet.Messages)
+		require.True(t, packet.Success)
+		require.Empty(t, packet.Error)
+	})
+
+	t.Run("packet error", func(t *testing.T) {
+		msg := &storage.Message{
+			Type:   types.MsgRecvPacket,
+			Height: 104,
+			Time:   ts,
+		}
+		err := handleIbc(context.NewContext(), icaPacket(`{"error":"ABCI code: 5: error handling packet: see events for details"}`), msg, testsuite.Ptr(0))
+		require.NoError(t, err)
+		require.NotNil(t, msg.InterchainAccountPacket)
+		require.False(t, msg.InterchainAccountPacket.Success)
+		require.Equal(t, "ABCI code: 5: error handling packet: see events for details", msg.InterchainAccountPacket.Error)
+	})
+}
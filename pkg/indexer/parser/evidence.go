@@ -0,0 +1,25 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package parser
+
+import (
+	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode/context"
+	"github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// parseEvidences - saves infraction heights of validators which double signed according to block evidences
+func parseEvidences(ctx *context.Context, data types.EvidenceData) error {
+	for i := range data.Evidence {
+		evidence, ok, err := data.Evidence[i].DuplicateVote()
+		if err != nil {
+			return errors.Wrapf(err, "evidence %d", i)
+		}
+		if !ok || evidence.VoteA == nil {
+			continue
+		}
+		ctx.AddInfraction(evidence.VoteA.ValidatorAddress.String(), types.Level(evidence.VoteA.Height))
+	}
+	return nil
+}
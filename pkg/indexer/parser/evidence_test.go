@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package parser
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode/context"
+	"github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseEvidences(t *testing.T) {
+	ctx := context.NewContext()
+	ctx.Block = &storage.Block{
+		Height: 1000,
+	}
+
+	data := types.EvidenceData{
+		Evidence: []types.Evidence{
+			{
+				Type:  types.EvidenceTypeDuplicateVote,
+				Value: json.RawMessage(`{"vote_a":{"height":"995","validator_address":"81A24EE534DEFE1557A4C7C437E8E8FBC2F834E8"},"vote_b":{"height":"995","validator_address":"81A24EE534DEFE1557A4C7C437E8E8FBC2F834E8"},"TotalVotingPower":"1000","ValidatorPower":"10"}`),
+			}, {
+				Type:  "tendermint/LightClientAttackEvidence",
+				Value: json.RawMessage(`{}`),
+			},
+		},
+	}
+	require.NoError(t, parseEvidences(ctx, data))
+
+	ctx.AddSlash(&storage.Slash{
+		Reason: "double_sign",
+		Validator: &storage.Validator{
+			ConsAddress: "81A24EE534DEFE1557A4C7C437E8E8FBC2F834E8",
+		},
+	})
+	require.Len(t, ctx.Slashes, 1)
+	require.EqualValues(t, 995, ctx.Slashes[0].InfractionHeight)
+
+	err := parseEvidences(ctx, types.EvidenceData{
+		Evidence: []types.Evidence{
+			{
+				Type:  types.EvidenceTypeDuplicateVote,
+				Value: json.RawMessage(`invalid`),
+			},
+		},
+	})
+	require.Error(t, err)
+}
@@ -63,7 +63,18 @@ func (p *Module) parse(b types.BlockData) error {
 		},
 	}
 
-	txs, err := p.parseTxs(decodeCtx, b)
+	if err := parseEvidences(decodeCtx, b.Block.Evidence); err != nil {
+		return errors.Wrapf(err, "while parsing evidences on level=%d", b.Height)
+	}
+
+	beginEvents, err := parseEvents(decodeCtx, b, b.ResultBlockResults.BeginBlockEvents)
+	if err != nil {
+		return errors.Wrap(err, "parsing begin block events")
+	}
+	decodeCtx.FinishBeginBlock()
+
+	bs := newBlockSquare(b.Block)
+	txs, err := p.parseTxs(decodeCtx, b, bs)
 	if err != nil {
 		return errors.Wrapf(err, "while parsing block on level=%d", b.Height)
 	}
@@ -73,18 +84,24 @@ func (p *Module) parse(b types.BlockData) error {
 		decodeCtx.Block.Stats.BytesInBlock += int64(len(b.Block.Txs[i]))
 	}
 
-	decodeCtx.Block.BlockSignatures = p.parseBlockSignatures(b.Block.LastCommit)
-
-	decodeCtx.Block.Events, err = parseEvents(decodeCtx, b, b.ResultBlockResults.BeginBlockEvents)
-	if err != nil {
-		return errors.Wrap(err, "parsing begin block events")
+	if p.cfg.VerifyDah {
+		decodeCtx.Block.Dah, err = p.parseDah(b, bs)
+		if err != nil {
+			return errors.Wrapf(err, "while computing data availability header on level=%d", b.Height)
+		}
 	}
 
+	decodeCtx.Block.BlockSignatures = p.parseBlockSignatures(b.Block.LastCommit)
+	decodeCtx.Block.MissedBlocks = p.parseMissedBlocks(b.Block.LastCommit, b.Block.Time)
+
+	decodeCtx.SetTx(nil)
+	decodeCtx.SetBalanceCause(storageTypes.BalanceHistoryTypeTransfer)
+	decodeCtx.StartBalancePhase()
 	endEvents, err := parseEvents(decodeCtx, b, b.ResultBlockResults.EndBlockEvents)
 	if err != nil {
 		return errors.Wrap(err, "parsing begin end events")
 	}
-	decodeCtx.Block.Events = append(decodeCtx.Block.Events, endEvents...)
+	decodeCtx.Block.Events = append(beginEvents, endEvents...)
 
 	p.Log.Info().
 		Uint64("height", uint64(decodeCtx.Block.Height)).
@@ -115,3 +132,20 @@ func (p *Module) parseBlockSignatures(commit *types.Commit) []storage.BlockSigna
 	}
 	return signs
 }
+
+func (p *Module) parseMissedBlocks(commit *types.Commit, blockTime time.Time) []storage.MissedBlock {
+	missed := make([]storage.MissedBlock, 0)
+	for i := range commit.Signatures {
+		if commit.Signatures[i].BlockIDFlag != 1 {
+			continue
+		}
+		missed = append(missed, storage.MissedBlock{
+			Height: types.Level(commit.Height),
+			Time:   blockTime,
+			Validator: &storage.Validator{
+				ConsAddress: strings.ToUpper(hex.EncodeToString(commit.Signatures[i].ValidatorAddress)),
+			},
+		})
+	}
+	return missed
+}
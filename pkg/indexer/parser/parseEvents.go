@@ -9,6 +9,7 @@ import (
 	"github.com/celenium-io/celestia-indexer/internal/storage"
 	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
 	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode/context"
+	"github.com/celenium-io/celestia-indexer/pkg/indexer/decode/decoder"
 	"github.com/celenium-io/celestia-indexer/pkg/types"
 )
 
@@ -53,8 +54,15 @@ func processEvent(ctx *context.Context, event *storage.Event) error {
 		ctx.AddSupply(event.Data)
 	case storageTypes.EventTypeCoinReceived:
 		return parseCoinReceived(ctx, event.Data, event.Height)
+	case storageTypes.EventTypeMessage:
+		if action := decoder.StringFromMap(event.Data, "action"); action != "" {
+			ctx.SetBalanceCause(balanceCauseByAction(action))
+		}
 	case storageTypes.EventTypeCoinSpent:
+		ctx.SetEventBalanceCause(balanceCauseBySpender(decoder.StringFromMap(event.Data, "spender")))
 		return parseCoinSpent(ctx, event.Data, event.Height)
+	case storageTypes.EventTypeTransfer:
+		return parseTransfer(ctx, event)
 	case storageTypes.EventTypeCompleteUnbonding:
 		return parseCompleteUnbonding(ctx, event.Data, event.Height)
 	case storageTypes.EventTypeCommission:
@@ -65,6 +73,16 @@ func processEvent(ctx *context.Context, event *storage.Event) error {
 		return parseRewards(ctx, event.Data)
 	case storageTypes.EventTypeSlash:
 		return parseSlash(ctx, event.Data)
+	case storageTypes.EventTypeActiveProposal, storageTypes.EventTypeInactiveProposal:
+		return parseProposalStatus(ctx, event.Data)
+	case storageTypes.EventTypeAttestationRequest:
+		return parseAttestationRequest(ctx, event.Data)
+	case storageTypes.EventTypeCosmosgroupv1EventExec:
+		return parseGroupExec(ctx, event.Data)
+	case storageTypes.EventTypeCosmosgroupv1EventWithdrawProposal:
+		return parseGroupWithdrawProposal(ctx, event.Data)
+	case storageTypes.EventTypeCosmosgroupv1EventProposalPruned:
+		return parseGroupProposalPruned(ctx, event.Data)
 	}
 
 	return nil
@@ -122,3 +122,98 @@ func BenchmarkParseEvent(b *testing.B) {
 		}
 	})
 }
+
+func TestProcessEvents_BalanceHistory(t *testing.T) {
+	const (
+		delegator = "celestia1p330stapusykfss47qrhqlukjncvgyzf6gdufs"
+		collector = "celestia17xpfvakm2amg962yls6f84z3kell8c5lpnjs3s"
+	)
+	distribution := moduleAddress("distribution")
+	bonded := moduleAddress("bonded_tokens_pool")
+
+	ctx := context.NewContext()
+	ctx.Block = &storage.Block{
+		Height: 100,
+		Time:   time.Now(),
+	}
+	ctx.SetBalanceCause(storageTypes.BalanceHistoryTypeFee)
+
+	events := []storage.Event{
+		{
+			Type: storageTypes.EventTypeCoinSpent,
+			Data: map[string]any{"spender": delegator, "amount": "100utia"},
+		}, {
+			Type: storageTypes.EventTypeCoinReceived,
+			Data: map[string]any{"receiver": collector, "amount": "100utia"},
+		}, {
+			Type: storageTypes.EventTypeMessage,
+			Data: map[string]any{"action": "/cosmos.staking.v1beta1.MsgDelegate"},
+		}, {
+			Type: storageTypes.EventTypeCoinSpent,
+			Data: map[string]any{"spender": distribution, "amount": "10utia"},
+		}, {
+			Type: storageTypes.EventTypeCoinReceived,
+			Data: map[string]any{"receiver": delegator, "amount": "10utia"},
+		}, {
+			Type: storageTypes.EventTypeCoinSpent,
+			Data: map[string]any{"spender": delegator, "amount": "1000utia"},
+		}, {
+			Type: storageTypes.EventTypeCoinReceived,
+			Data: map[string]any{"receiver": bonded, "amount": "1000utia"},
+		},
+	}
+	for i := range events {
+		events[i].Height = 100
+		require.NoError(t, processEvent(ctx, &events[i]))
+	}
+
+	got := make(map[string]string)
+	for _, h := range ctx.BalanceHistory {
+		require.EqualValues(t, 100, h.Height)
+		got[h.Address.Address+"/"+h.Type.String()] = h.SpendableChange.String()
+	}
+	require.Equal(t, map[string]string{
+		delegator + "/fee":        "-100",
+		collector + "/fee":        "100",
+		distribution + "/reward":  "-10",
+		delegator + "/reward":     "10",
+		delegator + "/delegation": "-1000",
+		bonded + "/delegation":    "1000",
+	}, got)
+}
+
+func TestProcessEvents_Transfer(t *testing.T) {
+	ctx := context.NewContext()
+	tx := &storage.Tx{Hash: []byte{0x01}}
+	ctx.SetTx(tx)
+
+	event := storage.Event{
+		Height: 100,
+		Time:   time.Now(),
+		Type:   storageTypes.EventTypeTransfer,
+		Data: map[string]any{
+			"recipient": "celestia17xpfvakm2amg962yls6f84z3kell8c5lpnjs3s",
+			"sender":    "celestia1p330stapusykfss47qrhqlukjncvgyzf6gdufs",
+			"amount":    "5ibc/C4CFF46FD6DE35CA4CF4CE031E643C8FDC9BA4B99AE598E9B0ED98FE3A2319F9,100utia",
+		},
+	}
+	require.NoError(t, processEvent(ctx, &event))
+	require.Len(t, ctx.Transfers, 2)
+
+	for _, transfer := range ctx.Transfers {
+		require.EqualValues(t, 100, transfer.Height)
+		require.Equal(t, event.Time, transfer.Time)
+		require.Equal(t, tx, transfer.Tx)
+		require.Equal(t, "celestia1p330stapusykfss47qrhqlukjncvgyzf6gdufs", transfer.Sender.Address)
+		require.Equal(t, "celestia17xpfvakm2amg962yls6f84z3kell8c5lpnjs3s", transfer.Receiver.Address)
+	}
+	require.Equal(t, "5", ctx.Transfers[0].Amount.String())
+	require.Equal(t, "ibc/C4CFF46FD6DE35CA4CF4CE031E643C8FDC9BA4B99AE598E9B0ED98FE3A2319F9", ctx.Transfers[0].Denom)
+	require.Equal(t, "100", ctx.Transfers[1].Amount.String())
+	require.Equal(t, "utia", ctx.Transfers[1].Denom)
+
+	ctx.SetTx(nil)
+	require.NoError(t, processEvent(ctx, &event))
+	require.Len(t, ctx.Transfers, 4)
+	require.Nil(t, ctx.Transfers[3].Tx)
+}
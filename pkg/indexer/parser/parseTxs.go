@@ -16,11 +16,11 @@ import (
 	"github.com/shopspring/decimal"
 )
 
-func (p *Module) parseTxs(ctx *context.Context, b types.BlockData) ([]storage.Tx, error) {
+func (p *Module) parseTxs(ctx *context.Context, b types.BlockData, bs *blockSquare) ([]storage.Tx, error) {
 	txs := make([]storage.Tx, len(b.TxsResults))
 
 	for i := range b.TxsResults {
-		if err := p.parseTx(ctx, b, i, b.TxsResults[i], &txs[i]); err != nil {
+		if err := p.parseTx(ctx, b, i, b.TxsResults[i], bs, &txs[i]); err != nil {
 			return nil, err
 		}
 	}
@@ -28,7 +28,7 @@ func (p *Module) parseTxs(ctx *context.Context, b types.BlockData) ([]storage.Tx
 	return txs, nil
 }
 
-func (p *Module) parseTx(ctx *context.Context, b types.BlockData, index int, txRes *types.ResponseDeliverTx, t *storage.Tx) error {
+func (p *Module) parseTx(ctx *context.Context, b types.BlockData, index int, txRes *types.ResponseDeliverTx, bs *blockSquare, t *storage.Tx) error {
 	d, err := decode.Tx(b, index)
 	if err != nil {
 		return errors.Wrapf(err, "while parsing Tx on index %d", index)
@@ -51,6 +51,8 @@ func (p *Module) parseTx(ctx *context.Context, b types.BlockData, index int, txR
 	t.Messages = make([]storage.Message, len(d.Messages))
 	t.Events = nil
 	t.Signers = make([]storage.Address, 0)
+	t.FeePayer = nil
+	t.FeeGranter = nil
 	t.BlobsSize = 0
 	t.BytesSize = int64(len(txRes.Data))
 
@@ -73,11 +75,26 @@ func (p *Module) parseTx(ctx *context.Context, b types.BlockData, index int, txR
 		}
 	}
 
+	if d.FeePayer != "" {
+		t.FeePayer, err = feeAddress(ctx, d.FeePayer, t.Height)
+		if err != nil {
+			return errors.Wrap(err, "fee payer")
+		}
+	}
+	if d.FeeGranter != "" {
+		t.FeeGranter, err = feeAddress(ctx, d.FeeGranter, t.Height)
+		if err != nil {
+			return errors.Wrap(err, "fee granter")
+		}
+	}
+
 	if txRes.IsFailed() {
 		t.Status = storageTypes.StatusFailed
 		t.Error = txRes.Log
 	}
 
+	ctx.SetTx(t)
+	ctx.SetBalanceCause(storageTypes.BalanceHistoryTypeFee)
 	t.Events, err = parseEvents(ctx, b, txRes.Events)
 	if err != nil {
 		return errors.Wrap(err, "parsing events")
@@ -103,14 +120,23 @@ func (p *Module) parseTx(ctx *context.Context, b types.BlockData, index int, txR
 		}
 
 		processBlob(dm.Msg.BlobLogs, d, t)
+		if !txRes.IsFailed() {
+			p.decodeBlobs(dm.Msg.BlobLogs, d)
+		}
+		if err := bs.setShares(index, dm.Msg.BlobLogs); err != nil {
+			p.Log.Err(err).
+				Uint64("height", uint64(b.Height)).
+				Int64("position", t.Position).
+				Msg("can't compute blob shares, share range is left unset")
+		}
 
 		if txRes.IsFailed() {
-			dm.Msg.Namespace = nil
+			clearNamespaces(&dm.Msg)
 			dm.BlobsSize = 0
 		}
 
 		t.Messages[i] = dm.Msg
-		t.MessageTypes.SetByMsgType(dm.Msg.Type)
+		setMessageTypes(&t.MessageTypes, &t.Messages[i])
 		t.BlobsSize += dm.BlobsSize
 
 		if !txRes.IsFailed() {
@@ -133,3 +159,35 @@ func (p *Module) parseTx(ctx *context.Context, b types.BlockData, index int, txR
 
 	return nil
 }
+
+func feeAddress(ctx *context.Context, address types.Address, height types.Level) (*storage.Address, error) {
+	addr := storage.Address{
+		Address:    address.String(),
+		Height:     height,
+		LastHeight: height,
+		Balance: storage.Balance{
+			Currency:  currency.DefaultCurrency,
+			Spendable: decimal.Zero,
+			Delegated: decimal.Zero,
+			Unbonding: decimal.Zero,
+		},
+	}
+	if err := ctx.AddAddress(&addr); err != nil {
+		return nil, err
+	}
+	return &addr, nil
+}
+
+func clearNamespaces(msg *storage.Message) {
+	msg.Namespace = nil
+	for i := range msg.InternalMsgs {
+		clearNamespaces(msg.InternalMsgs[i])
+	}
+}
+
+func setMessageTypes(mask *storageTypes.MsgTypeBits, msg *storage.Message) {
+	mask.SetByMsgType(msg.Type)
+	for i := range msg.InternalMsgs {
+		setMessageTypes(mask, msg.InternalMsgs[i])
+	}
+}
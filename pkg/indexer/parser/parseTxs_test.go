@@ -23,9 +23,9 @@ func TestParseTxs_EmptyTxsResults(t *testing.T) {
 		},
 	}
 
-	p := NewModule(config.Indexer{})
+	p := NewModule(config.Indexer{}, nil, nil)
 	decodeCtx := context.NewContext()
-	resultTxs, err := p.parseTxs(decodeCtx, block)
+	resultTxs, err := p.parseTxs(decodeCtx, block, newBlockSquare(block.Block))
 
 	assert.NoError(t, err)
 	assert.Empty(t, resultTxs)
@@ -267,8 +267,8 @@ func TestParseTxs_SuccessTx(t *testing.T) {
 		Time:         now,
 		MessageTypes: storageTypes.NewMsgTypeBitMask(),
 	}
-	p := NewModule(config.Indexer{})
-	resultTxs, err := p.parseTxs(decodeCtx, block)
+	p := NewModule(config.Indexer{}, nil, nil)
+	resultTxs, err := p.parseTxs(decodeCtx, block, newBlockSquare(block.Block))
 
 	assert.NoError(t, err)
 	assert.Len(t, resultTxs, 3)
@@ -302,8 +302,8 @@ func TestParseTxs_FailedTx(t *testing.T) {
 		MessageTypes: storageTypes.NewMsgTypeBitMask(),
 	}
 
-	p := NewModule(config.Indexer{})
-	resultTxs, err := p.parseTxs(decodeCtx, block)
+	p := NewModule(config.Indexer{}, nil, nil)
+	resultTxs, err := p.parseTxs(decodeCtx, block, newBlockSquare(block.Block))
 
 	assert.NoError(t, err)
 	assert.Len(t, resultTxs, 1)
@@ -337,8 +337,8 @@ func TestParseTxs_FailedTxWithNonstandardErrorCode(t *testing.T) {
 		MessageTypes: storageTypes.NewMsgTypeBitMask(),
 	}
 
-	p := NewModule(config.Indexer{})
-	resultTxs, err := p.parseTxs(decodeCtx, block)
+	p := NewModule(config.Indexer{}, nil, nil)
+	resultTxs, err := p.parseTxs(decodeCtx, block, newBlockSquare(block.Block))
 
 	assert.NoError(t, err)
 	assert.Len(t, resultTxs, 1)
@@ -6,6 +6,8 @@ package parser
 import (
 	"context"
 
+	"github.com/celenium-io/celestia-indexer/internal/blob/decoder"
+	"github.com/celenium-io/celestia-indexer/internal/storage"
 	"github.com/celenium-io/celestia-indexer/pkg/indexer/config"
 	"github.com/dipdup-net/indexer-sdk/pkg/modules"
 )
@@ -13,7 +15,10 @@ import (
 type Module struct {
 	modules.BaseModule
 
-	cfg config.Indexer
+	cfg      config.Indexer
+	decoders *decoder.Registry
+	rollups  storage.IRollup
+	stacks   *rollupStacks
 }
 
 var _ modules.Module = (*Module)(nil)
@@ -25,10 +30,14 @@ const (
 	StopOutput      = "stop"
 )
 
-func NewModule(cfg config.Indexer) Module {
+// NewModule - creates parser module. Blob payloads are decoded by passed registry if decoding is enabled in config.
+func NewModule(cfg config.Indexer, decoders *decoder.Registry, rollups storage.IRollup) Module {
 	m := Module{
 		BaseModule: modules.New("parser"),
 		cfg:        cfg,
+		decoders:   decoders,
+		rollups:    rollups,
+		stacks:     newRollupStacks(),
 	}
 	m.CreateInputWithCapacity(InputName, 32)
 	m.CreateOutput(OutputName)
@@ -40,6 +49,10 @@ func NewModule(cfg config.Indexer) Module {
 
 func (p *Module) Start(ctx context.Context) {
 	p.Log.Info().Msg("starting parser module...")
+	if p.decodingEnabled() && p.rollups != nil {
+		p.refreshStacks(ctx)
+		p.G.GoCtx(ctx, p.syncStacks)
+	}
 	p.G.GoCtx(ctx, p.listen)
 }
 
@@ -28,7 +28,7 @@ func createModules(t *testing.T) (modules.BaseModule, string, Module) {
 	writerModule := modules.New("writer-module")
 	outputName := "write"
 	writerModule.CreateOutput(outputName)
-	parserModule := NewModule(config.Indexer{})
+	parserModule := NewModule(config.Indexer{}, nil, nil)
 
 	err := parserModule.AttachTo(&writerModule, outputName, InputName)
 	assert.NoError(t, err)
@@ -67,6 +67,15 @@ func getExpectedBlock() storage.Block {
 				Time: time.Time{},
 			},
 		},
+		MissedBlocks: []storage.MissedBlock{
+			{
+				Height: 999,
+				Validator: &storage.Validator{
+					ConsAddress: "0011",
+				},
+				Time: time.Time{},
+			},
+		},
 		Stats: storage.BlockStats{
 			Id:            0,
 			Height:        100,
@@ -124,6 +133,10 @@ func getBlock() types.BlockData {
 							ValidatorAddress: testHashAddress,
 							Timestamp:        time.Time{},
 							Signature:        testsuite.MustHexDecode("0011"),
+						}, {
+							BlockIDFlag:      tmTypes.BlockIDFlagAbsent,
+							ValidatorAddress: testsuite.MustHexDecode("0011"),
+							Timestamp:        time.Time{},
 						},
 					},
 				},
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package parser
+
+import (
+	"context"
+	"encoding/base64"
+	"sync"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+)
+
+const stacksRefreshPeriod = time.Minute
+
+// rollupStacks - stacks of known rollups keyed by signer address and base64-encoded namespace.
+// Empty namespace in the key means that the rollup pushes blobs to any namespace.
+type rollupStacks struct {
+	mx     sync.RWMutex
+	stacks map[string]string
+}
+
+func newRollupStacks() *rollupStacks {
+	return &rollupStacks{
+		stacks: make(map[string]string),
+	}
+}
+
+func stackKey(address, namespace string) string {
+	return address + "/" + namespace
+}
+
+func (rs *rollupStacks) set(stacks []storage.RollupStack) {
+	m := make(map[string]string, len(stacks))
+	for i := range stacks {
+		var namespace string
+		if len(stacks[i].NamespaceId) > 0 {
+			namespace = base64.StdEncoding.EncodeToString(append([]byte{stacks[i].Version}, stacks[i].NamespaceId...))
+		}
+		m[stackKey(stacks[i].Address, namespace)] = stacks[i].Stack
+	}
+
+	rs.mx.Lock()
+	rs.stacks = m
+	rs.mx.Unlock()
+}
+
+// get - returns stack of the rollup which pushed blob from the address to the namespace. Returns empty string if rollup is unknown.
+func (rs *rollupStacks) get(address, namespace string) string {
+	rs.mx.RLock()
+	defer rs.mx.RUnlock()
+
+	if stack, ok := rs.stacks[stackKey(address, namespace)]; ok {
+		return stack
+	}
+	return rs.stacks[stackKey(address, "")]
+}
+
+func (p *Module) refreshStacks(ctx context.Context) {
+	stacks, err := p.rollups.Stacks(ctx)
+	if err != nil {
+		p.Log.Err(err).Msg("receiving rollup stacks")
+		return
+	}
+	p.stacks.set(stacks)
+}
+
+// syncStacks - periodically reloads stacks of rollups, because rollups can be added or changed while indexer is running
+func (p *Module) syncStacks(ctx context.Context) {
+	ticker := time.NewTicker(stacksRefreshPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.refreshStacks(ctx)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package rollback
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// rollbackConstants - removes constant values which became effective at the height and restores previous values
+func rollbackConstants(ctx context.Context, tx storage.Transaction, height types.Level) error {
+	history, err := tx.RollbackConstantHistory(ctx, height)
+	if err != nil {
+		return err
+	}
+	if len(history) == 0 {
+		return nil
+	}
+
+	restored := make(map[string]struct{})
+	constants := make([]storage.Constant, 0, len(history))
+	for i := range history {
+		key := string(history[i].Module) + "." + history[i].Name
+		if _, ok := restored[key]; ok {
+			continue
+		}
+		restored[key] = struct{}{}
+
+		last, err := tx.LastConstant(ctx, history[i].Module, history[i].Name)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			return errors.Wrap(err, "receiving last constant value")
+		}
+		constants = append(constants, last.Constant())
+	}
+
+	return tx.SaveConstants(ctx, constants...)
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package rollback
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_rollbackConstants(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tx := mock.NewMockTransaction(ctrl)
+	ctx := context.Background()
+
+	tx.EXPECT().
+		RollbackConstantHistory(ctx, pkgTypes.Level(100)).
+		Return([]storage.ConstantHistory{
+			{Height: 100, Module: types.ModuleNameSlashing, Name: "slash_fraction_downtime", Value: "0.01"},
+			{Height: 100, Module: types.ModuleNameSlashing, Name: "slash_fraction_downtime", Value: "0.02"},
+			{Height: 100, Module: types.ModuleNameConsensus, Name: "app_version", Value: "2"},
+		}, nil).
+		Times(1)
+
+	tx.EXPECT().
+		LastConstant(ctx, types.ModuleNameSlashing, "slash_fraction_downtime").
+		Return(storage.ConstantHistory{Height: 1, Module: types.ModuleNameSlashing, Name: "slash_fraction_downtime", Value: "0.0001"}, nil).
+		Times(1)
+
+	tx.EXPECT().
+		LastConstant(ctx, types.ModuleNameConsensus, "app_version").
+		Return(storage.ConstantHistory{}, sql.ErrNoRows).
+		Times(1)
+
+	tx.EXPECT().
+		SaveConstants(ctx, storage.Constant{
+			Module: types.ModuleNameSlashing,
+			Name:   "slash_fraction_downtime",
+			Value:  "0.0001",
+		}).
+		Return(nil).
+		Times(1)
+
+	err := rollbackConstants(ctx, tx, 100)
+	require.NoError(t, err)
+}
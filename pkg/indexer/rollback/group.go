@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package rollback
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/celenium-io/celestia-indexer/pkg/types"
+)
+
+func rollbackGroups(ctx context.Context, tx storage.Transaction, height types.Level) error {
+	votes, err := tx.RollbackGroupVotes(ctx, height)
+	if err != nil {
+		return err
+	}
+
+	proposals := make(map[uint64]*storage.GroupProposal)
+	for i := range votes {
+		p, ok := proposals[votes[i].ProposalId]
+		if !ok {
+			p = &storage.GroupProposal{Id: votes[i].ProposalId}
+			proposals[votes[i].ProposalId] = p
+		}
+		p.VotesCount -= 1
+		switch votes[i].Option {
+		case storageTypes.VoteOptionYes:
+			p.Yes -= 1
+		case storageTypes.VoteOptionNo:
+			p.No -= 1
+		case storageTypes.VoteOptionNoWithVeto:
+			p.NoWithVeto -= 1
+		case storageTypes.VoteOptionAbstain:
+			p.Abstain -= 1
+		}
+	}
+
+	if len(proposals) > 0 {
+		arr := make([]*storage.GroupProposal, 0, len(proposals))
+		for _, p := range proposals {
+			arr = append(arr, p)
+		}
+		if err := tx.SaveGroupProposals(ctx, arr...); err != nil {
+			return err
+		}
+	}
+	if err := tx.RollbackGroupProposals(ctx, height); err != nil {
+		return err
+	}
+
+	members, err := tx.RollbackGroupMembers(ctx, height)
+	if err != nil {
+		return err
+	}
+	if err := tx.RollbackGroupPolicies(ctx, height); err != nil {
+		return err
+	}
+	if err := tx.RollbackGroups(ctx, height); err != nil {
+		return err
+	}
+	if err := tx.RollbackGroupUpdates(ctx, height); err != nil {
+		return err
+	}
+
+	if len(members) == 0 {
+		return nil
+	}
+	groupIds := make([]uint64, 0)
+	exists := make(map[uint64]struct{})
+	for i := range members {
+		if _, ok := exists[members[i].GroupId]; ok {
+			continue
+		}
+		exists[members[i].GroupId] = struct{}{}
+		groupIds = append(groupIds, members[i].GroupId)
+	}
+	return tx.UpdateGroupWeights(ctx, groupIds...)
+}
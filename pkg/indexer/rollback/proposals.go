@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package rollback
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/pkg/types"
+)
+
+func rollbackProposals(ctx context.Context, tx storage.Transaction, height types.Level) error {
+	votes, err := tx.RollbackVotes(ctx, height)
+	if err != nil {
+		return err
+	}
+	deposits, err := tx.RollbackDeposits(ctx, height)
+	if err != nil {
+		return err
+	}
+
+	proposals := make(map[uint64]*storage.Proposal)
+	getProposal := func(id uint64) *storage.Proposal {
+		if p, ok := proposals[id]; ok {
+			return p
+		}
+		p := &storage.Proposal{Id: id}
+		proposals[id] = p
+		return p
+	}
+
+	for i := range deposits {
+		p := getProposal(deposits[i].ProposalId)
+		p.Deposit = p.Deposit.Sub(deposits[i].Amount)
+	}
+
+	if len(proposals) > 0 {
+		arr := make([]*storage.Proposal, 0, len(proposals))
+		for _, p := range proposals {
+			arr = append(arr, p)
+		}
+		if err := tx.SaveProposals(ctx, arr...); err != nil {
+			return err
+		}
+	}
+
+	ids := make([]uint64, 0)
+	unique := make(map[uint64]struct{})
+	for i := range votes {
+		if _, ok := unique[votes[i].ProposalId]; ok {
+			continue
+		}
+		unique[votes[i].ProposalId] = struct{}{}
+		ids = append(ids, votes[i].ProposalId)
+	}
+	if err := tx.UpdateProposalsTally(ctx, ids...); err != nil {
+		return err
+	}
+
+	return tx.RollbackProposals(ctx, height)
+}
@@ -189,15 +189,42 @@ func (module *Module) rollbackBlock(ctx context.Context, height types.Level) err
 	if err := module.rollbackBalances(ctx, tx, events, addresses); err != nil {
 		return tx.HandleError(ctx, err)
 	}
+	if err := tx.RollbackBalanceHistory(ctx, height); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	if err := tx.RollbackTransfers(ctx, height); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	if err := rollbackConstants(ctx, tx, height); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	if err := tx.RollbackSlashes(ctx, height); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	if err := tx.RollbackBlobstreamAttestations(ctx, height); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	if err := tx.RollbackBlobstreamEvmAddresses(ctx, height); err != nil {
+		return tx.HandleError(ctx, err)
+	}
 
 	vals, err := rollbackValidators(ctx, tx, height)
 	if err != nil {
 		return tx.HandleError(ctx, err)
 	}
+	if err := tx.RollbackValidatorHistory(ctx, height); err != nil {
+		return tx.HandleError(ctx, err)
+	}
 
 	if err := tx.RollbackBlockSignatures(ctx, height); err != nil {
 		return err
 	}
+	if err := tx.RollbackMissedBlocks(ctx, height); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	if err := tx.RollbackBlockDah(ctx, height); err != nil {
+		return tx.HandleError(ctx, err)
+	}
 
 	if err := tx.RollbackBlobLog(ctx, height); err != nil {
 		return tx.HandleError(ctx, err)
@@ -205,6 +232,33 @@ func (module *Module) rollbackBlock(ctx context.Context, height types.Level) err
 	if err := tx.RollbackGrants(ctx, height); err != nil {
 		return tx.HandleError(ctx, err)
 	}
+	if err := rollbackProposals(ctx, tx, height); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	if err := rollbackGroups(ctx, tx, height); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	if err := tx.RollbackUpgrades(ctx, height); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	if err := tx.RollbackIbcClients(ctx, height); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	if err := tx.RollbackIbcConnections(ctx, height); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	if err := tx.RollbackIbcChannels(ctx, height); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	if err := tx.RollbackIbcTransfers(ctx, height); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	if err := tx.RollbackInterchainAccounts(ctx, height); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	if err := tx.RollbackInterchainAccountPackets(ctx, height); err != nil {
+		return tx.HandleError(ctx, err)
+	}
 
 	if err := tx.RollbackVestingPeriods(ctx, height); err != nil {
 		return tx.HandleError(ctx, err)
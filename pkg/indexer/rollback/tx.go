@@ -5,9 +5,13 @@ package rollback
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/celenium-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
 	"github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
 )
 
 func (module *Module) rollbackTransactions(ctx context.Context, tx storage.Transaction, height types.Level) error {
@@ -21,13 +25,61 @@ func (module *Module) rollbackTransactions(ctx context.Context, tx storage.Trans
 	}
 
 	ids := make([]uint64, len(txs))
+	fees := make(map[uint64]decimal.Decimal)
 	for i := range txs {
 		ids[i] = txs[i].Id
+		fees[txs[i].Id] = txs[i].Fee
 	}
 
 	if err := tx.RollbackSigners(ctx, ids); err != nil {
 		return err
 	}
 
-	return nil
+	addresses, err := tx.RollbackTxAddresses(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	return rollbackFeeGrantSpends(ctx, tx, height, addresses, fees)
+}
+
+// rollbackFeeGrantSpends - returns fee spent by rolled back transactions to fee allowances
+func rollbackFeeGrantSpends(ctx context.Context, tx storage.Transaction, height types.Level, addresses []storage.TxAddress, fees map[uint64]decimal.Decimal) error {
+	var (
+		payers   = make(map[uint64]uint64)
+		granters = make(map[uint64]uint64)
+	)
+	for i := range addresses {
+		switch addresses[i].Type {
+		case storageTypes.TxAddressTypeFeePayer:
+			payers[addresses[i].TxId] = addresses[i].AddressId
+		case storageTypes.TxAddressTypeFeeGranter:
+			granters[addresses[i].TxId] = addresses[i].AddressId
+		}
+	}
+
+	spends := make(map[string]*storage.Grant)
+	for txId, granterId := range granters {
+		payerId, ok := payers[txId]
+		if !ok {
+			return errors.Errorf("can't find fee payer of transaction %d", txId)
+		}
+		key := fmt.Sprintf("%d_%d", granterId, payerId)
+		if spend, ok := spends[key]; ok {
+			spend.Spent = spend.Spent.Sub(fees[txId])
+		} else {
+			spends[key] = &storage.Grant{
+				Height:    height,
+				GranterId: granterId,
+				GranteeId: payerId,
+				Spent:     fees[txId].Neg(),
+			}
+		}
+	}
+
+	grants := make([]storage.Grant, 0, len(spends))
+	for _, spend := range spends {
+		grants = append(grants, *spend)
+	}
+	return tx.SpendFeeGrants(ctx, grants...)
 }
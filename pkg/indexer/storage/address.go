@@ -5,17 +5,25 @@ package storage
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	decodeContext "github.com/celenium-io/celestia-indexer/pkg/indexer/decode/context"
+	"github.com/pkg/errors"
 )
 
+// saveAddresses - saves addresses of the block, their balances and balance history. Slashed are balances of delegators
+// after slashing which was applied before.
 func saveAddresses(
 	ctx context.Context,
 	tx storage.Transaction,
 	addresses []*storage.Address,
+	history []*storage.BalanceHistory,
+	slashed []storage.Balance,
 ) (map[string]uint64, int64, error) {
 	if len(addresses) == 0 {
-		return nil, 0, nil
+		return nil, 0, saveBalanceHistory(ctx, tx, nil, slashed, history)
 	}
 
 	totalAccounts, err := tx.SaveAddresses(ctx, addresses...)
@@ -30,10 +38,76 @@ func saveAddresses(
 		addresses[i].Balance.Id = addresses[i].Id
 		balances[i] = addresses[i].Balance
 	}
-	err = tx.SaveBalances(ctx, balances...)
+	if err := tx.SaveBalances(ctx, balances...); err != nil {
+		return nil, 0, err
+	}
+
+	err = saveBalanceHistory(ctx, tx, addToId, append(slashed, balances...), history)
 	return addToId, totalAccounts, err
 }
 
+// saveBalanceHistory - saves balance changes of the block. Balances after each change are restored from the final balances of addresses
+// by subtracting changes in reverse order. If the same address has several balances the last one is final.
+// History items with address id set are not looked up by address.
+func saveBalanceHistory(
+	ctx context.Context,
+	tx storage.Transaction,
+	addrToId map[string]uint64,
+	balances []storage.Balance,
+	history []*storage.BalanceHistory,
+) error {
+	if len(history) == 0 {
+		return nil
+	}
+
+	current := make(map[uint64]storage.Balance, len(balances))
+	for i := range balances {
+		current[balances[i].Id] = balances[i]
+	}
+
+	result := make([]storage.BalanceHistory, len(history))
+	for i := len(history) - 1; i >= 0; i-- {
+		addressId := history[i].AddressId
+		if addressId == 0 {
+			id, ok := addrToId[history[i].Address.Address]
+			if !ok {
+				return errors.Errorf("unknown address in balance history: %s", history[i].Address.Address)
+			}
+			addressId = id
+		}
+		balance, ok := current[addressId]
+		if !ok {
+			return errors.Errorf("unknown balance in balance history: %d", addressId)
+		}
+
+		history[i].AddressId = addressId
+		history[i].Spendable = balance.Spendable
+		history[i].Delegated = balance.Delegated
+		history[i].Unbonding = balance.Unbonding
+		result[i] = *history[i]
+
+		balance.Spendable = balance.Spendable.Sub(history[i].SpendableChange)
+		balance.Delegated = balance.Delegated.Sub(history[i].DelegatedChange)
+		balance.Unbonding = balance.Unbonding.Sub(history[i].UnbondingChange)
+		current[addressId] = balance
+	}
+
+	return tx.SaveBalanceHistory(ctx, result...)
+}
+
+// blockBalanceHistory - returns balance history of the block in order of changes: begin block events, slashing, transactions and end block events
+func blockBalanceHistory(dCtx *decodeContext.Context, slashing []*storage.BalanceHistory) []*storage.BalanceHistory {
+	if len(slashing) == 0 {
+		return dCtx.BalanceHistory
+	}
+
+	history := make([]*storage.BalanceHistory, 0, len(dCtx.BalanceHistory)+len(slashing))
+	history = append(history, dCtx.BalanceHistory[:dCtx.BeginBlockHistory]...)
+	history = append(history, slashing...)
+	history = append(history, dCtx.BalanceHistory[dCtx.BeginBlockHistory:]...)
+	return history
+}
+
 func saveSigners(
 	ctx context.Context,
 	tx storage.Transaction,
@@ -57,3 +131,68 @@ func saveSigners(
 	}
 	return tx.SaveSigners(ctx, txAddresses...)
 }
+
+func saveFeePayers(
+	ctx context.Context,
+	tx storage.Transaction,
+	addrToId map[string]uint64,
+	txs []storage.Tx,
+) error {
+	if len(txs) == 0 || len(addrToId) == 0 {
+		return nil
+	}
+
+	var (
+		txAddresses []storage.TxAddress
+		spends      = make(map[string]*storage.Grant)
+	)
+	for i := range txs {
+		if txs[i].FeePayer == nil {
+			continue
+		}
+		payerId, ok := addrToId[txs[i].FeePayer.Address]
+		if !ok {
+			return errors.Errorf("unknown fee payer: %s", txs[i].FeePayer.Address)
+		}
+		txAddresses = append(txAddresses, storage.TxAddress{
+			TxId:      txs[i].Id,
+			AddressId: payerId,
+			Type:      types.TxAddressTypeFeePayer,
+		})
+
+		if txs[i].FeeGranter == nil {
+			continue
+		}
+		granterId, ok := addrToId[txs[i].FeeGranter.Address]
+		if !ok {
+			return errors.Errorf("unknown fee granter: %s", txs[i].FeeGranter.Address)
+		}
+		txAddresses = append(txAddresses, storage.TxAddress{
+			TxId:      txs[i].Id,
+			AddressId: granterId,
+			Type:      types.TxAddressTypeFeeGranter,
+		})
+
+		key := fmt.Sprintf("%d_%d", granterId, payerId)
+		if spend, ok := spends[key]; ok {
+			spend.Spent = spend.Spent.Add(txs[i].Fee)
+		} else {
+			spends[key] = &storage.Grant{
+				Height:    txs[i].Height,
+				GranterId: granterId,
+				GranteeId: payerId,
+				Spent:     txs[i].Fee,
+			}
+		}
+	}
+
+	if err := tx.SaveTxAddresses(ctx, txAddresses...); err != nil {
+		return err
+	}
+
+	grants := make([]storage.Grant, 0, len(spends))
+	for _, spend := range spends {
+		grants = append(grants, *spend)
+	}
+	return tx.SpendFeeGrants(ctx, grants...)
+}
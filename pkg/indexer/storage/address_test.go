@@ -7,8 +7,11 @@ import (
 	"context"
 	"testing"
 
+	"github.com/celenium-io/celestia-indexer/internal/currency"
 	"github.com/celenium-io/celestia-indexer/internal/storage"
 	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	decodeContext "github.com/celenium-io/celestia-indexer/pkg/indexer/decode/context"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -79,6 +82,79 @@ func TestModule_saveSigners(t *testing.T) {
 	}
 }
 
+func Test_saveFeePayers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tx := mock.NewMockTransaction(ctrl)
+
+	addrToId := map[string]uint64{
+		"payer":   1,
+		"granter": 2,
+	}
+	txs := []storage.Tx{
+		{
+			Id:       1,
+			Fee:      decimal.RequireFromString("100"),
+			FeePayer: &storage.Address{Address: "payer"},
+		}, {
+			Id:         2,
+			Fee:        decimal.RequireFromString("200"),
+			FeePayer:   &storage.Address{Address: "payer"},
+			FeeGranter: &storage.Address{Address: "granter"},
+		}, {
+			Id:         3,
+			Fee:        decimal.RequireFromString("300"),
+			FeePayer:   &storage.Address{Address: "payer"},
+			FeeGranter: &storage.Address{Address: "granter"},
+		},
+	}
+
+	tx.EXPECT().
+		SaveTxAddresses(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, addresses ...storage.TxAddress) error {
+			require.Equal(t, []storage.TxAddress{
+				{TxId: 1, AddressId: 1, Type: types.TxAddressTypeFeePayer},
+				{TxId: 2, AddressId: 1, Type: types.TxAddressTypeFeePayer},
+				{TxId: 2, AddressId: 2, Type: types.TxAddressTypeFeeGranter},
+				{TxId: 3, AddressId: 1, Type: types.TxAddressTypeFeePayer},
+				{TxId: 3, AddressId: 2, Type: types.TxAddressTypeFeeGranter},
+			}, addresses)
+			return nil
+		})
+
+	tx.EXPECT().
+		SpendFeeGrants(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, grants ...storage.Grant) error {
+			require.Len(t, grants, 1)
+			require.EqualValues(t, 2, grants[0].GranterId)
+			require.EqualValues(t, 1, grants[0].GranteeId)
+			require.Equal(t, "500", grants[0].Spent.String())
+			return nil
+		})
+
+	err := saveFeePayers(context.Background(), tx, addrToId, txs)
+	require.NoError(t, err)
+}
+
+func Test_saveFeePayersUnknownAddress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tx := mock.NewMockTransaction(ctrl)
+
+	err := saveFeePayers(context.Background(), tx, map[string]uint64{"payer": 1}, []storage.Tx{
+		{
+			Id:         1,
+			FeePayer:   &storage.Address{Address: "payer"},
+			FeeGranter: &storage.Address{Address: "unknown"},
+		},
+	})
+	require.Error(t, err)
+}
+
 func Test_saveAddresses(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -165,10 +241,180 @@ func Test_saveAddresses(t *testing.T) {
 			})
 
 		t.Run(tt.name, func(t *testing.T) {
-			got, got1, err := saveAddresses(context.Background(), tx, tt.addresses)
+			got, got1, err := saveAddresses(context.Background(), tx, tt.addresses, nil, nil)
 			require.Equal(t, tt.wantErr, err != nil)
 			require.Equal(t, tt.addr, got)
 			require.Equal(t, tt.total, got1)
 		})
 	}
 }
+
+func Test_saveBalanceHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tx := mock.NewMockTransaction(ctrl)
+
+	history := []*storage.BalanceHistory{
+		{
+			Height:          100,
+			Type:            types.BalanceHistoryTypeFee,
+			SpendableChange: decimal.RequireFromString("-10"),
+			Address:         &storage.Address{Address: "address1"},
+		}, {
+			Height:          100,
+			Type:            types.BalanceHistoryTypeTransfer,
+			SpendableChange: decimal.RequireFromString("100"),
+			Address:         &storage.Address{Address: "address2"},
+		}, {
+			Height:          100,
+			Type:            types.BalanceHistoryTypeDelegation,
+			SpendableChange: decimal.RequireFromString("-50"),
+			DelegatedChange: decimal.RequireFromString("50"),
+			Address:         &storage.Address{Address: "address1"},
+		},
+	}
+	balances := []storage.Balance{
+		{
+			Id:        1,
+			Spendable: decimal.RequireFromString("940"),
+			Delegated: decimal.RequireFromString("150"),
+			Unbonding: decimal.Zero,
+		}, {
+			Id:        2,
+			Spendable: decimal.RequireFromString("100"),
+			Delegated: decimal.Zero,
+			Unbonding: decimal.Zero,
+		},
+	}
+
+	tx.EXPECT().
+		SaveBalanceHistory(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, history ...storage.BalanceHistory) error {
+			require.Len(t, history, 3)
+
+			require.EqualValues(t, 1, history[0].AddressId)
+			require.Equal(t, "990", history[0].Spendable.String())
+			require.Equal(t, "100", history[0].Delegated.String())
+
+			require.EqualValues(t, 2, history[1].AddressId)
+			require.Equal(t, "100", history[1].Spendable.String())
+
+			require.EqualValues(t, 1, history[2].AddressId)
+			require.Equal(t, "940", history[2].Spendable.String())
+			require.Equal(t, "150", history[2].Delegated.String())
+			return nil
+		})
+
+	addrToId := map[string]uint64{
+		"address1": 1,
+		"address2": 2,
+	}
+	err := saveBalanceHistory(context.Background(), tx, addrToId, balances, history)
+	require.NoError(t, err)
+}
+
+func Test_saveBalanceHistoryWithSlashing(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tx := mock.NewMockTransaction(ctrl)
+
+	dCtx := decodeContext.NewContext()
+	dCtx.Block = &storage.Block{Height: 100}
+
+	dCtx.SetBalanceCause(types.BalanceHistoryTypeReward)
+	err := dCtx.AddAddress(&storage.Address{
+		Address: "celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60",
+		Balance: storage.Balance{
+			Currency:  currency.Utia,
+			Spendable: decimal.RequireFromString("10"),
+			Delegated: decimal.Zero,
+			Unbonding: decimal.Zero,
+		},
+	})
+	require.NoError(t, err)
+	dCtx.FinishBeginBlock()
+
+	dCtx.SetBalanceCause(types.BalanceHistoryTypeReward)
+	err = dCtx.AddAddress(&storage.Address{
+		Address: "celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60",
+		Balance: storage.Balance{
+			Currency:  currency.Utia,
+			Spendable: decimal.RequireFromString("20"),
+			Delegated: decimal.Zero,
+			Unbonding: decimal.Zero,
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, dCtx.BalanceHistory, 2)
+
+	slashing := []*storage.BalanceHistory{
+		{
+			Height:          100,
+			AddressId:       1,
+			Type:            types.BalanceHistoryTypeSlashing,
+			DelegatedChange: decimal.RequireFromString("-5"),
+		}, {
+			Height:          100,
+			AddressId:       2,
+			Type:            types.BalanceHistoryTypeSlashing,
+			DelegatedChange: decimal.RequireFromString("-1"),
+		},
+	}
+	history := blockBalanceHistory(dCtx, slashing)
+	require.Len(t, history, 4)
+	require.EqualValues(t, types.BalanceHistoryTypeReward, history[0].Type)
+	require.EqualValues(t, types.BalanceHistoryTypeSlashing, history[1].Type)
+	require.EqualValues(t, types.BalanceHistoryTypeSlashing, history[2].Type)
+	require.EqualValues(t, types.BalanceHistoryTypeReward, history[3].Type)
+
+	balances := []storage.Balance{
+		{
+			Id:        1,
+			Spendable: decimal.RequireFromString("100"),
+			Delegated: decimal.RequireFromString("95"),
+			Unbonding: decimal.Zero,
+		}, {
+			Id:        2,
+			Spendable: decimal.Zero,
+			Delegated: decimal.RequireFromString("9"),
+			Unbonding: decimal.Zero,
+		}, {
+			Id:        1,
+			Spendable: decimal.RequireFromString("130"),
+			Delegated: decimal.RequireFromString("95"),
+			Unbonding: decimal.Zero,
+		},
+	}
+
+	tx.EXPECT().
+		SaveBalanceHistory(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, history ...storage.BalanceHistory) error {
+			require.Len(t, history, 4)
+
+			require.EqualValues(t, 1, history[0].AddressId)
+			require.Equal(t, "110", history[0].Spendable.String())
+			require.Equal(t, "100", history[0].Delegated.String())
+
+			require.EqualValues(t, 1, history[1].AddressId)
+			require.Equal(t, "110", history[1].Spendable.String())
+			require.Equal(t, "95", history[1].Delegated.String())
+
+			require.EqualValues(t, 2, history[2].AddressId)
+			require.Equal(t, "9", history[2].Delegated.String())
+
+			require.EqualValues(t, 1, history[3].AddressId)
+			require.Equal(t, "130", history[3].Spendable.String())
+			require.Equal(t, "95", history[3].Delegated.String())
+			return nil
+		})
+
+	addrToId := map[string]uint64{
+		"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60": 1,
+	}
+	err = saveBalanceHistory(context.Background(), tx, addrToId, balances, history)
+	require.NoError(t, err)
+}
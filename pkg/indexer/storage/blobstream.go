@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+)
+
+const defaultDataCommitmentWindow uint64 = 400
+
+func (module *Module) saveBlobstream(
+	ctx context.Context,
+	tx storage.Transaction,
+	block *storage.Block,
+	messages []*storage.Message,
+	attestations []*storage.BlobstreamAttestation,
+) error {
+	addresses := make([]*storage.BlobstreamEvmAddress, 0)
+	for i := range messages {
+		if messages[i].EvmAddress == nil {
+			continue
+		}
+		address := messages[i].EvmAddress
+		validatorId, ok := module.validatorsByAddress[address.Validator.Address]
+		if !ok {
+			return errors.Errorf("unknown validator of evm address: %s", address.Validator.Address)
+		}
+		address.ValidatorId = validatorId
+		address.TxId = messages[i].TxId
+		addresses = append(addresses, address)
+	}
+	if err := tx.SaveBlobstreamEvmAddresses(ctx, addresses...); err != nil {
+		return err
+	}
+
+	if len(attestations) == 0 {
+		return nil
+	}
+
+	var lastEnd pkgTypes.Level
+	last, err := tx.LastDataCommitment(ctx)
+	switch {
+	case err == nil:
+		lastEnd = last.EndBlock
+	case errors.Is(err, sql.ErrNoRows):
+	default:
+		return errors.Wrap(err, "receiving last data commitment")
+	}
+
+	setAttestationTypes(attestations, block.Height, lastEnd, module.dataCommitmentWindow)
+	return tx.SaveBlobstreamAttestations(ctx, attestations...)
+}
+
+// setAttestationTypes - sets types of attestations requested in the block. Attestation request event contains only nonce.
+// So the ranges of data commitments are computed the same way as blobstream end blocker does:
+// a valset request (if any) is created before data commitments which are created while the window has passed since the end of the last one.
+func setAttestationTypes(attestations []*storage.BlobstreamAttestation, height, lastEnd pkgTypes.Level, window uint64) {
+	if window == 0 {
+		window = defaultDataCommitmentWindow
+	}
+
+	ranges := make([][2]pkgTypes.Level, 0)
+	for len(ranges) < len(attestations) {
+		var begin, end pkgTypes.Level
+		if lastEnd == 0 {
+			// the first data commitment covers range [1, window + 1)
+			if uint64(height) < window {
+				break
+			}
+			begin, end = 1, pkgTypes.Level(window)+1
+		} else {
+			if uint64(height) < uint64(lastEnd)+window {
+				break
+			}
+			begin, end = lastEnd, lastEnd+pkgTypes.Level(window)
+		}
+		ranges = append(ranges, [2]pkgTypes.Level{begin, end})
+		lastEnd = end
+	}
+
+	valsets := len(attestations) - len(ranges)
+	for i := range attestations {
+		if i < valsets {
+			attestations[i].Type = types.AttestationTypeValset
+			continue
+		}
+		attestations[i].Type = types.AttestationTypeDataCommitment
+		attestations[i].BeginBlock = ranges[i-valsets][0]
+		attestations[i].EndBlock = ranges[i-valsets][1]
+	}
+}
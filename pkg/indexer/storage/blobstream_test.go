@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_setAttestationTypes(t *testing.T) {
+	type want struct {
+		typ        types.AttestationType
+		beginBlock pkgTypes.Level
+		endBlock   pkgTypes.Level
+	}
+	tests := []struct {
+		name    string
+		count   int
+		height  pkgTypes.Level
+		lastEnd pkgTypes.Level
+		window  uint64
+		want    []want
+	}{
+		{
+			name:   "valset before the first window",
+			count:  1,
+			height: 10,
+			window: 400,
+			want: []want{
+				{typ: types.AttestationTypeValset},
+			},
+		}, {
+			name:   "first data commitment",
+			count:  1,
+			height: 400,
+			window: 400,
+			want: []want{
+				{typ: types.AttestationTypeDataCommitment, beginBlock: 1, endBlock: 401},
+			},
+		}, {
+			name:    "valset and data commitment",
+			count:   2,
+			height:  801,
+			lastEnd: 401,
+			window:  400,
+			want: []want{
+				{typ: types.AttestationTypeValset},
+				{typ: types.AttestationTypeDataCommitment, beginBlock: 401, endBlock: 801},
+			},
+		}, {
+			name:    "valset in the middle of window",
+			count:   1,
+			height:  900,
+			lastEnd: 801,
+			window:  400,
+			want: []want{
+				{typ: types.AttestationTypeValset},
+			},
+		}, {
+			name:    "catch up after window decreasing",
+			count:   3,
+			height:  1001,
+			lastEnd: 801,
+			window:  100,
+			want: []want{
+				{typ: types.AttestationTypeValset},
+				{typ: types.AttestationTypeDataCommitment, beginBlock: 801, endBlock: 901},
+				{typ: types.AttestationTypeDataCommitment, beginBlock: 901, endBlock: 1001},
+			},
+		}, {
+			name:   "default window",
+			count:  1,
+			height: 400,
+			want: []want{
+				{typ: types.AttestationTypeDataCommitment, beginBlock: 1, endBlock: 401},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attestations := make([]*storage.BlobstreamAttestation, tt.count)
+			for i := range attestations {
+				attestations[i] = &storage.BlobstreamAttestation{
+					Height: tt.height,
+					Nonce:  uint64(i + 1),
+				}
+			}
+
+			setAttestationTypes(attestations, tt.height, tt.lastEnd, tt.window)
+
+			require.Len(t, attestations, len(tt.want))
+			for i := range tt.want {
+				require.Equal(t, tt.want[i].typ, attestations[i].Type, i)
+				require.Equal(t, tt.want[i].beginBlock, attestations[i].BeginBlock, i)
+				require.Equal(t, tt.want[i].endBlock, attestations[i].EndBlock, i)
+			}
+		})
+	}
+}
+
+func TestModule_saveBlobstream(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tx := mock.NewMockTransaction(ctrl)
+	module := Module{
+		validatorsByAddress: map[string]uint64{
+			"celestiavaloper1": 3,
+		},
+		dataCommitmentWindow: 400,
+	}
+
+	messages := []*storage.Message{
+		{
+			TxId: 10,
+			EvmAddress: &storage.BlobstreamEvmAddress{
+				Height:     400,
+				EvmAddress: "0xfDC46fBDd8AF50d9Bf7536Bf44ce8560E423352c",
+				Validator:  &storage.Validator{Address: "celestiavaloper1"},
+			},
+		}, {
+			TxId: 11,
+		},
+	}
+	attestations := []*storage.BlobstreamAttestation{
+		{Height: 400, Nonce: 1},
+	}
+
+	tx.EXPECT().
+		SaveBlobstreamEvmAddresses(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, addresses ...*storage.BlobstreamEvmAddress) error {
+			require.Len(t, addresses, 1)
+			require.EqualValues(t, 3, addresses[0].ValidatorId)
+			require.EqualValues(t, 10, addresses[0].TxId)
+			return nil
+		})
+
+	tx.EXPECT().
+		LastDataCommitment(gomock.Any()).
+		Return(storage.BlobstreamAttestation{}, sql.ErrNoRows).
+		Times(1)
+
+	tx.EXPECT().
+		SaveBlobstreamAttestations(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, attestations ...*storage.BlobstreamAttestation) error {
+			require.Len(t, attestations, 1)
+			require.Equal(t, types.AttestationTypeDataCommitment, attestations[0].Type)
+			require.EqualValues(t, 1, attestations[0].BeginBlock)
+			require.EqualValues(t, 401, attestations[0].EndBlock)
+			return nil
+		})
+
+	err := module.saveBlobstream(context.Background(), tx, &storage.Block{Height: 400}, messages, attestations)
+	require.NoError(t, err)
+
+	err = module.saveBlobstream(context.Background(), tx, &storage.Block{Height: 401}, []*storage.Message{
+		{
+			EvmAddress: &storage.BlobstreamEvmAddress{
+				Validator: &storage.Validator{Address: "unknown"},
+			},
+		},
+	}, nil)
+	require.Error(t, err)
+}
@@ -46,3 +46,27 @@ func (module *Module) saveBlockSignatures(
 
 	return tx.SaveBlockSignatures(ctx, signs...)
 }
+
+func (module *Module) saveMissedBlocks(
+	ctx context.Context,
+	tx storage.Transaction,
+	missed []storage.MissedBlock,
+) error {
+	if len(missed) == 0 {
+		return nil
+	}
+
+	for i := range missed {
+		if missed[i].Validator == nil {
+			return errors.New("nil validator of missed block")
+		}
+
+		if id, ok := module.validatorsByConsAddress[missed[i].Validator.ConsAddress]; ok {
+			missed[i].ValidatorId = id
+		} else {
+			return errors.Errorf("unknown validator: %s", missed[i].Validator.ConsAddress)
+		}
+	}
+
+	return tx.SaveMissedBlocks(ctx, missed...)
+}
@@ -0,0 +1,258 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	constantAppVersion              = "app_version"
+	constantDataCommitmentWindow    = "data_commitment_window"
+	constantSlashFractionDoubleSign = "slash_fraction_double_sign"
+	constantSlashFractionDowntime   = "slash_fraction_downtime"
+)
+
+// subspaceModules - legacy params subspaces which names differ from module names
+var subspaceModules = map[string]types.ModuleName{
+	"baseapp": types.ModuleNameConsensus,
+	"qgb":     types.ModuleNameBlobstream,
+}
+
+// paramKeyAliases - legacy param store keys which can't be converted to constant names automatically
+var paramKeyAliases = map[string]string{
+	"communitytax":        "community_tax",
+	"baseproposerreward":  "base_proposer_reward",
+	"bonusproposerreward": "bonus_proposer_reward",
+	"withdrawaddrenabled": "withdraw_addr_enabled",
+}
+
+type paramChange struct {
+	Subspace string `json:"subspace"`
+	Key      string `json:"key"`
+	Value    string `json:"value"`
+}
+
+type coinValue struct {
+	Denom  string `json:"denom"`
+	Amount string `json:"amount"`
+}
+
+// saveConstants - saves constant values changed in the block by applied parameter change proposals and app version upgrades.
+// Cached values are updated by updateConstants after the block is committed.
+func (module *Module) saveConstants(
+	ctx context.Context,
+	tx storage.Transaction,
+	block *storage.Block,
+	proposals []*storage.Proposal,
+) error {
+	history := make([]storage.ConstantHistory, 0)
+	for i := range proposals {
+		if proposals[i].Status != types.ProposalStatusApplied || proposals[i].Type != types.ProposalTypeParamChanged {
+			continue
+		}
+
+		constants, err := constantsFromParamChanges(proposals[i].Changes)
+		if err != nil {
+			return errors.Wrapf(err, "changes of proposal %d", proposals[i].Id)
+		}
+		for j := range constants {
+			history = append(history, storage.ConstantHistory{
+				Height:     block.Height,
+				Time:       block.Time,
+				Module:     constants[j].Module,
+				Name:       constants[j].Name,
+				Value:      constants[j].Value,
+				ProposalId: &proposals[i].Id,
+			})
+		}
+	}
+
+	if block.VersionApp != module.appVersion {
+		history = append(history, storage.ConstantHistory{
+			Height: block.Height,
+			Time:   block.Time,
+			Module: types.ModuleNameConsensus,
+			Name:   constantAppVersion,
+			Value:  strconv.FormatUint(block.VersionApp, 10),
+		})
+	}
+
+	if len(history) == 0 {
+		return nil
+	}
+
+	constants := make([]storage.Constant, len(history))
+	for i := range history {
+		constants[i] = history[i].Constant()
+	}
+	if err := tx.SaveConstants(ctx, constants...); err != nil {
+		return err
+	}
+	if err := tx.SaveConstantHistory(ctx, history...); err != nil {
+		return err
+	}
+	module.changedConstants = constants
+	return nil
+}
+
+// updateConstants - refreshes cached constant values. It should be called after the transaction was committed.
+func (module *Module) updateConstants(constants []storage.Constant) error {
+	for i := range constants {
+		var err error
+		switch {
+		case constants[i].Module == types.ModuleNameSlashing && constants[i].Name == constantSlashFractionDoubleSign:
+			module.slashingForDoubleSign, err = decimal.NewFromString(constants[i].Value)
+		case constants[i].Module == types.ModuleNameSlashing && constants[i].Name == constantSlashFractionDowntime:
+			module.slashingForDowntime, err = decimal.NewFromString(constants[i].Value)
+		case constants[i].Module == types.ModuleNameConsensus && constants[i].Name == constantAppVersion:
+			module.appVersion, err = strconv.ParseUint(constants[i].Value, 10, 64)
+		case constants[i].Module == types.ModuleNameBlobstream && constants[i].Name == constantDataCommitmentWindow:
+			module.dataCommitmentWindow, err = strconv.ParseUint(constants[i].Value, 10, 64)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "%s.%s", constants[i].Module, constants[i].Name)
+		}
+	}
+	return nil
+}
+
+// constantsFromParamChanges - converts changes of parameter change proposal to constants.
+// Changes of modules which constants are not tracked are skipped.
+func constantsFromParamChanges(data json.RawMessage) ([]storage.Constant, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var changes []paramChange
+	if err := json.Unmarshal(data, &changes); err != nil {
+		return nil, err
+	}
+
+	constants := make([]storage.Constant, 0, len(changes))
+	for i := range changes {
+		module, ok := subspaceModules[changes[i].Subspace]
+		if !ok {
+			m, err := types.ParseModuleName(changes[i].Subspace)
+			if err != nil {
+				continue
+			}
+			module = m
+		}
+
+		name := paramName(changes[i].Key)
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(changes[i].Value), &fields); err != nil || len(fields) == 0 || isCoin(fields) {
+			constants = append(constants, storage.Constant{
+				Module: module,
+				Name:   name,
+				Value:  constantValue(module, name, json.RawMessage(changes[i].Value)),
+			})
+			continue
+		}
+
+		// structured params are flattened: consensus params names are prefixed by params group, e.g. `block_max_bytes`
+		var prefix string
+		if module == types.ModuleNameConsensus {
+			prefix = strings.TrimSuffix(name, "_params") + "_"
+		}
+
+		keys := make([]string, 0, len(fields))
+		for key := range fields {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			fieldName := prefix + paramName(key)
+			constants = append(constants, storage.Constant{
+				Module: module,
+				Name:   fieldName,
+				Value:  constantValue(module, fieldName, fields[key]),
+			})
+		}
+	}
+	return constants, nil
+}
+
+// paramName - converts param store key to constant name, e.g. `MaxValidators` -> `max_validators`
+func paramName(key string) string {
+	if alias, ok := paramKeyAliases[key]; ok {
+		return alias
+	}
+
+	runes := []rune(key)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(runes[i-1]) || (unicode.IsUpper(runes[i-1]) && i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				b.WriteByte('_')
+			}
+			b.WriteRune(unicode.ToLower(r))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// constantValue - converts JSON encoded param value to the format of constants received from genesis
+func constantValue(module types.ModuleName, name string, raw json.RawMessage) string {
+	var value string
+	if err := json.Unmarshal(raw, &value); err != nil {
+		value = rawConstantValue(raw)
+	}
+
+	if strings.HasSuffix(name, "_time") || strings.HasSuffix(name, "_duration") || strings.HasSuffix(name, "_period") {
+		// legacy params contain durations in nanoseconds
+		if ns, err := strconv.ParseInt(value, 10, 64); err == nil {
+			d := time.Duration(ns)
+			if module == types.ModuleNameConsensus {
+				return d.String()
+			}
+			return strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "s"
+		}
+	}
+	return value
+}
+
+func rawConstantValue(raw json.RawMessage) string {
+	var coin coinValue
+	if err := json.Unmarshal(raw, &coin); err == nil && coin.Denom != "" {
+		return coin.Amount + coin.Denom
+	}
+
+	var items []json.RawMessage
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return string(raw)
+	}
+
+	values := make([]string, len(items))
+	for i := range items {
+		var value string
+		if err := json.Unmarshal(items[i], &value); err == nil {
+			values[i] = value
+			continue
+		}
+		values[i] = rawConstantValue(items[i])
+	}
+	return strings.Join(values, ", ")
+}
+
+func isCoin(fields map[string]json.RawMessage) bool {
+	_, hasDenom := fields["denom"]
+	_, hasAmount := fields["amount"]
+	return hasDenom && hasAmount && len(fields) == 2
+}
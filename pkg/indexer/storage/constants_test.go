@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_constantsFromParamChanges(t *testing.T) {
+	tests := []struct {
+		name    string
+		changes string
+		want    []storage.Constant
+		wantErr bool
+	}{
+		{
+			name:    "legacy params",
+			changes: `[{"subspace":"staking","key":"MaxValidators","value":"105"},{"subspace":"slashing","key":"SlashFractionDowntime","value":"\"0.000000000000000000\""},{"subspace":"auth","key":"SigVerifyCostSecp256k1","value":"\"1000\""}]`,
+			want: []storage.Constant{
+				{Module: types.ModuleNameStaking, Name: "max_validators", Value: "105"},
+				{Module: types.ModuleNameSlashing, Name: "slash_fraction_downtime", Value: "0.000000000000000000"},
+				{Module: types.ModuleNameAuth, Name: "sig_verify_cost_secp256k1", Value: "1000"},
+			},
+		}, {
+			name:    "structured params",
+			changes: `[{"subspace":"baseapp","key":"EvidenceParams","value":"{\"max_age_num_blocks\":\"120960\",\"max_age_duration\":\"1814400000000000\",\"max_bytes\":\"1048576\"}"},{"subspace":"gov","key":"depositparams","value":"{\"min_deposit\":[{\"denom\":\"utia\",\"amount\":\"10000000000\"}]}"}]`,
+			want: []storage.Constant{
+				{Module: types.ModuleNameConsensus, Name: "evidence_max_age_duration", Value: "504h0m0s"},
+				{Module: types.ModuleNameConsensus, Name: "evidence_max_age_num_blocks", Value: "120960"},
+				{Module: types.ModuleNameConsensus, Name: "evidence_max_bytes", Value: "1048576"},
+				{Module: types.ModuleNameGov, Name: "min_deposit", Value: "10000000000utia"},
+			},
+		}, {
+			name:    "durations and aliases",
+			changes: `[{"subspace":"staking","key":"UnbondingTime","value":"\"1814400000000000\""},{"subspace":"distribution","key":"communitytax","value":"\"0.02\""},{"subspace":"transfer","key":"SendEnabled","value":"true"}]`,
+			want: []storage.Constant{
+				{Module: types.ModuleNameStaking, Name: "unbonding_time", Value: "1814400s"},
+				{Module: types.ModuleNameDistribution, Name: "community_tax", Value: "0.02"},
+			},
+		}, {
+			name:    "message params",
+			changes: `[{"subspace":"blob","key":"gas_per_blob_byte","value":"10"},{"subspace":"blob","key":"gov_max_square_size","value":"\"128\""}]`,
+			want: []storage.Constant{
+				{Module: types.ModuleNameBlob, Name: "gas_per_blob_byte", Value: "10"},
+				{Module: types.ModuleNameBlob, Name: "gov_max_square_size", Value: "128"},
+			},
+		}, {
+			name:    "invalid changes",
+			changes: `{}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := constantsFromParamChanges(json.RawMessage(tt.changes))
+			require.Equal(t, tt.wantErr, err != nil, err)
+			if err == nil {
+				require.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_saveConstants(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tx := mock.NewMockTransaction(ctrl)
+	module := Module{
+		appVersion:            1,
+		slashingForDoubleSign: decimal.RequireFromString("0.02"),
+		slashingForDowntime:   decimal.RequireFromString("0.0001"),
+	}
+
+	block := &storage.Block{
+		Height:     100,
+		Time:       time.Now(),
+		VersionApp: 2,
+	}
+	proposals := []*storage.Proposal{
+		{
+			Id:      1,
+			Status:  types.ProposalStatusApplied,
+			Type:    types.ProposalTypeParamChanged,
+			Changes: json.RawMessage(`[{"subspace":"slashing","key":"SlashFractionDoubleSign","value":"\"0.05\""}]`),
+		}, {
+			Id:      2,
+			Status:  types.ProposalStatusRejected,
+			Type:    types.ProposalTypeParamChanged,
+			Changes: json.RawMessage(`[{"subspace":"slashing","key":"SlashFractionDowntime","value":"\"0.5\""}]`),
+		}, {
+			Id:     3,
+			Status: types.ProposalStatusApplied,
+			Type:   types.ProposalTypeText,
+		},
+	}
+
+	tx.EXPECT().
+		SaveConstants(gomock.Any(), storage.Constant{
+			Module: types.ModuleNameSlashing,
+			Name:   "slash_fraction_double_sign",
+			Value:  "0.05",
+		}, storage.Constant{
+			Module: types.ModuleNameConsensus,
+			Name:   "app_version",
+			Value:  "2",
+		}).
+		Return(nil).
+		Times(1)
+
+	tx.EXPECT().
+		SaveConstantHistory(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, history ...storage.ConstantHistory) error {
+			require.Len(t, history, 2)
+			require.EqualValues(t, 100, history[0].Height)
+			require.NotNil(t, history[0].ProposalId)
+			require.EqualValues(t, 1, *history[0].ProposalId)
+			require.Nil(t, history[1].ProposalId)
+			return nil
+		}).
+		Times(1)
+
+	err := module.saveConstants(context.Background(), tx, block, proposals)
+	require.NoError(t, err)
+	require.Len(t, module.changedConstants, 2)
+
+	// cache is not changed until commit
+	require.Equal(t, "0.02", module.slashingForDoubleSign.String())
+	require.EqualValues(t, 1, module.appVersion)
+
+	err = module.updateConstants(module.changedConstants)
+	require.NoError(t, err)
+	require.Equal(t, "0.05", module.slashingForDoubleSign.String())
+	require.Equal(t, "0.0001", module.slashingForDowntime.String())
+	require.EqualValues(t, 2, module.appVersion)
+
+	// nothing is changed
+	err = module.saveConstants(context.Background(), tx, block, nil)
+	require.NoError(t, err)
+}
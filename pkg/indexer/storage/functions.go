@@ -17,3 +17,15 @@ func setNamespacesFromMessage(msg storage.Message, namespaces map[string]*storag
 		}
 	}
 }
+
+// appendMessage - appends message and all its internal messages to the flat list in parent-first order
+func appendMessage(messages []*storage.Message, msg *storage.Message, txId uint64, namespaces map[string]*storage.Namespace) []*storage.Message {
+	msg.TxId = txId
+	messages = append(messages, msg)
+	setNamespacesFromMessage(*msg, namespaces)
+
+	for i := range msg.InternalMsgs {
+		messages = appendMessage(messages, msg.InternalMsgs[i], txId, namespaces)
+	}
+	return messages
+}
@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	decodeContext "github.com/celenium-io/celestia-indexer/pkg/indexer/decode/context"
+	"github.com/pkg/errors"
+)
+
+func saveGroups(
+	ctx context.Context,
+	tx storage.Transaction,
+	dCtx *decodeContext.Context,
+	addrToId map[string]uint64,
+) error {
+	var (
+		groups    = dCtx.GetGroups()
+		members   = make([]*storage.GroupMember, 0)
+		groupIds  = make([]uint64, 0, len(groups))
+		policies  = dCtx.GetGroupPolicies()
+		proposals = dCtx.GetGroupProposals()
+	)
+
+	for _, group := range groups {
+		if group.Tx != nil {
+			group.TxId = group.Tx.Id
+		}
+		if group.Admin != nil {
+			adminId, ok := addrToId[group.Admin.Address]
+			if !ok {
+				return errors.Wrapf(errCantFindAddress, "group admin: %s", group.Admin.Address)
+			}
+			group.AdminId = adminId
+		}
+
+		for _, member := range group.Members {
+			memberId, ok := addrToId[member.Address.Address]
+			if !ok {
+				return errors.Wrapf(errCantFindAddress, "group member: %s", member.Address.Address)
+			}
+			member.AddressId = memberId
+			member.GroupId = group.Id
+			if member.Tx != nil {
+				member.TxId = member.Tx.Id
+			}
+			members = append(members, member)
+		}
+		groupIds = append(groupIds, group.Id)
+	}
+
+	for _, policy := range policies {
+		addressId, ok := addrToId[policy.Address.Address]
+		if !ok {
+			return errors.Wrapf(errCantFindAddress, "group policy: %s", policy.Address.Address)
+		}
+		policy.AddressId = addressId
+
+		if policy.Tx != nil {
+			policy.TxId = policy.Tx.Id
+		}
+		if policy.Admin != nil {
+			adminId, ok := addrToId[policy.Admin.Address]
+			if !ok {
+				return errors.Wrapf(errCantFindAddress, "group policy admin: %s", policy.Admin.Address)
+			}
+			policy.AdminId = adminId
+		}
+	}
+
+	for _, proposal := range proposals {
+		if proposal.Tx != nil {
+			proposal.TxId = proposal.Tx.Id
+		}
+		if proposal.PolicyAddress != nil {
+			policyId, ok := addrToId[proposal.PolicyAddress.Address]
+			if !ok {
+				return errors.Wrapf(errCantFindAddress, "group proposal policy: %s", proposal.PolicyAddress.Address)
+			}
+			proposal.PolicyAddressId = policyId
+		}
+	}
+
+	for _, vote := range dCtx.GroupVotes {
+		voterId, ok := addrToId[vote.Voter.Address]
+		if !ok {
+			return errors.Wrapf(errCantFindAddress, "group voter: %s", vote.Voter.Address)
+		}
+		vote.VoterId = voterId
+		if vote.Tx != nil {
+			vote.TxId = vote.Tx.Id
+		}
+	}
+
+	if err := tx.SaveGroups(ctx, groups...); err != nil {
+		return err
+	}
+	if err := tx.SaveGroupMembers(ctx, members...); err != nil {
+		return err
+	}
+	if err := tx.SaveGroupPolicies(ctx, policies...); err != nil {
+		return err
+	}
+	if err := tx.SaveGroupProposals(ctx, proposals...); err != nil {
+		return err
+	}
+	if err := tx.SaveGroupVotes(ctx, dCtx.GroupVotes...); err != nil {
+		return err
+	}
+
+	if len(members) == 0 {
+		return nil
+	}
+	return tx.UpdateGroupWeights(ctx, groupIds...)
+}
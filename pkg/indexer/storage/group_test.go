@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+	storageTypes "github.com/celenium-io/celestia-indexer/internal/storage/types"
+	decodeContext "github.com/celenium-io/celestia-indexer/pkg/indexer/decode/context"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_saveGroups(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tx := mock.NewMockTransaction(ctrl)
+
+	const (
+		admin  = "celestia1j33593mn9urzydakw06jdun8f37shlucmhr8p6"
+		member = "celestia1vsvx8n7f8dh5udesqqhgrjutyun7zqrgehdq2l"
+		policy = "celestia1vnflc6322f8z7cpl28r7un5dxhmjxghc20aydq"
+	)
+	addrToId := map[string]uint64{
+		admin:  1,
+		member: 2,
+		policy: 3,
+	}
+
+	dCtx := decodeContext.NewContext()
+	dCtx.SetTx(&storage.Tx{Id: 10})
+	dCtx.AddGroup(&storage.Group{
+		Id:       1,
+		Height:   100,
+		Metadata: "group",
+		Admin:    &storage.Address{Address: admin},
+		Members: []*storage.GroupMember{
+			{
+				Height:  100,
+				Weight:  decimal.NewFromInt(2),
+				Address: &storage.Address{Address: member},
+			},
+		},
+	})
+	dCtx.AddGroupPolicy(&storage.GroupPolicy{
+		GroupId:            1,
+		Height:             100,
+		DecisionPolicyType: storageTypes.DecisionPolicyTypeThreshold,
+		Threshold:          decimal.NewFromInt(1),
+		Address:            &storage.Address{Address: policy},
+		Admin:              &storage.Address{Address: admin},
+	})
+	dCtx.AddGroupProposal(&storage.GroupProposal{
+		Id:            1,
+		Height:        100,
+		Status:        storageTypes.GroupProposalStatusSubmitted,
+		Proposers:     []string{member},
+		PolicyAddress: &storage.Address{Address: policy},
+	})
+	dCtx.AddGroupVote(&storage.GroupVote{
+		Height:     100,
+		ProposalId: 1,
+		Option:     storageTypes.VoteOptionYes,
+		Voter:      &storage.Address{Address: member},
+	})
+
+	tx.EXPECT().
+		SaveGroups(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, groups ...*storage.Group) error {
+			require.Len(t, groups, 1)
+			require.EqualValues(t, 1, groups[0].AdminId)
+			require.EqualValues(t, 10, groups[0].TxId)
+			return nil
+		})
+
+	tx.EXPECT().
+		SaveGroupMembers(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, members ...*storage.GroupMember) error {
+			require.Len(t, members, 1)
+			require.EqualValues(t, 1, members[0].GroupId)
+			require.EqualValues(t, 2, members[0].AddressId)
+			require.EqualValues(t, 10, members[0].TxId)
+			require.Equal(t, "2", members[0].Weight.String())
+			return nil
+		})
+
+	tx.EXPECT().
+		SaveGroupPolicies(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, policies ...*storage.GroupPolicy) error {
+			require.Len(t, policies, 1)
+			require.EqualValues(t, 3, policies[0].AddressId)
+			require.EqualValues(t, 1, policies[0].AdminId)
+			require.EqualValues(t, 10, policies[0].TxId)
+			return nil
+		})
+
+	tx.EXPECT().
+		SaveGroupProposals(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, proposals ...*storage.GroupProposal) error {
+			require.Len(t, proposals, 1)
+			require.EqualValues(t, 3, proposals[0].PolicyAddressId)
+			require.EqualValues(t, 10, proposals[0].TxId)
+			require.EqualValues(t, 1, proposals[0].VotesCount)
+			require.EqualValues(t, 1, proposals[0].Yes)
+			return nil
+		})
+
+	tx.EXPECT().
+		SaveGroupVotes(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, votes ...*storage.GroupVote) error {
+			require.Len(t, votes, 1)
+			require.EqualValues(t, 2, votes[0].VoterId)
+			require.EqualValues(t, 10, votes[0].TxId)
+			return nil
+		})
+
+	tx.EXPECT().
+		UpdateGroupWeights(gomock.Any(), uint64(1)).
+		Times(1).
+		Return(nil)
+
+	err := saveGroups(context.Background(), tx, dCtx, addrToId)
+	require.NoError(t, err)
+}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/pkg/errors"
+)
+
+func saveIbc(
+	ctx context.Context,
+	tx storage.Transaction,
+	messages []*storage.Message,
+	addrToId map[string]uint64,
+) error {
+	var (
+		clients     = make([]*storage.IbcClient, 0)
+		connections = make([]*storage.IbcConnection, 0)
+		channels    = make([]*storage.IbcChannel, 0)
+		transfers   = make([]*storage.IbcTransfer, 0)
+		completions = make([]*storage.IbcTransfer, 0)
+		accounts    = make([]*storage.InterchainAccount, 0)
+		packets     = make([]*storage.InterchainAccountPacket, 0)
+	)
+
+	for i := range messages {
+		if client := messages[i].IbcClient; client != nil && client.Id != "" {
+			client.TxId = messages[i].TxId
+			if client.Creator != nil {
+				creatorId, ok := addrToId[client.Creator.Address]
+				if !ok {
+					return errors.Wrapf(errCantFindAddress, "ibc client creator: %s", client.Creator.Address)
+				}
+				client.CreatorId = creatorId
+			}
+			clients = append(clients, client)
+		}
+
+		if conn := messages[i].IbcConnection; conn != nil {
+			conn.CreateTxId = messages[i].TxId
+			if conn.Opened() {
+				conn.ConnectionTxId = &messages[i].TxId
+			}
+			connections = append(connections, conn)
+		}
+
+		if channel := messages[i].IbcChannel; channel != nil {
+			channel.CreateTxId = messages[i].TxId
+			if channel.ConfirmationHeight != nil {
+				channel.ConfirmationTxId = &messages[i].TxId
+			}
+			channels = append(channels, channel)
+		}
+
+		if account := messages[i].InterchainAccount; account != nil {
+			addressId, ok := addrToId[account.Address]
+			if !ok {
+				return errors.Wrapf(errCantFindAddress, "interchain account: %s", account.Address)
+			}
+			account.AddressId = addressId
+			account.TxId = messages[i].TxId
+			accounts = append(accounts, account)
+		}
+
+		if packet := messages[i].InterchainAccountPacket; packet != nil {
+			packet.TxId = messages[i].TxId
+			packets = append(packets, packet)
+		}
+
+		if transfer := messages[i].IbcTransfer; transfer != nil {
+			if transfer.IsCompletion() {
+				completions = append(completions, transfer)
+				continue
+			}
+
+			transfer.TxId = messages[i].TxId
+			if id, ok := addrToId[transfer.Sender]; ok {
+				transfer.SenderId = &id
+			}
+			if id, ok := addrToId[transfer.Receiver]; ok {
+				transfer.ReceiverId = &id
+			}
+			transfers = append(transfers, transfer)
+		}
+	}
+
+	if err := tx.SaveIbcClients(ctx, clients...); err != nil {
+		return err
+	}
+	if err := tx.SaveIbcConnections(ctx, connections...); err != nil {
+		return err
+	}
+	if err := tx.SaveIbcChannels(ctx, channels...); err != nil {
+		return err
+	}
+	if err := tx.SaveIbcTransfers(ctx, transfers...); err != nil {
+		return err
+	}
+	if err := tx.SaveInterchainAccounts(ctx, accounts...); err != nil {
+		return err
+	}
+	if err := tx.SaveInterchainAccountPackets(ctx, packets...); err != nil {
+		return err
+	}
+	return tx.UpdateIbcTransfers(ctx, completions...)
+}
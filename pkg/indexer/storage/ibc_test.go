@@ -0,0 +1,211 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_saveIbc(t *testing.T) {
+	now := time.Now()
+	height := pkgTypes.Level(100)
+
+	messages := []*storage.Message{
+		{
+			Height: height,
+			Time:   now,
+			Type:   types.MsgCreateClient,
+			TxId:   1,
+			IbcClient: &storage.IbcClient{
+				Id:        "07-tendermint-0",
+				Type:      "07-tendermint",
+				ChainId:   "osmosis-1",
+				Height:    height,
+				CreatedAt: now,
+				Creator: &storage.Address{
+					Address: "address1",
+				},
+			},
+		}, {
+			Height: height,
+			Time:   now,
+			Type:   types.MsgCreateClient,
+			TxId:   2,
+			IbcClient: &storage.IbcClient{
+				Height:    height,
+				CreatedAt: now,
+			},
+		}, {
+			Height: height,
+			Time:   now,
+			Type:   types.MsgConnectionOpenAck,
+			TxId:   3,
+			IbcConnection: &storage.IbcConnection{
+				Id:               "connection-0",
+				Height:           height,
+				CreatedAt:        now,
+				ConnectionHeight: &height,
+				ConnectedAt:      &now,
+			},
+		}, {
+			Height: height,
+			Time:   now,
+			Type:   types.MsgChannelOpenInit,
+			TxId:   4,
+			IbcChannel: &storage.IbcChannel{
+				Id:        "channel-0",
+				Height:    height,
+				CreatedAt: now,
+				Status:    types.IbcChannelStatusInitialization,
+			},
+		}, {
+			Height: height,
+			Time:   now,
+			Type:   types.IBCTransfer,
+			TxId:   5,
+			IbcTransfer: &storage.IbcTransfer{
+				Height:    height,
+				Time:      now,
+				ChannelId: "channel-0",
+				Port:      "transfer",
+				Sequence:  1,
+				Amount:    decimal.NewFromInt(100),
+				Denom:     "utia",
+				Sender:    "address1",
+				Receiver:  "osmo1receiver",
+				Status:    types.IbcTransferStatusSent,
+			},
+		}, {
+			Height: height,
+			Time:   now,
+			Type:   types.MsgAcknowledgement,
+			TxId:   6,
+			IbcTransfer: &storage.IbcTransfer{
+				ChannelId:       "channel-0",
+				Port:            "transfer",
+				Sequence:        0,
+				Status:          types.IbcTransferStatusAcknowledged,
+				CompletedHeight: &height,
+				CompletedAt:     &now,
+			},
+		}, {
+			Height: height,
+			Time:   now,
+			Type:   types.MsgChannelOpenTry,
+			TxId:   7,
+			InterchainAccount: &storage.InterchainAccount{
+				Address:      "ica1",
+				Owner:        "osmo1owner",
+				ConnectionId: "connection-0",
+				Height:       height,
+				CreatedAt:    now,
+			},
+		}, {
+			Height: height,
+			Time:   now,
+			Type:   types.MsgRecvPacket,
+			TxId:   8,
+			InterchainAccountPacket: &storage.InterchainAccountPacket{
+				Height:       height,
+				Time:         now,
+				ConnectionId: "connection-0",
+				Owner:        "osmo1owner",
+				Sequence:     1,
+				Success:      true,
+			},
+		},
+	}
+	addrToId := map[string]uint64{
+		"address1": 10,
+		"ica1":     11,
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	tx := mock.NewMockTransaction(ctrl)
+
+	tx.EXPECT().
+		SaveIbcClients(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, clients ...*storage.IbcClient) error {
+			require.Len(t, clients, 1)
+			require.EqualValues(t, 1, clients[0].TxId)
+			require.EqualValues(t, 10, clients[0].CreatorId)
+			return nil
+		})
+
+	tx.EXPECT().
+		SaveIbcConnections(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, conns ...*storage.IbcConnection) error {
+			require.Len(t, conns, 1)
+			require.EqualValues(t, 3, conns[0].CreateTxId)
+			require.NotNil(t, conns[0].ConnectionTxId)
+			require.EqualValues(t, 3, *conns[0].ConnectionTxId)
+			return nil
+		})
+
+	tx.EXPECT().
+		SaveIbcChannels(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, channels ...*storage.IbcChannel) error {
+			require.Len(t, channels, 1)
+			require.EqualValues(t, 4, channels[0].CreateTxId)
+			require.Nil(t, channels[0].ConfirmationTxId)
+			return nil
+		})
+
+	tx.EXPECT().
+		SaveIbcTransfers(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, transfers ...*storage.IbcTransfer) error {
+			require.Len(t, transfers, 1)
+			require.EqualValues(t, 5, transfers[0].TxId)
+			require.NotNil(t, transfers[0].SenderId)
+			require.EqualValues(t, 10, *transfers[0].SenderId)
+			require.Nil(t, transfers[0].ReceiverId)
+			return nil
+		})
+
+	tx.EXPECT().
+		SaveInterchainAccounts(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, accounts ...*storage.InterchainAccount) error {
+			require.Len(t, accounts, 1)
+			require.EqualValues(t, 7, accounts[0].TxId)
+			require.EqualValues(t, 11, accounts[0].AddressId)
+			return nil
+		})
+
+	tx.EXPECT().
+		SaveInterchainAccountPackets(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, packets ...*storage.InterchainAccountPacket) error {
+			require.Len(t, packets, 1)
+			require.EqualValues(t, 8, packets[0].TxId)
+			return nil
+		})
+
+	tx.EXPECT().
+		UpdateIbcTransfers(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, transfers ...*storage.IbcTransfer) error {
+			require.Len(t, transfers, 1)
+			require.EqualValues(t, types.IbcTransferStatusAcknowledged, transfers[0].Status)
+			return nil
+		})
+
+	err := saveIbc(context.Background(), tx, messages, addrToId)
+	require.NoError(t, err)
+}
@@ -18,7 +18,7 @@ func (module *Module) saveMessages(
 	messages []*storage.Message,
 	addrToId map[string]uint64,
 ) error {
-	if err := tx.SaveMessages(ctx, messages...); err != nil {
+	if err := saveMessagesTree(ctx, tx, messages); err != nil {
 		return err
 	}
 
@@ -144,6 +144,40 @@ func (module *Module) saveMessages(
 	return nil
 }
 
+// saveMessagesTree - saves messages level by level to link internal messages of MsgExec with already saved parents
+func saveMessagesTree(ctx context.Context, tx storage.Transaction, messages []*storage.Message) error {
+	internal := make(map[*storage.Message]struct{})
+	for i := range messages {
+		for j := range messages[i].InternalMsgs {
+			internal[messages[i].InternalMsgs[j]] = struct{}{}
+		}
+	}
+
+	level := make([]*storage.Message, 0, len(messages)-len(internal))
+	for i := range messages {
+		if _, ok := internal[messages[i]]; !ok {
+			level = append(level, messages[i])
+		}
+	}
+
+	for len(level) > 0 {
+		if err := tx.SaveMessages(ctx, level...); err != nil {
+			return err
+		}
+
+		next := make([]*storage.Message, 0)
+		for i := range level {
+			for j := range level[i].InternalMsgs {
+				level[i].InternalMsgs[j].ParentId = &level[i].Id
+				level[i].InternalMsgs[j].TxId = level[i].TxId
+				next = append(next, level[i].InternalMsgs[j])
+			}
+		}
+		level = next
+	}
+	return nil
+}
+
 func processPayForBlob(addrToId map[string]uint64, namespaces map[string]uint64, msg *storage.Message, blob *storage.BlobLog) error {
 	if blob.Namespace == nil {
 		return errors.New("nil namespace in pay for blob message")
@@ -373,3 +373,50 @@ func Test_saveMessages(t *testing.T) {
 		})
 	}
 }
+
+func Test_saveMessagesTree(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tx := mock.NewMockTransaction(ctrl)
+
+	delegate := &storage.Message{
+		Height: 100,
+		Type:   types.MsgDelegate,
+	}
+	exec := &storage.Message{
+		Height:       100,
+		Type:         types.MsgExec,
+		TxId:         1,
+		InternalMsgs: []*storage.Message{delegate},
+	}
+	send := &storage.Message{
+		Height: 100,
+		Type:   types.MsgSend,
+		TxId:   1,
+	}
+
+	var lastId uint64
+	tx.EXPECT().
+		SaveMessages(gomock.Any(), gomock.Any()).
+		Times(2).
+		DoAndReturn(func(_ context.Context, msgs ...*storage.Message) error {
+			for i := range msgs {
+				lastId++
+				msgs[i].Id = lastId
+			}
+			return nil
+		})
+
+	err := saveMessagesTree(context.Background(), tx, []*storage.Message{exec, delegate, send})
+	require.NoError(t, err)
+
+	require.EqualValues(t, 1, exec.Id)
+	require.EqualValues(t, 2, send.Id)
+	require.EqualValues(t, 3, delegate.Id)
+	require.Nil(t, exec.ParentId)
+	require.Nil(t, send.ParentId)
+	require.NotNil(t, delegate.ParentId)
+	require.EqualValues(t, 1, *delegate.ParentId)
+	require.EqualValues(t, 1, delegate.TxId)
+}
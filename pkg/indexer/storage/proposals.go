@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	decodeContext "github.com/celenium-io/celestia-indexer/pkg/indexer/decode/context"
+	"github.com/pkg/errors"
+)
+
+func (module *Module) saveProposals(
+	ctx context.Context,
+	tx storage.Transaction,
+	dCtx *decodeContext.Context,
+	addrToId map[string]uint64,
+) error {
+	if dCtx.Proposals.Len() > 0 {
+		proposals := dCtx.GetProposals()
+		for i := range proposals {
+			if proposals[i].Proposer == nil {
+				continue
+			}
+			proposerId, ok := addrToId[proposals[i].Proposer.Address]
+			if !ok {
+				return errors.Wrapf(errCantFindAddress, "proposer address %s", proposals[i].Proposer.Address)
+			}
+			proposals[i].ProposerId = proposerId
+		}
+
+		if err := tx.SaveProposals(ctx, proposals...); err != nil {
+			return err
+		}
+	}
+
+	if len(dCtx.Votes) > 0 {
+		for i := range dCtx.Votes {
+			voterId, ok := addrToId[dCtx.Votes[i].Voter.Address]
+			if !ok {
+				return errors.Wrapf(errCantFindAddress, "voter address %s", dCtx.Votes[i].Voter.Address)
+			}
+			dCtx.Votes[i].VoterId = voterId
+
+			if validatorId, ok := module.validatorsByDelegator[dCtx.Votes[i].Voter.Address]; ok {
+				dCtx.Votes[i].ValidatorId = validatorId
+			}
+		}
+
+		if err := tx.SaveVotes(ctx, dCtx.Votes...); err != nil {
+			return err
+		}
+		if err := tx.UpdateProposalsTally(ctx, votedProposals(dCtx.Votes)...); err != nil {
+			return err
+		}
+	}
+
+	if len(dCtx.Deposits) > 0 {
+		for i := range dCtx.Deposits {
+			depositorId, ok := addrToId[dCtx.Deposits[i].Depositor.Address]
+			if !ok {
+				return errors.Wrapf(errCantFindAddress, "depositor address %s", dCtx.Deposits[i].Depositor.Address)
+			}
+			dCtx.Deposits[i].DepositorId = depositorId
+		}
+
+		if err := tx.SaveDeposits(ctx, dCtx.Deposits...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func votedProposals(votes []storage.Vote) []uint64 {
+	ids := make([]uint64, 0)
+	unique := make(map[uint64]struct{})
+	for i := range votes {
+		if _, ok := unique[votes[i].ProposalId]; ok {
+			continue
+		}
+		unique[votes[i].ProposalId] = struct{}{}
+		ids = append(ids, votes[i].ProposalId)
+	}
+	return ids
+}
@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-net/indexer-sdk/pkg/sync"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+func (module *Module) saveSlashes(
+	ctx context.Context,
+	tx storage.Transaction,
+	slashes []*storage.Slash,
+	jails *sync.Map[string, *storage.Jail],
+) error {
+	if len(slashes) == 0 {
+		return nil
+	}
+
+	for i := range slashes {
+		address := slashes[i].Validator.ConsAddress
+		validatorId, ok := module.validatorsByConsAddress[address]
+		if !ok {
+			return errors.Errorf("unknown slashed validator: %s", address)
+		}
+		slashes[i].ValidatorId = validatorId
+
+		if slashes[i].Tx != nil {
+			slashes[i].TxId = &slashes[i].Tx.Id
+		}
+
+		if jail, ok := jails.Get(address); ok && jail.Id > 0 {
+			slashes[i].JailId = &jail.Id
+		}
+
+		switch slashes[i].Reason {
+		case "double_sign":
+			slashes[i].Fraction = module.slashingForDoubleSign.Copy()
+		case "missing_signature":
+			slashes[i].Fraction = module.slashingForDowntime.Copy()
+		default:
+			slashes[i].Fraction = decimal.Zero
+		}
+	}
+
+	return tx.SaveSlashes(ctx, slashes...)
+}
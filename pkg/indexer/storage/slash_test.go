@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+	"github.com/dipdup-net/indexer-sdk/pkg/sync"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestModule_saveSlashes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tx := mock.NewMockTransaction(ctrl)
+	module := Module{
+		validatorsByConsAddress: map[string]uint64{
+			"81A24EE534DEFE1557A4C7C437E8E8FBC2F834E8": 1,
+			"0D36A6F2C5E7A0E2BAF1BA4A8A7AB0B4C4E4F1A2": 2,
+		},
+		slashingForDoubleSign: decimal.RequireFromString("0.02"),
+		slashingForDowntime:   decimal.RequireFromString("0.0001"),
+	}
+
+	jails := sync.NewMap[string, *storage.Jail]()
+	jails.Set("81A24EE534DEFE1557A4C7C437E8E8FBC2F834E8", &storage.Jail{Id: 10})
+
+	slashes := []*storage.Slash{
+		{
+			Height:    100,
+			Reason:    "double_sign",
+			Tx:        &storage.Tx{Id: 5},
+			Validator: &storage.Validator{ConsAddress: "81A24EE534DEFE1557A4C7C437E8E8FBC2F834E8"},
+		}, {
+			Height:    100,
+			Reason:    "missing_signature",
+			Validator: &storage.Validator{ConsAddress: "0D36A6F2C5E7A0E2BAF1BA4A8A7AB0B4C4E4F1A2"},
+		},
+	}
+
+	tx.EXPECT().
+		SaveSlashes(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, slashes ...*storage.Slash) error {
+			require.Len(t, slashes, 2)
+
+			require.EqualValues(t, 1, slashes[0].ValidatorId)
+			require.NotNil(t, slashes[0].TxId)
+			require.EqualValues(t, 5, *slashes[0].TxId)
+			require.NotNil(t, slashes[0].JailId)
+			require.EqualValues(t, 10, *slashes[0].JailId)
+			require.Equal(t, "0.02", slashes[0].Fraction.String())
+
+			require.EqualValues(t, 2, slashes[1].ValidatorId)
+			require.Nil(t, slashes[1].TxId)
+			require.Nil(t, slashes[1].JailId)
+			require.Equal(t, "0.0001", slashes[1].Fraction.String())
+			return nil
+		})
+
+	err := module.saveSlashes(context.Background(), tx, slashes, jails)
+	require.NoError(t, err)
+
+	err = module.saveSlashes(context.Background(), tx, []*storage.Slash{
+		{Validator: &storage.Validator{ConsAddress: "unknown"}},
+	}, jails)
+	require.Error(t, err)
+}
@@ -22,8 +22,10 @@ import (
 )
 
 const (
-	InputName  = "data"
-	StopOutput = "stop"
+	InputName         = "data"
+	RollbackInputName = "rollback"
+	RollbackOutput    = "state"
+	StopOutput        = "stop"
 )
 
 // Module - saves received from input block to storage.
@@ -31,8 +33,11 @@ const (
 //	                     |----------------|
 //	                     |                |
 //	-- storage.Block ->  |     MODULE     |
-//	                     |                |
+//	-- storage.State ->  |                | -- storage.State ->
 //	                     |----------------|
+//
+// storage.State is received from rollback module. Cached constants are reloaded after rollback and then the state is passed to
+// the receiver, so the next block is not accepted until the cache is actual.
 type Module struct {
 	modules.BaseModule
 	storage                 sdk.Transactable
@@ -45,7 +50,12 @@ type Module struct {
 
 	slashingForDowntime   decimal.Decimal
 	slashingForDoubleSign decimal.Decimal
+	appVersion            uint64
+	dataCommitmentWindow  uint64
 	indexerName           string
+
+	// changedConstants - constants changed in the saving block. They are applied to the cache after commit.
+	changedConstants []storage.Constant
 }
 
 var _ modules.Module = (*Module)(nil)
@@ -69,10 +79,13 @@ func NewModule(
 		validatorsByDelegator:   make(map[string]uint64),
 		slashingForDowntime:     decimal.Zero,
 		slashingForDoubleSign:   decimal.Zero,
+		dataCommitmentWindow:    defaultDataCommitmentWindow,
 		indexerName:             cfg.Name,
 	}
 
 	m.CreateInputWithCapacity(InputName, 16)
+	m.CreateInput(RollbackInputName)
+	m.CreateOutput(RollbackOutput)
 	m.CreateOutput(StopOutput)
 
 	return m
@@ -115,7 +128,33 @@ func (module *Module) isConstantsEmpty() bool {
 }
 
 func (module *Module) initConstants(ctx context.Context) error {
-	doubleSign, err := module.constants.Get(ctx, types.ModuleNameSlashing, "slash_fraction_double_sign")
+	appVersion, err := module.constants.Get(ctx, types.ModuleNameConsensus, constantAppVersion)
+	switch {
+	case err == nil:
+		module.appVersion, err = strconv.ParseUint(appVersion.Value, 10, 64)
+		if err != nil {
+			return err
+		}
+	case module.validators.IsNoRows(err):
+		module.appVersion = 0
+	default:
+		return err
+	}
+
+	window, err := module.constants.Get(ctx, types.ModuleNameBlobstream, constantDataCommitmentWindow)
+	switch {
+	case err == nil:
+		module.dataCommitmentWindow, err = strconv.ParseUint(window.Value, 10, 64)
+		if err != nil {
+			return err
+		}
+	case module.validators.IsNoRows(err):
+		module.dataCommitmentWindow = defaultDataCommitmentWindow
+	default:
+		return err
+	}
+
+	doubleSign, err := module.constants.Get(ctx, types.ModuleNameSlashing, constantSlashFractionDoubleSign)
 	if err != nil {
 		if module.validators.IsNoRows(err) {
 			return nil
@@ -127,7 +166,7 @@ func (module *Module) initConstants(ctx context.Context) error {
 		return err
 	}
 
-	downtime, err := module.constants.Get(ctx, types.ModuleNameSlashing, "slash_fraction_downtime")
+	downtime, err := module.constants.Get(ctx, types.ModuleNameSlashing, constantSlashFractionDowntime)
 	if err != nil {
 		if module.validators.IsNoRows(err) {
 			return nil
@@ -144,11 +183,28 @@ func (module *Module) initConstants(ctx context.Context) error {
 func (module *Module) listen(ctx context.Context) {
 	module.Log.Info().Msg("module started")
 	input := module.MustInput(InputName)
+	rollbackInput := module.MustInput(RollbackInputName)
 
 	for {
 		select {
 		case <-ctx.Done():
 			return
+		case msg, ok := <-rollbackInput.Listen():
+			if !ok {
+				module.Log.Warn().Msg("can't read message from rollback input")
+				continue
+			}
+			state, ok := msg.(storage.State)
+			if !ok {
+				module.Log.Warn().Msgf("invalid message type: %T", msg)
+				continue
+			}
+			if err := module.initConstants(ctx); err != nil {
+				module.Log.Err(err).Msg("constants reloading after rollback")
+				module.MustOutput(StopOutput).Push(struct{}{})
+				continue
+			}
+			module.MustOutput(RollbackOutput).Push(state)
 		case msg, ok := <-input.Listen():
 			if !ok {
 				module.Log.Warn().Msg("can't read message from input")
@@ -200,6 +256,7 @@ func (module *Module) saveBlock(ctx context.Context, dCtx *decodeContext.Context
 	}
 	defer tx.Close(ctx)
 
+	module.changedConstants = nil
 	state, err := module.processBlockInTransaction(ctx, tx, dCtx)
 	if err != nil {
 		return state, tx.HandleError(ctx, err)
@@ -208,6 +265,9 @@ func (module *Module) saveBlock(ctx context.Context, dCtx *decodeContext.Context
 	if err := tx.Flush(ctx); err != nil {
 		return state, tx.HandleError(ctx, err)
 	}
+	if err := module.updateConstants(module.changedConstants); err != nil {
+		return state, errors.Wrap(err, "updating cached constants")
+	}
 	module.Log.Info().
 		Uint64("height", uint64(dCtx.Block.Height)).
 		Time("block_time", dCtx.Block.Time).
@@ -274,9 +334,7 @@ func (module *Module) processBlockInTransaction(ctx context.Context, tx storage.
 	events := make([]storage.Event, 0, 10000)
 	for i := range block.Txs {
 		for j := range block.Txs[i].Messages {
-			block.Txs[i].Messages[j].TxId = block.Txs[i].Id
-			messages = append(messages, &block.Txs[i].Messages[j])
-			setNamespacesFromMessage(block.Txs[i].Messages[j], namespaces)
+			messages = appendMessage(messages, &block.Txs[i].Messages[j], block.Txs[i].Id, namespaces)
 		}
 
 		for j := range block.Txs[i].Events {
@@ -297,7 +355,12 @@ func (module *Module) processBlockInTransaction(ctx context.Context, tx storage.
 		}
 	}
 
-	addrToId, totalAccounts, err := saveAddresses(ctx, tx, dCtx.GetAddresses())
+	slashing, slashed, err := module.saveSlashedDelegations(ctx, tx, block, dCtx.Jails)
+	if err != nil {
+		return state, err
+	}
+
+	addrToId, totalAccounts, err := saveAddresses(ctx, tx, dCtx.GetAddresses(), blockBalanceHistory(dCtx, slashing), slashed)
 	if err != nil {
 		return state, err
 	}
@@ -306,6 +369,10 @@ func (module *Module) processBlockInTransaction(ctx context.Context, tx storage.
 		return state, err
 	}
 
+	if err := saveTransfers(ctx, tx, dCtx.Transfers, addrToId); err != nil {
+		return state, err
+	}
+
 	totalNamespaces, err := saveNamespaces(ctx, tx, namespaces)
 	if err != nil {
 		return state, err
@@ -315,20 +382,65 @@ func (module *Module) processBlockInTransaction(ctx context.Context, tx storage.
 		return state, err
 	}
 
-	totalValidators, err := module.saveValidators(ctx, tx, dCtx.GetValidators(), dCtx.Jails)
+	if err := saveFeePayers(ctx, tx, addrToId, block.Txs); err != nil {
+		return state, err
+	}
+
+	if err := saveIbc(ctx, tx, messages, addrToId); err != nil {
+		return state, err
+	}
+
+	validators := dCtx.GetValidators()
+	totalValidators, err := module.saveValidators(ctx, tx, validators, dCtx.Jails)
 	if err != nil {
 		return state, err
 	}
 
+	if err := saveValidatorHistory(ctx, tx, block, validators); err != nil {
+		return state, err
+	}
+
+	if err := module.saveSlashes(ctx, tx, dCtx.Slashes, dCtx.Jails); err != nil {
+		return state, err
+	}
+
+	if err := module.saveBlobstream(ctx, tx, block, messages, dCtx.Attestations); err != nil {
+		return state, err
+	}
+
 	totalVotingPower, err := module.saveDelegations(ctx, tx, dCtx, addrToId)
 	if err != nil {
 		return state, err
 	}
 
+	if err := module.saveProposals(ctx, tx, dCtx, addrToId); err != nil {
+		return state, err
+	}
+
+	if err := saveGroups(ctx, tx, dCtx, addrToId); err != nil {
+		return state, err
+	}
+
+	if err := module.saveUpgrades(ctx, tx, block, dCtx.GetProposals()); err != nil {
+		return state, err
+	}
+
+	if err := module.saveConstants(ctx, tx, block, dCtx.GetProposals()); err != nil {
+		return state, err
+	}
+
 	if err := module.saveBlockSignatures(ctx, tx, block.BlockSignatures, block.Height); err != nil {
 		return state, err
 	}
 
+	if err := module.saveMissedBlocks(ctx, tx, block.MissedBlocks); err != nil {
+		return state, err
+	}
+
+	if err := tx.SaveBlockDah(ctx, block.Dah); err != nil {
+		return state, err
+	}
+
 	updateState(block, totalAccounts, totalNamespaces, totalValidators, totalVotingPower, &state)
 	err = tx.Update(ctx, &state)
 	return state, err
@@ -353,5 +465,12 @@ func (module *Module) notify(ctx context.Context, state storage.State, block sto
 		return err
 	}
 
+	if block.Stats.BlobsCount > 0 {
+		height := strconv.FormatUint(uint64(block.Height), 10)
+		if err := module.notificator.Notify(ctx, storage.ChannelBlob, height); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
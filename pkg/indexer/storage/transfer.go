@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/pkg/errors"
+)
+
+func saveTransfers(
+	ctx context.Context,
+	tx storage.Transaction,
+	transfers []*storage.Transfer,
+	addrToId map[string]uint64,
+) error {
+	if len(transfers) == 0 {
+		return nil
+	}
+
+	for i := range transfers {
+		if transfers[i].Tx != nil {
+			transfers[i].TxId = &transfers[i].Tx.Id
+		}
+
+		senderId, ok := addrToId[transfers[i].Sender.Address]
+		if !ok {
+			return errors.Wrapf(errCantFindAddress, "transfer sender: %s", transfers[i].Sender.Address)
+		}
+		transfers[i].SenderId = senderId
+
+		receiverId, ok := addrToId[transfers[i].Receiver.Address]
+		if !ok {
+			return errors.Wrapf(errCantFindAddress, "transfer receiver: %s", transfers[i].Receiver.Address)
+		}
+		transfers[i].ReceiverId = receiverId
+	}
+
+	return tx.SaveTransfers(ctx, transfers...)
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_saveTransfers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tx := mock.NewMockTransaction(ctrl)
+
+	transfers := []*storage.Transfer{
+		{
+			Height:   100,
+			Amount:   decimal.RequireFromString("10"),
+			Denom:    "utia",
+			Tx:       &storage.Tx{Id: 5},
+			Sender:   &storage.Address{Address: "address1"},
+			Receiver: &storage.Address{Address: "address2"},
+		}, {
+			Height:   100,
+			Amount:   decimal.RequireFromString("20"),
+			Denom:    "utia",
+			Sender:   &storage.Address{Address: "address2"},
+			Receiver: &storage.Address{Address: "address1"},
+		},
+	}
+	addrToId := map[string]uint64{
+		"address1": 1,
+		"address2": 2,
+	}
+
+	tx.EXPECT().
+		SaveTransfers(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, transfers ...*storage.Transfer) error {
+			require.Len(t, transfers, 2)
+
+			require.NotNil(t, transfers[0].TxId)
+			require.EqualValues(t, 5, *transfers[0].TxId)
+			require.EqualValues(t, 1, transfers[0].SenderId)
+			require.EqualValues(t, 2, transfers[0].ReceiverId)
+
+			require.Nil(t, transfers[1].TxId)
+			require.EqualValues(t, 2, transfers[1].SenderId)
+			require.EqualValues(t, 1, transfers[1].ReceiverId)
+			return nil
+		})
+
+	err := saveTransfers(context.Background(), tx, transfers, addrToId)
+	require.NoError(t, err)
+
+	err = saveTransfers(context.Background(), tx, []*storage.Transfer{
+		{
+			Sender:   &storage.Address{Address: "unknown"},
+			Receiver: &storage.Address{Address: "address1"},
+		},
+	}, addrToId)
+	require.Error(t, err)
+}
@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
+	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/pkg/errors"
+)
+
+// govAuthority - address of gov module which is the authority of upgrades scheduled by proposals
+var govAuthority = func() string {
+	address, _ := pkgTypes.NewAddressFromBytes(authTypes.NewModuleAddress(govTypes.ModuleName))
+	return address.String()
+}()
+
+type upgradePlan struct {
+	Name   string          `json:"name"`
+	Height json.RawMessage `json:"height"`
+	Info   string          `json:"info"`
+}
+
+// saveUpgrades - saves upgrade plans scheduled or cancelled by applied proposals and activates them on app version change.
+// It should be called before `saveConstants` which refreshes cached app version.
+func (module *Module) saveUpgrades(
+	ctx context.Context,
+	tx storage.Transaction,
+	block *storage.Block,
+	proposals []*storage.Proposal,
+) error {
+	applied := make([]*storage.Proposal, 0)
+	for i := range proposals {
+		if proposals[i].Status == types.ProposalStatusApplied {
+			applied = append(applied, proposals[i])
+		}
+	}
+	sort.Slice(applied, func(i, j int) bool {
+		return applied[i].Id < applied[j].Id
+	})
+
+	for i := range applied {
+		switch applied[i].Type {
+		case types.ProposalTypeSoftwareUpgrade:
+			upgrade, err := upgradeFromPlan(applied[i].Changes)
+			if err != nil {
+				return errors.Wrapf(err, "plan of proposal %d", applied[i].Id)
+			}
+			// upgrade module keeps only one plan: new plan overrides the scheduled one
+			if err := tx.CancelUpgrades(ctx, block.Height, block.Time); err != nil {
+				return err
+			}
+			upgrade.Height = block.Height
+			upgrade.Time = block.Time
+			upgrade.ProposalId = &applied[i].Id
+			upgrade.Authority = govAuthority
+			upgrade.Status = types.UpgradeStatusScheduled
+			if err := tx.SaveUpgrades(ctx, upgrade); err != nil {
+				return err
+			}
+		case types.ProposalTypeCancelSoftwareUpgrade:
+			if err := tx.CancelUpgrades(ctx, block.Height, block.Time); err != nil {
+				return err
+			}
+		}
+	}
+
+	if module.appVersion == 0 || block.VersionApp == module.appVersion {
+		return nil
+	}
+
+	count, err := tx.ApplyUpgrades(ctx, block.Height, block.Time, block.VersionApp)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+
+	// app version was changed without scheduled plan, e.g. by signalling
+	activationHeight := block.Height
+	activationTime := block.Time
+	return tx.SaveUpgrades(ctx, &storage.Upgrade{
+		Height:           block.Height,
+		Time:             block.Time,
+		Name:             fmt.Sprintf("v%d", block.VersionApp),
+		PlanHeight:       int64(block.Height),
+		Status:           types.UpgradeStatusApplied,
+		AppVersion:       block.VersionApp,
+		ActivationHeight: &activationHeight,
+		ActivationTime:   &activationTime,
+	})
+}
+
+// upgradeFromPlan - decodes upgrade plan stored in proposal changes
+func upgradeFromPlan(data json.RawMessage) (*storage.Upgrade, error) {
+	if len(data) == 0 {
+		return nil, errors.New("empty upgrade plan")
+	}
+
+	var plan upgradePlan
+	if err := json.Unmarshal(data, &plan); err != nil {
+		return nil, err
+	}
+
+	upgrade := &storage.Upgrade{
+		Name: plan.Name,
+		Info: plan.Info,
+	}
+	if len(plan.Height) > 0 {
+		// height may be encoded either as number or as string
+		value, err := strconv.Unquote(string(plan.Height))
+		if err != nil {
+			value = string(plan.Height)
+		}
+		height, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, "plan height")
+		}
+		upgrade.PlanHeight = height
+	}
+	return upgrade, nil
+}
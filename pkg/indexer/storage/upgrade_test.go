@@ -0,0 +1,180 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_upgradeFromPlan(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    json.RawMessage
+		want    *storage.Upgrade
+		wantErr bool
+	}{
+		{
+			name: "numeric height",
+			data: json.RawMessage(`{"name":"v2","time":"0001-01-01T00:00:00Z","height":1000,"info":"info"}`),
+			want: &storage.Upgrade{
+				Name:       "v2",
+				PlanHeight: 1000,
+				Info:       "info",
+			},
+		}, {
+			name: "string height",
+			data: json.RawMessage(`{"name":"v3","height":"2000"}`),
+			want: &storage.Upgrade{
+				Name:       "v3",
+				PlanHeight: 2000,
+			},
+		}, {
+			name:    "empty",
+			wantErr: true,
+		}, {
+			name:    "invalid height",
+			data:    json.RawMessage(`{"name":"v3","height":"abc"}`),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := upgradeFromPlan(tt.data)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_saveUpgrades(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tx := mock.NewMockTransaction(ctrl)
+	module := Module{
+		appVersion: 1,
+	}
+
+	block := &storage.Block{
+		Height:     100,
+		Time:       time.Now(),
+		VersionApp: 1,
+	}
+	proposals := []*storage.Proposal{
+		{
+			Id:      2,
+			Status:  types.ProposalStatusApplied,
+			Type:    types.ProposalTypeSoftwareUpgrade,
+			Changes: json.RawMessage(`{"name":"v2","height":1000,"info":"info"}`),
+		}, {
+			Id:      1,
+			Status:  types.ProposalStatusRejected,
+			Type:    types.ProposalTypeSoftwareUpgrade,
+			Changes: json.RawMessage(`{"name":"v2","height":900}`),
+		}, {
+			Id:     3,
+			Status: types.ProposalStatusApplied,
+			Type:   types.ProposalTypeText,
+		},
+	}
+
+	tx.EXPECT().
+		CancelUpgrades(gomock.Any(), block.Height, block.Time).
+		Return(nil).
+		Times(1)
+
+	tx.EXPECT().
+		SaveUpgrades(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, upgrades ...*storage.Upgrade) error {
+			require.Len(t, upgrades, 1)
+			require.EqualValues(t, 100, upgrades[0].Height)
+			require.EqualValues(t, "v2", upgrades[0].Name)
+			require.EqualValues(t, 1000, upgrades[0].PlanHeight)
+			require.EqualValues(t, "info", upgrades[0].Info)
+			require.EqualValues(t, types.UpgradeStatusScheduled, upgrades[0].Status)
+			require.EqualValues(t, "celestia10d07y265gmmuvt4z0w9aw880jnsr700jtgz4v7", upgrades[0].Authority)
+			require.NotNil(t, upgrades[0].ProposalId)
+			require.EqualValues(t, 2, *upgrades[0].ProposalId)
+			return nil
+		}).
+		Times(1)
+
+	err := module.saveUpgrades(context.Background(), tx, block, proposals)
+	require.NoError(t, err)
+}
+
+func Test_saveUpgradesActivation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tx := mock.NewMockTransaction(ctrl)
+	module := Module{
+		appVersion: 1,
+	}
+
+	block := &storage.Block{
+		Height:     1000,
+		Time:       time.Now(),
+		VersionApp: 2,
+	}
+
+	tx.EXPECT().
+		ApplyUpgrades(gomock.Any(), block.Height, block.Time, uint64(2)).
+		Return(int64(1), nil).
+		Times(1)
+
+	err := module.saveUpgrades(context.Background(), tx, block, nil)
+	require.NoError(t, err)
+}
+
+func Test_saveUpgradesActivationWithoutPlan(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tx := mock.NewMockTransaction(ctrl)
+	module := Module{
+		appVersion: 2,
+	}
+
+	block := &storage.Block{
+		Height:     1000,
+		Time:       time.Now(),
+		VersionApp: 3,
+	}
+
+	tx.EXPECT().
+		ApplyUpgrades(gomock.Any(), block.Height, block.Time, uint64(3)).
+		Return(int64(0), nil).
+		Times(1)
+
+	tx.EXPECT().
+		SaveUpgrades(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, upgrades ...*storage.Upgrade) error {
+			require.Len(t, upgrades, 1)
+			require.EqualValues(t, "v3", upgrades[0].Name)
+			require.EqualValues(t, 1000, upgrades[0].PlanHeight)
+			require.EqualValues(t, 3, upgrades[0].AppVersion)
+			require.EqualValues(t, types.UpgradeStatusApplied, upgrades[0].Status)
+			require.NotNil(t, upgrades[0].ActivationHeight)
+			require.EqualValues(t, 1000, *upgrades[0].ActivationHeight)
+			return nil
+		}).
+		Times(1)
+
+	err := module.saveUpgrades(context.Background(), tx, block, nil)
+	require.NoError(t, err)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/types"
 	"github.com/dipdup-net/indexer-sdk/pkg/sync"
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
@@ -32,26 +33,6 @@ func (module *Module) saveValidators(
 			}
 
 			jailsArr = append(jailsArr, *j)
-
-			fraction := decimal.Zero
-			switch j.Reason {
-			case "double_sign":
-				fraction = module.slashingForDoubleSign.Copy()
-			case "missing_signature":
-				fraction = module.slashingForDowntime.Copy()
-			}
-			if !fraction.IsPositive() {
-				return nil, false
-			}
-
-			balanceUpdates, err := tx.UpdateSlashedDelegations(ctx, j.ValidatorId, fraction)
-			if err != nil {
-				return err, false
-			}
-			if err := tx.SaveBalances(ctx, balanceUpdates...); err != nil {
-				return err, false
-			}
-
 			return nil, false
 		})
 		if err != nil {
@@ -65,6 +46,13 @@ func (module *Module) saveValidators(
 		if err := tx.SaveJails(ctx, jailsArr...); err != nil {
 			return 0, err
 		}
+
+		// jail ids are required to link slashes with jails
+		for i := range jailsArr {
+			if j, ok := jails.Get(jailsArr[i].Validator.ConsAddress); ok {
+				j.Id = jailsArr[i].Id
+			}
+		}
 	}
 
 	if len(validators) == 0 {
@@ -94,3 +82,92 @@ func (module *Module) saveValidators(
 
 	return count, nil
 }
+
+// saveSlashedDelegations - decreases delegations to jailed validators by slashing fraction of the jail reason.
+// It returns balance history items of slashing and delegator balances after it. Later items of the same address override earlier ones.
+func (module *Module) saveSlashedDelegations(
+	ctx context.Context,
+	tx storage.Transaction,
+	block *storage.Block,
+	jails *sync.Map[string, *storage.Jail],
+) ([]*storage.BalanceHistory, []storage.Balance, error) {
+	var (
+		history  = make([]*storage.BalanceHistory, 0)
+		balances = make([]storage.Balance, 0)
+	)
+
+	err := jails.Range(func(address string, j *storage.Jail) (error, bool) {
+		validatorId, ok := module.validatorsByConsAddress[address]
+		if !ok {
+			return errors.Errorf("unknown jailed validator: %s", address), false
+		}
+
+		fraction := decimal.Zero
+		switch j.Reason {
+		case "double_sign":
+			fraction = module.slashingForDoubleSign.Copy()
+		case "missing_signature":
+			fraction = module.slashingForDowntime.Copy()
+		}
+		if !fraction.IsPositive() {
+			return nil, false
+		}
+
+		balanceUpdates, err := tx.UpdateSlashedDelegations(ctx, validatorId, fraction)
+		if err != nil {
+			return err, false
+		}
+		for i := range balanceUpdates {
+			history = append(history, &storage.BalanceHistory{
+				Height:          block.Height,
+				Time:            block.Time,
+				AddressId:       balanceUpdates[i].Id,
+				Currency:        balanceUpdates[i].Currency,
+				Type:            types.BalanceHistoryTypeSlashing,
+				SpendableChange: decimal.Zero,
+				DelegatedChange: balanceUpdates[i].Delegated.Copy(),
+				UnbondingChange: decimal.Zero,
+			})
+		}
+
+		// balances after slashing are returned to balanceUpdates
+		if err := tx.SaveBalances(ctx, balanceUpdates...); err != nil {
+			return err, false
+		}
+		balances = append(balances, balanceUpdates...)
+		return nil, false
+	})
+	return history, balances, err
+}
+
+// saveValidatorHistory - saves snapshots of validators which description or commission was changed in the block.
+// It should be called after validators were saved.
+func saveValidatorHistory(
+	ctx context.Context,
+	tx storage.Transaction,
+	block *storage.Block,
+	validators []*storage.Validator,
+) error {
+	ids := make([]uint64, 0)
+	for i := range validators {
+		if validators[i].Id == 0 || !isValidatorMetadataChanged(validators[i]) {
+			continue
+		}
+		ids = append(ids, validators[i].Id)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	return tx.SaveValidatorHistory(ctx, block.Height, block.Time, ids...)
+}
+
+// isValidatorMetadataChanged - returns true if validator was created or edited, i.e. its description, commission rate or min self delegation was set
+func isValidatorMetadataChanged(validator *storage.Validator) bool {
+	return validator.Moniker != storage.DoNotModify ||
+		validator.Website != storage.DoNotModify ||
+		validator.Identity != storage.DoNotModify ||
+		validator.Contacts != storage.DoNotModify ||
+		validator.Details != storage.DoNotModify ||
+		validator.RateChanged ||
+		validator.MinSelfDelegationChanged
+}
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/celestia-indexer/internal/storage"
+	"github.com/celenium-io/celestia-indexer/internal/storage/mock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_saveValidatorHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tx := mock.NewMockTransaction(ctrl)
+
+	block := &storage.Block{
+		Height: 100,
+		Time:   time.Now(),
+	}
+
+	delegated := storage.EmptyValidator()
+	delegated.Id = 1
+	delegated.Stake = decimal.RequireFromString("100")
+
+	edited := storage.EmptyValidator()
+	edited.Id = 2
+	edited.Rate = decimal.Zero
+	edited.RateChanged = true
+
+	renamed := storage.EmptyValidator()
+	renamed.Id = 3
+	renamed.Moniker = "new moniker"
+
+	validators := []*storage.Validator{&delegated, &edited, &renamed}
+
+	tx.EXPECT().
+		SaveValidatorHistory(gomock.Any(), block.Height, block.Time, uint64(2), uint64(3)).
+		Return(nil).
+		Times(1)
+
+	err := saveValidatorHistory(context.Background(), tx, block, validators)
+	require.NoError(t, err)
+
+	// nothing is changed
+	err = saveValidatorHistory(context.Background(), tx, block, []*storage.Validator{&delegated})
+	require.NoError(t, err)
+}
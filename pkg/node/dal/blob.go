@@ -5,6 +5,8 @@ package dal
 
 import (
 	"context"
+	"strings"
+
 	pkgTypes "github.com/celenium-io/celestia-indexer/pkg/types"
 
 	"github.com/celenium-io/celestia-indexer/pkg/node/types"
@@ -36,6 +38,9 @@ func (node *Node) Blob(ctx context.Context, height pkgTypes.Level, namespace, co
 	}
 
 	if response.Error != nil {
+		if strings.Contains(response.Error.Message, types.ErrBlobNotFound.Error()) {
+			return response.Result, errors.Wrapf(types.ErrBlobNotFound, "request %d", response.Id)
+		}
 		return response.Result, errors.Wrapf(types.ErrRequest, "request %d error: %s", response.Id, response.Error.Error())
 	}
 	return response.Result, nil
@@ -7,5 +7,6 @@ import "errors"
 
 // errors
 var (
-	ErrRequest = errors.New("request error")
+	ErrRequest      = errors.New("request error")
+	ErrBlobNotFound = errors.New("blob: not found")
 )
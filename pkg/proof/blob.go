@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package proof
+
+import (
+	"bytes"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/da"
+	appNamespace "github.com/celestiaorg/celestia-app/pkg/namespace"
+	appProof "github.com/celestiaorg/celestia-app/pkg/proof"
+	appShares "github.com/celestiaorg/celestia-app/pkg/shares"
+	sqBlob "github.com/celestiaorg/go-square/blob"
+	"github.com/celestiaorg/go-square/inclusion"
+	"github.com/celestiaorg/go-square/merkle"
+	"github.com/celestiaorg/go-square/namespace"
+	"github.com/celestiaorg/go-square/shares"
+	"github.com/celestiaorg/go-square/square"
+	"github.com/pkg/errors"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobProof - inclusion proof of blob shares to the data hash of the block.
+// Shares of the blob occupy range [ShareStart, ShareEnd) of the original data square.
+type BlobProof struct {
+	ShareStart int                `json:"share_start"`
+	ShareEnd   int                `json:"share_end"`
+	Proof      tmTypes.ShareProof `json:"proof"`
+}
+
+// NewBlobProof - reconstructs the data square from block transactions and builds inclusion proof
+// of the blob with passed namespace (version byte followed by namespace id) and commitment.
+func NewBlobProof(txs [][]byte, ns []byte, commitment []byte) (BlobProof, error) {
+	shareRange, err := findBlobShareRange(txs, ns, commitment)
+	if err != nil {
+		return BlobProof{}, err
+	}
+
+	dataSquare, err := square.Construct(
+		txs,
+		appconsts.SquareSizeUpperBound(0),
+		appconsts.SubtreeRootThreshold(0),
+	)
+	if err != nil {
+		return BlobProof{}, errors.Wrap(err, "construct square")
+	}
+
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	if err != nil {
+		return BlobProof{}, errors.Wrap(err, "extend shares")
+	}
+
+	appNs, err := appNamespace.From(ns)
+	if err != nil {
+		return BlobProof{}, errors.Wrap(err, "namespace")
+	}
+
+	shareProof, err := appProof.NewShareInclusionProofFromEDS(
+		eds,
+		appNs,
+		appShares.NewRange(shareRange.Start, shareRange.End),
+	)
+	if err != nil {
+		return BlobProof{}, errors.Wrap(err, "share inclusion proof")
+	}
+
+	return BlobProof{
+		ShareStart: shareRange.Start,
+		ShareEnd:   shareRange.End,
+		Proof:      shareProof,
+	}, nil
+}
+
+// Verify - checks NMT proofs of blob shares to row roots and Merkle proofs of row roots to the data hash of the block.
+// Also it rebuilds the blob from proven shares and checks that it has passed namespace and commitment.
+func (p BlobProof) Verify(dataHash, ns, commitment []byte) error {
+	if p.ShareEnd <= p.ShareStart {
+		return errors.Errorf("invalid share range: [%d, %d)", p.ShareStart, p.ShareEnd)
+	}
+	if len(p.Proof.Data) != p.ShareEnd-p.ShareStart {
+		return errors.Errorf("shares count mismatch: expected %d got %d", p.ShareEnd-p.ShareStart, len(p.Proof.Data))
+	}
+	if err := p.Proof.Validate(dataHash); err != nil {
+		return err
+	}
+
+	blobShares, err := shares.FromBytes(p.Proof.Data)
+	if err != nil {
+		return errors.Wrap(err, "parse shares")
+	}
+	blobs, err := shares.ParseBlobs(blobShares)
+	if err != nil {
+		return errors.Wrap(err, "parse blob")
+	}
+	if len(blobs) != 1 {
+		return errors.Errorf("proven shares contain %d blobs instead of one", len(blobs))
+	}
+
+	if len(ns) != namespace.NamespaceSize || ns[0] != uint8(blobs[0].NamespaceVersion) || !bytes.Equal(ns[1:], blobs[0].NamespaceId) {
+		return errors.Errorf("namespace mismatch: expected %x", ns)
+	}
+
+	blobCommitment, err := createCommitment(blobs[0])
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(blobCommitment, commitment) {
+		return errors.Errorf("commitment mismatch: expected %x got %x", commitment, blobCommitment)
+	}
+	return nil
+}
+
+func findBlobShareRange(txs [][]byte, ns []byte, commitment []byte) (shares.Range, error) {
+	for i := range txs {
+		bTx, isBlob := sqBlob.UnmarshalBlobTx(txs[i])
+		if !isBlob {
+			continue
+		}
+
+		for j, b := range bTx.Blobs {
+			if len(ns) != namespace.NamespaceSize || ns[0] != uint8(b.NamespaceVersion) || !bytes.Equal(ns[1:], b.NamespaceId) {
+				continue
+			}
+
+			blobCommitment, err := createCommitment(b)
+			if err != nil {
+				return shares.Range{}, err
+			}
+			if !bytes.Equal(blobCommitment, commitment) {
+				continue
+			}
+
+			return square.BlobShareRange(
+				txs,
+				i,
+				j,
+				appconsts.SquareSizeUpperBound(0),
+				appconsts.SubtreeRootThreshold(0),
+			)
+		}
+	}
+
+	return shares.Range{}, ErrBlobNotFound
+}
+
+func createCommitment(b *sqBlob.Blob) ([]byte, error) {
+	blobNs, err := namespace.New(uint8(b.NamespaceVersion), b.NamespaceId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't parse namespace: version=%d id=%x", b.NamespaceVersion, b.NamespaceId)
+	}
+	sb := sqBlob.New(blobNs, b.Data, uint8(b.ShareVersion))
+	commitment, err := inclusion.CreateCommitment(sb, merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold(0))
+	if err != nil {
+		return nil, errors.Wrap(err, "can't create commitment")
+	}
+	return commitment, nil
+}
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package proof
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/da"
+	sqBlob "github.com/celestiaorg/go-square/blob"
+	"github.com/celestiaorg/go-square/inclusion"
+	"github.com/celestiaorg/go-square/merkle"
+	"github.com/celestiaorg/go-square/namespace"
+	"github.com/celestiaorg/go-square/shares"
+	"github.com/celestiaorg/go-square/square"
+	"github.com/stretchr/testify/require"
+)
+
+func testBlob(t *testing.T, id byte, size int) (*sqBlob.Blob, []byte) {
+	ns := namespace.MustNewV0(bytes.Repeat([]byte{id}, namespace.NamespaceVersionZeroIDSize))
+	b := sqBlob.New(ns, bytes.Repeat([]byte{id}, size), 0)
+	commitment, err := inclusion.CreateCommitment(b, merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold(0))
+	require.NoError(t, err)
+	return b, commitment
+}
+
+func testBlock(t *testing.T) ([][]byte, []*sqBlob.Blob, [][]byte, []byte) {
+	small, smallCommitment := testBlob(t, 1, 100)
+	large, largeCommitment := testBlob(t, 2, 20000)
+
+	tx1, err := sqBlob.MarshalBlobTx([]byte("pfb_1"), small)
+	require.NoError(t, err)
+	tx2, err := sqBlob.MarshalBlobTx([]byte("pfb_2"), large)
+	require.NoError(t, err)
+
+	txs := [][]byte{[]byte("send"), tx1, tx2}
+
+	dataSquare, err := square.Construct(txs, appconsts.SquareSizeUpperBound(0), appconsts.SubtreeRootThreshold(0))
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+
+	return txs, []*sqBlob.Blob{small, large}, [][]byte{smallCommitment, largeCommitment}, dah.Hash()
+}
+
+func TestNewBlobProof(t *testing.T) {
+	txs, blobs, commitments, dataHash := testBlock(t)
+
+	for i := range blobs {
+		ns := append([]byte{byte(blobs[i].NamespaceVersion)}, blobs[i].NamespaceId...)
+
+		p, err := NewBlobProof(txs, ns, commitments[i])
+		require.NoError(t, err)
+		require.Greater(t, p.ShareEnd, p.ShareStart)
+		require.NoError(t, p.Verify(dataHash, ns, commitments[i]))
+
+		// proof should be verifiable after transferring in JSON
+		raw, err := json.Marshal(p)
+		require.NoError(t, err)
+
+		var decoded BlobProof
+		require.NoError(t, json.Unmarshal(raw, &decoded))
+		require.NoError(t, decoded.Verify(dataHash, ns, commitments[i]))
+
+		require.Error(t, p.Verify(bytes.Repeat([]byte{0}, 32), ns, commitments[i]))
+	}
+}
+
+func TestBlobProofVerifyAnotherBlob(t *testing.T) {
+	txs, blobs, commitments, dataHash := testBlock(t)
+
+	nsSmall := append([]byte{byte(blobs[0].NamespaceVersion)}, blobs[0].NamespaceId...)
+	nsLarge := append([]byte{byte(blobs[1].NamespaceVersion)}, blobs[1].NamespaceId...)
+
+	p, err := NewBlobProof(txs, nsLarge, commitments[1])
+	require.NoError(t, err)
+	require.NoError(t, p.Verify(dataHash, nsLarge, commitments[1]))
+
+	// valid proof of another blob must not be accepted
+	require.Error(t, p.Verify(dataHash, nsSmall, commitments[0]))
+	require.Error(t, p.Verify(dataHash, nsLarge, commitments[0]))
+}
+
+func TestNewBlobProofNotFound(t *testing.T) {
+	txs, blobs, _, _ := testBlock(t)
+
+	ns := append([]byte{byte(blobs[0].NamespaceVersion)}, blobs[0].NamespaceId...)
+	_, err := NewBlobProof(txs, ns, []byte("unknown"))
+	require.ErrorIs(t, err, ErrBlobNotFound)
+}
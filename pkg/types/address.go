@@ -28,6 +28,12 @@ func (a Address) Decode() (string, []byte, error) {
 	return bech32.DecodeAndConvert(a.String())
 }
 
+// IsCelestia returns true if address is a valid bech32 address with celestia account prefix.
+func (a Address) IsCelestia() bool {
+	prefix, _, err := a.Decode()
+	return err == nil && prefix == AddressPrefixCelestia
+}
+
 func (a Address) String() string {
 	return string(a)
 }
@@ -180,6 +180,37 @@ func TestAddress_Decode(t *testing.T) {
 	}
 }
 
+func TestAddress_IsCelestia(t *testing.T) {
+	tests := []struct {
+		name string
+		a    Address
+		want bool
+	}{
+		{
+			name: "celestia",
+			a:    Address("celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8"),
+			want: true,
+		}, {
+			name: "osmosis",
+			a:    Address("osmo1mm8yykm46ec3t0dgwls70g0jvtm055wkuvxanc"),
+			want: false,
+		}, {
+			name: "valoper",
+			a:    Address("celestiavaloper17vmk8m246t648hpmde2q7kp4ft9uwrayy09t5y"),
+			want: false,
+		}, {
+			name: "invalid",
+			a:    Address("0x1234"),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.a.IsCelestia())
+		})
+	}
+}
+
 func TestGetConsAddressBytesFromPubKey(t *testing.T) {
 	tests := []struct {
 		name string
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package types
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const EvidenceTypeDuplicateVote = "tendermint/DuplicateVoteEvidence"
+
+// EvidenceData contains a list of evidences of byzantine behavior included in the block
+type EvidenceData struct {
+	Evidence []Evidence `json:"evidence"`
+}
+
+// Evidence - amino encoded evidence. Value depends on evidence type.
+type Evidence struct {
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+// DuplicateVoteEvidence contains evidence of a single validator signing two conflicting votes.
+type DuplicateVoteEvidence struct {
+	VoteA            *EvidenceVote `json:"vote_a"`
+	VoteB            *EvidenceVote `json:"vote_b"`
+	TotalVotingPower int64         `json:"TotalVotingPower,string"`
+	ValidatorPower   int64         `json:"ValidatorPower,string"`
+	Timestamp        time.Time     `json:"Timestamp"`
+}
+
+// EvidenceVote - conflicting vote of the validator
+type EvidenceVote struct {
+	Height           int64     `json:"height,string"`
+	Round            int32     `json:"round"`
+	Timestamp        time.Time `json:"timestamp"`
+	ValidatorAddress Hex       `json:"validator_address"`
+	ValidatorIndex   int32     `json:"validator_index"`
+}
+
+// DuplicateVote - returns duplicate vote evidence. The second value is false if evidence has another type.
+func (e Evidence) DuplicateVote() (DuplicateVoteEvidence, bool, error) {
+	var evidence DuplicateVoteEvidence
+	if e.Type != EvidenceTypeDuplicateVote {
+		return evidence, false, nil
+	}
+	err := json.Unmarshal(e.Value, &evidence)
+	return evidence, err == nil, err
+}
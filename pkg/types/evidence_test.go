@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvidence_DuplicateVote(t *testing.T) {
+	data := []byte(`{"evidence":[{"type":"tendermint/DuplicateVoteEvidence","value":{"vote_a":{"type":1,"height":"100","round":0,"timestamp":"2023-07-04T03:10:57Z","validator_address":"81A24EE534DEFE1557A4C7C437E8E8FBC2F834E8","validator_index":3},"vote_b":{"type":1,"height":"100","round":0,"timestamp":"2023-07-04T03:10:57Z","validator_address":"81A24EE534DEFE1557A4C7C437E8E8FBC2F834E8","validator_index":3},"TotalVotingPower":"1000","ValidatorPower":"10","Timestamp":"2023-07-04T03:10:57Z"}},{"type":"tendermint/LightClientAttackEvidence","value":{}}]}`)
+
+	var evidences EvidenceData
+	require.NoError(t, json.Unmarshal(data, &evidences))
+	require.Len(t, evidences.Evidence, 2)
+
+	evidence, ok, err := evidences.Evidence[0].DuplicateVote()
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.EqualValues(t, 100, evidence.VoteA.Height)
+	require.EqualValues(t, 10, evidence.ValidatorPower)
+	require.EqualValues(t, 1000, evidence.TotalVotingPower)
+	require.Equal(t, "81A24EE534DEFE1557A4C7C437E8E8FBC2F834E8", evidence.VoteA.ValidatorAddress.String())
+
+	_, ok, err = evidences.Evidence[1].DuplicateVote()
+	require.NoError(t, err)
+	require.False(t, ok)
+}